@@ -0,0 +1,28 @@
+BEGIN;
+
+-- The filter component now emits to the matched and unmatched channels,
+-- instead of the default and fail ones, so connections from filters are moved to them.
+CREATE FUNCTION pg_temp.rename_filter_channels(nodes jsonb, edges jsonb) RETURNS jsonb AS $$
+  SELECT COALESCE(jsonb_agg(
+    CASE
+      WHEN e->>'source_id' IN (
+        SELECT n->>'id' FROM jsonb_array_elements(nodes) n
+        WHERE n->'ref'->'component'->>'name' = 'filter'
+      ) AND e->>'channel' IN ('default', 'fail')
+      THEN jsonb_set(e, '{channel}', CASE e->>'channel' WHEN 'default' THEN '"matched"'::jsonb ELSE '"unmatched"'::jsonb END)
+      ELSE e
+    END
+    ORDER BY ord
+  ), '[]'::jsonb)
+  FROM jsonb_array_elements(edges) WITH ORDINALITY AS t(e, ord)
+$$ LANGUAGE sql;
+
+UPDATE workflows
+SET edges = pg_temp.rename_filter_channels(nodes, edges)
+WHERE nodes @> '[{"ref": {"component": {"name": "filter"}}}]';
+
+UPDATE blueprints
+SET edges = pg_temp.rename_filter_channels(nodes, edges)
+WHERE nodes @> '[{"ref": {"component": {"name": "filter"}}}]';
+
+COMMIT;
@@ -0,0 +1,21 @@
+begin;
+
+CREATE TABLE workflow_node_execution_logs (
+  id           uuid NOT NULL DEFAULT gen_random_uuid(),
+  workflow_id  uuid NOT NULL,
+  node_id      CHARACTER VARYING(128) NOT NULL,
+  execution_id uuid NOT NULL,
+  level        CHARACTER VARYING(16) NOT NULL,
+  message      text NOT NULL,
+  fields       jsonb NOT NULL DEFAULT '{}'::jsonb,
+  created_at   TIMESTAMP NOT NULL,
+
+  PRIMARY KEY (id),
+  FOREIGN KEY (workflow_id) REFERENCES workflows(id) ON DELETE CASCADE,
+  FOREIGN KEY (execution_id) REFERENCES workflow_node_executions(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_workflow_node_execution_logs_execution ON workflow_node_execution_logs(execution_id, created_at);
+CREATE INDEX idx_workflow_node_execution_logs_created_at ON workflow_node_execution_logs(created_at);
+
+commit;
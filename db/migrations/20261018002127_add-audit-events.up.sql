@@ -0,0 +1,21 @@
+begin;
+
+CREATE TABLE audit_events (
+  id              uuid NOT NULL DEFAULT gen_random_uuid(),
+  organization_id uuid NOT NULL,
+  user_id         uuid NOT NULL,
+  method          CHARACTER VARYING(255) NOT NULL,
+  resource        CHARACTER VARYING(64) NOT NULL,
+  action          CHARACTER VARYING(32) NOT NULL,
+  request         jsonb NOT NULL DEFAULT '{}'::jsonb,
+  result          CHARACTER VARYING(32) NOT NULL,
+  created_at      TIMESTAMP NOT NULL,
+
+  PRIMARY KEY (id),
+  FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_audit_events_organization_id ON audit_events(organization_id, created_at);
+CREATE INDEX idx_audit_events_created_at ON audit_events(created_at);
+
+commit;
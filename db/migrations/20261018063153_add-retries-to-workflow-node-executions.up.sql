@@ -0,0 +1,7 @@
+begin;
+
+ALTER TABLE workflow_node_executions
+  ADD COLUMN retry_count INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN next_attempt_at TIMESTAMP;
+
+commit;
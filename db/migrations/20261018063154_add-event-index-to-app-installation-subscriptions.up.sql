@@ -0,0 +1,17 @@
+begin;
+
+ALTER TABLE app_installation_subscriptions
+  ADD COLUMN event_source CHARACTER VARYING(255),
+  ADD COLUMN event_type CHARACTER VARYING(255);
+
+--
+-- EventBridge subscriptions store the source and detail type in their configuration.
+--
+UPDATE app_installation_subscriptions
+  SET event_source = configuration->>'source', event_type = configuration->>'detail-type'
+  WHERE configuration ? 'source' AND configuration ? 'detail-type';
+
+CREATE INDEX idx_app_installation_subscriptions_event
+  ON app_installation_subscriptions(installation_id, event_source, event_type);
+
+commit;
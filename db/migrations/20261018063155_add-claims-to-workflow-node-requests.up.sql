@@ -0,0 +1,8 @@
+begin;
+
+ALTER TABLE workflow_node_requests
+  ADD COLUMN claimed_until TIMESTAMP;
+
+CREATE INDEX idx_node_requests_claimed_until ON workflow_node_requests (claimed_until) WHERE state = 'claimed';
+
+commit;
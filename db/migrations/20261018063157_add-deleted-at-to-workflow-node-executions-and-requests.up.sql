@@ -0,0 +1,9 @@
+begin;
+
+ALTER TABLE workflow_node_executions
+  ADD COLUMN deleted_at TIMESTAMP;
+
+ALTER TABLE workflow_node_requests
+  ADD COLUMN deleted_at TIMESTAMP;
+
+commit;
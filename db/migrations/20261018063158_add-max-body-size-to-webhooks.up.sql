@@ -0,0 +1,6 @@
+begin;
+
+ALTER TABLE webhooks
+  ADD COLUMN max_body_size bigint DEFAULT 0 NOT NULL;
+
+commit;
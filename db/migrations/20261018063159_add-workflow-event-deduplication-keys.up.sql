@@ -0,0 +1,17 @@
+begin;
+
+CREATE TABLE workflow_event_deduplication_keys (
+  id uuid DEFAULT gen_random_uuid() NOT NULL,
+  workflow_id uuid NOT NULL,
+  node_id CHARACTER VARYING(128) NOT NULL,
+  key CHARACTER VARYING(64) NOT NULL,
+  expires_at TIMESTAMP NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  PRIMARY KEY (id),
+  FOREIGN KEY (workflow_id) REFERENCES workflows(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_workflow_event_deduplication_keys_node_key_unique ON workflow_event_deduplication_keys (workflow_id, node_id, key);
+CREATE INDEX idx_workflow_event_deduplication_keys_expires_at ON workflow_event_deduplication_keys (expires_at);
+
+commit;
@@ -0,0 +1,6 @@
+begin;
+
+CREATE INDEX idx_workflow_node_executions_workflow_node_created_at
+  ON workflow_node_executions (workflow_id, node_id, created_at DESC);
+
+commit;
@@ -0,0 +1,8 @@
+begin;
+
+ALTER TABLE app_installations
+  ADD COLUMN sync_failure_count INTEGER DEFAULT 0 NOT NULL,
+  ADD COLUMN last_sync_error TEXT,
+  ADD COLUMN last_sync_failed_at TIMESTAMP;
+
+commit;
@@ -0,0 +1,16 @@
+begin;
+
+CREATE TABLE workflow_node_execution_attachments (
+  id           uuid NOT NULL DEFAULT gen_random_uuid(),
+  execution_id uuid NOT NULL,
+  name         CHARACTER VARYING(128) NOT NULL,
+  data         bytea NOT NULL,
+  created_at   TIMESTAMP NOT NULL,
+  updated_at   TIMESTAMP NOT NULL,
+
+  PRIMARY KEY (id),
+  UNIQUE (execution_id, name),
+  FOREIGN KEY (execution_id) REFERENCES workflow_node_executions(id) ON DELETE CASCADE
+);
+
+commit;
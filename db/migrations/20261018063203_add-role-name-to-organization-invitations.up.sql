@@ -0,0 +1,5 @@
+begin;
+
+ALTER TABLE organization_invitations ADD COLUMN role_name CHARACTER VARYING(255);
+
+commit;
@@ -0,0 +1,26 @@
+begin;
+
+CREATE TABLE workflow_node_execution_dead_letters (
+  id                    uuid NOT NULL DEFAULT gen_random_uuid(),
+  workflow_id           uuid NOT NULL,
+  node_id               CHARACTER VARYING(128) NOT NULL,
+  execution_id          uuid NOT NULL,
+  root_event_id         uuid NOT NULL,
+  event_id              uuid NOT NULL,
+  config_hash           CHARACTER VARYING(64) NOT NULL,
+  configuration         jsonb NOT NULL DEFAULT '{}',
+  input                 jsonb NOT NULL DEFAULT '{}',
+  result_reason         CHARACTER VARYING(128),
+  result_message        text,
+  requeued_execution_id uuid,
+  requeued_at           TIMESTAMP,
+  created_at            TIMESTAMP NOT NULL,
+
+  PRIMARY KEY (id),
+  UNIQUE (execution_id),
+  FOREIGN KEY (execution_id) REFERENCES workflow_node_executions(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_workflow_node_execution_dead_letters_workflow_id ON workflow_node_execution_dead_letters(workflow_id, created_at);
+
+commit;
@@ -0,0 +1,7 @@
+begin;
+
+CREATE INDEX idx_workflow_events_root_created_at_id
+  ON workflow_events (workflow_id, created_at DESC, id DESC)
+  WHERE execution_id IS NULL;
+
+commit;
@@ -126,7 +126,9 @@ CREATE TABLE public.app_installation_subscriptions (
     node_id character varying(128) NOT NULL,
     configuration jsonb DEFAULT '{}'::jsonb NOT NULL,
     created_at timestamp without time zone NOT NULL,
-    updated_at timestamp without time zone NOT NULL
+    updated_at timestamp without time zone NOT NULL,
+    event_source character varying(255),
+    event_type character varying(255)
 );
 
 
@@ -146,7 +148,27 @@ CREATE TABLE public.app_installations (
     browser_action jsonb,
     created_at timestamp without time zone NOT NULL,
     updated_at timestamp without time zone NOT NULL,
-    deleted_at timestamp with time zone
+    deleted_at timestamp with time zone,
+    sync_failure_count integer DEFAULT 0 NOT NULL,
+    last_sync_error text,
+    last_sync_failed_at timestamp without time zone
+);
+
+
+--
+-- Name: audit_events; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.audit_events (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    organization_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    method character varying(255) NOT NULL,
+    resource character varying(64) NOT NULL,
+    action character varying(32) NOT NULL,
+    request jsonb DEFAULT '{}'::jsonb NOT NULL,
+    result character varying(32) NOT NULL,
+    created_at timestamp without time zone NOT NULL
 );
 
 
@@ -277,7 +299,8 @@ CREATE TABLE public.organization_invitations (
     state character varying(20) DEFAULT 'pending'::character varying NOT NULL,
     created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
     updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP,
-    canvas_ids jsonb DEFAULT '[]'::jsonb
+    canvas_ids jsonb DEFAULT '[]'::jsonb,
+    role_name character varying(255)
 );
 
 
@@ -385,7 +408,22 @@ CREATE TABLE public.webhooks (
     deleted_at timestamp without time zone,
     retry_count integer DEFAULT 0 NOT NULL,
     max_retries integer DEFAULT 3 NOT NULL,
-    app_installation_id uuid
+    app_installation_id uuid,
+    max_body_size bigint DEFAULT 0 NOT NULL
+);
+
+
+--
+-- Name: workflow_event_deduplication_keys; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_event_deduplication_keys (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    workflow_id uuid NOT NULL,
+    node_id character varying(128) NOT NULL,
+    key character varying(64) NOT NULL,
+    expires_at timestamp without time zone NOT NULL,
+    created_at timestamp without time zone NOT NULL
 );
 
 
@@ -406,6 +444,42 @@ CREATE TABLE public.workflow_events (
 );
 
 
+--
+-- Name: workflow_node_execution_attachments; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_node_execution_attachments (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    execution_id uuid NOT NULL,
+    name character varying(128) NOT NULL,
+    data bytea NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: workflow_node_execution_dead_letters; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_node_execution_dead_letters (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    workflow_id uuid NOT NULL,
+    node_id character varying(128) NOT NULL,
+    execution_id uuid NOT NULL,
+    root_event_id uuid NOT NULL,
+    event_id uuid NOT NULL,
+    config_hash character varying(64) NOT NULL,
+    configuration jsonb DEFAULT '{}'::jsonb NOT NULL,
+    input jsonb DEFAULT '{}'::jsonb NOT NULL,
+    result_reason character varying(128),
+    result_message text,
+    requeued_execution_id uuid,
+    requeued_at timestamp without time zone,
+    created_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: workflow_node_execution_kvs; Type: TABLE; Schema: public; Owner: -
 --
@@ -421,6 +495,22 @@ CREATE TABLE public.workflow_node_execution_kvs (
 );
 
 
+--
+-- Name: workflow_node_execution_logs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_node_execution_logs (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    workflow_id uuid NOT NULL,
+    node_id character varying(128) NOT NULL,
+    execution_id uuid NOT NULL,
+    level character varying(16) NOT NULL,
+    message text NOT NULL,
+    fields jsonb DEFAULT '{}'::jsonb NOT NULL,
+    created_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: workflow_node_executions; Type: TABLE; Schema: public; Owner: -
 --
@@ -441,7 +531,10 @@ CREATE TABLE public.workflow_node_executions (
     configuration jsonb DEFAULT '{}'::jsonb NOT NULL,
     created_at timestamp without time zone NOT NULL,
     updated_at timestamp without time zone NOT NULL,
-    cancelled_by uuid
+    cancelled_by uuid,
+    retry_count integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone,
+    deleted_at timestamp without time zone
 );
 
 
@@ -473,7 +566,9 @@ CREATE TABLE public.workflow_node_requests (
     run_at timestamp without time zone NOT NULL,
     created_at timestamp without time zone NOT NULL,
     updated_at timestamp without time zone NOT NULL,
-    node_id character varying(128) NOT NULL
+    node_id character varying(128) NOT NULL,
+    claimed_until timestamp without time zone,
+    deleted_at timestamp without time zone
 );
 
 
@@ -624,6 +719,14 @@ ALTER TABLE ONLY public.app_installations
     ADD CONSTRAINT app_installations_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: audit_events audit_events_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_events
+    ADD CONSTRAINT audit_events_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: blueprints blueprints_organization_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -808,6 +911,14 @@ ALTER TABLE ONLY public.webhooks
     ADD CONSTRAINT webhooks_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: workflow_event_deduplication_keys workflow_event_deduplication_keys_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_event_deduplication_keys
+    ADD CONSTRAINT workflow_event_deduplication_keys_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: workflow_events workflow_events_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -816,6 +927,38 @@ ALTER TABLE ONLY public.workflow_events
     ADD CONSTRAINT workflow_events_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: workflow_node_execution_attachments workflow_node_execution_attachments_execution_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_attachments
+    ADD CONSTRAINT workflow_node_execution_attachments_execution_id_name_key UNIQUE (execution_id, name);
+
+
+--
+-- Name: workflow_node_execution_attachments workflow_node_execution_attachments_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_attachments
+    ADD CONSTRAINT workflow_node_execution_attachments_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: workflow_node_execution_dead_letters workflow_node_execution_dead_letters_execution_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_dead_letters
+    ADD CONSTRAINT workflow_node_execution_dead_letters_execution_id_key UNIQUE (execution_id);
+
+
+--
+-- Name: workflow_node_execution_dead_letters workflow_node_execution_dead_letters_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_dead_letters
+    ADD CONSTRAINT workflow_node_execution_dead_letters_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: workflow_node_execution_kvs workflow_node_execution_kvs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -832,6 +975,14 @@ ALTER TABLE ONLY public.workflow_node_requests
     ADD CONSTRAINT workflow_node_execution_requests_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: workflow_node_execution_logs workflow_node_execution_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_logs
+    ADD CONSTRAINT workflow_node_execution_logs_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: workflow_node_executions workflow_node_executions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -921,6 +1072,13 @@ CREATE INDEX idx_app_installation_secrets_installation_id ON public.app_installa
 CREATE INDEX idx_app_installation_secrets_organization_id ON public.app_installation_secrets USING btree (organization_id);
 
 
+--
+-- Name: idx_app_installation_subscriptions_event; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_app_installation_subscriptions_event ON public.app_installation_subscriptions USING btree (installation_id, event_source, event_type);
+
+
 --
 -- Name: idx_app_installation_subscriptions_installation; Type: INDEX; Schema: public; Owner: -
 --
@@ -963,6 +1121,20 @@ CREATE UNIQUE INDEX idx_app_installations_org_name_unique ON public.app_installa
 CREATE INDEX idx_app_installations_organization_id ON public.app_installations USING btree (organization_id);
 
 
+--
+-- Name: idx_audit_events_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_events_created_at ON public.audit_events USING btree (created_at);
+
+
+--
+-- Name: idx_audit_events_organization_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_events_organization_id ON public.audit_events USING btree (organization_id, created_at);
+
+
 --
 -- Name: idx_blueprints_organization_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1005,6 +1177,13 @@ CREATE INDEX idx_casbin_rule_v2 ON public.casbin_rule USING btree (v2);
 CREATE INDEX idx_group_metadata_lookup ON public.group_metadata USING btree (group_name, domain_type, domain_id);
 
 
+--
+-- Name: idx_node_requests_claimed_until; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_node_requests_claimed_until ON public.workflow_node_requests USING btree (claimed_until) WHERE ((state)::text = 'claimed'::text);
+
+
 --
 -- Name: idx_node_requests_state_run_at; Type: INDEX; Schema: public; Owner: -
 --
@@ -1040,6 +1219,20 @@ CREATE INDEX idx_webhooks_app_installation_id ON public.webhooks USING btree (ap
 CREATE INDEX idx_webhooks_deleted_at ON public.webhooks USING btree (deleted_at);
 
 
+--
+-- Name: idx_workflow_event_deduplication_keys_expires_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_event_deduplication_keys_expires_at ON public.workflow_event_deduplication_keys USING btree (expires_at);
+
+
+--
+-- Name: idx_workflow_event_deduplication_keys_node_key_unique; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_workflow_event_deduplication_keys_node_key_unique ON public.workflow_event_deduplication_keys USING btree (workflow_id, node_id, key);
+
+
 --
 -- Name: idx_workflow_events_execution_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1047,6 +1240,13 @@ CREATE INDEX idx_webhooks_deleted_at ON public.webhooks USING btree (deleted_at)
 CREATE INDEX idx_workflow_events_execution_id ON public.workflow_events USING btree (execution_id);
 
 
+--
+-- Name: idx_workflow_events_root_created_at_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_events_root_created_at_id ON public.workflow_events USING btree (workflow_id, created_at DESC, id DESC) WHERE (execution_id IS NULL);
+
+
 --
 -- Name: idx_workflow_events_state; Type: INDEX; Schema: public; Owner: -
 --
@@ -1061,6 +1261,13 @@ CREATE INDEX idx_workflow_events_state ON public.workflow_events USING btree (st
 CREATE INDEX idx_workflow_events_workflow_node_id ON public.workflow_events USING btree (workflow_id, node_id);
 
 
+--
+-- Name: idx_workflow_node_execution_dead_letters_workflow_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_execution_dead_letters_workflow_id ON public.workflow_node_execution_dead_letters USING btree (workflow_id, created_at);
+
+
 --
 -- Name: idx_workflow_node_execution_kvs_ekv; Type: INDEX; Schema: public; Owner: -
 --
@@ -1075,6 +1282,20 @@ CREATE INDEX idx_workflow_node_execution_kvs_ekv ON public.workflow_node_executi
 CREATE INDEX idx_workflow_node_execution_kvs_workflow_node_key_value ON public.workflow_node_execution_kvs USING btree (workflow_id, node_id, key, value);
 
 
+--
+-- Name: idx_workflow_node_execution_logs_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_execution_logs_created_at ON public.workflow_node_execution_logs USING btree (created_at);
+
+
+--
+-- Name: idx_workflow_node_execution_logs_execution; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_execution_logs_execution ON public.workflow_node_execution_logs USING btree (execution_id, created_at);
+
+
 --
 -- Name: idx_workflow_node_executions_event_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1117,6 +1338,13 @@ CREATE INDEX idx_workflow_node_executions_root_event_id ON public.workflow_node_
 CREATE INDEX idx_workflow_node_executions_state_created_at ON public.workflow_node_executions USING btree (state, created_at DESC);
 
 
+--
+-- Name: idx_workflow_node_executions_workflow_node_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_executions_workflow_node_created_at ON public.workflow_node_executions USING btree (workflow_id, node_id, created_at DESC);
+
+
 --
 -- Name: idx_workflow_node_executions_workflow_node_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1259,6 +1487,14 @@ ALTER TABLE ONLY public.app_installations
     ADD CONSTRAINT app_installations_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
+--
+-- Name: audit_events audit_events_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_events
+    ADD CONSTRAINT audit_events_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
 --
 -- Name: workflow_node_execution_kvs fk_wnek_workflow; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1355,6 +1591,14 @@ ALTER TABLE ONLY public.webhooks
     ADD CONSTRAINT webhooks_app_installation_id_fkey FOREIGN KEY (app_installation_id) REFERENCES public.app_installations(id);
 
 
+--
+-- Name: workflow_event_deduplication_keys workflow_event_deduplication_keys_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_event_deduplication_keys
+    ADD CONSTRAINT workflow_event_deduplication_keys_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
 --
 -- Name: workflow_events workflow_events_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1371,6 +1615,22 @@ ALTER TABLE ONLY public.workflow_events
     ADD CONSTRAINT workflow_events_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id);
 
 
+--
+-- Name: workflow_node_execution_attachments workflow_node_execution_attachments_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_attachments
+    ADD CONSTRAINT workflow_node_execution_attachments_execution_id_fkey FOREIGN KEY (execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE CASCADE;
+
+
+--
+-- Name: workflow_node_execution_dead_letters workflow_node_execution_dead_letters_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_dead_letters
+    ADD CONSTRAINT workflow_node_execution_dead_letters_execution_id_fkey FOREIGN KEY (execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE CASCADE;
+
+
 --
 -- Name: workflow_node_execution_kvs workflow_node_execution_kvs_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1379,6 +1639,22 @@ ALTER TABLE ONLY public.workflow_node_execution_kvs
     ADD CONSTRAINT workflow_node_execution_kvs_execution_id_fkey FOREIGN KEY (execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE CASCADE;
 
 
+--
+-- Name: workflow_node_execution_logs workflow_node_execution_logs_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_logs
+    ADD CONSTRAINT workflow_node_execution_logs_execution_id_fkey FOREIGN KEY (execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE CASCADE;
+
+
+--
+-- Name: workflow_node_execution_logs workflow_node_execution_logs_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_logs
+    ADD CONSTRAINT workflow_node_execution_logs_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
 --
 -- Name: workflow_node_executions workflow_node_executions_event_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1515,7 +1791,7 @@ SET row_security = off;
 --
 
 COPY public.schema_migrations (version, dirty) FROM stdin;
-20260212033945	f
+20261018063205	f
 \.
 
 
@@ -1551,7 +1827,7 @@ SET row_security = off;
 --
 
 COPY public.data_migrations (version, dirty) FROM stdin;
-20260202201226	f
+20261018062147	f
 \.
 
 
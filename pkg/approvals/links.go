@@ -0,0 +1,86 @@
+package approvals
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/jwt"
+)
+
+const (
+	LinkTokenSubject = "approval-link"
+	DecisionApprove  = "approve"
+	DecisionReject   = "reject"
+)
+
+/*
+ * LinkClaims bind an approval link to a single decision,
+ * taken by a single user, on a single approval item of an execution.
+ */
+type LinkClaims struct {
+	ID          string
+	ExecutionID uuid.UUID
+	UserID      uuid.UUID
+	Decision    string
+	Index       int
+}
+
+func NewLinkToken(signer *jwt.Signer, executionID, userID uuid.UUID, decision string, index int, duration time.Duration) (string, error) {
+	if decision != DecisionApprove && decision != DecisionReject {
+		return "", fmt.Errorf("invalid decision: %s", decision)
+	}
+
+	return signer.GenerateWithClaims(LinkTokenSubject, duration, map[string]any{
+		"jti":          uuid.NewString(),
+		"execution_id": executionID.String(),
+		"user_id":      userID.String(),
+		"decision":     decision,
+		"index":        index,
+	})
+}
+
+func ParseLinkToken(signer *jwt.Signer, token string) (*LinkClaims, error) {
+	claims, err := signer.ValidateAndGetClaims(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims["sub"] != LinkTokenSubject {
+		return nil, fmt.Errorf("invalid token subject")
+	}
+
+	id, _ := claims["jti"].(string)
+	decision, _ := claims["decision"].(string)
+	if id == "" || (decision != DecisionApprove && decision != DecisionReject) {
+		return nil, fmt.Errorf("invalid token claims")
+	}
+
+	executionID, err := uuid.Parse(fmt.Sprint(claims["execution_id"]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid execution ID: %w", err)
+	}
+
+	userID, err := uuid.Parse(fmt.Sprint(claims["user_id"]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	index, ok := claims["index"].(float64)
+	if !ok || index < 0 {
+		return nil, fmt.Errorf("invalid index")
+	}
+
+	return &LinkClaims{
+		ID:          id,
+		ExecutionID: executionID,
+		UserID:      userID,
+		Decision:    decision,
+		Index:       int(index),
+	}, nil
+}
+
+func LinkURL(baseURL, token string) string {
+	return fmt.Sprintf("%s/api/v1/approvals/%s", strings.TrimRight(baseURL, "/"), token)
+}
@@ -0,0 +1,76 @@
+package approvals
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/jwt"
+)
+
+func Test__LinkToken(t *testing.T) {
+	signer := jwt.NewSigner("test")
+	executionID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("token is bound to execution, user, decision and index", func(t *testing.T) {
+		token, err := NewLinkToken(signer, executionID, userID, DecisionReject, 2, time.Hour)
+		require.NoError(t, err)
+
+		claims, err := ParseLinkToken(signer, token)
+		require.NoError(t, err)
+		assert.NotEmpty(t, claims.ID)
+		assert.Equal(t, executionID, claims.ExecutionID)
+		assert.Equal(t, userID, claims.UserID)
+		assert.Equal(t, DecisionReject, claims.Decision)
+		assert.Equal(t, 2, claims.Index)
+	})
+
+	t.Run("each token has its own ID", func(t *testing.T) {
+		token1, err := NewLinkToken(signer, executionID, userID, DecisionApprove, 0, time.Hour)
+		require.NoError(t, err)
+		token2, err := NewLinkToken(signer, executionID, userID, DecisionApprove, 0, time.Hour)
+		require.NoError(t, err)
+
+		claims1, err := ParseLinkToken(signer, token1)
+		require.NoError(t, err)
+		claims2, err := ParseLinkToken(signer, token2)
+		require.NoError(t, err)
+		assert.NotEqual(t, claims1.ID, claims2.ID)
+	})
+
+	t.Run("invalid decision -> error", func(t *testing.T) {
+		_, err := NewLinkToken(signer, executionID, userID, "maybe", 0, time.Hour)
+		require.ErrorContains(t, err, "invalid decision")
+	})
+
+	t.Run("expired token -> error", func(t *testing.T) {
+		token, err := NewLinkToken(signer, executionID, userID, DecisionApprove, 0, -time.Minute)
+		require.NoError(t, err)
+
+		_, err = ParseLinkToken(signer, token)
+		require.Error(t, err)
+	})
+
+	t.Run("token signed with a different secret -> error", func(t *testing.T) {
+		token, err := NewLinkToken(jwt.NewSigner("other"), executionID, userID, DecisionApprove, 0, time.Hour)
+		require.NoError(t, err)
+
+		_, err = ParseLinkToken(signer, token)
+		require.Error(t, err)
+	})
+
+	t.Run("token for a different subject -> error", func(t *testing.T) {
+		token, err := signer.Generate(userID.String(), time.Hour)
+		require.NoError(t, err)
+
+		_, err = ParseLinkToken(signer, token)
+		require.ErrorContains(t, err, "invalid token subject")
+	})
+}
+
+func Test__LinkURL(t *testing.T) {
+	assert.Equal(t, "https://app.superplane.com/api/v1/approvals/abc", LinkURL("https://app.superplane.com/", "abc"))
+}
@@ -244,7 +244,7 @@ func (a *Handler) acceptInvitation(invitation models.OrganizationInvitation, acc
 			return err
 		}
 
-		err = a.authService.AssignRole(user.ID.String(), models.RoleOrgViewer, invitation.OrganizationID.String(), models.DomainTypeOrganization)
+		err = a.authService.AssignRole(user.ID.String(), invitation.Role(), invitation.OrganizationID.String(), models.DomainTypeOrganization)
 		if err != nil {
 			return err
 		}
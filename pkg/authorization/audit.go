@@ -0,0 +1,100 @@
+package authorization
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/datatypes"
+)
+
+//
+// Request fields that may carry secret values or large documents
+// are never stored in the audit trail.
+//
+
+var auditRedactedFields = []string{
+	"value",
+	"data",
+	"configuration",
+	"spec",
+	"password",
+	"token",
+}
+
+/*
+ * Audit events are recorded for successful mutations,
+ * and for every request denied by the authorization check.
+ * Allowed reads are not recorded, to keep the volume of the audit trail low.
+ * Failing to record one does not fail the request.
+ */
+func (a *AuthorizationInterceptor) recordAuditEvent(userID string, organizationID uuid.UUID, method string, rule AuthorizationRule, req any, result string) {
+	if rule.Action == "read" && result == models.AuditEventResultSuccess {
+		return
+	}
+
+	logger := log.WithFields(log.Fields{
+		"method":       method,
+		"user":         userID,
+		"organization": organizationID.String(),
+	})
+
+	parsedUserID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.Errorf("Invalid user ID for audit event: %v", err)
+		return
+	}
+
+	err = models.CreateAuditEvent(&models.AuditEvent{
+		OrganizationID: organizationID,
+		UserID:         parsedUserID,
+		Method:         method,
+		Resource:       rule.Resource,
+		Action:         rule.Action,
+		Request:        datatypes.NewJSONType(auditRequestSummary(req)),
+		Result:         result,
+	})
+
+	if err != nil {
+		logger.Errorf("Error recording audit event: %v", err)
+	}
+}
+
+func auditRequestSummary(req any) map[string]any {
+	message, ok := req.(proto.Message)
+	if !ok || message == nil {
+		return map[string]any{}
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		return map[string]any{}
+	}
+
+	summary := map[string]any{}
+	if err := json.Unmarshal(data, &summary); err != nil {
+		return map[string]any{}
+	}
+
+	return redactAuditFields(summary)
+}
+
+func redactAuditFields(fields map[string]any) map[string]any {
+	for key, value := range fields {
+		if slices.Contains(auditRedactedFields, strings.ToLower(key)) {
+			delete(fields, key)
+			continue
+		}
+
+		if nested, ok := value.(map[string]any); ok {
+			fields[key] = redactAuditFields(nested)
+		}
+	}
+
+	return fields
+}
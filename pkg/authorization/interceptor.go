@@ -3,8 +3,10 @@ package authorization
 import (
 	"context"
 
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/models"
+	pbAuth "github.com/superplanehq/superplane/pkg/protos/authorization"
 	pbBlueprints "github.com/superplanehq/superplane/pkg/protos/blueprints"
 	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
 	pbGroups "github.com/superplanehq/superplane/pkg/protos/groups"
@@ -46,6 +48,7 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		pbSecrets.Secrets_SetSecretKey_FullMethodName:     {Resource: "secrets", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbSecrets.Secrets_DeleteSecretKey_FullMethodName:  {Resource: "secrets", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbSecrets.Secrets_UpdateSecretName_FullMethodName: {Resource: "secrets", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbSecrets.Secrets_ListSecretUsages_FullMethodName: {Resource: "secrets", Action: "read", DomainType: models.DomainTypeOrganization},
 
 		// Groups rules
 		pbGroups.Groups_CreateGroup_FullMethodName:         {Resource: "groups", Action: "create", DomainType: models.DomainTypeOrganization},
@@ -76,6 +79,7 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		pbOrganization.Organizations_RemoveInvitation_FullMethodName:         {Resource: "members", Action: "delete", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_UpdateOrganization_FullMethodName:       {Resource: "org", Action: "update", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_CreateInvitation_FullMethodName:         {Resource: "members", Action: "create", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_BulkCreateInvitations_FullMethodName:    {Resource: "members", Action: "create", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_GetInviteLink_FullMethodName:            {Resource: "members", Action: "create", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_UpdateInviteLink_FullMethodName:         {Resource: "members", Action: "create", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_ResetInviteLink_FullMethodName:          {Resource: "members", Action: "create", DomainType: models.DomainTypeOrganization},
@@ -87,6 +91,7 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		pbOrganization.Organizations_ListIntegrations_FullMethodName:         {Resource: "integrations", Action: "read", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_DescribeIntegration_FullMethodName:      {Resource: "integrations", Action: "read", DomainType: models.DomainTypeOrganization},
 		pbOrganization.Organizations_ListIntegrationResources_FullMethodName: {Resource: "integrations", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbOrganization.Organizations_ListAuditEvents_FullMethodName:          {Resource: "audit", Action: "read", DomainType: models.DomainTypeOrganization},
 
 		// Blueprints rules
 		pbBlueprints.Blueprints_ListBlueprints_FullMethodName:    {Resource: "blueprints", Action: "read", DomainType: models.DomainTypeOrganization},
@@ -96,24 +101,29 @@ func NewAuthorizationInterceptor(authService Authorization) *AuthorizationInterc
 		pbBlueprints.Blueprints_DeleteBlueprint_FullMethodName:   {Resource: "blueprints", Action: "delete", DomainType: models.DomainTypeOrganization},
 
 		// Canvases rules
-		pbCanvases.Canvases_ListCanvases_FullMethodName:              {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_DescribeCanvas_FullMethodName:            {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_CreateCanvas_FullMethodName:              {Resource: "canvases", Action: "create", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_UpdateCanvas_FullMethodName:              {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_DeleteCanvas_FullMethodName:              {Resource: "canvases", Action: "delete", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ListNodeExecutions_FullMethodName:        {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ListNodeQueueItems_FullMethodName:        {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_DeleteNodeQueueItem_FullMethodName:       {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_UpdateNodePause_FullMethodName:           {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ListCanvasEvents_FullMethodName:          {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ListEventExecutions_FullMethodName:       {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ListChildExecutions_FullMethodName:       {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_CancelExecution_FullMethodName:           {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ResolveExecutionErrors_FullMethodName:    {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_InvokeNodeExecutionAction_FullMethodName: {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_InvokeNodeTriggerAction_FullMethodName:   {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_ListNodeEvents_FullMethodName:            {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
-		pbCanvases.Canvases_EmitNodeEvent_FullMethodName:             {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ListCanvases_FullMethodName:                 {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_DescribeCanvas_FullMethodName:               {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_CreateCanvas_FullMethodName:                 {Resource: "canvases", Action: "create", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_UpdateCanvas_FullMethodName:                 {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_DeleteCanvas_FullMethodName:                 {Resource: "canvases", Action: "delete", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ListNodeExecutions_FullMethodName:           {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_GetNodeStatus_FullMethodName:                {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ListNodeStatuses_FullMethodName:             {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ListNodeQueueItems_FullMethodName:           {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_DeleteNodeQueueItem_FullMethodName:          {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_UpdateNodePause_FullMethodName:              {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ListCanvasEvents_FullMethodName:             {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ListEventExecutions_FullMethodName:          {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ListChildExecutions_FullMethodName:          {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_CancelExecution_FullMethodName:              {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ListExecutionLogs_FullMethodName:            {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ResolveExecutionErrors_FullMethodName:       {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ListDeadLetteredExecutions_FullMethodName:   {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_RequeueDeadLetteredExecution_FullMethodName: {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_InvokeNodeExecutionAction_FullMethodName:    {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_InvokeNodeTriggerAction_FullMethodName:      {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_ListNodeEvents_FullMethodName:               {Resource: "canvases", Action: "read", DomainType: models.DomainTypeOrganization},
+		pbCanvases.Canvases_EmitNodeEvent_FullMethodName:                {Resource: "canvases", Action: "update", DomainType: models.DomainTypeOrganization},
 	}
 
 	return &AuthorizationInterceptor{
@@ -154,19 +164,96 @@ func (a *AuthorizationInterceptor) UnaryInterceptor() grpc.UnaryServerIntercepto
 			return nil, status.Error(codes.NotFound, "organization not found")
 		}
 
-		allowed, err := a.authService.CheckOrganizationPermission(userID, org.ID.String(), rule.Resource, rule.Action)
+		domainType, domainID := domainForRequest(org.ID.String(), req)
+
+		//
+		// Canvas grants are only evaluated for canvases of the organization in the request,
+		// so a grant on a canvas of another organization is never used here.
+		//
+		if domainType == models.DomainTypeCanvas && !canvasBelongsToOrganization(org.ID, domainID) {
+			log.Warnf("User %s tried to access canvas %s outside of organization %s", userID, domainID, org.ID.String())
+			a.recordAuditEvent(userID, org.ID, info.FullMethod, rule, req, models.AuditEventResultDenied)
+			return nil, status.Error(codes.NotFound, "Not found")
+		}
+
+		allowed, err := a.checkPermission(userID, org.ID.String(), domainType, domainID, rule)
 		if err != nil {
 			return nil, err
 		}
 
 		if !allowed {
 			log.Warnf("User %s tried to %s %s in organization %s", userID, rule.Action, rule.Resource, org.ID.String())
+			a.recordAuditEvent(userID, org.ID, info.FullMethod, rule, req, models.AuditEventResultDenied)
 			return nil, status.Error(codes.NotFound, "Not found")
 		}
 
 		newContext := context.WithValue(ctx, OrganizationContextKey, organizationID)
-		newContext = context.WithValue(newContext, DomainTypeContextKey, models.DomainTypeOrganization)
-		newContext = context.WithValue(newContext, DomainIdContextKey, organizationID)
-		return handler(newContext, req)
+		newContext = context.WithValue(newContext, DomainTypeContextKey, domainType)
+		newContext = context.WithValue(newContext, DomainIdContextKey, domainID)
+		response, err := handler(newContext, req)
+		if err != nil {
+			return response, err
+		}
+
+		a.recordAuditEvent(userID, org.ID, info.FullMethod, rule, req, models.AuditEventResultSuccess)
+		return response, nil
+	}
+}
+
+func (a *AuthorizationInterceptor) checkPermission(userID, orgID, domainType, domainID string, rule AuthorizationRule) (bool, error) {
+	if domainType == models.DomainTypeCanvas {
+		return a.authService.CheckCanvasPermission(userID, orgID, domainID, rule.Resource, rule.Action)
+	}
+
+	return a.authService.CheckOrganizationPermission(userID, orgID, rule.Resource, rule.Action)
+}
+
+/*
+ * Requests targeting a single canvas are authorized in the canvas domain,
+ * so canvas-scoped roles apply to them. Everything else, including
+ * canvas requests without a canvas ID, like ListCanvases and CreateCanvas,
+ * is authorized in the organization domain.
+ */
+func domainForRequest(orgID string, req any) (string, string) {
+	canvasID := canvasIDFromRequest(req)
+	if canvasID == "" {
+		return models.DomainTypeOrganization, orgID
+	}
+
+	return models.DomainTypeCanvas, canvasID
+}
+
+func canvasBelongsToOrganization(orgID uuid.UUID, canvasID string) bool {
+	id, err := uuid.Parse(canvasID)
+	if err != nil {
+		return false
+	}
+
+	_, err = models.FindCanvas(orgID, id)
+	return err == nil
+}
+
+/*
+ * Most canvas requests carry the canvas ID in a canvas_id field,
+ * but the ones operating on the canvas itself use an id field.
+ * Role assignments carry it in the domain_id field.
+ */
+func canvasIDFromRequest(req any) string {
+	switch r := req.(type) {
+	case *pbRoles.AssignRoleRequest:
+		if r.GetDomainType() != pbAuth.DomainType_DOMAIN_TYPE_CANVAS {
+			return ""
+		}
+		return r.GetDomainId()
+	case *pbCanvases.DescribeCanvasRequest:
+		return r.GetId()
+	case *pbCanvases.UpdateCanvasRequest:
+		return r.GetId()
+	case *pbCanvases.DeleteCanvasRequest:
+		return r.GetId()
+	case interface{ GetCanvasId() string }:
+		return r.GetCanvasId()
+	default:
+		return ""
 	}
 }
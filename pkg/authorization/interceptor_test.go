@@ -0,0 +1,393 @@
+package authorization_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/models"
+	pbAuth "github.com/superplanehq/superplane/pkg/protos/authorization"
+	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
+	pbOrganization "github.com/superplanehq/superplane/pkg/protos/organizations"
+	pbRoles "github.com/superplanehq/superplane/pkg/protos/roles"
+	pbSecrets "github.com/superplanehq/superplane/pkg/protos/secrets"
+	pbUsers "github.com/superplanehq/superplane/pkg/protos/users"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func Test__AuthorizationInterceptor_Viewer(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	viewerID := uuid.NewString()
+	require.NoError(t, r.AuthService.AssignRole(viewerID, models.RoleOrgViewer, orgID, models.DomainTypeOrganization))
+
+	interceptor := authorization.NewAuthorizationInterceptor(r.AuthService).UnaryInterceptor()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-user-id", viewerID,
+		"x-organization-id", orgID,
+	))
+
+	call := func(method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+			return "ok", nil
+		})
+
+		return err
+	}
+
+	t.Run("read methods are allowed", func(t *testing.T) {
+		methods := []string{
+			pbCanvases.Canvases_ListCanvases_FullMethodName,
+			pbCanvases.Canvases_DescribeCanvas_FullMethodName,
+			pbCanvases.Canvases_ListNodeExecutions_FullMethodName,
+			pbOrganization.Organizations_ListIntegrations_FullMethodName,
+			pbSecrets.Secrets_ListSecrets_FullMethodName,
+			pbUsers.Users_ListUsers_FullMethodName,
+		}
+
+		for _, method := range methods {
+			assert.NoError(t, call(method), "viewer should be allowed to call %s", method)
+		}
+	})
+
+	t.Run("mutating methods are denied", func(t *testing.T) {
+		methods := []string{
+			pbCanvases.Canvases_UpdateCanvas_FullMethodName,
+			pbCanvases.Canvases_CancelExecution_FullMethodName,
+			pbSecrets.Secrets_CreateSecret_FullMethodName,
+			pbOrganization.Organizations_CreateIntegration_FullMethodName,
+		}
+
+		for _, method := range methods {
+			err := call(method)
+			require.Error(t, err, "viewer should not be allowed to call %s", method)
+			s, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.NotFound, s.Code())
+		}
+	})
+}
+
+func Test__AuthorizationInterceptor_CanvasScoped(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+	otherCanvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+	canvasA := canvas.ID.String()
+	canvasB := otherCanvas.ID.String()
+
+	userID := uuid.NewString()
+	require.NoError(t, r.AuthService.AssignRole(userID, models.RoleOrgViewer, orgID, models.DomainTypeOrganization))
+	require.NoError(t, r.AuthService.AssignRole(userID, models.RoleCanvasEditor, canvasA, models.DomainTypeCanvas))
+
+	interceptor := authorization.NewAuthorizationInterceptor(r.AuthService).UnaryInterceptor()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-user-id", userID,
+		"x-organization-id", orgID,
+	))
+
+	call := func(method string, req any) error {
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+			return "ok", nil
+		})
+
+		return err
+	}
+
+	t.Run("user can update canvas with grant", func(t *testing.T) {
+		err := call(pbCanvases.Canvases_UpdateCanvas_FullMethodName, &pbCanvases.UpdateCanvasRequest{Id: canvasA})
+		require.NoError(t, err)
+
+		err = call(pbCanvases.Canvases_CancelExecution_FullMethodName, &pbCanvases.CancelExecutionRequest{CanvasId: canvasA})
+		require.NoError(t, err)
+	})
+
+	t.Run("user cannot update canvas without grant", func(t *testing.T) {
+		err := call(pbCanvases.Canvases_UpdateCanvas_FullMethodName, &pbCanvases.UpdateCanvasRequest{Id: canvasB})
+		require.Error(t, err)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("user falls back to organization permissions on other canvases", func(t *testing.T) {
+		err := call(pbCanvases.Canvases_DescribeCanvas_FullMethodName, &pbCanvases.DescribeCanvasRequest{Id: canvasB})
+		require.NoError(t, err)
+	})
+
+	t.Run("user cannot delete canvas with editor grant", func(t *testing.T) {
+		err := call(pbCanvases.Canvases_DeleteCanvas_FullMethodName, &pbCanvases.DeleteCanvasRequest{Id: canvasA})
+		require.Error(t, err)
+	})
+
+	t.Run("grant on a canvas of another organization is not used", func(t *testing.T) {
+		otherOrg := support.CreateOrganization(t, r, r.User)
+		foreignCanvas, _ := support.CreateCanvas(t, otherOrg.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+		require.NoError(t, r.AuthService.AssignRole(userID, models.RoleCanvasEditor, foreignCanvas.ID.String(), models.DomainTypeCanvas))
+
+		err := call(pbCanvases.Canvases_UpdateCanvas_FullMethodName, &pbCanvases.UpdateCanvasRequest{Id: foreignCanvas.ID.String()})
+		require.Error(t, err)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("unknown canvas -> not found", func(t *testing.T) {
+		err := call(pbCanvases.Canvases_DescribeCanvas_FullMethodName, &pbCanvases.DescribeCanvasRequest{Id: uuid.NewString()})
+		require.Error(t, err)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
+
+func Test__AuthorizationInterceptor_AuditEvents(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	adminID := uuid.New()
+	require.NoError(t, r.AuthService.AssignRole(adminID.String(), models.RoleOrgAdmin, orgID, models.DomainTypeOrganization))
+
+	interceptor := authorization.NewAuthorizationInterceptor(r.AuthService).UnaryInterceptor()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-user-id", adminID.String(),
+		"x-organization-id", orgID,
+	))
+
+	call := func(method string, req any, handlerErr error) error {
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+			return "ok", handlerErr
+		})
+
+		return err
+	}
+
+	listEvents := func(t *testing.T) []models.AuditEvent {
+		events, err := models.ListAuditEvents(r.Organization.ID, models.AuditEventFilters{UserID: &adminID}, 100)
+		require.NoError(t, err)
+		return events
+	}
+
+	t.Run("allowed read methods are not recorded", func(t *testing.T) {
+		require.NoError(t, call(pbSecrets.Secrets_ListSecrets_FullMethodName, &pbSecrets.ListSecretsRequest{}, nil))
+		assert.Empty(t, listEvents(t))
+	})
+
+	t.Run("failed mutations are not recorded", func(t *testing.T) {
+		err := call(pbSecrets.Secrets_CreateSecret_FullMethodName, &pbSecrets.CreateSecretRequest{}, status.Error(codes.InvalidArgument, "bad"))
+		require.Error(t, err)
+		assert.Empty(t, listEvents(t))
+	})
+
+	t.Run("successful mutations are recorded without secret values", func(t *testing.T) {
+		req := &pbSecrets.CreateSecretRequest{
+			Secret: &pbSecrets.Secret{
+				Metadata: &pbSecrets.Secret_Metadata{Name: "my-secret"},
+				Spec: &pbSecrets.Secret_Spec{
+					Local: &pbSecrets.Secret_Local{Data: map[string]string{"key": "super-secret"}},
+				},
+			},
+		}
+
+		require.NoError(t, call(pbSecrets.Secrets_CreateSecret_FullMethodName, req, nil))
+
+		events := listEvents(t)
+		require.Len(t, events, 1)
+		assert.Equal(t, pbSecrets.Secrets_CreateSecret_FullMethodName, events[0].Method)
+		assert.Equal(t, "secrets", events[0].Resource)
+		assert.Equal(t, "create", events[0].Action)
+		assert.Equal(t, models.AuditEventResultSuccess, events[0].Result)
+
+		request := events[0].Request.Data()
+		secret, ok := request["secret"].(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, map[string]any{"name": "my-secret"}, secret["metadata"])
+		assert.NotContains(t, secret, "spec")
+	})
+}
+
+func Test__AuthorizationInterceptor_DeniedAuditEvents(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	viewerID := uuid.New()
+	require.NoError(t, r.AuthService.AssignRole(viewerID.String(), models.RoleOrgViewer, orgID, models.DomainTypeOrganization))
+
+	interceptor := authorization.NewAuthorizationInterceptor(r.AuthService).UnaryInterceptor()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-user-id", viewerID.String(),
+		"x-organization-id", orgID,
+	))
+
+	call := func(method string, req any) error {
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+			return "ok", nil
+		})
+
+		return err
+	}
+
+	listEvents := func(t *testing.T, result string) []models.AuditEvent {
+		events, err := models.ListAuditEvents(r.Organization.ID, models.AuditEventFilters{UserID: &viewerID, Result: result}, 100)
+		require.NoError(t, err)
+		return events
+	}
+
+	t.Run("denied mutation is recorded", func(t *testing.T) {
+		err := call(pbSecrets.Secrets_DeleteSecret_FullMethodName, &pbSecrets.DeleteSecretRequest{IdOrName: "my-secret"})
+		require.Error(t, err)
+
+		events := listEvents(t, models.AuditEventResultDenied)
+		require.Len(t, events, 1)
+		assert.Equal(t, r.Organization.ID, events[0].OrganizationID)
+		assert.Equal(t, viewerID, events[0].UserID)
+		assert.Equal(t, pbSecrets.Secrets_DeleteSecret_FullMethodName, events[0].Method)
+		assert.Equal(t, "secrets", events[0].Resource)
+		assert.Equal(t, "delete", events[0].Action)
+		assert.Equal(t, "my-secret", events[0].Request.Data()["id_or_name"])
+	})
+
+	t.Run("denied read is recorded", func(t *testing.T) {
+		err := call(pbOrganization.Organizations_ListAuditEvents_FullMethodName, &pbOrganization.ListAuditEventsRequest{Id: orgID})
+		require.Error(t, err)
+
+		events := listEvents(t, models.AuditEventResultDenied)
+		require.Len(t, events, 2)
+		assert.Equal(t, "audit", events[0].Resource)
+		assert.Equal(t, "read", events[0].Action)
+	})
+
+	t.Run("allowed mutation is recorded as success", func(t *testing.T) {
+		adminID := uuid.New()
+		require.NoError(t, r.AuthService.AssignRole(adminID.String(), models.RoleOrgAdmin, orgID, models.DomainTypeOrganization))
+		adminCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"x-user-id", adminID.String(),
+			"x-organization-id", orgID,
+		))
+
+		_, err := interceptor(adminCtx, &pbSecrets.DeleteSecretRequest{IdOrName: "my-secret"}, &grpc.UnaryServerInfo{FullMethod: pbSecrets.Secrets_DeleteSecret_FullMethodName}, func(ctx context.Context, req any) (any, error) {
+			return "ok", nil
+		})
+		require.NoError(t, err)
+
+		events, err := models.ListAuditEvents(r.Organization.ID, models.AuditEventFilters{UserID: &adminID}, 100)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, models.AuditEventResultSuccess, events[0].Result)
+		assert.Equal(t, "delete", events[0].Action)
+	})
+
+	t.Run("admin can list audit events", func(t *testing.T) {
+		adminID := uuid.New()
+		require.NoError(t, r.AuthService.AssignRole(adminID.String(), models.RoleOrgAdmin, orgID, models.DomainTypeOrganization))
+		adminCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"x-user-id", adminID.String(),
+			"x-organization-id", orgID,
+		))
+
+		_, err := interceptor(adminCtx, &pbOrganization.ListAuditEventsRequest{Id: orgID}, &grpc.UnaryServerInfo{FullMethod: pbOrganization.Organizations_ListAuditEvents_FullMethodName}, func(ctx context.Context, req any) (any, error) {
+			return "ok", nil
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func Test__AuthorizationInterceptor_CanvasDomain(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+	canvasID := canvas.ID.String()
+
+	adminID := uuid.NewString()
+	require.NoError(t, r.AuthService.AssignRole(adminID, models.RoleOrgViewer, orgID, models.DomainTypeOrganization))
+	require.NoError(t, r.AuthService.AssignRole(adminID, models.RoleCanvasAdmin, canvasID, models.DomainTypeCanvas))
+
+	editorID := uuid.NewString()
+	require.NoError(t, r.AuthService.AssignRole(editorID, models.RoleOrgViewer, orgID, models.DomainTypeOrganization))
+	require.NoError(t, r.AuthService.AssignRole(editorID, models.RoleCanvasEditor, canvasID, models.DomainTypeCanvas))
+
+	interceptor := authorization.NewAuthorizationInterceptor(r.AuthService).UnaryInterceptor()
+
+	type domain struct {
+		domainType string
+		domainID   string
+	}
+
+	call := func(userID, method string, req any) (*domain, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"x-user-id", userID,
+			"x-organization-id", orgID,
+		))
+
+		var received *domain
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+			received = &domain{
+				domainType: ctx.Value(authorization.DomainTypeContextKey).(string),
+				domainID:   ctx.Value(authorization.DomainIdContextKey).(string),
+			}
+
+			return "ok", nil
+		})
+
+		return received, err
+	}
+
+	t.Run("requests for a canvas use the canvas domain", func(t *testing.T) {
+		received, err := call(adminID, pbCanvases.Canvases_DescribeCanvas_FullMethodName, &pbCanvases.DescribeCanvasRequest{Id: canvasID})
+		require.NoError(t, err)
+		assert.Equal(t, &domain{domainType: models.DomainTypeCanvas, domainID: canvasID}, received)
+
+		received, err = call(adminID, pbCanvases.Canvases_ListNodeExecutions_FullMethodName, &pbCanvases.ListNodeExecutionsRequest{CanvasId: canvasID})
+		require.NoError(t, err)
+		assert.Equal(t, &domain{domainType: models.DomainTypeCanvas, domainID: canvasID}, received)
+	})
+
+	t.Run("requests without a canvas ID use the organization domain", func(t *testing.T) {
+		received, err := call(adminID, pbCanvases.Canvases_ListCanvases_FullMethodName, &pbCanvases.ListCanvasesRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, &domain{domainType: models.DomainTypeOrganization, domainID: orgID}, received)
+	})
+
+	t.Run("canvas admin can delete the canvas", func(t *testing.T) {
+		_, err := call(adminID, pbCanvases.Canvases_DeleteCanvas_FullMethodName, &pbCanvases.DeleteCanvasRequest{Id: canvasID})
+		require.NoError(t, err)
+	})
+
+	t.Run("canvas admin can assign roles on the canvas only", func(t *testing.T) {
+		received, err := call(adminID, pbRoles.Roles_AssignRole_FullMethodName, &pbRoles.AssignRoleRequest{
+			DomainType: pbAuth.DomainType_DOMAIN_TYPE_CANVAS,
+			DomainId:   canvasID,
+			RoleName:   models.RoleCanvasViewer,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain{domainType: models.DomainTypeCanvas, domainID: canvasID}, received)
+
+		_, err = call(adminID, pbRoles.Roles_AssignRole_FullMethodName, &pbRoles.AssignRoleRequest{
+			DomainType: pbAuth.DomainType_DOMAIN_TYPE_ORGANIZATION,
+			DomainId:   orgID,
+			RoleName:   models.RoleOrgViewer,
+		})
+
+		require.Error(t, err)
+	})
+
+	t.Run("canvas editor cannot assign roles on the canvas", func(t *testing.T) {
+		_, err := call(editorID, pbRoles.Roles_AssignRole_FullMethodName, &pbRoles.AssignRoleRequest{
+			DomainType: pbAuth.DomainType_DOMAIN_TYPE_CANVAS,
+			DomainId:   canvasID,
+			RoleName:   models.RoleCanvasViewer,
+		})
+
+		require.Error(t, err)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
@@ -76,6 +76,31 @@ func (a *AuthService) CheckOrganizationPermission(userID, orgID, resource, actio
 	return a.checkPermission(userID, orgID, models.DomainTypeOrganization, resource, action)
 }
 
+/*
+ * Checks the permission of a user on a specific canvas.
+ * Roles assigned directly on the canvas only add permissions:
+ * the user keeps everything their organization-level role allows,
+ * so an org admin with a viewer role on a canvas can still update it.
+ */
+func (a *AuthService) CheckCanvasPermission(userID, orgID, canvasID, resource, action string) (bool, error) {
+	allowed, err := a.CheckOrganizationPermission(userID, orgID, resource, action)
+	if err != nil {
+		return false, err
+	}
+
+	if allowed {
+		return true, nil
+	}
+
+	domain := prefixDomain(models.DomainTypeCanvas, canvasID)
+	err = a.loadPoliciesForDomain(domain, models.DomainTypeCanvas)
+	if err != nil {
+		return false, err
+	}
+
+	return a.enforcer.Enforce(prefixUserID(userID), domain, resource, action)
+}
+
 func (a *AuthService) IsValidPermission(domainType string, permission *Permission) bool {
 	if permission == nil {
 		return false
@@ -99,13 +124,27 @@ func (a *AuthService) IsValidPermission(domainType string, permission *Permissio
 
 func (a *AuthService) checkPermission(userID, domainID, domainType, resource, action string) (bool, error) {
 	domain := prefixDomain(domainType, domainID)
-	defaultDomain := ""
-	if domainType == models.DomainTypeOrganization {
-		defaultDomain = "/org/*"
+	err := a.loadPoliciesForDomain(domain, domainType)
+	if err != nil {
+		return false, err
+	}
+
+	prefixedUserID := prefixUserID(userID)
+	allowed, err := a.enforcer.Enforce(prefixedUserID, domain, resource, action)
+	if err != nil {
+		return false, err
+	}
+
+	if allowed {
+		return true, nil
 	}
 
+	return false, nil
+}
+
+func (a *AuthService) loadPoliciesForDomain(domain, domainType string) error {
 	policyDomains := []string{domain}
-	if defaultDomain != "" {
+	if defaultDomain := defaultDomainFor(domainType); defaultDomain != "" {
 		policyDomains = append(policyDomains, defaultDomain)
 	}
 
@@ -127,24 +166,10 @@ func (a *AuthService) checkPermission(userID, domainID, domainType, resource, ac
 	//
 	err := a.enforcer.LoadFilteredPolicy(filters)
 	if err != nil {
-		return false, err
-	}
-
-	if err := a.loadDefaultPolicies(); err != nil {
-		return false, err
-	}
-
-	prefixedUserID := prefixUserID(userID)
-	allowed, err := a.enforcer.Enforce(prefixedUserID, domain, resource, action)
-	if err != nil {
-		return false, err
-	}
-
-	if allowed {
-		return true, nil
+		return err
 	}
 
-	return false, nil
+	return a.loadDefaultPolicies()
 }
 
 func (a *AuthService) CreateGroup(domainID string, domainType string, groupName string, role string, displayName string, description string) error {
@@ -405,6 +430,7 @@ func (a *AuthService) assignRoleWithEnforcer(enforcer casbin.IEnforcer, userID,
 	// Check if it's a default role
 	validRoles := map[string][]string{
 		models.DomainTypeOrganization: {models.RoleOrgViewer, models.RoleOrgAdmin, models.RoleOrgOwner},
+		models.DomainTypeCanvas:       {models.RoleCanvasViewer, models.RoleCanvasEditor, models.RoleCanvasAdmin},
 	}
 
 	isValidDefaultRole := false
@@ -868,6 +894,7 @@ func (a *AuthService) DeleteCustomRole(domainID string, domainType string, roleN
 func (a *AuthService) IsDefaultRole(roleName string, domainType string) bool {
 	defaultRoles := map[string][]string{
 		models.DomainTypeOrganization: {models.RoleOrgOwner, models.RoleOrgAdmin, models.RoleOrgViewer},
+		models.DomainTypeCanvas:       {models.RoleCanvasAdmin, models.RoleCanvasEditor, models.RoleCanvasViewer},
 	}
 
 	roles, exists := defaultRoles[domainType]
@@ -1150,6 +1177,10 @@ func (a *AuthService) getRoleDescription(roleName string) string {
 		models.RoleOrgViewer: models.DescOrgViewer,
 		models.RoleOrgAdmin:  models.DescOrgAdmin,
 		models.RoleOrgOwner:  models.DescOrgOwner,
+
+		models.RoleCanvasViewer: models.DescCanvasViewer,
+		models.RoleCanvasEditor: models.DescCanvasEditor,
+		models.RoleCanvasAdmin:  models.DescCanvasAdmin,
 	}
 
 	if description, exists := descriptions[roleName]; exists {
@@ -1172,6 +1203,10 @@ func (a *AuthService) getDomainTypeFromDomain(domain string) string {
 		return models.DomainTypeOrganization
 	}
 
+	if strings.HasPrefix(domain, "/canvas/") {
+		return models.DomainTypeCanvas
+	}
+
 	return ""
 }
 
@@ -1227,6 +1262,21 @@ func prefixDomain(domainType string, domainID string) string {
 	return fmt.Sprintf("/%s/%s", domainType, domainID)
 }
 
+/*
+ * Default roles are defined once, in a wildcard domain,
+ * and apply to all domains of the same type.
+ */
+func defaultDomainFor(domainType string) string {
+	switch domainType {
+	case models.DomainTypeOrganization:
+		return "/org/*"
+	case models.DomainTypeCanvas:
+		return "/canvas/*"
+	default:
+		return ""
+	}
+}
+
 func useIfNonEmpty(a, b string) string {
 	if a != "" {
 		return a
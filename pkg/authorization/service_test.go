@@ -123,6 +123,94 @@ func Test__AuthService_OrganizationPermissions(t *testing.T) {
 		allowed, err = r.AuthService.CheckOrganizationPermission(viewerID, orgID, memberPath, "create")
 		require.NoError(t, err)
 		assert.False(t, allowed)
+
+		// Should have read permissions for members, integrations and secrets
+		for _, resource := range []string{memberPath, "integrations", "secrets"} {
+			allowed, err := r.AuthService.CheckOrganizationPermission(viewerID, orgID, resource, "read")
+			require.NoError(t, err)
+			assert.True(t, allowed, "Org viewer should have read permission for %s", resource)
+		}
+
+		// Should not be able to manage secrets
+		allowed, err = r.AuthService.CheckOrganizationPermission(viewerID, orgID, "secrets", "create")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+}
+
+func Test__AuthService_CanvasPermissions(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	canvasID := uuid.NewString()
+
+	t.Run("canvas grant does not remove organization permissions", func(t *testing.T) {
+		adminID := uuid.NewString()
+		require.NoError(t, r.AuthService.AssignRole(adminID, models.RoleOrgAdmin, orgID, models.DomainTypeOrganization))
+		require.NoError(t, r.AuthService.AssignRole(adminID, models.RoleCanvasViewer, canvasID, models.DomainTypeCanvas))
+
+		for _, action := range []string{"read", "update", "delete"} {
+			allowed, err := r.AuthService.CheckCanvasPermission(adminID, orgID, canvasID, "canvases", action)
+			require.NoError(t, err)
+			assert.True(t, allowed, "Org admin with canvas viewer role should keep %s permission", action)
+		}
+	})
+
+	t.Run("canvas grant adds permissions to the organization role", func(t *testing.T) {
+		viewerID := uuid.NewString()
+		require.NoError(t, r.AuthService.AssignRole(viewerID, models.RoleOrgViewer, orgID, models.DomainTypeOrganization))
+
+		allowed, err := r.AuthService.CheckCanvasPermission(viewerID, orgID, canvasID, "canvases", "update")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+
+		require.NoError(t, r.AuthService.AssignRole(viewerID, models.RoleCanvasEditor, canvasID, models.DomainTypeCanvas))
+
+		allowed, err = r.AuthService.CheckCanvasPermission(viewerID, orgID, canvasID, "canvases", "update")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, err = r.AuthService.CheckCanvasPermission(viewerID, orgID, uuid.NewString(), "canvases", "update")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("canvas editor inherits canvas viewer permissions", func(t *testing.T) {
+		editorID := uuid.NewString()
+		require.NoError(t, r.AuthService.AssignRole(editorID, models.RoleCanvasEditor, canvasID, models.DomainTypeCanvas))
+
+		for _, action := range []string{"read", "update"} {
+			allowed, err := r.AuthService.CheckCanvasPermission(editorID, orgID, canvasID, "canvases", action)
+			require.NoError(t, err)
+			assert.True(t, allowed, "Canvas editor should have %s permission", action)
+		}
+
+		allowed, err := r.AuthService.CheckCanvasPermission(editorID, orgID, canvasID, "canvases", "delete")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("canvas admin inherits canvas editor permissions", func(t *testing.T) {
+		adminID := uuid.NewString()
+		require.NoError(t, r.AuthService.AssignRole(adminID, models.RoleCanvasAdmin, canvasID, models.DomainTypeCanvas))
+
+		for _, action := range []string{"read", "update", "delete"} {
+			allowed, err := r.AuthService.CheckCanvasPermission(adminID, orgID, canvasID, "canvases", action)
+			require.NoError(t, err)
+			assert.True(t, allowed, "Canvas admin should have %s permission", action)
+		}
+
+		allowed, err := r.AuthService.CheckCanvasPermission(adminID, orgID, canvasID, "members", "update")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, err = r.AuthService.CheckOrganizationPermission(adminID, orgID, "members", "update")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("organization roles cannot be assigned on a canvas", func(t *testing.T) {
+		err := r.AuthService.AssignRole(uuid.NewString(), models.RoleOrgAdmin, canvasID, models.DomainTypeCanvas)
+		require.Error(t, err)
 	})
 }
 
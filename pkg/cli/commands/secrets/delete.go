@@ -7,7 +7,9 @@ import (
 	"github.com/superplanehq/superplane/pkg/cli/core"
 )
 
-type deleteCommand struct{}
+type deleteCommand struct {
+	force *bool
+}
 
 func (c *deleteCommand) Execute(ctx core.CommandContext) error {
 	organizationID, err := resolveOrganizationID(ctx)
@@ -15,11 +17,16 @@ func (c *deleteCommand) Execute(ctx core.CommandContext) error {
 		return err
 	}
 
-	response, _, err := ctx.API.SecretAPI.
+	request := ctx.API.SecretAPI.
 		SecretsDeleteSecret(ctx.Context, ctx.Args[0]).
 		DomainType(string(organizationDomainType())).
-		DomainId(organizationID).
-		Execute()
+		DomainId(organizationID)
+
+	if c.force != nil && *c.force {
+		request = request.Force(true)
+	}
+
+	response, _, err := request.Execute()
 	if err != nil {
 		return err
 	}
@@ -51,7 +51,9 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 		Short: "Delete a secret",
 		Args:  cobra.ExactArgs(1),
 	}
-	core.Bind(deleteCmd, &deleteCommand{}, options)
+	var deleteForce bool
+	deleteCmd.Flags().BoolVar(&deleteForce, "force", false, "delete the secret even if nodes or integrations still use it")
+	core.Bind(deleteCmd, &deleteCommand{force: &deleteForce}, options)
 
 	root.AddCommand(listCmd)
 	root.AddCommand(getCmd)
@@ -19,6 +19,7 @@ const (
 	StatePending  = "pending"
 	StateApproved = "approved"
 	StateRejected = "rejected"
+	StateExpired  = "expired"
 
 	ItemTypeAnyone = "anyone"
 	ItemTypeUser   = "user"
@@ -27,6 +28,11 @@ const (
 
 	ChannelApproved = "approved"
 	ChannelRejected = "rejected"
+	ChannelExpired  = "expired"
+
+	ActionExpire = "expire"
+
+	DefaultLinkExpiration = 7 * 24 * time.Hour
 )
 
 func init() {
@@ -38,22 +44,25 @@ func init() {
  * Filled when the component is added to a blueprint/workflow.
  */
 type Config struct {
-	Items []Item `json:"items" mapstructure:"items"`
+	Items               []Item `json:"items" mapstructure:"items"`
+	ExpiresAfterSeconds int    `json:"expiresAfterSeconds,omitempty" mapstructure:"expiresAfterSeconds"`
 }
 
 type Item struct {
-	Type  string `mapstructure:"type" json:"type"`
-	User  string `mapstructure:"user" json:"user,omitempty"`
-	Role  string `mapstructure:"role" json:"role,omitempty"`
-	Group string `mapstructure:"group" json:"group,omitempty"`
+	Type         string `mapstructure:"type" json:"type"`
+	User         string `mapstructure:"user" json:"user,omitempty"`
+	Role         string `mapstructure:"role" json:"role,omitempty"`
+	Group        string `mapstructure:"group" json:"group,omitempty"`
+	MinApprovals int    `mapstructure:"minApprovals" json:"minApprovals,omitempty"`
 }
 
 /*
  * Metadata for the component.
  */
 type Metadata struct {
-	Result  string   `mapstructure:"result" json:"result"`
-	Records []Record `mapstructure:"records" json:"records"`
+	Result    string   `mapstructure:"result" json:"result"`
+	Records   []Record `mapstructure:"records" json:"records"`
+	ExpiresAt string   `mapstructure:"expiresAt" json:"expiresAt,omitempty"`
 }
 
 type Record struct {
@@ -65,6 +74,34 @@ type Record struct {
 	Group     *string        `mapstructure:"group" json:"group,omitempty"`
 	Approval  *ApprovalInfo  `mapstructure:"approval" json:"approval,omitempty"`
 	Rejection *RejectionInfo `mapstructure:"rejection" json:"rejection,omitempty"`
+
+	//
+	// Group records are resolved to the group members when the execution starts,
+	// and are only approved once MinApprovals distinct members approve.
+	//
+	Members      []core.User      `mapstructure:"members" json:"members,omitempty"`
+	MinApprovals int              `mapstructure:"minApprovals" json:"minApprovals,omitempty"`
+	Approvals    []MemberApproval `mapstructure:"approvals" json:"approvals,omitempty"`
+}
+
+type MemberApproval struct {
+	User       core.User `mapstructure:"user" json:"user"`
+	ApprovedAt string    `mapstructure:"approvedAt" json:"approvedAt"`
+	Comment    string    `mapstructure:"comment" json:"comment"`
+}
+
+func (r *Record) hasMemberApproval(userID string) bool {
+	return slices.ContainsFunc(r.Approvals, func(approval MemberApproval) bool {
+		return approval.User.ID == userID
+	})
+}
+
+func (r *Record) requiredApprovals() int {
+	if r.MinApprovals < 1 {
+		return 1
+	}
+
+	return r.MinApprovals
 }
 
 type ApprovalInfo struct {
@@ -117,6 +154,10 @@ func (m *Metadata) hasApprovedAnyRecord(userID string) bool {
 	}
 
 	return slices.ContainsFunc(m.Records, func(record Record) bool {
+		if record.hasMemberApproval(userID) {
+			return true
+		}
+
 		if record.State != StateApproved || record.User == nil {
 			return false
 		}
@@ -127,6 +168,10 @@ func (m *Metadata) hasApprovedAnyRecord(userID string) bool {
 
 func (m *Metadata) Approve(record *Record, index int, ctx core.ActionContext) error {
 	authenticatedUser := ctx.Auth.AuthenticatedUser()
+	if authenticatedUser != nil && record.hasMemberApproval(authenticatedUser.ID) {
+		return fmt.Errorf("user has already approved this requirement")
+	}
+
 	if authenticatedUser != nil && m.hasApprovedAnyRecord(authenticatedUser.ID) {
 		return fmt.Errorf("user has already approved another requirement")
 	}
@@ -136,14 +181,29 @@ func (m *Metadata) Approve(record *Record, index int, ctx core.ActionContext) er
 		return err
 	}
 
-	record.State = StateApproved
-	record.Approval = &ApprovalInfo{ApprovedAt: time.Now().Format(time.RFC3339)}
-	record.User = authenticatedUser
-	comment, ok := ctx.Parameters["comment"].(string)
-	if ok {
-		record.Approval.Comment = comment
+	now := time.Now().Format(time.RFC3339)
+	comment, _ := ctx.Parameters["comment"].(string)
+
+	//
+	// Group records collect approvals from distinct members,
+	// and stay pending until enough members approve.
+	//
+	if record.Type == ItemTypeGroup && authenticatedUser != nil {
+		record.Approvals = append(record.Approvals, MemberApproval{
+			User:       *authenticatedUser,
+			ApprovedAt: now,
+			Comment:    comment,
+		})
+
+		if len(record.Approvals) < record.requiredApprovals() {
+			m.Records[index] = *record
+			return nil
+		}
 	}
 
+	record.State = StateApproved
+	record.Approval = &ApprovalInfo{ApprovedAt: now, Comment: comment}
+	record.User = authenticatedUser
 	m.Records[index] = *record
 	return nil
 }
@@ -202,6 +262,23 @@ func (m *Metadata) validateAction(record *Record, ctx core.ActionContext) error
 		return nil
 
 	case ItemTypeGroup:
+		//
+		// Members resolved when the execution started take precedence,
+		// so group changes made afterwards do not affect the approval.
+		//
+		if len(record.Members) > 0 {
+			authenticatedUser := ctx.Auth.AuthenticatedUser()
+			isMember := authenticatedUser != nil && slices.ContainsFunc(record.Members, func(member core.User) bool {
+				return member.ID == authenticatedUser.ID
+			})
+
+			if !isMember {
+				return fmt.Errorf("item must be approved by %s", *record.Group)
+			}
+
+			return nil
+		}
+
 		inGroup, err := ctx.Auth.InGroup(*record.Group)
 		if err != nil {
 			return fmt.Errorf("error checking group %s: %v", *record.Group, err)
@@ -272,11 +349,23 @@ func approvalItemToRecord(ctx core.ExecutionContext, item Item, index int) (*Rec
 		}, nil
 
 	case ItemTypeGroup:
+		members, err := ctx.Auth.GetGroupUsers(item.Group)
+		if err != nil {
+			return nil, err
+		}
+
+		minApprovals := max(item.MinApprovals, 1)
+		if len(members) < minApprovals {
+			return nil, fmt.Errorf("group %s has %d members, but %d approvals are required", item.Group, len(members), minApprovals)
+		}
+
 		return &Record{
-			Type:  item.Type,
-			Index: index,
-			Group: &item.Group,
-			State: StatePending,
+			Type:         item.Type,
+			Index:        index,
+			Group:        &item.Group,
+			State:        StatePending,
+			Members:      members,
+			MinApprovals: minApprovals,
 		}, nil
 	}
 
@@ -315,19 +404,28 @@ func (a *Approval) Documentation() string {
 4. Once all approvals are collected, the workflow continues:
    - **Approved channel**: All required approvers approved
    - **Rejected channel**: At least one approver rejected
+   - **Expired channel**: The approval window closed before everyone responded
+
+## Approval Links
+
+Specific users and group members receive their own notification, with signed links to approve or reject without logging into SuperPlane.
+Each link can only be used by the user it was sent to, and stops working once a decision is recorded or the link expires.
+Links expire together with the approval window, or after 7 days if no expiration is configured.
 
 ## Configuration
 
 - **Approvers**: List of users, groups, or roles who must approve
   - **Any user**: Any authenticated user can approve
   - **Specific user**: Only the specified user can approve
-  - **Group**: Any member of the specified group can approve
+  - **Group**: Members of the specified group can approve. The group members are resolved when the execution starts, and **Minimum approvals** sets how many distinct members must approve
   - **Role**: Any user with the specified role can approve
+- **Expires after (seconds)**: Optional approval window. When it closes, the execution finishes on the expired channel
 
 ## Output Channels
 
 - **Approved**: Emitted when all required approvers have approved
 - **Rejected**: Emitted when at least one approver rejects (after all have responded)
+- **Expired**: Emitted when the approval window closes, only available if an expiration is configured
 
 ## Actions
 
@@ -344,13 +442,28 @@ func (a *Approval) Color() string {
 }
 
 func (a *Approval) OutputChannels(configuration any) []core.OutputChannel {
-	return []core.OutputChannel{
+	channels := []core.OutputChannel{
 		{Name: ChannelApproved, Label: "Approved", Description: "All required actors approved"},
 		{Name: ChannelRejected, Label: "Rejected", Description: "At least one actor rejected (after everyone responded)"},
 	}
+
+	config := Config{}
+	err := mapstructure.Decode(configuration, &config)
+	if err != nil || config.ExpiresAfterSeconds <= 0 {
+		return channels
+	}
+
+	return append(channels, core.OutputChannel{
+		Name:        ChannelExpired,
+		Label:       "Expired",
+		Description: "The approval window closed before everyone responded",
+	})
 }
 
 func (a *Approval) Configuration() []configuration.Field {
+	minApprovals := 1
+	minExpiration := 1
+
 	return []configuration.Field{
 		{
 			Name:        "items",
@@ -415,11 +528,41 @@ func (a *Approval) Configuration() []configuration.Field {
 									},
 								},
 							},
+							{
+								Name:        "minApprovals",
+								Label:       "Minimum approvals",
+								Type:        configuration.FieldTypeNumber,
+								Description: "Number of distinct group members who must approve",
+								Default:     1,
+								TypeOptions: &configuration.TypeOptions{
+									Number: &configuration.NumberTypeOptions{
+										Min: &minApprovals,
+									},
+								},
+								VisibilityConditions: []configuration.VisibilityCondition{
+									{
+										Field:  "type",
+										Values: []string{"group"},
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+		{
+			Name:        "expiresAfterSeconds",
+			Label:       "Expires after (seconds)",
+			Type:        configuration.FieldTypeNumber,
+			Description: "If set, the execution finishes on the expired channel when not everyone responded in time",
+			Required:    false,
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: &minExpiration,
+				},
+			},
+		},
 	}
 }
 
@@ -460,8 +603,22 @@ func (a *Approval) Execute(ctx core.ExecutionContext) error {
 		)
 	}
 
+	if config.ExpiresAfterSeconds > 0 {
+		expiresAfter := time.Duration(config.ExpiresAfterSeconds) * time.Second
+		metadata.ExpiresAt = time.Now().Add(expiresAfter).Format(time.RFC3339)
+		err = ctx.Metadata.Set(metadata)
+		if err != nil {
+			return fmt.Errorf("error setting metadata: %v", err)
+		}
+
+		err = ctx.Requests.ScheduleActionCall(ActionExpire, map[string]any{}, expiresAfter)
+		if err != nil {
+			return fmt.Errorf("error scheduling expiration: %v", err)
+		}
+	}
+
 	if ctx.Notifications != nil {
-		if err := a.notifyApprovers(ctx, metadata); err != nil {
+		if err := a.notifyApprovers(ctx, config, metadata); err != nil {
 			if ctx.Logger != nil {
 				ctx.Logger.Warnf("failed to send approval notification: %v", err)
 			}
@@ -515,6 +672,9 @@ func (a *Approval) Actions() []core.Action {
 				},
 			},
 		},
+		{
+			Name: ActionExpire,
+		},
 	}
 }
 
@@ -526,6 +686,8 @@ func (a *Approval) HandleAction(ctx core.ActionContext) error {
 		metadata, err = a.handleApprove(ctx)
 	case "reject":
 		metadata, err = a.handleReject(ctx)
+	case ActionExpire:
+		return a.handleExpire(ctx)
 	default:
 		return fmt.Errorf("unknown action: %s", ctx.Name)
 	}
@@ -697,6 +859,11 @@ func findPendingEligibleRecord(metadata Metadata, ctx core.ActionContext) *Recor
 			continue
 		}
 
+		authenticatedUser := ctx.Auth.AuthenticatedUser()
+		if authenticatedUser != nil && record.hasMemberApproval(authenticatedUser.ID) {
+			continue
+		}
+
 		record.Index = i
 		return &record
 	}
@@ -724,6 +891,34 @@ func (a *Approval) handleReject(ctx core.ActionContext) (*Metadata, error) {
 	return &metadata, nil
 }
 
+/*
+ * The expiration is scheduled when the execution starts,
+ * so it is ignored if the execution already finished.
+ */
+func (a *Approval) handleExpire(ctx core.ActionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	var metadata Metadata
+	err := mapstructure.Decode(ctx.Metadata.Get(), &metadata)
+	if err != nil {
+		return fmt.Errorf("failed to parse metadata: %w", err)
+	}
+
+	metadata.Result = StateExpired
+	err = ctx.Metadata.Set(metadata)
+	if err != nil {
+		return err
+	}
+
+	return ctx.ExecutionState.Emit(
+		ChannelExpired,
+		"approval.finished",
+		[]any{metadata},
+	)
+}
+
 func (a *Approval) Cancel(ctx core.ExecutionContext) error {
 	return nil
 }
@@ -732,7 +927,7 @@ func (a *Approval) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 	return http.StatusOK, nil
 }
 
-func (a *Approval) notifyApprovers(ctx core.ExecutionContext, metadata *Metadata) error {
+func (a *Approval) notifyApprovers(ctx core.ExecutionContext, config Config, metadata *Metadata) error {
 	url := ""
 	if ctx.BaseURL != "" && ctx.OrganizationID != "" && ctx.WorkflowID != "" && ctx.NodeID != "" {
 		url = fmt.Sprintf(
@@ -752,6 +947,25 @@ func (a *Approval) notifyApprovers(ctx core.ExecutionContext, metadata *Metadata
 	groupSet := map[string]struct{}{}
 	roleSet := map[string]struct{}{}
 
+	//
+	// Approvers we know by name receive their own notification,
+	// with signed links to approve or reject the item they are eligible for.
+	//
+	linkRecipients := []linkRecipient{}
+	linkRecipientSet := map[string]struct{}{}
+	addLinkRecipient := func(user core.User, index int) {
+		if user.ID == "" || user.Email == "" {
+			return
+		}
+
+		if _, ok := linkRecipientSet[user.ID]; ok {
+			return
+		}
+
+		linkRecipientSet[user.ID] = struct{}{}
+		linkRecipients = append(linkRecipients, linkRecipient{User: user, Index: index})
+	}
+
 	for _, record := range metadata.Records {
 		if record.State != StatePending {
 			continue
@@ -764,29 +978,89 @@ func (a *Approval) notifyApprovers(ctx core.ExecutionContext, metadata *Metadata
 			roleSet[models.RoleOrgOwner] = struct{}{}
 
 		case ItemTypeUser:
-			if record.User != nil && record.User.Email != "" {
-				emailSet[record.User.Email] = struct{}{}
+			if record.User == nil || record.User.Email == "" {
+				continue
 			}
 
+			if ctx.ApprovalLinks != nil {
+				addLinkRecipient(*record.User, record.Index)
+				continue
+			}
+
+			emailSet[record.User.Email] = struct{}{}
+
 		case ItemTypeRole:
 			if record.Role != nil && *record.Role != "" {
 				roleSet[*record.Role] = struct{}{}
 			}
 
 		case ItemTypeGroup:
+			if ctx.ApprovalLinks != nil && len(record.Members) > 0 {
+				for _, member := range record.Members {
+					addLinkRecipient(member, record.Index)
+				}
+
+				continue
+			}
+
 			if record.Group != nil && *record.Group != "" {
 				groupSet[*record.Group] = struct{}{}
 			}
 		}
 	}
 
+	linkExpiration := DefaultLinkExpiration
+	if config.ExpiresAfterSeconds > 0 {
+		linkExpiration = time.Duration(config.ExpiresAfterSeconds) * time.Second
+	}
+
+	for _, recipient := range linkRecipients {
+		err := a.notifyWithLinks(ctx, recipient, url, linkExpiration)
+		if err != nil {
+			return err
+		}
+	}
+
 	receivers.Emails = mapKeys(emailSet)
 	receivers.Groups = mapKeys(groupSet)
 	receivers.Roles = mapKeys(roleSet)
+	if len(receivers.Emails) == 0 && len(receivers.Groups) == 0 && len(receivers.Roles) == 0 {
+		return nil
+	}
 
 	return ctx.Notifications.Send(title, body, url, "Open approval", receivers)
 }
 
+type linkRecipient struct {
+	User  core.User
+	Index int
+}
+
+func (a *Approval) notifyWithLinks(ctx core.ExecutionContext, recipient linkRecipient, url string, expiresIn time.Duration) error {
+	approveURL, err := ctx.ApprovalLinks.Create(recipient.User, "approve", recipient.Index, expiresIn)
+	if err != nil {
+		return fmt.Errorf("error creating approve link for %s: %v", recipient.User.Email, err)
+	}
+
+	rejectURL, err := ctx.ApprovalLinks.Create(recipient.User, "reject", recipient.Index, expiresIn)
+	if err != nil {
+		return fmt.Errorf("error creating reject link for %s: %v", recipient.User.Email, err)
+	}
+
+	body := fmt.Sprintf("A canvas run item is waiting for your approval. Use the button below to approve it, or open this link to reject it: %s", rejectURL)
+	if url != "" {
+		body = fmt.Sprintf("%s\n\nYou can also review it in SuperPlane: %s", body, url)
+	}
+
+	return ctx.Notifications.Send(
+		"Approval required",
+		body,
+		approveURL,
+		"Approve",
+		core.NotificationReceivers{Emails: []string{recipient.User.Email}},
+	)
+}
+
 func mapKeys(input map[string]struct{}) []string {
 	result := make([]string, 0, len(input))
 	for key := range input {
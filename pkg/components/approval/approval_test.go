@@ -2,6 +2,7 @@ package approval
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -393,6 +394,215 @@ func TestApproval_HandleAction_ApproveFallsBackToPendingRequirement(t *testing.T
 	assert.Equal(t, user.ID, stored.Records[1].User.ID)
 }
 
+func TestApproval_HandleAction_GroupMinimumApprovals(t *testing.T) {
+	approval := &Approval{}
+
+	group := "sre"
+	alice := core.User{ID: "alice"}
+	bob := core.User{ID: "bob"}
+	metadataCtx := &contexts.MetadataContext{
+		Metadata: &Metadata{
+			Result: StatePending,
+			Records: []Record{
+				{
+					Index:        0,
+					State:        StatePending,
+					Type:         ItemTypeGroup,
+					Group:        &group,
+					Members:      []core.User{alice, bob, {ID: "carol"}},
+					MinApprovals: 2,
+				},
+			},
+		},
+	}
+
+	stateCtx := &contexts.ExecutionStateContext{}
+	approve := func(user core.User) error {
+		return approval.HandleAction(core.ActionContext{
+			Name:           "approve",
+			Parameters:     map[string]any{"index": float64(0)},
+			Metadata:       metadataCtx,
+			ExecutionState: stateCtx,
+			Auth:           &contexts.AuthContext{User: &user},
+		})
+	}
+
+	t.Run("first approval keeps the record pending", func(t *testing.T) {
+		require.NoError(t, approve(alice))
+
+		stored := metadataCtx.Metadata.(*Metadata)
+		assert.Equal(t, StatePending, stored.Records[0].State)
+		require.Len(t, stored.Records[0].Approvals, 1)
+		assert.Equal(t, alice, stored.Records[0].Approvals[0].User)
+		assert.False(t, stateCtx.Finished)
+	})
+
+	t.Run("re-approval by the same member does not count twice", func(t *testing.T) {
+		err := approve(alice)
+		require.ErrorContains(t, err, "already approved this requirement")
+
+		stored := metadataCtx.Metadata.(*Metadata)
+		assert.Len(t, stored.Records[0].Approvals, 1)
+		assert.False(t, stateCtx.Finished)
+	})
+
+	t.Run("users outside of the resolved members cannot approve", func(t *testing.T) {
+		err := approve(core.User{ID: "mallory"})
+		require.ErrorContains(t, err, "item must be approved by sre")
+	})
+
+	t.Run("second distinct approval approves the record", func(t *testing.T) {
+		require.NoError(t, approve(bob))
+
+		stored := metadataCtx.Metadata.(*Metadata)
+		assert.Equal(t, StateApproved, stored.Records[0].State)
+		assert.Equal(t, []string{"alice", "bob"}, []string{stored.Records[0].Approvals[0].User.ID, stored.Records[0].Approvals[1].User.ID})
+		assert.True(t, stateCtx.Finished)
+		assert.Equal(t, ChannelApproved, stateCtx.Channel)
+	})
+}
+
+func TestApproval_Expiration(t *testing.T) {
+	approval := &Approval{}
+	group := "sre"
+
+	t.Run("expired channel is only available when expiration is configured", func(t *testing.T) {
+		assert.Len(t, approval.OutputChannels(map[string]any{}), 2)
+
+		channels := approval.OutputChannels(map[string]any{"expiresAfterSeconds": float64(60)})
+		require.Len(t, channels, 3)
+		assert.Equal(t, ChannelExpired, channels[2].Name)
+	})
+
+	t.Run("execute schedules the expiration", func(t *testing.T) {
+		metadataCtx := &contexts.MetadataContext{}
+		requestCtx := &contexts.RequestContext{}
+
+		err := approval.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"items":               []any{map[string]any{"type": "anyone"}},
+				"expiresAfterSeconds": float64(86400),
+			},
+			Metadata:       metadataCtx,
+			ExecutionState: &contexts.ExecutionStateContext{},
+			Requests:       requestCtx,
+			Auth:           &contexts.AuthContext{},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, ActionExpire, requestCtx.Action)
+		assert.Equal(t, 24*time.Hour, requestCtx.Duration)
+		assert.NotEmpty(t, metadataCtx.Metadata.(*Metadata).ExpiresAt)
+	})
+
+	t.Run("execute fails if the group has fewer members than required approvals", func(t *testing.T) {
+		err := approval.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"items": []any{map[string]any{"type": "group", "group": group, "minApprovals": float64(2)}},
+			},
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: &contexts.ExecutionStateContext{},
+			Auth: &contexts.AuthContext{
+				GroupMembers: map[string][]core.User{group: {{ID: "alice"}}},
+			},
+		})
+
+		require.ErrorContains(t, err, "group sre has 1 members, but 2 approvals are required")
+	})
+
+	t.Run("pending execution finishes on the expired channel", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		metadataCtx := &contexts.MetadataContext{
+			Metadata: &Metadata{
+				Result:  StatePending,
+				Records: []Record{{Index: 0, State: StatePending, Type: ItemTypeAnyone}},
+			},
+		}
+
+		err := approval.HandleAction(core.ActionContext{
+			Name:           ActionExpire,
+			Metadata:       metadataCtx,
+			ExecutionState: stateCtx,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, stateCtx.Finished)
+		assert.Equal(t, ChannelExpired, stateCtx.Channel)
+		assert.Equal(t, StateExpired, metadataCtx.Metadata.(Metadata).Result)
+	})
+
+	t.Run("finished execution is not expired", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{Finished: true, Channel: ChannelApproved}
+		err := approval.HandleAction(core.ActionContext{
+			Name:           ActionExpire,
+			Metadata:       &contexts.MetadataContext{Metadata: &Metadata{Result: StateApproved}},
+			ExecutionState: stateCtx,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, ChannelApproved, stateCtx.Channel)
+	})
+}
+
+func TestApproval_Execute_NotifiesApproversWithLinks(t *testing.T) {
+	approval := &Approval{}
+
+	alice := core.User{ID: uuid.NewString(), Name: "Alice", Email: "alice@example.com"}
+	bob := core.User{ID: uuid.NewString(), Name: "Bob", Email: "bob@example.com"}
+	group := "sre"
+
+	notificationCtx := &contexts.NotificationContext{}
+	linkCtx := &contexts.ApprovalLinkContext{}
+
+	err := approval.Execute(core.ExecutionContext{
+		Configuration: map[string]any{
+			"items": []any{
+				map[string]any{"type": "user", "user": alice.ID},
+				map[string]any{"type": "group", "group": group},
+				map[string]any{"type": "role", "role": models.RoleOrgAdmin},
+			},
+			"expiresAfterSeconds": float64(3600),
+		},
+		Metadata:       &contexts.MetadataContext{},
+		ExecutionState: &contexts.ExecutionStateContext{},
+		Requests:       &contexts.RequestContext{},
+		Notifications:  notificationCtx,
+		ApprovalLinks:  linkCtx,
+		Auth: &contexts.AuthContext{
+			Users:        map[string]*core.User{alice.ID: &alice},
+			GroupMembers: map[string][]core.User{group: {alice, bob}},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, linkCtx.ExpiresIn)
+
+	//
+	// Alice is eligible for both the user and the group item,
+	// but only receives links for the first one.
+	//
+	require.Len(t, notificationCtx.Notifications, 3)
+
+	aliceNotification := notificationCtx.Notifications[0]
+	assert.Equal(t, []string{alice.Email}, aliceNotification.Receivers.Emails)
+	assert.Equal(t, "Approve", aliceNotification.URLLabel)
+	assert.Equal(t, "https://superplane.example.com/api/v1/approvals/"+alice.ID+"-approve-0", aliceNotification.URL)
+	assert.Contains(t, aliceNotification.Body, "https://superplane.example.com/api/v1/approvals/"+alice.ID+"-reject-0")
+
+	bobNotification := notificationCtx.Notifications[1]
+	assert.Equal(t, []string{bob.Email}, bobNotification.Receivers.Emails)
+	assert.Equal(t, "https://superplane.example.com/api/v1/approvals/"+bob.ID+"-approve-1", bobNotification.URL)
+
+	//
+	// Roles are not resolved to users, so they still get the regular notification.
+	//
+	roleNotification := notificationCtx.Notifications[2]
+	assert.Equal(t, []string{models.RoleOrgAdmin}, roleNotification.Receivers.Roles)
+	assert.Empty(t, roleNotification.Receivers.Emails)
+	assert.Empty(t, roleNotification.Receivers.Groups)
+	assert.Equal(t, "Open approval", roleNotification.URLLabel)
+}
+
 func TestMetadata_UpdateResult(t *testing.T) {
 	t.Run("all approved sets approved", func(t *testing.T) {
 		user1 := &core.User{ID: "user-1"}
@@ -605,7 +815,9 @@ func TestApproval_Execute(t *testing.T) {
 			},
 			Metadata:       metadataCtx,
 			ExecutionState: stateCtx,
-			Auth:           &contexts.AuthContext{},
+			Auth: &contexts.AuthContext{
+				GroupMembers: map[string][]core.User{group: {{ID: "member-1"}}},
+			},
 		}
 
 		err := approval.Execute(ctx)
@@ -620,6 +832,8 @@ func TestApproval_Execute(t *testing.T) {
 		assert.Equal(t, role, *stored.Records[0].Role)
 		assert.Equal(t, ItemTypeGroup, stored.Records[1].Type)
 		assert.Equal(t, group, *stored.Records[1].Group)
+		assert.Equal(t, []core.User{{ID: "member-1"}}, stored.Records[1].Members)
+		assert.Equal(t, 1, stored.Records[1].MinApprovals)
 	})
 }
 
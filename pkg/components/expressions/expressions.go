@@ -0,0 +1,171 @@
+package expressions
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+)
+
+/*
+ * Expressions are evaluated synchronously by the executor,
+ * so their cost is bounded: compilation rejects programs with more than MaxNodes nodes,
+ * and evaluation stops once the program allocates more than MemoryBudget.
+ * Since every loop iteration and every builtin allocation counts against the budget,
+ * both limits together bound how long an expression can run.
+ */
+const (
+	MaxNodes     = 1000
+	MemoryBudget = 1_000_000
+)
+
+/*
+ * Expressions evaluated by components use the same environment
+ * as the ones in node configurations: $ holds the run context data,
+ * root() returns the root event, and previous() the outputs of the previous nodes.
+ */
+
+/*
+ * Returns the environment to evaluate the expression with.
+ * The executor provides a builder with the full run context;
+ * without one, the environment only has the input of the node.
+ */
+func Env(builder func(expression string) (map[string]any, error), input any, sourceNodeID, expression string) (map[string]any, error) {
+	if builder != nil {
+		return builder(expression)
+	}
+
+	return BuildEnv(input, sourceNodeID), nil
+}
+
+/*
+ * Builds an environment where $ holds the input,
+ * keyed by the node that sent it, if there is one.
+ */
+func BuildEnv(input any, sourceNodeID string) map[string]any {
+	if sourceNodeID == "" {
+		return map[string]any{"$": input}
+	}
+
+	if inputMap, ok := input.(map[string]any); ok {
+		envData := make(map[string]any, len(inputMap)+1)
+		for key, value := range inputMap {
+			envData[key] = value
+		}
+		if _, exists := envData[sourceNodeID]; !exists {
+			envData[sourceNodeID] = input
+		}
+		return map[string]any{"$": envData}
+	}
+
+	if inputMap, ok := input.(map[string]string); ok {
+		envData := make(map[string]any, len(inputMap)+1)
+		for key, value := range inputMap {
+			envData[key] = value
+		}
+		if _, exists := envData[sourceNodeID]; !exists {
+			envData[sourceNodeID] = input
+		}
+		return map[string]any{"$": envData}
+	}
+
+	return map[string]any{"$": map[string]any{sourceNodeID: input}}
+}
+
+/*
+ * The options to compile expressions with.
+ * Additional options, like expr.AsBool(), are appended to them.
+ */
+func Options(env map[string]any, additional ...expr.Option) []expr.Option {
+	options := []expr.Option{
+		expr.Env(env),
+		expr.MaxNodes(MaxNodes),
+		expr.WithContext("ctx"),
+		expr.Timezone(time.UTC.String()),
+		expr.Function("root", func(params ...any) (any, error) {
+			if len(params) != 0 {
+				return nil, fmt.Errorf("root() takes no arguments")
+			}
+
+			rootPayload, ok := env["__root"]
+			if !ok {
+				return nil, fmt.Errorf("no root event found")
+			}
+			return rootPayload, nil
+		}),
+		expr.Function("previous", func(params ...any) (any, error) {
+			depth := 1
+			if len(params) > 1 {
+				return nil, fmt.Errorf("previous() accepts zero or one argument")
+			}
+			if len(params) == 1 {
+				parsedDepth, err := parseDepthValue(params[0])
+				if err != nil {
+					return nil, err
+				}
+				depth = parsedDepth
+			}
+
+			previousByDepth, ok := env["__previousByDepth"]
+			if !ok {
+				return nil, nil
+			}
+			if values, ok := previousByDepth.(map[string]any); ok {
+				return values[strconv.Itoa(depth)], nil
+			}
+			if values, ok := previousByDepth.(map[int]any); ok {
+				return values[depth], nil
+			}
+
+			return nil, nil
+		}),
+	}
+
+	return append(options, additional...)
+}
+
+/*
+ * Expressions are compiled on setup with an empty environment,
+ * so syntax errors are reported before any event reaches the node.
+ */
+func Validate(expression string, additional ...expr.Option) error {
+	_, err := expr.Compile(expression, Options(map[string]any{"$": map[string]any{}}, additional...)...)
+	return err
+}
+
+/*
+ * Runs a program compiled with Options, within the memory budget.
+ * The program runs in the calling goroutine, so nothing is left running when it returns.
+ */
+func Run(program *vm.Program, env map[string]any) (any, error) {
+	machine := vm.VM{MemoryBudget: MemoryBudget}
+	return machine.Run(program, env)
+}
+
+func parseDepthValue(param any) (int, error) {
+	switch value := param.(type) {
+	case int:
+		if value < 1 {
+			return 0, fmt.Errorf("depth must be >= 1")
+		}
+		return value, nil
+	case int64:
+		if value < 1 {
+			return 0, fmt.Errorf("depth must be >= 1")
+		}
+		return int(value), nil
+	case float64:
+		parsed := int(value)
+		if value != float64(parsed) {
+			return 0, fmt.Errorf("depth must be an integer")
+		}
+		if parsed < 1 {
+			return 0, fmt.Errorf("depth must be >= 1")
+		}
+		return parsed, nil
+	default:
+		return 0, fmt.Errorf("depth must be an integer")
+	}
+}
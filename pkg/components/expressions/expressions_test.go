@@ -0,0 +1,108 @@
+package expressions
+
+import (
+	"testing"
+
+	"github.com/expr-lang/expr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnv_UsesBuilder(t *testing.T) {
+	builder := func(expression string) (map[string]any, error) {
+		return map[string]any{
+			"$": map[string]any{
+				"other-node": map[string]any{
+					"result": "ok",
+				},
+			},
+		}, nil
+	}
+
+	env, err := Env(builder, map[string]any{"result": "ignored"}, "source-node", `$["other-node"].result == "ok"`)
+	require.NoError(t, err)
+
+	vm, err := expr.Compile(`$["other-node"].result == "ok"`, Options(env, expr.AsBool())...)
+	require.NoError(t, err)
+
+	out, err := expr.Run(vm, env)
+	require.NoError(t, err)
+	assert.True(t, out.(bool))
+}
+
+func TestEnv_WithoutBuilder(t *testing.T) {
+	t.Run("input is keyed by source node", func(t *testing.T) {
+		env, err := Env(nil, map[string]any{"result": "ok"}, "source-node", "")
+		require.NoError(t, err)
+
+		data := env["$"].(map[string]any)
+		assert.Equal(t, "ok", data["result"])
+		assert.Equal(t, map[string]any{"result": "ok"}, data["source-node"])
+	})
+
+	t.Run("no source node", func(t *testing.T) {
+		env, err := Env(nil, "value", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, "value", env["$"])
+	})
+}
+
+func TestOptions_RootAndPrevious(t *testing.T) {
+	env := map[string]any{
+		"$": map[string]any{},
+		"__root": map[string]any{
+			"data": map[string]any{
+				"ref": "main",
+			},
+		},
+		"__previousByDepth": map[string]any{
+			"1": map[string]any{
+				"data": map[string]any{
+					"ok": true,
+				},
+			},
+		},
+	}
+
+	vm, err := expr.Compile(`root().data.ref == "main" && previous().data.ok == true`, Options(env)...)
+	require.NoError(t, err)
+
+	out, err := expr.Run(vm, env)
+	require.NoError(t, err)
+	assert.True(t, out.(bool))
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(`$.status == "ok"`, expr.AsBool()))
+	require.Error(t, Validate(`$.status ==`))
+}
+
+func TestRun_Limits(t *testing.T) {
+	env := map[string]any{"$": map[string]any{}}
+
+	t.Run("within the budget -> result", func(t *testing.T) {
+		program, err := expr.Compile(`sum(map(1..100, # * 2))`, Options(env)...)
+		require.NoError(t, err)
+
+		out, err := Run(program, env)
+		require.NoError(t, err)
+		assert.Equal(t, 10100, out)
+	})
+
+	t.Run("over the memory budget -> error", func(t *testing.T) {
+		program, err := expr.Compile(`len(map(1..2000, map(1..2000, #)))`, Options(env)...)
+		require.NoError(t, err)
+
+		_, err = Run(program, env)
+		require.ErrorContains(t, err, "memory budget exceeded")
+	})
+
+	t.Run("over the node limit -> compilation error", func(t *testing.T) {
+		expression := "1"
+		for i := 0; i < MaxNodes; i++ {
+			expression += " + 1"
+		}
+
+		require.Error(t, Validate(expression))
+	})
+}
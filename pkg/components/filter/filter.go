@@ -3,18 +3,24 @@ package filter
 import (
 	"fmt"
 	"net/http"
-	"strconv"
-	"time"
+	"strings"
 
 	"github.com/expr-lang/expr"
 	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/components/expressions"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/registry"
 )
 
 const ComponentName = "filter"
+const PayloadType = "filter.executed"
+
+const (
+	ChannelNameMatched   = "matched"
+	ChannelNameUnmatched = "unmatched"
+)
 
 func init() {
 	registry.RegisterComponent(ComponentName, &Filter{})
@@ -39,7 +45,7 @@ func (f *Filter) Description() string {
 }
 
 func (f *Filter) Documentation() string {
-	return `The Filter component evaluates a boolean expression against incoming events and only forwards events that match the condition.
+	return `The Filter component evaluates a boolean expression against incoming events and forwards them based on whether they match the condition.
 
 ## Use Cases
 
@@ -51,8 +57,17 @@ func (f *Filter) Documentation() string {
 ## How It Works
 
 1. The Filter component evaluates a boolean expression against the incoming event data
-2. If the expression evaluates to ` + "`true`" + `, the event is emitted to the default output channel
-3. If the expression evaluates to ` + "`false`" + `, the execution passes without emitting (effectively filtering out the event)
+2. If the expression evaluates to ` + "`true`" + `, the event is emitted to the "Matched" output channel
+3. If the expression evaluates to ` + "`false`" + `, the event is emitted to the "Unmatched" output channel
+
+The incoming event is passed through unchanged on both channels.
+A ` + "`false`" + ` result never fails the execution. Only expressions that cannot be evaluated do, including expressions that go over the evaluation limits.
+Fields missing from the event evaluate to ` + "`nil`" + `, so comparing them does not fail the execution.
+
+## Output Channels
+
+- **Matched**: Events where the expression evaluates to ` + "`true`" + `
+- **Unmatched**: Events where the expression evaluates to ` + "`false`" + `. Leave it unconnected to drop those events
 
 ## Expression Environment
 
@@ -61,6 +76,8 @@ The expression has access to:
 - **root()**: Access to the root event data
 - **previous()**: Access to previous node outputs (optionally with depth parameter)
 
+Expressions are limited to 1000 nodes, and their evaluation to a budget of 1,000,000 allocated items, which also bounds loops over large arrays.
+
 ## Examples
 
 - ` + "`$[\"Node Name\"].status == \"active\"`" + `: Only forward events where status is "active"
@@ -69,7 +86,10 @@ The expression has access to:
 }
 
 func (f *Filter) OutputChannels(configuration any) []core.OutputChannel {
-	return []core.OutputChannel{core.DefaultOutputChannel}
+	return []core.OutputChannel{
+		{Name: ChannelNameMatched, Label: "Matched", Description: "Events matching the expression"},
+		{Name: ChannelNameUnmatched, Label: "Unmatched", Description: "Events not matching the expression"},
+	}
 }
 
 func (f *Filter) Icon() string {
@@ -109,146 +129,32 @@ func (f *Filter) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("error setting metadata: %w", err)
 	}
 
-	env, err := expressionEnv(ctx, spec.Expression)
+	env, err := expressions.Env(ctx.ExpressionEnv, ctx.Data, ctx.SourceNodeID, spec.Expression)
 	if err != nil {
 		return err
 	}
 
-	vm, err := expr.Compile(spec.Expression, expressionOptions(env)...)
-
+	program, err := expr.Compile(spec.Expression, expressions.Options(env, expr.AsBool())...)
 	if err != nil {
-		return fmt.Errorf("expression compilation failed: %w", err)
+		return fmt.Errorf("expression compilation failed for %q: %w", spec.Expression, err)
 	}
 
-	output, err := expr.Run(vm, env)
+	output, err := expressions.Run(program, env)
 	if err != nil {
-		return fmt.Errorf("expression evaluation failed: %w", err)
+		return fmt.Errorf("expression evaluation failed for %q: %w", spec.Expression, err)
 	}
 
 	matches, ok := output.(bool)
 	if !ok {
-		return fmt.Errorf("expression must evaluate to boolean, got %T", output)
+		return fmt.Errorf("expression %q must evaluate to boolean, got %T", spec.Expression, output)
 	}
 
+	channel := ChannelNameUnmatched
 	if matches {
-		return ctx.ExecutionState.Emit(
-			core.DefaultOutputChannel.Name,
-			"filter.executed",
-			[]any{map[string]any{}},
-		)
+		channel = ChannelNameMatched
 	}
 
-	return ctx.ExecutionState.Pass()
-}
-
-func expressionEnv(ctx core.ExecutionContext, expression string) (map[string]any, error) {
-	if ctx.ExpressionEnv != nil {
-		return ctx.ExpressionEnv(expression)
-	}
-
-	return buildExpressionEnv(ctx.Data, ctx.SourceNodeID), nil
-}
-
-func buildExpressionEnv(input any, sourceNodeID string) map[string]any {
-	if sourceNodeID == "" {
-		return map[string]any{"$": input}
-	}
-
-	if inputMap, ok := input.(map[string]any); ok {
-		envData := make(map[string]any, len(inputMap)+1)
-		for key, value := range inputMap {
-			envData[key] = value
-		}
-		if _, exists := envData[sourceNodeID]; !exists {
-			envData[sourceNodeID] = input
-		}
-		return map[string]any{"$": envData}
-	}
-
-	if inputMap, ok := input.(map[string]string); ok {
-		envData := make(map[string]any, len(inputMap)+1)
-		for key, value := range inputMap {
-			envData[key] = value
-		}
-		if _, exists := envData[sourceNodeID]; !exists {
-			envData[sourceNodeID] = input
-		}
-		return map[string]any{"$": envData}
-	}
-
-	return map[string]any{"$": map[string]any{sourceNodeID: input}}
-}
-
-func expressionOptions(env map[string]any) []expr.Option {
-	return []expr.Option{
-		expr.Env(env),
-		expr.AsBool(),
-		expr.WithContext("ctx"),
-		expr.Timezone(time.UTC.String()),
-		expr.Function("root", func(params ...any) (any, error) {
-			if len(params) != 0 {
-				return nil, fmt.Errorf("root() takes no arguments")
-			}
-
-			rootPayload, ok := env["__root"]
-			if !ok {
-				return nil, fmt.Errorf("no root event found")
-			}
-			return rootPayload, nil
-		}),
-		expr.Function("previous", func(params ...any) (any, error) {
-			depth := 1
-			if len(params) > 1 {
-				return nil, fmt.Errorf("previous() accepts zero or one argument")
-			}
-			if len(params) == 1 {
-				parsedDepth, err := parseDepthValue(params[0])
-				if err != nil {
-					return nil, err
-				}
-				depth = parsedDepth
-			}
-
-			previousByDepth, ok := env["__previousByDepth"]
-			if !ok {
-				return nil, nil
-			}
-			if values, ok := previousByDepth.(map[string]any); ok {
-				return values[strconv.Itoa(depth)], nil
-			}
-			if values, ok := previousByDepth.(map[int]any); ok {
-				return values[depth], nil
-			}
-
-			return nil, nil
-		}),
-	}
-}
-
-func parseDepthValue(param any) (int, error) {
-	switch value := param.(type) {
-	case int:
-		if value < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return value, nil
-	case int64:
-		if value < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return int(value), nil
-	case float64:
-		parsed := int(value)
-		if value != float64(parsed) {
-			return 0, fmt.Errorf("depth must be an integer")
-		}
-		if parsed < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return parsed, nil
-	default:
-		return 0, fmt.Errorf("depth must be an integer")
-	}
+	return ctx.ExecutionState.Emit(channel, PayloadType, []any{ctx.Data})
 }
 
 func (f *Filter) Actions() []core.Action {
@@ -259,7 +165,26 @@ func (f *Filter) HandleAction(ctx core.ActionContext) error {
 	return fmt.Errorf("filter does not support actions")
 }
 
+/*
+ * Expressions are compiled on setup,
+ * so syntax errors are reported before any event reaches the node.
+ */
 func (f *Filter) Setup(ctx core.SetupContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(spec.Expression) == "" {
+		return fmt.Errorf("expression is required")
+	}
+
+	err = expressions.Validate(spec.Expression, expr.AsBool())
+	if err != nil {
+		return fmt.Errorf("invalid expression: %w", err)
+	}
+
 	return nil
 }
 
@@ -4,46 +4,42 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/test/support/contexts"
 )
 
-func TestFilter_Execute_EmitsEmptyEvents(t *testing.T) {
+func TestFilter_Execute_PassesEventsThrough(t *testing.T) {
 	tests := []struct {
-		name                 string
-		configuration        map[string]any
-		inputData            any
-		expectedOutputsCount int
-		expectedChannel      string
+		name            string
+		configuration   map[string]any
+		inputData       any
+		expectedChannel string
 	}{
 		{
-			name:                 "filter with true condition emits empty event",
-			configuration:        map[string]any{"expression": "true"},
-			inputData:            map[string]any{"test": "value"},
-			expectedOutputsCount: 1,
-			expectedChannel:      "default",
+			name:            "filter with true condition emits to matched channel",
+			configuration:   map[string]any{"expression": "true"},
+			inputData:       map[string]any{"test": "value"},
+			expectedChannel: ChannelNameMatched,
 		},
 		{
-			name:                 "filter with false condition emits empty event",
-			configuration:        map[string]any{"expression": "false"},
-			inputData:            map[string]any{"test": "value"},
-			expectedOutputsCount: 0,
-			expectedChannel:      "",
+			name:            "filter with false condition emits to unmatched channel",
+			configuration:   map[string]any{"expression": "false"},
+			inputData:       map[string]any{"test": "value"},
+			expectedChannel: ChannelNameUnmatched,
 		},
 		{
-			name:                 "filter with complex true condition emits empty event",
-			configuration:        map[string]any{"expression": "$.test == 'value'"},
-			inputData:            map[string]any{"test": "value"},
-			expectedOutputsCount: 1,
-			expectedChannel:      "default",
+			name:            "filter with complex true condition emits to matched channel",
+			configuration:   map[string]any{"expression": "$.test == 'value'"},
+			inputData:       map[string]any{"test": "value"},
+			expectedChannel: ChannelNameMatched,
 		},
 		{
-			name:                 "filter with complex false condition emits empty event",
-			configuration:        map[string]any{"expression": "$.test == 'different'"},
-			inputData:            map[string]any{"test": "value"},
-			expectedOutputsCount: 0,
-			expectedChannel:      "",
+			name:            "filter with complex false condition emits to unmatched channel",
+			configuration:   map[string]any{"expression": "$.test == 'different'"},
+			inputData:       map[string]any{"test": "value"},
+			expectedChannel: ChannelNameUnmatched,
 		},
 	}
 
@@ -74,25 +70,17 @@ func TestFilter_Execute_EmitsEmptyEvents(t *testing.T) {
 			assert.True(t, ok)
 			assert.Equal(t, tt.configuration["expression"], metadata["expression"])
 
-			if tt.expectedOutputsCount > 0 {
-				assert.Equal(t, tt.expectedChannel, stateCtx.Channel)
-				assert.Equal(t, "filter.executed", stateCtx.Type)
-				assert.Len(t, stateCtx.Payloads, 1)
-
-				// Verify payload structure follows SuperPlane conventions
-				payload, ok := stateCtx.Payloads[0].(map[string]any)
-				assert.True(t, ok, "payload should be a map")
-				assert.Equal(t, "filter.executed", payload["type"])
-				assert.NotEmpty(t, payload["timestamp"])
-				assert.Contains(t, payload, "data")
-				data, ok := payload["data"].(map[string]any)
-				assert.True(t, ok, "data should be a map")
-				assert.Empty(t, data, "data should be empty")
-			} else {
-				assert.Empty(t, stateCtx.Channel)
-				assert.Empty(t, stateCtx.Type)
-				assert.Empty(t, stateCtx.Payloads)
-			}
+			assert.Equal(t, tt.expectedChannel, stateCtx.Channel)
+			assert.Equal(t, "filter.executed", stateCtx.Type)
+			assert.Len(t, stateCtx.Payloads, 1)
+
+			// Verify payload structure follows SuperPlane conventions
+			payload, ok := stateCtx.Payloads[0].(map[string]any)
+			assert.True(t, ok, "payload should be a map")
+			assert.Equal(t, "filter.executed", payload["type"])
+			assert.NotEmpty(t, payload["timestamp"])
+			assert.Contains(t, payload, "data")
+			assert.Equal(t, tt.inputData, payload["data"])
 		})
 	}
 }
@@ -112,7 +100,7 @@ func TestFilter_Execute_InvalidExpression_ShouldReturnError(t *testing.T) {
 
 	err := filter.Execute(ctx)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "expression compilation failed")
+	assert.Contains(t, err.Error(), `expression compilation failed for "invalid expression syntax +++"`)
 }
 
 func TestFilter_Execute_NonBooleanResult_ShouldReturnError(t *testing.T) {
@@ -166,7 +154,7 @@ func TestFilter_Execute_NodeReferenceExpression(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, stateCtx.Passed)
 	assert.True(t, stateCtx.Finished)
-	assert.Equal(t, core.DefaultOutputChannel.Name, stateCtx.Channel)
+	assert.Equal(t, ChannelNameMatched, stateCtx.Channel)
 }
 
 func TestFilter_Execute_RootAndPreviousExpressions(t *testing.T) {
@@ -204,5 +192,72 @@ func TestFilter_Execute_RootAndPreviousExpressions(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, stateCtx.Passed)
 	assert.True(t, stateCtx.Finished)
-	assert.Equal(t, core.DefaultOutputChannel.Name, stateCtx.Channel)
+	assert.Equal(t, ChannelNameMatched, stateCtx.Channel)
+}
+
+func TestFilter_Execute_MissingField_RoutesToUnmatched(t *testing.T) {
+	filter := &Filter{}
+	input := map[string]any{"test": "value"}
+
+	for _, expression := range []string{"$.missing == 'value'", "$.missing?.nested == 'value'"} {
+		t.Run(expression, func(t *testing.T) {
+			stateCtx := &contexts.ExecutionStateContext{}
+
+			err := filter.Execute(core.ExecutionContext{
+				Data:           input,
+				Configuration:  map[string]any{"expression": expression},
+				ExecutionState: stateCtx,
+				Metadata:       &contexts.MetadataContext{},
+			})
+
+			assert.NoError(t, err)
+			assert.True(t, stateCtx.Passed)
+			assert.Equal(t, ChannelNameUnmatched, stateCtx.Channel)
+			require.Len(t, stateCtx.Payloads, 1)
+			assert.Equal(t, input, stateCtx.Payloads[0].(map[string]any)["data"])
+		})
+	}
+}
+
+func TestFilter_OutputChannels(t *testing.T) {
+	channels := (&Filter{}).OutputChannels(nil)
+	assert.True(t, core.HasOutputChannel(channels, ChannelNameMatched))
+	assert.True(t, core.HasOutputChannel(channels, ChannelNameUnmatched))
+	assert.Len(t, channels, 2)
+}
+
+func TestFilter_Execute_EvaluationError_IncludesExpression(t *testing.T) {
+	filter := &Filter{}
+
+	err := filter.Execute(core.ExecutionContext{
+		Data:           map[string]any{"test": "value"},
+		Configuration:  map[string]any{"expression": "root().data.ref == 'main'"},
+		ExecutionState: &contexts.ExecutionStateContext{},
+		Metadata:       &contexts.MetadataContext{},
+	})
+
+	assert.ErrorContains(t, err, `expression evaluation failed for "root().data.ref == 'main'"`)
+	assert.ErrorContains(t, err, "no root event found")
+}
+
+func TestFilter_Setup(t *testing.T) {
+	filter := &Filter{}
+
+	t.Run("valid expression", func(t *testing.T) {
+		err := filter.Setup(core.SetupContext{
+			Configuration: map[string]any{"expression": `$["Node"].amount > 1000 || previous().amount > 1000`},
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("empty expression -> error", func(t *testing.T) {
+		err := filter.Setup(core.SetupContext{Configuration: map[string]any{}})
+		assert.ErrorContains(t, err, "expression is required")
+	})
+
+	t.Run("invalid expression -> error", func(t *testing.T) {
+		err := filter.Setup(core.SetupContext{Configuration: map[string]any{"expression": "invalid expression syntax +++"}})
+		assert.ErrorContains(t, err, "invalid expression")
+	})
 }
@@ -3,12 +3,12 @@ package ifp
 import (
 	"fmt"
 	"net/http"
-	"strconv"
-	"time"
+	"strings"
 
 	"github.com/expr-lang/expr"
 	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/components/expressions"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/registry"
@@ -118,18 +118,18 @@ func (f *If) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("error setting metadata: %w", err)
 	}
 
-	env, err := expressionEnv(ctx, spec.Expression)
+	env, err := expressions.Env(ctx.ExpressionEnv, ctx.Data, ctx.SourceNodeID, spec.Expression)
 	if err != nil {
 		return err
 	}
 
-	vm, err := expr.Compile(spec.Expression, expressionOptions(env)...)
+	vm, err := expr.Compile(spec.Expression, expressions.Options(env, expr.AsBool())...)
 
 	if err != nil {
 		return err
 	}
 
-	output, err := expr.Run(vm, env)
+	output, err := expressions.Run(vm, env)
 	if err != nil {
 		return fmt.Errorf("expression evaluation failed: %w", err)
 	}
@@ -154,116 +154,6 @@ func (f *If) Execute(ctx core.ExecutionContext) error {
 	)
 }
 
-func expressionEnv(ctx core.ExecutionContext, expression string) (map[string]any, error) {
-	if ctx.ExpressionEnv != nil {
-		return ctx.ExpressionEnv(expression)
-	}
-
-	return buildExpressionEnv(ctx.Data, ctx.SourceNodeID), nil
-}
-
-func buildExpressionEnv(input any, sourceNodeID string) map[string]any {
-	if sourceNodeID == "" {
-		return map[string]any{"$": input}
-	}
-
-	if inputMap, ok := input.(map[string]any); ok {
-		envData := make(map[string]any, len(inputMap)+1)
-		for key, value := range inputMap {
-			envData[key] = value
-		}
-		if _, exists := envData[sourceNodeID]; !exists {
-			envData[sourceNodeID] = input
-		}
-		return map[string]any{"$": envData}
-	}
-
-	if inputMap, ok := input.(map[string]string); ok {
-		envData := make(map[string]any, len(inputMap)+1)
-		for key, value := range inputMap {
-			envData[key] = value
-		}
-		if _, exists := envData[sourceNodeID]; !exists {
-			envData[sourceNodeID] = input
-		}
-		return map[string]any{"$": envData}
-	}
-
-	return map[string]any{"$": map[string]any{sourceNodeID: input}}
-}
-
-func expressionOptions(env map[string]any) []expr.Option {
-	return []expr.Option{
-		expr.Env(env),
-		expr.AsBool(),
-		expr.WithContext("ctx"),
-		expr.Timezone(time.UTC.String()),
-		expr.Function("root", func(params ...any) (any, error) {
-			if len(params) != 0 {
-				return nil, fmt.Errorf("root() takes no arguments")
-			}
-
-			rootPayload, ok := env["__root"]
-			if !ok {
-				return nil, fmt.Errorf("no root event found")
-			}
-			return rootPayload, nil
-		}),
-		expr.Function("previous", func(params ...any) (any, error) {
-			depth := 1
-			if len(params) > 1 {
-				return nil, fmt.Errorf("previous() accepts zero or one argument")
-			}
-			if len(params) == 1 {
-				parsedDepth, err := parseDepthValue(params[0])
-				if err != nil {
-					return nil, err
-				}
-				depth = parsedDepth
-			}
-
-			previousByDepth, ok := env["__previousByDepth"]
-			if !ok {
-				return nil, nil
-			}
-			if values, ok := previousByDepth.(map[string]any); ok {
-				return values[strconv.Itoa(depth)], nil
-			}
-			if values, ok := previousByDepth.(map[int]any); ok {
-				return values[depth], nil
-			}
-
-			return nil, nil
-		}),
-	}
-}
-
-func parseDepthValue(param any) (int, error) {
-	switch value := param.(type) {
-	case int:
-		if value < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return value, nil
-	case int64:
-		if value < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return int(value), nil
-	case float64:
-		parsed := int(value)
-		if value != float64(parsed) {
-			return 0, fmt.Errorf("depth must be an integer")
-		}
-		if parsed < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return parsed, nil
-	default:
-		return 0, fmt.Errorf("depth must be an integer")
-	}
-}
-
 func (f *If) Actions() []core.Action {
 	return []core.Action{}
 }
@@ -272,7 +162,26 @@ func (f *If) HandleAction(ctx core.ActionContext) error {
 	return fmt.Errorf("if does not support actions")
 }
 
+/*
+ * Expressions are compiled on setup,
+ * so syntax errors are reported before any event reaches the node.
+ */
 func (f *If) Setup(ctx core.SetupContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(spec.Expression) == "" {
+		return fmt.Errorf("expression is required")
+	}
+
+	err = expressions.Validate(spec.Expression, expr.AsBool())
+	if err != nil {
+		return fmt.Errorf("invalid expression: %w", err)
+	}
+
 	return nil
 }
 
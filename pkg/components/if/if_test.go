@@ -255,3 +255,41 @@ func TestIf_Execute_RootAndPreviousExpressions(t *testing.T) {
 	assert.True(t, stateCtx.Finished)
 	assert.Equal(t, ChannelNameTrue, stateCtx.Channel)
 }
+
+func TestIf_Execute_MissingField_RoutesToFalse(t *testing.T) {
+	ifComponent := &If{}
+	stateCtx := &contexts.ExecutionStateContext{}
+
+	err := ifComponent.Execute(core.ExecutionContext{
+		Data:           map[string]any{"test": "value"},
+		Configuration:  map[string]any{"expression": "$.missing == 'value'"},
+		ExecutionState: stateCtx,
+		Metadata:       &contexts.MetadataContext{},
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, stateCtx.Passed)
+	assert.Equal(t, ChannelNameFalse, stateCtx.Channel)
+}
+
+func TestIf_Setup(t *testing.T) {
+	ifComponent := &If{}
+
+	t.Run("valid expression", func(t *testing.T) {
+		err := ifComponent.Setup(core.SetupContext{
+			Configuration: map[string]any{"expression": `$["Node"].status == "active" && root().ref != ""`},
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("empty expression -> error", func(t *testing.T) {
+		err := ifComponent.Setup(core.SetupContext{Configuration: map[string]any{"expression": " "}})
+		assert.ErrorContains(t, err, "expression is required")
+	})
+
+	t.Run("invalid expression -> error", func(t *testing.T) {
+		err := ifComponent.Setup(core.SetupContext{Configuration: map[string]any{"expression": "$.status == "}})
+		assert.ErrorContains(t, err, "invalid expression")
+	})
+}
@@ -0,0 +1,413 @@
+package iterate
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/components/expressions"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const ComponentName = "iterate"
+const PayloadType = "iterate.element"
+const CompletedPayloadType = "iterate.completed"
+const ChannelNameCompleted = "completed"
+
+const DefaultConcurrency = 10
+const DefaultMaxItems = 100
+const MaxItemsLimit = 1000
+
+/*
+ * Elements waiting to be released, and the completion of the ones
+ * already released, are checked periodically through an action call.
+ */
+const ProgressCheckInterval = 5 * time.Second
+
+func init() {
+	registry.RegisterComponent(ComponentName, &Iterate{})
+}
+
+type Iterate struct{}
+
+type Spec struct {
+	ItemsPath         string `json:"itemsPath" mapstructure:"itemsPath"`
+	Concurrency       int    `json:"concurrency" mapstructure:"concurrency"`
+	WaitForCompletion bool   `json:"waitForCompletion" mapstructure:"waitForCompletion"`
+	MaxItems          int    `json:"maxItems" mapstructure:"maxItems"`
+}
+
+/*
+ * The elements are kept in the metadata until all of them are released.
+ * InFlight holds the IDs of the released events whose downstream
+ * executions are not finished yet.
+ */
+type ExecutionMetadata struct {
+	ItemsPath string   `json:"itemsPath" mapstructure:"itemsPath"`
+	Items     []any    `json:"items" mapstructure:"items"`
+	Total     int      `json:"total" mapstructure:"total"`
+	Released  int      `json:"released" mapstructure:"released"`
+	InFlight  []string `json:"inFlight" mapstructure:"inFlight"`
+}
+
+func (i *Iterate) Name() string {
+	return ComponentName
+}
+
+func (i *Iterate) Label() string {
+	return "Iterate"
+}
+
+func (i *Iterate) Description() string {
+	return "Run the downstream steps once per element of an array"
+}
+
+func (i *Iterate) Documentation() string {
+	return `The Iterate component selects an array from the incoming event and runs the downstream steps once per element, optionally limiting how many elements are processed at the same time.
+
+## Use Cases
+
+- **Rolling operations**: Restart services or deploy to regions a few at a time
+- **Batch processing**: Process each item of a list, and continue once all of them are done
+
+## How It Works
+
+1. The items path expression is evaluated against the incoming event data, and must evaluate to an array
+2. One event is emitted on the default output channel per element, with the element value, its index and the total number of elements
+3. At most **Concurrency** elements are processed at the same time. The next element is released once everything downstream of a previous one has finished
+4. If **Wait for completion** is enabled, an event is emitted on the "Completed" output channel once every element has been processed downstream
+
+## Limits
+
+The number of elements is capped by the **Max items** setting.
+If the array has more elements than allowed, the execution fails and nothing is emitted.
+
+## Output Channels
+
+- **Default**: One event per element
+- **Completed**: Every element was processed downstream
+
+## Examples
+
+- ` + "`$[\"Node Name\"].tasks`" + `: Run once per task
+- ` + "`$[\"Node Name\"].data.regions`" + `: Run once per region`
+}
+
+func (i *Iterate) Icon() string {
+	return "repeat"
+}
+
+func (i *Iterate) Color() string {
+	return "blue"
+}
+
+func (i *Iterate) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		core.DefaultOutputChannel,
+		{Name: ChannelNameCompleted, Label: "Completed", Description: "Every element was processed downstream"},
+	}
+}
+
+func (i *Iterate) Configuration() []configuration.Field {
+	min := 1
+	max := MaxItemsLimit
+
+	return []configuration.Field{
+		{
+			Name:        "itemsPath",
+			Label:       "Items Path",
+			Type:        configuration.FieldTypeExpression,
+			Description: "Expression selecting the array to iterate over",
+			Required:    true,
+		},
+		{
+			Name:        "concurrency",
+			Label:       "Concurrency",
+			Type:        configuration.FieldTypeNumber,
+			Description: "Maximum number of elements processed at the same time",
+			Default:     DefaultConcurrency,
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: &min,
+					Max: &max,
+				},
+			},
+		},
+		{
+			Name:        "waitForCompletion",
+			Label:       "Wait for completion",
+			Type:        configuration.FieldTypeBool,
+			Description: "Emit on the Completed channel once every element has been processed",
+			Default:     false,
+		},
+		{
+			Name:        "maxItems",
+			Label:       "Max items",
+			Type:        configuration.FieldTypeNumber,
+			Description: "Maximum number of elements for a single execution",
+			Default:     DefaultMaxItems,
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: &min,
+					Max: &max,
+				},
+			},
+		},
+	}
+}
+
+func decodeSpec(config any) (*Spec, error) {
+	spec := Spec{}
+	err := mapstructure.Decode(config, &spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(spec.ItemsPath) == "" {
+		return nil, fmt.Errorf("itemsPath is required")
+	}
+
+	if spec.Concurrency == 0 {
+		spec.Concurrency = DefaultConcurrency
+	}
+
+	if spec.Concurrency < 0 {
+		return nil, fmt.Errorf("concurrency must be at least 1, got: %d", spec.Concurrency)
+	}
+
+	if spec.MaxItems == 0 {
+		spec.MaxItems = DefaultMaxItems
+	}
+
+	if spec.MaxItems < 0 || spec.MaxItems > MaxItemsLimit {
+		return nil, fmt.Errorf("maxItems must be between 1 and %d, got: %d", MaxItemsLimit, spec.MaxItems)
+	}
+
+	return &spec, nil
+}
+
+func (i *Iterate) Setup(ctx core.SetupContext) error {
+	spec, err := decodeSpec(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	err = expressions.Validate(spec.ItemsPath)
+	if err != nil {
+		return fmt.Errorf("invalid itemsPath: %w", err)
+	}
+
+	return nil
+}
+
+func (i *Iterate) Execute(ctx core.ExecutionContext) error {
+	spec, err := decodeSpec(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	env, err := expressions.Env(ctx.ExpressionEnv, ctx.Data, ctx.SourceNodeID, spec.ItemsPath)
+	if err != nil {
+		return err
+	}
+
+	vm, err := expr.Compile(spec.ItemsPath, expressions.Options(env)...)
+	if err != nil {
+		return fmt.Errorf("expression compilation failed: %w", err)
+	}
+
+	output, err := expressions.Run(vm, env)
+	if err != nil {
+		return fmt.Errorf("expression evaluation failed: %w", err)
+	}
+
+	items, err := toItems(output)
+	if err != nil {
+		return err
+	}
+
+	if len(items) > spec.MaxItems {
+		return fmt.Errorf("itemsPath returned %d items, but at most %d are allowed", len(items), spec.MaxItems)
+	}
+
+	metadata := &ExecutionMetadata{
+		ItemsPath: spec.ItemsPath,
+		Items:     items,
+		Total:     len(items),
+		InFlight:  []string{},
+	}
+
+	return i.progress(spec, metadata, ctx.Metadata, ctx.ExecutionState, ctx.Requests)
+}
+
+func (i *Iterate) Actions() []core.Action {
+	return []core.Action{
+		{Name: "checkProgress"},
+	}
+}
+
+func (i *Iterate) HandleAction(ctx core.ActionContext) error {
+	switch ctx.Name {
+	case "checkProgress":
+		return i.HandleCheckProgress(ctx)
+	default:
+		return fmt.Errorf("iterate does not support action: %s", ctx.Name)
+	}
+}
+
+func (i *Iterate) HandleCheckProgress(ctx core.ActionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	spec, err := decodeSpec(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	metadata := &ExecutionMetadata{}
+	err = mapstructure.Decode(ctx.Metadata.Get(), metadata)
+	if err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	return i.progress(spec, metadata, ctx.Metadata, ctx.ExecutionState, ctx.Requests)
+}
+
+/*
+ * Drops the released elements that are done downstream,
+ * releases as many new elements as the concurrency allows,
+ * and finishes the execution once there is nothing left to wait for.
+ */
+func (i *Iterate) progress(
+	spec *Spec,
+	metadata *ExecutionMetadata,
+	metadataCtx core.MetadataContext,
+	state core.ExecutionStateContext,
+	requests core.RequestContext,
+) error {
+	inFlight, err := unfinished(state, metadata.InFlight)
+	if err != nil {
+		return err
+	}
+
+	metadata.InFlight = inFlight
+
+	available := min(spec.Concurrency-len(metadata.InFlight), metadata.Total-metadata.Released)
+	if available > 0 {
+		err = release(state, metadata, available)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = metadataCtx.Set(metadata)
+	if err != nil {
+		return fmt.Errorf("error setting metadata: %w", err)
+	}
+
+	if metadata.Released < metadata.Total {
+		return requests.ScheduleActionCall("checkProgress", map[string]any{}, ProgressCheckInterval)
+	}
+
+	if !spec.WaitForCompletion {
+		return state.Pass()
+	}
+
+	if len(metadata.InFlight) > 0 {
+		return requests.ScheduleActionCall("checkProgress", map[string]any{}, ProgressCheckInterval)
+	}
+
+	return state.Emit(ChannelNameCompleted, CompletedPayloadType, []any{
+		map[string]any{"total": metadata.Total},
+	})
+}
+
+func unfinished(state core.ExecutionStateContext, eventIDs []string) ([]string, error) {
+	inFlight := []string{}
+	for _, eventID := range eventIDs {
+		id, err := uuid.Parse(eventID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid event ID %s: %w", eventID, err)
+		}
+
+		running, err := state.HasUnfinishedDescendants(id)
+		if err != nil {
+			return nil, fmt.Errorf("error checking event %s: %w", eventID, err)
+		}
+
+		if running {
+			inFlight = append(inFlight, eventID)
+		}
+	}
+
+	return inFlight, nil
+}
+
+func release(state core.ExecutionStateContext, metadata *ExecutionMetadata, count int) error {
+	payloads := make([]any, 0, count)
+	for index := metadata.Released; index < metadata.Released+count; index++ {
+		payloads = append(payloads, map[string]any{
+			"value": metadata.Items[index],
+			"index": index,
+			"total": metadata.Total,
+		})
+	}
+
+	ids, err := state.Release(core.DefaultOutputChannel.Name, PayloadType, payloads)
+	if err != nil {
+		return fmt.Errorf("error releasing elements: %w", err)
+	}
+
+	for _, id := range ids {
+		metadata.InFlight = append(metadata.InFlight, id.String())
+	}
+
+	metadata.Released += count
+	return nil
+}
+
+/*
+ * Arrays built inside expressions and arrays coming from
+ * decoded event data have different Go types, so any slice is accepted.
+ */
+func toItems(output any) ([]any, error) {
+	if items, ok := output.([]any); ok {
+		return items, nil
+	}
+
+	value := reflect.ValueOf(output)
+	if !value.IsValid() || (value.Kind() != reflect.Slice && value.Kind() != reflect.Array) {
+		return nil, fmt.Errorf("itemsPath must evaluate to an array, got %T", output)
+	}
+
+	items := make([]any, value.Len())
+	for i := range items {
+		items[i] = value.Index(i).Interface()
+	}
+
+	return items, nil
+}
+
+func (i *Iterate) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (i *Iterate) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (i *Iterate) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (i *Iterate) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,228 @@
+package iterate
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func elementData(t *testing.T, payload any) map[string]any {
+	t.Helper()
+	return payload.(map[string]any)["data"].(map[string]any)
+}
+
+func TestIterate_Execute(t *testing.T) {
+	i := &Iterate{}
+	data := map[string]any{"services": []any{"api", "web", "worker"}}
+
+	t.Run("releases one event per element with index and total", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		metadataCtx := &contexts.MetadataContext{}
+		requestCtx := &contexts.RequestContext{}
+
+		err := i.Execute(core.ExecutionContext{
+			Data:           data,
+			Configuration:  map[string]any{"itemsPath": "$.services"},
+			Metadata:       metadataCtx,
+			ExecutionState: stateCtx,
+			Requests:       requestCtx,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, core.DefaultOutputChannel.Name, stateCtx.Channel)
+		assert.Equal(t, PayloadType, stateCtx.Type)
+		require.Len(t, stateCtx.Released, 3)
+		for index, name := range []string{"api", "web", "worker"} {
+			assert.Equal(t, map[string]any{"value": name, "index": index, "total": 3}, elementData(t, stateCtx.Released[index]))
+		}
+
+		//
+		// Without waiting for completion, the execution passes once everything is released.
+		//
+		assert.True(t, stateCtx.Passed)
+		assert.Empty(t, stateCtx.Payloads)
+		assert.Empty(t, requestCtx.Action)
+	})
+
+	t.Run("concurrency limits the elements released at once", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		metadataCtx := &contexts.MetadataContext{}
+		requestCtx := &contexts.RequestContext{}
+
+		err := i.Execute(core.ExecutionContext{
+			Data:           data,
+			Configuration:  map[string]any{"itemsPath": "$.services", "concurrency": 2},
+			Metadata:       metadataCtx,
+			ExecutionState: stateCtx,
+			Requests:       requestCtx,
+		})
+
+		require.NoError(t, err)
+		assert.False(t, stateCtx.Finished)
+		require.Len(t, stateCtx.Released, 2)
+		assert.Equal(t, "checkProgress", requestCtx.Action)
+		assert.Equal(t, ProgressCheckInterval, requestCtx.Duration)
+
+		metadata := metadataCtx.Metadata.(*ExecutionMetadata)
+		assert.Equal(t, 3, metadata.Total)
+		assert.Equal(t, 2, metadata.Released)
+		assert.Len(t, metadata.InFlight, 2)
+	})
+
+	t.Run("empty array with wait for completion emits completed right away", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+
+		err := i.Execute(core.ExecutionContext{
+			Data:           map[string]any{"services": []any{}},
+			Configuration:  map[string]any{"itemsPath": "$.services", "waitForCompletion": true},
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: stateCtx,
+			Requests:       &contexts.RequestContext{},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, stateCtx.Released)
+		assert.Equal(t, ChannelNameCompleted, stateCtx.Channel)
+		require.Len(t, stateCtx.Payloads, 1)
+		assert.Equal(t, map[string]any{"total": 0}, elementData(t, stateCtx.Payloads[0]))
+	})
+
+	t.Run("non-array -> error", func(t *testing.T) {
+		err := i.Execute(core.ExecutionContext{
+			Data:           map[string]any{"services": "api"},
+			Configuration:  map[string]any{"itemsPath": "$.services"},
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: &contexts.ExecutionStateContext{},
+			Requests:       &contexts.RequestContext{},
+		})
+
+		require.ErrorContains(t, err, "itemsPath must evaluate to an array")
+	})
+
+	t.Run("too many items -> error", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		err := i.Execute(core.ExecutionContext{
+			Data:           data,
+			Configuration:  map[string]any{"itemsPath": "$.services", "maxItems": 2},
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: stateCtx,
+			Requests:       &contexts.RequestContext{},
+		})
+
+		require.ErrorContains(t, err, "itemsPath returned 3 items, but at most 2 are allowed")
+		assert.Empty(t, stateCtx.Released)
+	})
+}
+
+func TestIterate_HandleCheckProgress(t *testing.T) {
+	i := &Iterate{}
+	configuration := map[string]any{"itemsPath": "$.services", "concurrency": 2, "waitForCompletion": true}
+
+	start := func(t *testing.T) (*contexts.ExecutionStateContext, *contexts.MetadataContext) {
+		stateCtx := &contexts.ExecutionStateContext{Unfinished: map[uuid.UUID]bool{}}
+		metadataCtx := &contexts.MetadataContext{}
+
+		err := i.Execute(core.ExecutionContext{
+			Data:           map[string]any{"services": []any{"api", "web", "worker"}},
+			Configuration:  configuration,
+			Metadata:       metadataCtx,
+			ExecutionState: stateCtx,
+			Requests:       &contexts.RequestContext{},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, stateCtx.Released, 2)
+		return stateCtx, metadataCtx
+	}
+
+	checkProgress := func(t *testing.T, stateCtx *contexts.ExecutionStateContext, metadataCtx *contexts.MetadataContext) *contexts.RequestContext {
+		requestCtx := &contexts.RequestContext{}
+		err := i.HandleAction(core.ActionContext{
+			Name:           "checkProgress",
+			Configuration:  configuration,
+			Metadata:       metadataCtx,
+			ExecutionState: stateCtx,
+			Requests:       requestCtx,
+		})
+
+		require.NoError(t, err)
+		return requestCtx
+	}
+
+	t.Run("elements still running downstream -> nothing new is released", func(t *testing.T) {
+		stateCtx, metadataCtx := start(t)
+		for _, id := range stateCtx.ReleasedIDs {
+			stateCtx.Unfinished[id] = true
+		}
+
+		requestCtx := checkProgress(t, stateCtx, metadataCtx)
+		assert.Len(t, stateCtx.Released, 2)
+		assert.Equal(t, "checkProgress", requestCtx.Action)
+	})
+
+	t.Run("finished element frees a slot for the next one", func(t *testing.T) {
+		stateCtx, metadataCtx := start(t)
+		stateCtx.Unfinished[stateCtx.ReleasedIDs[1]] = true
+
+		requestCtx := checkProgress(t, stateCtx, metadataCtx)
+		require.Len(t, stateCtx.Released, 3)
+		assert.Equal(t, map[string]any{"value": "worker", "index": 2, "total": 3}, elementData(t, stateCtx.Released[2]))
+		assert.False(t, stateCtx.Finished)
+		assert.Equal(t, "checkProgress", requestCtx.Action)
+	})
+
+	t.Run("every element finished -> completed is emitted", func(t *testing.T) {
+		stateCtx, metadataCtx := start(t)
+		checkProgress(t, stateCtx, metadataCtx)
+		require.Len(t, stateCtx.Released, 3)
+
+		requestCtx := checkProgress(t, stateCtx, metadataCtx)
+		assert.Empty(t, requestCtx.Action)
+		assert.True(t, stateCtx.Passed)
+		assert.Equal(t, ChannelNameCompleted, stateCtx.Channel)
+		assert.Equal(t, CompletedPayloadType, stateCtx.Type)
+		require.Len(t, stateCtx.Payloads, 1)
+		assert.Equal(t, map[string]any{"total": 3}, elementData(t, stateCtx.Payloads[0]))
+	})
+
+	t.Run("cancelled execution -> nothing is released", func(t *testing.T) {
+		stateCtx, metadataCtx := start(t)
+		stateCtx.Finished = true
+
+		requestCtx := checkProgress(t, stateCtx, metadataCtx)
+		assert.Len(t, stateCtx.Released, 2)
+		assert.Empty(t, requestCtx.Action)
+	})
+}
+
+func TestIterate_Setup(t *testing.T) {
+	i := &Iterate{}
+
+	tests := []struct {
+		name          string
+		configuration map[string]any
+		errorMsg      string
+	}{
+		{name: "valid", configuration: map[string]any{"itemsPath": "$.services", "concurrency": 5}},
+		{name: "missing itemsPath", configuration: map[string]any{}, errorMsg: "itemsPath is required"},
+		{name: "invalid itemsPath", configuration: map[string]any{"itemsPath": "$.services["}, errorMsg: "invalid itemsPath"},
+		{name: "negative concurrency", configuration: map[string]any{"itemsPath": "$.services", "concurrency": -1}, errorMsg: "concurrency must be at least 1"},
+		{name: "maxItems above limit", configuration: map[string]any{"itemsPath": "$.services", "maxItems": 5000}, errorMsg: "maxItems must be between 1 and 1000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := i.Setup(core.SetupContext{Configuration: tt.configuration})
+			if tt.errorMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+}
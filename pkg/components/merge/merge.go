@@ -1,14 +1,16 @@
 package merge
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
-	"strconv"
 	"time"
 
 	"github.com/expr-lang/expr"
 	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/components/expressions"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/models"
@@ -26,6 +28,13 @@ const (
 	ChannelNameFail    = "fail"
 )
 
+const (
+	WaitForAll       = "all"
+	WaitForBranches  = "branches"
+	WaitForEvents    = "events"
+	MaxExpectedCount = 1000
+)
+
 func init() {
 	registry.RegisterComponent("merge", &Merge{})
 }
@@ -47,21 +56,36 @@ func (m *Merge) Documentation() string {
 
 ## How It Works
 
-1. The Merge component waits for events from all distinct upstream source nodes
-2. Once all inputs are received, it emits the combined data to the Success channel
-3. Optional timeout and conditional stop features allow early completion
+1. Events that belong to the same run (same root event) are collected by a single execution
+2. By default, the Merge component waits for events from all distinct upstream source nodes
+3. Once all inputs are received, it emits the combined data to the Success channel
+4. Optional timeout and conditional stop features allow early completion
+5. Events arriving after the execution finished are ignored
 
 ## Configuration Options
 
+- **Wait for**: What to wait for before emitting
+  - **All incoming connections**: One event from each distinct upstream node
+  - **Number of branches**: One event from the given number of distinct upstream nodes
+  - **Number of events**: The number of events given by the expected count expression, e.g. ` + "`3`" + ` or ` + "`len(root().data.services)`" + `. The expression is evaluated when the first event arrives. Use it to collect the events started for each element of an array, after a split.
 - **Enable Timeout**: Cancel merge after a specified time if not all inputs are received
 - **Enable Conditional Stop**: Stop waiting early when a condition is met (e.g., if one branch fails)
 
 ## Output Channels
 
 - **Success**: Emitted when all upstream inputs are received
-- **Timeout**: Emitted if the timeout is reached before all inputs are received
+- **Timeout**: Emitted with the inputs received so far, if the timeout is reached before all inputs are received
 - **Fail**: Emitted if the conditional stop expression evaluates to true
 
+## Output
+
+The emitted event contains:
+- **inputs**: The payload of each upstream node, keyed by its node ID. If a node sent more than one event, its latest payload is kept
+- **items**: The received events, in the order they arrived, with their ` + "`eventId`" + `, ` + "`sourceNodeId`" + ` and ` + "`data`" + `
+- **sources**: The distinct upstream nodes that sent events
+- **expected**: Number of expected events, when waiting for a number of events
+- **partial**: Whether the timeout was reached before all inputs were received
+
 ## Behavior
 
 - Tracks distinct source nodes (ignoring multiple channels from the same source)
@@ -81,6 +105,15 @@ func (m *Merge) OutputChannels(configuration any) []core.OutputChannel {
 }
 
 type Spec struct {
+	// WaitFor is what the merge waits for before emitting: all incoming connections, a number of branches, or a number of events
+	WaitFor string `json:"waitFor" mapstructure:"waitFor"`
+
+	// Branches is the number of distinct upstream nodes to wait for
+	Branches int `json:"branches" mapstructure:"branches"`
+
+	// Expression evaluated on the first event, returning the number of events to wait for
+	ExpectedCount string `json:"expectedCount" mapstructure:"expectedCount"`
+
 	// EnableTimeout toggles the execution timeout feature
 	EnableTimeout bool `json:"enableTimeout" mapstructure:"enableTimeout"`
 
@@ -100,7 +133,62 @@ type Spec struct {
 }
 
 func (m *Merge) Configuration() []configuration.Field {
+	minBranches := 1
+
 	return []configuration.Field{
+		{
+			Name:        "waitFor",
+			Label:       "Wait for",
+			Type:        configuration.FieldTypeSelect,
+			Description: "What to wait for before emitting",
+			Required:    false,
+			Default:     WaitForAll,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "All incoming connections", Value: WaitForAll},
+						{Label: "Number of branches", Value: WaitForBranches},
+						{Label: "Number of events", Value: WaitForEvents},
+					},
+				},
+			},
+		},
+		{
+			Name:        "branches",
+			Label:       "Branches",
+			Type:        configuration.FieldTypeNumber,
+			Description: "Number of distinct upstream nodes to wait for",
+			Default:     2,
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{Min: &minBranches},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{
+					Field:  "waitFor",
+					Values: []string{WaitForBranches},
+				},
+			},
+			RequiredConditions: []configuration.RequiredCondition{
+				{
+					Field:  "waitFor",
+					Values: []string{WaitForBranches},
+				},
+			},
+		},
+		{
+			Name:        "expectedCount",
+			Label:       "Expected count",
+			Type:        configuration.FieldTypeExpression,
+			Description: "Number of events to wait for, evaluated when the first event arrives",
+			Placeholder: "e.g. len(root().data.services)",
+			Required:    true,
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{
+					Field:  "waitFor",
+					Values: []string{WaitForEvents},
+				},
+			},
+		},
 		{
 			Name:        "enableTimeout",
 			Label:       "Enable Timeout",
@@ -181,7 +269,35 @@ func (m *Merge) Actions() []core.Action {
 }
 
 func (m *Merge) Setup(ctx core.SetupContext) error {
-	return nil
+	spec := &Spec{}
+	err := mapstructure.Decode(ctx.Configuration, spec)
+	if err != nil {
+		return fmt.Errorf("error decoding configuration: %v", err)
+	}
+
+	switch spec.WaitFor {
+	case "", WaitForAll:
+		return nil
+	case WaitForBranches:
+		if spec.Branches < 1 {
+			return fmt.Errorf("branches must be at least 1")
+		}
+
+		return nil
+	case WaitForEvents:
+		if spec.ExpectedCount == "" {
+			return fmt.Errorf("expectedCount is required")
+		}
+
+		err = expressions.Validate(spec.ExpectedCount)
+		if err != nil {
+			return fmt.Errorf("invalid expectedCount: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("invalid waitFor: %s", spec.WaitFor)
+	}
 }
 
 func (m *Merge) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
@@ -191,7 +307,7 @@ func (m *Merge) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, erro
 		return nil, fmt.Errorf("error decoding configuration: %v", err)
 	}
 
-	executionCtx, err := m.findOrCreateExecution(ctx, ctx.RootEventID)
+	executionCtx, err := m.findOrCreateExecution(ctx, spec, ctx.RootEventID)
 	if err != nil {
 		return nil, fmt.Errorf("error finding or creating execution: %v", err)
 	}
@@ -213,11 +329,6 @@ func (m *Merge) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, erro
 		return nil, fmt.Errorf("error updating node state: %v", err)
 	}
 
-	incoming, err := ctx.CountDistinctIncomingSources()
-	if err != nil {
-		return nil, fmt.Errorf("error counting distinct incoming sources: %v", err)
-	}
-
 	md, err := m.addEventToMetadata(ctx, executionCtx)
 	if err != nil {
 		return nil, fmt.Errorf("error adding event to metadata: %v", err)
@@ -238,17 +349,17 @@ func (m *Merge) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, erro
 	// configs created before the toggle will still work if they have an expression.
 	//
 	if spec.StopIfExpression != "" {
-		env, err := expressionEnv(ctx, spec.StopIfExpression)
+		env, err := expressions.Env(ctx.ExpressionEnv, ctx.Input, ctx.SourceNodeID, spec.StopIfExpression)
 		if err != nil {
 			return nil, err
 		}
 
-		vm, err := expr.Compile(spec.StopIfExpression, expressionOptions(env)...)
+		vm, err := expr.Compile(spec.StopIfExpression, expressions.Options(env, expr.AsBool())...)
 		if err != nil {
 			return nil, fmt.Errorf("stopIfExpression compilation failed: %w", err)
 		}
 
-		out, err := expr.Run(vm, env)
+		out, err := expressions.Run(vm, env)
 		if err != nil {
 			return nil, fmt.Errorf("stopIfExpression evaluation failed: %w", err)
 		}
@@ -264,136 +375,57 @@ func (m *Merge) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, erro
 				return nil, err
 			}
 
+			output, err := buildOutput(executionCtx.Attachments, md)
+			if err != nil {
+				return nil, err
+			}
+
 			return &executionCtx.ID, executionCtx.ExecutionState.Emit(
 				ChannelNameFail,
 				"merge.stopped",
-				[]any{md},
+				[]any{output},
 			)
 		}
 	}
 
-	if len(md.Sources) >= incoming {
+	received, err := m.receivedAll(ctx, spec, md)
+	if err != nil {
+		return nil, err
+	}
+
+	if received {
+		output, err := buildOutput(executionCtx.Attachments, md)
+		if err != nil {
+			return nil, err
+		}
+
 		return &executionCtx.ID, executionCtx.ExecutionState.Emit(
 			ChannelNameSuccess,
 			"merge.finished",
-			[]any{md},
+			[]any{output},
 		)
 	}
 
 	return nil, nil
 }
 
-func expressionEnv(ctx core.ProcessQueueContext, expression string) (map[string]any, error) {
-	if ctx.ExpressionEnv != nil {
-		return ctx.ExpressionEnv(expression)
-	}
-
-	return buildExpressionEnv(ctx.Input, ctx.SourceNodeID), nil
-}
-
-func buildExpressionEnv(input any, sourceNodeID string) map[string]any {
-	if sourceNodeID == "" {
-		return map[string]any{"$": input}
-	}
-
-	if inputMap, ok := input.(map[string]any); ok {
-		envData := make(map[string]any, len(inputMap)+1)
-		for key, value := range inputMap {
-			envData[key] = value
-		}
-		if _, exists := envData[sourceNodeID]; !exists {
-			envData[sourceNodeID] = input
-		}
-		return map[string]any{"$": envData}
-	}
-
-	if inputMap, ok := input.(map[string]string); ok {
-		envData := make(map[string]any, len(inputMap)+1)
-		for key, value := range inputMap {
-			envData[key] = value
-		}
-		if _, exists := envData[sourceNodeID]; !exists {
-			envData[sourceNodeID] = input
-		}
-		return map[string]any{"$": envData}
+func (m *Merge) receivedAll(ctx core.ProcessQueueContext, spec *Spec, md *ExecutionMetadata) (bool, error) {
+	switch spec.WaitFor {
+	case WaitForEvents:
+		return len(md.Items) >= md.Expected, nil
+	case WaitForBranches:
+		return len(md.Sources) >= spec.Branches, nil
 	}
 
-	return map[string]any{"$": map[string]any{sourceNodeID: input}}
-}
-
-func expressionOptions(env map[string]any) []expr.Option {
-	return []expr.Option{
-		expr.Env(env),
-		expr.AsBool(),
-		expr.WithContext("ctx"),
-		expr.Timezone(time.UTC.String()),
-		expr.Function("root", func(params ...any) (any, error) {
-			if len(params) != 0 {
-				return nil, fmt.Errorf("root() takes no arguments")
-			}
-
-			rootPayload, ok := env["__root"]
-			if !ok {
-				return nil, fmt.Errorf("no root event found")
-			}
-			return rootPayload, nil
-		}),
-		expr.Function("previous", func(params ...any) (any, error) {
-			depth := 1
-			if len(params) > 1 {
-				return nil, fmt.Errorf("previous() accepts zero or one argument")
-			}
-			if len(params) == 1 {
-				parsedDepth, err := parseDepthValue(params[0])
-				if err != nil {
-					return nil, err
-				}
-				depth = parsedDepth
-			}
-
-			previousByDepth, ok := env["__previousByDepth"]
-			if !ok {
-				return nil, nil
-			}
-			if values, ok := previousByDepth.(map[string]any); ok {
-				return values[strconv.Itoa(depth)], nil
-			}
-			if values, ok := previousByDepth.(map[int]any); ok {
-				return values[depth], nil
-			}
-
-			return nil, nil
-		}),
+	incoming, err := ctx.CountDistinctIncomingSources()
+	if err != nil {
+		return false, fmt.Errorf("error counting distinct incoming sources: %v", err)
 	}
-}
 
-func parseDepthValue(param any) (int, error) {
-	switch value := param.(type) {
-	case int:
-		if value < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return value, nil
-	case int64:
-		if value < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return int(value), nil
-	case float64:
-		parsed := int(value)
-		if value != float64(parsed) {
-			return 0, fmt.Errorf("depth must be an integer")
-		}
-		if parsed < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return parsed, nil
-	default:
-		return 0, fmt.Errorf("depth must be an integer")
-	}
+	return len(md.Sources) >= incoming, nil
 }
 
-func (m *Merge) findOrCreateExecution(ctx core.ProcessQueueContext, mergeGroup string) (*core.ExecutionContext, error) {
+func (m *Merge) findOrCreateExecution(ctx core.ProcessQueueContext, spec *Spec, mergeGroup string) (*core.ExecutionContext, error) {
 	executionCtx, err := ctx.FindExecutionByKV("merge_group", mergeGroup)
 	if err != nil {
 		return nil, err
@@ -406,6 +438,18 @@ func (m *Merge) findOrCreateExecution(ctx core.ProcessQueueContext, mergeGroup s
 		return executionCtx, nil
 	}
 
+	//
+	// The expected count is evaluated once, for the first event,
+	// so all events of the run are compared against the same number.
+	//
+	expected := 0
+	if spec.WaitFor == WaitForEvents {
+		expected, err = evaluateExpectedCount(ctx, spec.ExpectedCount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	//
 	// Execution does not exist yet, create it.
 	//
@@ -423,6 +467,8 @@ func (m *Merge) findOrCreateExecution(ctx core.ProcessQueueContext, mergeGroup s
 		GroupKey: mergeGroup,
 		EventIDs: []string{},
 		Sources:  []string{},
+		Items:    []Item{},
+		Expected: expected,
 	}
 
 	err = executionCtx.Metadata.Set(md)
@@ -433,6 +479,48 @@ func (m *Merge) findOrCreateExecution(ctx core.ProcessQueueContext, mergeGroup s
 	return executionCtx, nil
 }
 
+func evaluateExpectedCount(ctx core.ProcessQueueContext, expression string) (int, error) {
+	if expression == "" {
+		return 0, fmt.Errorf("expectedCount is required")
+	}
+
+	env, err := expressions.Env(ctx.ExpressionEnv, ctx.Input, ctx.SourceNodeID, expression)
+	if err != nil {
+		return 0, err
+	}
+
+	vm, err := expr.Compile(expression, expressions.Options(env)...)
+	if err != nil {
+		return 0, fmt.Errorf("expectedCount compilation failed: %w", err)
+	}
+
+	output, err := expressions.Run(vm, env)
+	if err != nil {
+		return 0, fmt.Errorf("expectedCount evaluation failed: %w", err)
+	}
+
+	var expected int
+	switch value := output.(type) {
+	case int:
+		expected = value
+	case int64:
+		expected = int(value)
+	case float64:
+		if value != math.Trunc(value) {
+			return 0, fmt.Errorf("expectedCount must be an integer, got %v", value)
+		}
+		expected = int(value)
+	default:
+		return 0, fmt.Errorf("expectedCount must evaluate to a number, got %T", output)
+	}
+
+	if expected < 1 || expected > MaxExpectedCount {
+		return 0, fmt.Errorf("expectedCount must be between 1 and %d, got: %d", MaxExpectedCount, expected)
+	}
+
+	return expected, nil
+}
+
 func (m *Merge) addEventToMetadata(ctx core.ProcessQueueContext, executionCtx *core.ExecutionContext) (*ExecutionMetadata, error) {
 	md := &ExecutionMetadata{}
 	err := mapstructure.Decode(executionCtx.Metadata.Get(), md)
@@ -440,10 +528,24 @@ func (m *Merge) addEventToMetadata(ctx core.ProcessQueueContext, executionCtx *c
 		return nil, err
 	}
 
+	data, err := json.Marshal(ctx.Input)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding input: %v", err)
+	}
+
+	err = executionCtx.Attachments.Put(inputAttachmentName(ctx.EventID), data)
+	if err != nil {
+		return nil, fmt.Errorf("error storing input: %v", err)
+	}
+
 	md.EventIDs = append(md.EventIDs, ctx.EventID)
+	md.Items = append(md.Items, Item{
+		EventID:      ctx.EventID,
+		SourceNodeID: ctx.SourceNodeID,
+	})
 
 	//
-	// Track distinct source nodes that reached this merge
+	// Track distinct source nodes that reached this merge.
 	//
 	if ctx.SourceNodeID != "" {
 		exists := false
@@ -466,6 +568,53 @@ func (m *Merge) addEventToMetadata(ctx core.ProcessQueueContext, executionCtx *c
 	return md, nil
 }
 
+func inputAttachmentName(eventID string) string {
+	return "input-" + eventID
+}
+
+/*
+ * Loads the payloads of the events that reached the merge.
+ * If the same node sent more than one event, its latest payload
+ * is the one used in the inputs keyed by source node.
+ */
+func buildOutput(attachments core.AttachmentContext, md *ExecutionMetadata) (*Output, error) {
+	output := &Output{
+		GroupKey:  md.GroupKey,
+		EventIDs:  md.EventIDs,
+		Sources:   md.Sources,
+		Inputs:    map[string]any{},
+		Items:     make([]OutputItem, 0, len(md.Items)),
+		Expected:  md.Expected,
+		StopEarly: md.StopEarly,
+		Partial:   md.Partial,
+	}
+
+	for _, item := range md.Items {
+		raw, err := attachments.Get(inputAttachmentName(item.EventID))
+		if err != nil {
+			return nil, fmt.Errorf("error loading input of event %s: %v", item.EventID, err)
+		}
+
+		var data any
+		err = json.Unmarshal(raw, &data)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding input of event %s: %v", item.EventID, err)
+		}
+
+		output.Items = append(output.Items, OutputItem{
+			EventID:      item.EventID,
+			SourceNodeID: item.SourceNodeID,
+			Data:         data,
+		})
+
+		if item.SourceNodeID != "" {
+			output.Inputs[item.SourceNodeID] = data
+		}
+	}
+
+	return output, nil
+}
+
 func (m *Merge) HandleAction(ctx core.ActionContext) error {
 	switch ctx.Name {
 	case "timeoutReached":
@@ -491,10 +640,21 @@ func (m *Merge) HandleTimeout(ctx core.ActionContext) error {
 		)
 	}
 
+	//
+	// The inputs received so far are emitted,
+	// marked as partial, since not all of them arrived.
+	//
+	md.Partial = true
+
+	output, err := buildOutput(ctx.Attachments, md)
+	if err != nil {
+		return err
+	}
+
 	return ctx.ExecutionState.Emit(
 		ChannelNameTimeout,
 		"merge.timeout",
-		[]any{md},
+		[]any{output},
 	)
 }
 
@@ -1,11 +1,11 @@
 package merge
 
 import (
+	"encoding/json"
 	"fmt"
-	"net/http"
+	"strings"
 	"testing"
 
-	"github.com/expr-lang/expr"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -13,6 +13,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	testcontexts "github.com/superplanehq/superplane/test/support/contexts"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
@@ -118,62 +119,266 @@ func Test_Merge_WaitsForDistinctSources(t *testing.T) {
 	steps.AssertQueueIsEmpty()
 }
 
-func TestMerge_ExpressionEnv_UsesContextEnv(t *testing.T) {
-	ctx := core.ProcessQueueContext{
-		Input:        map[string]any{"result": "ignored"},
-		SourceNodeID: "source-node",
-		ExpressionEnv: func(expression string) (map[string]any, error) {
-			return map[string]any{
-				"$": map[string]any{
-					"other-node": map[string]any{
-						"result": "ok",
-					},
-				},
-			}, nil
-		},
+func Test_Merge_WaitForEvents(t *testing.T) {
+	configuration := map[string]any{"waitFor": WaitForEvents, "expectedCount": "3"}
+
+	t.Run("three inputs produce one emission", func(t *testing.T) {
+		queue := newTestQueue()
+
+		assert.Nil(t, queue.process(t, configuration, "deploy", map[string]any{"service": "api"}))
+		assert.Nil(t, queue.process(t, configuration, "deploy", map[string]any{"service": "web"}))
+
+		stateCtx := queue.execution().ExecutionState.(*testcontexts.ExecutionStateContext)
+		assert.False(t, stateCtx.Finished)
+		assert.Equal(t, queue.rootEventID, stateCtx.KVs["merge_group"])
+
+		id := queue.process(t, configuration, "deploy", map[string]any{"service": "worker"})
+		require.NotNil(t, id)
+		assert.Equal(t, queue.execution().ID, *id)
+		assert.Equal(t, 3, queue.dequeued)
+
+		assert.True(t, stateCtx.Finished)
+		assert.Equal(t, ChannelNameSuccess, stateCtx.Channel)
+		assert.Equal(t, "merge.finished", stateCtx.Type)
+		require.Len(t, stateCtx.Payloads, 1)
+
+		output := stateCtx.Payloads[0].(map[string]any)["data"].(*Output)
+		require.Len(t, output.Items, 3)
+		assert.Equal(t, map[string]any{"service": "api"}, output.Items[0].Data)
+		assert.Equal(t, map[string]any{"service": "web"}, output.Items[1].Data)
+		assert.Equal(t, map[string]any{"service": "worker"}, output.Items[2].Data)
+		assert.Equal(t, 3, output.Expected)
+		assert.False(t, output.Partial)
+	})
+
+	t.Run("inputs arriving after the execution finished are dropped", func(t *testing.T) {
+		queue := newTestQueue()
+		config := map[string]any{"waitFor": WaitForEvents, "expectedCount": "1"}
+
+		require.NotNil(t, queue.process(t, config, "deploy", map[string]any{"service": "api"}))
+		assert.Nil(t, queue.process(t, config, "deploy", map[string]any{"service": "web"}))
+		assert.Equal(t, 2, queue.dequeued)
+
+		md := queue.execution().Metadata.Get().(*ExecutionMetadata)
+		assert.Len(t, md.Items, 1)
+	})
+
+	t.Run("expected count is evaluated against the input", func(t *testing.T) {
+		queue := newTestQueue()
+		config := map[string]any{"waitFor": WaitForEvents, "expectedCount": "len($.services)"}
+
+		assert.Nil(t, queue.process(t, config, "", map[string]any{"services": []any{"api", "web"}}))
+		md := queue.execution().Metadata.Get().(*ExecutionMetadata)
+		assert.Equal(t, 2, md.Expected)
+	})
+
+	t.Run("invalid expected count -> error", func(t *testing.T) {
+		queue := newTestQueue()
+		config := map[string]any{"waitFor": WaitForEvents, "expectedCount": "0"}
+
+		_, err := (&Merge{}).ProcessQueueItem(queue.context(config, "deploy", map[string]any{}))
+		require.ErrorContains(t, err, "expectedCount must be between 1 and 1000")
+		assert.Nil(t, queue.execution())
+	})
+}
+
+func Test_Merge_InputsKeyedBySourceNode(t *testing.T) {
+	t.Run("all incoming connections -> payloads keyed by source node", func(t *testing.T) {
+		queue := newTestQueue()
+		queue.incoming = 2
+
+		assert.Nil(t, queue.process(t, map[string]any{}, "deploy", map[string]any{"status": "deployed"}))
+		require.NotNil(t, queue.process(t, map[string]any{}, "migrate", map[string]any{"status": "done"}))
+
+		stateCtx := queue.execution().ExecutionState.(*testcontexts.ExecutionStateContext)
+		assert.Equal(t, ChannelNameSuccess, stateCtx.Channel)
+		require.Len(t, stateCtx.Payloads, 1)
+
+		output := stateCtx.Payloads[0].(map[string]any)["data"].(*Output)
+		assert.Equal(t, map[string]any{
+			"deploy":  map[string]any{"status": "deployed"},
+			"migrate": map[string]any{"status": "done"},
+		}, output.Inputs)
+	})
+
+	t.Run("same source arriving twice is counted once, with its latest payload", func(t *testing.T) {
+		queue := newTestQueue()
+		queue.incoming = 2
+
+		assert.Nil(t, queue.process(t, map[string]any{}, "deploy", map[string]any{"attempt": 1}))
+		assert.Nil(t, queue.process(t, map[string]any{}, "deploy", map[string]any{"attempt": 2}))
+		require.NotNil(t, queue.process(t, map[string]any{}, "migrate", map[string]any{"status": "done"}))
+
+		md := queue.execution().Metadata.Get().(*ExecutionMetadata)
+		assert.Equal(t, []string{"deploy", "migrate"}, md.Sources)
+		assert.Len(t, md.Items, 3)
+
+		stateCtx := queue.execution().ExecutionState.(*testcontexts.ExecutionStateContext)
+		output := stateCtx.Payloads[0].(map[string]any)["data"].(*Output)
+		assert.Equal(t, map[string]any{"attempt": float64(2)}, output.Inputs["deploy"])
+		assert.Len(t, output.Items, 3)
+	})
+
+	t.Run("number of branches", func(t *testing.T) {
+		queue := newTestQueue()
+		queue.incoming = 3
+		config := map[string]any{"waitFor": WaitForBranches, "branches": 2}
+
+		assert.Nil(t, queue.process(t, config, "deploy", map[string]any{}))
+		assert.Nil(t, queue.process(t, config, "deploy", map[string]any{}))
+		require.NotNil(t, queue.process(t, config, "migrate", map[string]any{}))
+
+		stateCtx := queue.execution().ExecutionState.(*testcontexts.ExecutionStateContext)
+		assert.Equal(t, ChannelNameSuccess, stateCtx.Channel)
+	})
+}
+
+func Test_Merge_LargePayloads(t *testing.T) {
+	queue := newTestQueue()
+	queue.incoming = 5
+
+	//
+	// Payloads are kept out of the execution metadata,
+	// so merging a few branches with payloads of a few KB each
+	// does not go over the metadata size limit.
+	//
+	payload := func(source string) map[string]any {
+		return map[string]any{"source": source, "logs": strings.Repeat("x", 8*1024)}
 	}
 
-	env, err := expressionEnv(ctx, "$[\"other-node\"].result == \"ok\"")
-	require.NoError(t, err)
+	sources := []string{"build", "test", "lint", "scan", "deploy"}
+	for _, source := range sources[:4] {
+		assert.Nil(t, queue.process(t, map[string]any{}, source, payload(source)))
+	}
 
-	vm, err := expr.Compile("$[\"other-node\"].result == \"ok\"", expr.Env(env), expr.AsBool())
-	require.NoError(t, err)
+	require.NotNil(t, queue.process(t, map[string]any{}, "deploy", payload("deploy")))
 
-	out, err := expr.Run(vm, env)
+	metadata, err := json.Marshal(queue.execution().Metadata.Get())
 	require.NoError(t, err)
-	assert.True(t, out.(bool))
-}
-
-func TestMerge_ExpressionOptions_RootAndPrevious(t *testing.T) {
-	ctx := core.ProcessQueueContext{
-		ExpressionEnv: func(expression string) (map[string]any, error) {
-			return map[string]any{
-				"$": map[string]any{},
-				"__root": map[string]any{
-					"data": map[string]any{
-						"ref": "main",
-					},
-				},
-				"__previousByDepth": map[string]any{
-					"1": map[string]any{
-						"data": map[string]any{
-							"ok": true,
-						},
-					},
+	assert.Less(t, len(metadata), contexts.DefaultMaxMetadataSize)
+
+	stateCtx := queue.execution().ExecutionState.(*testcontexts.ExecutionStateContext)
+	output := stateCtx.Payloads[0].(map[string]any)["data"].(*Output)
+	require.Len(t, output.Items, len(sources))
+	for _, source := range sources {
+		data := output.Inputs[source].(map[string]any)
+		assert.Equal(t, source, data["source"])
+		assert.Len(t, data["logs"], 8*1024)
+	}
+}
+
+func Test_Merge_TimeoutEmitsPartialInputs(t *testing.T) {
+	stateCtx := &testcontexts.ExecutionStateContext{}
+	err := (&Merge{}).HandleAction(core.ActionContext{
+		Name: "timeoutReached",
+		Metadata: &testcontexts.MetadataContext{
+			Metadata: &ExecutionMetadata{
+				Expected: 3,
+				Items: []Item{
+					{EventID: "e1", SourceNodeID: "deploy"},
+					{EventID: "e2", SourceNodeID: "deploy"},
 				},
+			},
+		},
+		Attachments: &testcontexts.AttachmentContext{
+			Attachments: map[string][]byte{
+				inputAttachmentName("e1"): []byte(`{"service":"api"}`),
+				inputAttachmentName("e2"): []byte(`{"service":"web"}`),
+			},
+		},
+		ExecutionState: stateCtx,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, stateCtx.Finished)
+	assert.Equal(t, ChannelNameTimeout, stateCtx.Channel)
+	require.Len(t, stateCtx.Payloads, 1)
+
+	output := stateCtx.Payloads[0].(map[string]any)["data"].(*Output)
+	require.Len(t, output.Items, 2)
+	assert.Equal(t, map[string]any{"service": "web"}, output.Items[1].Data)
+	assert.Equal(t, map[string]any{"service": "web"}, output.Inputs["deploy"])
+	assert.Equal(t, 3, output.Expected)
+	assert.True(t, output.Partial)
+}
+
+func Test_Merge_Setup(t *testing.T) {
+	m := &Merge{}
+
+	require.NoError(t, m.Setup(core.SetupContext{Configuration: map[string]any{}}))
+	require.NoError(t, m.Setup(core.SetupContext{Configuration: map[string]any{"waitFor": WaitForEvents, "expectedCount": "3"}}))
+	require.ErrorContains(t, m.Setup(core.SetupContext{Configuration: map[string]any{"waitFor": WaitForEvents}}), "expectedCount is required")
+	require.ErrorContains(t, m.Setup(core.SetupContext{Configuration: map[string]any{"waitFor": WaitForEvents, "expectedCount": "len("}}), "invalid expectedCount")
+	require.NoError(t, m.Setup(core.SetupContext{Configuration: map[string]any{"waitFor": WaitForBranches, "branches": 2}}))
+	require.ErrorContains(t, m.Setup(core.SetupContext{Configuration: map[string]any{"waitFor": WaitForBranches, "branches": 0}}), "branches must be at least 1")
+	require.ErrorContains(t, m.Setup(core.SetupContext{Configuration: map[string]any{"waitFor": "nothing"}}), "invalid waitFor")
+}
+
+type testQueue struct {
+	rootEventID string
+	incoming    int
+	executions  map[string]*core.ExecutionContext
+	dequeued    int
+}
+
+func newTestQueue() *testQueue {
+	return &testQueue{
+		rootEventID: uuid.NewString(),
+		executions:  map[string]*core.ExecutionContext{},
+	}
+}
+
+func (q *testQueue) context(configuration map[string]any, sourceNodeID string, input any) core.ProcessQueueContext {
+	return core.ProcessQueueContext{
+		RootEventID:   q.rootEventID,
+		EventID:       uuid.NewString(),
+		SourceNodeID:  sourceNodeID,
+		Configuration: configuration,
+		Input:         input,
+		DequeueItem: func() error {
+			q.dequeued++
+			return nil
+		},
+		UpdateNodeState: func(state string) error {
+			return nil
+		},
+		CountDistinctIncomingSources: func() (int, error) {
+			return q.incoming, nil
+		},
+		CreateExecution: func() (*core.ExecutionContext, error) {
+			return &core.ExecutionContext{
+				ID:             uuid.New(),
+				Metadata:       &testcontexts.MetadataContext{},
+				Attachments:    &testcontexts.AttachmentContext{},
+				ExecutionState: &testcontexts.ExecutionStateContext{KVs: map[string]string{}},
+				Requests:       &testcontexts.RequestContext{},
 			}, nil
 		},
+		FindExecutionByKV: func(key, value string) (*core.ExecutionContext, error) {
+			return q.executions[key+"="+value], nil
+		},
 	}
+}
 
-	env, err := expressionEnv(ctx, `root().data.ref == "main" && previous().data.ok == true`)
-	require.NoError(t, err)
+func (q *testQueue) process(t *testing.T, configuration map[string]any, sourceNodeID string, input any) *uuid.UUID {
+	ctx := q.context(configuration, sourceNodeID, input)
+	createExecution := ctx.CreateExecution
+	ctx.CreateExecution = func() (*core.ExecutionContext, error) {
+		executionCtx, err := createExecution()
+		if err == nil {
+			q.executions["merge_group="+q.rootEventID] = executionCtx
+		}
 
-	vm, err := expr.Compile(`root().data.ref == "main" && previous().data.ok == true`, expressionOptions(env)...)
-	require.NoError(t, err)
+		return executionCtx, err
+	}
 
-	out, err := expr.Run(vm, env)
+	id, err := (&Merge{}).ProcessQueueItem(ctx)
 	require.NoError(t, err)
-	assert.True(t, out.(bool))
+	return id
+}
+
+func (q *testQueue) execution() *core.ExecutionContext {
+	return q.executions["merge_group="+q.rootEventID]
 }
 
 type MergeTestSteps struct {
@@ -451,7 +656,7 @@ func (s *MergeTestSteps) CreateSingleQueueItemForProcess1() {
 func (s *MergeTestSteps) ProcessFirstEvent(m *Merge) {
 	fmt.Println("Processing first event")
 
-	ctx1, err := contexts.BuildProcessQueueContext(http.DefaultClient, s.Tx, s.MergeNode, s.QueureItem1, nil)
+	ctx1, err := contexts.BuildProcessQueueContext(testcontexts.NewClientHTTPContext(), s.Tx, s.MergeNode, s.QueureItem1, nil)
 	assert.NoError(s.t, err)
 
 	execution, err := m.ProcessQueueItem(*ctx1)
@@ -464,7 +669,7 @@ func (s *MergeTestSteps) ProcessFirstEvent(m *Merge) {
 func (s *MergeTestSteps) ProcessFirstEventExpectFinish(m *Merge) {
 	fmt.Println("Processing first event (expect finish)")
 
-	ctx1, err := contexts.BuildProcessQueueContext(http.DefaultClient, s.Tx, s.MergeNode, s.QueureItem1, nil)
+	ctx1, err := contexts.BuildProcessQueueContext(testcontexts.NewClientHTTPContext(), s.Tx, s.MergeNode, s.QueureItem1, nil)
 	assert.NoError(s.t, err)
 
 	execution, err := m.ProcessQueueItem(*ctx1)
@@ -475,7 +680,7 @@ func (s *MergeTestSteps) ProcessFirstEventExpectFinish(m *Merge) {
 func (s *MergeTestSteps) ProcessSecondEvent(m *Merge) {
 	fmt.Println("Processing second event")
 
-	ctx2, err := contexts.BuildProcessQueueContext(http.DefaultClient, s.Tx, s.MergeNode, s.QueureItem2, nil)
+	ctx2, err := contexts.BuildProcessQueueContext(testcontexts.NewClientHTTPContext(), s.Tx, s.MergeNode, s.QueureItem2, nil)
 	assert.NoError(s.t, err)
 
 	execution, err := m.ProcessQueueItem(*ctx2)
@@ -486,7 +691,7 @@ func (s *MergeTestSteps) ProcessSecondEvent(m *Merge) {
 func (s *MergeTestSteps) ProcessSecondEventExpectNoFinish(m *Merge) {
 	fmt.Println("Processing second event")
 
-	ctx2, err := contexts.BuildProcessQueueContext(http.DefaultClient, s.Tx, s.MergeNode, s.QueureItem2, nil)
+	ctx2, err := contexts.BuildProcessQueueContext(testcontexts.NewClientHTTPContext(), s.Tx, s.MergeNode, s.QueureItem2, nil)
 	assert.NoError(s.t, err)
 
 	execution, err := m.ProcessQueueItem(*ctx2)
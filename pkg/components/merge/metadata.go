@@ -3,6 +3,9 @@ package merge
 //
 // The execution metadata associated with a merge component
 // holds information about the grouping of events.
+// Payloads are not kept here, since metadata is size limited:
+// each one is stored as an attachment of the execution,
+// and loaded again when the merge emits.
 //
 
 type ExecutionMetadata struct {
@@ -15,6 +18,42 @@ type ExecutionMetadata struct {
 	// Sources collects distinct upstream source node ids that reached this merge
 	Sources []string `json:"sources,omitempty" mapstructure:"sources"`
 
+	// Items collects the events that reached this merge, in arrival order
+	Items []Item `json:"items,omitempty" mapstructure:"items"`
+
+	// Expected is the number of events to wait for, when waiting for a number of events
+	Expected int `json:"expected,omitempty" mapstructure:"expected"`
+
 	// StopEarly indicates the merge was short-circuited based on a stop condition
 	StopEarly bool `json:"stopEarly,omitempty" mapstructure:"stopEarly"`
+
+	// Partial indicates the timeout was reached before all inputs were received
+	Partial bool `json:"partial,omitempty" mapstructure:"partial"`
+}
+
+type Item struct {
+	EventID      string `json:"eventId" mapstructure:"eventId"`
+	SourceNodeID string `json:"sourceNodeId" mapstructure:"sourceNodeId"`
+}
+
+//
+// Output is what the merge emits: the metadata,
+// with the payloads of the events that reached it.
+//
+
+type Output struct {
+	GroupKey  string         `json:"groupKey,omitempty"`
+	EventIDs  []string       `json:"eventIDs,omitempty"`
+	Sources   []string       `json:"sources,omitempty"`
+	Inputs    map[string]any `json:"inputs,omitempty"`
+	Items     []OutputItem   `json:"items,omitempty"`
+	Expected  int            `json:"expected,omitempty"`
+	StopEarly bool           `json:"stopEarly,omitempty"`
+	Partial   bool           `json:"partial,omitempty"`
+}
+
+type OutputItem struct {
+	EventID      string `json:"eventId"`
+	SourceNodeID string `json:"sourceNodeId"`
+	Data         any    `json:"data"`
 }
@@ -0,0 +1,247 @@
+package split
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/expr-lang/expr"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/components/expressions"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const ComponentName = "split"
+const PayloadType = "split.item"
+const DefaultMaxItems = 100
+const MaxItemsLimit = 1000
+
+func init() {
+	registry.RegisterComponent(ComponentName, &Split{})
+}
+
+type Split struct{}
+
+type Spec struct {
+	ItemsPath string `json:"itemsPath" mapstructure:"itemsPath"`
+	MaxItems  int    `json:"maxItems" mapstructure:"maxItems"`
+}
+
+type ExecutionMetadata struct {
+	ItemsPath string `json:"itemsPath" mapstructure:"itemsPath"`
+	Count     int    `json:"count" mapstructure:"count"`
+}
+
+func (s *Split) Name() string {
+	return ComponentName
+}
+
+func (s *Split) Label() string {
+	return "Split"
+}
+
+func (s *Split) Description() string {
+	return "Emit one event per element of an array"
+}
+
+func (s *Split) Documentation() string {
+	return `The Split component selects an array from the incoming event and emits one event per element, so downstream nodes run once for each element.
+
+## Use Cases
+
+- **Fan-out**: Run the same steps for every service, environment or region returned by a previous step
+- **Batch processing**: Process each item of a list independently
+
+## How It Works
+
+1. The items path expression is evaluated against the incoming event data
+2. The expression must evaluate to an array
+3. Each element of the array is emitted to the default output channel as the body of its own event
+4. If the array is empty, the execution passes without emitting anything
+
+## Limits
+
+The number of elements is capped by the **Max items** setting.
+If the array has more elements than allowed, the execution fails and nothing is emitted.
+
+## Expression Environment
+
+The expression has access to:
+- **$**: The run context data
+- **root()**: Access to the root event data
+- **previous()**: Access to previous node outputs (optionally with depth parameter)
+
+## Examples
+
+- ` + "`$[\"Node Name\"].services`" + `: Emit one event per service
+- ` + "`$[\"Node Name\"].data.items`" + `: Emit one event per item of a nested list`
+}
+
+func (s *Split) Icon() string {
+	return "split"
+}
+
+func (s *Split) Color() string {
+	return "blue"
+}
+
+func (s *Split) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (s *Split) Configuration() []configuration.Field {
+	min := 1
+	max := MaxItemsLimit
+
+	return []configuration.Field{
+		{
+			Name:        "itemsPath",
+			Label:       "Items Path",
+			Type:        configuration.FieldTypeExpression,
+			Description: "Expression selecting the array to split",
+			Required:    true,
+		},
+		{
+			Name:        "maxItems",
+			Label:       "Max items",
+			Type:        configuration.FieldTypeNumber,
+			Description: "Maximum number of events emitted for a single execution",
+			Required:    false,
+			Default:     DefaultMaxItems,
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: &min,
+					Max: &max,
+				},
+			},
+		},
+	}
+}
+
+func decodeSpec(config any) (*Spec, error) {
+	spec := Spec{}
+	err := mapstructure.Decode(config, &spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if strings.TrimSpace(spec.ItemsPath) == "" {
+		return nil, fmt.Errorf("itemsPath is required")
+	}
+
+	if spec.MaxItems == 0 {
+		spec.MaxItems = DefaultMaxItems
+	}
+
+	if spec.MaxItems < 0 || spec.MaxItems > MaxItemsLimit {
+		return nil, fmt.Errorf("maxItems must be between 1 and %d, got: %d", MaxItemsLimit, spec.MaxItems)
+	}
+
+	return &spec, nil
+}
+
+func (s *Split) Setup(ctx core.SetupContext) error {
+	spec, err := decodeSpec(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	err = expressions.Validate(spec.ItemsPath)
+	if err != nil {
+		return fmt.Errorf("invalid itemsPath: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Split) Execute(ctx core.ExecutionContext) error {
+	spec, err := decodeSpec(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	env, err := expressions.Env(ctx.ExpressionEnv, ctx.Data, ctx.SourceNodeID, spec.ItemsPath)
+	if err != nil {
+		return err
+	}
+
+	vm, err := expr.Compile(spec.ItemsPath, expressions.Options(env)...)
+	if err != nil {
+		return fmt.Errorf("expression compilation failed: %w", err)
+	}
+
+	output, err := expressions.Run(vm, env)
+	if err != nil {
+		return fmt.Errorf("expression evaluation failed: %w", err)
+	}
+
+	items, err := toItems(output)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.Metadata.Set(ExecutionMetadata{ItemsPath: spec.ItemsPath, Count: len(items)})
+	if err != nil {
+		return fmt.Errorf("error setting metadata: %w", err)
+	}
+
+	if len(items) > spec.MaxItems {
+		return fmt.Errorf("itemsPath returned %d items, but at most %d are allowed", len(items), spec.MaxItems)
+	}
+
+	if len(items) == 0 {
+		return ctx.ExecutionState.Pass()
+	}
+
+	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, PayloadType, items)
+}
+
+/*
+ * Arrays built inside expressions and arrays coming from
+ * decoded event data have different Go types, so any slice is accepted.
+ */
+func toItems(output any) ([]any, error) {
+	if items, ok := output.([]any); ok {
+		return items, nil
+	}
+
+	value := reflect.ValueOf(output)
+	if !value.IsValid() || (value.Kind() != reflect.Slice && value.Kind() != reflect.Array) {
+		return nil, fmt.Errorf("itemsPath must evaluate to an array, got %T", output)
+	}
+
+	items := make([]any, value.Len())
+	for i := range items {
+		items[i] = value.Index(i).Interface()
+	}
+
+	return items, nil
+}
+
+func (s *Split) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (s *Split) HandleAction(ctx core.ActionContext) error {
+	return fmt.Errorf("split does not support actions")
+}
+
+func (s *Split) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (s *Split) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (s *Split) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (s *Split) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
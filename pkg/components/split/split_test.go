@@ -0,0 +1,111 @@
+package split
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func TestSplit_Execute(t *testing.T) {
+	s := &Split{}
+
+	t.Run("emits one event per element", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		metadataCtx := &contexts.MetadataContext{}
+
+		err := s.Execute(core.ExecutionContext{
+			Data: map[string]any{
+				"services": []any{
+					map[string]any{"name": "api"},
+					map[string]any{"name": "web"},
+					map[string]any{"name": "worker"},
+				},
+			},
+			Configuration:  map[string]any{"itemsPath": "$.services"},
+			Metadata:       metadataCtx,
+			ExecutionState: stateCtx,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, stateCtx.Passed)
+		assert.Equal(t, core.DefaultOutputChannel.Name, stateCtx.Channel)
+		assert.Equal(t, PayloadType, stateCtx.Type)
+		require.Len(t, stateCtx.Payloads, 3)
+		for i, name := range []string{"api", "web", "worker"} {
+			payload := stateCtx.Payloads[i].(map[string]any)
+			assert.Equal(t, map[string]any{"name": name}, payload["data"])
+		}
+
+		assert.Equal(t, ExecutionMetadata{ItemsPath: "$.services", Count: 3}, metadataCtx.Metadata)
+	})
+
+	t.Run("empty array passes without emitting", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+
+		err := s.Execute(core.ExecutionContext{
+			Data:           map[string]any{"services": []any{}},
+			Configuration:  map[string]any{"itemsPath": "$.services"},
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: stateCtx,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, stateCtx.Finished)
+		assert.True(t, stateCtx.Passed)
+		assert.Empty(t, stateCtx.Channel)
+		assert.Empty(t, stateCtx.Payloads)
+	})
+
+	t.Run("more elements than allowed -> error", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+
+		err := s.Execute(core.ExecutionContext{
+			Data:           map[string]any{"services": []any{"api", "web", "worker"}},
+			Configuration:  map[string]any{"itemsPath": "$.services", "maxItems": float64(2)},
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: stateCtx,
+		})
+
+		require.ErrorContains(t, err, "itemsPath returned 3 items, but at most 2 are allowed")
+		assert.False(t, stateCtx.Finished)
+		assert.Empty(t, stateCtx.Payloads)
+	})
+
+	t.Run("non-array value -> error", func(t *testing.T) {
+		err := s.Execute(core.ExecutionContext{
+			Data:           map[string]any{"services": "api"},
+			Configuration:  map[string]any{"itemsPath": "$.services"},
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		require.ErrorContains(t, err, "itemsPath must evaluate to an array, got string")
+	})
+}
+
+func TestSplit_Setup(t *testing.T) {
+	s := &Split{}
+
+	t.Run("missing itemsPath -> error", func(t *testing.T) {
+		err := s.Setup(core.SetupContext{Configuration: map[string]any{}})
+		require.ErrorContains(t, err, "itemsPath is required")
+	})
+
+	t.Run("invalid expression -> error", func(t *testing.T) {
+		err := s.Setup(core.SetupContext{Configuration: map[string]any{"itemsPath": "$.services["}})
+		require.ErrorContains(t, err, "invalid itemsPath")
+	})
+
+	t.Run("maxItems above the limit -> error", func(t *testing.T) {
+		err := s.Setup(core.SetupContext{Configuration: map[string]any{"itemsPath": "$.services", "maxItems": float64(5000)}})
+		require.ErrorContains(t, err, "maxItems must be between 1 and 1000")
+	})
+
+	t.Run("valid configuration", func(t *testing.T) {
+		err := s.Setup(core.SetupContext{Configuration: map[string]any{"itemsPath": "$.services"}})
+		require.NoError(t, err)
+	})
+}
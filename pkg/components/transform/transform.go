@@ -0,0 +1,261 @@
+package transform
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/expr-lang/expr"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/components/expressions"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const ComponentName = "transform"
+
+func init() {
+	registry.RegisterComponent(ComponentName, &Transform{})
+}
+
+type Spec struct {
+	Fields []Field `json:"fields" mapstructure:"fields"`
+}
+
+type Field struct {
+	Name       string `json:"name" mapstructure:"name"`
+	Expression string `json:"expression" mapstructure:"expression"`
+}
+
+type Transform struct{}
+
+func (t *Transform) Name() string {
+	return ComponentName
+}
+
+func (t *Transform) Label() string {
+	return "Transform"
+}
+
+func (t *Transform) Description() string {
+	return "Build a new payload from the incoming event"
+}
+
+func (t *Transform) Documentation() string {
+	return `The Transform component builds a new payload by evaluating one expression per output field against the incoming event.
+
+## Use Cases
+
+- **Payload shaping**: Keep only the fields the next steps need
+- **Data mapping**: Rename fields or combine values from several upstream nodes
+- **Computed values**: Derive new values, like totals or flags, from the event data
+
+## How It Works
+
+1. Each configured field has a name and an expression
+2. The expressions are evaluated against the incoming event data
+3. The results are emitted as a single payload, keyed by field name, on the default output channel
+
+If an expression fails to evaluate, the execution fails with the offending expression in the error message.
+Expressions are limited to 1000 nodes, and their evaluation to a budget of 1,000,000 allocated items, which also bounds loops over large arrays.
+The resulting payload cannot exceed 4KB.
+
+## Expression Environment
+
+The expressions have access to:
+- **$**: The run context data
+- **root()**: Access to the root event data
+- **previous()**: Access to previous node outputs (optionally with depth parameter)
+
+## Examples
+
+- **status** = ` + "`$[\"Node Name\"].data.status`" + `: Copy a field from an upstream node
+- **total** = ` + "`$[\"Node Name\"].data.price * $[\"Node Name\"].data.quantity`" + `: Compute a new value
+- **ref** = ` + "`root().data.ref`" + `: Copy a field from the root event`
+}
+
+func (t *Transform) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (t *Transform) Icon() string {
+	return "shuffle"
+}
+
+func (t *Transform) Color() string {
+	return "blue"
+}
+
+func (t *Transform) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "fields",
+			Label:       "Fields",
+			Type:        configuration.FieldTypeList,
+			Description: "Fields of the new payload, and the expressions used to compute them",
+			Required:    true,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Field",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:               "name",
+								Label:              "Name",
+								Type:               configuration.FieldTypeString,
+								Required:           true,
+								Placeholder:        "status",
+								DisallowExpression: true,
+							},
+							{
+								Name:        "expression",
+								Label:       "Expression",
+								Type:        configuration.FieldTypeExpression,
+								Required:    true,
+								Placeholder: `$["Node Name"].data.status`,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (t *Transform) Execute(ctx core.ExecutionContext) error {
+	spec, err := decodeSpec(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	output := make(map[string]any, len(spec.Fields))
+	for _, field := range spec.Fields {
+		env, err := expressions.Env(ctx.ExpressionEnv, ctx.Data, ctx.SourceNodeID, field.Expression)
+		if err != nil {
+			return err
+		}
+
+		value, err := evaluate(field.Expression, env)
+		if err != nil {
+			return fmt.Errorf("field '%s': %w", field.Name, err)
+		}
+
+		output[field.Name] = value
+	}
+
+	size, err := payloadSize(output)
+	if err != nil {
+		return err
+	}
+
+	if size > core.MaxExecutionOutputsSize {
+		return fmt.Errorf("transformed payload is %d bytes, above the limit of %d bytes", size, core.MaxExecutionOutputsSize)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"transform.executed",
+		[]any{output},
+	)
+}
+
+func decodeSpec(config any) (Spec, error) {
+	spec := Spec{}
+	err := mapstructure.Decode(config, &spec)
+	if err != nil {
+		return spec, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	return spec, nil
+}
+
+func payloadSize(payload map[string]any) (int, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return 0, fmt.Errorf("transformed payload is not valid JSON: %w", err)
+	}
+
+	return len(data), nil
+}
+
+func evaluate(expression string, env map[string]any) (any, error) {
+	program, err := expr.Compile(expression, expressions.Options(env)...)
+	if err != nil {
+		return nil, fmt.Errorf("expression %q compilation failed: %w", expression, err)
+	}
+
+	value, err := expressions.Run(program, env)
+	if err != nil {
+		return nil, fmt.Errorf("expression %q evaluation failed: %w", expression, err)
+	}
+
+	return value, nil
+}
+
+func (t *Transform) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (t *Transform) HandleAction(ctx core.ActionContext) error {
+	return fmt.Errorf("transform does not support actions")
+}
+
+/*
+ * Expressions are compiled on setup,
+ * so syntax errors are reported before any event reaches the node.
+ */
+func (t *Transform) Setup(ctx core.SetupContext) error {
+	spec, err := decodeSpec(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	if len(spec.Fields) == 0 {
+		return fmt.Errorf("at least one field is required")
+	}
+
+	names := make(map[string]bool, len(spec.Fields))
+	for _, field := range spec.Fields {
+		name := strings.TrimSpace(field.Name)
+		if name == "" {
+			return fmt.Errorf("field name is required")
+		}
+
+		if names[name] {
+			return fmt.Errorf("field '%s' is defined more than once", name)
+		}
+
+		names[name] = true
+
+		if strings.TrimSpace(field.Expression) == "" {
+			return fmt.Errorf("field '%s': expression is required", name)
+		}
+
+		err := expressions.Validate(field.Expression)
+		if err != nil {
+			return fmt.Errorf("field '%s': invalid expression %q: %w", name, field.Expression, err)
+		}
+	}
+
+	return nil
+}
+
+func (t *Transform) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (t *Transform) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (t *Transform) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (t *Transform) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
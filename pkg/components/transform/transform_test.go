@@ -0,0 +1,120 @@
+package transform
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func fields(pairs ...string) map[string]any {
+	items := []any{}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		items = append(items, map[string]any{"name": pairs[i], "expression": pairs[i+1]})
+	}
+
+	return map[string]any{"fields": items}
+}
+
+func TestTransform_Execute(t *testing.T) {
+	transform := &Transform{}
+
+	t.Run("builds payload from expressions", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		err := transform.Execute(core.ExecutionContext{
+			Data: map[string]any{"status": "active", "price": 10, "quantity": 3},
+			Configuration: fields(
+				"status", "$.status",
+				"total", "$.price * $.quantity",
+				"active", `$.status == "active"`,
+			),
+			ExecutionState: stateCtx,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, stateCtx.Passed)
+		assert.Equal(t, core.DefaultOutputChannel.Name, stateCtx.Channel)
+		assert.Equal(t, "transform.executed", stateCtx.Type)
+		require.Len(t, stateCtx.Payloads, 1)
+
+		payload := stateCtx.Payloads[0].(map[string]any)
+		assert.Equal(t, map[string]any{"status": "active", "total": 30, "active": true}, payload["data"])
+	})
+
+	t.Run("uses expression environment from context", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		err := transform.Execute(core.ExecutionContext{
+			Configuration: fields(
+				"ref", "root().data.ref",
+				"id", `$["Build"].data.id`,
+			),
+			ExecutionState: stateCtx,
+			ExpressionEnv: func(expression string) (map[string]any, error) {
+				return map[string]any{
+					"$":      map[string]any{"Build": map[string]any{"data": map[string]any{"id": "b-1"}}},
+					"__root": map[string]any{"data": map[string]any{"ref": "main"}},
+				}, nil
+			},
+		})
+
+		require.NoError(t, err)
+		payload := stateCtx.Payloads[0].(map[string]any)
+		assert.Equal(t, map[string]any{"ref": "main", "id": "b-1"}, payload["data"])
+	})
+
+	t.Run("evaluation error includes field and expression", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		err := transform.Execute(core.ExecutionContext{
+			Data:           map[string]any{},
+			Configuration:  fields("ref", "root().data.ref"),
+			ExecutionState: stateCtx,
+		})
+
+		assert.ErrorContains(t, err, `field 'ref': expression "root().data.ref" evaluation failed`)
+		assert.ErrorContains(t, err, "no root event found")
+		assert.False(t, stateCtx.Finished)
+	})
+
+	t.Run("payload above size limit -> error", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		err := transform.Execute(core.ExecutionContext{
+			Data:           map[string]any{"text": strings.Repeat("a", core.MaxExecutionOutputsSize)},
+			Configuration:  fields("text", "$.text"),
+			ExecutionState: stateCtx,
+		})
+
+		assert.ErrorContains(t, err, "above the limit of 4096 bytes")
+		assert.False(t, stateCtx.Finished)
+	})
+}
+
+func TestTransform_Setup(t *testing.T) {
+	transform := &Transform{}
+
+	t.Run("valid fields", func(t *testing.T) {
+		err := transform.Setup(core.SetupContext{
+			Configuration: fields("status", `$["Node"].data.status`, "previous", "previous(2)"),
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("no fields -> error", func(t *testing.T) {
+		err := transform.Setup(core.SetupContext{Configuration: map[string]any{}})
+		assert.ErrorContains(t, err, "at least one field is required")
+	})
+
+	t.Run("duplicate field -> error", func(t *testing.T) {
+		err := transform.Setup(core.SetupContext{Configuration: fields("a", "1", "a", "2")})
+		assert.ErrorContains(t, err, "field 'a' is defined more than once")
+	})
+
+	t.Run("invalid expression -> error", func(t *testing.T) {
+		err := transform.Setup(core.SetupContext{Configuration: fields("a", "1 +++")})
+		assert.ErrorContains(t, err, `field 'a': invalid expression "1 +++"`)
+	})
+}
@@ -1,15 +1,19 @@
 package wait
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/expr-lang/expr"
 	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/components/expressions"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/registry"
@@ -24,22 +28,41 @@ func init() {
 type Wait struct{}
 
 type Spec struct {
-	Mode      string  `json:"mode"`
-	WaitFor   any     `json:"waitFor"`
-	Unit      *string `json:"unit"`
-	WaitUntil any     `json:"waitUntil"`
+	Mode        string  `json:"mode"`
+	WaitFor     any     `json:"waitFor"`
+	Unit        *string `json:"unit"`
+	WaitUntil   any     `json:"waitUntil"`
+	Until       any     `json:"until"`
+	PassThrough bool    `json:"passThrough"`
 }
 
+/*
+ * The resume time is used to split long waits into several action calls.
+ * Executions passing their input through keep it in an attachment,
+ * since it can be larger than what the metadata allows.
+ */
 type ExecutionMetadata struct {
 	StartTime        string `json:"start_time" mapstructure:"start_time"`
 	IntervalDuration int64  `json:"interval_duration" mapstructure:"interval_duration"` // Duration in milliseconds
+	ResumeAt         string `json:"resume_at,omitempty" mapstructure:"resume_at"`
+	PassThrough      bool   `json:"pass_through,omitempty" mapstructure:"pass_through"`
 }
 
 const (
 	ModeInterval  = "interval"
 	ModeCountdown = "countdown"
+	ModeUntil     = "until"
 )
 
+const InputAttachmentName = "input"
+
+/*
+ * Long waits are split into several action calls,
+ * each one re-scheduling the next until the resume time is reached.
+ * That way, a single scheduled call never has to survive for days.
+ */
+const MaxActionInterval = time.Hour
+
 func (w *Wait) Name() string {
 	return "wait"
 }
@@ -73,16 +96,24 @@ func (w *Wait) Documentation() string {
   - Supports expressions for dynamic target times
   - Example: ` + "`{{$.release_date}}`" + ` or ` + "`{{$.run_time + duration(\"48h\")}}`" + `
 
+- **Until**: Wait until a timestamp, like ` + "`2026-01-02T02:00:00Z`" + `
+  - Supports expressions evaluated against the incoming payload, like ` + "`$.deploy_at`" + ` or ` + "`{{ root().data.deploy_at }}`" + `
+  - If the time is already in the past, the execution finishes right away
+
 ## Behavior
 
 - Execution pauses until the wait period completes
+- The resume time is stored in the execution metadata, and shown as a countdown
 - Can be manually pushed through using the "Push Through" action
 - Automatically resumes when the wait time expires
 - Emits metadata including start time, finish time, and result
 
 ## Output
 
-The component emits a payload with:
+With **Pass through input** enabled, the payload received by the component is emitted unchanged
+when the wait completes, or is pushed through.
+
+Otherwise, the component emits a payload with:
 - **started_at**: When the wait began
 - **finished_at**: When the wait completed
 - **result**: Completion status (completed, cancelled)
@@ -120,6 +151,10 @@ func (w *Wait) Configuration() []configuration.Field {
 							Label: "Countdown",
 							Value: ModeCountdown,
 						},
+						{
+							Label: "Until",
+							Value: ModeUntil,
+						},
 					},
 				},
 			},
@@ -180,6 +215,26 @@ func (w *Wait) Configuration() []configuration.Field {
 				{Field: "mode", Values: []string{ModeCountdown}},
 			},
 		},
+		{
+			Name:        "until",
+			Label:       "Until",
+			Type:        configuration.FieldTypeString,
+			Description: "Timestamp to wait until, or an expression evaluated against the incoming payload, like $.deploy_at",
+			Placeholder: "2026-01-02T02:00:00Z",
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{Field: "mode", Values: []string{ModeUntil}},
+			},
+			RequiredConditions: []configuration.RequiredCondition{
+				{Field: "mode", Values: []string{ModeUntil}},
+			},
+		},
+		{
+			Name:        "passThrough",
+			Label:       "Pass through input",
+			Type:        configuration.FieldTypeBool,
+			Description: "Emit the received payload unchanged when the wait completes, instead of the wait details",
+			Default:     true,
+		},
 	}
 }
 
@@ -262,6 +317,50 @@ func calculateIntervalDuration(value int, unit string) (time.Duration, error) {
 	}
 }
 
+/*
+ * The until value is either a timestamp, possibly resolved from a {{ }} expression,
+ * or an expression evaluated against the incoming payload.
+ */
+func resolveUntil(ctx core.ExecutionContext, value any) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		v = strings.TrimSpace(v)
+		if v == "" {
+			return time.Time{}, errors.New("until is required for until mode")
+		}
+
+		if t, err := parseDateValue(v); err == nil {
+			return t, nil
+		}
+
+		env, err := expressions.Env(ctx.ExpressionEnv, ctx.Data, ctx.SourceNodeID, v)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		program, err := expr.Compile(v, expressions.Options(env)...)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid until value '%s': use a timestamp, like 2026-01-02T02:00:00Z, or an expression: %v", v, err)
+		}
+
+		output, err := expressions.Run(program, env)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("until expression evaluation failed for '%s': %v", v, err)
+		}
+
+		t, err := parseDateValue(output)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("until expression '%s' must evaluate to a date/time: %v", v, err)
+		}
+
+		return t, nil
+	default:
+		return time.Time{}, errors.New("until is required for until mode")
+	}
+}
+
 func createPayload(startedAt, finishedAt, result, reason string, actor *core.User) map[string]any {
 	output := map[string]any{
 		"started_at":  startedAt,
@@ -336,21 +435,61 @@ func (w *Wait) Execute(ctx core.ExecutionContext) error {
 			return fmt.Errorf("Target time %s is in the past", targetTime.Format(time.RFC3339))
 		}
 
+	case ModeUntil:
+
+		targetTime, err := resolveUntil(ctx, spec.Until)
+		if err != nil {
+			return err
+		}
+
+		interval = time.Until(targetTime)
+		if interval <= 0 {
+			if spec.PassThrough {
+				return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, PayloadType, []any{ctx.Data})
+			}
+
+			return ctx.ExecutionState.Emit(
+				core.DefaultOutputChannel.Name,
+				PayloadType,
+				[]any{createPayload(startTime, startTime, "completed", "timeout", nil)},
+			)
+		}
+
 	default:
-		return fmt.Errorf("Invalid mode: %s. Must be either '%s' or '%s'", spec.Mode, ModeInterval, ModeCountdown)
+		return fmt.Errorf("Invalid mode: %s. Must be one of '%s', '%s' or '%s'", spec.Mode, ModeInterval, ModeCountdown, ModeUntil)
+	}
+
+	if spec.PassThrough {
+		input, err := json.Marshal(ctx.Data)
+		if err != nil {
+			return fmt.Errorf("failed to marshal input: %v", err)
+		}
+
+		err = ctx.Attachments.Put(InputAttachmentName, input)
+		if err != nil {
+			return fmt.Errorf("failed to store input: %v", err)
+		}
 	}
 
 	// Store start time and calculated interval duration in metadata
+	resumeAt := time.Now().Add(interval)
 	err = ctx.Metadata.Set(ExecutionMetadata{
 		StartTime:        startTime,
 		IntervalDuration: interval.Milliseconds(),
+		ResumeAt:         resumeAt.UTC().Format(time.RFC3339Nano),
+		PassThrough:      spec.PassThrough,
 	})
 
 	if err != nil {
 		return err
 	}
 
-	return ctx.Requests.ScheduleActionCall("timeReached", map[string]any{}, interval)
+	return scheduleNextCall(ctx.Requests, interval)
+}
+
+func scheduleNextCall(requests core.RequestContext, interval time.Duration) error {
+	interval = min(max(interval, time.Second), MaxActionInterval)
+	return requests.ScheduleActionCall("timeReached", map[string]any{}, interval)
 }
 
 func (w *Wait) Actions() []core.Action {
@@ -378,11 +517,36 @@ func (w *Wait) HandleAction(ctx core.ActionContext) error {
 	}
 }
 
+/*
+ * Executions started before the resume time
+ * was stored in the metadata do not have one, and finish right away.
+ */
 func (w *Wait) HandleTimeReached(ctx core.ActionContext) error {
 	if ctx.ExecutionState.IsFinished() {
 		return nil
 	}
 
+	metadata := ExecutionMetadata{}
+	err := mapstructure.Decode(ctx.Metadata.Get(), &metadata)
+	if err != nil {
+		return fmt.Errorf("failed to decode metadata: %v", err)
+	}
+
+	if metadata.ResumeAt != "" {
+		resumeAt, err := time.Parse(time.RFC3339Nano, metadata.ResumeAt)
+		if err != nil {
+			return fmt.Errorf("invalid resume time '%s': %v", metadata.ResumeAt, err)
+		}
+
+		if time.Now().Before(resumeAt) {
+			return scheduleNextCall(ctx.Requests, time.Until(resumeAt))
+		}
+	}
+
+	if metadata.PassThrough {
+		return emitInput(ctx)
+	}
+
 	return ctx.ExecutionState.Emit(
 		core.DefaultOutputChannel.Name,
 		PayloadType,
@@ -403,6 +567,16 @@ func (w *Wait) HandlePushThrough(ctx core.ActionContext) error {
 		return nil
 	}
 
+	metadata := ExecutionMetadata{}
+	err := mapstructure.Decode(ctx.Metadata.Get(), &metadata)
+	if err != nil {
+		return fmt.Errorf("failed to decode metadata: %v", err)
+	}
+
+	if metadata.PassThrough {
+		return emitInput(ctx)
+	}
+
 	return ctx.ExecutionState.Emit(
 		core.DefaultOutputChannel.Name,
 		PayloadType,
@@ -418,7 +592,55 @@ func (w *Wait) HandlePushThrough(ctx core.ActionContext) error {
 	)
 }
 
+func emitInput(ctx core.ActionContext) error {
+	data, err := ctx.Attachments.Get(InputAttachmentName)
+	if err != nil {
+		return fmt.Errorf("failed to load input: %v", err)
+	}
+
+	var input any
+	err = json.Unmarshal(data, &input)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal input: %v", err)
+	}
+
+	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, PayloadType, []any{input})
+}
+
+/*
+ * Expressions are only resolved on execution,
+ * so they are only checked for syntax errors here.
+ */
 func (w *Wait) Setup(ctx core.SetupContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return fmt.Errorf("Failed to decode configuration: %v", err)
+	}
+
+	if spec.Mode != ModeUntil {
+		return nil
+	}
+
+	until, ok := spec.Until.(string)
+	if !ok || strings.TrimSpace(until) == "" {
+		return errors.New("until is required for until mode")
+	}
+
+	until = strings.TrimSpace(until)
+	if strings.Contains(until, "{{") {
+		return nil
+	}
+
+	if _, err := parseDateValue(until); err == nil {
+		return nil
+	}
+
+	err = expressions.Validate(until)
+	if err != nil {
+		return fmt.Errorf("invalid until value '%s': use a timestamp, like 2026-01-02T02:00:00Z, or an expression: %v", until, err)
+	}
+
 	return nil
 }
 
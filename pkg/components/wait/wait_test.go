@@ -643,3 +643,237 @@ func TestWait_Cancel_CompletionOutput(t *testing.T) {
 	assert.Equal(t, "alex@company.com", actor["email"])
 	assert.Equal(t, "Aleksandar Mitrović", actor["display_name"])
 }
+
+func TestWait_Execute_PassThrough(t *testing.T) {
+	w := &Wait{}
+	input := map[string]any{"version": "1.2.3", "tags": []any{"stable"}}
+
+	t.Run("execution stays started until the time is reached, then emits the input", func(t *testing.T) {
+		requestCtx := &contexts.RequestContext{}
+		metadataCtx := &contexts.MetadataContext{}
+		stateCtx := &contexts.ExecutionStateContext{}
+		attachmentCtx := &contexts.AttachmentContext{}
+
+		err := w.Execute(core.ExecutionContext{
+			Data:           input,
+			Configuration:  map[string]any{"mode": ModeInterval, "waitFor": 5, "unit": "minutes", "passThrough": true},
+			Requests:       requestCtx,
+			Metadata:       metadataCtx,
+			ExecutionState: stateCtx,
+			Attachments:    attachmentCtx,
+		})
+
+		require.NoError(t, err)
+		assert.False(t, stateCtx.Finished)
+		assert.Equal(t, "timeReached", requestCtx.Action)
+
+		metadata := metadataCtx.Metadata.(ExecutionMetadata)
+		assert.True(t, metadata.PassThrough)
+
+		metadata.ResumeAt = time.Now().Add(-time.Second).Format(time.RFC3339Nano)
+		err = w.HandleAction(core.ActionContext{
+			Name:           "timeReached",
+			Metadata:       &contexts.MetadataContext{Metadata: metadata},
+			ExecutionState: stateCtx,
+			Requests:       &contexts.RequestContext{},
+			Attachments:    attachmentCtx,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, stateCtx.Passed)
+		assert.Equal(t, PayloadType, stateCtx.Type)
+		require.Len(t, stateCtx.Payloads, 1)
+		assert.Equal(t, input, stateCtx.Payloads[0].(map[string]any)["data"])
+	})
+
+	t.Run("push through emits the input", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+
+		err := w.HandleAction(core.ActionContext{
+			Name:           "pushThrough",
+			Metadata:       &contexts.MetadataContext{Metadata: ExecutionMetadata{PassThrough: true}},
+			ExecutionState: stateCtx,
+			Attachments: &contexts.AttachmentContext{
+				Attachments: map[string][]byte{InputAttachmentName: []byte(`{"version":"1.2.3"}`)},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, stateCtx.Passed)
+		require.Len(t, stateCtx.Payloads, 1)
+		assert.Equal(t, map[string]any{"version": "1.2.3"}, stateCtx.Payloads[0].(map[string]any)["data"])
+	})
+}
+
+func TestWait_Execute_UntilMode(t *testing.T) {
+	w := &Wait{}
+
+	t.Run("future time -> schedules the call", func(t *testing.T) {
+		requestCtx := &contexts.RequestContext{}
+		stateCtx := &contexts.ExecutionStateContext{}
+
+		err := w.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"mode": ModeUntil, "until": time.Now().Add(30 * time.Minute).Format(time.RFC3339)},
+			Requests:       requestCtx,
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: stateCtx,
+		})
+
+		require.NoError(t, err)
+		assert.False(t, stateCtx.Finished)
+		assert.Equal(t, "timeReached", requestCtx.Action)
+		assert.True(t, requestCtx.Duration > 29*time.Minute)
+		assert.True(t, requestCtx.Duration <= 30*time.Minute)
+	})
+
+	t.Run("past time -> finishes right away", func(t *testing.T) {
+		requestCtx := &contexts.RequestContext{}
+		stateCtx := &contexts.ExecutionStateContext{}
+
+		err := w.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"mode": ModeUntil, "until": "2020-01-01T00:00:00Z"},
+			Requests:       requestCtx,
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: stateCtx,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, requestCtx.Action)
+		assert.True(t, stateCtx.Passed)
+		require.Len(t, stateCtx.Payloads, 1)
+		output := stateCtx.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, "completed", output["result"])
+		assert.Equal(t, "timeout", output["reason"])
+	})
+
+	t.Run("past time with pass through -> emits the input right away", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		input := map[string]any{"version": "1.2.3"}
+
+		err := w.Execute(core.ExecutionContext{
+			Data:           input,
+			Configuration:  map[string]any{"mode": ModeUntil, "until": "2020-01-01T00:00:00Z", "passThrough": true},
+			Requests:       &contexts.RequestContext{},
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: stateCtx,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, stateCtx.Passed)
+		require.Len(t, stateCtx.Payloads, 1)
+		assert.Equal(t, input, stateCtx.Payloads[0].(map[string]any)["data"])
+	})
+
+	t.Run("expression evaluated from the input", func(t *testing.T) {
+		requestCtx := &contexts.RequestContext{}
+		stateCtx := &contexts.ExecutionStateContext{}
+
+		err := w.Execute(core.ExecutionContext{
+			Data:           map[string]any{"deploy_at": time.Now().Add(30 * time.Minute).Format(time.RFC3339)},
+			Configuration:  map[string]any{"mode": ModeUntil, "until": "$.deploy_at"},
+			Requests:       requestCtx,
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: stateCtx,
+		})
+
+		require.NoError(t, err)
+		assert.False(t, stateCtx.Finished)
+		assert.Equal(t, "timeReached", requestCtx.Action)
+		assert.True(t, requestCtx.Duration > 29*time.Minute)
+		assert.True(t, requestCtx.Duration <= 30*time.Minute)
+	})
+
+	t.Run("expression not evaluating to a time -> error", func(t *testing.T) {
+		err := w.Execute(core.ExecutionContext{
+			Data:           map[string]any{"deploy_at": "soon"},
+			Configuration:  map[string]any{"mode": ModeUntil, "until": "$.deploy_at"},
+			Requests:       &contexts.RequestContext{},
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		require.ErrorContains(t, err, "must evaluate to a date/time")
+	})
+
+	t.Run("invalid until -> error", func(t *testing.T) {
+		err := w.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"mode": ModeUntil, "until": "tomorrow"},
+			Requests:       &contexts.RequestContext{},
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		require.ErrorContains(t, err, "invalid until value 'tomorrow'")
+	})
+}
+
+func TestWait_HandleTimeReached_ResumeTime(t *testing.T) {
+	w := &Wait{}
+
+	t.Run("resume time not reached yet -> schedules the next call", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		requestCtx := &contexts.RequestContext{}
+
+		err := w.HandleAction(core.ActionContext{
+			Name: "timeReached",
+			Metadata: &contexts.MetadataContext{
+				Metadata: ExecutionMetadata{ResumeAt: time.Now().Add(3 * time.Hour).Format(time.RFC3339Nano)},
+			},
+			ExecutionState: stateCtx,
+			Requests:       requestCtx,
+		})
+
+		require.NoError(t, err)
+		assert.False(t, stateCtx.Finished)
+		assert.Equal(t, "timeReached", requestCtx.Action)
+		assert.Equal(t, MaxActionInterval, requestCtx.Duration)
+	})
+
+	t.Run("resume time reached -> finishes", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		requestCtx := &contexts.RequestContext{}
+
+		err := w.HandleAction(core.ActionContext{
+			Name: "timeReached",
+			Metadata: &contexts.MetadataContext{
+				Metadata: ExecutionMetadata{ResumeAt: time.Now().Add(-time.Second).Format(time.RFC3339Nano)},
+			},
+			ExecutionState: stateCtx,
+			Requests:       requestCtx,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, requestCtx.Action)
+		assert.True(t, stateCtx.Passed)
+		assert.Equal(t, PayloadType, stateCtx.Type)
+	})
+}
+
+func TestWait_Setup(t *testing.T) {
+	w := &Wait{}
+
+	tests := []struct {
+		name          string
+		configuration map[string]any
+		errorMsg      string
+	}{
+		{name: "interval", configuration: map[string]any{"mode": ModeInterval, "waitFor": "{{ $.wait }}", "unit": "seconds"}},
+		{name: "until timestamp", configuration: map[string]any{"mode": ModeUntil, "until": "2030-01-01T02:00:00Z"}},
+		{name: "until expression", configuration: map[string]any{"mode": ModeUntil, "until": "{{ root().data.deploy_at }}"}},
+		{name: "until payload expression", configuration: map[string]any{"mode": ModeUntil, "until": "$.deploy_at"}},
+		{name: "invalid until expression", configuration: map[string]any{"mode": ModeUntil, "until": "$.deploy_at +"}, errorMsg: "invalid until value"},
+		{name: "missing until", configuration: map[string]any{"mode": ModeUntil}, errorMsg: "until is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := w.Setup(core.SetupContext{Configuration: tt.configuration})
+			if tt.errorMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 )
 
 func RabbitMQURL() (string, error) {
@@ -13,3 +15,195 @@ func RabbitMQURL() (string, error) {
 
 	return URL, nil
 }
+
+const DefaultExecutionLogsRetentionDays = 30
+
+func ExecutionLogsRetentionDays() (int, error) {
+	value := os.Getenv("EXECUTION_LOGS_RETENTION_DAYS")
+	if value == "" {
+		return DefaultExecutionLogsRetentionDays, nil
+	}
+
+	days, err := strconv.Atoi(value)
+	if err != nil || days <= 0 {
+		return 0, fmt.Errorf("invalid EXECUTION_LOGS_RETENTION_DAYS: %s", value)
+	}
+
+	return days, nil
+}
+
+const DefaultAuditEventsRetentionDays = 365
+
+func AuditEventsRetentionDays() (int, error) {
+	value := os.Getenv("AUDIT_EVENTS_RETENTION_DAYS")
+	if value == "" {
+		return DefaultAuditEventsRetentionDays, nil
+	}
+
+	days, err := strconv.Atoi(value)
+	if err != nil || days <= 0 {
+		return 0, fmt.Errorf("invalid AUDIT_EVENTS_RETENTION_DAYS: %s", value)
+	}
+
+	return days, nil
+}
+
+const DefaultMaxRequestBodyBytes = 1024 * 1024
+
+func MaxRequestBodyBytes() (int64, error) {
+	value := os.Getenv("API_MAX_REQUEST_BODY_BYTES")
+	if value == "" {
+		return DefaultMaxRequestBodyBytes, nil
+	}
+
+	limit, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || limit <= 0 {
+		return 0, fmt.Errorf("invalid API_MAX_REQUEST_BODY_BYTES: %s", value)
+	}
+
+	return limit, nil
+}
+
+/*
+ * Rate limiting of the public event endpoints is opt-in:
+ * operators enable it by setting EVENTS_RATE_LIMIT.
+ */
+const (
+	DefaultEventsRateLimit      = 0.0
+	DefaultEventsRateLimitBurst = 50
+)
+
+/*
+ * Number of requests per second accepted for each webhook
+ * and each integration on the public event endpoints,
+ * set with EVENTS_RATE_LIMIT. Zero, the default, disables rate limiting.
+ */
+func EventsRateLimit() (float64, error) {
+	value := os.Getenv("EVENTS_RATE_LIMIT")
+	if value == "" {
+		return DefaultEventsRateLimit, nil
+	}
+
+	limit, err := strconv.ParseFloat(value, 64)
+	if err != nil || limit < 0 {
+		return 0, fmt.Errorf("invalid EVENTS_RATE_LIMIT: %s", value)
+	}
+
+	return limit, nil
+}
+
+/*
+ * Number of requests accepted at once for each webhook
+ * and each integration, before the rate limit applies,
+ * set with EVENTS_RATE_LIMIT_BURST. Only used when EVENTS_RATE_LIMIT is set.
+ */
+func EventsRateLimitBurst() (int, error) {
+	value := os.Getenv("EVENTS_RATE_LIMIT_BURST")
+	if value == "" {
+		return DefaultEventsRateLimitBurst, nil
+	}
+
+	burst, err := strconv.Atoi(value)
+	if err != nil || burst <= 0 {
+		return 0, fmt.Errorf("invalid EVENTS_RATE_LIMIT_BURST: %s", value)
+	}
+
+	return burst, nil
+}
+
+/*
+ * Whether the public API serves the Prometheus metrics on /metrics.
+ * The dedicated metrics server (START_METRICS_SERVER) is not affected by it.
+ */
+func MetricsEnabled() bool {
+	return os.Getenv("METRICS_ENABLED") == "yes"
+}
+
+/*
+ * Basic auth credentials required to scrape /metrics on the public API.
+ * The public API does not start if metrics are enabled without them.
+ */
+func MetricsBasicAuth() (string, string) {
+	return os.Getenv("METRICS_USERNAME"), os.Getenv("METRICS_PASSWORD")
+}
+
+const (
+	DefaultWebhookMaxBodyBytes = 64 * 1024
+	WebhookMaxBodyBytesCeiling = 1024 * 1024
+)
+
+/*
+ * Default body size limit for webhook requests.
+ * Webhooks and the nodes using them can raise it,
+ * but never above WebhookMaxBodyBytesCeiling.
+ */
+func WebhookMaxBodyBytes() (int64, error) {
+	value := os.Getenv("WEBHOOK_MAX_BODY_BYTES")
+	if value == "" {
+		return DefaultWebhookMaxBodyBytes, nil
+	}
+
+	limit, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || limit <= 0 || limit > WebhookMaxBodyBytesCeiling {
+		return 0, fmt.Errorf("invalid WEBHOOK_MAX_BODY_BYTES: %s", value)
+	}
+
+	return limit, nil
+}
+
+const (
+	DefaultWebsocketMaxConnectionsPerUser   = 20
+	DefaultWebsocketMaxConnectionsPerCanvas = 200
+	WebsocketLimitPolicyEvictOldest         = "evict-oldest"
+	WebsocketLimitPolicyRefuse              = "refuse"
+)
+
+/*
+ * Origins allowed to open websocket connections,
+ * in addition to the origin of the base URL.
+ */
+func WebsocketAllowedOrigins() []string {
+	origins := []string{}
+	for _, origin := range strings.Split(os.Getenv("WEBSOCKET_ALLOWED_ORIGINS"), ",") {
+		origin = strings.TrimSpace(origin)
+		if origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+
+	return origins
+}
+
+func WebsocketMaxConnectionsPerUser() (int, error) {
+	return connectionLimit("WEBSOCKET_MAX_CONNECTIONS_PER_USER", DefaultWebsocketMaxConnectionsPerUser)
+}
+
+func WebsocketMaxConnectionsPerCanvas() (int, error) {
+	return connectionLimit("WEBSOCKET_MAX_CONNECTIONS_PER_CANVAS", DefaultWebsocketMaxConnectionsPerCanvas)
+}
+
+func WebsocketLimitPolicy() (string, error) {
+	value := os.Getenv("WEBSOCKET_LIMIT_POLICY")
+	switch value {
+	case "":
+		return WebsocketLimitPolicyEvictOldest, nil
+	case WebsocketLimitPolicyEvictOldest, WebsocketLimitPolicyRefuse:
+		return value, nil
+	default:
+		return "", fmt.Errorf("invalid WEBSOCKET_LIMIT_POLICY: %s", value)
+	}
+}
+
+func connectionLimit(name string, defaultValue int) (int, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 0 {
+		return 0, fmt.Errorf("invalid %s: %s", name, value)
+	}
+
+	return limit, nil
+}
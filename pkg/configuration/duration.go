@@ -0,0 +1,102 @@
+package configuration
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+/*
+ * ParseDuration parses the value of a duration field.
+ * Strings use Go duration syntax, e.g. "90s", "5m" or "1h30m".
+ * Numbers are treated as seconds, to keep supporting
+ * the number fields used for durations before.
+ */
+func ParseDuration(value any) (time.Duration, error) {
+	var duration time.Duration
+
+	switch v := value.(type) {
+	case string:
+		d, err := time.ParseDuration(strings.TrimSpace(v))
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration '%s': use a format like 30s, 5m or 1h30m", v)
+		}
+
+		duration = d
+	case float64:
+		duration = time.Duration(v * float64(time.Second))
+	case int:
+		duration = time.Duration(v) * time.Second
+	case int32:
+		duration = time.Duration(v) * time.Second
+	case int64:
+		duration = time.Duration(v) * time.Second
+	default:
+		return 0, fmt.Errorf("must be a duration string or a number of seconds")
+	}
+
+	if duration < 0 {
+		return 0, fmt.Errorf("duration cannot be negative")
+	}
+
+	return duration, nil
+}
+
+/*
+ * DecodeDuration reads a duration from a component configuration.
+ * If the duration field is not set, the legacy field, holding a number
+ * of seconds, is used. Zero is returned if neither of them is set.
+ */
+func DecodeDuration(config any, fieldName, legacyFieldName string) (time.Duration, error) {
+	values, ok := config.(map[string]any)
+	if !ok {
+		return 0, nil
+	}
+
+	if value, ok := values[fieldName]; ok && value != nil && value != "" {
+		d, err := ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", fieldName, err)
+		}
+
+		return d, nil
+	}
+
+	if legacyFieldName == "" {
+		return 0, nil
+	}
+
+	value, ok := values[legacyFieldName]
+	if !ok || value == nil {
+		return 0, nil
+	}
+
+	d, err := ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", legacyFieldName, err)
+	}
+
+	return d, nil
+}
+
+func validateDuration(field Field, value any) error {
+	duration, err := ParseDuration(value)
+	if err != nil {
+		return err
+	}
+
+	if field.TypeOptions == nil || field.TypeOptions.Duration == nil {
+		return nil
+	}
+
+	options := field.TypeOptions.Duration
+	if options.Min != nil && duration < time.Duration(*options.Min)*time.Second {
+		return fmt.Errorf("must be at least %s", time.Duration(*options.Min)*time.Second)
+	}
+
+	if options.Max != nil && duration > time.Duration(*options.Max)*time.Second {
+		return fmt.Errorf("must be at most %s", time.Duration(*options.Max)*time.Second)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package configuration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected time.Duration
+	}{
+		{name: "seconds", value: "90s", expected: 90 * time.Second},
+		{name: "minutes", value: "5m", expected: 5 * time.Minute},
+		{name: "hours and minutes", value: "1h30m", expected: 90 * time.Minute},
+		{name: "surrounding whitespace", value: " 10m ", expected: 10 * time.Minute},
+		{name: "legacy float seconds", value: float64(300), expected: 5 * time.Minute},
+		{name: "legacy int seconds", value: 60, expected: time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := ParseDuration(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, d)
+		})
+	}
+
+	t.Run("invalid values -> error", func(t *testing.T) {
+		for _, value := range []any{"", "soon", "5", "1x", "-5m", float64(-1), true, []any{"5m"}} {
+			_, err := ParseDuration(value)
+			assert.Error(t, err, "expected error for %v", value)
+		}
+	})
+}
+
+func TestDecodeDuration(t *testing.T) {
+	t.Run("duration field takes precedence", func(t *testing.T) {
+		d, err := DecodeDuration(map[string]any{"timeout": "2m", "timeoutSeconds": float64(10)}, "timeout", "timeoutSeconds")
+		require.NoError(t, err)
+		assert.Equal(t, 2*time.Minute, d)
+	})
+
+	t.Run("legacy number field is used when duration is not set", func(t *testing.T) {
+		d, err := DecodeDuration(map[string]any{"timeoutSeconds": float64(10)}, "timeout", "timeoutSeconds")
+		require.NoError(t, err)
+		assert.Equal(t, 10*time.Second, d)
+	})
+
+	t.Run("nothing set -> zero", func(t *testing.T) {
+		d, err := DecodeDuration(map[string]any{}, "timeout", "timeoutSeconds")
+		require.NoError(t, err)
+		assert.Zero(t, d)
+	})
+
+	t.Run("invalid duration -> error", func(t *testing.T) {
+		_, err := DecodeDuration(map[string]any{"timeout": "later"}, "timeout", "timeoutSeconds")
+		assert.ErrorContains(t, err, "timeout: invalid duration 'later'")
+	})
+}
+
+func TestValidateConfiguration_Duration(t *testing.T) {
+	min := 60
+	max := 3600
+	fields := []Field{
+		{
+			Name: "timeout",
+			Type: FieldTypeDuration,
+			TypeOptions: &TypeOptions{
+				Duration: &DurationTypeOptions{Min: &min, Max: &max},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		value    any
+		errorMsg string
+	}{
+		{name: "within bounds", value: "30m"},
+		{name: "exactly min", value: "1m"},
+		{name: "exactly max", value: "1h"},
+		{name: "legacy number within bounds", value: float64(120)},
+		{name: "below min", value: "30s", errorMsg: "field 'timeout': must be at least 1m0s"},
+		{name: "above max", value: "1h30m", errorMsg: "field 'timeout': must be at most 1h0m0s"},
+		{name: "garbage", value: "an hour", errorMsg: "field 'timeout': invalid duration 'an hour': use a format like 30s, 5m or 1h30m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateConfiguration(fields, map[string]any{"timeout": tt.value})
+			if tt.errorMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.EqualError(t, err, tt.errorMsg)
+		})
+	}
+}
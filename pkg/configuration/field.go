@@ -20,6 +20,7 @@ const (
 	FieldTypeTimezone    = "timezone"
 	FieldTypeDaysOfWeek  = "days-of-week"
 	FieldTypeTimeRange   = "time-range"
+	FieldTypeDuration    = "duration"
 
 	/*
 	 * Special field types
@@ -111,6 +112,7 @@ type TypeOptions struct {
 	DayInYear        *DayInYearTypeOptions        `json:"day_in_year,omitempty"`
 	Cron             *CronTypeOptions             `json:"cron,omitempty"`
 	Timezone         *TimezoneTypeOptions         `json:"timezone,omitempty"`
+	Duration         *DurationTypeOptions         `json:"duration,omitempty"`
 }
 
 /*
@@ -210,6 +212,14 @@ type TimezoneTypeOptions struct {
 	// Could add supported timezones list here if needed in the future
 }
 
+/*
+ * DurationTypeOptions specifies bounds for duration fields, in seconds
+ */
+type DurationTypeOptions struct {
+	Min *int `json:"min,omitempty"`
+	Max *int `json:"max,omitempty"`
+}
+
 /*
  * SelectTypeOptions specifies options for select fields
  */
@@ -243,16 +253,16 @@ type ObjectTypeOptions struct {
  * FieldOption represents a selectable option for select / multi_select field types
  */
 type FieldOption struct {
-	Label string
-	Value string
+	Label string `json:"label"`
+	Value string `json:"value"`
 }
 
 /*
  * ListItemDefinition defines the structure of items in an 'list' field
  */
 type ListItemDefinition struct {
-	Type   string
-	Schema []Field
+	Type   string  `json:"type"`
+	Schema []Field `json:"schema,omitempty"`
 }
 
 type VisibilityCondition struct {
@@ -272,11 +282,33 @@ const (
 	ValidationRuleNotEqual    = "not_equal"
 	ValidationRuleMaxLength   = "max_length"
 	ValidationRuleMinLength   = "min_length"
+
+	/*
+	 * Cross-field rules, where CompareWith is the name of the other field.
+	 * mutually_exclusive: the field cannot be set if the other field is set.
+	 * required_if: the field is required if the other field is set.
+	 */
+	ValidationRuleMutuallyExclusive = "mutually_exclusive"
+	ValidationRuleRequiredIf        = "required_if"
 )
 
 type ValidationRule struct {
-	Type        string `json:"type"`         // less_than, greater_than, equal, not_equal, max_length, min_length
+	Type        string `json:"type"`         // less_than, greater_than, equal, not_equal, max_length, min_length, mutually_exclusive, required_if
 	CompareWith string `json:"compare_with"` // field name to compare with (for field comparisons)
 	Value       any    `json:"value"`        // static value to compare with (for direct validation)
 	Message     string `json:"message"`      // custom error message
 }
+
+/*
+ * MutuallyExclusive declares that a field cannot be set together with another field.
+ */
+func MutuallyExclusive(fieldName string) ValidationRule {
+	return ValidationRule{Type: ValidationRuleMutuallyExclusive, CompareWith: fieldName}
+}
+
+/*
+ * RequiredIf declares that a field is required when another field is set.
+ */
+func RequiredIf(fieldName string) ValidationRule {
+	return ValidationRule{Type: ValidationRuleRequiredIf, CompareWith: fieldName}
+}
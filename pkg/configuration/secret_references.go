@@ -0,0 +1,108 @@
+package configuration
+
+import (
+	"fmt"
+	"sort"
+)
+
+/*
+ * SecretKeyReference is a secret-key field value
+ * referencing a key of an organization secret.
+ */
+type SecretKeyReference struct {
+	FieldPath string
+	Secret    string
+	Key       string
+}
+
+/*
+ * FindSecretKeyReferences goes through the secret-key fields in the configuration,
+ * including the ones nested in objects and lists of objects, and returns the ones
+ * referencing the given secret. Field paths use dots for nested fields,
+ * and brackets for list items, e.g. "auth.privateKey" or "headers[0].value".
+ */
+func FindSecretKeyReferences(fields []Field, config map[string]any, secretName string) []SecretKeyReference {
+	references := []SecretKeyReference{}
+	findSecretKeyReferences(fields, config, "", secretName, &references)
+	return references
+}
+
+func findSecretKeyReferences(fields []Field, config map[string]any, prefix, secretName string, references *[]SecretKeyReference) {
+	//
+	// Fields are sorted by name, so the references are always listed in the same order.
+	//
+	sorted := make([]Field, len(fields))
+	copy(sorted, fields)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	for _, field := range sorted {
+		value, ok := config[field.Name]
+		if !ok || value == nil {
+			continue
+		}
+
+		path := field.Name
+		if prefix != "" {
+			path = prefix + "." + field.Name
+		}
+
+		switch field.Type {
+		case FieldTypeSecretKey:
+			reference, ok := secretKeyReference(value)
+			if ok && reference.Secret == secretName {
+				reference.FieldPath = path
+				*references = append(*references, reference)
+			}
+
+		case FieldTypeObject:
+			obj, ok := value.(map[string]any)
+			if !ok || field.TypeOptions == nil || field.TypeOptions.Object == nil {
+				continue
+			}
+
+			findSecretKeyReferences(field.TypeOptions.Object.Schema, obj, path, secretName, references)
+
+		case FieldTypeList:
+			list, ok := value.([]any)
+			if !ok || field.TypeOptions == nil || field.TypeOptions.List == nil || field.TypeOptions.List.ItemDefinition == nil {
+				continue
+			}
+
+			itemDef := field.TypeOptions.List.ItemDefinition
+			for i, item := range list {
+				itemPath := fmt.Sprintf("%s[%d]", path, i)
+				if itemDef.Type == FieldTypeSecretKey {
+					reference, ok := secretKeyReference(item)
+					if ok && reference.Secret == secretName {
+						reference.FieldPath = itemPath
+						*references = append(*references, reference)
+					}
+
+					continue
+				}
+
+				itemMap, ok := item.(map[string]any)
+				if ok && itemDef.Type == FieldTypeObject {
+					findSecretKeyReferences(itemDef.Schema, itemMap, itemPath, secretName, references)
+				}
+			}
+		}
+	}
+}
+
+func secretKeyReference(value any) (SecretKeyReference, bool) {
+	m, ok := value.(map[string]any)
+	if !ok {
+		return SecretKeyReference{}, false
+	}
+
+	secret, _ := m["secret"].(string)
+	key, _ := m["key"].(string)
+	if secret == "" {
+		return SecretKeyReference{}, false
+	}
+
+	return SecretKeyReference{Secret: secret, Key: key}, true
+}
@@ -0,0 +1,66 @@
+package configuration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test__FindSecretKeyReferences(t *testing.T) {
+	fields := []Field{
+		{Name: "token", Type: FieldTypeSecretKey},
+		{Name: "name", Type: FieldTypeString},
+		{
+			Name: "auth",
+			Type: FieldTypeObject,
+			TypeOptions: &TypeOptions{
+				Object: &ObjectTypeOptions{
+					Schema: []Field{
+						{Name: "password", Type: FieldTypeSecretKey},
+					},
+				},
+			},
+		},
+		{
+			Name: "headers",
+			Type: FieldTypeList,
+			TypeOptions: &TypeOptions{
+				List: &ListTypeOptions{
+					ItemDefinition: &ListItemDefinition{
+						Type: FieldTypeObject,
+						Schema: []Field{
+							{Name: "name", Type: FieldTypeString},
+							{Name: "value", Type: FieldTypeSecretKey},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	config := map[string]any{
+		"token": map[string]any{"secret": "credentials", "key": "token"},
+		"name":  map[string]any{"secret": "credentials", "key": "not-a-secret-key-field"},
+		"auth": map[string]any{
+			"password": map[string]any{"secret": "credentials", "key": "password"},
+		},
+		"headers": []any{
+			map[string]any{"name": "X-A", "value": map[string]any{"secret": "other", "key": "a"}},
+			map[string]any{"name": "X-B", "value": map[string]any{"secret": "credentials", "key": "b"}},
+		},
+	}
+
+	t.Run("references to the secret are returned with their paths", func(t *testing.T) {
+		references := FindSecretKeyReferences(fields, config, "credentials")
+		assert.Equal(t, []SecretKeyReference{
+			{FieldPath: "auth.password", Secret: "credentials", Key: "password"},
+			{FieldPath: "headers[1].value", Secret: "credentials", Key: "b"},
+			{FieldPath: "token", Secret: "credentials", Key: "token"},
+		}, references)
+	})
+
+	t.Run("no references -> empty", func(t *testing.T) {
+		assert.Empty(t, FindSecretKeyReferences(fields, config, "unused"))
+		assert.Empty(t, FindSecretKeyReferences(fields, map[string]any{}, "credentials"))
+	})
+}
@@ -29,11 +29,16 @@ func ValidateConfiguration(fields []Field, config map[string]any) error {
 			return fmt.Errorf("field '%s' is required", field.Name)
 		}
 
+		err := validateRequiredIfRules(field, value, config)
+		if err != nil {
+			return err
+		}
+
 		if !exists || value == nil {
 			continue
 		}
 
-		err := validateFieldValue(field, value)
+		err = validateFieldValue(field, value)
 		if err != nil {
 			return fmt.Errorf("field '%s': %w", field.Name, err)
 		}
@@ -48,6 +53,31 @@ func ValidateConfiguration(fields []Field, config map[string]any) error {
 	return nil
 }
 
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return e.Message
+}
+
+/*
+ * Unlike ValidateConfiguration, this does not stop at the first invalid field,
+ * so forms can show the errors for all their fields at once.
+ */
+func ValidateConfigurationFields(fields []Field, config map[string]any) []FieldError {
+	errors := []FieldError{}
+	for _, field := range fields {
+		err := ValidateConfiguration([]Field{field}, config)
+		if err != nil {
+			errors = append(errors, FieldError{Field: field.Name, Message: err.Error()})
+		}
+	}
+
+	return errors
+}
+
 func validateNumber(field Field, value any) error {
 	var num float64
 	switch v := value.(type) {
@@ -666,6 +696,9 @@ func validateFieldValue(field Field, value any) error {
 
 	case FieldTypeTimezone:
 		return validateTimezone(field, value)
+
+	case FieldTypeDuration:
+		return validateDuration(field, value)
 	}
 
 	return nil
@@ -689,14 +722,69 @@ func isRequiredByCondition(field Field, config map[string]any) bool {
 	return false
 }
 
+// validateRequiredIfRules checks that a field is set when a field it depends on is set
+func validateRequiredIfRules(field Field, value any, config map[string]any) error {
+	if isSet(value) {
+		return nil
+	}
+
+	for _, rule := range field.ValidationRules {
+		if rule.Type != ValidationRuleRequiredIf || !isSet(config[rule.CompareWith]) {
+			continue
+		}
+
+		if rule.Message != "" {
+			return fmt.Errorf("field '%s': %s", field.Name, rule.Message)
+		}
+
+		return fmt.Errorf("field '%s' is required when '%s' is set", field.Name, rule.CompareWith)
+	}
+
+	return nil
+}
+
+// isSet checks if a value is present and not empty
+func isSet(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case string:
+		return strings.TrimSpace(v) != ""
+	case []any:
+		return len(v) > 0
+	case []string:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
 // validateFieldRules validates comparison rules between fields
 func validateFieldRules(field Field, value any, config map[string]any) error {
 	for _, rule := range field.ValidationRules {
+		if rule.Type == ValidationRuleRequiredIf {
+			continue // Checked before the field value is validated
+		}
+
 		compareValue, exists := config[rule.CompareWith]
 		if !exists || compareValue == nil {
 			continue // Skip validation if comparison field doesn't exist
 		}
 
+		if rule.Type == ValidationRuleMutuallyExclusive {
+			if !isSet(value) || !isSet(compareValue) {
+				continue
+			}
+
+			if rule.Message != "" {
+				return fmt.Errorf("%s", rule.Message)
+			}
+
+			return fmt.Errorf("cannot be set together with '%s'", rule.CompareWith)
+		}
+
 		err := validateComparisonRule(field, value, compareValue, rule)
 		if err != nil {
 			if rule.Message != "" {
@@ -207,6 +207,108 @@ func TestValidateConfiguration_ValidationRules(t *testing.T) {
 	}
 }
 
+func TestValidateConfiguration_CrossFieldRules(t *testing.T) {
+	fields := []Field{
+		{
+			Name:            "launchType",
+			Type:            FieldTypeString,
+			ValidationRules: []ValidationRule{MutuallyExclusive("capacityProviderStrategy")},
+		},
+		{
+			Name: "capacityProviderStrategy",
+			Type: FieldTypeList,
+			TypeOptions: &TypeOptions{
+				List: &ListTypeOptions{
+					ItemDefinition: &ListItemDefinition{
+						Type:   FieldTypeObject,
+						Schema: []Field{{Name: "provider", Type: FieldTypeString}},
+					},
+				},
+			},
+		},
+		{
+			Name:            "subnets",
+			Type:            FieldTypeString,
+			ValidationRules: []ValidationRule{RequiredIf("securityGroups")},
+		},
+		{
+			Name: "securityGroups",
+			Type: FieldTypeString,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		config   map[string]any
+		errorMsg string
+	}{
+		{
+			name:   "only launch type",
+			config: map[string]any{"launchType": "FARGATE"},
+		},
+		{
+			name:   "only capacity provider strategy",
+			config: map[string]any{"capacityProviderStrategy": []any{map[string]any{"provider": "FARGATE_SPOT"}}},
+		},
+		{
+			name: "both mutually exclusive fields set",
+			config: map[string]any{
+				"launchType":               "FARGATE",
+				"capacityProviderStrategy": []any{map[string]any{"provider": "FARGATE_SPOT"}},
+			},
+			errorMsg: "field 'launchType': cannot be set together with 'capacityProviderStrategy'",
+		},
+		{
+			name: "empty values do not conflict",
+			config: map[string]any{
+				"launchType":               "",
+				"capacityProviderStrategy": []any{map[string]any{"provider": "FARGATE_SPOT"}},
+			},
+		},
+		{
+			name:     "required if other field is set",
+			config:   map[string]any{"securityGroups": "sg-123"},
+			errorMsg: "field 'subnets' is required when 'securityGroups' is set",
+		},
+		{
+			name:     "required if other field is set and value is empty",
+			config:   map[string]any{"securityGroups": "sg-123", "subnets": ""},
+			errorMsg: "field 'subnets' is required when 'securityGroups' is set",
+		},
+		{
+			name:   "required if other field is set and value is present",
+			config: map[string]any{"securityGroups": "sg-123", "subnets": "subnet-123"},
+		},
+		{
+			name:   "not required if other field is not set",
+			config: map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateConfiguration(fields, tt.config)
+			if tt.errorMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.EqualError(t, err, tt.errorMsg)
+		})
+	}
+
+	t.Run("custom message", func(t *testing.T) {
+		rule := MutuallyExclusive("b")
+		rule.Message = "a and b cannot be combined"
+		err := ValidateConfiguration(
+			[]Field{{Name: "a", Type: FieldTypeString, ValidationRules: []ValidationRule{rule}}, {Name: "b", Type: FieldTypeString}},
+			map[string]any{"a": "x", "b": "y"},
+		)
+
+		assert.EqualError(t, err, "field 'a': a and b cannot be combined")
+	})
+}
+
 func TestValidateConfiguration_DaysOfWeek(t *testing.T) {
 	fields := []Field{
 		{
@@ -449,3 +551,24 @@ func TestValidateDayInYearComparison(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfigurationFields(t *testing.T) {
+	fields := []Field{
+		{Name: "name", Type: FieldTypeString, Required: true},
+		{Name: "count", Type: FieldTypeNumber},
+		{Name: "enabled", Type: FieldTypeBool},
+	}
+
+	t.Run("all fields valid -> no errors", func(t *testing.T) {
+		errors := ValidateConfigurationFields(fields, map[string]any{"name": "a", "count": 1.0, "enabled": true})
+		assert.Empty(t, errors)
+	})
+
+	t.Run("every invalid field is returned", func(t *testing.T) {
+		errors := ValidateConfigurationFields(fields, map[string]any{"count": "1", "enabled": true})
+		assert.Equal(t, []FieldError{
+			{Field: "name", Message: "field 'name' is required"},
+			{Field: "count", Message: "field 'count': must be a number"},
+		}, errors)
+	})
+}
@@ -1,8 +1,10 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,6 +16,11 @@ var DefaultOutputChannel = OutputChannel{Name: "default", Label: "Default"}
 
 var ErrSecretKeyNotFound = errors.New("secret or key not found")
 
+var ErrAttachmentNotFound = errors.New("attachment not found")
+
+// The size of the execution outputs can be up to 4k
+const MaxExecutionOutputsSize = 4 * 1024
+
 type Component interface {
 
 	/*
@@ -129,6 +136,12 @@ type OutputChannel struct {
 	Description string
 }
 
+func HasOutputChannel(channels []OutputChannel, name string) bool {
+	return slices.ContainsFunc(channels, func(channel OutputChannel) bool {
+		return channel.Name == name
+	})
+}
+
 /*
  * ExecutionContext allows the component
  * to control the state and metadata of each execution of it.
@@ -147,6 +160,7 @@ type ExecutionContext struct {
 	HTTP           HTTPContext
 	Metadata       MetadataContext
 	NodeMetadata   MetadataContext
+	Attachments    AttachmentContext
 	ExecutionState ExecutionStateContext
 	Requests       RequestContext
 	Auth           AuthContext
@@ -154,6 +168,16 @@ type ExecutionContext struct {
 	Notifications  NotificationContext
 	Secrets        SecretsContext
 	Webhook        NodeWebhookContext
+	OIDC           TokenIssuer
+	ApprovalLinks  ApprovalLinkContext
+}
+
+/*
+ * TokenIssuer signs OIDC tokens identifying SuperPlane,
+ * e.g. to assume a cloud role through web identity federation.
+ */
+type TokenIssuer interface {
+	Sign(subject string, duration time.Duration, audience string, additionalClaims map[string]any) (string, error)
 }
 
 /*
@@ -165,6 +189,12 @@ type ExecutionContext struct {
  */
 type HTTPContext interface {
 	Do(*http.Request) (*http.Response, error)
+
+	/*
+	 * The context requests should be created with,
+	 * so they carry the trace of the call making them.
+	 */
+	Context() context.Context
 }
 
 /*
@@ -191,6 +221,28 @@ type MetadataContext interface {
 	Set(any) error
 }
 
+/*
+ * AttachmentContext allows components to store data
+ * that is too large for the execution metadata.
+ * Attachments are deleted when the execution finishes.
+ */
+type AttachmentContext interface {
+
+	//
+	// Stores the data under the given name,
+	// replacing any data previously stored with it.
+	//
+	Put(name string, data []byte) error
+
+	//
+	// Returns the data stored under the given name,
+	// or ErrAttachmentNotFound if there is none.
+	//
+	Get(name string) ([]byte, error)
+
+	Delete(name string) error
+}
+
 /*
  * ExecutionStateContext allows components to control execution lifecycle.
  */
@@ -198,6 +250,11 @@ type ExecutionStateContext interface {
 	IsFinished() bool
 	SetKV(key, value string) error
 
+	/*
+	 * Same as SetKV, but stores all the pairs at once.
+	 */
+	SetKVs(pairs map[string]string) error
+
 	/*
 	 * Pass the execution, emitting a payload to the specified channel.
 	 */
@@ -213,6 +270,18 @@ type ExecutionStateContext interface {
 	 * No payloads are emitted.
 	 */
 	Fail(reason, message string) error
+
+	/*
+	 * Emit payloads to the specified channel, without finishing the execution.
+	 * Returns the IDs of the events created, one per payload.
+	 */
+	Release(channel, payloadType string, payloads []any) ([]uuid.UUID, error)
+
+	/*
+	 * Whether an event released by this execution still has
+	 * pending events, queue items or executions downstream of it.
+	 */
+	HasUnfinishedDescendants(eventID uuid.UUID) (bool, error)
 }
 
 /*
@@ -248,6 +317,7 @@ type ActionContext struct {
 	Logger         *log.Entry
 	HTTP           HTTPContext
 	Metadata       MetadataContext
+	Attachments    AttachmentContext
 	ExecutionState ExecutionStateContext
 	Auth           AuthContext
 	Requests       RequestContext
@@ -311,6 +381,7 @@ type AuthContext interface {
 	GetUser(id uuid.UUID) (*User, error)
 	HasRole(role string) (bool, error)
 	InGroup(group string) (bool, error)
+	GetGroupUsers(group string) ([]User, error)
 }
 
 type NotificationReceivers struct {
@@ -323,6 +394,19 @@ type NotificationContext interface {
 	Send(title, body, url, urlLabel string, receivers NotificationReceivers) error
 }
 
+/*
+ * ApprovalLinkContext creates signed links that let a user
+ * record an approval decision without logging into the UI.
+ */
+type ApprovalLinkContext interface {
+	Create(user User, decision string, index int, expiresIn time.Duration) (string, error)
+}
+
+/*
+ * SecretsContext allows components to read a single key of an organization secret,
+ * so they never get access to the other keys of the same secret.
+ * A missing secret or key returns an error wrapping ErrSecretKeyNotFound.
+ */
 type SecretsContext interface {
 	GetKey(secretName, keyName string) ([]byte, error)
 }
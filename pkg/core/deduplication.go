@@ -0,0 +1,61 @@
+package core
+
+import "github.com/superplanehq/superplane/pkg/configuration"
+
+const (
+	DeduplicationFieldName        = "deduplication"
+	DefaultDeduplicationWindow    = 300
+	MaxDeduplicationWindowSeconds = 24 * 60 * 60
+)
+
+/*
+ * Triggers supporting event deduplication include DeduplicationField()
+ * in their configuration. The key expression is evaluated against each
+ * event emitted by the node, and events whose key was already emitted
+ * by the node within the window are dropped.
+ */
+type DeduplicationConfiguration struct {
+	Key           string `json:"key" mapstructure:"key"`
+	WindowSeconds int    `json:"windowSeconds" mapstructure:"windowSeconds"`
+}
+
+func DeduplicationField() configuration.Field {
+	minWindow := 1
+	maxWindow := MaxDeduplicationWindowSeconds
+
+	return configuration.Field{
+		Name:        DeduplicationFieldName,
+		Label:       "Deduplication",
+		Type:        configuration.FieldTypeObject,
+		Togglable:   true,
+		Description: "Drop events with the same key as an event received within the window",
+		TypeOptions: &configuration.TypeOptions{
+			Object: &configuration.ObjectTypeOptions{
+				Schema: []configuration.Field{
+					{
+						Name:        "key",
+						Label:       "Key",
+						Type:        configuration.FieldTypeExpression,
+						Required:    true,
+						Description: "Expression evaluated against the event data",
+						Placeholder: "e.g. $.headers['X-Delivery-Id'][0]",
+					},
+					{
+						Name:        "windowSeconds",
+						Label:       "Window (seconds)",
+						Type:        configuration.FieldTypeNumber,
+						Required:    true,
+						Default:     DefaultDeduplicationWindow,
+						Description: "How long to remember a key for",
+						TypeOptions: &configuration.TypeOptions{
+							Number: &configuration.NumberTypeOptions{
+								Min: &minWindow,
+								Max: &maxWindow,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"errors"
+	"time"
+)
+
+/*
+ * Components return a RetryableError from Execute when the failure is transient,
+ * like an API being throttled or temporarily unavailable.
+ * Instead of failing the execution, it is attempted again later, with backoff.
+ *
+ * After can be used to ask for a minimum delay before the next attempt.
+ */
+type RetryableError struct {
+	Err   error
+	After time.Duration
+}
+
+func (e *RetryableError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryableError) Unwrap() error {
+	return e.Err
+}
+
+/*
+ * Components return a PermanentError from Execute when trying again
+ * cannot succeed, like an invalid configuration or a missing resource.
+ * The execution is failed right away, even if the error wraps a RetryableError.
+ *
+ * Errors that are not classified are also treated as permanent.
+ */
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+func Retryable(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &RetryableError{Err: err}
+}
+
+func RetryableAfter(err error, after time.Duration) error {
+	if err == nil {
+		return nil
+	}
+
+	return &RetryableError{Err: err, After: after}
+}
+
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &PermanentError{Err: err}
+}
+
+func IsRetryable(err error) bool {
+	var permanent *PermanentError
+	if errors.As(err, &permanent) {
+		return false
+	}
+
+	var retryable *RetryableError
+	return errors.As(err, &retryable)
+}
+
+/*
+ * Returns the minimum delay requested by a retryable error, if any.
+ */
+func RetryDelay(err error) time.Duration {
+	var retryable *RetryableError
+	if errors.As(err, &retryable) {
+		return retryable.After
+	}
+
+	return 0
+}
@@ -0,0 +1,127 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	SchemaTypeObject  = "object"
+	SchemaTypeArray   = "array"
+	SchemaTypeString  = "string"
+	SchemaTypeNumber  = "number"
+	SchemaTypeBoolean = "boolean"
+)
+
+/*
+ * Components can implement this to declare the shape
+ * of the event data they expect to receive.
+ * The input event is validated against the schema before Execute() is called,
+ * and the execution fails if it does not match.
+ */
+type InputSchemaProvider interface {
+	InputSchema() *Schema
+}
+
+/*
+ * A subset of JSON schema, describing the shape of the input event data.
+ * An empty type accepts any value.
+ */
+type Schema struct {
+	Type       string             `json:"type,omitempty"`
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	Required   []string           `json:"required,omitempty"`
+	Items      *Schema            `json:"items,omitempty"`
+}
+
+func (s *Schema) Validate(value any) error {
+	return s.validate("input", value)
+}
+
+func (s *Schema) validate(path string, value any) error {
+	if s == nil {
+		return nil
+	}
+
+	switch s.Type {
+	case "":
+		return nil
+
+	case SchemaTypeObject:
+		return s.validateObject(path, value)
+
+	case SchemaTypeArray:
+		items, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%s must be an array", path)
+		}
+
+		for i, item := range items {
+			if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	case SchemaTypeString:
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s must be a string", path)
+		}
+
+		return nil
+
+	case SchemaTypeNumber:
+		switch value.(type) {
+		case float64, float32, int, int32, int64:
+			return nil
+		}
+
+		return fmt.Errorf("%s must be a number", path)
+
+	case SchemaTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s must be a boolean", path)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("%s has unsupported schema type %s", path, s.Type)
+}
+
+func (s *Schema) validateObject(path string, value any) error {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("%s must be an object", path)
+	}
+
+	for _, name := range s.Required {
+		if v, ok := object[name]; !ok || v == nil {
+			return fmt.Errorf("%s.%s is required", path, name)
+		}
+	}
+
+	//
+	// Properties are checked in order,
+	// so the same input always fails with the same message.
+	//
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		v, ok := object[name]
+		if !ok || v == nil {
+			continue
+		}
+
+		if err := s.Properties[name].validate(path+"."+name, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__Schema__Validate(t *testing.T) {
+	schema := &Schema{
+		Type:     SchemaTypeObject,
+		Required: []string{"data"},
+		Properties: map[string]*Schema{
+			"data": {
+				Type:     SchemaTypeObject,
+				Required: []string{"ref"},
+				Properties: map[string]*Schema{
+					"ref":     {Type: SchemaTypeString},
+					"retries": {Type: SchemaTypeNumber},
+					"labels":  {Type: SchemaTypeArray, Items: &Schema{Type: SchemaTypeString}},
+					"extra":   {},
+				},
+			},
+		},
+	}
+
+	t.Run("matching input -> no error", func(t *testing.T) {
+		require.NoError(t, schema.Validate(map[string]any{
+			"data": map[string]any{
+				"ref":     "main",
+				"retries": 3.0,
+				"labels":  []any{"a", "b"},
+				"extra":   map[string]any{"anything": true},
+			},
+		}))
+	})
+
+	t.Run("optional properties can be missing", func(t *testing.T) {
+		require.NoError(t, schema.Validate(map[string]any{"data": map[string]any{"ref": "main"}}))
+	})
+
+	t.Run("missing required field -> error with its path", func(t *testing.T) {
+		err := schema.Validate(map[string]any{"data": map[string]any{"retries": 1.0}})
+		assert.EqualError(t, err, "input.data.ref is required")
+	})
+
+	t.Run("wrong types -> error with their path", func(t *testing.T) {
+		err := schema.Validate(map[string]any{"data": "main"})
+		assert.EqualError(t, err, "input.data must be an object")
+
+		err = schema.Validate(map[string]any{"data": map[string]any{"ref": "main", "retries": "3"}})
+		assert.EqualError(t, err, "input.data.retries must be a number")
+
+		err = schema.Validate(map[string]any{"data": map[string]any{"ref": "main", "labels": []any{"a", 1.0}}})
+		assert.EqualError(t, err, "input.data.labels[1] must be a string")
+	})
+
+	t.Run("nil schema accepts anything", func(t *testing.T) {
+		var empty *Schema
+		require.NoError(t, empty.Validate("anything"))
+	})
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/configuration"
-	"github.com/superplanehq/superplane/pkg/oidc"
 )
 
 type Integration interface {
@@ -177,7 +176,7 @@ type SyncContext struct {
 	OrganizationID  string
 	HTTP            HTTPContext
 	Integration     IntegrationContext
-	OIDC            oidc.Provider
+	OIDC            TokenIssuer
 }
 
 type IntegrationCleanupContext struct {
@@ -242,6 +241,12 @@ type IntegrationContext interface {
 	 */
 	Subscribe(any) (*uuid.UUID, error)
 
+	/*
+	 * Replace the configuration of an existing subscription.
+	 * Used when the node configuration changes the events it is interested in.
+	 */
+	UpdateSubscription(subscriptionID uuid.UUID, configuration any) error
+
 	/*
 	 * Schedule actions for the integration.
 	 */
@@ -250,8 +255,29 @@ type IntegrationContext interface {
 
 	/*
 	 * List integration subscriptions from nodes.
+	 * Only subscriptions that can match the filter are returned.
+	 * An empty filter returns all of them.
 	 */
-	ListSubscriptions() ([]IntegrationSubscriptionContext, error)
+	ListSubscriptions(filter SubscriptionFilter) ([]IntegrationSubscriptionContext, error)
+}
+
+/*
+ * Subscriptions are indexed by the source and type of the events they want,
+ * so integrations receiving a lot of events only load the ones that can match.
+ * Empty fields match everything, and subscriptions without an index
+ * are always returned.
+ */
+type SubscriptionFilter struct {
+	Source string
+	Type   string
+}
+
+/*
+ * Subscription configurations implementing IndexedSubscription
+ * are indexed by the filter they return when subscribing.
+ */
+type IndexedSubscription interface {
+	SubscriptionIndex() SubscriptionFilter
 }
 
 type IntegrationSubscriptionContext interface {
@@ -280,6 +306,61 @@ type HTTPRequestContext struct {
 	WebhooksBaseURL string
 	HTTP            HTTPContext
 	Integration     IntegrationContext
+
+	/*
+	 * Lets the handler tell the caller how to finish the request.
+	 * May be nil, e.g. when the handler is called from tests.
+	 */
+	Result *HTTPRequestResult
+}
+
+/*
+ * HTTPRequestResult is filled in by HandleRequest().
+ *
+ * Changes made through the integration context are persisted
+ * only if the handler responds with a non-error status code.
+ * Handlers that do not change the integration itself
+ * can call SkipPersistence() to avoid saving it.
+ */
+type HTTPRequestResult struct {
+	skipPersistence bool
+	onFinish        []func(committed bool)
+}
+
+func (r *HTTPRequestResult) SkipPersistence() {
+	if r == nil {
+		return
+	}
+
+	r.skipPersistence = true
+}
+
+func (r *HTTPRequestResult) PersistenceSkipped() bool {
+	return r != nil && r.skipPersistence
+}
+
+/*
+ * Registers a function called once the request's transaction is finished,
+ * telling it if the transaction was committed. Handlers use it
+ * for state kept outside of the database, which should only
+ * be kept if the changes made by the request were persisted.
+ */
+func (r *HTTPRequestResult) OnFinish(fn func(committed bool)) {
+	if r == nil {
+		return
+	}
+
+	r.onFinish = append(r.onFinish, fn)
+}
+
+func (r *HTTPRequestResult) Finish(committed bool) {
+	if r == nil {
+		return
+	}
+
+	for _, fn := range r.onFinish {
+		fn(committed)
+	}
 }
 
 /*
@@ -0,0 +1,159 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+)
+
+const (
+	RetryPolicyFieldName   = "retryPolicy"
+	MaxRetryPolicyAttempts = 20
+)
+
+/*
+ * Component nodes can override how executions failing
+ * with a RetryableError are retried with RetryPolicyField().
+ * Fields which are not set use the defaults of the executor.
+ */
+type RetryPolicy struct {
+	MaxAttempts int
+	BackoffBase time.Duration
+	MaxBackoff  time.Duration
+}
+
+/*
+ * Delay before the next attempt, after the given number of retries.
+ * The backoff is exponential, capped at MaxBackoff,
+ * but never shorter than the delay requested by the error.
+ */
+func (p RetryPolicy) Delay(retryCount int, minDelay time.Duration) time.Duration {
+	delay := min(p.BackoffBase<<retryCount, p.MaxBackoff)
+
+	//
+	// Shifting a large base by many retries overflows.
+	//
+	if delay <= 0 {
+		delay = p.MaxBackoff
+	}
+
+	return max(delay, minDelay)
+}
+
+func RetryPolicyField() configuration.Field {
+	minAttempts := 1
+	maxAttempts := MaxRetryPolicyAttempts
+	minBackoff := 1
+
+	return configuration.Field{
+		Name:        RetryPolicyFieldName,
+		Label:       "Retry policy",
+		Type:        configuration.FieldTypeObject,
+		Togglable:   true,
+		Description: "How executions failing with a transient error are retried",
+		TypeOptions: &configuration.TypeOptions{
+			Object: &configuration.ObjectTypeOptions{
+				Schema: []configuration.Field{
+					{
+						Name:        "maxAttempts",
+						Label:       "Max attempts",
+						Type:        configuration.FieldTypeNumber,
+						Required:    true,
+						Default:     3,
+						Description: "Number of attempts, including the first one, before the execution fails",
+						TypeOptions: &configuration.TypeOptions{
+							Number: &configuration.NumberTypeOptions{
+								Min: &minAttempts,
+								Max: &maxAttempts,
+							},
+						},
+					},
+					{
+						Name:        "backoffBase",
+						Label:       "Backoff base",
+						Type:        configuration.FieldTypeDuration,
+						Description: "Delay before the first retry, doubled on each retry after it",
+						Placeholder: "e.g. 10s",
+						TypeOptions: &configuration.TypeOptions{
+							Duration: &configuration.DurationTypeOptions{
+								Min: &minBackoff,
+							},
+						},
+					},
+					{
+						Name:        "maxBackoff",
+						Label:       "Max backoff",
+						Type:        configuration.FieldTypeDuration,
+						Description: "Longest delay between two attempts",
+						Placeholder: "e.g. 5m",
+						TypeOptions: &configuration.TypeOptions{
+							Duration: &configuration.DurationTypeOptions{
+								Min: &minBackoff,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+/*
+ * Reads the retry policy from the configuration of a node.
+ * Nil is returned if the node has no retry policy.
+ */
+func RetryPolicyFromConfiguration(config map[string]any) (*RetryPolicy, error) {
+	raw, ok := config[RetryPolicyFieldName]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	values, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object", RetryPolicyFieldName)
+	}
+
+	policy := RetryPolicy{}
+	if value, ok := values["maxAttempts"]; ok && value != nil {
+		attempts, err := retryPolicyAttempts(value)
+		if err != nil {
+			return nil, err
+		}
+
+		policy.MaxAttempts = attempts
+	}
+
+	var err error
+	policy.BackoffBase, err = configuration.DecodeDuration(values, "backoffBase", "")
+	if err != nil {
+		return nil, err
+	}
+
+	policy.MaxBackoff, err = configuration.DecodeDuration(values, "maxBackoff", "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func retryPolicyAttempts(value any) (int, error) {
+	var attempts int
+	switch v := value.(type) {
+	case float64:
+		attempts = int(v)
+	case int:
+		attempts = v
+	case int64:
+		attempts = int(v)
+	default:
+		return 0, fmt.Errorf("maxAttempts must be a number")
+	}
+
+	if attempts < 1 || attempts > MaxRetryPolicyAttempts {
+		return 0, fmt.Errorf("maxAttempts must be between 1 and %d", MaxRetryPolicyAttempts)
+	}
+
+	return attempts, nil
+}
@@ -137,4 +137,28 @@ type NodeWebhookContext interface {
 	GetSecret() ([]byte, error)
 	ResetSecret() ([]byte, []byte, error)
 	GetBaseURL() string
+
+	/*
+	 * Overrides the body size limit for requests to the node webhook.
+	 * The server never accepts bodies larger than its ceiling.
+	 */
+	SetMaxBodySize(bytes int64) error
+}
+
+/*
+ * Triggers whose action parameters depend on the node configuration
+ * can implement this, so the parameters given to the action
+ * are validated against the ones returned here, instead of
+ * the ones in the action definition.
+ */
+type ConfigurableActionParameters interface {
+	ActionParameters(action string, configuration any) ([]configuration.Field, error)
+}
+
+/*
+ * Triggers and components receiving webhook payloads larger
+ * than the server default can implement this to request a larger limit.
+ */
+type WebhookBodySizeLimiter interface {
+	MaxWebhookBodySize() int64
 }
@@ -43,7 +43,10 @@ func dbPoolSize() int {
 	return size
 }
 
-func connect() *gorm.DB {
+/*
+ * Connection string for the database configured through the environment.
+ */
+func dsn() string {
 	postgresDbSSL := os.Getenv("POSTGRES_DB_SSL")
 	sslMode := "disable"
 	if postgresDbSSL == "true" {
@@ -61,8 +64,10 @@ func connect() *gorm.DB {
 	}
 
 	dsnTemplate := "host=%s port=%s user=%s password=%s dbname=%s sslmode=%s application_name=%s"
-	dsn := fmt.Sprintf(dsnTemplate, c.Host, c.Port, c.User, c.Pass, c.Name, c.Ssl, c.ApplicationName)
+	return fmt.Sprintf(dsnTemplate, c.Host, c.Port, c.User, c.Pass, c.Name, c.Ssl, c.ApplicationName)
+}
 
+func connect() *gorm.DB {
 	logger := gormLogger.New(log.New(os.Stdout, "\r\n", log.LstdFlags), gormLogger.Config{
 		SlowThreshold:             200 * time.Millisecond,
 		LogLevel:                  gormLogger.Warn,
@@ -70,7 +75,7 @@ func connect() *gorm.DB {
 		IgnoreRecordNotFoundError: true,
 	})
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger})
+	db, err := gorm.Open(postgres.Open(dsn()), &gorm.Config{Logger: logger})
 	if err != nil {
 		panic(err)
 	}
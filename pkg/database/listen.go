@@ -0,0 +1,41 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+/*
+ * Listens for notifications sent with NOTIFY / pg_notify on a Postgres channel.
+ * A dedicated connection is used, since the ones in the pool are shared.
+ *
+ * onListening is called once the connection is listening,
+ * and onNotification is called with the payload of every notification.
+ * Blocks until ctx is done or the connection fails.
+ */
+func Listen(ctx context.Context, channel string, onListening func(), onNotification func(payload string)) error {
+	conn, err := pgx.Connect(ctx, dsn())
+	if err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize())
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", channel, err)
+	}
+
+	onListening()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		onNotification(notification.Payload)
+	}
+}
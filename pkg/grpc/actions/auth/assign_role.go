@@ -3,9 +3,11 @@ package auth
 import (
 	"context"
 
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/authentication"
 	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/roles"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -21,6 +23,12 @@ func AssignRole(ctx context.Context, orgID, domainType, domainID, roleName, user
 		return nil, status.Error(codes.InvalidArgument, "invalid role")
 	}
 
+	if domainType == models.DomainTypeCanvas {
+		if err := validateCanvasDomain(orgID, domainID); err != nil {
+			return nil, err
+		}
+	}
+
 	user, err := FindUser(orgID, userID, userEmail)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "user not found")
@@ -38,3 +46,26 @@ func AssignRole(ctx context.Context, orgID, domainType, domainID, roleName, user
 
 	return &pb.AssignRoleResponse{}, nil
 }
+
+/*
+ * Canvas roles can only be assigned on canvases of the organization
+ * the request is made in.
+ */
+func validateCanvasDomain(orgID, canvasID string) error {
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid organization")
+	}
+
+	canvasUUID, err := uuid.Parse(canvasID)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid canvas")
+	}
+
+	_, err = models.FindCanvas(orgUUID, canvasUUID)
+	if err != nil {
+		return status.Error(codes.NotFound, "canvas not found")
+	}
+
+	return nil
+}
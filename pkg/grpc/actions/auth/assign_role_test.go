@@ -49,6 +49,46 @@ func Test_AssignRole(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("assign viewer role", func(t *testing.T) {
+		newUser := support.CreateUser(t, r, r.Organization.ID)
+		require.NoError(t, r.AuthService.AssignRole(newUser.ID.String(), models.RoleOrgAdmin, orgID, models.DomainTypeOrganization))
+
+		resp, err := AssignRole(ctx, orgID, models.DomainTypeOrganization, orgID, models.RoleOrgViewer, newUser.ID.String(), "", r.AuthService)
+		require.NoError(t, err)
+		assert.NotNil(t, resp)
+
+		allowed, err := r.AuthService.CheckOrganizationPermission(newUser.ID.String(), orgID, "canvases", "read")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, err = r.AuthService.CheckOrganizationPermission(newUser.ID.String(), orgID, "canvases", "update")
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	})
+
+	t.Run("assign canvas role", func(t *testing.T) {
+		newUser := support.CreateUser(t, r, r.Organization.ID)
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+		canvasID := canvas.ID.String()
+
+		resp, err := AssignRole(ctx, orgID, models.DomainTypeCanvas, canvasID, models.RoleCanvasEditor, newUser.ID.String(), "", r.AuthService)
+		require.NoError(t, err)
+		assert.NotNil(t, resp)
+
+		allowed, err := r.AuthService.CheckCanvasPermission(newUser.ID.String(), orgID, canvasID, "canvases", "update")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	t.Run("assign canvas role on canvas from another organization -> error", func(t *testing.T) {
+		newUser := support.CreateUser(t, r, r.Organization.ID)
+		_, err := AssignRole(ctx, orgID, models.DomainTypeCanvas, uuid.NewString(), models.RoleCanvasEditor, newUser.ID.String(), "", r.AuthService)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+		assert.Equal(t, "canvas not found", s.Message())
+	})
+
 	t.Run("user cannot change own role", func(t *testing.T) {
 		_, err := AssignRole(ctx, orgID, models.DomainTypeOrganization, orgID, models.RoleOrgAdmin, r.User.String(), "", r.AuthService)
 		s, ok := status.FromError(err)
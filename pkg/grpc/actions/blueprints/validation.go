@@ -6,6 +6,7 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/registry"
 )
@@ -62,7 +63,7 @@ func ValidateNodeConfiguration(node models.Node, registry *registry.Registry) er
 }
 
 func validateConfiguration(nodeID string, config any, component core.Component) error {
-	configFields := component.Configuration()
+	configFields := actions.AppendGlobalComponentFields(component.Configuration())
 
 	// Convert config to map for easier validation
 	var configMap map[string]any
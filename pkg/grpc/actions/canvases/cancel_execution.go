@@ -14,6 +14,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -41,6 +42,7 @@ func CancelExecution(ctx context.Context, authService authorization.Authorizatio
 		return nil, status.Error(codes.InvalidArgument, "cannot cancel child execution directly, cancel the parent execution instead")
 	}
 
+	wasFinished := execution.State == models.CanvasNodeExecutionStateFinished
 	err = database.Conn().Transaction(func(tx *gorm.DB) error {
 		node, err := models.FindCanvasNode(tx, workflowID, execution.NodeID)
 
@@ -61,6 +63,10 @@ func CancelExecution(ctx context.Context, authService authorization.Authorizatio
 		return nil, err
 	}
 
+	if !wasFinished {
+		telemetry.RecordExecutionFinished(execution.Result)
+	}
+
 	return &pb.CancelExecutionResponse{}, nil
 }
 
@@ -90,6 +96,7 @@ func cancelExecutionInTransaction(tx *gorm.DB, authService authorization.Authori
 				Configuration:  execution.Configuration.Data(),
 				HTTP:           registry.HTTPContext(),
 				Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
+				Attachments:    contexts.NewExecutionAttachmentContext(tx, execution),
 				ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 				Requests:       contexts.NewExecutionRequestContext(tx, execution),
 				Auth:           contexts.NewAuthContext(tx, orgUUID, authService, user),
@@ -114,12 +121,15 @@ func cancelExecutionInTransaction(tx *gorm.DB, authService authorization.Authori
 		}
 	}
 
-	var cancelledBy *uuid.UUID
-	if user != nil {
-		cancelledBy = &user.ID
+	return execution.CancelInTransaction(tx, cancelledByUser(user))
+}
+
+func cancelledByUser(user *models.User) *uuid.UUID {
+	if user == nil {
+		return nil
 	}
 
-	return execution.CancelInTransaction(tx, cancelledBy)
+	return &user.ID
 }
 
 func cancelChildExecutions(
@@ -167,9 +177,19 @@ func cancelChildExecutions(
 
 	for _, childExecution := range childExecutions {
 		childNode, exists := nodeMap[childExecution.NodeID]
+
+		//
+		// If the node of the child is gone, there is no component to cancel,
+		// but the child execution must still not be left running.
+		//
 		if !exists {
-			log.Errorf("failed to find child node %s in fetched nodes", childExecution.NodeID)
-			return err
+			log.Warnf("child node %s not found - cancelling execution %s", childExecution.NodeID, childExecution.ID.String())
+			err = childExecution.CancelInTransaction(tx, cancelledByUser(user))
+			if err != nil {
+				return err
+			}
+
+			continue
 		}
 
 		err = cancelExecutionInTransaction(tx, authService, encryptor, organizationID, registry, &childExecution, childNode, user)
@@ -3,6 +3,7 @@ package canvases
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -100,15 +101,80 @@ func Test__DeleteCanvas(t *testing.T) {
 		assert.Contains(t, canvasUnscoped.Name, "(deleted-")
 		assert.NotEqual(t, canvas.Name, canvasUnscoped.Name)
 
-		// Associated data should still exist (cleanup worker handles this)
+		// Nodes are soft deleted with the canvas
 		nodes, err = models.FindCanvasNodes(canvas.ID)
 		require.NoError(t, err)
-		assert.Len(t, nodes, 2)
+		assert.Empty(t, nodes)
+
+		// Associated data should still exist (cleanup worker handles this)
+		var nodeCount int64
+		require.NoError(t, database.Conn().Unscoped().Model(&models.CanvasNode{}).Where("workflow_id = ?", canvas.ID).Count(&nodeCount).Error)
+		assert.Equal(t, int64(2), nodeCount)
 		support.VerifyCanvasEventsCount(t, canvas.ID, 2)
 		support.VerifyNodeExecutionsCount(t, canvas.ID, 1)
 		support.VerifyNodeQueueCount(t, canvas.ID, 1)
 	})
 
+	t.Run("requests and executions that are not finished are deleted with the canvas", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(
+			t,
+			r.Organization.ID,
+			r.User,
+			[]models.CanvasNode{
+				{
+					NodeID: "node-1",
+					Type:   models.NodeTypeComponent,
+					Ref: datatypes.NewJSONType(models.NodeRef{
+						Component: &models.ComponentRef{Name: "noop"},
+					}),
+				},
+			},
+			[]models.Edge{},
+		)
+
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+		running := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", event.ID, event.ID, nil)
+		finished := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", event.ID, event.ID, nil)
+		_, err := finished.PassInTransaction(database.Conn(), map[string][]any{})
+		require.NoError(t, err)
+
+		node, err := models.FindCanvasNode(database.Conn(), canvas.ID, "node-1")
+		require.NoError(t, err)
+		require.NoError(t, node.CreateRequest(database.Conn(), models.NodeRequestTypeInvokeAction, models.NodeExecutionRequestSpec{
+			InvokeAction: &models.InvokeAction{ActionName: "test", Parameters: map[string]any{}},
+		}, nil))
+		require.NoError(t, running.CreateRequest(database.Conn(), models.NodeRequestTypeInvokeAction, models.NodeExecutionRequestSpec{
+			InvokeAction: &models.InvokeAction{ActionName: "test", Parameters: map[string]any{}},
+		}, nil))
+
+		_, err = DeleteCanvas(context.Background(), r.Registry, r.Organization.ID, canvas.ID.String())
+		require.NoError(t, err)
+
+		_, err = models.FindNodeExecution(canvas.ID, running.ID)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+		pending, err := models.ListPendingNodeExecutions()
+		require.NoError(t, err)
+		for _, execution := range pending {
+			assert.NotEqual(t, running.ID, execution.ID)
+		}
+
+		_, err = models.FindNodeExecution(canvas.ID, finished.ID)
+		require.NoError(t, err)
+
+		_, err = models.FindPendingRequestForNode(database.Conn(), canvas.ID, "node-1")
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+		claimed, err := models.ClaimNodeRequests(100, time.Minute)
+		require.NoError(t, err)
+		for _, request := range claimed {
+			assert.NotEqual(t, canvas.ID, request.WorkflowID)
+		}
+
+		support.VerifyNodeExecutionsCount(t, canvas.ID, 2)
+		support.VerifyNodeRequestCount(t, canvas.ID, 2)
+	})
+
 	t.Run("canvas node webhook remains until cleanup worker processes it", func(t *testing.T) {
 		//
 		// Create webhook
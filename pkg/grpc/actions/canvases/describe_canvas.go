@@ -6,8 +6,12 @@ import (
 
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	componentpb "github.com/superplanehq/superplane/pkg/protos/components"
+	configpb "github.com/superplanehq/superplane/pkg/protos/configuration"
 	"github.com/superplanehq/superplane/pkg/registry"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -39,7 +43,56 @@ func DescribeCanvas(ctx context.Context, registry *registry.Registry, organizati
 		return nil, status.Error(codes.Internal, "failed to serialize workflow")
 	}
 
+	addActionParameters(registry, proto)
+
 	return &pb.DescribeCanvasResponse{
 		Canvas: proto,
 	}, nil
 }
+
+/*
+ * Forms for invoking trigger actions need their parameters,
+ * and some triggers define those through the node configuration.
+ */
+func addActionParameters(registry *registry.Registry, canvas *pb.Canvas) {
+	if canvas.Spec == nil {
+		return
+	}
+
+	for _, node := range canvas.Spec.Nodes {
+		if node.Type != componentpb.Node_TYPE_TRIGGER || node.Trigger == nil {
+			continue
+		}
+
+		trigger, err := registry.GetTrigger(node.Trigger.Name)
+		if err != nil {
+			continue
+		}
+
+		configurable, ok := trigger.(core.ConfigurableActionParameters)
+		if !ok {
+			continue
+		}
+
+		for _, action := range trigger.Actions() {
+			if !action.UserAccessible {
+				continue
+			}
+
+			fields, err := configurable.ActionParameters(action.Name, node.Configuration.AsMap())
+			if err != nil {
+				continue
+			}
+
+			parameters := []*configpb.Field{}
+			for _, field := range fields {
+				parameters = append(parameters, actions.ConfigurationFieldToProto(field))
+			}
+
+			node.ActionParameters = append(node.ActionParameters, &componentpb.Node_ActionParameters{
+				Action:     action.Name,
+				Parameters: parameters,
+			})
+		}
+	}
+}
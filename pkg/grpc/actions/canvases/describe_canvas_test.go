@@ -657,4 +657,52 @@ func Test__DescribeCanvas(t *testing.T) {
 		assert.True(t, eventIDs[activeEvent2.ID.String()])
 		assert.False(t, eventIDs[deletedEvent.ID.String()])
 	})
+
+	t.Run("trigger nodes include the parameters of their actions", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(
+			t,
+			r.Organization.ID,
+			r.User,
+			[]models.CanvasNode{
+				{
+					NodeID: "start",
+					Name:   "Start",
+					Type:   models.NodeTypeTrigger,
+					Ref: datatypes.NewJSONType(models.NodeRef{
+						Trigger: &models.TriggerRef{Name: "start"},
+					}),
+					Configuration: datatypes.NewJSONType(map[string]any{
+						"parameters": []any{
+							map[string]any{"name": "environment", "type": "string", "required": true},
+						},
+					}),
+				},
+				{
+					NodeID: "noop",
+					Name:   "Noop",
+					Type:   models.NodeTypeComponent,
+					Ref: datatypes.NewJSONType(models.NodeRef{
+						Component: &models.ComponentRef{Name: "noop"},
+					}),
+				},
+			},
+			[]models.Edge{},
+		)
+
+		response, err := DescribeCanvas(context.Background(), r.Registry, r.Organization.ID.String(), canvas.ID.String())
+		require.NoError(t, err)
+
+		for _, node := range response.Canvas.Spec.Nodes {
+			if node.Id == "noop" {
+				assert.Empty(t, node.ActionParameters)
+				continue
+			}
+
+			require.Len(t, node.ActionParameters, 1)
+			assert.Equal(t, "emitEvent", node.ActionParameters[0].Action)
+			require.Len(t, node.ActionParameters[0].Parameters, 1)
+			assert.Equal(t, "environment", node.ActionParameters[0].Parameters[0].Name)
+			assert.True(t, node.ActionParameters[0].Parameters[0].Required)
+		}
+	})
 }
@@ -0,0 +1,195 @@
+package canvases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	DefaultNodeStatusWindow = 24 * time.Hour
+	MaxNodeStatusWindow     = 30 * 24 * time.Hour
+	MaxNodeStatusNodes      = 200
+)
+
+func GetNodeStatus(ctx context.Context, canvasID uuid.UUID, nodeID string, windowSeconds uint32) (*pb.GetNodeStatusResponse, error) {
+	if nodeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "node_id is required")
+	}
+
+	statuses, err := findNodeStatuses(canvasID, []string{nodeID}, windowSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(statuses) == 0 {
+		return nil, status.Error(codes.NotFound, "canvas node not found")
+	}
+
+	return &pb.GetNodeStatusResponse{Status: statuses[0]}, nil
+}
+
+/*
+ * If no node IDs are given, the status of every node in the canvas is returned.
+ * Node IDs that do not exist in the canvas are ignored.
+ */
+func ListNodeStatuses(ctx context.Context, canvasID uuid.UUID, nodeIDs []string, windowSeconds uint32) (*pb.ListNodeStatusesResponse, error) {
+	if len(nodeIDs) > MaxNodeStatusNodes {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d node IDs can be given", MaxNodeStatusNodes)
+	}
+
+	if len(nodeIDs) == 0 {
+		nodes, err := models.FindCanvasNodes(canvasID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range nodes {
+			nodeIDs = append(nodeIDs, node.NodeID)
+		}
+	}
+
+	statuses, err := findNodeStatuses(canvasID, nodeIDs, windowSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListNodeStatusesResponse{Statuses: statuses}, nil
+}
+
+/*
+ * The status of all the nodes is built from a fixed number of queries,
+ * regardless of the number of nodes and executions.
+ */
+func findNodeStatuses(canvasID uuid.UUID, nodeIDs []string, windowSeconds uint32) ([]*pb.NodeStatus, error) {
+	window, err := getNodeStatusWindow(windowSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nodeIDs) == 0 {
+		return []*pb.NodeStatus{}, nil
+	}
+
+	nodes, err := models.FindCanvasNodesByIDs(database.Conn(), canvasID, nodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nodes) == 0 {
+		return []*pb.NodeStatus{}, nil
+	}
+
+	windowStart := time.Now().Add(-window)
+	counts, err := models.CountNodeExecutionsByResult(canvasID, nodeIDs, windowStart)
+	if err != nil {
+		return nil, err
+	}
+
+	lastExecutions, err := models.FindLastNodeExecutions(canvasID, nodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	queueDepths, err := models.CountNodeQueueItemsForNodes(canvasID, nodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[string]*pb.NodeStatus, len(nodes))
+	for _, node := range nodes {
+		statuses[node.NodeID] = &pb.NodeStatus{
+			NodeId:          node.NodeID,
+			Paused:          node.State == models.CanvasNodeStatePaused,
+			QueueDepth:      uint32(queueDepths[node.NodeID]),
+			ExecutionCounts: &pb.NodeExecutionCounts{},
+			WindowStart:     timestamppb.New(windowStart),
+		}
+	}
+
+	for _, count := range counts {
+		nodeStatus, ok := statuses[count.NodeID]
+		if !ok {
+			continue
+		}
+
+		addExecutionCount(nodeStatus.ExecutionCounts, count)
+	}
+
+	for _, execution := range lastExecutions {
+		nodeStatus, ok := statuses[execution.NodeID]
+		if !ok {
+			continue
+		}
+
+		nodeStatus.LastExecution = serializeNodeStatusExecution(execution)
+		if execution.Result == models.CanvasNodeExecutionResultFailed {
+			nodeStatus.LastErrorMessage = execution.ResultMessage
+		}
+	}
+
+	//
+	// Keep the order in which the node IDs were requested, skipping duplicates.
+	//
+	result := make([]*pb.NodeStatus, 0, len(statuses))
+	for _, nodeID := range nodeIDs {
+		nodeStatus, ok := statuses[nodeID]
+		if !ok {
+			continue
+		}
+
+		result = append(result, nodeStatus)
+		delete(statuses, nodeID)
+	}
+
+	return result, nil
+}
+
+func getNodeStatusWindow(windowSeconds uint32) (time.Duration, error) {
+	if windowSeconds == 0 {
+		return DefaultNodeStatusWindow, nil
+	}
+
+	window := time.Duration(windowSeconds) * time.Second
+	if window > MaxNodeStatusWindow {
+		return 0, status.Errorf(codes.InvalidArgument, "window_seconds must be at most %d", int(MaxNodeStatusWindow.Seconds()))
+	}
+
+	return window, nil
+}
+
+func addExecutionCount(counts *pb.NodeExecutionCounts, count models.NodeExecutionCount) {
+	switch count.State {
+	case models.CanvasNodeExecutionStatePending:
+		counts.Pending += uint32(count.Count)
+	case models.CanvasNodeExecutionStateStarted:
+		counts.Started += uint32(count.Count)
+	case models.CanvasNodeExecutionStateFinished:
+		switch count.Result {
+		case models.CanvasNodeExecutionResultPassed:
+			counts.Passed += uint32(count.Count)
+		case models.CanvasNodeExecutionResultFailed:
+			counts.Failed += uint32(count.Count)
+		case models.CanvasNodeExecutionResultCancelled:
+			counts.Cancelled += uint32(count.Count)
+		}
+	}
+}
+
+func serializeNodeStatusExecution(execution models.CanvasNodeExecution) *pb.NodeStatusExecution {
+	return &pb.NodeStatusExecution{
+		Id:           execution.ID.String(),
+		State:        NodeExecutionStateToProto(execution.State),
+		Result:       NodeExecutionResultToProto(execution.Result),
+		ResultReason: NodeExecutionResultReasonToProto(execution.ResultReason),
+		CreatedAt:    timestamppb.New(*execution.CreatedAt),
+		UpdatedAt:    timestamppb.New(*execution.UpdatedAt),
+	}
+}
@@ -0,0 +1,144 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func finishNodeExecution(t *testing.T, execution *models.CanvasNodeExecution, result, message string, createdAt time.Time) {
+	require.NoError(t, database.Conn().
+		Model(execution).
+		Updates(map[string]any{
+			"state":          models.CanvasNodeExecutionStateFinished,
+			"result":         result,
+			"result_message": message,
+			"created_at":     createdAt,
+		}).Error)
+}
+
+func Test__GetNodeStatus(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, nodes := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+			{
+				NodeID: "node-2",
+				Name:   "Node 2",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	require.NoError(t, nodes[1].UpdateState(database.Conn(), models.CanvasNodeStatePaused))
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+
+	//
+	// node-1: one passed execution outside of the window,
+	// one passed and one failed execution inside of it,
+	// with the failed one being the last one.
+	//
+	old := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+	finishNodeExecution(t, old, models.CanvasNodeExecutionResultPassed, "", time.Now().Add(-48*time.Hour))
+	passed := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+	finishNodeExecution(t, passed, models.CanvasNodeExecutionResultPassed, "", time.Now().Add(-time.Hour))
+	failed := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+	finishNodeExecution(t, failed, models.CanvasNodeExecutionResultFailed, "boom", time.Now().Add(-time.Minute))
+
+	//
+	// node-2: one pending execution and two queue items.
+	//
+	support.CreateCanvasNodeExecution(t, canvas.ID, "node-2", rootEvent.ID, rootEvent.ID, nil)
+	support.CreateQueueItem(t, canvas.ID, "node-2", rootEvent.ID, rootEvent.ID)
+	support.CreateQueueItem(t, canvas.ID, "node-2", rootEvent.ID, rootEvent.ID)
+
+	t.Run("node not found -> error", func(t *testing.T) {
+		_, err := GetNodeStatus(context.Background(), canvas.ID, "does-not-exist", 0)
+		require.Error(t, err)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("window too large -> error", func(t *testing.T) {
+		_, err := GetNodeStatus(context.Background(), canvas.ID, "node-1", uint32(MaxNodeStatusWindow.Seconds())+1)
+		require.Error(t, err)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("summarizes executions in the default window", func(t *testing.T) {
+		response, err := GetNodeStatus(context.Background(), canvas.ID, "node-1", 0)
+		require.NoError(t, err)
+
+		nodeStatus := response.Status
+		assert.Equal(t, "node-1", nodeStatus.NodeId)
+		assert.False(t, nodeStatus.Paused)
+		assert.Equal(t, uint32(0), nodeStatus.QueueDepth)
+		assert.Equal(t, uint32(1), nodeStatus.ExecutionCounts.Passed)
+		assert.Equal(t, uint32(1), nodeStatus.ExecutionCounts.Failed)
+		require.NotNil(t, nodeStatus.LastExecution)
+		assert.Equal(t, failed.ID.String(), nodeStatus.LastExecution.Id)
+		assert.Equal(t, pb.CanvasNodeExecution_RESULT_FAILED, nodeStatus.LastExecution.Result)
+		assert.Equal(t, "boom", nodeStatus.LastErrorMessage)
+	})
+
+	t.Run("window can be configured", func(t *testing.T) {
+		response, err := GetNodeStatus(context.Background(), canvas.ID, "node-1", uint32((72 * time.Hour).Seconds()))
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), response.Status.ExecutionCounts.Passed)
+		assert.Equal(t, uint32(1), response.Status.ExecutionCounts.Failed)
+	})
+
+	t.Run("batch variant returns statuses in requested order", func(t *testing.T) {
+		response, err := ListNodeStatuses(context.Background(), canvas.ID, []string{"node-2", "does-not-exist", "node-1"}, 0)
+		require.NoError(t, err)
+		require.Len(t, response.Statuses, 2)
+
+		assert.Equal(t, "node-2", response.Statuses[0].NodeId)
+		assert.True(t, response.Statuses[0].Paused)
+		assert.Equal(t, uint32(2), response.Statuses[0].QueueDepth)
+		assert.Equal(t, uint32(1), response.Statuses[0].ExecutionCounts.Pending)
+		assert.Empty(t, response.Statuses[0].LastErrorMessage)
+		assert.Equal(t, "node-1", response.Statuses[1].NodeId)
+	})
+
+	t.Run("batch variant without node IDs returns all nodes", func(t *testing.T) {
+		response, err := ListNodeStatuses(context.Background(), canvas.ID, []string{}, 0)
+		require.NoError(t, err)
+		assert.Len(t, response.Statuses, 2)
+	})
+
+	t.Run("batch variant with unknown canvas returns no statuses", func(t *testing.T) {
+		response, err := ListNodeStatuses(context.Background(), uuid.New(), []string{"node-1"}, 0)
+		require.NoError(t, err)
+		assert.Empty(t, response.Statuses)
+	})
+}
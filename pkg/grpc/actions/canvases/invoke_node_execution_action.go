@@ -19,6 +19,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 func InvokeNodeExecutionAction(
@@ -37,77 +38,113 @@ func InvokeNodeExecutionAction(
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	canvas, err := models.FindCanvas(orgID, canvasID)
+	user, err := models.FindActiveUserByID(orgID.String(), userID)
 	if err != nil {
-		return nil, fmt.Errorf("canvas not found: %w", err)
+		return nil, fmt.Errorf("user not found: %w", err)
 	}
 
-	execution, err := models.FindNodeExecution(canvas.ID, executionID)
+	err = InvokeNodeExecutionActionAsUser(authService, encryptor, registry, orgID, canvasID, executionID, user, actionName, parameters)
 	if err != nil {
-		return nil, fmt.Errorf("execution not found: %w", err)
+		return nil, err
 	}
 
-	node, err := canvas.FindNode(execution.NodeID)
+	return &pb.InvokeNodeExecutionActionResponse{}, nil
+}
+
+/*
+ * InvokeNodeExecutionActionAsUser runs an execution action on behalf of a user
+ * that was already authenticated by the caller.
+ *
+ * The execution is locked while the action runs, so concurrent actions
+ * on the same execution, e.g. an approval from the UI and one from a link,
+ * do not both finish it.
+ */
+func InvokeNodeExecutionActionAsUser(
+	authService authorization.Authorization,
+	encryptor crypto.Encryptor,
+	registry *registry.Registry,
+	orgID uuid.UUID,
+	canvasID uuid.UUID,
+	executionID uuid.UUID,
+	user *models.User,
+	actionName string,
+	parameters map[string]any,
+) error {
+	canvas, err := models.FindCanvas(orgID, canvasID)
 	if err != nil {
-		return nil, fmt.Errorf("node not found: %w", err)
+		return fmt.Errorf("canvas not found: %w", err)
 	}
 
-	//
-	// TODO
-	// Blueprint nodes don't expose actions for now.
-	//
-	if node.Ref.Data().Component == nil {
-		return nil, fmt.Errorf("node is not a component node")
-	}
+	var execution *models.CanvasNodeExecution
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		execution, err = models.FindNodeExecutionForUpdateInTransaction(tx, canvas.ID, executionID)
+		if err != nil {
+			return fmt.Errorf("execution not found: %w", err)
+		}
 
-	component, err := registry.GetComponent(node.Ref.Data().Component.Name)
-	if err != nil {
-		return nil, fmt.Errorf("component not found: %w", err)
-	}
+		node, err := canvas.FindNode(execution.NodeID)
+		if err != nil {
+			return fmt.Errorf("node not found: %w", err)
+		}
 
-	actionDef := findAction(component, actionName)
-	if actionDef == nil {
-		return nil, fmt.Errorf("action '%s' not found for component '%s'", actionName, node.Ref.Data().Component.Name)
-	}
+		//
+		// TODO
+		// Blueprint nodes don't expose actions for now.
+		//
+		if node.Ref.Data().Component == nil {
+			return fmt.Errorf("node is not a component node")
+		}
 
-	if err := configuration.ValidateConfiguration(actionDef.Parameters, parameters); err != nil {
-		return nil, fmt.Errorf("action parameter validation failed: %w", err)
-	}
+		component, err := registry.GetComponent(node.Ref.Data().Component.Name)
+		if err != nil {
+			return fmt.Errorf("component not found: %w", err)
+		}
 
-	user, err := models.FindActiveUserByID(orgID.String(), userID)
-	if err != nil {
-		return nil, fmt.Errorf("user not found: %w", err)
-	}
+		actionDef := findAction(component, actionName)
+		if actionDef == nil {
+			return fmt.Errorf("action '%s' not found for component '%s'", actionName, node.Ref.Data().Component.Name)
+		}
 
-	tx := database.Conn()
-	logger := logging.ForExecution(execution, nil)
-	actionCtx := core.ActionContext{
-		Name:           actionName,
-		Parameters:     parameters,
-		Configuration:  node.Configuration.Data(),
-		HTTP:           registry.HTTPContext(),
-		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
-		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
-		Auth:           contexts.NewAuthContext(tx, orgID, authService, user),
-		Requests:       contexts.NewExecutionRequestContext(tx, execution),
-		Notifications:  contexts.NewNotificationContext(tx, orgID, canvas.ID),
-	}
+		if err := configuration.ValidateConfiguration(actionDef.Parameters, parameters); err != nil {
+			return fmt.Errorf("action parameter validation failed: %w", err)
+		}
+
+		logger := logging.ForExecution(execution, nil)
+		actionCtx := core.ActionContext{
+			Name:           actionName,
+			Parameters:     parameters,
+			Configuration:  node.Configuration.Data(),
+			HTTP:           registry.HTTPContext(),
+			Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
+			Attachments:    contexts.NewExecutionAttachmentContext(tx, execution),
+			ExecutionState: contexts.NewExecutionStateContext(tx, execution),
+			Auth:           contexts.NewAuthContext(tx, orgID, authService, user),
+			Requests:       contexts.NewExecutionRequestContext(tx, execution),
+			Notifications:  contexts.NewNotificationContext(tx, orgID, canvas.ID),
+		}
+
+		if node.AppInstallationID != nil {
+			integration, err := models.FindUnscopedIntegrationInTransaction(tx, *node.AppInstallationID)
+			if err != nil {
+				logger.Errorf("error finding app installation: %v", err)
+				return status.Error(codes.Internal, "error building context")
+			}
 
-	if node.AppInstallationID != nil {
-		integration, err := models.FindUnscopedIntegrationInTransaction(tx, *node.AppInstallationID)
+			logger = logging.WithIntegration(logger, *integration)
+			actionCtx.Integration = contexts.NewIntegrationContext(tx, node, integration, encryptor, registry)
+		}
+
+		actionCtx.Logger = logger
+		err = component.HandleAction(actionCtx)
 		if err != nil {
-			logger.Errorf("error finding app installation: %v", err)
-			return nil, status.Error(codes.Internal, "error building context")
+			return status.Errorf(codes.InvalidArgument, "action execution failed: %v", err)
 		}
 
-		logger = logging.WithIntegration(logger, *integration)
-		actionCtx.Integration = contexts.NewIntegrationContext(tx, node, integration, encryptor, registry)
-	}
+		return nil
+	})
 
-	actionCtx.Logger = logger
-	err = component.HandleAction(actionCtx)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "action execution failed: %v", err)
+		return err
 	}
 
 	messages.NewCanvasExecutionMessage(
@@ -116,7 +153,7 @@ func InvokeNodeExecutionAction(
 		execution.NodeID,
 	).Publish()
 
-	return &pb.InvokeNodeExecutionActionResponse{}, nil
+	return nil
 }
 
 func findAction(component core.Component, actionName string) *core.Action {
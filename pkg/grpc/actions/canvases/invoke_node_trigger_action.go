@@ -15,6 +15,7 @@ import (
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	"github.com/superplanehq/superplane/pkg/registry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -67,8 +68,13 @@ func InvokeNodeTriggerAction(
 		return nil, status.Errorf(codes.PermissionDenied, "action '%s' is not user accessible", actionName)
 	}
 
-	if err := configuration.ValidateConfiguration(actionDef.Parameters, parameters); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "action parameter validation failed: %v", err)
+	parameterFields, err := findTriggerActionParameters(trigger, actionDef, node)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "action parameters not available: %v", err)
+	}
+
+	if fieldErrors := configuration.ValidateConfigurationFields(parameterFields, parameters); len(fieldErrors) > 0 {
+		return nil, parameterValidationError(fieldErrors)
 	}
 
 	_, err = models.FindActiveUserByID(orgID.String(), userID)
@@ -86,6 +92,7 @@ func InvokeNodeTriggerAction(
 		HTTP:          registry.HTTPContext(),
 		Metadata:      contexts.NewNodeMetadataContext(tx, node),
 		Requests:      contexts.NewNodeRequestContext(tx, node),
+		Events:        contexts.NewEventContext(tx, node),
 		Webhook:       contexts.NewNodeWebhookContext(ctx, tx, encryptor, node, webhookBaseURL),
 	}
 
@@ -126,3 +133,34 @@ func findTriggerAction(trigger core.Trigger, actionName string) *core.Action {
 
 	return nil
 }
+
+func findTriggerActionParameters(trigger core.Trigger, action *core.Action, node *models.CanvasNode) ([]configuration.Field, error) {
+	configurable, ok := trigger.(core.ConfigurableActionParameters)
+	if !ok {
+		return action.Parameters, nil
+	}
+
+	return configurable.ActionParameters(action.Name, node.Configuration.Data())
+}
+
+/*
+ * Each invalid parameter is returned as a field violation,
+ * so clients can show the errors next to the parameters in their forms.
+ */
+func parameterValidationError(fieldErrors []configuration.FieldError) error {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+	for _, fieldError := range fieldErrors {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       fieldError.Field,
+			Description: fieldError.Message,
+		})
+	}
+
+	st := status.Newf(codes.InvalidArgument, "action parameter validation failed: %v", fieldErrors[0])
+	withDetails, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
@@ -0,0 +1,170 @@
+package canvases
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/triggers/manual"
+	start "github.com/superplanehq/superplane/pkg/triggers/start"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__InvokeNodeTriggerAction(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Name:   "Manual",
+				Type:   models.NodeTypeTrigger,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Trigger: &models.TriggerRef{Name: "manual"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	t.Run("manual trigger emits the payload as a canvas event", func(t *testing.T) {
+		payload := map[string]any{"environment": "production", "version": "v1.2.3"}
+		_, err := InvokeNodeTriggerAction(
+			ctx,
+			r.AuthService,
+			r.Encryptor,
+			r.Registry,
+			r.Organization.ID,
+			canvas.ID,
+			"trigger-1",
+			manual.ActionEmitEvent,
+			map[string]any{"payload": payload},
+			"http://localhost:8000",
+		)
+		require.NoError(t, err)
+
+		events, err := models.ListCanvasEvents(canvas.ID, "trigger-1", 10, nil)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		data, ok := events[0].Data.Data().(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, manual.EventType, data["type"])
+		assert.Equal(t, payload, data["data"])
+	})
+
+	t.Run("oversized payload is rejected", func(t *testing.T) {
+		_, err := InvokeNodeTriggerAction(
+			ctx,
+			r.AuthService,
+			r.Encryptor,
+			r.Registry,
+			r.Organization.ID,
+			canvas.ID,
+			"trigger-1",
+			manual.ActionEmitEvent,
+			map[string]any{"payload": map[string]any{"data": strings.Repeat("a", manual.MaxEventSize)}},
+			"http://localhost:8000",
+		)
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Contains(t, s.Message(), "payload is too large")
+
+		events, err := models.ListCanvasEvents(canvas.ID, "trigger-1", 10, nil)
+		require.NoError(t, err)
+		assert.Len(t, events, 1)
+	})
+}
+
+func Test__InvokeNodeTriggerAction__PayloadSchema(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "start",
+				Name:   "Start",
+				Type:   models.NodeTypeTrigger,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Trigger: &models.TriggerRef{Name: "start"},
+				}),
+				Configuration: datatypes.NewJSONType(map[string]any{
+					"parameters": []any{
+						map[string]any{"name": "environment", "type": "string", "required": true},
+						map[string]any{"name": "replicas", "type": "number", "default": "3"},
+					},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	invoke := func(parameters map[string]any) error {
+		_, err := InvokeNodeTriggerAction(
+			ctx,
+			r.AuthService,
+			r.Encryptor,
+			r.Registry,
+			r.Organization.ID,
+			canvas.ID,
+			"start",
+			start.ActionEmitEvent,
+			parameters,
+			"http://localhost:8000",
+		)
+
+		return err
+	}
+
+	t.Run("invalid parameters -> field errors", func(t *testing.T) {
+		err := invoke(map[string]any{"replicas": "three"})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		require.Len(t, s.Details(), 1)
+
+		badRequest, ok := s.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		require.Len(t, badRequest.FieldViolations, 2)
+		assert.Equal(t, "environment", badRequest.FieldViolations[0].Field)
+		assert.Equal(t, "field 'environment' is required", badRequest.FieldViolations[0].Description)
+		assert.Equal(t, "replicas", badRequest.FieldViolations[1].Field)
+		assert.Equal(t, "field 'replicas': must be a number", badRequest.FieldViolations[1].Description)
+
+		events, err := models.ListCanvasEvents(canvas.ID, "start", 10, nil)
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("valid parameters -> emitted as the event payload", func(t *testing.T) {
+		require.NoError(t, invoke(map[string]any{"environment": "production"}))
+
+		events, err := models.ListCanvasEvents(canvas.ID, "start", 10, nil)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		data, ok := events[0].Data.Data().(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, start.EventType, data["type"])
+		assert.Equal(t, map[string]any{"environment": "production", "replicas": 3.0}, data["data"])
+	})
+}
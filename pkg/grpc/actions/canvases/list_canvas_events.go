@@ -2,29 +2,77 @@ package canvases
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func ListCanvasEvents(ctx context.Context, registry *registry.Registry, canvasID uuid.UUID, limit uint32, before *timestamppb.Timestamp) (*pb.ListCanvasEventsResponse, error) {
-	limit = getLimit(limit)
-	beforeTime := getBefore(before)
-	events, err := models.ListRootCanvasEvents(canvasID, int(limit), beforeTime)
-	if err != nil {
-		return nil, err
+func ListCanvasEvents(ctx context.Context, registry *registry.Registry, canvasID uuid.UUID, req *pb.ListCanvasEventsRequest) (*pb.ListCanvasEventsResponse, error) {
+	filters := models.CanvasEventFilters{
+		NodeID:      req.NodeId,
+		Channel:     req.Channel,
+		PayloadType: req.PayloadType,
+		After:       getBefore(req.After),
+		Before:      getBefore(req.Before),
+	}
+
+	if filters.After != nil && filters.Before != nil && !filters.After.Before(*filters.Before) {
+		return nil, status.Error(codes.InvalidArgument, "after must be earlier than before")
 	}
 
-	count, err := models.CountRootCanvasEvents(canvasID)
+	if req.CountOnly {
+		return countCanvasEvents(canvasID, filters)
+	}
+
+	var cursor *models.CanvasEventCursor
+	if req.Cursor != "" {
+		c, err := decodeCanvasEventCursor(req.Cursor)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+
+		cursor = c
+	}
+
+	//
+	// One more event than requested is loaded,
+	// to know if there is a next page without counting.
+	//
+	limit := int(getLimit(req.Limit))
+	events, err := models.ListRootCanvasEvents(canvasID, filters, cursor, limit+1)
 	if err != nil {
 		return nil, err
 	}
 
+	hasNextPage := len(events) > limit
+	if hasNextPage {
+		events = events[:limit]
+	}
+
+	//
+	// Counting all the events is only done for the first page,
+	// so deep pages stay fast on canvases with many events.
+	// Later pages rely on the extra event loaded above instead.
+	//
+	var count int64
+	if cursor == nil {
+		count, err = models.CountRootCanvasEvents(canvasID, filters)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	executionsByEventID, childExecutionsByEventID, err := listExecutionsForCanvasEvents(events)
 	if err != nil {
 		return nil, err
@@ -35,14 +83,80 @@ func ListCanvasEvents(ctx context.Context, registry *registry.Registry, canvasID
 		return nil, err
 	}
 
-	return &pb.ListCanvasEventsResponse{
+	response := &pb.ListCanvasEventsResponse{
 		Events:        serialized,
 		TotalCount:    uint32(count),
-		HasNextPage:   hasNextPage(len(events), int(limit), count),
+		HasNextPage:   hasNextPage,
 		LastTimestamp: getLastEventTimestamp(events),
+	}
+
+	if hasNextPage {
+		last := events[len(events)-1]
+		response.NextCursor = encodeCanvasEventCursor(models.CanvasEventCursor{CreatedAt: *last.CreatedAt, ID: last.ID})
+	}
+
+	return response, nil
+}
+
+/*
+ * Counts the events per node, without loading them,
+ * for the overview of the canvas.
+ */
+func countCanvasEvents(canvasID uuid.UUID, filters models.CanvasEventFilters) (*pb.ListCanvasEventsResponse, error) {
+	counts, err := models.CountRootCanvasEventsByNode(canvasID, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	nodeCounts := make([]*pb.CanvasEventNodeCount, 0, len(counts))
+	for _, count := range counts {
+		total += count.Count
+		nodeCounts = append(nodeCounts, &pb.CanvasEventNodeCount{
+			NodeId: count.NodeID,
+			Count:  uint32(count.Count),
+		})
+	}
+
+	return &pb.ListCanvasEventsResponse{
+		TotalCount: uint32(total),
+		NodeCounts: nodeCounts,
 	}, nil
 }
 
+/*
+ * Cursors are opaque to clients: the creation time,
+ * in microseconds, and the ID of the last event of the page.
+ */
+func encodeCanvasEventCursor(cursor models.CanvasEventCursor) string {
+	value := fmt.Sprintf("%d:%s", cursor.CreatedAt.UnixMicro(), cursor.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+func decodeCanvasEventCursor(cursor string) (*models.CanvasEventCursor, error) {
+	value, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, id, ok := strings.Cut(string(value), ":")
+	if !ok {
+		return nil, fmt.Errorf("malformed cursor")
+	}
+
+	micros, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	eventID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CanvasEventCursor{CreatedAt: time.UnixMicro(micros).UTC(), ID: eventID}, nil
+}
+
 func SerializeCanvasEvents(events []models.CanvasEvent) ([]*pb.CanvasEvent, error) {
 	result := make([]*pb.CanvasEvent, 0, len(events))
 
@@ -3,13 +3,18 @@ package canvases
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/datatypes"
 )
 
@@ -42,7 +47,7 @@ func Test__ListCanvasEvents__ReturnsEventsWithExecutions(t *testing.T) {
 	parentExecution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent1.ID, rootEvent1.ID, nil)
 	nextExecution := support.CreateNextNodeExecution(t, canvas.ID, "node-1", rootEvent1.ID, rootEvent1.ID, &parentExecution.ID)
 
-	response, err := ListCanvasEvents(context.Background(), r.Registry, canvas.ID, 0, nil)
+	response, err := ListCanvasEvents(context.Background(), r.Registry, canvas.ID, &pb.ListCanvasEventsRequest{})
 	require.NoError(t, err)
 	require.NotNil(t, response)
 	require.Len(t, response.Events, 2)
@@ -73,6 +78,122 @@ func Test__ListCanvasEvents__ReturnsEventsWithExecutions(t *testing.T) {
 	assert.Empty(t, event2.Executions)
 }
 
+func Test__ListCanvasEvents__FiltersAndCursor(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+
+	start := time.Now()
+	pushes := []*models.CanvasEvent{}
+	for range 5 {
+		event := support.EmitCanvasEventForNodeWithData(t, canvas.ID, "node-1", "default", nil, map[string]any{"type": "github.push"})
+		pushes = append(pushes, event)
+	}
+
+	support.EmitCanvasEventForNodeWithData(t, canvas.ID, "node-1", "failed", nil, map[string]any{"type": "github.push"})
+	support.EmitCanvasEventForNodeWithData(t, canvas.ID, "node-2", "default", nil, map[string]any{"type": "github.release"})
+	support.EmitCanvasEventForNodeWithData(t, canvas.ID, "node-2", "default", nil, map[string]any{"type": "github.release"})
+
+	list := func(t *testing.T, req *pb.ListCanvasEventsRequest) *pb.ListCanvasEventsResponse {
+		response, err := ListCanvasEvents(context.Background(), r.Registry, canvas.ID, req)
+		require.NoError(t, err)
+		return response
+	}
+
+	t.Run("filters by node, channel and payload type", func(t *testing.T) {
+		response := list(t, &pb.ListCanvasEventsRequest{NodeId: "node-1"})
+		assert.Len(t, response.Events, 6)
+		assert.Equal(t, uint32(6), response.TotalCount)
+
+		response = list(t, &pb.ListCanvasEventsRequest{NodeId: "node-1", Channel: "failed"})
+		assert.Len(t, response.Events, 1)
+
+		response = list(t, &pb.ListCanvasEventsRequest{PayloadType: "github.release"})
+		require.Len(t, response.Events, 2)
+		assert.Equal(t, "node-2", response.Events[0].NodeId)
+	})
+
+	t.Run("filters by time range", func(t *testing.T) {
+		response := list(t, &pb.ListCanvasEventsRequest{After: timestamppb.New(time.Now().Add(time.Minute))})
+		assert.Empty(t, response.Events)
+
+		response = list(t, &pb.ListCanvasEventsRequest{After: timestamppb.New(start.Add(-time.Minute)), Before: timestamppb.New(time.Now().Add(time.Minute))})
+		assert.Len(t, response.Events, 8)
+
+		_, err := ListCanvasEvents(context.Background(), r.Registry, canvas.ID, &pb.ListCanvasEventsRequest{
+			After:  timestamppb.New(time.Now()),
+			Before: timestamppb.New(start),
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("pages through events with the cursor", func(t *testing.T) {
+		seen := []string{}
+		req := &pb.ListCanvasEventsRequest{NodeId: "node-1", Channel: "default", Limit: 2}
+		for {
+			response := list(t, req)
+
+			//
+			// Events are only counted for the first page.
+			//
+			if req.Cursor == "" {
+				assert.Equal(t, uint32(len(pushes)), response.TotalCount)
+			} else {
+				assert.Zero(t, response.TotalCount)
+			}
+
+			for _, event := range response.Events {
+				seen = append(seen, event.Id)
+			}
+
+			if !response.HasNextPage {
+				assert.Empty(t, response.NextCursor)
+				break
+			}
+
+			require.NotEmpty(t, response.NextCursor)
+			req.Cursor = response.NextCursor
+		}
+
+		require.Len(t, seen, len(pushes))
+		for i, event := range pushes {
+			assert.Equal(t, event.ID.String(), seen[len(pushes)-1-i])
+		}
+	})
+
+	t.Run("invalid cursor -> error", func(t *testing.T) {
+		_, err := ListCanvasEvents(context.Background(), r.Registry, canvas.ID, &pb.ListCanvasEventsRequest{Cursor: "not-a-cursor"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("count only -> counts per node without events", func(t *testing.T) {
+		response := list(t, &pb.ListCanvasEventsRequest{CountOnly: true})
+		assert.Empty(t, response.Events)
+		assert.Equal(t, uint32(8), response.TotalCount)
+		require.Len(t, response.NodeCounts, 2)
+		assert.Equal(t, "node-1", response.NodeCounts[0].NodeId)
+		assert.Equal(t, uint32(6), response.NodeCounts[0].Count)
+		assert.Equal(t, "node-2", response.NodeCounts[1].NodeId)
+		assert.Equal(t, uint32(2), response.NodeCounts[1].Count)
+
+		response = list(t, &pb.ListCanvasEventsRequest{CountOnly: true, Channel: "failed"})
+		require.Len(t, response.NodeCounts, 1)
+		assert.Equal(t, uint32(1), response.TotalCount)
+	})
+}
+
+func Test__CanvasEventCursor(t *testing.T) {
+	cursor := models.CanvasEventCursor{CreatedAt: time.Now().UTC().Truncate(time.Microsecond), ID: uuid.New()}
+
+	decoded, err := decodeCanvasEventCursor(encodeCanvasEventCursor(cursor))
+	require.NoError(t, err)
+	assert.Equal(t, cursor, *decoded)
+
+	_, err = decodeCanvasEventCursor("not-a-cursor")
+	assert.Error(t, err)
+}
+
 func findCanvasEventWithExecutions(events []*pb.CanvasEventWithExecutions, id string) *pb.CanvasEventWithExecutions {
 	for _, event := range events {
 		if event.Id == id {
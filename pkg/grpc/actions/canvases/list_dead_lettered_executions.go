@@ -0,0 +1,74 @@
+package canvases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ListDeadLetteredExecutions(ctx context.Context, workflowID uuid.UUID, nodeID string, includeRequeued bool, limit uint32) (*pb.ListDeadLetteredExecutionsResponse, error) {
+	deadLetters, err := models.ListNodeExecutionDeadLetters(workflowID, nodeID, includeRequeued, int(getLimit(limit)))
+	if err != nil {
+		return nil, err
+	}
+
+	serialized := make([]*pb.DeadLetteredExecution, 0, len(deadLetters))
+	for _, deadLetter := range deadLetters {
+		s, err := serializeDeadLetteredExecution(deadLetter)
+		if err != nil {
+			return nil, err
+		}
+
+		serialized = append(serialized, s)
+	}
+
+	return &pb.ListDeadLetteredExecutionsResponse{DeadLetters: serialized}, nil
+}
+
+func serializeDeadLetteredExecution(deadLetter models.CanvasNodeExecutionDeadLetter) (*pb.DeadLetteredExecution, error) {
+	configuration, err := structpb.NewStruct(deadLetter.Configuration.Data())
+	if err != nil {
+		return nil, err
+	}
+
+	s := &pb.DeadLetteredExecution{
+		Id:            deadLetter.ID.String(),
+		CanvasId:      deadLetter.WorkflowID.String(),
+		NodeId:        deadLetter.NodeID,
+		ExecutionId:   deadLetter.ExecutionID.String(),
+		RootEventId:   deadLetter.RootEventID.String(),
+		EventId:       deadLetter.EventID.String(),
+		ConfigHash:    deadLetter.ConfigHash,
+		Configuration: configuration,
+		ResultReason:  deadLetter.ResultReason,
+		ResultMessage: deadLetter.ResultMessage,
+		CreatedAt:     timestamppb.New(*deadLetter.CreatedAt),
+	}
+
+	//
+	// The input is only missing if the event
+	// was already gone when the execution was dead-lettered.
+	//
+	if data, ok := deadLetter.Input.Data().(map[string]any); ok {
+		input, err := structpb.NewStruct(data)
+		if err != nil {
+			return nil, err
+		}
+
+		s.Input = input
+	}
+
+	if deadLetter.RequeuedExecutionID != nil {
+		s.RequeuedExecutionId = deadLetter.RequeuedExecutionID.String()
+	}
+
+	if deadLetter.RequeuedAt != nil {
+		s.RequeuedAt = timestamppb.New(*deadLetter.RequeuedAt)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,60 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func ListExecutionLogs(ctx context.Context, workflowID, executionID uuid.UUID) (*pb.ListExecutionLogsResponse, error) {
+	_, err := models.FindNodeExecution(workflowID, executionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "execution not found")
+		}
+
+		return nil, err
+	}
+
+	logs, err := models.ListNodeExecutionLogs(workflowID, executionID)
+	if err != nil {
+		return nil, err
+	}
+
+	serialized, err := serializeExecutionLogs(logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListExecutionLogsResponse{
+		Logs: serialized,
+	}, nil
+}
+
+func serializeExecutionLogs(logs []models.CanvasNodeExecutionLog) ([]*pb.ExecutionLog, error) {
+	result := make([]*pb.ExecutionLog, 0, len(logs))
+	for _, log := range logs {
+		fields, err := structpb.NewStruct(log.Fields.Data())
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &pb.ExecutionLog{
+			Id:        log.ID.String(),
+			Level:     log.Level,
+			Message:   log.Message,
+			Fields:    fields,
+			Timestamp: timestamppb.New(*log.CreatedAt),
+		})
+	}
+
+	return result, nil
+}
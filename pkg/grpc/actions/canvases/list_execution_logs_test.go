@@ -0,0 +1,110 @@
+package canvases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__ListExecutionLogs(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+
+	newLog := func(level, message string, createdAt time.Time, fields map[string]any) models.CanvasNodeExecutionLog {
+		return models.CanvasNodeExecutionLog{
+			Level:     level,
+			Message:   message,
+			Fields:    datatypes.NewJSONType(fields),
+			CreatedAt: &createdAt,
+		}
+	}
+
+	t.Run("returns logs in the order they were written", func(t *testing.T) {
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+		now := time.Now()
+
+		err := models.CreateNodeExecutionLogsInTransaction(database.Conn(), execution, []models.CanvasNodeExecutionLog{
+			newLog("info", "first", now, map[string]any{"attempt": 1}),
+			newLog("error", "second", now.Add(time.Second), map[string]any{}),
+		})
+		require.NoError(t, err)
+
+		response, err := ListExecutionLogs(context.Background(), canvas.ID, execution.ID)
+		require.NoError(t, err)
+		require.Len(t, response.Logs, 2)
+
+		assert.Equal(t, "info", response.Logs[0].Level)
+		assert.Equal(t, "first", response.Logs[0].Message)
+		assert.Equal(t, float64(1), response.Logs[0].Fields.AsMap()["attempt"])
+		assert.NotEmpty(t, response.Logs[0].Id)
+		assert.NotNil(t, response.Logs[0].Timestamp)
+		assert.Equal(t, "error", response.Logs[1].Level)
+		assert.Equal(t, "second", response.Logs[1].Message)
+	})
+
+	t.Run("only the most recent logs are kept for an execution", func(t *testing.T) {
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+		now := time.Now()
+
+		logs := []models.CanvasNodeExecutionLog{}
+		for i := range models.MaxLogsPerExecution {
+			logs = append(logs, newLog("info", fmt.Sprintf("message %d", i), now.Add(time.Duration(i)*time.Millisecond), map[string]any{}))
+		}
+
+		require.NoError(t, models.CreateNodeExecutionLogsInTransaction(database.Conn(), execution, logs))
+		require.NoError(t, models.CreateNodeExecutionLogsInTransaction(database.Conn(), execution, []models.CanvasNodeExecutionLog{
+			newLog("info", "latest", now.Add(time.Hour), map[string]any{}),
+		}))
+
+		response, err := ListExecutionLogs(context.Background(), canvas.ID, execution.ID)
+		require.NoError(t, err)
+		require.Len(t, response.Logs, models.MaxLogsPerExecution)
+		assert.Equal(t, "message 1", response.Logs[0].Message)
+		assert.Equal(t, "latest", response.Logs[len(response.Logs)-1].Message)
+	})
+
+	t.Run("execution without logs -> empty list", func(t *testing.T) {
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+
+		response, err := ListExecutionLogs(context.Background(), canvas.ID, execution.ID)
+		require.NoError(t, err)
+		assert.Empty(t, response.Logs)
+	})
+
+	t.Run("execution from another canvas -> not found", func(t *testing.T) {
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+
+		_, err := ListExecutionLogs(context.Background(), uuid.New(), execution.ID)
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
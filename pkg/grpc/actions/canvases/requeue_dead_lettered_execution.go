@@ -0,0 +1,58 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func RequeueDeadLetteredExecution(ctx context.Context, workflowID, deadLetterID uuid.UUID) (*pb.RequeueDeadLetteredExecutionResponse, error) {
+	var deadLetter *models.CanvasNodeExecutionDeadLetter
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		var err error
+		deadLetter, err = models.LockNodeExecutionDeadLetterInTransaction(tx, workflowID, deadLetterID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Error(codes.NotFound, "dead-lettered execution not found")
+			}
+
+			return err
+		}
+
+		execution, err := deadLetter.RequeueInTransaction(tx)
+		if err != nil {
+			if errors.Is(err, models.ErrDeadLetterAlreadyRequeued) {
+				return status.Error(codes.FailedPrecondition, err.Error())
+			}
+
+			return err
+		}
+
+		log.Infof("Dead-lettered execution %s re-enqueued as %s", deadLetter.ExecutionID, execution.ID)
+		return nil
+	})
+
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+
+		log.Errorf("failed to re-enqueue dead-lettered execution %s: %v", deadLetterID, err)
+		return nil, status.Error(codes.Internal, "failed to re-enqueue dead-lettered execution")
+	}
+
+	serialized, err := serializeDeadLetteredExecution(*deadLetter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RequeueDeadLetteredExecutionResponse{DeadLetter: serialized}, nil
+}
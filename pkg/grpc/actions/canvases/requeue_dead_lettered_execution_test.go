@@ -0,0 +1,126 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__RequeueDeadLetteredExecution(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+
+	t.Run("ordinary failures are not dead-lettered", func(t *testing.T) {
+		failed := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+		require.NoError(t, failed.Fail(models.CanvasNodeExecutionResultReasonError, "boom"))
+
+		response, err := ListDeadLetteredExecutions(context.Background(), canvas.ID, "", false, 0)
+		require.NoError(t, err)
+		assert.Empty(t, response.DeadLetters)
+	})
+
+	t.Run("permanently failed execution is dead-lettered", func(t *testing.T) {
+		require.NoError(t, execution.FailPermanently(models.CanvasNodeExecutionResultReasonError, "boom"))
+
+		response, err := ListDeadLetteredExecutions(context.Background(), canvas.ID, "", false, 0)
+		require.NoError(t, err)
+		require.Len(t, response.DeadLetters, 1)
+
+		deadLetter := response.DeadLetters[0]
+		assert.Equal(t, execution.ID.String(), deadLetter.ExecutionId)
+		assert.Equal(t, "node-1", deadLetter.NodeId)
+		assert.Equal(t, rootEvent.ID.String(), deadLetter.EventId)
+		assert.Equal(t, models.CanvasNodeExecutionResultReasonError, deadLetter.ResultReason)
+		assert.Equal(t, "boom", deadLetter.ResultMessage)
+		assert.NotEmpty(t, deadLetter.ConfigHash)
+		assert.Equal(t, "value", deadLetter.Input.AsMap()["key"])
+		assert.Empty(t, deadLetter.RequeuedExecutionId)
+	})
+
+	t.Run("child executions are not dead-lettered", func(t *testing.T) {
+		child := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, &execution.ID)
+		require.NoError(t, child.FailPermanently(models.CanvasNodeExecutionResultReasonError, "boom"))
+
+		response, err := ListDeadLetteredExecutions(context.Background(), canvas.ID, "node-1", false, 0)
+		require.NoError(t, err)
+		require.Len(t, response.DeadLetters, 1)
+		assert.Equal(t, execution.ID.String(), response.DeadLetters[0].ExecutionId)
+	})
+
+	t.Run("unknown dead letter returns not found", func(t *testing.T) {
+		_, err := RequeueDeadLetteredExecution(context.Background(), canvas.ID, uuid.New())
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("re-enqueuing creates a fresh pending execution", func(t *testing.T) {
+		list, err := ListDeadLetteredExecutions(context.Background(), canvas.ID, "", false, 0)
+		require.NoError(t, err)
+		require.Len(t, list.DeadLetters, 1)
+
+		deadLetterID := uuid.MustParse(list.DeadLetters[0].Id)
+		response, err := RequeueDeadLetteredExecution(context.Background(), canvas.ID, deadLetterID)
+		require.NoError(t, err)
+		require.NotEmpty(t, response.DeadLetter.RequeuedExecutionId)
+		require.NotNil(t, response.DeadLetter.RequeuedAt)
+
+		requeued, err := models.FindNodeExecution(canvas.ID, uuid.MustParse(response.DeadLetter.RequeuedExecutionId))
+		require.NoError(t, err)
+		assert.NotEqual(t, execution.ID, requeued.ID)
+		assert.Equal(t, models.CanvasNodeExecutionStatePending, requeued.State)
+		assert.Equal(t, execution.NodeID, requeued.NodeID)
+		assert.Equal(t, execution.RootEventID, requeued.RootEventID)
+		assert.Equal(t, execution.EventID, requeued.EventID)
+
+		node, err := models.FindCanvasNode(database.Conn(), canvas.ID, "node-1")
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeStateProcessing, node.State)
+
+		//
+		// Re-enqueued dead letters are only listed if requested.
+		//
+		list, err = ListDeadLetteredExecutions(context.Background(), canvas.ID, "", false, 0)
+		require.NoError(t, err)
+		assert.Empty(t, list.DeadLetters)
+
+		list, err = ListDeadLetteredExecutions(context.Background(), canvas.ID, "", true, 0)
+		require.NoError(t, err)
+		require.Len(t, list.DeadLetters, 1)
+		assert.Equal(t, response.DeadLetter.RequeuedExecutionId, list.DeadLetters[0].RequeuedExecutionId)
+
+		_, err = RequeueDeadLetteredExecution(context.Background(), canvas.ID, deadLetterID)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+	})
+}
@@ -165,6 +165,7 @@ func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) (
 	nodeIDs := make(map[string]bool)
 	nodeTypeByID := make(map[string]compb.Node_Type)
 	nodeValidationErrors := make(map[string]string)
+	outputChannelsByID := make(map[string][]core.OutputChannel)
 
 	for i, node := range canvas.Spec.Nodes {
 		if node.Id == "" {
@@ -184,6 +185,15 @@ func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) (
 
 		if err := validateNodeRef(registry, orgID, node); err != nil {
 			nodeValidationErrors[node.Id] = err.Error()
+			continue
+		}
+
+		if node.Type != compb.Node_TYPE_COMPONENT {
+			continue
+		}
+
+		if channels := findOutputChannels(registry, node); channels != nil {
+			outputChannelsByID[node.Id] = channels
 		}
 	}
 
@@ -210,6 +220,18 @@ func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) (
 		if nodeTypeByID[edge.TargetId] == compb.Node_TYPE_WIDGET {
 			return nil, nil, status.Errorf(codes.InvalidArgument, "edge %d: widget nodes cannot be used as target nodes", i)
 		}
+
+		//
+		// The output channels of a component can depend on its configuration,
+		// so edges from channels that are not available with the current one
+		// are reported on the source node, since they would never be used.
+		//
+		channels, ok := outputChannelsByID[edge.SourceId]
+		if ok && !core.HasOutputChannel(channels, edge.Channel) {
+			if _, hasError := nodeValidationErrors[edge.SourceId]; !hasError {
+				nodeValidationErrors[edge.SourceId] = fmt.Sprintf("output channel %s is not available with the current configuration", edge.Channel)
+			}
+		}
 	}
 
 	if err := actions.CheckForCycles(canvas.Spec.Nodes, canvas.Spec.Edges); err != nil {
@@ -251,7 +273,7 @@ func validateNodeRef(registry *registry.Registry, organizationID string, node *c
 			return err
 		}
 
-		return configuration.ValidateConfiguration(component.Configuration(), node.Configuration.AsMap())
+		return configuration.ValidateConfiguration(actions.AppendGlobalComponentFields(component.Configuration()), node.Configuration.AsMap())
 
 	case compb.Node_TYPE_BLUEPRINT:
 		if node.Blueprint == nil {
@@ -350,6 +372,29 @@ func findAndValidateComponent(registry *registry.Registry, organizationID string
 	return registry.GetIntegrationComponent(parts[0], node.Component.Name)
 }
 
+func findOutputChannels(registry *registry.Registry, node *compb.Node) []core.OutputChannel {
+	parts := strings.SplitN(node.Component.Name, ".", 2)
+
+	var component core.Component
+	var err error
+	if len(parts) == 1 {
+		component, err = registry.GetComponent(parts[0])
+	} else {
+		component, err = registry.GetIntegrationComponent(parts[0], node.Component.Name)
+	}
+
+	if err != nil {
+		return nil
+	}
+
+	channels := component.OutputChannels(node.Configuration.AsMap())
+	if len(channels) == 0 {
+		return []core.OutputChannel{core.DefaultOutputChannel}
+	}
+
+	return channels
+}
+
 func validateIntegration(organizationID string, ref *compb.IntegrationRef) error {
 	if ref == nil || ref.Id == "" {
 		return fmt.Errorf("integration is required")
@@ -602,6 +602,73 @@ func TestUpdateCanvas_ValidationErrorsPersisted(t *testing.T) {
 	assert.Contains(t, *invalidNode.StateReason, "nonexistent-component", "error reason should mention the invalid component")
 }
 
+func TestUpdateCanvas_UnavailableOutputChannelIsNodeError(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{},
+		[]models.Edge{},
+	)
+
+	ifConfig, _ := structpb.NewStruct(map[string]any{"expression": "true"})
+	updatedCanvasPB := &pb.Canvas{
+		Metadata: &pb.Canvas_Metadata{
+			Name:        canvas.Name,
+			Description: canvas.Description,
+		},
+		Spec: &pb.Canvas_Spec{
+			Nodes: []*componentpb.Node{
+				{
+					Id:            "if-node",
+					Name:          "If Node",
+					Type:          componentpb.Node_TYPE_COMPONENT,
+					Configuration: ifConfig,
+					Component: &componentpb.Node_ComponentRef{
+						Name: "if",
+					},
+				},
+				{
+					Id:   "noop-node",
+					Name: "Noop Node",
+					Type: componentpb.Node_TYPE_COMPONENT,
+					Component: &componentpb.Node_ComponentRef{
+						Name: "noop",
+					},
+				},
+			},
+			Edges: []*componentpb.Edge{
+				{SourceId: "if-node", TargetId: "noop-node", Channel: "true"},
+				{SourceId: "if-node", TargetId: "noop-node", Channel: "default"},
+			},
+		},
+	}
+
+	response, err := UpdateCanvas(
+		context.Background(),
+		r.Encryptor,
+		r.Registry,
+		r.Organization.ID.String(),
+		canvas.ID.String(),
+		updatedCanvasPB,
+		"http://localhost:3000/api/v1",
+	)
+	require.NoError(t, err)
+
+	for _, node := range response.Canvas.Spec.Nodes {
+		if node.Id == "if-node" {
+			assert.Equal(t, "output channel default is not available with the current configuration", node.ErrorMessage)
+		}
+
+		if node.Id == "noop-node" {
+			assert.Empty(t, node.ErrorMessage)
+		}
+	}
+}
+
 func TestUpdateCanvas_SetupErrorsPersistedInResponse(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
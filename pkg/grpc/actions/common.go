@@ -37,6 +37,8 @@ func DomainTypeToProto(domainType string) pbAuth.DomainType {
 	switch domainType {
 	case models.DomainTypeOrganization:
 		return pbAuth.DomainType_DOMAIN_TYPE_ORGANIZATION
+	case models.DomainTypeCanvas:
+		return pbAuth.DomainType_DOMAIN_TYPE_CANVAS
 	default:
 		return pbAuth.DomainType_DOMAIN_TYPE_UNSPECIFIED
 	}
@@ -1111,7 +1113,7 @@ func SerializeComponents(in []core.Component) []*componentpb.Component {
 			}
 		}
 
-		configFields := component.Configuration()
+		configFields := AppendGlobalComponentFields(component.Configuration())
 		configuration := make([]*configpb.Field, len(configFields))
 		for j, field := range configFields {
 			configuration[j] = ConfigurationFieldToProto(field)
@@ -1176,6 +1178,16 @@ func AppendGlobalTriggerFields(fields []configuration.Field) []configuration.Fie
 	return fields
 }
 
+func AppendGlobalComponentFields(fields []configuration.Field) []configuration.Field {
+	if slices.ContainsFunc(fields, func(field configuration.Field) bool {
+		return field.Name == core.RetryPolicyFieldName
+	}) {
+		return fields
+	}
+
+	return append(fields, core.RetryPolicyField())
+}
+
 func SerializeWidgets(in []core.Widget) []*widgetpb.Widget {
 	out := make([]*widgetpb.Widget, len(in))
 	for i, widget := range in {
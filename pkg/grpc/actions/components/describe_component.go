@@ -24,7 +24,7 @@ func DescribeComponent(ctx context.Context, registry *registry.Registry, name st
 		}
 	}
 
-	configFields := component.Configuration()
+	configFields := actions.AppendGlobalComponentFields(component.Configuration())
 	configuration := make([]*configpb.Field, len(configFields))
 	for i, field := range configFields {
 		configuration[i] = actions.ConfigurationFieldToProto(field)
@@ -0,0 +1,107 @@
+package components
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	pb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+ * ListComponentCatalog returns every registered component and trigger,
+ * including the ones provided by integrations.
+ *
+ * The configuration schema is the JSON encoding of the configuration fields,
+ * so the UI can render forms without hardcoding component metadata.
+ */
+func ListComponentCatalog(ctx context.Context, registry *registry.Registry) (*pb.ListComponentCatalogResponse, error) {
+	components := registry.ListComponents()
+	triggers := registry.ListTriggers()
+	for _, integration := range registry.ListIntegrations() {
+		components = append(components, integration.Components()...)
+		triggers = append(triggers, integration.Triggers()...)
+	}
+
+	sort.Slice(components, func(i, j int) bool {
+		return components[i].Name() < components[j].Name()
+	})
+
+	sort.Slice(triggers, func(i, j int) bool {
+		return triggers[i].Name() < triggers[j].Name()
+	})
+
+	componentEntries := make([]*pb.CatalogEntry, 0, len(components))
+	for _, component := range components {
+		fields := actions.AppendGlobalComponentFields(component.Configuration())
+		entry, err := serializeCatalogEntry(component, fields, component.OutputChannels(nil))
+		if err != nil {
+			log.Errorf("error serializing component %s: %v", component.Name(), err)
+			return nil, status.Error(codes.Internal, "failed to serialize component catalog")
+		}
+
+		componentEntries = append(componentEntries, entry)
+	}
+
+	triggerEntries := make([]*pb.CatalogEntry, 0, len(triggers))
+	for _, trigger := range triggers {
+		fields := actions.AppendGlobalTriggerFields(trigger.Configuration())
+		entry, err := serializeCatalogEntry(trigger, fields, nil)
+		if err != nil {
+			log.Errorf("error serializing trigger %s: %v", trigger.Name(), err)
+			return nil, status.Error(codes.Internal, "failed to serialize component catalog")
+		}
+
+		triggerEntries = append(triggerEntries, entry)
+	}
+
+	return &pb.ListComponentCatalogResponse{
+		Components: componentEntries,
+		Triggers:   triggerEntries,
+	}, nil
+}
+
+type catalogItem interface {
+	Name() string
+	Label() string
+	Description() string
+	Icon() string
+	Color() string
+}
+
+func serializeCatalogEntry(item catalogItem, fields []configuration.Field, outputChannels []core.OutputChannel) (*pb.CatalogEntry, error) {
+	if fields == nil {
+		fields = []configuration.Field{}
+	}
+
+	schema, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	channels := make([]*pb.OutputChannel, len(outputChannels))
+	for i, channel := range outputChannels {
+		channels[i] = &pb.OutputChannel{
+			Name:        channel.Name,
+			Label:       channel.Label,
+			Description: channel.Description,
+		}
+	}
+
+	return &pb.CatalogEntry{
+		Name:                item.Name(),
+		Label:               item.Label(),
+		Description:         item.Description(),
+		Icon:                item.Icon(),
+		Color:               item.Color(),
+		OutputChannels:      channels,
+		ConfigurationSchema: string(schema),
+	}, nil
+}
@@ -0,0 +1,120 @@
+package components
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	pb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/pkg/registry"
+
+	_ "github.com/superplanehq/superplane/pkg/components/http"
+	_ "github.com/superplanehq/superplane/pkg/integrations/slack"
+	_ "github.com/superplanehq/superplane/pkg/triggers/start"
+)
+
+func Test__ListComponentCatalog(t *testing.T) {
+	r, err := registry.NewRegistry(&crypto.NoOpEncryptor{}, registry.HTTPOptions{})
+	require.NoError(t, err)
+
+	response, err := ListComponentCatalog(context.Background(), r)
+	require.NoError(t, err)
+
+	t.Run("includes core and integration components and triggers", func(t *testing.T) {
+		assert.NotNil(t, findCatalogEntry(response.Components, "http"))
+		assert.NotNil(t, findCatalogEntry(response.Components, "slack.sendTextMessage"))
+		assert.NotNil(t, findCatalogEntry(response.Triggers, "start"))
+		assert.NotNil(t, findCatalogEntry(response.Triggers, "slack.onMessage"))
+	})
+
+	t.Run("component metadata and output channels are included", func(t *testing.T) {
+		entry := findCatalogEntry(response.Components, "http")
+		require.NotNil(t, entry)
+
+		component, err := r.GetComponent("http")
+		require.NoError(t, err)
+
+		assert.Equal(t, component.Label(), entry.Label)
+		assert.Equal(t, component.Description(), entry.Description)
+		assert.Equal(t, component.Icon(), entry.Icon)
+		assert.Equal(t, component.Color(), entry.Color)
+		require.Len(t, entry.OutputChannels, len(component.OutputChannels(nil)))
+		assert.Equal(t, component.OutputChannels(nil)[0].Name, entry.OutputChannels[0].Name)
+	})
+
+	t.Run("configuration schema round-trips without losing data", func(t *testing.T) {
+		entry := findCatalogEntry(response.Components, "http")
+		require.NotNil(t, entry)
+
+		component, err := r.GetComponent("http")
+		require.NoError(t, err)
+
+		fields := []configuration.Field{}
+		require.NoError(t, json.Unmarshal([]byte(entry.ConfigurationSchema), &fields))
+		configFields := actions.AppendGlobalComponentFields(component.Configuration())
+		require.Len(t, fields, len(configFields))
+
+		reencoded, err := json.Marshal(fields)
+		require.NoError(t, err)
+		assert.JSONEq(t, entry.ConfigurationSchema, string(reencoded))
+
+		for i, field := range configFields {
+			assert.Equal(t, field.Name, fields[i].Name)
+			assert.Equal(t, field.VisibilityConditions, fields[i].VisibilityConditions)
+		}
+	})
+
+	t.Run("visibility conditions, options and list schemas use JSON keys", func(t *testing.T) {
+		entry := findCatalogEntry(response.Components, "http")
+		require.NotNil(t, entry)
+
+		raw := []map[string]any{}
+		require.NoError(t, json.Unmarshal([]byte(entry.ConfigurationSchema), &raw))
+
+		text := findRawField(raw, "text")
+		require.NotNil(t, text)
+		conditions := text["visibility_conditions"].([]any)
+		require.NotEmpty(t, conditions)
+		assert.Contains(t, conditions[0], "field")
+		assert.Contains(t, conditions[0], "values")
+
+		method := findRawField(raw, "method")
+		require.NotNil(t, method)
+		options := method["type_options"].(map[string]any)["select"].(map[string]any)["options"].([]any)
+		require.NotEmpty(t, options)
+		assert.Contains(t, options[0], "label")
+		assert.Contains(t, options[0], "value")
+
+		headers := findRawField(raw, "headers")
+		require.NotNil(t, headers)
+		list := headers["type_options"].(map[string]any)["list"].(map[string]any)
+		itemDefinition := list["item_definition"].(map[string]any)
+		assert.Equal(t, configuration.FieldTypeObject, itemDefinition["type"])
+		assert.Len(t, itemDefinition["schema"], 2)
+	})
+}
+
+func findCatalogEntry(entries []*pb.CatalogEntry, name string) *pb.CatalogEntry {
+	for _, entry := range entries {
+		if entry.Name == name {
+			return entry
+		}
+	}
+
+	return nil
+}
+
+func findRawField(fields []map[string]any, name string) map[string]any {
+	for _, field := range fields {
+		if field["name"] == name {
+			return field
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const MaxBulkInvitations = 100
+
+/*
+ * A bulk invitation entry that passed validation,
+ * and how it should be fulfilled.
+ */
+type bulkInvitation struct {
+	result *pb.BulkCreateInvitationsResponse_Result
+
+	//
+	// The organization user, if the address
+	// was added to the organization and removed before.
+	//
+	deletedUser *models.User
+
+	//
+	// The account for the address, if one exists.
+	// If it doesn't, a pending invitation is created.
+	//
+	account *models.Account
+
+	invitation *models.OrganizationInvitation
+
+	//
+	// The organization user added for an existing account,
+	// which gets the role of the invitation once it is stored.
+	//
+	user *models.User
+}
+
+func BulkCreateInvitations(ctx context.Context, authService authorization.Authorization, orgID string, entries []*pb.BulkCreateInvitationsRequest_Entry) (*pb.BulkCreateInvitationsResponse, error) {
+	authenticatedUserID, userIsSet := authentication.GetUserIdFromMetadata(ctx)
+	if !userIsSet {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if len(entries) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one entry is required")
+	}
+
+	if len(entries) > MaxBulkInvitations {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d entries are allowed", MaxBulkInvitations)
+	}
+
+	org := uuid.MustParse(orgID)
+	authenticatedUser := uuid.MustParse(authenticatedUserID)
+
+	//
+	// Everything is validated up front,
+	// so entries that cannot be fulfilled are skipped
+	// without affecting the other ones.
+	//
+	results := make([]*pb.BulkCreateInvitationsResponse_Result, 0, len(entries))
+	invitations := []*bulkInvitation{}
+	seen := map[string]bool{}
+	for _, entry := range entries {
+		invitation := validateBulkInvitation(authService, orgID, entry, seen)
+		results = append(results, invitation.result)
+		if invitation.result.Status == pb.BulkCreateInvitationsResponse_Result_STATUS_CREATED {
+			invitations = append(invitations, invitation)
+		}
+	}
+
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		for _, invitation := range invitations {
+			if err := createBulkInvitationInTransaction(tx, org, authenticatedUser, invitation); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		log.Errorf("Error creating invitations for organization %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "failed to create invitations")
+	}
+
+	//
+	// Roles and invitation emails are only handled once the invitations are stored,
+	// so a failed batch never leaves roles behind for users that were not added.
+	// Failing to assign a role or send an email does not undo the invitations.
+	//
+	warnings := []string{}
+	for _, invitation := range invitations {
+		invitation.result.Invitation = serializeInvitation(invitation.invitation)
+		if invitation.user != nil {
+			err := authService.AssignRole(invitation.user.ID.String(), invitation.invitation.Role(), orgID, models.DomainTypeOrganization)
+			if err != nil {
+				log.Errorf("Failed to assign role %s to user %s in organization %s: %v", invitation.invitation.Role(), invitation.user.ID, orgID, err)
+				warnings = append(warnings, fmt.Sprintf("failed to assign role %s to %s", invitation.invitation.Role(), invitation.invitation.Email))
+			}
+		}
+
+		if invitation.invitation.State != models.InvitationStatePending {
+			continue
+		}
+
+		message := messages.NewInvitationCreatedMessage(invitation.invitation)
+		if err := message.Publish(); err != nil {
+			log.Errorf("Failed to publish invitation created message for invitation %s: %v", invitation.invitation.ID, err)
+			warnings = append(warnings, fmt.Sprintf("failed to send invitation email to %s", invitation.invitation.Email))
+		}
+	}
+
+	return &pb.BulkCreateInvitationsResponse{
+		Results:  results,
+		Warnings: warnings,
+	}, nil
+}
+
+func validateBulkInvitation(authService authorization.Authorization, orgID string, entry *pb.BulkCreateInvitationsRequest_Entry, seen map[string]bool) *bulkInvitation {
+	email := utils.NormalizeEmail(entry.Email)
+	roleName := entry.RoleName
+	if roleName == "" {
+		roleName = models.RoleOrgViewer
+	}
+
+	invitation := &bulkInvitation{
+		result: &pb.BulkCreateInvitationsResponse_Result{
+			Email:    email,
+			RoleName: roleName,
+			Status:   pb.BulkCreateInvitationsResponse_Result_STATUS_CREATED,
+		},
+	}
+
+	skip := func(reason string) *bulkInvitation {
+		invitation.result.Status = pb.BulkCreateInvitationsResponse_Result_STATUS_SKIPPED
+		invitation.result.Reason = reason
+		return invitation
+	}
+
+	if email == "" {
+		return skip("email is required")
+	}
+
+	if _, err := mail.ParseAddress(email); err != nil {
+		return skip("invalid email")
+	}
+
+	if seen[email] {
+		return skip("duplicate email in request")
+	}
+
+	seen[email] = true
+
+	if _, err := authService.GetRoleDefinition(roleName, models.DomainTypeOrganization, orgID); err != nil {
+		return skip(fmt.Sprintf("role %s not found", roleName))
+	}
+
+	user, err := models.FindMaybeDeletedUserByEmail(orgID, email)
+	if err == nil {
+		if !user.DeletedAt.Valid {
+			return skip("already a member of the organization")
+		}
+
+		invitation.deletedUser = user
+		return invitation
+	}
+
+	if _, err := models.FindPendingInvitation(email, orgID); err == nil {
+		return skip("invitation already exists")
+	}
+
+	account, err := models.FindAccountByEmail(email)
+	if err == nil {
+		invitation.account = account
+	}
+
+	return invitation
+}
+
+func createBulkInvitationInTransaction(tx *gorm.DB, orgID, invitedBy uuid.UUID, invitation *bulkInvitation) error {
+	email := invitation.result.Email
+	roleName := invitation.result.RoleName
+
+	if invitation.deletedUser == nil && invitation.account == nil {
+		i, err := models.CreateInvitationWithRoleInTransaction(tx, orgID, invitedBy, email, models.InvitationStatePending, roleName)
+		if err != nil {
+			return fmt.Errorf("failed to create invitation for %s: %v", email, err)
+		}
+
+		invitation.invitation = i
+		return nil
+	}
+
+	i, err := models.CreateInvitationWithRoleInTransaction(tx, orgID, invitedBy, email, models.InvitationStateAccepted, roleName)
+	if err != nil {
+		return fmt.Errorf("failed to create invitation for %s: %v", email, err)
+	}
+
+	invitation.invitation = i
+
+	user := invitation.deletedUser
+	if user != nil {
+		err = user.RestoreInTransaction(tx)
+	} else {
+		user, err = models.CreateUserInTransaction(tx, orgID, invitation.account.ID, invitation.account.Email, invitation.account.Name)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to add %s to organization: %v", email, err)
+	}
+
+	invitation.user = user
+	return nil
+}
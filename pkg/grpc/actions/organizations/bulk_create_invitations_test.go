@@ -0,0 +1,142 @@
+package organizations
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	testconsumer "github.com/superplanehq/superplane/test/consumer"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__BulkCreateInvitations(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+	orgID := r.Organization.ID.String()
+
+	t.Run("unauthenticated user -> error", func(t *testing.T) {
+		_, err := BulkCreateInvitations(context.Background(), r.AuthService, orgID, []*pb.BulkCreateInvitationsRequest_Entry{
+			{Email: "new@example.com"},
+		})
+
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, s.Code())
+	})
+
+	t.Run("no entries -> error", func(t *testing.T) {
+		_, err := BulkCreateInvitations(ctx, r.AuthService, orgID, []*pb.BulkCreateInvitationsRequest_Entry{})
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "at least one entry is required", s.Message())
+	})
+
+	t.Run("invalid entries are skipped and valid ones are created", func(t *testing.T) {
+		amqpURL, _ := config.RabbitMQURL()
+		testconsumer := testconsumer.New(amqpURL, messages.InvitationCreatedRoutingKey)
+		testconsumer.Start()
+		defer testconsumer.Stop()
+
+		account, err := models.CreateAccount(support.RandomName("account")+"@example.com", support.RandomName("user"))
+		require.NoError(t, err)
+
+		response, err := BulkCreateInvitations(ctx, r.AuthService, orgID, []*pb.BulkCreateInvitationsRequest_Entry{
+			{Email: "pending@example.com", RoleName: models.RoleOrgAdmin},
+			{Email: "PENDING@example.com", RoleName: models.RoleOrgViewer},
+			{Email: account.Email},
+			{Email: r.Account.Email},
+			{Email: "unknown-role@example.com", RoleName: "does-not-exist"},
+			{Email: "not-an-email"},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.Results, 6)
+		assert.Empty(t, response.Warnings)
+
+		created := pb.BulkCreateInvitationsResponse_Result_STATUS_CREATED
+		skipped := pb.BulkCreateInvitationsResponse_Result_STATUS_SKIPPED
+
+		assert.Equal(t, created, response.Results[0].Status)
+		assert.Equal(t, models.InvitationStatePending, response.Results[0].Invitation.State)
+		assert.Equal(t, skipped, response.Results[1].Status)
+		assert.Equal(t, "duplicate email in request", response.Results[1].Reason)
+		assert.Equal(t, created, response.Results[2].Status)
+		assert.Equal(t, models.RoleOrgViewer, response.Results[2].RoleName)
+		assert.Equal(t, models.InvitationStateAccepted, response.Results[2].Invitation.State)
+		assert.Equal(t, skipped, response.Results[3].Status)
+		assert.Equal(t, "already a member of the organization", response.Results[3].Reason)
+		assert.Equal(t, skipped, response.Results[4].Status)
+		assert.Equal(t, "role does-not-exist not found", response.Results[4].Reason)
+		assert.Equal(t, skipped, response.Results[5].Status)
+		assert.Equal(t, "invalid email", response.Results[5].Reason)
+
+		//
+		// Pending invitations keep the role for when they are accepted.
+		//
+		invitation, err := models.FindPendingInvitation("pending@example.com", orgID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RoleOrgAdmin, invitation.Role())
+		assert.True(t, testconsumer.HasReceivedMessage())
+
+		//
+		// Users with existing accounts are added immediately.
+		//
+		user, err := models.FindActiveUserByEmail(orgID, account.Email)
+		require.NoError(t, err)
+		roles, err := r.AuthService.GetUserRolesForOrg(user.ID.String(), orgID)
+		require.NoError(t, err)
+		require.Len(t, roles, 1)
+		assert.Equal(t, models.RoleOrgViewer, roles[0].Name)
+	})
+
+	t.Run("pending invitation already exists -> skipped", func(t *testing.T) {
+		_, err := CreateInvitation(ctx, r.AuthService, orgID, "already-invited@example.com")
+		require.NoError(t, err)
+
+		response, err := BulkCreateInvitations(ctx, r.AuthService, orgID, []*pb.BulkCreateInvitationsRequest_Entry{
+			{Email: "already-invited@example.com"},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.Results, 1)
+		assert.Equal(t, pb.BulkCreateInvitationsResponse_Result_STATUS_SKIPPED, response.Results[0].Status)
+		assert.Equal(t, "invitation already exists", response.Results[0].Reason)
+	})
+
+	t.Run("roles are assigned after the invitations are stored, and failures are warnings", func(t *testing.T) {
+		account, err := models.CreateAccount(support.RandomName("account")+"@example.com", support.RandomName("user"))
+		require.NoError(t, err)
+
+		mockAuth := &mockAuthService{
+			Authorization: r.AuthService,
+			Error:         errors.New("auth service failure"),
+		}
+
+		response, err := BulkCreateInvitations(ctx, mockAuth, orgID, []*pb.BulkCreateInvitationsRequest_Entry{
+			{Email: "stored@example.com"},
+			{Email: account.Email},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"failed to assign role " + models.RoleOrgViewer + " to " + account.Email}, response.Warnings)
+
+		_, err = models.FindPendingInvitation("stored@example.com", orgID)
+		require.NoError(t, err)
+
+		user, err := models.FindActiveUserByEmail(orgID, account.Email)
+		require.NoError(t, err)
+		roles, err := r.AuthService.GetUserRolesForOrg(user.ID.String(), orgID)
+		require.NoError(t, err)
+		assert.Empty(t, roles)
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func CreateIntegration(ctx context.Context, registry *registry.Registry, oidcProvider oidc.Provider, baseURL string, webhooksBaseURL string, orgID string, integrationName, name string, appConfig *structpb.Struct) (*pb.CreateIntegrationResponse, error) {
@@ -135,9 +136,18 @@ func serializeIntegration(registry *registry.Registry, instance *models.Integrat
 			StateDescription: instance.StateDescription,
 			Metadata:         metadata,
 			UsedIn:           []*pb.Integration_NodeRef{},
+			SyncFailureCount: uint32(instance.SyncFailureCount),
 		},
 	}
 
+	if instance.LastSyncError != nil {
+		proto.Status.LastSyncError = *instance.LastSyncError
+	}
+
+	if instance.LastSyncFailedAt != nil {
+		proto.Status.LastSyncFailedAt = timestamppb.New(*instance.LastSyncFailedAt)
+	}
+
 	if instance.BrowserAction != nil {
 		browserAction := instance.BrowserAction.Data()
 		proto.Status.BrowserAction = &pb.BrowserAction{
@@ -73,7 +73,7 @@ func Test__CreateIntegration(t *testing.T) {
 		//
 		// Delete the integration
 		//
-		_, err = DeleteIntegration(ctx, r.Organization.ID.String(), integrationID)
+		_, err = DeleteIntegration(ctx, r.Organization.ID.String(), integrationID, false)
 		require.NoError(t, err)
 
 		//
@@ -2,6 +2,8 @@ package organizations
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/database"
@@ -12,7 +14,16 @@ import (
 	"gorm.io/gorm"
 )
 
-func DeleteIntegration(ctx context.Context, orgID string, ID string) (*pb.DeleteIntegrationResponse, error) {
+const maxListedNodeReferences = 10
+
+/*
+ * Nodes using the integration would fail on their next execution,
+ * so the deletion is refused while there are any, unless force is set,
+ * in which case they are detached from the integration and paused.
+ * The integration resources are only cleaned up after it is soft-deleted,
+ * so nothing is removed from the provider when the deletion is refused.
+ */
+func DeleteIntegration(ctx context.Context, orgID string, ID string, force bool) (*pb.DeleteIntegrationResponse, error) {
 	org, err := uuid.Parse(orgID)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid organization ID: %v", err)
@@ -34,6 +45,25 @@ func DeleteIntegration(ctx context.Context, orgID string, ID string) (*pb.Delete
 	// and delete its webhooks before we delete the integration itself.
 	//
 	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		references, err := models.ListIntegrationNodeReferencesInTransaction(tx, integration.ID)
+		if err != nil {
+			return status.Error(codes.Internal, "failed to list nodes using the integration")
+		}
+
+		if len(references) > 0 && !force {
+			//
+			// Aborted is returned by the API as 409 Conflict.
+			//
+			return status.Errorf(codes.Aborted, "integration is used by %s; delete it with force to detach and pause them", describeNodeReferences(references))
+		}
+
+		if len(references) > 0 {
+			reason := fmt.Sprintf("Integration %s was deleted", integration.InstallationName)
+			if err := models.DetachIntegrationFromNodesInTransaction(tx, integration.ID, reason); err != nil {
+				return status.Error(codes.Internal, "failed to detach nodes from the integration")
+			}
+		}
+
 		webhooks, err := models.ListIntegrationWebhooks(tx, integration.ID)
 		if err != nil {
 			return status.Error(codes.Internal, "failed to list integration webhooks")
@@ -57,5 +87,23 @@ func DeleteIntegration(ctx context.Context, orgID string, ID string) (*pb.Delete
 		return nil
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.DeleteIntegrationResponse{}, nil
 }
+
+func describeNodeReferences(references []models.CanvasNodeReference) string {
+	descriptions := []string{}
+	for i, reference := range references {
+		if i == maxListedNodeReferences {
+			descriptions = append(descriptions, fmt.Sprintf("and %d more", len(references)-i))
+			break
+		}
+
+		descriptions = append(descriptions, fmt.Sprintf("node %q in canvas %q", reference.NodeName, reference.CanvasName))
+	}
+
+	return fmt.Sprintf("%d nodes: %s", len(references), strings.Join(descriptions, ", "))
+}
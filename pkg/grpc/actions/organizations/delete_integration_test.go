@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/datatypes"
 )
 
 func Test__DeleteIntegration(t *testing.T) {
@@ -59,7 +60,7 @@ func Test__DeleteIntegration(t *testing.T) {
 		//
 		// Delete the integration
 		//
-		deleteResponse, err := DeleteIntegration(ctx, r.Organization.ID.String(), integrationID)
+		deleteResponse, err := DeleteIntegration(ctx, r.Organization.ID.String(), integrationID, false)
 		require.NoError(t, err)
 		require.NotNil(t, deleteResponse)
 
@@ -85,7 +86,7 @@ func Test__DeleteIntegration(t *testing.T) {
 		//
 		// Try to delete with an invalid organization ID
 		//
-		_, err := DeleteIntegration(ctx, "invalid-uuid", uuid.NewString())
+		_, err := DeleteIntegration(ctx, "invalid-uuid", uuid.NewString(), false)
 		require.Error(t, err)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -97,7 +98,7 @@ func Test__DeleteIntegration(t *testing.T) {
 		//
 		// Try to delete with an invalid integration ID
 		//
-		_, err := DeleteIntegration(ctx, r.Organization.ID.String(), "invalid-uuid")
+		_, err := DeleteIntegration(ctx, r.Organization.ID.String(), "invalid-uuid", false)
 		require.Error(t, err)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -109,7 +110,7 @@ func Test__DeleteIntegration(t *testing.T) {
 		//
 		// Try to delete a non-existent integration
 		//
-		_, err := DeleteIntegration(ctx, r.Organization.ID.String(), uuid.NewString())
+		_, err := DeleteIntegration(ctx, r.Organization.ID.String(), uuid.NewString(), false)
 		require.Error(t, err)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -148,7 +149,7 @@ func Test__DeleteIntegration(t *testing.T) {
 		//
 		// Try to delete using the second organization's ID
 		//
-		_, err = DeleteIntegration(ctx, org2.ID.String(), integrationID)
+		_, err = DeleteIntegration(ctx, org2.ID.String(), integrationID, false)
 		require.Error(t, err)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -180,13 +181,13 @@ func Test__DeleteIntegration(t *testing.T) {
 		//
 		// Delete the integration (first time)
 		//
-		_, err = DeleteIntegration(ctx, r.Organization.ID.String(), integrationID)
+		_, err = DeleteIntegration(ctx, r.Organization.ID.String(), integrationID, false)
 		require.NoError(t, err)
 
 		//
 		// Try to delete again (should fail)
 		//
-		_, err = DeleteIntegration(ctx, r.Organization.ID.String(), integrationID)
+		_, err = DeleteIntegration(ctx, r.Organization.ID.String(), integrationID, false)
 		require.Error(t, err)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -219,7 +220,7 @@ func Test__DeleteIntegration(t *testing.T) {
 		//
 		// Delete the integration
 		//
-		_, err = DeleteIntegration(ctx, r.Organization.ID.String(), integrationID)
+		_, err = DeleteIntegration(ctx, r.Organization.ID.String(), integrationID, false)
 		require.NoError(t, err)
 
 		//
@@ -231,4 +232,73 @@ func Test__DeleteIntegration(t *testing.T) {
 		assert.NotEqual(t, name, integration.InstallationName)
 		assert.Contains(t, integration.InstallationName, "deleted-", "Integration name should be prefixed with 'deleted-'")
 	})
+
+	t.Run("integration used by nodes -> error", func(t *testing.T) {
+		r.Registry.Integrations["dummy"] = support.NewDummyIntegration(support.DummyIntegrationOptions{})
+		integration, err := models.CreateIntegration(uuid.New(), r.Organization.ID, "dummy", support.RandomName("integration"), nil)
+		require.NoError(t, err)
+
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{
+			{
+				NodeID:            "node-1",
+				Name:              "Node 1",
+				Type:              models.NodeTypeComponent,
+				Ref:               datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+				AppInstallationID: &integration.ID,
+			},
+		}, []models.Edge{})
+
+		//
+		// Deletion is refused, and the node still uses the integration.
+		//
+		_, err = DeleteIntegration(ctx, r.Organization.ID.String(), integration.ID.String(), false)
+		require.Error(t, err)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Aborted, s.Code())
+		assert.Contains(t, s.Message(), `node "Node 1" in canvas "`+canvas.Name+`"`)
+
+		_, err = models.FindIntegration(r.Organization.ID, integration.ID)
+		require.NoError(t, err)
+
+		node, err := models.FindCanvasNode(database.Conn(), canvas.ID, "node-1")
+		require.NoError(t, err)
+		require.NotNil(t, node.AppInstallationID)
+		assert.Equal(t, models.CanvasNodeStateReady, node.State)
+	})
+
+	t.Run("integration used by nodes with force -> nodes are detached and paused", func(t *testing.T) {
+		r.Registry.Integrations["dummy"] = support.NewDummyIntegration(support.DummyIntegrationOptions{})
+		integration, err := models.CreateIntegration(uuid.New(), r.Organization.ID, "dummy", support.RandomName("integration"), nil)
+		require.NoError(t, err)
+
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{
+			{
+				NodeID:            "node-1",
+				Name:              "Node 1",
+				Type:              models.NodeTypeComponent,
+				Ref:               datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+				AppInstallationID: &integration.ID,
+			},
+		}, []models.Edge{})
+
+		_, err = DeleteIntegration(ctx, r.Organization.ID.String(), integration.ID.String(), true)
+		require.NoError(t, err)
+
+		deleted, err := models.FindMaybeDeletedIntegrationInTransaction(database.Conn(), integration.ID)
+		require.NoError(t, err)
+		assert.True(t, deleted.DeletedAt.Valid)
+
+		node, err := models.FindCanvasNode(database.Conn(), canvas.ID, "node-1")
+		require.NoError(t, err)
+		assert.Nil(t, node.AppInstallationID)
+		assert.Equal(t, models.CanvasNodeStatePaused, node.State)
+		require.NotNil(t, node.StateReason)
+		assert.Contains(t, *node.StateReason, integration.InstallationName)
+
+		canvas, err = models.FindCanvas(r.Organization.ID, canvas.ID)
+		require.NoError(t, err)
+		require.Len(t, canvas.Nodes, 1)
+		assert.Nil(t, canvas.Nodes[0].IntegrationID)
+	})
 }
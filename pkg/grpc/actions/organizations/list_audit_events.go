@@ -0,0 +1,119 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	DefaultAuditEventsLimit = 50
+	MaxAuditEventsLimit     = 200
+)
+
+func ListAuditEvents(ctx context.Context, orgID string, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	if req.Result != "" && req.Result != models.AuditEventResultSuccess && req.Result != models.AuditEventResultDenied {
+		return nil, status.Error(codes.InvalidArgument, "invalid result")
+	}
+
+	filters := models.AuditEventFilters{Resource: req.Resource, Result: req.Result}
+	if req.UserId != "" {
+		userID, err := uuid.Parse(req.UserId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid user id")
+		}
+
+		filters.UserID = &userID
+	}
+
+	if req.After != nil {
+		after := req.After.AsTime()
+		filters.After = &after
+	}
+
+	if req.Before != nil {
+		before := req.Before.AsTime()
+		filters.Before = &before
+	}
+
+	limit := auditEventsLimit(req.Limit)
+
+	//
+	// One more event than requested is loaded,
+	// to know if there is another page without counting all events.
+	//
+	events, err := models.ListAuditEvents(organizationID, filters, limit+1)
+	if err != nil {
+		log.Errorf("error listing audit events for %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "error listing audit events")
+	}
+
+	hasNextPage := len(events) > limit
+	if hasNextPage {
+		events = events[:limit]
+	}
+
+	serialized, err := serializeAuditEvents(events)
+	if err != nil {
+		log.Errorf("error serializing audit events for %s: %v", orgID, err)
+		return nil, status.Error(codes.Internal, "error listing audit events")
+	}
+
+	response := &pb.ListAuditEventsResponse{
+		Events:      serialized,
+		HasNextPage: hasNextPage,
+	}
+
+	if len(events) > 0 {
+		response.LastTimestamp = timestamppb.New(*events[len(events)-1].CreatedAt)
+	}
+
+	return response, nil
+}
+
+func auditEventsLimit(limit uint32) int {
+	if limit == 0 {
+		return DefaultAuditEventsLimit
+	}
+
+	if limit > MaxAuditEventsLimit {
+		return MaxAuditEventsLimit
+	}
+
+	return int(limit)
+}
+
+func serializeAuditEvents(events []models.AuditEvent) ([]*pb.AuditEvent, error) {
+	result := make([]*pb.AuditEvent, 0, len(events))
+	for _, event := range events {
+		request, err := structpb.NewStruct(event.Request.Data())
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &pb.AuditEvent{
+			Id:        event.ID.String(),
+			UserId:    event.UserID.String(),
+			Method:    event.Method,
+			Resource:  event.Resource,
+			Action:    event.Action,
+			Request:   request,
+			Result:    event.Result,
+			CreatedAt: timestamppb.New(*event.CreatedAt),
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,127 @@
+package organizations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	uuid "github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/datatypes"
+)
+
+func Test__ListAuditEvents(t *testing.T) {
+	r := support.Setup(t)
+	otherUser := uuid.New()
+	now := time.Now().Truncate(time.Millisecond)
+
+	createEvent := func(userID uuid.UUID, resource string, createdAt time.Time) {
+		require.NoError(t, models.CreateAuditEvent(&models.AuditEvent{
+			OrganizationID: r.Organization.ID,
+			UserID:         userID,
+			Method:         "/Superplane.Test/Method",
+			Resource:       resource,
+			Action:         "update",
+			Request:        datatypes.NewJSONType(map[string]any{"id": "123"}),
+			Result:         models.AuditEventResultSuccess,
+			CreatedAt:      &createdAt,
+		}))
+	}
+
+	createEvent(r.User, "canvases", now.Add(-3*time.Minute))
+	createEvent(r.User, "secrets", now.Add(-2*time.Minute))
+	createEvent(otherUser, "canvases", now.Add(-time.Minute))
+
+	t.Run("invalid user id -> error", func(t *testing.T) {
+		_, err := ListAuditEvents(context.Background(), r.Organization.ID.String(), &pb.ListAuditEventsRequest{UserId: "not-a-uuid"})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("events are listed most recent first", func(t *testing.T) {
+		response, err := ListAuditEvents(context.Background(), r.Organization.ID.String(), &pb.ListAuditEventsRequest{})
+		require.NoError(t, err)
+		require.Len(t, response.Events, 3)
+		assert.False(t, response.HasNextPage)
+		assert.Equal(t, otherUser.String(), response.Events[0].UserId)
+		assert.Equal(t, "secrets", response.Events[1].Resource)
+		assert.Equal(t, "123", response.Events[2].Request.AsMap()["id"])
+	})
+
+	t.Run("events can be filtered by user and resource", func(t *testing.T) {
+		response, err := ListAuditEvents(context.Background(), r.Organization.ID.String(), &pb.ListAuditEventsRequest{
+			UserId:   r.User.String(),
+			Resource: "canvases",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.Events, 1)
+		assert.Equal(t, r.User.String(), response.Events[0].UserId)
+		assert.Equal(t, "canvases", response.Events[0].Resource)
+	})
+
+	t.Run("events are paginated", func(t *testing.T) {
+		response, err := ListAuditEvents(context.Background(), r.Organization.ID.String(), &pb.ListAuditEventsRequest{Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, response.Events, 2)
+		assert.True(t, response.HasNextPage)
+		require.NotNil(t, response.LastTimestamp)
+
+		response, err = ListAuditEvents(context.Background(), r.Organization.ID.String(), &pb.ListAuditEventsRequest{
+			Limit:  2,
+			Before: response.LastTimestamp,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.Events, 1)
+		assert.False(t, response.HasNextPage)
+		assert.Equal(t, "canvases", response.Events[0].Resource)
+	})
+
+	t.Run("events from other organizations are not listed", func(t *testing.T) {
+		response, err := ListAuditEvents(context.Background(), uuid.NewString(), &pb.ListAuditEventsRequest{
+			After: timestamppb.New(now.Add(-time.Hour)),
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, response.Events)
+	})
+
+	t.Run("events can be filtered by result", func(t *testing.T) {
+		createdAt := now.Add(-30 * time.Second)
+		require.NoError(t, models.CreateAuditEvent(&models.AuditEvent{
+			OrganizationID: r.Organization.ID,
+			UserID:         otherUser,
+			Method:         "/Superplane.Test/Method",
+			Resource:       "secrets",
+			Action:         "read",
+			Request:        datatypes.NewJSONType(map[string]any{}),
+			Result:         models.AuditEventResultDenied,
+			CreatedAt:      &createdAt,
+		}))
+
+		response, err := ListAuditEvents(context.Background(), r.Organization.ID.String(), &pb.ListAuditEventsRequest{
+			Result: models.AuditEventResultDenied,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.Events, 1)
+		assert.Equal(t, models.AuditEventResultDenied, response.Events[0].Result)
+		assert.Equal(t, "read", response.Events[0].Action)
+	})
+
+	t.Run("invalid result -> error", func(t *testing.T) {
+		_, err := ListAuditEvents(context.Background(), r.Organization.ID.String(), &pb.ListAuditEventsRequest{Result: "maybe"})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+}
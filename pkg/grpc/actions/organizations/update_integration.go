@@ -80,6 +80,12 @@ func UpdateIntegration(
 	maps.Copy(existingConfig, configuration)
 	instance.Configuration = datatypes.NewJSONType(existingConfig)
 
+	//
+	// Updating the integration starts over the backoff of previous sync failures,
+	// so the sync below can schedule its next resync right away.
+	//
+	instance.ResetSyncFailures()
+
 	integrationCtx := contexts.NewIntegrationContext(
 		database.Conn(),
 		nil,
@@ -3,30 +3,51 @@ package secrets
 import (
 	"context"
 
-	"github.com/google/uuid"
-	"github.com/superplanehq/superplane/pkg/grpc/actions"
-	"github.com/superplanehq/superplane/pkg/models"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
 	pb "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"github.com/superplanehq/superplane/pkg/registry"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
-func DeleteSecret(ctx context.Context, domainType, domainID, idOrName string) (*pb.DeleteSecretResponse, error) {
-	err := actions.ValidateUUIDs(idOrName)
-	var secret *models.Secret
-	if err != nil {
-		secret, err = models.FindSecretByName(domainType, uuid.MustParse(domainID), idOrName)
-	} else {
-		secret, err = models.FindSecretByID(domainType, uuid.MustParse(domainID), idOrName)
-	}
-
+/*
+ * Nodes and integrations referencing the secret would fail
+ * to resolve it once it is deleted, so the deletion is refused
+ * while there are any, unless force is set.
+ */
+func DeleteSecret(ctx context.Context, registry *registry.Registry, domainType, domainID, idOrName string, force bool) (*pb.DeleteSecretResponse, error) {
+	secret, err := findSecret(domainType, domainID, idOrName)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "secret not found")
 	}
 
-	err = secret.Delete()
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		if !force {
+			usages, err := FindSecretUsagesInTransaction(tx, registry, secret)
+			if err != nil {
+				log.Errorf("Error listing usages of secret %s: %v", secret.ID, err)
+				return status.Error(codes.Internal, "failed to list secret usages")
+			}
+
+			if len(usages.Nodes) > 0 || len(usages.Integrations) > 0 {
+				//
+				// Aborted is returned by the API as 409 Conflict.
+				//
+				return status.Errorf(codes.Aborted, "secret is used by %s; delete it with force to delete it anyway", describeSecretUsages(usages))
+			}
+		}
+
+		if err := secret.DeleteInTransaction(tx); err != nil {
+			return status.Error(codes.Internal, "error deleting secret")
+		}
+
+		return nil
+	})
+
 	if err != nil {
-		return nil, status.Error(codes.Internal, "error deleting secret")
+		return nil, err
 	}
 
 	return &pb.DeleteSecretResponse{}, nil
@@ -26,7 +26,7 @@ func Test__DeleteSecret(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("secret does not exist -> error", func(t *testing.T) {
-		_, err := DeleteSecret(context.Background(), models.DomainTypeOrganization, r.Organization.ID.String(), "test2")
+		_, err := DeleteSecret(context.Background(), r.Registry, models.DomainTypeOrganization, r.Organization.ID.String(), "test2", false)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
 		assert.Equal(t, codes.InvalidArgument, s.Code())
@@ -34,10 +34,32 @@ func Test__DeleteSecret(t *testing.T) {
 	})
 
 	t.Run("secret is deleted", func(t *testing.T) {
-		_, err := DeleteSecret(context.Background(), models.DomainTypeOrganization, r.Organization.ID.String(), "test")
+		_, err := DeleteSecret(context.Background(), r.Registry, models.DomainTypeOrganization, r.Organization.ID.String(), "test", false)
 		require.NoError(t, err)
 
 		_, err = models.FindSecretByName(models.DomainTypeOrganization, r.Organization.ID, "test")
 		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
 	})
+
+	t.Run("secret used by a node -> error unless forced", func(t *testing.T) {
+		_, err := models.CreateSecret("used", secrets.ProviderLocal, uuid.NewString(), models.DomainTypeOrganization, r.Organization.ID, data)
+		require.NoError(t, err)
+		createSSHNodeUsingSecret(t, r, "used")
+
+		_, err = DeleteSecret(context.Background(), r.Registry, models.DomainTypeOrganization, r.Organization.ID.String(), "used", false)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Aborted, s.Code())
+		assert.Contains(t, s.Message(), "secret is used by node \"ssh\"")
+		assert.Contains(t, s.Message(), "authentication.privateKey")
+
+		_, err = models.FindSecretByName(models.DomainTypeOrganization, r.Organization.ID, "used")
+		require.NoError(t, err)
+
+		_, err = DeleteSecret(context.Background(), r.Registry, models.DomainTypeOrganization, r.Organization.ID.String(), "used", true)
+		require.NoError(t, err)
+
+		_, err = models.FindSecretByName(models.DomainTypeOrganization, r.Organization.ID, "used")
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
 }
@@ -0,0 +1,161 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const maxListedSecretUsages = 10
+
+func ListSecretUsages(ctx context.Context, registry *registry.Registry, domainType, domainID, idOrName string) (*pb.ListSecretUsagesResponse, error) {
+	secret, err := findSecret(domainType, domainID, idOrName)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "secret not found")
+	}
+
+	usages, err := FindSecretUsagesInTransaction(database.Conn(), registry, secret)
+	if err != nil {
+		log.Errorf("Error listing usages of secret %s: %v", secret.ID, err)
+		return nil, status.Error(codes.Internal, "failed to list secret usages")
+	}
+
+	return usages, nil
+}
+
+/*
+ * Nodes and integrations reference organization secrets through their secret-key fields.
+ * The database only returns the ones with an object referencing the secret somewhere
+ * in their configuration, and those are checked against their configuration fields.
+ * Nodes are loaded one canvas at a time, so large organizations
+ * do not need all their nodes in memory at once.
+ */
+func FindSecretUsagesInTransaction(tx *gorm.DB, registry *registry.Registry, secret *models.Secret) (*pb.ListSecretUsagesResponse, error) {
+	usages := &pb.ListSecretUsagesResponse{
+		Nodes:        []*pb.SecretNodeUsage{},
+		Integrations: []*pb.SecretIntegrationUsage{},
+	}
+
+	if secret.DomainType != models.DomainTypeOrganization {
+		return usages, nil
+	}
+
+	canvases, err := models.ListCanvasSummariesInTransaction(tx, secret.DomainID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, canvas := range canvases {
+		nodes, err := models.FindCanvasNodesReferencingSecretInTransaction(tx, canvas.ID, secret.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range nodes {
+			fields := nodeConfigurationFields(registry, node)
+			for _, reference := range configuration.FindSecretKeyReferences(fields, node.Configuration.Data(), secret.Name) {
+				usages.Nodes = append(usages.Nodes, &pb.SecretNodeUsage{
+					CanvasId:   canvas.ID.String(),
+					CanvasName: canvas.Name,
+					NodeId:     node.NodeID,
+					NodeName:   node.Name,
+					FieldPath:  reference.FieldPath,
+					KeyName:    reference.Key,
+				})
+			}
+		}
+	}
+
+	integrations, err := models.FindIntegrationsReferencingSecretInTransaction(tx, secret.DomainID, secret.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, integration := range integrations {
+		impl, err := registry.GetIntegration(integration.AppName)
+		if err != nil {
+			continue
+		}
+
+		for _, reference := range configuration.FindSecretKeyReferences(impl.Configuration(), integration.Configuration.Data(), secret.Name) {
+			usages.Integrations = append(usages.Integrations, &pb.SecretIntegrationUsage{
+				IntegrationId:   integration.ID.String(),
+				IntegrationName: integration.InstallationName,
+				FieldPath:       reference.FieldPath,
+				KeyName:         reference.Key,
+			})
+		}
+	}
+
+	return usages, nil
+}
+
+func nodeConfigurationFields(registry *registry.Registry, node models.CanvasNode) []configuration.Field {
+	ref := node.Ref.Data()
+
+	switch node.Type {
+	case models.NodeTypeComponent:
+		if ref.Component == nil {
+			return nil
+		}
+
+		component, err := registry.GetComponent(ref.Component.Name)
+		if err != nil {
+			return nil
+		}
+
+		return component.Configuration()
+
+	case models.NodeTypeTrigger:
+		if ref.Trigger == nil {
+			return nil
+		}
+
+		trigger, err := registry.GetTrigger(ref.Trigger.Name)
+		if err != nil {
+			return nil
+		}
+
+		return trigger.Configuration()
+	}
+
+	return nil
+}
+
+func describeSecretUsages(usages *pb.ListSecretUsagesResponse) string {
+	descriptions := []string{}
+	for _, usage := range usages.Nodes {
+		descriptions = append(descriptions, fmt.Sprintf("node %q in canvas %q (%s)", usage.NodeName, usage.CanvasName, usage.FieldPath))
+	}
+
+	for _, usage := range usages.Integrations {
+		descriptions = append(descriptions, fmt.Sprintf("integration %q (%s)", usage.IntegrationName, usage.FieldPath))
+	}
+
+	if len(descriptions) > maxListedSecretUsages {
+		more := len(descriptions) - maxListedSecretUsages
+		descriptions = append(descriptions[:maxListedSecretUsages], fmt.Sprintf("and %d more", more))
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+func findSecret(domainType, domainID, idOrName string) (*models.Secret, error) {
+	if err := actions.ValidateUUIDs(idOrName); err != nil {
+		return models.FindSecretByName(domainType, uuid.MustParse(domainID), idOrName)
+	}
+
+	return models.FindSecretByID(domainType, uuid.MustParse(domainID), idOrName)
+}
@@ -0,0 +1,90 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/secrets"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__ListSecretUsages(t *testing.T) {
+	r := support.SetupWithOptions(t, support.SetupOptions{})
+
+	data, _ := json.Marshal(map[string]string{"private-key": "key"})
+	_, err := models.CreateSecret("ssh-credentials", secrets.ProviderLocal, uuid.NewString(), models.DomainTypeOrganization, r.Organization.ID, data)
+	require.NoError(t, err)
+
+	t.Run("secret does not exist -> error", func(t *testing.T) {
+		_, err := ListSecretUsages(context.Background(), r.Registry, models.DomainTypeOrganization, r.Organization.ID.String(), "does-not-exist")
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "secret not found", s.Message())
+	})
+
+	t.Run("secret not used -> no usages", func(t *testing.T) {
+		response, err := ListSecretUsages(context.Background(), r.Registry, models.DomainTypeOrganization, r.Organization.ID.String(), "ssh-credentials")
+		require.NoError(t, err)
+		assert.Empty(t, response.Nodes)
+		assert.Empty(t, response.Integrations)
+	})
+
+	t.Run("secret used by a node -> node usage with field path", func(t *testing.T) {
+		canvas := createSSHNodeUsingSecret(t, r, "ssh-credentials")
+
+		response, err := ListSecretUsages(context.Background(), r.Registry, models.DomainTypeOrganization, r.Organization.ID.String(), "ssh-credentials")
+		require.NoError(t, err)
+		require.Len(t, response.Nodes, 1)
+		assert.Equal(t, canvas.ID.String(), response.Nodes[0].CanvasId)
+		assert.Equal(t, "ssh", response.Nodes[0].NodeId)
+		assert.Equal(t, "authentication.privateKey", response.Nodes[0].FieldPath)
+		assert.Equal(t, "private-key", response.Nodes[0].KeyName)
+		assert.Empty(t, response.Integrations)
+	})
+
+	t.Run("other secrets are not matched", func(t *testing.T) {
+		_, err := models.CreateSecret("other", secrets.ProviderLocal, uuid.NewString(), models.DomainTypeOrganization, r.Organization.ID, data)
+		require.NoError(t, err)
+
+		response, err := ListSecretUsages(context.Background(), r.Registry, models.DomainTypeOrganization, r.Organization.ID.String(), "other")
+		require.NoError(t, err)
+		assert.Empty(t, response.Nodes)
+	})
+}
+
+func createSSHNodeUsingSecret(t *testing.T, r *support.ResourceRegistry, secretName string) *models.Canvas {
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "ssh",
+				Name:   "ssh",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "ssh"}}),
+				Configuration: datatypes.NewJSONType(map[string]any{
+					"host":     "example.com",
+					"username": "root",
+					"command":  "ls",
+					"authentication": map[string]any{
+						"authMethod": "ssh_key",
+						"privateKey": map[string]any{"secret": secretName, "key": "private-key"},
+					},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	return canvas
+}
@@ -76,6 +76,24 @@ func (s *CanvasService) ListNodeExecutions(ctx context.Context, req *pb.ListNode
 	return canvases.ListNodeExecutions(ctx, s.registry, req.CanvasId, req.NodeId, req.States, req.Results, req.Limit, req.Before)
 }
 
+func (s *CanvasService) GetNodeStatus(ctx context.Context, req *pb.GetNodeStatusRequest) (*pb.GetNodeStatusResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	return canvases.GetNodeStatus(ctx, canvasID, req.NodeId, req.WindowSeconds)
+}
+
+func (s *CanvasService) ListNodeStatuses(ctx context.Context, req *pb.ListNodeStatusesRequest) (*pb.ListNodeStatusesResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	return canvases.ListNodeStatuses(ctx, canvasID, req.NodeIds, req.WindowSeconds)
+}
+
 func (s *CanvasService) ListNodeEvents(ctx context.Context, req *pb.ListNodeEventsRequest) (*pb.ListNodeEventsResponse, error) {
 	canvasID, err := uuid.Parse(req.CanvasId)
 	if err != nil {
@@ -177,7 +195,7 @@ func (s *CanvasService) ListCanvasEvents(ctx context.Context, req *pb.ListCanvas
 		return nil, status.Error(codes.InvalidArgument, "invalid workflow_id")
 	}
 
-	return canvases.ListCanvasEvents(ctx, s.registry, canvasID, req.Limit, req.Before)
+	return canvases.ListCanvasEvents(ctx, s.registry, canvasID, req)
 }
 
 func (s *CanvasService) ListEventExecutions(ctx context.Context, req *pb.ListEventExecutionsRequest) (*pb.ListEventExecutionsResponse, error) {
@@ -214,6 +232,20 @@ func (s *CanvasService) CancelExecution(ctx context.Context, req *pb.CancelExecu
 	return canvases.CancelExecution(ctx, s.authService, s.encryptor, organizationID, s.registry, canvasID, executionID)
 }
 
+func (s *CanvasService) ListExecutionLogs(ctx context.Context, req *pb.ListExecutionLogsRequest) (*pb.ListExecutionLogsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid workflow_id")
+	}
+
+	executionID, err := uuid.Parse(req.ExecutionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid execution_id")
+	}
+
+	return canvases.ListExecutionLogs(ctx, canvasID, executionID)
+}
+
 func (s *CanvasService) ResolveExecutionErrors(ctx context.Context, req *pb.ResolveExecutionErrorsRequest) (*pb.ResolveExecutionErrorsResponse, error) {
 	canvasID, err := uuid.Parse(req.CanvasId)
 	if err != nil {
@@ -231,3 +263,26 @@ func (s *CanvasService) ResolveExecutionErrors(ctx context.Context, req *pb.Reso
 
 	return canvases.ResolveExecutionErrors(ctx, canvasID, executionIDs)
 }
+
+func (s *CanvasService) ListDeadLetteredExecutions(ctx context.Context, req *pb.ListDeadLetteredExecutionsRequest) (*pb.ListDeadLetteredExecutionsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid workflow_id")
+	}
+
+	return canvases.ListDeadLetteredExecutions(ctx, canvasID, req.NodeId, req.IncludeRequeued, req.Limit)
+}
+
+func (s *CanvasService) RequeueDeadLetteredExecution(ctx context.Context, req *pb.RequeueDeadLetteredExecutionRequest) (*pb.RequeueDeadLetteredExecutionResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid workflow_id")
+	}
+
+	deadLetterID, err := uuid.Parse(req.DeadLetterId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid dead_letter_id")
+	}
+
+	return canvases.RequeueDeadLetteredExecution(ctx, canvasID, deadLetterID)
+}
@@ -27,3 +27,7 @@ func (s *ComponentService) DescribeComponent(ctx context.Context, req *pb.Descri
 func (s *ComponentService) ListComponentActions(ctx context.Context, req *pb.ListComponentActionsRequest) (*pb.ListComponentActionsResponse, error) {
 	return components.ListComponentActions(ctx, s.registry, req.Name)
 }
+
+func (s *ComponentService) ListComponentCatalog(ctx context.Context, req *pb.ListComponentCatalogRequest) (*pb.ListComponentCatalogResponse, error) {
+	return components.ListComponentCatalog(ctx, s.registry)
+}
@@ -63,6 +63,11 @@ func (s *OrganizationService) CreateInvitation(ctx context.Context, req *pb.Crea
 	return organizations.CreateInvitation(ctx, s.authorizationService, orgID, req.Email)
 }
 
+func (s *OrganizationService) BulkCreateInvitations(ctx context.Context, req *pb.BulkCreateInvitationsRequest) (*pb.BulkCreateInvitationsResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.BulkCreateInvitations(ctx, s.authorizationService, orgID, req.Entries)
+}
+
 func (s *OrganizationService) ListInvitations(ctx context.Context, req *pb.ListInvitationsRequest) (*pb.ListInvitationsResponse, error) {
 	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
 	return organizations.ListInvitations(ctx, orgID)
@@ -149,7 +154,12 @@ func (s *OrganizationService) UpdateIntegration(ctx context.Context, req *pb.Upd
 
 func (s *OrganizationService) DeleteIntegration(ctx context.Context, req *pb.DeleteIntegrationRequest) (*pb.DeleteIntegrationResponse, error) {
 	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
-	return organizations.DeleteIntegration(ctx, orgID, req.IntegrationId)
+	return organizations.DeleteIntegration(ctx, orgID, req.IntegrationId, req.Force)
+}
+
+func (s *OrganizationService) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.ListAuditEvents(ctx, orgID, req)
 }
 
 func accountIDFromContext(ctx context.Context) (string, error) {
@@ -2,12 +2,15 @@ package grpc
 
 import (
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
 	"google.golang.org/protobuf/proto"
 
+	canvaspb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
 )
 
@@ -17,6 +20,8 @@ func (p *QueryParser) Parse(target proto.Message, values url.Values, filter *uti
 	switch req := target.(type) {
 	case *pb.ListIntegrationResourcesRequest:
 		return populateListIntegrationResourcesParams(values, req)
+	case *canvaspb.ListCanvasEventsRequest:
+		values = normalizeTimeRangeParams(values, "after", "before")
 	}
 
 	defaultParser := runtime.DefaultQueryParser{}
@@ -48,3 +53,27 @@ func populateListIntegrationResourcesParams(values url.Values, r *pb.ListIntegra
 	r.Parameters = parameters
 	return nil
 }
+
+/*
+ * Timestamps in query parameters are RFC 3339 strings,
+ * but the UI timeline works with Unix milliseconds,
+ * so those are accepted for the time range too.
+ */
+func normalizeTimeRangeParams(values url.Values, keys ...string) url.Values {
+	normalized := url.Values{}
+	for key, vals := range values {
+		normalized[key] = vals
+	}
+
+	for _, key := range keys {
+		value := normalized.Get(key)
+		millis, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		normalized.Set(key, time.UnixMilli(millis).UTC().Format(time.RFC3339Nano))
+	}
+
+	return normalized
+}
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	canvaspb "github.com/superplanehq/superplane/pkg/protos/canvases"
+)
+
+func Test__QueryParser__ListCanvasEvents(t *testing.T) {
+	parser := &QueryParser{}
+	after := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("filters and RFC 3339 time range", func(t *testing.T) {
+		req := &canvaspb.ListCanvasEventsRequest{}
+		values := url.Values{
+			"node_id":      {"node-1"},
+			"channel":      {"default"},
+			"payload_type": {"github.push"},
+			"after":        {after.Format(time.RFC3339)},
+			"cursor":       {"abc"},
+			"count_only":   {"true"},
+		}
+
+		require.NoError(t, parser.Parse(req, values, utilities.NewDoubleArray(nil)))
+		assert.Equal(t, "node-1", req.NodeId)
+		assert.Equal(t, "default", req.Channel)
+		assert.Equal(t, "github.push", req.PayloadType)
+		assert.Equal(t, after, req.After.AsTime())
+		assert.Equal(t, "abc", req.Cursor)
+		assert.True(t, req.CountOnly)
+	})
+
+	t.Run("JSON names", func(t *testing.T) {
+		req := &canvaspb.ListCanvasEventsRequest{}
+		values := url.Values{
+			"nodeId":      {"node-1"},
+			"payloadType": {"github.push"},
+			"countOnly":   {"true"},
+		}
+
+		require.NoError(t, parser.Parse(req, values, utilities.NewDoubleArray(nil)))
+		assert.Equal(t, "node-1", req.NodeId)
+		assert.Equal(t, "github.push", req.PayloadType)
+		assert.True(t, req.CountOnly)
+	})
+
+	t.Run("Unix milliseconds time range", func(t *testing.T) {
+		req := &canvaspb.ListCanvasEventsRequest{}
+		values := url.Values{
+			"after":  {"1767323045000"},
+			"before": {"1767323046500"},
+		}
+
+		require.NoError(t, parser.Parse(req, values, utilities.NewDoubleArray(nil)))
+		assert.Equal(t, after, req.After.AsTime())
+		assert.Equal(t, after.Add(1500*time.Millisecond), req.Before.AsTime())
+		assert.Equal(t, "1767323045000", values.Get("after"))
+	})
+}
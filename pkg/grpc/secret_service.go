@@ -7,17 +7,20 @@ import (
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/secrets"
 	pb "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"github.com/superplanehq/superplane/pkg/registry"
 )
 
 type SecretService struct {
 	encryptor            crypto.Encryptor
 	authorizationService authorization.Authorization
+	registry             *registry.Registry
 }
 
-func NewSecretService(encryptor crypto.Encryptor, authService authorization.Authorization) *SecretService {
+func NewSecretService(encryptor crypto.Encryptor, authService authorization.Authorization, registry *registry.Registry) *SecretService {
 	return &SecretService{
 		encryptor:            encryptor,
 		authorizationService: authService,
+		registry:             registry,
 	}
 }
 
@@ -48,7 +51,13 @@ func (s *SecretService) ListSecrets(ctx context.Context, req *pb.ListSecretsRequ
 func (s *SecretService) DeleteSecret(ctx context.Context, req *pb.DeleteSecretRequest) (*pb.DeleteSecretResponse, error) {
 	domainType := ctx.Value(authorization.DomainTypeContextKey).(string)
 	domainId := ctx.Value(authorization.DomainIdContextKey).(string)
-	return secrets.DeleteSecret(ctx, domainType, domainId, req.IdOrName)
+	return secrets.DeleteSecret(ctx, s.registry, domainType, domainId, req.IdOrName, req.Force)
+}
+
+func (s *SecretService) ListSecretUsages(ctx context.Context, req *pb.ListSecretUsagesRequest) (*pb.ListSecretUsagesResponse, error) {
+	domainType := ctx.Value(authorization.DomainTypeContextKey).(string)
+	domainId := ctx.Value(authorization.DomainIdContextKey).(string)
+	return secrets.ListSecretUsages(ctx, s.registry, domainType, domainId, req.IdOrName)
 }
 
 func (s *SecretService) SetSecretKey(ctx context.Context, req *pb.SetSecretKeyRequest) (*pb.SetSecretKeyResponse, error) {
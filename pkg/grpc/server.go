@@ -100,7 +100,7 @@ func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encry
 	roleService := NewRoleService(authService)
 	pbRoles.RegisterRolesServer(grpcServer, roleService)
 
-	secretsService := NewSecretService(encryptor, authService)
+	secretsService := NewSecretService(encryptor, authService, registry)
 	secretPb.RegisterSecretsServer(grpcServer, secretsService)
 
 	meService := NewMeService()
@@ -20,11 +20,14 @@ import (
 	"github.com/superplanehq/superplane/pkg/integrations/aws/codeartifact"
 	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
 	"github.com/superplanehq/superplane/pkg/integrations/aws/ecr"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/ecs"
 	"github.com/superplanehq/superplane/pkg/integrations/aws/eventbridge"
 	"github.com/superplanehq/superplane/pkg/integrations/aws/iam"
 	"github.com/superplanehq/superplane/pkg/integrations/aws/lambda"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/secretsmanager"
 	"github.com/superplanehq/superplane/pkg/integrations/aws/sns"
 	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/secrets"
 )
 
 const (
@@ -35,6 +38,7 @@ const (
 
 func init() {
 	registry.RegisterIntegrationWithWebhookHandler("aws", &AWS{}, &WebhookHandler{})
+	secrets.RegisterReferenceResolver(secretsmanager.ReferenceScheme, &secretsmanager.ReferenceResolver{})
 }
 
 type AWS struct{}
@@ -44,6 +48,8 @@ type Configuration struct {
 	Region                 string       `json:"region" mapstructure:"region"`
 	SessionDurationSeconds int          `json:"sessionDurationSeconds" mapstructure:"sessionDurationSeconds"`
 	Tags                   []common.Tag `json:"tags" mapstructure:"tags"`
+
+	EndpointOverrides []common.EndpointOverride `json:"endpointOverrides" mapstructure:"endpointOverrides"`
 }
 
 func (a *AWS) Name() string {
@@ -127,6 +133,42 @@ func (a *AWS) Configuration() []configuration.Field {
 				},
 			},
 		},
+		{
+			Name:        "endpointOverrides",
+			Label:       "Endpoint Overrides",
+			Type:        configuration.FieldTypeList,
+			Required:    false,
+			Description: "Service endpoints to use instead of the public regional ones, e.g. FIPS or VPC endpoints",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Endpoint",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "service",
+								Label:    "Service",
+								Type:     configuration.FieldTypeSelect,
+								Required: true,
+								TypeOptions: &configuration.TypeOptions{
+									Select: &configuration.SelectTypeOptions{
+										Options: common.EndpointServices,
+									},
+								},
+							},
+							{
+								Name:               "url",
+								Label:              "URL",
+								Type:               configuration.FieldTypeString,
+								Required:           true,
+								DisallowExpression: true,
+								Description:        "Absolute HTTPS URL, like https://sns-fips.us-gov-west-1.amazonaws.com/",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -138,6 +180,7 @@ func (a *AWS) Components() []core.Component {
 		&codeartifact.DeleteRepository{},
 		&codeartifact.DisposePackageVersions{},
 		&codeartifact.GetPackageVersion{},
+		&codeartifact.GetRepositoryEndpoint{},
 		&codeartifact.UpdatePackageVersionsStatus{},
 		&sns.GetTopic{},
 		&sns.GetSubscription{},
@@ -147,6 +190,7 @@ func (a *AWS) Components() []core.Component {
 		&ecr.GetImage{},
 		&ecr.GetImageScanFindings{},
 		&ecr.ScanImage{},
+		&ecs.StopServiceTasks{},
 		&lambda.RunFunction{},
 	}
 }
@@ -177,6 +221,12 @@ func (a *AWS) Sync(ctx core.SyncContext) error {
 	}
 
 	metadata.Tags = common.NormalizeTags(config.Tags)
+	endpointOverrides, err := common.NormalizeEndpointOverrides(config.EndpointOverrides)
+	if err != nil {
+		return err
+	}
+
+	metadata.EndpointOverrides = endpointOverrides
 	accountID, err := common.AccountIDFromRoleArn(config.RoleArn)
 	if err != nil {
 		return fmt.Errorf("failed to get account ID from role ARN: %v", err)
@@ -184,7 +234,12 @@ func (a *AWS) Sync(ctx core.SyncContext) error {
 
 	credentials, err := a.generateCredentials(ctx, config, accountID, &metadata)
 	if err != nil {
-		return fmt.Errorf("failed to generate credentials: %v", err)
+		return a.credentialsError(ctx, config, err)
+	}
+
+	err = a.validateTrustPolicy(ctx, config, credentials)
+	if err != nil {
+		return err
 	}
 
 	err = a.configureRole(ctx, &metadata, credentials)
@@ -280,7 +335,7 @@ func (a *AWS) cleanupEventBridge(ctx core.IntegrationCleanupContext, metadata *c
 }
 
 func (a *AWS) cleanupIAM(ctx core.IntegrationCleanupContext, metadata *common.IntegrationMetadata, credentials *aws.Credentials) error {
-	client := iam.NewClient(ctx.HTTP, credentials)
+	client := iam.NewClient(ctx.HTTP, credentials, common.PartitionFromArn(metadata.Session.RoleArn))
 
 	var err error
 	if metadata.IAM.TargetDestinationRole != nil {
@@ -300,7 +355,14 @@ func (a *AWS) cleanupIAM(ctx core.IntegrationCleanupContext, metadata *common.In
 
 func (a *AWS) showBrowserAction(ctx core.SyncContext) error {
 	ctx.Integration.NewBrowserAction(core.BrowserAction{
-		Description: fmt.Sprintf(`
+		Description: setupInstructions(ctx),
+	})
+
+	return nil
+}
+
+func setupInstructions(ctx core.SyncContext) string {
+	return fmt.Sprintf(`
 **1. Create Identity Provider**
 
 - Go to AWS IAM Console → Identity Providers → Add provider
@@ -322,10 +384,7 @@ func (a *AWS) showBrowserAction(ctx core.SyncContext) error {
 
 - Copy the ARN of the IAM role created in step 2
 - Paste it into the "Role ARN" field in the installation configuration
-`, ctx.BaseURL, ctx.Integration.ID().String()),
-	})
-
-	return nil
+`, ctx.BaseURL, ctx.Integration.ID().String())
 }
 
 func (a *AWS) generateCredentials(ctx core.SyncContext, config Configuration, accountID string, metadata *common.IntegrationMetadata) (*aws.Credentials, error) {
@@ -341,19 +400,36 @@ func (a *AWS) generateCredentials(ctx core.SyncContext, config Configuration, ac
 	}
 
 	sessionName := fmt.Sprintf("SuperPlane-%s", ctx.Integration.ID())
-	stsCredentials, err := assumeRoleWithWebIdentity(ctx.HTTP, config.Region, config.RoleArn, sessionName, oidcToken, durationSeconds)
+	stsCredentials, err := common.AssumeRoleWithWebIdentity(ctx.HTTP, config.Region, config.RoleArn, sessionName, oidcToken, durationSeconds)
+
+	//
+	// If the configured duration is above the max session duration of the role,
+	// the role is assumed again with the longest duration it allows.
+	//
+	if allowed, ok := common.AllowedSessionDuration(err, durationSeconds); ok {
+		ctx.Logger.Warnf("session duration of %ds exceeds the max session duration of role %s, using %ds", durationSeconds, config.RoleArn, allowed)
+		durationSeconds = allowed
+		stsCredentials, err = common.AssumeRoleWithWebIdentity(ctx.HTTP, config.Region, config.RoleArn, sessionName, oidcToken, durationSeconds)
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("failed to assume role: %w", err)
 	}
 
-	if err := ctx.Integration.SetSecret("accessKeyId", []byte(stsCredentials.AccessKeyID)); err != nil {
-		return nil, fmt.Errorf("failed to set access key ID secret: %w", err)
-	}
-	if err := ctx.Integration.SetSecret("secretAccessKey", []byte(stsCredentials.SecretAccessKey)); err != nil {
-		return nil, fmt.Errorf("failed to set secret access key secret: %w", err)
-	}
-	if err := ctx.Integration.SetSecret("sessionToken", []byte(stsCredentials.SessionToken)); err != nil {
-		return nil, fmt.Errorf("failed to set session token secret: %w", err)
+	//
+	// All the session values are stored together, as a new version of the credentials,
+	// so executions reading them while we rotate never get a mix of old and new values.
+	//
+	snapshot, err := common.StoreCredentials(
+		ctx.Integration,
+		stsCredentials.AccessKeyID,
+		stsCredentials.SecretAccessKey,
+		stsCredentials.SessionToken,
+		stsCredentials.Expiration,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to store credentials: %w", err)
 	}
 
 	refreshAfter := time.Until(stsCredentials.Expiration) / 2
@@ -362,18 +438,14 @@ func (a *AWS) generateCredentials(ctx core.SyncContext, config Configuration, ac
 	}
 
 	metadata.Session = &common.SessionMetadata{
-		RoleArn:   config.RoleArn,
-		AccountID: accountID,
-		Region:    strings.TrimSpace(config.Region),
-		ExpiresAt: stsCredentials.Expiration.Format(time.RFC3339),
+		RoleArn:         config.RoleArn,
+		AccountID:       accountID,
+		Region:          strings.TrimSpace(config.Region),
+		ExpiresAt:       stsCredentials.Expiration.Format(time.RFC3339),
+		DurationSeconds: durationSeconds,
 	}
 
-	credentials, err := common.CredentialsFromInstallation(ctx.Integration)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get AWS credentials: %w", err)
-	}
-
-	return credentials, ctx.Integration.ScheduleResync(refreshAfter)
+	return snapshot.Credentials(), ctx.Integration.ScheduleResync(refreshAfter)
 }
 
 func (a *AWS) configureEventBridge(ctx core.SyncContext, config Configuration, metadata *common.IntegrationMetadata, credentials *aws.Credentials) error {
@@ -434,7 +506,8 @@ func (a *AWS) configureRole(ctx core.SyncContext, metadata *common.IntegrationMe
 	//
 	// Otherwise, create IAM role.
 	//
-	client := iam.NewClient(ctx.HTTP, credentials)
+	partition := common.PartitionFromArn(metadata.Session.RoleArn)
+	client := iam.NewClient(ctx.HTTP, credentials, partition)
 	roleName := a.roleName(ctx.Integration)
 	roleArn := ""
 
@@ -469,13 +542,21 @@ func (a *AWS) configureRole(ctx core.SyncContext, metadata *common.IntegrationMe
 	//
 	// Attach policy to the role to allow it to invoke the API destinations.
 	//
+	apiDestinations := common.Arn{
+		Partition: partition,
+		Service:   "events",
+		Region:    "*",
+		AccountID: metadata.Session.AccountID,
+		Resource:  "api-destination/*",
+	}
+
 	policyDocument, err := json.Marshal(map[string]any{
 		"Version": "2012-10-17",
 		"Statement": []map[string]any{
 			{
 				"Effect":   "Allow",
 				"Action":   "events:InvokeApiDestination",
-				"Resource": fmt.Sprintf("arn:aws:events:*:%s:api-destination/*", metadata.Session.AccountID),
+				"Resource": apiDestinations.String(),
 			},
 		},
 	})
@@ -628,6 +709,11 @@ func (a *AWS) HandleRequest(ctx core.HTTPRequestContext) {
 }
 
 func (a *AWS) handleEvent(ctx core.HTTPRequestContext) {
+	//
+	// Events are routed to subscriptions, and never change the integration.
+	//
+	ctx.Result.SkipPersistence()
+
 	apiKey := ctx.Request.Header.Get(APIKeyHeaderName)
 	if apiKey == "" {
 		ctx.Response.WriteHeader(http.StatusBadRequest)
@@ -635,34 +721,19 @@ func (a *AWS) handleEvent(ctx core.HTTPRequestContext) {
 		return
 	}
 
-	secrets, err := ctx.Integration.GetSecrets()
+	accepted, err := a.acceptsAPIKey(ctx.Integration, apiKey, time.Now())
 	if err != nil {
 		ctx.Response.WriteHeader(http.StatusInternalServerError)
 		ctx.Response.Write([]byte("error finding integration secrets: " + err.Error()))
 		return
 	}
 
-	var secret string
-	for _, s := range secrets {
-		if s.Name == EventBridgeConnectionSecretName {
-			secret = string(s.Value)
-			break
-		}
-	}
-
-	if apiKey != secret {
+	if !accepted {
 		ctx.Response.WriteHeader(http.StatusForbidden)
 		ctx.Response.Write([]byte("invalid " + APIKeyHeaderName + " header"))
 		return
 	}
 
-	subscriptions, err := ctx.Integration.ListSubscriptions()
-	if err != nil {
-		ctx.Response.WriteHeader(http.StatusInternalServerError)
-		ctx.Response.Write([]byte("error listing integration subscriptions: " + err.Error()))
-		return
-	}
-
 	body, err := io.ReadAll(ctx.Request.Body)
 	if err != nil {
 		ctx.Response.WriteHeader(http.StatusInternalServerError)
@@ -677,8 +748,47 @@ func (a *AWS) handleEvent(ctx core.HTTPRequestContext) {
 		return
 	}
 
+	var event common.EventBridgeEvent
+	if err := mapstructure.Decode(data, &event); err != nil {
+		ctx.Response.WriteHeader(http.StatusBadRequest)
+		ctx.Response.Write([]byte("error parsing event: " + err.Error()))
+		return
+	}
+
+	//
+	// Duplicate deliveries of an event are acknowledged,
+	// but not sent to the subscriptions again.
+	// The event is only kept as processed if the request's transaction
+	// is committed, so the retry of a failed delivery is processed.
+	//
+	integrationID := ctx.Integration.ID().String()
+	if event.ID != "" {
+		if !processedEvents.Record(integrationID, event.ID) {
+			ctx.Logger.Infof("skipping duplicate event %s", event.ID)
+			ctx.Response.WriteHeader(http.StatusOK)
+			return
+		}
+
+		ctx.Result.OnFinish(func(committed bool) {
+			if !committed {
+				processedEvents.Forget(integrationID, event.ID)
+			}
+		})
+	}
+
+	//
+	// Only subscriptions for the source and detail type
+	// of the event are loaded, the detail is matched below.
+	//
+	subscriptions, err := ctx.Integration.ListSubscriptions(event.SubscriptionIndex())
+	if err != nil {
+		ctx.Response.WriteHeader(http.StatusInternalServerError)
+		ctx.Response.Write([]byte("error listing integration subscriptions: " + err.Error()))
+		return
+	}
+
 	for _, subscription := range subscriptions {
-		if !a.subscriptionApplies(subscription, data) {
+		if !a.subscriptionApplies(subscription, event) {
 			continue
 		}
 
@@ -691,15 +801,9 @@ func (a *AWS) handleEvent(ctx core.HTTPRequestContext) {
 	ctx.Response.WriteHeader(http.StatusOK)
 }
 
-func (a *AWS) subscriptionApplies(subscription core.IntegrationSubscriptionContext, data map[string]any) bool {
-	var event common.EventBridgeEvent
-	err := mapstructure.Decode(data, &event)
-	if err != nil {
-		return false
-	}
-
+func (a *AWS) subscriptionApplies(subscription core.IntegrationSubscriptionContext, event common.EventBridgeEvent) bool {
 	var configuration common.EventBridgeEvent
-	err = mapstructure.Decode(subscription.Configuration(), &configuration)
+	err := mapstructure.Decode(subscription.Configuration(), &configuration)
 	if err != nil {
 		return false
 	}
@@ -712,17 +816,67 @@ func (a *AWS) subscriptionApplies(subscription core.IntegrationSubscriptionConte
 		return false
 	}
 
-	if len(configuration.Detail) > 0 {
-		for key, value := range configuration.Detail {
-			if event.Detail[key] != value {
-				return false
-			}
+	return detailMatches(configuration.Detail, event.Detail)
+}
+
+/*
+ * Detail patterns follow a subset of the EventBridge content filtering rules:
+ * - a scalar value must be equal to the event value;
+ * - a list matches if any of its items matches the event value;
+ * - a {"prefix": "..."} item matches string values starting with the prefix;
+ * - a nested object is matched against the nested event object.
+ */
+func detailMatches(pattern map[string]any, detail map[string]any) bool {
+	for key, expected := range pattern {
+		if !detailValueMatches(expected, detail[key]) {
+			return false
 		}
 	}
 
 	return true
 }
 
+func detailValueMatches(expected any, actual any) bool {
+	switch e := expected.(type) {
+	case map[string]any:
+		if prefix, ok := e["prefix"].(string); ok && len(e) == 1 {
+			value, ok := actual.(string)
+			return ok && strings.HasPrefix(value, prefix)
+		}
+
+		nested, ok := actual.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		return detailMatches(e, nested)
+
+	case []any:
+		for _, item := range e {
+			if detailValueMatches(item, actual) {
+				return true
+			}
+		}
+
+		return false
+
+	case []string:
+		value, ok := actual.(string)
+		return ok && slices.Contains(e, value)
+
+	default:
+		if _, ok := actual.(map[string]any); ok {
+			return false
+		}
+
+		if _, ok := actual.([]any); ok {
+			return false
+		}
+
+		return expected == actual
+	}
+}
+
 func (a *AWS) Actions() []core.Action {
 	return []core.Action{
 		{
@@ -752,6 +906,17 @@ func (a *AWS) Actions() []core.Action {
 				},
 			},
 		},
+		{
+			Name:        "healthCheck",
+			Description: "Check that the integration credentials and EventBridge permissions still work",
+			Parameters:  []configuration.Field{},
+		},
+		{
+			Name:           RotateEventBridgeSecretAction,
+			Description:    "Rotate the secret EventBridge sends with events to SuperPlane",
+			UserAccessible: true,
+			Parameters:     rotateEventBridgeSecretParameters(),
+		},
 	}
 }
 
@@ -760,6 +925,12 @@ func (a *AWS) HandleAction(ctx core.IntegrationActionContext) error {
 	case "provisionRule":
 		return a.handleProvisionRule(ctx)
 
+	case "healthCheck":
+		return a.handleHealthCheck(ctx)
+
+	case RotateEventBridgeSecretAction:
+		return a.handleRotateEventBridgeSecret(ctx)
+
 	default:
 		return fmt.Errorf("unknown action: %s", ctx.Name)
 	}
@@ -4,10 +4,15 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -42,6 +47,7 @@ func Test__AWS__Sync(t *testing.T) {
 		putRolePolicyResponse := `<PutRolePolicyResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/"></PutRolePolicyResponse>`
 		createConnectionResponse := `{"ConnectionArn":"arn:aws:events:us-east-1:123456789012:connection/superplane-test/abc123"}`
 		createAPIDestinationResponse := `{"ApiDestinationArn":"arn:aws:events:us-east-1:123456789012:api-destination/superplane-test/def456"}`
+		integrationID := uuid.NewString()
 
 		httpContext := &contexts.HTTPContext{
 			Responses: []*http.Response{
@@ -49,6 +55,10 @@ func Test__AWS__Sync(t *testing.T) {
 					StatusCode: http.StatusOK,
 					Body:       io.NopCloser(strings.NewReader(stsResponse)),
 				},
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(getRoleResponse("localhost:8000", integrationID))),
+				},
 				{
 					StatusCode: http.StatusOK,
 					Body:       io.NopCloser(strings.NewReader(createRoleResponse)),
@@ -74,6 +84,7 @@ func Test__AWS__Sync(t *testing.T) {
 				"region":                 "us-east-1",
 				"sessionDurationSeconds": 3600,
 			},
+			IntegrationID: integrationID,
 			Secrets:       map[string]core.IntegrationSecret{},
 			BrowserAction: &core.BrowserAction{},
 		}
@@ -83,6 +94,7 @@ func Test__AWS__Sync(t *testing.T) {
 			HTTP:            httpContext,
 			OIDC:            support.NewOIDCProvider(),
 			Integration:     integrationCtx,
+			BaseURL:         "http://localhost:8000",
 			WebhooksBaseURL: "http://localhost:8000",
 			Logger:          logrus.NewEntry(logrus.New()),
 		})
@@ -91,12 +103,14 @@ func Test__AWS__Sync(t *testing.T) {
 		assert.Equal(t, "ready", integrationCtx.State)
 		assert.Nil(t, integrationCtx.BrowserAction)
 
-		require.Contains(t, integrationCtx.Secrets, "accessKeyId")
-		require.Contains(t, integrationCtx.Secrets, "secretAccessKey")
-		require.Contains(t, integrationCtx.Secrets, "sessionToken")
-		assert.Equal(t, []byte("AKIA_TEST"), integrationCtx.Secrets["accessKeyId"].Value)
-		assert.Equal(t, []byte("secret"), integrationCtx.Secrets["secretAccessKey"].Value)
-		assert.Equal(t, []byte("token"), integrationCtx.Secrets["sessionToken"].Value)
+		require.Contains(t, integrationCtx.Secrets, common.CredentialsSecret)
+		snapshot, err := common.CurrentCredentialsSnapshot(integrationCtx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, snapshot.Version)
+		assert.Equal(t, "AKIA_TEST", snapshot.AccessKeyID)
+		assert.Equal(t, "secret", snapshot.SecretAccessKey)
+		assert.Equal(t, "token", snapshot.SessionToken)
+		assert.Equal(t, expiration, snapshot.Expiration.Format(time.RFC3339))
 
 		metadata, ok := integrationCtx.Metadata.(common.IntegrationMetadata)
 		require.True(t, ok)
@@ -115,17 +129,19 @@ func Test__AWS__Sync(t *testing.T) {
 		require.Len(t, integrationCtx.ResyncRequests, 1)
 		assert.GreaterOrEqual(t, integrationCtx.ResyncRequests[0], time.Minute)
 
-		require.Len(t, httpContext.Requests, 5)
+		require.Len(t, httpContext.Requests, 6)
 		assert.Equal(t, "https://sts.us-east-1.amazonaws.com", httpContext.Requests[0].URL.String())
 		assert.Equal(t, "https://iam.amazonaws.com/", httpContext.Requests[1].URL.String())
 		assert.Equal(t, "https://iam.amazonaws.com/", httpContext.Requests[2].URL.String())
-		assert.Equal(t, "https://events.us-east-1.amazonaws.com/", httpContext.Requests[3].URL.String())
+		assert.Equal(t, "https://iam.amazonaws.com/", httpContext.Requests[3].URL.String())
 		assert.Equal(t, "https://events.us-east-1.amazonaws.com/", httpContext.Requests[4].URL.String())
+		assert.Equal(t, "https://events.us-east-1.amazonaws.com/", httpContext.Requests[5].URL.String())
 	})
 
 	t.Run("IAM and EventBridge already configured, only session token is refreshed", func(t *testing.T) {
 		expiration := time.Now().Add(2 * time.Hour).UTC().Format(time.RFC3339)
 		stsResponse := stsResponse("token2", expiration)
+		integrationID := uuid.NewString()
 
 		httpContext := &contexts.HTTPContext{
 			Responses: []*http.Response{
@@ -133,10 +149,15 @@ func Test__AWS__Sync(t *testing.T) {
 					StatusCode: http.StatusOK,
 					Body:       io.NopCloser(strings.NewReader(stsResponse)),
 				},
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(getRoleResponse("localhost:8000", integrationID))),
+				},
 			},
 		}
 
 		integrationCtx := &contexts.IntegrationContext{
+			IntegrationID: integrationID,
 			Configuration: map[string]any{
 				"roleArn":                "arn:aws:iam::123456789012:role/test-role",
 				"region":                 "us-east-1",
@@ -174,6 +195,7 @@ func Test__AWS__Sync(t *testing.T) {
 			HTTP:            httpContext,
 			OIDC:            support.NewOIDCProvider(),
 			Integration:     integrationCtx,
+			BaseURL:         "http://localhost:8000",
 			WebhooksBaseURL: "http://localhost:8000",
 			Logger:          logrus.NewEntry(logrus.New()),
 		})
@@ -186,20 +208,22 @@ func Test__AWS__Sync(t *testing.T) {
 		assert.GreaterOrEqual(t, integrationCtx.ResyncRequests[0], time.Minute)
 
 		//
-		// On the STS request to refresh the session token is done.
+		// Only the STS request to refresh the session token,
+		// and the IAM request to check the trust policy of the role are done.
 		//
-		require.Len(t, httpContext.Requests, 1)
+		require.Len(t, httpContext.Requests, 2)
 		assert.Equal(t, "https://sts.us-east-1.amazonaws.com", httpContext.Requests[0].URL.String())
+		assert.Equal(t, "https://iam.amazonaws.com/", httpContext.Requests[1].URL.String())
 
 		//
 		// Session token is refreshed.
 		//
-		require.Contains(t, integrationCtx.Secrets, "accessKeyId")
-		require.Contains(t, integrationCtx.Secrets, "secretAccessKey")
-		require.Contains(t, integrationCtx.Secrets, "sessionToken")
-		assert.Equal(t, []byte("AKIA_TEST"), integrationCtx.Secrets["accessKeyId"].Value)
-		assert.Equal(t, []byte("secret"), integrationCtx.Secrets["secretAccessKey"].Value)
-		assert.Equal(t, []byte("token2"), integrationCtx.Secrets["sessionToken"].Value)
+		require.Contains(t, integrationCtx.Secrets, common.CredentialsSecret)
+		snapshot, err := common.CurrentCredentialsSnapshot(integrationCtx)
+		require.NoError(t, err)
+		assert.Equal(t, "AKIA_TEST", snapshot.AccessKeyID)
+		assert.Equal(t, "secret", snapshot.SecretAccessKey)
+		assert.Equal(t, "token2", snapshot.SessionToken)
 
 		metadata, ok := integrationCtx.Metadata.(common.IntegrationMetadata)
 		require.True(t, ok)
@@ -211,6 +235,492 @@ func Test__AWS__Sync(t *testing.T) {
 	})
 }
 
+func Test__AWS__Sync__CredentialsRotation(t *testing.T) {
+	a := &AWS{}
+
+	integrationID := uuid.NewString()
+	integrationCtx := &contexts.IntegrationContext{
+		IntegrationID: integrationID,
+		Configuration: map[string]any{
+			"roleArn":                "arn:aws:iam::123456789012:role/test-role",
+			"region":                 "us-east-1",
+			"sessionDurationSeconds": 3600,
+		},
+		Secrets: map[string]core.IntegrationSecret{},
+		Metadata: common.IntegrationMetadata{
+			IAM: &common.IAMMetadata{
+				TargetDestinationRole: &common.IAMRoleMetadata{
+					RoleArn: "arn:aws:iam::123456789012:role/superplane-destination-invoker-test",
+				},
+			},
+			EventBridge: &common.EventBridgeMetadata{
+				APIDestinations: map[string]common.APIDestinationMetadata{
+					"us-east-1": {
+						APIDestinationArn: "arn:aws:events:us-east-1:123456789012:api-destination/superplane-test/def456",
+					},
+				},
+			},
+		},
+	}
+
+	_, err := common.StoreCredentials(integrationCtx, "AKIA_OLD", "old-secret", "old-token", time.Now().Add(30*time.Minute))
+	require.NoError(t, err)
+
+	//
+	// A client call reads the credentials before the rotation starts.
+	//
+	before, err := common.CurrentCredentialsSnapshot(integrationCtx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, before.Version)
+
+	//
+	// While the rotation is in progress, another read happens.
+	// It should still see the old credentials, and never a mix of both.
+	//
+	var during *common.CredentialsSnapshot
+	expiration := time.Now().Add(2 * time.Hour).UTC().Format(time.RFC3339)
+	httpContext := &interleavingHTTPContext{
+		HTTPContext: &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(stsResponse("new-token", expiration))),
+				},
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(getRoleResponse("localhost:8000", integrationID))),
+				},
+			},
+		},
+		onRequest: func() {
+			//
+			// Only the STS request happens during the rotation.
+			//
+			if during != nil {
+				return
+			}
+
+			during, err = common.CurrentCredentialsSnapshot(integrationCtx)
+			require.NoError(t, err)
+		},
+	}
+
+	err = a.Sync(core.SyncContext{
+		Configuration:   integrationCtx.Configuration,
+		HTTP:            httpContext,
+		OIDC:            support.NewOIDCProvider(),
+		Integration:     integrationCtx,
+		BaseURL:         "http://localhost:8000",
+		WebhooksBaseURL: "http://localhost:8000",
+		Logger:          logrus.NewEntry(logrus.New()),
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, during)
+	assert.Equal(t, *before, *during)
+
+	after, err := common.CurrentCredentialsSnapshot(integrationCtx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, after.Version)
+	assert.Equal(t, "AKIA_TEST", after.AccessKeyID)
+	assert.Equal(t, "secret", after.SecretAccessKey)
+	assert.Equal(t, "new-token", after.SessionToken)
+
+	//
+	// The previous version is still readable until it expires,
+	// so the client call that started before the rotation can finish.
+	//
+	previous, err := common.CredentialsForVersion(integrationCtx, before.Version)
+	require.NoError(t, err)
+	assert.Equal(t, "AKIA_OLD", previous.AccessKeyID)
+	assert.Equal(t, "old-secret", previous.SecretAccessKey)
+	assert.Equal(t, "old-token", previous.SessionToken)
+}
+
+func Test__AWS__Sync__TrustPolicy(t *testing.T) {
+	a := &AWS{}
+	expiration := time.Now().Add(2 * time.Hour).UTC().Format(time.RFC3339)
+
+	newIntegrationContext := func(integrationID string) *contexts.IntegrationContext {
+		return &contexts.IntegrationContext{
+			IntegrationID: integrationID,
+			Configuration: map[string]any{
+				"roleArn": "arn:aws:iam::123456789012:role/test-role",
+				"region":  "us-east-1",
+			},
+			Secrets: map[string]core.IntegrationSecret{},
+			Metadata: common.IntegrationMetadata{
+				IAM: &common.IAMMetadata{
+					TargetDestinationRole: &common.IAMRoleMetadata{
+						RoleArn: "arn:aws:iam::123456789012:role/superplane-destination-invoker-test",
+					},
+				},
+				EventBridge: &common.EventBridgeMetadata{
+					APIDestinations: map[string]common.APIDestinationMetadata{
+						"us-east-1": {
+							APIDestinationArn: "arn:aws:events:us-east-1:123456789012:api-destination/superplane-test/def456",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	runSync := func(integrationCtx *contexts.IntegrationContext, httpContext *contexts.HTTPContext) error {
+		return a.Sync(core.SyncContext{
+			Configuration:   integrationCtx.Configuration,
+			HTTP:            httpContext,
+			OIDC:            support.NewOIDCProvider(),
+			Integration:     integrationCtx,
+			BaseURL:         "http://localhost:8000",
+			WebhooksBaseURL: "http://localhost:8000",
+			Logger:          logrus.NewEntry(logrus.New()),
+		})
+	}
+
+	t.Run("mismatched audience -> browser action with guidance", func(t *testing.T) {
+		integrationID := uuid.NewString()
+		integrationCtx := newIntegrationContext(integrationID)
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(stsResponse("token", expiration)))},
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(getRoleResponse("localhost:8000", uuid.NewString())))},
+			},
+		}
+
+		err := runSync(integrationCtx, httpContext)
+		require.ErrorContains(t, err, "trust policy of role arn:aws:iam::123456789012:role/test-role")
+		assert.NotEqual(t, "ready", integrationCtx.State)
+		require.NotNil(t, integrationCtx.BrowserAction)
+		assert.Contains(t, integrationCtx.BrowserAction.Description, "Edit trust policy")
+		assert.Contains(t, integrationCtx.BrowserAction.Description, "localhost:8000:aud")
+		assert.Contains(t, integrationCtx.BrowserAction.Description, integrationID)
+		require.Len(t, httpContext.Requests, 2)
+	})
+
+	t.Run("mismatched provider -> browser action with guidance", func(t *testing.T) {
+		integrationID := uuid.NewString()
+		integrationCtx := newIntegrationContext(integrationID)
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(stsResponse("token", expiration)))},
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(getRoleResponse("other.example.com", integrationID)))},
+			},
+		}
+
+		err := runSync(integrationCtx, httpContext)
+		require.Error(t, err)
+		require.NotNil(t, integrationCtx.BrowserAction)
+		assert.Contains(t, integrationCtx.BrowserAction.Description, "Edit trust policy")
+	})
+
+	t.Run("correct trust policy -> proceeds", func(t *testing.T) {
+		integrationID := uuid.NewString()
+		integrationCtx := newIntegrationContext(integrationID)
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(stsResponse("token", expiration)))},
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(getRoleResponse("localhost:8000", integrationID)))},
+			},
+		}
+
+		require.NoError(t, runSync(integrationCtx, httpContext))
+		assert.Equal(t, "ready", integrationCtx.State)
+		assert.Nil(t, integrationCtx.BrowserAction)
+	})
+
+	t.Run("trust policy cannot be read -> proceeds", func(t *testing.T) {
+		integrationCtx := newIntegrationContext(uuid.NewString())
+		accessDenied := `<ErrorResponse><Error><Code>AccessDenied</Code><Message>not allowed</Message></Error></ErrorResponse>`
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(stsResponse("token", expiration)))},
+				{StatusCode: http.StatusForbidden, Body: io.NopCloser(strings.NewReader(accessDenied))},
+			},
+		}
+
+		require.NoError(t, runSync(integrationCtx, httpContext))
+		assert.Equal(t, "ready", integrationCtx.State)
+		assert.Nil(t, integrationCtx.BrowserAction)
+	})
+}
+
+func Test__AWS__Sync__STSErrors(t *testing.T) {
+	a := &AWS{}
+
+	stsError := func(code, message string) string {
+		return fmt.Sprintf(`<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>%s</Code>
+    <Message>%s</Message>
+  </Error>
+  <RequestId>c6104cbe-af31-11e0-8154-cbc7ccf896c7</RequestId>
+</ErrorResponse>`, code, message)
+	}
+
+	runSync := func(integrationID string, status int, body string) (*contexts.IntegrationContext, error) {
+		integrationCtx := &contexts.IntegrationContext{
+			IntegrationID: integrationID,
+			Configuration: map[string]any{
+				"roleArn": "arn:aws:iam::123456789012:role/test-role",
+				"region":  "us-east-1",
+			},
+			Secrets:  map[string]core.IntegrationSecret{},
+			Metadata: common.IntegrationMetadata{},
+		}
+
+		err := a.Sync(core.SyncContext{
+			Configuration: integrationCtx.Configuration,
+			HTTP: &contexts.HTTPContext{
+				Responses: []*http.Response{
+					{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))},
+				},
+			},
+			OIDC:            support.NewOIDCProvider(),
+			Integration:     integrationCtx,
+			BaseURL:         "http://localhost:8000",
+			WebhooksBaseURL: "http://localhost:8000",
+			Logger:          logrus.NewEntry(logrus.New()),
+		})
+
+		return integrationCtx, err
+	}
+
+	t.Run("AccessDenied -> trust policy guidance", func(t *testing.T) {
+		integrationID := uuid.NewString()
+		body := stsError("AccessDenied", "Not authorized to perform sts:AssumeRoleWithWebIdentity")
+		integrationCtx, err := runSync(integrationID, http.StatusForbidden, body)
+
+		require.Error(t, err)
+		assert.True(t, common.IsSTSErr(err, common.STSErrorAccessDenied))
+		assert.ErrorContains(t, err, "AWS denied assuming role arn:aws:iam::123456789012:role/test-role, check that its trust policy allows the localhost:8000 identity provider with audience "+integrationID)
+		assert.ErrorContains(t, err, "AccessDenied: Not authorized to perform sts:AssumeRoleWithWebIdentity")
+		assert.NotEqual(t, "ready", integrationCtx.State)
+		require.NotNil(t, integrationCtx.BrowserAction)
+		assert.Contains(t, integrationCtx.BrowserAction.Description, "Edit trust policy")
+		assert.Contains(t, integrationCtx.BrowserAction.Description, integrationID)
+	})
+
+	t.Run("InvalidIdentityToken -> identity provider guidance", func(t *testing.T) {
+		integrationID := uuid.NewString()
+		body := stsError("InvalidIdentityToken", "No OpenIDConnect provider found in your account for http://localhost:8000")
+		integrationCtx, err := runSync(integrationID, http.StatusBadRequest, body)
+
+		require.Error(t, err)
+		assert.True(t, common.IsSTSErr(err, common.STSErrorInvalidIdentityToken))
+		assert.ErrorContains(t, err, "AWS rejected the SuperPlane identity token, check that the IAM identity provider for http://localhost:8000 exists with audience "+integrationID)
+		require.NotNil(t, integrationCtx.BrowserAction)
+		assert.Contains(t, integrationCtx.BrowserAction.Description, "AWS rejected the SuperPlane identity token")
+		assert.Contains(t, integrationCtx.BrowserAction.Description, "Create Identity Provider")
+		assert.Contains(t, integrationCtx.BrowserAction.Description, integrationID)
+	})
+
+	t.Run("ExpiredTokenException -> clock guidance, no browser action", func(t *testing.T) {
+		body := stsError("ExpiredTokenException", "Token expired")
+		integrationCtx, err := runSync(uuid.NewString(), http.StatusBadRequest, body)
+
+		require.ErrorContains(t, err, "the SuperPlane identity token expired before AWS accepted it")
+		assert.Nil(t, integrationCtx.BrowserAction)
+	})
+
+	t.Run("response that is not an STS error -> generic error", func(t *testing.T) {
+		integrationCtx, err := runSync(uuid.NewString(), http.StatusInternalServerError, "oops")
+
+		require.ErrorContains(t, err, "failed to generate credentials: failed to assume role: STS request failed with 500: oops")
+		assert.Nil(t, integrationCtx.BrowserAction)
+	})
+}
+
+func Test__AWS__Sync__SessionDuration(t *testing.T) {
+	a := &AWS{}
+	expiration := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	validationError := func(message string) string {
+		return fmt.Sprintf(`<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>ValidationError</Code>
+    <Message>%s</Message>
+  </Error>
+  <RequestId>c6104cbe-af31-11e0-8154-cbc7ccf896c7</RequestId>
+</ErrorResponse>`, message)
+	}
+
+	runSync := func(t *testing.T, stsResponses ...*http.Response) (*contexts.IntegrationContext, *contexts.HTTPContext, error) {
+		integrationID := uuid.NewString()
+		integrationCtx := &contexts.IntegrationContext{
+			IntegrationID: integrationID,
+			Configuration: map[string]any{
+				"roleArn":                "arn:aws:iam::123456789012:role/test-role",
+				"sessionDurationSeconds": 43200,
+			},
+			Secrets:  map[string]core.IntegrationSecret{},
+			Metadata: common.IntegrationMetadata{},
+		}
+
+		httpContext := &contexts.HTTPContext{
+			Responses: append(stsResponses,
+				&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(getRoleResponse("localhost:8000", integrationID)))},
+				&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(createRoleResponse()))},
+				&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`<PutRolePolicyResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/"></PutRolePolicyResponse>`))},
+			),
+		}
+
+		err := a.Sync(core.SyncContext{
+			Configuration:   integrationCtx.Configuration,
+			HTTP:            httpContext,
+			OIDC:            support.NewOIDCProvider(),
+			Integration:     integrationCtx,
+			BaseURL:         "http://localhost:8000",
+			WebhooksBaseURL: "http://localhost:8000",
+			Logger:          logrus.NewEntry(logrus.New()),
+		})
+
+		return integrationCtx, httpContext, err
+	}
+
+	requestedDuration := func(t *testing.T, request *http.Request) string {
+		body, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+		form, err := url.ParseQuery(string(body))
+		require.NoError(t, err)
+		return form.Get("DurationSeconds")
+	}
+
+	t.Run("duration above the role max -> retried with the duration every role allows", func(t *testing.T) {
+		integrationCtx, httpContext, err := runSync(t,
+			&http.Response{StatusCode: http.StatusBadRequest, Body: io.NopCloser(strings.NewReader(validationError("The requested DurationSeconds exceeds the MaxSessionDuration set for this role.")))},
+			&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(stsResponse("token", expiration)))},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "ready", integrationCtx.State)
+		require.Len(t, httpContext.Requests, 5)
+		assert.Equal(t, "43200", requestedDuration(t, httpContext.Requests[0]))
+		assert.Equal(t, "3600", requestedDuration(t, httpContext.Requests[1]))
+
+		metadata, ok := integrationCtx.Metadata.(common.IntegrationMetadata)
+		require.True(t, ok)
+		assert.Equal(t, 3600, metadata.Session.DurationSeconds)
+	})
+
+	t.Run("error including the allowed maximum -> retried with it", func(t *testing.T) {
+		integrationCtx, httpContext, err := runSync(t,
+			&http.Response{StatusCode: http.StatusBadRequest, Body: io.NopCloser(strings.NewReader(validationError("1 validation error detected: Value '43200' at 'durationSeconds' failed to satisfy constraint: Member must have value less than or equal to 14400")))},
+			&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(stsResponse("token", expiration)))},
+		)
+
+		require.NoError(t, err)
+		require.Len(t, httpContext.Requests, 5)
+		assert.Equal(t, "14400", requestedDuration(t, httpContext.Requests[1]))
+
+		metadata, ok := integrationCtx.Metadata.(common.IntegrationMetadata)
+		require.True(t, ok)
+		assert.Equal(t, 14400, metadata.Session.DurationSeconds)
+	})
+
+	t.Run("other validation error -> not retried", func(t *testing.T) {
+		_, httpContext, err := runSync(t,
+			&http.Response{StatusCode: http.StatusBadRequest, Body: io.NopCloser(strings.NewReader(validationError("Request ARN is invalid")))},
+		)
+
+		require.ErrorContains(t, err, "ValidationError: Request ARN is invalid")
+		assert.Len(t, httpContext.Requests, 1)
+	})
+}
+
+func Test__AWS__Sync__Partitions(t *testing.T) {
+	a := &AWS{}
+	expiration := time.Now().Add(2 * time.Hour).UTC().Format(time.RFC3339)
+	putRolePolicyResponse := `<PutRolePolicyResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/"></PutRolePolicyResponse>`
+
+	sync := func(t *testing.T, config map[string]any, httpContext *contexts.HTTPContext, integrationID string) *contexts.IntegrationContext {
+		integrationCtx := &contexts.IntegrationContext{
+			Configuration: config,
+			IntegrationID: integrationID,
+			Secrets:       map[string]core.IntegrationSecret{},
+		}
+
+		err := a.Sync(core.SyncContext{
+			Configuration:   integrationCtx.Configuration,
+			HTTP:            httpContext,
+			OIDC:            support.NewOIDCProvider(),
+			Integration:     integrationCtx,
+			BaseURL:         "http://localhost:8000",
+			WebhooksBaseURL: "http://localhost:8000",
+			Logger:          logrus.NewEntry(logrus.New()),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "ready", integrationCtx.State)
+		return integrationCtx
+	}
+
+	t.Run("GovCloud role -> GovCloud endpoints and ARNs", func(t *testing.T) {
+		integrationID := uuid.NewString()
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(stsResponse("token", expiration)))},
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(getRoleResponse("localhost:8000", integrationID)))},
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(createRoleResponse()))},
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(putRolePolicyResponse))},
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"ConnectionArn":"arn:aws-us-gov:events:us-gov-west-1:123456789012:connection/superplane-test/abc123"}`))},
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"ApiDestinationArn":"arn:aws-us-gov:events:us-gov-west-1:123456789012:api-destination/superplane-test/def456"}`))},
+			},
+		}
+
+		integrationCtx := sync(t, map[string]any{
+			"roleArn": "arn:aws-us-gov:iam::123456789012:role/test-role",
+			"region":  "us-gov-west-1",
+		}, httpContext, integrationID)
+
+		metadata, ok := integrationCtx.Metadata.(common.IntegrationMetadata)
+		require.True(t, ok)
+		assert.Equal(t, "123456789012", metadata.Session.AccountID)
+
+		require.Len(t, httpContext.Requests, 6)
+		assert.Equal(t, "https://sts.us-gov-west-1.amazonaws.com", httpContext.Requests[0].URL.String())
+		assert.Equal(t, "https://iam.us-gov.amazonaws.com/", httpContext.Requests[1].URL.String())
+		assert.Equal(t, "https://iam.us-gov.amazonaws.com/", httpContext.Requests[2].URL.String())
+		assert.Equal(t, "https://iam.us-gov.amazonaws.com/", httpContext.Requests[3].URL.String())
+		assert.Equal(t, "https://events.us-gov-west-1.amazonaws.com/", httpContext.Requests[4].URL.String())
+		assert.Equal(t, "https://events.us-gov-west-1.amazonaws.com/", httpContext.Requests[5].URL.String())
+
+		body, err := io.ReadAll(httpContext.Requests[3].Body)
+		require.NoError(t, err)
+		form, err := url.ParseQuery(string(body))
+		require.NoError(t, err)
+		assert.Contains(t, form.Get("PolicyDocument"), `"arn:aws-us-gov:events:*:123456789012:api-destination/*"`)
+	})
+
+	t.Run("China role without region -> China STS and IAM endpoints", func(t *testing.T) {
+		integrationID := uuid.NewString()
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(stsResponse("token", expiration)))},
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(getRoleResponse("localhost:8000", integrationID)))},
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(createRoleResponse()))},
+				{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(putRolePolicyResponse))},
+			},
+		}
+
+		sync(t, map[string]any{
+			"roleArn": "arn:aws-cn:iam::123456789012:role/test-role",
+			"region":  "",
+		}, httpContext, integrationID)
+
+		require.Len(t, httpContext.Requests, 4)
+		assert.Equal(t, "https://sts.cn-north-1.amazonaws.com.cn", httpContext.Requests[0].URL.String())
+		assert.Equal(t, "https://iam.cn-north-1.amazonaws.com.cn/", httpContext.Requests[1].URL.String())
+
+		body, err := io.ReadAll(httpContext.Requests[3].Body)
+		require.NoError(t, err)
+		form, err := url.ParseQuery(string(body))
+		require.NoError(t, err)
+		assert.Contains(t, form.Get("PolicyDocument"), `"arn:aws-cn:events:*:123456789012:api-destination/*"`)
+	})
+}
+
 func Test__AWS__ListResources(t *testing.T) {
 	a := &AWS{}
 
@@ -296,6 +806,89 @@ func Test__AWS__ListResources(t *testing.T) {
 		assert.Equal(t, "https://lambda.us-east-1.amazonaws.com/2015-03-31/functions?MaxItems=50", httpContext.Requests[0].URL.String())
 	})
 
+	t.Run("lambda.alias returns aliases for the function", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(strings.NewReader(`
+						{
+							"Aliases": [
+								{
+									"Name": "prod",
+									"AliasArn": "arn:aws:lambda:us-east-1:123456789012:function:runFunction:prod",
+									"FunctionVersion": "3"
+								}
+							],
+							"NextMarker": "next"
+						}
+					`)),
+				},
+				{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(strings.NewReader(`
+						{
+							"Aliases": [
+								{
+									"Name": "staging",
+									"AliasArn": "arn:aws:lambda:us-east-1:123456789012:function:runFunction:staging",
+									"FunctionVersion": "4"
+								}
+							]
+						}
+					`)),
+				},
+			},
+		}
+
+		integrationCtx := &contexts.IntegrationContext{
+			Secrets: map[string]core.IntegrationSecret{
+				"accessKeyId":     {Name: "accessKeyId", Value: []byte("key")},
+				"secretAccessKey": {Name: "secretAccessKey", Value: []byte("secret")},
+				"sessionToken":    {Name: "sessionToken", Value: []byte("token")},
+			},
+		}
+
+		resources, err := a.ListResources("lambda.alias", core.ListResourcesContext{
+			Integration: integrationCtx,
+			Logger:      logrus.NewEntry(logrus.New()),
+			HTTP:        httpContext,
+			Parameters: map[string]string{
+				"functionArn": "arn:aws:lambda:us-east-1:123456789012:function:runFunction",
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resources, 2)
+		assert.Equal(t, "lambda.alias", resources[0].Type)
+		assert.Equal(t, "prod", resources[0].Name)
+		assert.Equal(t, "arn:aws:lambda:us-east-1:123456789012:function:runFunction:prod", resources[0].ID)
+		assert.Equal(t, "staging", resources[1].Name)
+
+		require.Len(t, httpContext.Requests, 2)
+		assert.Equal(t, "https://lambda.us-east-1.amazonaws.com/2015-03-31/functions/arn:aws:lambda:us-east-1:123456789012:function:runFunction/aliases?MaxItems=50", httpContext.Requests[0].URL.String())
+		assert.Equal(t, "next", httpContext.Requests[1].URL.Query().Get("Marker"))
+	})
+
+	t.Run("lambda.alias without function returns empty list", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			Secrets: map[string]core.IntegrationSecret{
+				"accessKeyId":     {Name: "accessKeyId", Value: []byte("key")},
+				"secretAccessKey": {Name: "secretAccessKey", Value: []byte("secret")},
+				"sessionToken":    {Name: "sessionToken", Value: []byte("token")},
+			},
+		}
+
+		resources, err := a.ListResources("lambda.alias", core.ListResourcesContext{
+			Integration: integrationCtx,
+			Logger:      logrus.NewEntry(logrus.New()),
+			Parameters:  map[string]string{"region": "us-east-1"},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, resources)
+	})
+
 	t.Run("ecr.repository returns repositories", func(t *testing.T) {
 		httpContext := &contexts.HTTPContext{
 			Responses: []*http.Response{
@@ -385,6 +978,218 @@ func Test__AWS__ListResources(t *testing.T) {
 		require.Len(t, httpContext.Requests, 1)
 		assert.Equal(t, "https://sns.us-east-1.amazonaws.com/", httpContext.Requests[0].URL.String())
 	})
+
+	t.Run("sns.subscription -> follows pagination tokens", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(strings.NewReader(`
+<ListSubscriptionsByTopicResponse xmlns="http://sns.amazonaws.com/doc/2010-03-31/">
+  <ListSubscriptionsByTopicResult>
+    <Subscriptions>
+      <member>
+        <SubscriptionArn>arn:aws:sns:us-east-1:123456789012:orders-events:sub-1</SubscriptionArn>
+      </member>
+    </Subscriptions>
+    <NextToken>page-2</NextToken>
+  </ListSubscriptionsByTopicResult>
+</ListSubscriptionsByTopicResponse>
+`)),
+				},
+				{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(strings.NewReader(`
+<ListSubscriptionsByTopicResponse xmlns="http://sns.amazonaws.com/doc/2010-03-31/">
+  <ListSubscriptionsByTopicResult>
+    <Subscriptions>
+      <member>
+        <SubscriptionArn>arn:aws:sns:us-east-1:123456789012:orders-events:sub-2</SubscriptionArn>
+      </member>
+    </Subscriptions>
+  </ListSubscriptionsByTopicResult>
+</ListSubscriptionsByTopicResponse>
+`)),
+				},
+			},
+		}
+
+		integrationCtx := &contexts.IntegrationContext{
+			Secrets: map[string]core.IntegrationSecret{
+				"accessKeyId":     {Name: "accessKeyId", Value: []byte("key")},
+				"secretAccessKey": {Name: "secretAccessKey", Value: []byte("secret")},
+				"sessionToken":    {Name: "sessionToken", Value: []byte("token")},
+			},
+		}
+
+		resources, err := a.ListResources("sns.subscription", core.ListResourcesContext{
+			Integration: integrationCtx,
+			Logger:      logrus.NewEntry(logrus.New()),
+			HTTP:        httpContext,
+			Parameters: map[string]string{
+				"region":   "us-east-1",
+				"topicArn": "arn:aws:sns:us-east-1:123456789012:orders-events",
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resources, 2)
+		assert.Equal(t, "sns.subscription", resources[0].Type)
+		assert.Equal(t, "sub-1", resources[0].Name)
+		assert.Equal(t, "arn:aws:sns:us-east-1:123456789012:orders-events:sub-2", resources[1].ID)
+
+		require.Len(t, httpContext.Requests, 2)
+		body, err := io.ReadAll(httpContext.Requests[1].Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "NextToken=page-2")
+	})
+}
+
+func Test__AWS__DetailMatches(t *testing.T) {
+	detail := map[string]any{
+		"alarmName": "prod-HighCPU",
+		"state": map[string]any{
+			"value":  "ALARM",
+			"reason": "Threshold Crossed",
+		},
+	}
+
+	t.Run("scalar values -> equality", func(t *testing.T) {
+		assert.True(t, detailMatches(map[string]any{"alarmName": "prod-HighCPU"}, detail))
+		assert.False(t, detailMatches(map[string]any{"alarmName": "prod-LowCPU"}, detail))
+	})
+
+	t.Run("nested objects -> matched against nested detail", func(t *testing.T) {
+		assert.True(t, detailMatches(map[string]any{"state": map[string]any{"value": "ALARM"}}, detail))
+		assert.False(t, detailMatches(map[string]any{"state": map[string]any{"value": "OK"}}, detail))
+		assert.False(t, detailMatches(map[string]any{"state": "ALARM"}, detail))
+	})
+
+	t.Run("lists -> any item matches", func(t *testing.T) {
+		assert.True(t, detailMatches(map[string]any{"state": map[string]any{"value": []any{"OK", "ALARM"}}}, detail))
+		assert.False(t, detailMatches(map[string]any{"state": map[string]any{"value": []any{"OK", "INSUFFICIENT_DATA"}}}, detail))
+	})
+
+	t.Run("prefix -> string values starting with prefix", func(t *testing.T) {
+		assert.True(t, detailMatches(map[string]any{"alarmName": []any{map[string]any{"prefix": "prod-"}}}, detail))
+		assert.False(t, detailMatches(map[string]any{"alarmName": []any{map[string]any{"prefix": "staging-"}}}, detail))
+	})
+}
+
+func Test__AWS__HandleEvent__Deduplication(t *testing.T) {
+	subscription := &countingSubscription{
+		configuration: common.EventBridgeEvent{
+			Source:     "aws.ecr",
+			DetailType: "ECR Image Action",
+		},
+	}
+
+	integrationCtx := &subscriptionsIntegrationContext{
+		IntegrationContext: &contexts.IntegrationContext{
+			IntegrationID: uuid.NewString(),
+			Secrets: map[string]core.IntegrationSecret{
+				EventBridgeConnectionSecretName: {Name: EventBridgeConnectionSecretName, Value: []byte("secret")},
+			},
+		},
+		subscriptions: []core.IntegrationSubscriptionContext{subscription},
+	}
+
+	deliverAndFinish := func(eventID string, committed bool) int {
+		body := fmt.Sprintf(`{"id":"%s","source":"aws.ecr","detail-type":"ECR Image Action","detail":{}}`, eventID)
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/integrations/aws/events", strings.NewReader(body))
+		request.Header.Set(APIKeyHeaderName, "secret")
+		response := httptest.NewRecorder()
+		result := &core.HTTPRequestResult{}
+
+		a := &AWS{}
+		a.HandleRequest(core.HTTPRequestContext{
+			Logger:      logrus.NewEntry(logrus.New()),
+			Request:     request,
+			Response:    response,
+			Integration: integrationCtx,
+			Result:      result,
+		})
+
+		result.Finish(committed)
+		return response.Code
+	}
+
+	deliver := func(eventID string) int {
+		return deliverAndFinish(eventID, true)
+	}
+
+	t.Run("same event delivered twice -> dispatched once", func(t *testing.T) {
+		eventID := uuid.NewString()
+		assert.Equal(t, http.StatusOK, deliver(eventID))
+		assert.Equal(t, http.StatusOK, deliver(eventID))
+		assert.Equal(t, int32(1), subscription.messages.Load())
+	})
+
+	t.Run("same event delivered concurrently -> dispatched once", func(t *testing.T) {
+		subscription.messages.Store(0)
+		eventID := uuid.NewString()
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				deliver(eventID)
+			}()
+		}
+
+		wg.Wait()
+		assert.Equal(t, int32(1), subscription.messages.Load())
+	})
+
+	t.Run("different events -> dispatched separately", func(t *testing.T) {
+		subscription.messages.Store(0)
+		deliver(uuid.NewString())
+		deliver(uuid.NewString())
+		assert.Equal(t, int32(2), subscription.messages.Load())
+	})
+
+	t.Run("delivery not committed -> retry is dispatched again", func(t *testing.T) {
+		subscription.messages.Store(0)
+		eventID := uuid.NewString()
+		assert.Equal(t, http.StatusOK, deliverAndFinish(eventID, false))
+		assert.Equal(t, http.StatusOK, deliver(eventID))
+		assert.Equal(t, http.StatusOK, deliver(eventID))
+		assert.Equal(t, int32(2), subscription.messages.Load())
+	})
+}
+
+type countingSubscription struct {
+	configuration any
+	messages      atomic.Int32
+}
+
+func (s *countingSubscription) Configuration() any {
+	return s.configuration
+}
+
+func (s *countingSubscription) SendMessage(message any) error {
+	s.messages.Add(1)
+	return nil
+}
+
+type subscriptionsIntegrationContext struct {
+	*contexts.IntegrationContext
+	subscriptions []core.IntegrationSubscriptionContext
+}
+
+func (c *subscriptionsIntegrationContext) ListSubscriptions(filter core.SubscriptionFilter) ([]core.IntegrationSubscriptionContext, error) {
+	return c.subscriptions, nil
+}
+
+type interleavingHTTPContext struct {
+	*contexts.HTTPContext
+	onRequest func()
+}
+
+func (c *interleavingHTTPContext) Do(request *http.Request) (*http.Response, error) {
+	c.onRequest()
+	return c.HTTPContext.Do(request)
 }
 
 func stsResponse(token string, expiration string) string {
@@ -402,6 +1207,31 @@ func stsResponse(token string, expiration string) string {
 `, token, expiration)
 }
 
+func getRoleResponse(provider, audience string) string {
+	policy := fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Federated": "arn:aws:iam::123456789012:oidc-provider/%s"},
+      "Action": "sts:AssumeRoleWithWebIdentity",
+      "Condition": {"StringEquals": {"%s:aud": "%s"}}
+    }
+  ]
+}`, provider, provider, audience)
+
+	return fmt.Sprintf(`
+<GetRoleResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <GetRoleResult>
+    <Role>
+      <Arn>arn:aws:iam::123456789012:role/test-role</Arn>
+      <AssumeRolePolicyDocument>%s</AssumeRolePolicyDocument>
+    </Role>
+  </GetRoleResult>
+</GetRoleResponse>
+`, url.QueryEscape(policy))
+}
+
 func createRoleResponse() string {
 	return `
 <CreateRoleResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
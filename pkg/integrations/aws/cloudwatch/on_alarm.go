@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
@@ -21,14 +22,35 @@ const (
 type OnAlarm struct{}
 
 type OnAlarmConfiguration struct {
-	Region string                    `json:"region" mapstructure:"region"`
-	Alarms []configuration.Predicate `json:"alarms" mapstructure:"alarms"`
-	State  string                    `json:"state" mapstructure:"state"`
+	Region              string                    `json:"region" mapstructure:"region"`
+	Alarms              []configuration.Predicate `json:"alarms" mapstructure:"alarms"`
+	AlarmNamePrefix     string                    `json:"alarmNamePrefix" mapstructure:"alarmNamePrefix"`
+	StateFilter         []string                  `json:"stateFilter" mapstructure:"stateFilter"`
+	PreviousStateFilter []string                  `json:"previousStateFilter" mapstructure:"previousStateFilter"`
+
+	//
+	// Single state filter used before stateFilter was introduced.
+	// Only used when stateFilter is empty.
+	//
+	State string `json:"state" mapstructure:"state"`
+}
+
+func (c *OnAlarmConfiguration) States() []string {
+	if len(c.StateFilter) > 0 {
+		return c.StateFilter
+	}
+
+	if strings.TrimSpace(c.State) != "" {
+		return []string{strings.TrimSpace(c.State)}
+	}
+
+	return nil
 }
 
 type OnAlarmMetadata struct {
 	Region         string `json:"region" mapstructure:"region"`
 	SubscriptionID string `json:"subscriptionId" mapstructure:"subscriptionId"`
+	ConfigHash     string `json:"configHash" mapstructure:"configHash"`
 }
 
 type AlarmStateChangeDetail struct {
@@ -56,7 +78,8 @@ func (p *OnAlarm) Description() string {
 }
 
 func (p *OnAlarm) Documentation() string {
-	return `The On Alarm trigger starts a workflow execution when a CloudWatch alarm transitions to the ALARM state.
+	return `The On Alarm trigger starts a workflow execution when a CloudWatch alarm changes state.
+Both metric alarms and composite alarms are supported.
 
 ## Use Cases
 
@@ -68,14 +91,20 @@ func (p *OnAlarm) Documentation() string {
 
 - **Region**: AWS region where alarms are evaluated
 - **Alarms**: Optional alarm name filters (supports equals, not-equals, and regex matches)
-- **State**: Only trigger for alarms in the specified state (OK, ALARM, or INSUFFICIENT_DATA)
+- **Alarm Name Prefix**: Optional prefix the alarm name must start with
+- **State**: Only trigger when the alarm transitions to one of the selected states (OK, ALARM, or INSUFFICIENT_DATA)
+- **Previous State**: Optionally, only trigger when the alarm transitions from one of the selected states
 
 ## Event Data
 
 Each alarm event includes:
 - **detail.alarmName**: CloudWatch alarm name
-- **detail.state.value**: Current alarm state
+- **detail.state.value**: New alarm state
+- **detail.state.reason**: Reason for the new state
+- **detail.state.timestamp**: When the alarm entered the new state
 - **detail.previousState.value**: Previous alarm state
+- **detail.previousState.reason**: Reason for the previous state
+- **detail.previousState.timestamp**: When the alarm entered the previous state
 `
 }
 
@@ -102,17 +131,39 @@ func (p *OnAlarm) Configuration() []configuration.Field {
 			},
 		},
 		{
-			Name:     "state",
-			Label:    "Alarm State",
-			Type:     configuration.FieldTypeSelect,
-			Required: true,
-			Default:  AlarmStateAlarm,
+			Name:        "stateFilter",
+			Label:       "State",
+			Type:        configuration.FieldTypeMultiSelect,
+			Required:    true,
+			Default:     []string{AlarmStateAlarm},
+			Description: "Only trigger when the alarm transitions to one of these states",
 			TypeOptions: &configuration.TypeOptions{
-				Select: &configuration.SelectTypeOptions{
+				MultiSelect: &configuration.MultiSelectTypeOptions{
+					Options: AllAlarmStates,
+				},
+			},
+		},
+		{
+			Name:        "previousStateFilter",
+			Label:       "Previous State",
+			Type:        configuration.FieldTypeMultiSelect,
+			Required:    false,
+			Togglable:   true,
+			Description: "Only trigger when the alarm transitions from one of these states",
+			TypeOptions: &configuration.TypeOptions{
+				MultiSelect: &configuration.MultiSelectTypeOptions{
 					Options: AllAlarmStates,
 				},
 			},
 		},
+		{
+			Name:        "alarmNamePrefix",
+			Label:       "Alarm Name Prefix",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Togglable:   true,
+			Description: "Only trigger for alarms whose name starts with this prefix",
+		},
 		{
 			Name:     "alarms",
 			Label:    "Alarms",
@@ -124,6 +175,7 @@ func (p *OnAlarm) Configuration() []configuration.Field {
 				},
 			},
 		},
+		core.DeduplicationField(),
 	}
 }
 
@@ -142,10 +194,31 @@ func (p *OnAlarm) Setup(ctx core.TriggerContext) error {
 		return fmt.Errorf("region is required")
 	}
 
-	if metadata.SubscriptionID != "" {
+	configHash, err := common.ConfigHash(config)
+	if err != nil {
+		return err
+	}
+
+	//
+	// Nothing changed since the last successful setup.
+	//
+	if metadata.SubscriptionID != "" && metadata.ConfigHash == configHash {
 		return nil
 	}
 
+	//
+	// The subscription pattern depends on the filters,
+	// so we keep it in sync with the current configuration.
+	//
+	if metadata.SubscriptionID != "" {
+		if err := p.updateSubscription(ctx.Integration, metadata, config); err != nil {
+			return err
+		}
+
+		metadata.ConfigHash = configHash
+		return ctx.Metadata.Set(metadata)
+	}
+
 	integrationMetadata := common.IntegrationMetadata{}
 	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &integrationMetadata); err != nil {
 		return fmt.Errorf("failed to decode integration metadata: %w", err)
@@ -164,7 +237,7 @@ func (p *OnAlarm) Setup(ctx core.TriggerContext) error {
 		return p.provisionRule(ctx.Integration, ctx.Requests, config.Region)
 	}
 
-	subscriptionID, err := ctx.Integration.Subscribe(p.subscriptionPattern(config.Region))
+	subscriptionID, err := ctx.Integration.Subscribe(p.subscriptionPattern(config.Region, config))
 	if err != nil {
 		return fmt.Errorf("failed to subscribe: %w", err)
 	}
@@ -172,6 +245,7 @@ func (p *OnAlarm) Setup(ctx core.TriggerContext) error {
 	return ctx.Metadata.Set(OnAlarmMetadata{
 		Region:         config.Region,
 		SubscriptionID: subscriptionID.String(),
+		ConfigHash:     configHash,
 	})
 }
 
@@ -196,12 +270,55 @@ func (p *OnAlarm) provisionRule(integration core.IntegrationContext, requests co
 	)
 }
 
-func (p *OnAlarm) subscriptionPattern(region string) *common.EventBridgeEvent {
-	return &common.EventBridgeEvent{
+func (p *OnAlarm) updateSubscription(integration core.IntegrationContext, metadata OnAlarmMetadata, config OnAlarmConfiguration) error {
+	subscriptionID, err := uuid.Parse(metadata.SubscriptionID)
+	if err != nil {
+		return fmt.Errorf("invalid subscription ID %s: %w", metadata.SubscriptionID, err)
+	}
+
+	err = integration.UpdateSubscription(subscriptionID, p.subscriptionPattern(metadata.Region, config))
+	if err != nil {
+		return fmt.Errorf("failed to update subscription: %w", err)
+	}
+
+	return nil
+}
+
+func (p *OnAlarm) subscriptionPattern(region string, config OnAlarmConfiguration) *common.EventBridgeEvent {
+	event := &common.EventBridgeEvent{
 		Region:     region,
 		DetailType: DetailTypeAlarmStateChange,
 		Source:     Source,
 	}
+
+	detail := map[string]any{}
+	if states := config.States(); len(states) > 0 {
+		detail["state"] = map[string]any{"value": anySlice(states)}
+	}
+
+	if len(config.PreviousStateFilter) > 0 {
+		detail["previousState"] = map[string]any{"value": anySlice(config.PreviousStateFilter)}
+	}
+
+	prefix := strings.TrimSpace(config.AlarmNamePrefix)
+	if prefix != "" {
+		detail["alarmName"] = []any{map[string]any{"prefix": prefix}}
+	}
+
+	if len(detail) > 0 {
+		event.Detail = detail
+	}
+
+	return event
+}
+
+func anySlice(values []string) []any {
+	result := make([]any, 0, len(values))
+	for _, value := range values {
+		result = append(result, value)
+	}
+
+	return result
 }
 
 func (p *OnAlarm) Actions() []core.Action {
@@ -262,12 +379,23 @@ func (p *OnAlarm) checkRuleAvailability(ctx core.TriggerActionContext) (map[stri
 		)
 	}
 
-	subscriptionID, err := ctx.Integration.Subscribe(p.subscriptionPattern(metadata.Region))
+	config := OnAlarmConfiguration{}
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	configHash, err := common.ConfigHash(config)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionID, err := ctx.Integration.Subscribe(p.subscriptionPattern(metadata.Region, config))
 	if err != nil {
 		return nil, fmt.Errorf("failed to subscribe: %w", err)
 	}
 
 	metadata.SubscriptionID = subscriptionID.String()
+	metadata.ConfigHash = configHash
 	return nil, ctx.Metadata.Set(metadata)
 }
 
@@ -307,11 +435,24 @@ func (p *OnAlarm) OnIntegrationMessage(ctx core.IntegrationMessageContext) error
 		return fmt.Errorf("missing alarm state in event")
 	}
 
-	if state != config.State {
+	states := config.States()
+	if len(states) > 0 && !slices.Contains(states, state) {
 		ctx.Logger.Infof("Skipping event for alarm %s with state %s", alarmName, state)
 		return nil
 	}
 
+	previousState := strings.TrimSpace(detail.PreviousState.Value)
+	if len(config.PreviousStateFilter) > 0 && !slices.Contains(config.PreviousStateFilter, previousState) {
+		ctx.Logger.Infof("Skipping event for alarm %s with previous state %s", alarmName, previousState)
+		return nil
+	}
+
+	prefix := strings.TrimSpace(config.AlarmNamePrefix)
+	if prefix != "" && !strings.HasPrefix(alarmName, prefix) {
+		ctx.Logger.Infof("Skipping event for alarm %s, does not start with %s", alarmName, prefix)
+		return nil
+	}
+
 	if len(config.Alarms) > 0 {
 		if !configuration.MatchesAnyPredicate(config.Alarms, alarmName) {
 			ctx.Logger.Infof("Skipping event for alarm %s, does not match any predicate: %v", alarmName, config.Alarms)
@@ -319,7 +460,12 @@ func (p *OnAlarm) OnIntegrationMessage(ctx core.IntegrationMessageContext) error
 		}
 	}
 
-	return ctx.Events.Emit("aws.cloudwatch.alarm", ctx.Message)
+	payload, err := common.EventPayload(ctx.Message)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Events.Emit("aws.cloudwatch.alarm", payload)
 }
 
 func (p *OnAlarm) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
@@ -83,6 +83,126 @@ func Test__OnAlarm__Setup(t *testing.T) {
 		assert.Equal(t, "us-east-1", stored.Region)
 		assert.NotEmpty(t, stored.SubscriptionID)
 	})
+
+	t.Run("filters configured -> subscription pattern includes them", func(t *testing.T) {
+		metadata := &contexts.MetadataContext{}
+		integrationCtx := &contexts.IntegrationContext{
+			Metadata: common.IntegrationMetadata{
+				EventBridge: &common.EventBridgeMetadata{
+					Rules: map[string]common.EventBridgeRuleMetadata{
+						Source: {
+							Source:      Source,
+							DetailTypes: []string{DetailTypeAlarmStateChange},
+						},
+					},
+				},
+			},
+		}
+
+		err := trigger.Setup(core.TriggerContext{
+			Logger:      logrus.NewEntry(logrus.New()),
+			Integration: integrationCtx,
+			Metadata:    metadata,
+			Configuration: OnAlarmConfiguration{
+				Region:              "us-east-1",
+				StateFilter:         []string{AlarmStateAlarm, AlarmStateInsufficientData},
+				PreviousStateFilter: []string{AlarmStateOK},
+				AlarmNamePrefix:     "prod-",
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, integrationCtx.Subscriptions, 1)
+
+		pattern := integrationCtx.Subscriptions[0].Configuration.(*common.EventBridgeEvent)
+		assert.Equal(t, map[string]any{
+			"state":         map[string]any{"value": []any{AlarmStateAlarm, AlarmStateInsufficientData}},
+			"previousState": map[string]any{"value": []any{AlarmStateOK}},
+			"alarmName":     []any{map[string]any{"prefix": "prod-"}},
+		}, pattern.Detail)
+	})
+
+	t.Run("existing subscription -> pattern is updated", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{}
+		subscriptionID, err := integrationCtx.Subscribe(trigger.subscriptionPattern("us-east-1", OnAlarmConfiguration{State: AlarmStateAlarm}))
+		require.NoError(t, err)
+
+		metadata := &contexts.MetadataContext{
+			Metadata: OnAlarmMetadata{Region: "us-east-1", SubscriptionID: subscriptionID.String()},
+		}
+
+		err = trigger.Setup(core.TriggerContext{
+			Logger:      logrus.NewEntry(logrus.New()),
+			Integration: integrationCtx,
+			Metadata:    metadata,
+			Configuration: OnAlarmConfiguration{
+				Region:      "us-east-1",
+				StateFilter: []string{AlarmStateOK},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, integrationCtx.Subscriptions, 1)
+
+		pattern := integrationCtx.Subscriptions[0].Configuration.(*common.EventBridgeEvent)
+		assert.Equal(t, map[string]any{
+			"state": map[string]any{"value": []any{AlarmStateOK}},
+		}, pattern.Detail)
+	})
+
+	t.Run("configuration unchanged since last setup -> no-op", func(t *testing.T) {
+		metadata := &contexts.MetadataContext{}
+		integrationCtx := &contexts.IntegrationContext{
+			Metadata: common.IntegrationMetadata{
+				EventBridge: &common.EventBridgeMetadata{
+					Rules: map[string]common.EventBridgeRuleMetadata{
+						Source: {
+							Source:      Source,
+							DetailTypes: []string{DetailTypeAlarmStateChange},
+						},
+					},
+				},
+			},
+		}
+
+		setup := func(config OnAlarmConfiguration) {
+			err := trigger.Setup(core.TriggerContext{
+				Logger:        logrus.NewEntry(logrus.New()),
+				Integration:   integrationCtx,
+				Metadata:      metadata,
+				Requests:      &contexts.RequestContext{},
+				Configuration: config,
+			})
+
+			require.NoError(t, err)
+		}
+
+		config := OnAlarmConfiguration{Region: "us-east-1", StateFilter: []string{AlarmStateAlarm}}
+		setup(config)
+		require.Len(t, integrationCtx.Subscriptions, 1)
+		stored := metadata.Get().(OnAlarmMetadata)
+		assert.NotEmpty(t, stored.ConfigHash)
+
+		//
+		// Same configuration -> subscription is not touched.
+		//
+		integrationCtx.Subscriptions[0].Configuration = "untouched"
+		setup(config)
+		require.Len(t, integrationCtx.Subscriptions, 1)
+		assert.Equal(t, "untouched", integrationCtx.Subscriptions[0].Configuration)
+		assert.Empty(t, integrationCtx.ActionRequests)
+
+		//
+		// Changed configuration -> subscription is updated.
+		//
+		setup(OnAlarmConfiguration{Region: "us-east-1", StateFilter: []string{AlarmStateOK}})
+		require.Len(t, integrationCtx.Subscriptions, 1)
+		pattern := integrationCtx.Subscriptions[0].Configuration.(*common.EventBridgeEvent)
+		assert.Equal(t, map[string]any{
+			"state": map[string]any{"value": []any{AlarmStateOK}},
+		}, pattern.Detail)
+		assert.NotEqual(t, stored.ConfigHash, metadata.Get().(OnAlarmMetadata).ConfigHash)
+	})
 }
 
 func Test__OnAlarm__HandleAction(t *testing.T) {
@@ -275,4 +395,112 @@ func Test__OnAlarm__OnIntegrationMessage(t *testing.T) {
 		assert.Equal(t, 1, eventContext.Count())
 		assert.Equal(t, "aws.cloudwatch.alarm", eventContext.Payloads[0].Type)
 	})
+
+	transition := func(alarmName, previousState, state string) common.EventBridgeEvent {
+		return common.EventBridgeEvent{
+			Region: "us-east-1",
+			Detail: map[string]any{
+				"alarmName": alarmName,
+				"state": map[string]any{
+					"value":     state,
+					"reason":    "Threshold Crossed",
+					"timestamp": "2024-11-20T20:35:33.000+0000",
+				},
+				"previousState": map[string]any{
+					"value":     previousState,
+					"reason":    "Threshold Crossed",
+					"timestamp": "2024-11-20T20:30:33.000+0000",
+				},
+			},
+		}
+	}
+
+	config := OnAlarmConfiguration{
+		StateFilter:         []string{AlarmStateAlarm, AlarmStateInsufficientData},
+		PreviousStateFilter: []string{AlarmStateOK},
+		AlarmNamePrefix:     "prod-",
+	}
+
+	t.Run("state not in filter -> no event", func(t *testing.T) {
+		eventContext := &contexts.EventContext{}
+		err := trigger.OnIntegrationMessage(core.IntegrationMessageContext{
+			Logger:        logrus.NewEntry(logrus.New()),
+			Events:        eventContext,
+			NodeMetadata:  &contexts.MetadataContext{Metadata: OnAlarmMetadata{Region: "us-east-1"}},
+			Configuration: config,
+			Message:       transition("prod-HighCPU", AlarmStateAlarm, AlarmStateOK),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, eventContext.Count())
+	})
+
+	t.Run("previous state not in filter -> no event", func(t *testing.T) {
+		eventContext := &contexts.EventContext{}
+		err := trigger.OnIntegrationMessage(core.IntegrationMessageContext{
+			Logger:        logrus.NewEntry(logrus.New()),
+			Events:        eventContext,
+			NodeMetadata:  &contexts.MetadataContext{Metadata: OnAlarmMetadata{Region: "us-east-1"}},
+			Configuration: config,
+			Message:       transition("prod-HighCPU", AlarmStateInsufficientData, AlarmStateAlarm),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, eventContext.Count())
+	})
+
+	t.Run("alarm name does not match prefix -> no event", func(t *testing.T) {
+		eventContext := &contexts.EventContext{}
+		err := trigger.OnIntegrationMessage(core.IntegrationMessageContext{
+			Logger:        logrus.NewEntry(logrus.New()),
+			Events:        eventContext,
+			NodeMetadata:  &contexts.MetadataContext{Metadata: OnAlarmMetadata{Region: "us-east-1"}},
+			Configuration: config,
+			Message:       transition("staging-HighCPU", AlarmStateOK, AlarmStateAlarm),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, eventContext.Count())
+	})
+
+	t.Run("matching transition -> emits event with both states", func(t *testing.T) {
+		eventContext := &contexts.EventContext{}
+		message := transition("prod-HighCPU", AlarmStateOK, AlarmStateInsufficientData)
+		err := trigger.OnIntegrationMessage(core.IntegrationMessageContext{
+			Logger:        logrus.NewEntry(logrus.New()),
+			Events:        eventContext,
+			NodeMetadata:  &contexts.MetadataContext{Metadata: OnAlarmMetadata{Region: "us-east-1"}},
+			Configuration: config,
+			Message:       message,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, eventContext.Count())
+
+		payload := eventContext.Payloads[0].Data.(map[string]any)
+		detail := payload["detail"].(map[string]any)
+		assert.Equal(t, "prod-HighCPU", detail["alarmName"])
+		assert.Equal(t, AlarmStateInsufficientData, detail["state"].(map[string]any)["value"])
+		assert.Equal(t, AlarmStateOK, detail["previousState"].(map[string]any)["value"])
+	})
+
+	t.Run("event from another account -> account ID is included", func(t *testing.T) {
+		eventContext := &contexts.EventContext{}
+		message := transition("prod-HighCPU", AlarmStateOK, AlarmStateAlarm)
+		message.Account = "999988887777"
+		err := trigger.OnIntegrationMessage(core.IntegrationMessageContext{
+			Logger:        logrus.NewEntry(logrus.New()),
+			Events:        eventContext,
+			NodeMetadata:  &contexts.MetadataContext{Metadata: OnAlarmMetadata{Region: "us-east-1"}},
+			Configuration: config,
+			Message:       message,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, eventContext.Count())
+
+		payload := eventContext.Payloads[0].Data.(map[string]any)
+		assert.Equal(t, "999988887777", payload["accountId"])
+		assert.Equal(t, "999988887777", payload["account"])
+	})
 }
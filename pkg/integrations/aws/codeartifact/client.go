@@ -105,7 +105,7 @@ type Domain struct {
 }
 
 func (c *Client) ListDomains() ([]Domain, error) {
-	endpoint := fmt.Sprintf("https://codeartifact.%s.amazonaws.com/v1/domains", c.region)
+	endpoint := common.Endpoint("codeartifact", c.region) + "/v1/domains"
 	domains := []Domain{}
 	nextToken := ""
 
@@ -122,7 +122,7 @@ func (c *Client) ListDomains() ([]Domain, error) {
 			return nil, fmt.Errorf("failed to encode list domains request: %w", err)
 		}
 
-		req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
+		req, err := http.NewRequestWithContext(c.http.Context(), http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
 		if err != nil {
 			return nil, fmt.Errorf("failed to build list domains request: %w", err)
 		}
@@ -200,7 +200,7 @@ type UpstreamRepositoryInfo struct {
 }
 
 func (c *Client) ListRepositories(domain string) ([]Repository, error) {
-	endpoint := fmt.Sprintf("https://codeartifact.%s.amazonaws.com/v1/repositories", c.region)
+	endpoint := common.Endpoint("codeartifact", c.region) + "/v1/repositories"
 	repositories := []Repository{}
 	nextToken := ""
 
@@ -222,7 +222,7 @@ func (c *Client) ListRepositories(domain string) ([]Repository, error) {
 			return nil, fmt.Errorf("failed to encode list repositories request: %w", err)
 		}
 
-		req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
+		req, err := http.NewRequestWithContext(c.http.Context(), http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
 		if err != nil {
 			return nil, fmt.Errorf("failed to build list repositories request: %w", err)
 		}
@@ -275,7 +275,7 @@ type CreateRepositoryInput struct {
 
 // CreateRepository creates a repository in the given domain.
 func (c *Client) CreateRepository(input CreateRepositoryInput) (*RepositoryDescription, error) {
-	endpoint := fmt.Sprintf("https://codeartifact.%s.amazonaws.com/v1/repository", c.region)
+	endpoint := common.Endpoint("codeartifact", c.region) + "/v1/repository"
 	payload := map[string]any{}
 	if strings.TrimSpace(input.Description) != "" {
 		payload["description"] = strings.TrimSpace(input.Description)
@@ -285,7 +285,7 @@ func (c *Client) CreateRepository(input CreateRepositoryInput) (*RepositoryDescr
 		return nil, fmt.Errorf("failed to encode create repository request: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
+	req, err := http.NewRequestWithContext(c.http.Context(), http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
 	if err != nil {
 		return nil, fmt.Errorf("failed to build create repository request: %w", err)
 	}
@@ -336,8 +336,8 @@ type DeleteRepositoryInput struct {
 
 // DeleteRepository deletes a repository from the given domain.
 func (c *Client) DeleteRepository(input DeleteRepositoryInput) (*RepositoryDescription, error) {
-	endpoint := fmt.Sprintf("https://codeartifact.%s.amazonaws.com/v1/repository", c.region)
-	req, err := http.NewRequest(http.MethodDelete, endpoint, nil)
+	endpoint := common.Endpoint("codeartifact", c.region) + "/v1/repository"
+	req, err := http.NewRequestWithContext(c.http.Context(), http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build delete repository request: %w", err)
 	}
@@ -379,6 +379,59 @@ func (c *Client) DeleteRepository(input DeleteRepositoryInput) (*RepositoryDescr
 	return &response.Repository, nil
 }
 
+// GetRepositoryEndpointInput is the input for GetRepositoryEndpoint.
+type GetRepositoryEndpointInput struct {
+	Domain     string
+	Repository string
+	Format     string
+}
+
+// GetRepositoryEndpoint returns the endpoint package managers use to connect to a repository, for the given format.
+func (c *Client) GetRepositoryEndpoint(input GetRepositoryEndpointInput) (string, error) {
+	endpoint := common.Endpoint("codeartifact", c.region) + "/v1/repository/endpoint"
+	req, err := http.NewRequestWithContext(c.http.Context(), http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build get repository endpoint request: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("domain", input.Domain)
+	query.Set("repository", input.Repository)
+	query.Set("format", input.Format)
+	req.URL.RawQuery = query.Encode()
+
+	if err := c.signRequest(req, []byte{}); err != nil {
+		return "", err
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("get repository endpoint request failed: %w", err)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return "", fmt.Errorf("failed to read get repository endpoint response: %w", err)
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		if awsErr := common.ParseError(body); awsErr != nil {
+			return "", awsErr
+		}
+		return "", fmt.Errorf("get repository endpoint failed with %d: %s", res.StatusCode, string(body))
+	}
+
+	var response struct {
+		RepositoryEndpoint string `json:"repositoryEndpoint"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", fmt.Errorf("failed to decode get repository endpoint response: %w", err)
+	}
+
+	return response.RepositoryEndpoint, nil
+}
+
 // SuccessfulPackageVersionInfo is returned for each successfully updated or copied package version.
 type SuccessfulPackageVersionInfo struct {
 	Revision string `json:"revision"`
@@ -412,7 +465,7 @@ type UpdatePackageVersionsStatusResponse struct {
 
 // UpdatePackageVersionsStatus updates the status of one or more package versions.
 func (c *Client) UpdatePackageVersionsStatus(input UpdatePackageVersionsStatusInput) (*UpdatePackageVersionsStatusResponse, error) {
-	endpoint := fmt.Sprintf("https://codeartifact.%s.amazonaws.com/v1/package/versions/update_status", c.region)
+	endpoint := common.Endpoint("codeartifact", c.region) + "/v1/package/versions/update_status"
 	payload := map[string]any{"targetStatus": input.TargetStatus}
 	if len(input.VersionRevisions) > 0 {
 		payload["versionRevisions"] = input.VersionRevisions
@@ -427,7 +480,7 @@ func (c *Client) UpdatePackageVersionsStatus(input UpdatePackageVersionsStatusIn
 		return nil, fmt.Errorf("failed to encode update package versions status request: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
+	req, err := http.NewRequestWithContext(c.http.Context(), http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
 	if err != nil {
 		return nil, fmt.Errorf("failed to build update package versions status request: %w", err)
 	}
@@ -494,7 +547,7 @@ type CopyPackageVersionsResponse struct {
 
 // CopyPackageVersions copies package versions from one repository to another in the same domain.
 func (c *Client) CopyPackageVersions(input CopyPackageVersionsInput) (*CopyPackageVersionsResponse, error) {
-	endpoint := fmt.Sprintf("https://codeartifact.%s.amazonaws.com/v1/package/versions/copy", c.region)
+	endpoint := common.Endpoint("codeartifact", c.region) + "/v1/package/versions/copy"
 	payload := map[string]any{
 		"allowOverwrite":      input.AllowOverwrite,
 		"includeFromUpstream": input.IncludeFromUpstream,
@@ -509,7 +562,7 @@ func (c *Client) CopyPackageVersions(input CopyPackageVersionsInput) (*CopyPacka
 		return nil, fmt.Errorf("failed to encode copy package versions request: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
+	req, err := http.NewRequestWithContext(c.http.Context(), http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
 	if err != nil {
 		return nil, fmt.Errorf("failed to build copy package versions request: %w", err)
 	}
@@ -574,7 +627,7 @@ type DeletePackageVersionsResponse struct {
 
 // DeletePackageVersions permanently deletes one or more package versions.
 func (c *Client) DeletePackageVersions(input DeletePackageVersionsInput) (*DeletePackageVersionsResponse, error) {
-	endpoint := fmt.Sprintf("https://codeartifact.%s.amazonaws.com/v1/package/versions/delete", c.region)
+	endpoint := common.Endpoint("codeartifact", c.region) + "/v1/package/versions/delete"
 	payload := map[string]any{"versions": input.Versions}
 	if strings.TrimSpace(input.ExpectedStatus) != "" {
 		payload["expectedStatus"] = strings.TrimSpace(input.ExpectedStatus)
@@ -584,7 +637,7 @@ func (c *Client) DeletePackageVersions(input DeletePackageVersionsInput) (*Delet
 		return nil, fmt.Errorf("failed to encode delete package versions request: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
+	req, err := http.NewRequestWithContext(c.http.Context(), http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
 	if err != nil {
 		return nil, fmt.Errorf("failed to build delete package versions request: %w", err)
 	}
@@ -649,7 +702,7 @@ type DisposePackageVersionsResponse struct {
 
 // DisposePackageVersions deletes assets and sets package version status to Disposed.
 func (c *Client) DisposePackageVersions(input DisposePackageVersionsInput) (*DisposePackageVersionsResponse, error) {
-	endpoint := fmt.Sprintf("https://codeartifact.%s.amazonaws.com/v1/package/versions/dispose", c.region)
+	endpoint := common.Endpoint("codeartifact", c.region) + "/v1/package/versions/dispose"
 	payload := map[string]any{}
 	if len(input.VersionRevisions) > 0 {
 		payload["versionRevisions"] = input.VersionRevisions
@@ -664,7 +717,7 @@ func (c *Client) DisposePackageVersions(input DisposePackageVersionsInput) (*Dis
 		return nil, fmt.Errorf("failed to encode dispose package versions request: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
+	req, err := http.NewRequestWithContext(c.http.Context(), http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
 	if err != nil {
 		return nil, fmt.Errorf("failed to build dispose package versions request: %w", err)
 	}
@@ -710,8 +763,8 @@ func (c *Client) DisposePackageVersions(input DisposePackageVersionsInput) (*Dis
 }
 
 func (c *Client) DescribePackageVersion(input DescribePackageVersionInput) (*PackageVersionDescription, error) {
-	endpoint := fmt.Sprintf("https://codeartifact.%s.amazonaws.com/v1/package/version", c.region)
-	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	endpoint := common.Endpoint("codeartifact", c.region) + "/v1/package/version"
+	req, err := http.NewRequestWithContext(c.http.Context(), http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build describe package version request: %w", err)
 	}
@@ -765,12 +818,12 @@ type ListPackageVersionAssetsResponse struct {
 }
 
 func (c *Client) ListPackageVersionAssets(input ListPackageVersionAssetsInput) ([]PackageVersionAsset, error) {
-	endpoint := fmt.Sprintf("https://codeartifact.%s.amazonaws.com/v1/package/version/assets", c.region)
+	endpoint := common.Endpoint("codeartifact", c.region) + "/v1/package/version/assets"
 	assets := []PackageVersionAsset{}
 	nextToken := ""
 
 	for {
-		req, err := http.NewRequest(http.MethodPost, endpoint, nil)
+		req, err := http.NewRequestWithContext(c.http.Context(), http.MethodPost, endpoint, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to build list package version assets request: %w", err)
 		}
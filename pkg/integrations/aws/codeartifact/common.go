@@ -2,6 +2,7 @@ package codeartifact
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/superplanehq/superplane/pkg/configuration"
@@ -24,6 +25,18 @@ var PackageFormatOptions = []configuration.FieldOption{
 	{Label: "cargo", Value: "cargo"},
 }
 
+func validatePackageFormat(format string) error {
+	supported := slices.ContainsFunc(PackageFormatOptions, func(option configuration.FieldOption) bool {
+		return option.Value == format
+	})
+
+	if !supported {
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+
+	return nil
+}
+
 /*
  * Package version statuses supported by AWS CodeArtifact.
  */
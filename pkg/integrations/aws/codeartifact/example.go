@@ -19,6 +19,9 @@ var exampleOutputCreateRepositoryBytes []byte
 //go:embed example_output_delete_repository.json
 var exampleOutputDeleteRepositoryBytes []byte
 
+//go:embed example_output_get_repository_endpoint.json
+var exampleOutputGetRepositoryEndpointBytes []byte
+
 //go:embed example_output_update_package_versions_status.json
 var exampleOutputUpdatePackageVersionsStatusBytes []byte
 
@@ -43,6 +46,9 @@ var exampleOutputCreateRepository map[string]any
 var exampleOutputDeleteRepositoryOnce sync.Once
 var exampleOutputDeleteRepository map[string]any
 
+var exampleOutputGetRepositoryEndpointOnce sync.Once
+var exampleOutputGetRepositoryEndpoint map[string]any
+
 var exampleOutputUpdatePackageVersionsStatusOnce sync.Once
 var exampleOutputUpdatePackageVersionsStatus map[string]any
 
@@ -83,6 +89,14 @@ func (c *DeleteRepository) ExampleOutput() map[string]any {
 	)
 }
 
+func (c *GetRepositoryEndpoint) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(
+		&exampleOutputGetRepositoryEndpointOnce,
+		exampleOutputGetRepositoryEndpointBytes,
+		&exampleOutputGetRepositoryEndpoint,
+	)
+}
+
 func (c *UpdatePackageVersionsStatus) ExampleOutput() map[string]any {
 	return utils.UnmarshalEmbeddedJSON(
 		&exampleOutputUpdatePackageVersionsStatusOnce,
@@ -0,0 +1,254 @@
+package codeartifact
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
+)
+
+type GetRepositoryEndpoint struct{}
+
+type GetRepositoryEndpointConfiguration struct {
+	Region     string `json:"region" mapstructure:"region"`
+	Domain     string `json:"domain" mapstructure:"domain"`
+	Repository string `json:"repository" mapstructure:"repository"`
+	Format     string `json:"format" mapstructure:"format"`
+}
+
+func (c *GetRepositoryEndpoint) Name() string {
+	return "aws.codeArtifact.getRepositoryEndpoint"
+}
+
+func (c *GetRepositoryEndpoint) Label() string {
+	return "CodeArtifact • Get Repository Endpoint"
+}
+
+func (c *GetRepositoryEndpoint) Description() string {
+	return "Get the endpoint URL of an AWS CodeArtifact repository for a package format"
+}
+
+func (c *GetRepositoryEndpoint) Documentation() string {
+	return `The Get Repository Endpoint component returns the URL package managers use to connect to an AWS CodeArtifact repository.
+
+## Use Cases
+
+- **Package manager configuration**: Configure npm, pip, Maven and other clients to publish to or install from the repository
+- **Build pipelines**: Pass the repository URL to downstream build and publish steps
+
+## Configuration
+
+- **Region**: AWS region of the CodeArtifact domain
+- **Domain**: Domain that contains the repository
+- **Repository**: Repository to get the endpoint for
+- **Format**: Package format of the endpoint, such as npm, pypi or maven
+
+## Output
+
+Emits the domain, repository, format and the returned ` + "`repositoryEndpoint`" + ` URL.
+`
+}
+
+func (c *GetRepositoryEndpoint) Icon() string {
+	return "aws"
+}
+
+func (c *GetRepositoryEndpoint) Color() string {
+	return "gray"
+}
+
+func (c *GetRepositoryEndpoint) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *GetRepositoryEndpoint) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "region",
+			Label:    "Region",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  "us-east-1",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: RegionsForCodeArtifact,
+				},
+			},
+		},
+		{
+			Name:     "domain",
+			Label:    "Domain",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{
+					Field:  "region",
+					Values: []string{"*"},
+				},
+			},
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "codeartifact.domain",
+					UseNameAsValue: true,
+					Parameters: []configuration.ParameterRef{
+						{
+							Name: "region",
+							ValueFrom: &configuration.ParameterValueFrom{
+								Field: "region",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{
+					Field:  "region",
+					Values: []string{"*"},
+				},
+				{
+					Field:  "domain",
+					Values: []string{"*"},
+				},
+			},
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "codeartifact.repository",
+					UseNameAsValue: true,
+					Parameters: []configuration.ParameterRef{
+						{
+							Name: "region",
+							ValueFrom: &configuration.ParameterValueFrom{
+								Field: "region",
+							},
+						},
+						{
+							Name: "domain",
+							ValueFrom: &configuration.ParameterValueFrom{
+								Field: "domain",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:     "format",
+			Label:    "Package format",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			Default:  "npm",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{Options: PackageFormatOptions},
+			},
+		},
+	}
+}
+
+func (c *GetRepositoryEndpoint) Setup(ctx core.SetupContext) error {
+	var config GetRepositoryEndpointConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	return c.validateConfig(c.normalizeConfig(config))
+}
+
+func (c *GetRepositoryEndpoint) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *GetRepositoryEndpoint) Execute(ctx core.ExecutionContext) error {
+	var config GetRepositoryEndpointConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	config = c.normalizeConfig(config)
+	if err := c.validateConfig(config); err != nil {
+		return err
+	}
+
+	creds, err := common.CredentialsFromInstallation(ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("failed to get AWS credentials: %w", err)
+	}
+
+	client := NewClient(ctx.HTTP, creds, config.Region)
+	endpoint, err := client.GetRepositoryEndpoint(GetRepositoryEndpointInput{
+		Domain:     config.Domain,
+		Repository: config.Repository,
+		Format:     config.Format,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get repository endpoint: %w", err)
+	}
+
+	output := map[string]any{
+		"domain":             config.Domain,
+		"repository":         config.Repository,
+		"format":             config.Format,
+		"repositoryEndpoint": endpoint,
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"aws.codeartifact.repository.endpoint",
+		[]any{output},
+	)
+}
+
+func (c *GetRepositoryEndpoint) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *GetRepositoryEndpoint) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *GetRepositoryEndpoint) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (c *GetRepositoryEndpoint) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *GetRepositoryEndpoint) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
+
+func (c *GetRepositoryEndpoint) validateConfig(config GetRepositoryEndpointConfiguration) error {
+	if config.Region == "" {
+		return fmt.Errorf("region is required")
+	}
+	if config.Domain == "" {
+		return fmt.Errorf("domain is required")
+	}
+	if config.Repository == "" {
+		return fmt.Errorf("repository is required")
+	}
+	if config.Format == "" {
+		return fmt.Errorf("format is required")
+	}
+
+	return validatePackageFormat(config.Format)
+}
+
+func (c *GetRepositoryEndpoint) normalizeConfig(config GetRepositoryEndpointConfiguration) GetRepositoryEndpointConfiguration {
+	config.Region = strings.TrimSpace(config.Region)
+	config.Domain = strings.TrimSpace(config.Domain)
+	config.Repository = strings.TrimSpace(config.Repository)
+	config.Format = strings.ToLower(strings.TrimSpace(config.Format))
+	return config
+}
@@ -0,0 +1,149 @@
+package codeartifact
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func TestGetRepositoryEndpoint_Setup(t *testing.T) {
+	component := &GetRepositoryEndpoint{}
+
+	t.Run("invalid configuration -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: "invalid",
+		})
+
+		require.ErrorContains(t, err, "failed to decode configuration")
+	})
+
+	t.Run("missing repository -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"region": "us-east-1", "domain": "my-domain", "format": "npm"},
+		})
+
+		require.ErrorContains(t, err, "repository is required")
+	})
+
+	t.Run("missing format -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"region": "us-east-1", "domain": "my-domain", "repository": "my-repo"},
+		})
+
+		require.ErrorContains(t, err, "format is required")
+	})
+
+	t.Run("unsupported format -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{},
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: map[string]any{
+				"region":     "us-east-1",
+				"domain":     "my-domain",
+				"repository": "my-repo",
+				"format":     "rubygems",
+			},
+		})
+
+		require.ErrorContains(t, err, "unsupported format: rubygems")
+	})
+
+	t.Run("valid configuration -> success", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{},
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: map[string]any{
+				"region":     "us-east-1",
+				"domain":     "my-domain",
+				"repository": "my-repo",
+				"format":     "PyPI",
+			},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func TestGetRepositoryEndpoint_Execute(t *testing.T) {
+	component := &GetRepositoryEndpoint{}
+	integration := &contexts.IntegrationContext{
+		Secrets: map[string]core.IntegrationSecret{
+			"accessKeyId":     {Name: "accessKeyId", Value: []byte("key")},
+			"secretAccessKey": {Name: "secretAccessKey", Value: []byte("secret")},
+			"sessionToken":    {Name: "sessionToken", Value: []byte("token")},
+		},
+	}
+
+	t.Run("unsupported format -> error before request", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{}
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"region":     "us-east-1",
+				"domain":     "my-domain",
+				"repository": "my-repo",
+				"format":     "rubygems",
+			},
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			HTTP:           httpContext,
+			Integration:    integration,
+		})
+
+		require.ErrorContains(t, err, "unsupported format: rubygems")
+		assert.Empty(t, httpContext.Requests)
+	})
+
+	t.Run("success -> emits repository endpoint", func(t *testing.T) {
+		endpoint := "https://my-domain-123456789012.d.codeartifact.us-east-1.amazonaws.com/npm/my-repo/"
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"repositoryEndpoint": "` + endpoint + `"}`)),
+				},
+			},
+		}
+
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"region":     "us-east-1",
+				"domain":     "my-domain",
+				"repository": "my-repo",
+				"format":     "npm",
+			},
+			ExecutionState: execState,
+			HTTP:           httpContext,
+			Integration:    integration,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, httpContext.Requests, 1)
+		request := httpContext.Requests[0]
+		assert.Equal(t, http.MethodGet, request.Method)
+		assert.Equal(t, "/v1/repository/endpoint", request.URL.Path)
+		assert.Equal(t, "my-domain", request.URL.Query().Get("domain"))
+		assert.Equal(t, "my-repo", request.URL.Query().Get("repository"))
+		assert.Equal(t, "npm", request.URL.Query().Get("format"))
+
+		require.True(t, execState.Passed)
+		require.Equal(t, "aws.codeartifact.repository.endpoint", execState.Type)
+		require.Len(t, execState.Payloads, 1)
+		payload := execState.Payloads[0].(map[string]any)
+		data, ok := payload["data"].(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, endpoint, data["repositoryEndpoint"])
+		assert.Equal(t, "npm", data["format"])
+	})
+}
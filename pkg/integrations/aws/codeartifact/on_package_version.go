@@ -3,9 +3,12 @@ package codeartifact
 import (
 	"fmt"
 	"net/http"
+	"path"
 	"slices"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
@@ -19,17 +22,49 @@ const (
 
 type OnPackageVersion struct{}
 
+const (
+	OperationTypeCreated = "Created"
+	OperationTypeDeleted = "Deleted"
+
+	PackageVersionStatusPublished = "Published"
+	PackageVersionStatusDeleted   = "Deleted"
+)
+
+/*
+ * Statuses the trigger can filter on.
+ * Deleted is not a package version status, but matches deletion events.
+ */
+var TriggerStatusOptions = append(
+	slices.Clone(PackageVersionStatusOptions),
+	configuration.FieldOption{Value: PackageVersionStatusDeleted, Label: PackageVersionStatusDeleted},
+)
+
 type OnPackageVersionConfiguration struct {
-	Region     string                    `json:"region" mapstructure:"region"`
-	Repository string                    `json:"repository" mapstructure:"repository"`
-	Packages   []configuration.Predicate `json:"packages" mapstructure:"packages"`
-	Versions   []configuration.Predicate `json:"versions" mapstructure:"versions"`
+	Region             string                    `json:"region" mapstructure:"region"`
+	Repository         string                    `json:"repository" mapstructure:"repository"`
+	PackageNamePattern string                    `json:"packageNamePattern" mapstructure:"packageNamePattern"`
+	PackageFormats     []string                  `json:"packageFormats" mapstructure:"packageFormats"`
+	Statuses           []string                  `json:"statuses" mapstructure:"statuses"`
+	Packages           []configuration.Predicate `json:"packages" mapstructure:"packages"`
+	Versions           []configuration.Predicate `json:"versions" mapstructure:"versions"`
+}
+
+type PackageVersionEvent struct {
+	Domain     string `json:"domain" mapstructure:"domain"`
+	Repository string `json:"repository" mapstructure:"repository"`
+	Format     string `json:"format" mapstructure:"format"`
+	Namespace  string `json:"namespace,omitempty" mapstructure:"namespace"`
+	Name       string `json:"name" mapstructure:"name"`
+	Version    string `json:"version" mapstructure:"version"`
+	Status     string `json:"status" mapstructure:"status"`
+	Operation  string `json:"operation" mapstructure:"operation"`
 }
 
 type OnPackageVersionMetadata struct {
 	Region         string      `json:"region" mapstructure:"region"`
 	SubscriptionID string      `json:"subscriptionId" mapstructure:"subscriptionId"`
 	Repository     *Repository `json:"repository" mapstructure:"repository"`
+	ConfigHash     string      `json:"configHash" mapstructure:"configHash"`
 }
 
 func (p *OnPackageVersion) Name() string {
@@ -52,6 +87,26 @@ func (p *OnPackageVersion) Documentation() string {
 - **Release automation**: Trigger downstream workflows when a new package version is published
 - **Dependency monitoring**: Notify teams about changes to shared libraries
 - **Compliance checks**: Validate artifacts before promotion
+
+## Configuration
+
+- **Region**: AWS region of the CodeArtifact repository
+- **Repository**: Only trigger for package versions in this repository
+- **Package Name Pattern**: Optional glob matched against the package name (e.g. ` + "`my-service-*`" + ` or ` + "`@scope/*`" + `)
+- **Package Formats**: Optional list of package formats (npm, pypi, maven, ...)
+- **Statuses**: Only trigger for package versions with these statuses. Select Deleted to trigger when package versions are deleted.
+- **Packages** / **Versions**: Optional predicates on the full package name and version
+
+Events that do not match the filters are dropped.
+
+## Event Data
+
+Each event includes the original CodeArtifact event, and a ` + "`package`" + ` object with:
+- **package.domain** and **package.repository**
+- **package.format**, **package.namespace**, and **package.name**
+- **package.version**
+- **package.status**: package version status, or Deleted for deletion events
+- **package.operation**: Created, Updated, or Deleted
 `
 }
 
@@ -83,6 +138,41 @@ func (p *OnPackageVersion) Configuration() []configuration.Field {
 				},
 			},
 		},
+		{
+			Name:        "packageNamePattern",
+			Label:       "Package Name Pattern",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Togglable:   true,
+			Placeholder: "my-service-*",
+			Description: "Glob matched against the package name",
+		},
+		{
+			Name:        "packageFormats",
+			Label:       "Package Formats",
+			Type:        configuration.FieldTypeMultiSelect,
+			Required:    false,
+			Togglable:   true,
+			Description: "Only trigger for these package formats",
+			TypeOptions: &configuration.TypeOptions{
+				MultiSelect: &configuration.MultiSelectTypeOptions{
+					Options: PackageFormatOptions,
+				},
+			},
+		},
+		{
+			Name:        "statuses",
+			Label:       "Statuses",
+			Type:        configuration.FieldTypeMultiSelect,
+			Required:    false,
+			Default:     []string{PackageVersionStatusPublished},
+			Description: "Only trigger for package versions with these statuses",
+			TypeOptions: &configuration.TypeOptions{
+				MultiSelect: &configuration.MultiSelectTypeOptions{
+					Options: TriggerStatusOptions,
+				},
+			},
+		},
 		{
 			Name:     "packages",
 			Label:    "Packages",
@@ -117,6 +207,7 @@ func (p *OnPackageVersion) Configuration() []configuration.Field {
 				},
 			},
 		},
+		core.DeduplicationField(),
 	}
 }
 
@@ -131,13 +222,46 @@ func (p *OnPackageVersion) Setup(ctx core.TriggerContext) error {
 		return fmt.Errorf("failed to decode configuration: %w", err)
 	}
 
+	if config.PackageNamePattern != "" {
+		if _, err := path.Match(config.PackageNamePattern, ""); err != nil {
+			return fmt.Errorf("invalid package name pattern: %w", err)
+		}
+	}
+
+	configHash, err := common.ConfigHash(config)
+	if err != nil {
+		return err
+	}
+
 	//
-	// If already subscribed to the integration events, nothing to do.
+	// Nothing changed since the last successful setup.
 	//
-	if metadata.SubscriptionID != "" {
+	if metadata.SubscriptionID != "" && metadata.ConfigHash == configHash {
 		return nil
 	}
 
+	//
+	// If already subscribed to the integration events,
+	// keep the subscription pattern in sync with the filters.
+	//
+	if metadata.SubscriptionID != "" {
+		if metadata.Repository == nil || !repositoryMatches(metadata.Repository, config.Repository) {
+			repository, err := validateRepository(ctx.Integration, ctx.HTTP, metadata.Region, config.Repository)
+			if err != nil {
+				return fmt.Errorf("failed to validate repository: %w", err)
+			}
+
+			metadata.Repository = repository
+		}
+
+		if err := p.updateSubscription(ctx.Integration, metadata, config); err != nil {
+			return err
+		}
+
+		metadata.ConfigHash = configHash
+		return ctx.Metadata.Set(metadata)
+	}
+
 	integrationMetadata := common.IntegrationMetadata{}
 	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &integrationMetadata); err != nil {
 		return fmt.Errorf("failed to decode integration metadata: %w", err)
@@ -161,7 +285,7 @@ func (p *OnPackageVersion) Setup(ctx core.TriggerContext) error {
 		return p.provisionRule(ctx.Integration, ctx.Requests, config.Region)
 	}
 
-	subscriptionID, err := ctx.Integration.Subscribe(p.subscriptionPattern(config.Region))
+	subscriptionID, err := ctx.Integration.Subscribe(p.subscriptionPattern(config.Region, repository, config))
 	if err != nil {
 		return fmt.Errorf("failed to subscribe: %w", err)
 	}
@@ -170,6 +294,7 @@ func (p *OnPackageVersion) Setup(ctx core.TriggerContext) error {
 		Region:         config.Region,
 		Repository:     repository,
 		SubscriptionID: subscriptionID.String(),
+		ConfigHash:     configHash,
 	})
 }
 
@@ -194,15 +319,64 @@ func (p *OnPackageVersion) provisionRule(integration core.IntegrationContext, re
 	)
 }
 
-func (p *OnPackageVersion) subscriptionPattern(region string) *common.EventBridgeEvent {
+func (p *OnPackageVersion) updateSubscription(integration core.IntegrationContext, metadata OnPackageVersionMetadata, config OnPackageVersionConfiguration) error {
+	subscriptionID, err := uuid.Parse(metadata.SubscriptionID)
+	if err != nil {
+		return fmt.Errorf("invalid subscription ID %s: %w", metadata.SubscriptionID, err)
+	}
+
+	err = integration.UpdateSubscription(subscriptionID, p.subscriptionPattern(metadata.Region, metadata.Repository, config))
+	if err != nil {
+		return fmt.Errorf("failed to update subscription: %w", err)
+	}
+
+	return nil
+}
+
+/*
+ * The subscription pattern includes the filters EventBridge patterns can express.
+ * Everything else is checked in OnIntegrationMessage().
+ */
+func (p *OnPackageVersion) subscriptionPattern(region string, repository *Repository, config OnPackageVersionConfiguration) *common.EventBridgeEvent {
+	detail := map[string]any{}
+
+	//
+	// Nodes created before the status filter was introduced
+	// only received events for newly published versions.
+	//
+	if len(config.Statuses) == 0 {
+		detail["operationType"] = OperationTypeCreated
+		detail["packageVersionState"] = PackageVersionStatusPublished
+	}
+
+	//
+	// Deletion events are matched on the operation type,
+	// which can't be combined with the status list in the pattern.
+	//
+	if len(config.Statuses) > 0 && !slices.Contains(config.Statuses, PackageVersionStatusDeleted) {
+		detail["packageVersionState"] = stringsToAny(config.Statuses)
+	}
+
+	if repository != nil {
+		detail["repositoryName"] = repository.Name
+		if repository.DomainName != "" {
+			detail["domainName"] = repository.DomainName
+		}
+	}
+
+	if len(config.PackageFormats) > 0 {
+		detail["packageFormat"] = stringsToAny(config.PackageFormats)
+	}
+
+	if prefix := globPrefix(config.PackageNamePattern); prefix != "" {
+		detail["packageName"] = []any{map[string]any{"prefix": prefix}}
+	}
+
 	return &common.EventBridgeEvent{
 		Region:     region,
 		DetailType: DetailTypePackageVersionStateChange,
 		Source:     Source,
-		Detail: map[string]any{
-			"operationType":       "Created",
-			"packageVersionState": "Published",
-		},
+		Detail:     detail,
 	}
 }
 
@@ -255,12 +429,23 @@ func (p *OnPackageVersion) checkRuleAvailability(ctx core.TriggerActionContext)
 		)
 	}
 
-	subscriptionID, err := ctx.Integration.Subscribe(p.subscriptionPattern(metadata.Region))
+	config := OnPackageVersionConfiguration{}
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	configHash, err := common.ConfigHash(config)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionID, err := ctx.Integration.Subscribe(p.subscriptionPattern(metadata.Region, metadata.Repository, config))
 	if err != nil {
 		return nil, fmt.Errorf("failed to subscribe: %w", err)
 	}
 
 	metadata.SubscriptionID = subscriptionID.String()
+	metadata.ConfigHash = configHash
 	return nil, ctx.Metadata.Set(metadata)
 }
 
@@ -270,6 +455,11 @@ func (p *OnPackageVersion) OnIntegrationMessage(ctx core.IntegrationMessageConte
 		return fmt.Errorf("failed to decode configuration: %w", err)
 	}
 
+	metadata := OnPackageVersionMetadata{}
+	if err := mapstructure.Decode(ctx.NodeMetadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
 	event := common.EventBridgeEvent{}
 	if err := mapstructure.Decode(ctx.Message, &event); err != nil {
 		return fmt.Errorf("failed to decode message: %w", err)
@@ -280,7 +470,35 @@ func (p *OnPackageVersion) OnIntegrationMessage(ctx core.IntegrationMessageConte
 		return fmt.Errorf("failed to get full package name: %w", err)
 	}
 
-	if !configuration.MatchesAnyPredicate(config.Packages, fullPackageName) {
+	packageVersion := packageVersionFromDetail(event.Detail)
+	if metadata.Repository != nil && packageVersion.Repository != metadata.Repository.Name {
+		ctx.Logger.Infof("Skipping event for repository %s, expected %s", packageVersion.Repository, metadata.Repository.Name)
+		return nil
+	}
+
+	if len(config.Statuses) > 0 && !slices.Contains(config.Statuses, packageVersion.Status) {
+		ctx.Logger.Infof("Skipping event for package %s with status %s", fullPackageName, packageVersion.Status)
+		return nil
+	}
+
+	if len(config.PackageFormats) > 0 && !slices.Contains(config.PackageFormats, packageVersion.Format) {
+		ctx.Logger.Infof("Skipping event for package %s with format %s", fullPackageName, packageVersion.Format)
+		return nil
+	}
+
+	if config.PackageNamePattern != "" {
+		matched, err := path.Match(config.PackageNamePattern, packageVersion.Name)
+		if err != nil {
+			return fmt.Errorf("invalid package name pattern: %w", err)
+		}
+
+		if !matched {
+			ctx.Logger.Infof("Skipping event for package %s, does not match %s", packageVersion.Name, config.PackageNamePattern)
+			return nil
+		}
+	}
+
+	if len(config.Packages) > 0 && !configuration.MatchesAnyPredicate(config.Packages, fullPackageName) {
 		ctx.Logger.Infof("Skipping event for package %s, does not match any predicate: %v", fullPackageName, config.Packages)
 		return nil
 	}
@@ -290,12 +508,80 @@ func (p *OnPackageVersion) OnIntegrationMessage(ctx core.IntegrationMessageConte
 		return fmt.Errorf("missing package version")
 	}
 
-	if !configuration.MatchesAnyPredicate(config.Versions, version) {
+	if len(config.Versions) > 0 && !configuration.MatchesAnyPredicate(config.Versions, version) {
 		ctx.Logger.Infof("Skipping event for version %s, does not match any predicate: %v", version, config.Versions)
 		return nil
 	}
 
-	return ctx.Events.Emit("aws.codeartifact.package.version", ctx.Message)
+	payload, err := payloadWithPackageVersion(ctx.Message, packageVersion)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Events.Emit("aws.codeartifact.package.version", payload)
+}
+
+func packageVersionFromDetail(detail map[string]any) PackageVersionEvent {
+	stringValue := func(key string) string {
+		value, _ := detail[key].(string)
+		return value
+	}
+
+	packageVersion := PackageVersionEvent{
+		Domain:     stringValue("domainName"),
+		Repository: stringValue("repositoryName"),
+		Format:     stringValue("packageFormat"),
+		Namespace:  stringValue("packageNamespace"),
+		Name:       stringValue("packageName"),
+		Version:    stringValue("packageVersion"),
+		Status:     stringValue("packageVersionState"),
+		Operation:  stringValue("operationType"),
+	}
+
+	if packageVersion.Operation == OperationTypeDeleted {
+		packageVersion.Status = PackageVersionStatusDeleted
+	}
+
+	return packageVersion
+}
+
+/*
+ * The original event is emitted as is,
+ * with the parsed package version added under "package".
+ */
+func payloadWithPackageVersion(message any, packageVersion PackageVersionEvent) (map[string]any, error) {
+	payload, err := common.EventPayload(message)
+	if err != nil {
+		return nil, err
+	}
+
+	payload["package"] = packageVersion
+	return payload, nil
+}
+
+/*
+ * Returns the literal part of a glob pattern, before the first wildcard.
+ */
+func globPrefix(pattern string) string {
+	index := strings.IndexAny(pattern, `*?[\`)
+	if index == -1 {
+		return pattern
+	}
+
+	return pattern[:index]
+}
+
+func repositoryMatches(repository *Repository, value string) bool {
+	return repository.Name == value || repository.Arn == value
+}
+
+func stringsToAny(values []string) []any {
+	result := make([]any, 0, len(values))
+	for _, value := range values {
+		result = append(result, value)
+	}
+
+	return result
 }
 
 func (p *OnPackageVersion) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
@@ -0,0 +1,307 @@
+package codeartifact
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func TestOnPackageVersion_Setup(t *testing.T) {
+	trigger := &OnPackageVersion{}
+
+	newIntegration := func(t *testing.T) *contexts.IntegrationContext {
+		integrationCtx := &contexts.IntegrationContext{
+			Secrets: map[string]core.IntegrationSecret{},
+			Metadata: common.IntegrationMetadata{
+				EventBridge: &common.EventBridgeMetadata{
+					Rules: map[string]common.EventBridgeRuleMetadata{
+						Source: {
+							Source:      Source,
+							DetailTypes: []string{DetailTypePackageVersionStateChange},
+						},
+					},
+				},
+			},
+		}
+
+		_, err := common.StoreCredentials(integrationCtx, "key", "secret", "token", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		return integrationCtx
+	}
+
+	listRepositoriesResponse := func() *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`{
+				"repositories": [
+					{"name": "my-repo", "arn": "arn:aws:codeartifact:us-east-1:123456789012:repository/my-domain/my-repo", "domainName": "my-domain"}
+				]
+			}`)),
+		}
+	}
+
+	t.Run("invalid package name pattern -> error", func(t *testing.T) {
+		err := trigger.Setup(core.TriggerContext{
+			Logger:      logrus.NewEntry(logrus.New()),
+			Integration: newIntegration(t),
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: OnPackageVersionConfiguration{
+				Region:             "us-east-1",
+				Repository:         "my-repo",
+				PackageNamePattern: "my-service-[",
+			},
+		})
+
+		require.ErrorContains(t, err, "invalid package name pattern")
+	})
+
+	t.Run("filters configured -> subscription pattern includes them", func(t *testing.T) {
+		integrationCtx := newIntegration(t)
+		metadata := &contexts.MetadataContext{}
+		err := trigger.Setup(core.TriggerContext{
+			Logger:      logrus.NewEntry(logrus.New()),
+			Integration: integrationCtx,
+			Metadata:    metadata,
+			HTTP:        &contexts.HTTPContext{Responses: []*http.Response{listRepositoriesResponse()}},
+			Configuration: OnPackageVersionConfiguration{
+				Region:             "us-east-1",
+				Repository:         "my-repo",
+				PackageNamePattern: "my-service-*",
+				PackageFormats:     []string{"npm"},
+				Statuses:           []string{"Published", "Unlisted"},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, integrationCtx.Subscriptions, 1)
+
+		pattern := integrationCtx.Subscriptions[0].Configuration.(*common.EventBridgeEvent)
+		assert.Equal(t, map[string]any{
+			"repositoryName":      "my-repo",
+			"domainName":          "my-domain",
+			"packageFormat":       []any{"npm"},
+			"packageVersionState": []any{"Published", "Unlisted"},
+			"packageName":         []any{map[string]any{"prefix": "my-service-"}},
+		}, pattern.Detail)
+
+		stored := metadata.Get().(OnPackageVersionMetadata)
+		assert.NotEmpty(t, stored.SubscriptionID)
+	})
+
+	t.Run("deleted status -> status is only checked on messages", func(t *testing.T) {
+		integrationCtx := newIntegration(t)
+		err := trigger.Setup(core.TriggerContext{
+			Logger:      logrus.NewEntry(logrus.New()),
+			Integration: integrationCtx,
+			Metadata:    &contexts.MetadataContext{},
+			HTTP:        &contexts.HTTPContext{Responses: []*http.Response{listRepositoriesResponse()}},
+			Configuration: OnPackageVersionConfiguration{
+				Region:     "us-east-1",
+				Repository: "my-repo",
+				Statuses:   []string{"Published", "Deleted"},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, integrationCtx.Subscriptions, 1)
+
+		pattern := integrationCtx.Subscriptions[0].Configuration.(*common.EventBridgeEvent)
+		assert.NotContains(t, pattern.Detail, "packageVersionState")
+		assert.NotContains(t, pattern.Detail, "operationType")
+	})
+
+	t.Run("existing subscription -> pattern is updated", func(t *testing.T) {
+		integrationCtx := newIntegration(t)
+		repository := &Repository{Name: "my-repo", DomainName: "my-domain"}
+		subscriptionID, err := integrationCtx.Subscribe(trigger.subscriptionPattern("us-east-1", repository, OnPackageVersionConfiguration{}))
+		require.NoError(t, err)
+
+		err = trigger.Setup(core.TriggerContext{
+			Logger:      logrus.NewEntry(logrus.New()),
+			Integration: integrationCtx,
+			Metadata: &contexts.MetadataContext{
+				Metadata: OnPackageVersionMetadata{
+					Region:         "us-east-1",
+					Repository:     repository,
+					SubscriptionID: subscriptionID.String(),
+				},
+			},
+			Configuration: OnPackageVersionConfiguration{
+				Region:     "us-east-1",
+				Repository: "my-repo",
+				Statuses:   []string{"Unfinished"},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, integrationCtx.Subscriptions, 1)
+
+		pattern := integrationCtx.Subscriptions[0].Configuration.(*common.EventBridgeEvent)
+		assert.Equal(t, []any{"Unfinished"}, pattern.Detail["packageVersionState"])
+	})
+
+	t.Run("configuration unchanged since last setup -> no-op", func(t *testing.T) {
+		integrationCtx := newIntegration(t)
+		metadata := &contexts.MetadataContext{}
+		httpContext := &contexts.HTTPContext{Responses: []*http.Response{listRepositoriesResponse()}}
+		setup := func(config OnPackageVersionConfiguration) {
+			err := trigger.Setup(core.TriggerContext{
+				Logger:        logrus.NewEntry(logrus.New()),
+				Integration:   integrationCtx,
+				Metadata:      metadata,
+				Requests:      &contexts.RequestContext{},
+				HTTP:          httpContext,
+				Configuration: config,
+			})
+
+			require.NoError(t, err)
+		}
+
+		config := OnPackageVersionConfiguration{
+			Region:     "us-east-1",
+			Repository: "my-repo",
+			Statuses:   []string{"Published"},
+		}
+
+		setup(config)
+		require.Len(t, integrationCtx.Subscriptions, 1)
+		require.Len(t, httpContext.Requests, 1)
+		assert.NotEmpty(t, metadata.Get().(OnPackageVersionMetadata).ConfigHash)
+
+		//
+		// Same configuration -> no AWS calls, and subscription is not touched.
+		//
+		integrationCtx.Subscriptions[0].Configuration = "untouched"
+		setup(config)
+		require.Len(t, integrationCtx.Subscriptions, 1)
+		assert.Equal(t, "untouched", integrationCtx.Subscriptions[0].Configuration)
+		assert.Len(t, httpContext.Requests, 1)
+
+		//
+		// Changed configuration -> subscription is updated.
+		//
+		config.Statuses = []string{"Unlisted"}
+		setup(config)
+		require.Len(t, integrationCtx.Subscriptions, 1)
+		pattern := integrationCtx.Subscriptions[0].Configuration.(*common.EventBridgeEvent)
+		assert.Equal(t, []any{"Unlisted"}, pattern.Detail["packageVersionState"])
+		assert.Len(t, httpContext.Requests, 1)
+	})
+}
+
+func TestOnPackageVersion_OnIntegrationMessage(t *testing.T) {
+	trigger := &OnPackageVersion{}
+
+	metadata := &contexts.MetadataContext{
+		Metadata: OnPackageVersionMetadata{
+			Region:     "us-east-1",
+			Repository: &Repository{Name: "my-repo", DomainName: "my-domain"},
+		},
+	}
+
+	config := OnPackageVersionConfiguration{
+		PackageNamePattern: "my-service-*",
+		PackageFormats:     []string{"npm"},
+		Statuses:           []string{"Published", "Deleted"},
+	}
+
+	message := func(repository, name, format, state, operation string) common.EventBridgeEvent {
+		return common.EventBridgeEvent{
+			Region:     "us-east-1",
+			Source:     Source,
+			DetailType: DetailTypePackageVersionStateChange,
+			Detail: map[string]any{
+				"domainName":          "my-domain",
+				"repositoryName":      repository,
+				"packageFormat":       format,
+				"packageName":         name,
+				"packageVersion":      "1.2.3",
+				"packageVersionState": state,
+				"operationType":       operation,
+			},
+		}
+	}
+
+	run := func(t *testing.T, msg common.EventBridgeEvent) *contexts.EventContext {
+		eventContext := &contexts.EventContext{}
+		err := trigger.OnIntegrationMessage(core.IntegrationMessageContext{
+			Logger:        logrus.NewEntry(logrus.New()),
+			Events:        eventContext,
+			NodeMetadata:  metadata,
+			Configuration: config,
+			Message:       msg,
+		})
+
+		require.NoError(t, err)
+		return eventContext
+	}
+
+	t.Run("other repository -> no event", func(t *testing.T) {
+		events := run(t, message("other-repo", "my-service-api", "npm", "Published", "Created"))
+		assert.Equal(t, 0, events.Count())
+	})
+
+	t.Run("package name does not match pattern -> no event", func(t *testing.T) {
+		events := run(t, message("my-repo", "other-service", "npm", "Published", "Created"))
+		assert.Equal(t, 0, events.Count())
+	})
+
+	t.Run("format not in filter -> no event", func(t *testing.T) {
+		events := run(t, message("my-repo", "my-service-api", "pypi", "Published", "Created"))
+		assert.Equal(t, 0, events.Count())
+	})
+
+	t.Run("status not in filter -> no event", func(t *testing.T) {
+		events := run(t, message("my-repo", "my-service-api", "npm", "Unfinished", "Created"))
+		assert.Equal(t, 0, events.Count())
+	})
+
+	t.Run("deleted version -> matches Deleted status", func(t *testing.T) {
+		events := run(t, message("my-repo", "my-service-api", "npm", "Published", "Deleted"))
+		require.Equal(t, 1, events.Count())
+
+		payload := events.Payloads[0].Data.(map[string]any)
+		packageVersion := payload["package"].(PackageVersionEvent)
+		assert.Equal(t, "Deleted", packageVersion.Status)
+		assert.Equal(t, "Deleted", packageVersion.Operation)
+	})
+
+	t.Run("matching version -> emits event with package details", func(t *testing.T) {
+		events := run(t, message("my-repo", "my-service-api", "npm", "Published", "Created"))
+		require.Equal(t, 1, events.Count())
+		assert.Equal(t, "aws.codeartifact.package.version", events.Payloads[0].Type)
+
+		payload := events.Payloads[0].Data.(map[string]any)
+		assert.Equal(t, "us-east-1", payload["region"])
+		assert.Equal(t, "my-service-api", payload["detail"].(map[string]any)["packageName"])
+		assert.Equal(t, PackageVersionEvent{
+			Domain:     "my-domain",
+			Repository: "my-repo",
+			Format:     "npm",
+			Name:       "my-service-api",
+			Version:    "1.2.3",
+			Status:     "Published",
+			Operation:  "Created",
+		}, payload["package"])
+	})
+
+	t.Run("event from another account -> account ID is included", func(t *testing.T) {
+		msg := message("my-repo", "my-service-api", "npm", "Published", "Created")
+		msg.Account = "999988887777"
+
+		events := run(t, msg)
+		require.Equal(t, 1, events.Count())
+
+		payload := events.Payloads[0].Data.(map[string]any)
+		assert.Equal(t, "999988887777", payload["accountId"])
+	})
+}
@@ -1,20 +1,16 @@
 package common
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 )
 
-const (
-	accessKeyIDSecret     = "accessKeyId"
-	secretAccessKeySecret = "secretAccessKey"
-	sessionTokenSecret    = "sessionToken"
-)
-
 var AllRegions = []configuration.FieldOption{
 	{
 		Label: "us-east-1",
@@ -92,6 +88,14 @@ var AllRegions = []configuration.FieldOption{
 		Label: "sa-east-1",
 		Value: "sa-east-1",
 	},
+	{
+		Label: "us-gov-east-1",
+		Value: "us-gov-east-1",
+	},
+	{
+		Label: "us-gov-west-1",
+		Value: "us-gov-west-1",
+	},
 }
 
 type IntegrationMetadata struct {
@@ -99,6 +103,13 @@ type IntegrationMetadata struct {
 	IAM         *IAMMetadata         `json:"iam" mapstructure:"iam"`
 	EventBridge *EventBridgeMetadata `json:"eventBridge" mapstructure:"eventBridge"`
 	Tags        []Tag                `json:"tags" mapstructure:"tags"`
+	HealthCheck *HealthCheckMetadata `json:"healthCheck" mapstructure:"healthCheck"`
+
+	/*
+	 * Service name -> base URL, used instead of the public
+	 * regional endpoint, e.g. for FIPS or VPC endpoints.
+	 */
+	EndpointOverrides map[string]string `json:"endpointOverrides,omitempty" mapstructure:"endpointOverrides"`
 }
 
 type SessionMetadata struct {
@@ -106,6 +117,12 @@ type SessionMetadata struct {
 	AccountID string `json:"accountId"`
 	Region    string `json:"region"`
 	ExpiresAt string `json:"expiresAt"`
+
+	/*
+	 * Duration used for the session, which can be shorter than
+	 * the configured one, if the role does not allow it.
+	 */
+	DurationSeconds int `json:"durationSeconds"`
 }
 
 /*
@@ -140,6 +157,28 @@ type EventBridgeMetadata struct {
 	 * This ensures that we reuse the same rule for the same source, e.g., aws.codeartifact, aws.ecr, etc.
 	 */
 	Rules map[string]EventBridgeRuleMetadata `json:"rules" mapstructure:"rules"`
+
+	/*
+	 * Result of the last rotation of the connection secret.
+	 */
+	SecretRotation *SecretRotationMetadata `json:"secretRotation,omitempty" mapstructure:"secretRotation"`
+}
+
+/*
+ * Until PreviousSecretExpiresAt, events sent with the previous
+ * connection secret are still accepted, so deliveries already
+ * in flight when the secret was rotated are not dropped.
+ */
+type SecretRotationMetadata struct {
+	RotatedAt               string                 `json:"rotatedAt" mapstructure:"rotatedAt"`
+	PreviousSecretExpiresAt string                 `json:"previousSecretExpiresAt" mapstructure:"previousSecretExpiresAt"`
+	Regions                 []SecretRotationResult `json:"regions" mapstructure:"regions"`
+}
+
+type SecretRotationResult struct {
+	Region  string `json:"region" mapstructure:"region"`
+	Rotated bool   `json:"rotated" mapstructure:"rotated"`
+	Message string `json:"message" mapstructure:"message"`
 }
 
 type EventBridgeRuleMetadata struct {
@@ -157,6 +196,22 @@ type APIDestinationMetadata struct {
 	APIDestinationArn string `json:"apiDestinationArn" mapstructure:"apiDestinationArn"`
 }
 
+/*
+ * Result of the last healthCheck action.
+ * Healthy is true only if all checks passed.
+ */
+type HealthCheckMetadata struct {
+	Healthy   bool                `json:"healthy" mapstructure:"healthy"`
+	CheckedAt string              `json:"checkedAt" mapstructure:"checkedAt"`
+	Checks    []HealthCheckResult `json:"checks" mapstructure:"checks"`
+}
+
+type HealthCheckResult struct {
+	Name    string `json:"name" mapstructure:"name"`
+	Healthy bool   `json:"healthy" mapstructure:"healthy"`
+	Message string `json:"message" mapstructure:"message"`
+}
+
 type ProvisionRuleParameters struct {
 	Region     string `json:"region"`
 	Source     string `json:"source"`
@@ -164,10 +219,20 @@ type ProvisionRuleParameters struct {
 }
 
 type EventBridgeEvent struct {
-	Region     string         `json:"region"`
-	DetailType string         `json:"detail-type"`
-	Source     string         `json:"source"`
-	Detail     map[string]any `json:"detail"`
+	ID         string         `json:"id,omitempty" mapstructure:"id"`
+	Account    string         `json:"account" mapstructure:"account"`
+	Region     string         `json:"region" mapstructure:"region"`
+	DetailType string         `json:"detail-type" mapstructure:"detail-type"`
+	Source     string         `json:"source" mapstructure:"source"`
+	Detail     map[string]any `json:"detail" mapstructure:"detail"`
+}
+
+/*
+ * Subscriptions are indexed by source and detail type,
+ * so incoming events only load the subscriptions that can match them.
+ */
+func (e EventBridgeEvent) SubscriptionIndex() core.SubscriptionFilter {
+	return core.SubscriptionFilter{Source: e.Source, Type: e.DetailType}
 }
 
 type Tag struct {
@@ -186,48 +251,32 @@ func TagsForAPI(tags []Tag) []any {
 	return apiTags
 }
 
-func CredentialsFromInstallation(ctx core.IntegrationContext) (*aws.Credentials, error) {
-	if ctx == nil {
-		return nil, fmt.Errorf("AWS integration context is missing")
-	}
-	secrets, err := ctx.GetSecrets()
+func RegionFromInstallation(ctx core.IntegrationContext) string {
+	regionBytes, err := ctx.GetConfig("region")
 	if err != nil {
-		return nil, fmt.Errorf("failed to get AWS session secrets: %w", err)
-	}
-
-	var accessKeyID string
-	var secretAccessKey string
-	var sessionToken string
-
-	for _, secret := range secrets {
-		switch secret.Name {
-		case accessKeyIDSecret:
-			accessKeyID = string(secret.Value)
-		case secretAccessKeySecret:
-			secretAccessKey = string(secret.Value)
-		case sessionTokenSecret:
-			sessionToken = string(secret.Value)
-		}
+		return ""
 	}
+	return strings.TrimSpace(string(regionBytes))
+}
 
-	if strings.TrimSpace(accessKeyID) == "" || strings.TrimSpace(secretAccessKey) == "" || strings.TrimSpace(sessionToken) == "" {
-		return nil, fmt.Errorf("AWS session credentials are missing")
+/*
+ * Returns the region a component should use.
+ * The component's own region always wins. If it is empty,
+ * the integration region is used instead, unless it is
+ * an STS endpoint URL, which is not a region.
+ */
+func ResolveRegion(integration core.IntegrationContext, region string) string {
+	region = strings.TrimSpace(region)
+	if region != "" || integration == nil {
+		return region
 	}
 
-	return &aws.Credentials{
-		AccessKeyID:     accessKeyID,
-		SecretAccessKey: secretAccessKey,
-		SessionToken:    sessionToken,
-		Source:          "superplane",
-	}, nil
-}
-
-func RegionFromInstallation(ctx core.IntegrationContext) string {
-	regionBytes, err := ctx.GetConfig("region")
-	if err != nil {
+	fallback := RegionFromInstallation(integration)
+	if strings.Contains(fallback, "://") {
 		return ""
 	}
-	return strings.TrimSpace(string(regionBytes))
+
+	return fallback
 }
 
 func NormalizeTags(tags []Tag) []Tag {
@@ -262,7 +311,7 @@ func NormalizeTags(tags []Tag) []Tag {
 /*
  * Extract the account ID from an IAM role ARN.
  *
- * Expected format: arn:aws:iam::<account-id>:role/<role-name>
+ * Expected format: arn:<partition>:iam::<account-id>:role/<role-name>
  */
 func AccountIDFromRoleArn(roleArn string) (string, error) {
 	roleArn = strings.TrimSpace(roleArn)
@@ -270,14 +319,66 @@ func AccountIDFromRoleArn(roleArn string) (string, error) {
 		return "", fmt.Errorf("role ARN is empty")
 	}
 
-	parts := strings.Split(roleArn, ":")
-	if len(parts) < 6 {
+	arn, err := ParseArn(roleArn)
+	if err != nil {
+		return "", fmt.Errorf("role ARN is invalid: %w", err)
+	}
+
+	return arn.AccountID, nil
+}
+
+/*
+ * Role ARNs look like arn:<partition>:iam::<account>:role/<path>/<name>,
+ * and IAM only needs the name to find the role.
+ */
+func RoleNameFromRoleArn(roleArn string) (string, error) {
+	arn, err := ParseArn(roleArn)
+	if err != nil || !strings.HasPrefix(arn.Resource, "role/") {
 		return "", fmt.Errorf("role ARN is invalid")
 	}
 
-	if parts[0] != "arn" {
+	segments := strings.Split(arn.Resource, "/")
+	name := segments[len(segments)-1]
+	if name == "" {
 		return "", fmt.Errorf("role ARN is invalid")
 	}
 
-	return strings.TrimSpace(parts[4]), nil
+	return name, nil
+}
+
+/*
+ * Triggers store a hash of the configuration used in their last successful setup,
+ * so Setup() can skip subscribing / provisioning again when nothing changed.
+ */
+func ConfigHash(config any) (string, error) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal configuration: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+/*
+ * Triggers emit the EventBridge event they received,
+ * with the ID of the account where it happened under "accountId",
+ * so workflows receiving events from multiple accounts can branch on it.
+ */
+func EventPayload(message any) (map[string]any, error) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	payload := map[string]any{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal message: %w", err)
+	}
+
+	if account, ok := payload["account"].(string); ok {
+		payload["accountId"] = account
+	}
+
+	return payload, nil
 }
@@ -0,0 +1,36 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func TestResolveRegion(t *testing.T) {
+	integration := &contexts.IntegrationContext{
+		Configuration: map[string]any{"region": "eu-west-1"},
+	}
+
+	t.Run("empty region uses the integration region", func(t *testing.T) {
+		assert.Equal(t, "eu-west-1", ResolveRegion(integration, ""))
+		assert.Equal(t, "eu-west-1", ResolveRegion(integration, "  "))
+	})
+
+	t.Run("explicit region overrides the integration region", func(t *testing.T) {
+		assert.Equal(t, "us-west-2", ResolveRegion(integration, " us-west-2 "))
+	})
+
+	t.Run("STS endpoint is not used as a region", func(t *testing.T) {
+		endpoint := &contexts.IntegrationContext{
+			Configuration: map[string]any{"region": "https://sts.example.com"},
+		}
+
+		assert.Equal(t, "", ResolveRegion(endpoint, ""))
+	})
+
+	t.Run("no integration region", func(t *testing.T) {
+		assert.Equal(t, "", ResolveRegion(&contexts.IntegrationContext{}, ""))
+		assert.Equal(t, "", ResolveRegion(nil, ""))
+	})
+}
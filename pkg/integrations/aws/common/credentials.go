@@ -0,0 +1,306 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	CredentialsSecret = "credentials"
+
+	//
+	// Before credentials were stored as a single versioned record,
+	// each value was stored in its own secret.
+	// We still read them for integrations that were not synced since then.
+	//
+	legacyAccessKeyIDSecret     = "accessKeyId"
+	legacySecretAccessKeySecret = "secretAccessKey"
+	legacySessionTokenSecret    = "sessionToken"
+)
+
+/*
+ * Returned when the stored STS session expired before the integration
+ * was able to refresh it. A resync is requested when this happens,
+ * so new credentials should be available soon.
+ */
+var ErrCredentialsExpired = errors.New("AWS session expired, awaiting refresh")
+
+/*
+ * All the values of an STS session, written and read together,
+ * so a reader never sees a mix of values from two different sessions.
+ */
+type CredentialsSnapshot struct {
+	Version         int       `json:"version"`
+	AccessKeyID     string    `json:"accessKeyId"`
+	SecretAccessKey string    `json:"secretAccessKey"`
+	SessionToken    string    `json:"sessionToken"`
+	Expiration      time.Time `json:"expiration"`
+}
+
+/*
+ * The credentials record stored in the integration secrets.
+ * When credentials are rotated, the current snapshot becomes the previous one,
+ * and it remains readable until it expires, so operations that started
+ * with it can still finish.
+ */
+type CredentialsRecord struct {
+	Current  *CredentialsSnapshot `json:"current"`
+	Previous *CredentialsSnapshot `json:"previous,omitempty"`
+}
+
+func (s *CredentialsSnapshot) IsValid() bool {
+	return strings.TrimSpace(s.AccessKeyID) != "" &&
+		strings.TrimSpace(s.SecretAccessKey) != "" &&
+		strings.TrimSpace(s.SessionToken) != ""
+}
+
+func (s *CredentialsSnapshot) IsExpired() bool {
+	return !s.Expiration.IsZero() && !time.Now().Before(s.Expiration)
+}
+
+func (s *CredentialsSnapshot) Credentials() *aws.Credentials {
+	return &aws.Credentials{
+		AccessKeyID:     s.AccessKeyID,
+		SecretAccessKey: s.SecretAccessKey,
+		SessionToken:    s.SessionToken,
+		Source:          "superplane",
+		CanExpire:       !s.Expiration.IsZero(),
+		Expires:         s.Expiration,
+	}
+}
+
+/*
+ * Stores a new set of credentials as the current version,
+ * keeping the existing one as the previous version, if it has not expired yet.
+ * All values are written with a single secret update.
+ */
+func StoreCredentials(ctx core.IntegrationContext, accessKeyID, secretAccessKey, sessionToken string, expiration time.Time) (*CredentialsSnapshot, error) {
+	secrets, err := ctx.GetSecrets()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS session secrets: %w", err)
+	}
+
+	existing, err := findCredentialsRecord(secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &CredentialsSnapshot{
+		Version:         1,
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		SessionToken:    sessionToken,
+		Expiration:      expiration,
+	}
+
+	record := CredentialsRecord{Current: snapshot}
+	if existing != nil && existing.Current != nil {
+		snapshot.Version = existing.Current.Version + 1
+		if !existing.Current.IsExpired() {
+			record.Previous = existing.Current
+		}
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal AWS credentials: %w", err)
+	}
+
+	if err := ctx.SetSecret(CredentialsSecret, data); err != nil {
+		return nil, fmt.Errorf("failed to set AWS credentials secret: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+/*
+ * Returns the current credentials for the integration.
+ * The secrets are read once, so the values always belong to the same session.
+ */
+func CredentialsFromInstallation(ctx core.IntegrationContext) (*aws.Credentials, error) {
+	credentials, _, err := VersionedCredentialsFromInstallation(ctx)
+	return credentials, err
+}
+
+/*
+ * Same as CredentialsFromInstallation(), but also returns the version of the credentials.
+ * Long-running operations record it in their metadata,
+ * and use ResumeCredentials() with it when they resume.
+ */
+func VersionedCredentialsFromInstallation(ctx core.IntegrationContext) (*aws.Credentials, int, error) {
+	snapshot, err := CurrentCredentialsSnapshot(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := checkSessionExpiration(ctx, snapshot); err != nil {
+		return nil, 0, err
+	}
+
+	return snapshot.Credentials(), snapshot.Version, nil
+}
+
+/*
+ * Returns the credentials an operation started with, if that version is still readable,
+ * so all the requests of the operation are made with the same session.
+ * Once that version is gone, the current credentials are used.
+ */
+func ResumeCredentials(ctx core.IntegrationContext, version int) (*aws.Credentials, error) {
+	if version > 0 {
+		credentials, err := CredentialsForVersion(ctx, version)
+		if err == nil {
+			return credentials, nil
+		}
+	}
+
+	return CredentialsFromInstallation(ctx)
+}
+
+/*
+ * Using an expired session only leads to opaque signing errors from AWS,
+ * so we fail fast, and request the integration to refresh the session right away,
+ * instead of waiting for the scheduled resync.
+ */
+func checkSessionExpiration(ctx core.IntegrationContext, snapshot *CredentialsSnapshot) error {
+	expiresAt := sessionExpiration(ctx, snapshot)
+	if expiresAt.IsZero() || time.Now().Before(expiresAt) {
+		return nil
+	}
+
+	if err := ctx.ScheduleResync(time.Second); err != nil {
+		return fmt.Errorf("%w: failed to request refresh: %v", ErrCredentialsExpired, err)
+	}
+
+	return ErrCredentialsExpired
+}
+
+/*
+ * Credentials stored as a snapshot carry their own expiration.
+ * Legacy secrets do not, so the session metadata is used for them.
+ */
+func sessionExpiration(ctx core.IntegrationContext, snapshot *CredentialsSnapshot) time.Time {
+	if !snapshot.Expiration.IsZero() {
+		return snapshot.Expiration
+	}
+
+	metadata := IntegrationMetadata{}
+	if err := mapstructure.Decode(ctx.GetMetadata(), &metadata); err != nil {
+		return time.Time{}
+	}
+
+	if metadata.Session == nil || metadata.Session.ExpiresAt == "" {
+		return time.Time{}
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, metadata.Session.ExpiresAt)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return expiresAt
+}
+
+func CurrentCredentialsSnapshot(ctx core.IntegrationContext) (*CredentialsSnapshot, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("AWS integration context is missing")
+	}
+
+	secrets, err := ctx.GetSecrets()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS session secrets: %w", err)
+	}
+
+	record, err := findCredentialsRecord(secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	if record == nil {
+		return legacyCredentialsSnapshot(secrets)
+	}
+
+	if record.Current == nil || !record.Current.IsValid() {
+		return nil, fmt.Errorf("AWS session credentials are missing")
+	}
+
+	return record.Current, nil
+}
+
+/*
+ * Returns a specific version of the credentials.
+ * Only the current and the previous versions are kept,
+ * and the previous version is only available until it expires.
+ */
+func CredentialsForVersion(ctx core.IntegrationContext, version int) (*aws.Credentials, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("AWS integration context is missing")
+	}
+
+	secrets, err := ctx.GetSecrets()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS session secrets: %w", err)
+	}
+
+	record, err := findCredentialsRecord(secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	if record == nil {
+		return nil, fmt.Errorf("AWS credentials version %d not found", version)
+	}
+
+	if record.Current != nil && record.Current.Version == version {
+		return record.Current.Credentials(), nil
+	}
+
+	if record.Previous != nil && record.Previous.Version == version && !record.Previous.IsExpired() {
+		return record.Previous.Credentials(), nil
+	}
+
+	return nil, fmt.Errorf("AWS credentials version %d not found", version)
+}
+
+func findCredentialsRecord(secrets []core.IntegrationSecret) (*CredentialsRecord, error) {
+	for _, secret := range secrets {
+		if secret.Name != CredentialsSecret {
+			continue
+		}
+
+		var record CredentialsRecord
+		if err := json.Unmarshal(secret.Value, &record); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal AWS credentials: %w", err)
+		}
+
+		return &record, nil
+	}
+
+	return nil, nil
+}
+
+func legacyCredentialsSnapshot(secrets []core.IntegrationSecret) (*CredentialsSnapshot, error) {
+	snapshot := &CredentialsSnapshot{}
+	for _, secret := range secrets {
+		switch secret.Name {
+		case legacyAccessKeyIDSecret:
+			snapshot.AccessKeyID = string(secret.Value)
+		case legacySecretAccessKeySecret:
+			snapshot.SecretAccessKey = string(secret.Value)
+		case legacySessionTokenSecret:
+			snapshot.SessionToken = string(secret.Value)
+		}
+	}
+
+	if !snapshot.IsValid() {
+		return nil, fmt.Errorf("AWS session credentials are missing")
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,186 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func TestCredentials(t *testing.T) {
+	t.Run("no credentials -> error", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{Secrets: map[string]core.IntegrationSecret{}}
+		_, err := CredentialsFromInstallation(integrationCtx)
+		require.ErrorContains(t, err, "AWS session credentials are missing")
+	})
+
+	t.Run("legacy secrets are still read", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			Secrets: map[string]core.IntegrationSecret{
+				"accessKeyId":     {Name: "accessKeyId", Value: []byte("AKIA_TEST")},
+				"secretAccessKey": {Name: "secretAccessKey", Value: []byte("secret")},
+				"sessionToken":    {Name: "sessionToken", Value: []byte("token")},
+			},
+		}
+
+		credentials, err := CredentialsFromInstallation(integrationCtx)
+		require.NoError(t, err)
+		assert.Equal(t, "AKIA_TEST", credentials.AccessKeyID)
+		assert.Equal(t, "secret", credentials.SecretAccessKey)
+		assert.Equal(t, "token", credentials.SessionToken)
+	})
+
+	t.Run("stored credentials take precedence over legacy secrets", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			Secrets: map[string]core.IntegrationSecret{
+				"accessKeyId":     {Name: "accessKeyId", Value: []byte("AKIA_LEGACY")},
+				"secretAccessKey": {Name: "secretAccessKey", Value: []byte("legacy-secret")},
+				"sessionToken":    {Name: "sessionToken", Value: []byte("legacy-token")},
+			},
+		}
+
+		expiration := time.Now().Add(time.Hour)
+		_, err := StoreCredentials(integrationCtx, "AKIA_TEST", "secret", "token", expiration)
+		require.NoError(t, err)
+
+		credentials, err := CredentialsFromInstallation(integrationCtx)
+		require.NoError(t, err)
+		assert.Equal(t, "AKIA_TEST", credentials.AccessKeyID)
+		assert.Equal(t, "secret", credentials.SecretAccessKey)
+		assert.Equal(t, "token", credentials.SessionToken)
+		assert.True(t, credentials.CanExpire)
+		assert.True(t, credentials.Expires.Equal(expiration))
+	})
+
+	t.Run("rotation keeps previous version until it expires", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{Secrets: map[string]core.IntegrationSecret{}}
+
+		first, err := StoreCredentials(integrationCtx, "AKIA_1", "secret-1", "token-1", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, first.Version)
+
+		second, err := StoreCredentials(integrationCtx, "AKIA_2", "secret-2", "token-2", time.Now().Add(2*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 2, second.Version)
+
+		previous, err := CredentialsForVersion(integrationCtx, first.Version)
+		require.NoError(t, err)
+		assert.Equal(t, "AKIA_1", previous.AccessKeyID)
+
+		current, err := CredentialsForVersion(integrationCtx, second.Version)
+		require.NoError(t, err)
+		assert.Equal(t, "AKIA_2", current.AccessKeyID)
+
+		//
+		// Only one previous version is kept.
+		//
+		third, err := StoreCredentials(integrationCtx, "AKIA_3", "secret-3", "token-3", time.Now().Add(3*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 3, third.Version)
+
+		_, err = CredentialsForVersion(integrationCtx, first.Version)
+		require.ErrorContains(t, err, "AWS credentials version 1 not found")
+	})
+
+	t.Run("expired previous version is not kept", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{Secrets: map[string]core.IntegrationSecret{}}
+
+		first, err := StoreCredentials(integrationCtx, "AKIA_1", "secret-1", "token-1", time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+
+		_, err = StoreCredentials(integrationCtx, "AKIA_2", "secret-2", "token-2", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		_, err = CredentialsForVersion(integrationCtx, first.Version)
+		require.ErrorContains(t, err, "AWS credentials version 1 not found")
+	})
+
+	t.Run("resuming uses the recorded version while it is readable", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{Secrets: map[string]core.IntegrationSecret{}}
+
+		_, err := StoreCredentials(integrationCtx, "AKIA_1", "secret-1", "token-1", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		credentials, version, err := VersionedCredentialsFromInstallation(integrationCtx)
+		require.NoError(t, err)
+		assert.Equal(t, "AKIA_1", credentials.AccessKeyID)
+		assert.Equal(t, 1, version)
+
+		_, err = StoreCredentials(integrationCtx, "AKIA_2", "secret-2", "token-2", time.Now().Add(2*time.Hour))
+		require.NoError(t, err)
+
+		resumed, err := ResumeCredentials(integrationCtx, version)
+		require.NoError(t, err)
+		assert.Equal(t, "AKIA_1", resumed.AccessKeyID)
+
+		//
+		// Once the recorded version is gone, the current credentials are used.
+		//
+		_, err = StoreCredentials(integrationCtx, "AKIA_3", "secret-3", "token-3", time.Now().Add(3*time.Hour))
+		require.NoError(t, err)
+
+		resumed, err = ResumeCredentials(integrationCtx, version)
+		require.NoError(t, err)
+		assert.Equal(t, "AKIA_3", resumed.AccessKeyID)
+
+		//
+		// Operations started before versions were recorded use the current credentials.
+		//
+		resumed, err = ResumeCredentials(integrationCtx, 0)
+		require.NoError(t, err)
+		assert.Equal(t, "AKIA_3", resumed.AccessKeyID)
+	})
+
+	t.Run("expired session -> typed error and resync is requested", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{Secrets: map[string]core.IntegrationSecret{}}
+		_, err := StoreCredentials(integrationCtx, "AKIA_TEST", "secret", "token", time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+
+		_, err = CredentialsFromInstallation(integrationCtx)
+		require.ErrorIs(t, err, ErrCredentialsExpired)
+		assert.Equal(t, "AWS session expired, awaiting refresh", err.Error())
+		assert.Equal(t, []time.Duration{time.Second}, integrationCtx.ResyncRequests)
+	})
+
+	t.Run("legacy secrets with expired session metadata -> typed error", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			Metadata: IntegrationMetadata{
+				Session: &SessionMetadata{
+					ExpiresAt: time.Now().Add(-time.Minute).Format(time.RFC3339),
+				},
+			},
+			Secrets: map[string]core.IntegrationSecret{
+				"accessKeyId":     {Name: "accessKeyId", Value: []byte("AKIA_TEST")},
+				"secretAccessKey": {Name: "secretAccessKey", Value: []byte("secret")},
+				"sessionToken":    {Name: "sessionToken", Value: []byte("token")},
+			},
+		}
+
+		_, err := CredentialsFromInstallation(integrationCtx)
+		require.ErrorIs(t, err, ErrCredentialsExpired)
+		assert.Len(t, integrationCtx.ResyncRequests, 1)
+	})
+
+	t.Run("legacy secrets with valid session metadata -> credentials", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			Metadata: IntegrationMetadata{
+				Session: &SessionMetadata{
+					ExpiresAt: time.Now().Add(time.Hour).Format(time.RFC3339),
+				},
+			},
+			Secrets: map[string]core.IntegrationSecret{
+				"accessKeyId":     {Name: "accessKeyId", Value: []byte("AKIA_TEST")},
+				"secretAccessKey": {Name: "secretAccessKey", Value: []byte("secret")},
+				"sessionToken":    {Name: "sessionToken", Value: []byte("token")},
+			},
+		}
+
+		credentials, err := CredentialsFromInstallation(integrationCtx)
+		require.NoError(t, err)
+		assert.Equal(t, "AKIA_TEST", credentials.AccessKeyID)
+		assert.Empty(t, integrationCtx.ResyncRequests)
+	})
+}
@@ -0,0 +1,79 @@
+package common
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	ServiceECR    = "ecr"
+	ServiceECS    = "ecs"
+	ServiceLambda = "lambda"
+	ServiceSNS    = "sns"
+)
+
+var EndpointServices = []configuration.FieldOption{
+	{Label: "ECR", Value: ServiceECR},
+	{Label: "ECS", Value: ServiceECS},
+	{Label: "Lambda", Value: ServiceLambda},
+	{Label: "SNS", Value: ServiceSNS},
+}
+
+type EndpointOverride struct {
+	Service string `json:"service" mapstructure:"service"`
+	URL     string `json:"url" mapstructure:"url"`
+}
+
+/*
+ * Validates the endpoint overrides from the integration configuration,
+ * and turns them into a service -> URL map. If the same service
+ * appears more than once, the last URL is used.
+ */
+func NormalizeEndpointOverrides(overrides []EndpointOverride) (map[string]string, error) {
+	if len(overrides) == 0 {
+		return nil, nil
+	}
+
+	normalized := map[string]string{}
+	for _, override := range overrides {
+		service := strings.TrimSpace(override.Service)
+		if !slices.ContainsFunc(EndpointServices, func(option configuration.FieldOption) bool {
+			return option.Value == service
+		}) {
+			return nil, fmt.Errorf("endpoint override: unsupported service %q", service)
+		}
+
+		endpoint := strings.TrimSpace(override.URL)
+		parsed, err := url.Parse(endpoint)
+		if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+			return nil, fmt.Errorf("endpoint override for %s must be an absolute HTTPS URL, got %q", service, endpoint)
+		}
+
+		normalized[service] = endpoint
+	}
+
+	return normalized, nil
+}
+
+/*
+ * Returns the endpoint override configured for a service,
+ * or an empty string if the public regional endpoint should be used.
+ */
+func EndpointOverrideFor(integration core.IntegrationContext, service string) string {
+	if integration == nil {
+		return ""
+	}
+
+	metadata := IntegrationMetadata{}
+	if err := mapstructure.Decode(integration.GetMetadata(), &metadata); err != nil {
+		return ""
+	}
+
+	return metadata.EndpointOverrides[service]
+}
@@ -0,0 +1,58 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func TestNormalizeEndpointOverrides(t *testing.T) {
+	t.Run("no overrides -> nil", func(t *testing.T) {
+		overrides, err := NormalizeEndpointOverrides(nil)
+		require.NoError(t, err)
+		assert.Nil(t, overrides)
+	})
+
+	t.Run("overrides are keyed by service, last one wins", func(t *testing.T) {
+		overrides, err := NormalizeEndpointOverrides([]EndpointOverride{
+			{Service: ServiceSNS, URL: " https://sns.internal.example.com/ "},
+			{Service: ServiceECR, URL: "https://ecr-fips.us-gov-west-1.amazonaws.com/"},
+			{Service: ServiceSNS, URL: "https://sns-fips.us-gov-west-1.amazonaws.com/"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			ServiceSNS: "https://sns-fips.us-gov-west-1.amazonaws.com/",
+			ServiceECR: "https://ecr-fips.us-gov-west-1.amazonaws.com/",
+		}, overrides)
+	})
+
+	t.Run("non-HTTPS URL -> error", func(t *testing.T) {
+		_, err := NormalizeEndpointOverrides([]EndpointOverride{{Service: ServiceECR, URL: "http://ecr.internal"}})
+		require.ErrorContains(t, err, "endpoint override for ecr must be an absolute HTTPS URL")
+	})
+
+	t.Run("relative URL -> error", func(t *testing.T) {
+		_, err := NormalizeEndpointOverrides([]EndpointOverride{{Service: ServiceLambda, URL: "lambda.internal"}})
+		require.ErrorContains(t, err, "endpoint override for lambda must be an absolute HTTPS URL")
+	})
+
+	t.Run("unsupported service -> error", func(t *testing.T) {
+		_, err := NormalizeEndpointOverrides([]EndpointOverride{{Service: "s3", URL: "https://s3.internal"}})
+		require.ErrorContains(t, err, `unsupported service "s3"`)
+	})
+}
+
+func TestEndpointOverrideFor(t *testing.T) {
+	integration := &contexts.IntegrationContext{
+		Metadata: IntegrationMetadata{
+			EndpointOverrides: map[string]string{ServiceECR: "https://ecr.internal.example.com/"},
+		},
+	}
+
+	assert.Equal(t, "https://ecr.internal.example.com/", EndpointOverrideFor(integration, ServiceECR))
+	assert.Empty(t, EndpointOverrideFor(integration, ServiceSNS))
+	assert.Empty(t, EndpointOverrideFor(&contexts.IntegrationContext{}, ServiceECR))
+}
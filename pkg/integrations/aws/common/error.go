@@ -112,3 +112,12 @@ func IsNotFoundErr(err error) bool {
 
 	return false
 }
+
+func IsAccessDeniedErr(err error) bool {
+	var awsErr *Error
+	if errors.As(err, &awsErr) {
+		return strings.Contains(awsErr.Code, "AccessDenied") || strings.Contains(awsErr.Code, "UnauthorizedOperation")
+	}
+
+	return false
+}
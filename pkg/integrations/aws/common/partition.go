@@ -0,0 +1,134 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+ * AWS partitions are isolated groups of regions,
+ * each with its own endpoints domain and ARN prefix.
+ * See: https://docs.aws.amazon.com/whitepapers/latest/aws-fault-isolation-boundaries/partitions.html
+ */
+const (
+	PartitionAWS      = "aws"
+	PartitionChina    = "aws-cn"
+	PartitionGovCloud = "aws-us-gov"
+)
+
+type partition struct {
+	dnsSuffix     string
+	defaultRegion string
+	iamEndpoint   string
+}
+
+var partitions = map[string]partition{
+	PartitionAWS: {
+		dnsSuffix:     "amazonaws.com",
+		defaultRegion: "us-east-1",
+		iamEndpoint:   "https://iam.amazonaws.com/",
+	},
+	PartitionChina: {
+		dnsSuffix:     "amazonaws.com.cn",
+		defaultRegion: "cn-north-1",
+		iamEndpoint:   "https://iam.cn-north-1.amazonaws.com.cn/",
+	},
+	PartitionGovCloud: {
+		dnsSuffix:     "amazonaws.com",
+		defaultRegion: "us-gov-west-1",
+		iamEndpoint:   "https://iam.us-gov.amazonaws.com/",
+	},
+}
+
+func PartitionForRegion(region string) string {
+	region = strings.TrimSpace(region)
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return PartitionChina
+	case strings.HasPrefix(region, "us-gov-"):
+		return PartitionGovCloud
+	default:
+		return PartitionAWS
+	}
+}
+
+/*
+ * Returns the partition of an ARN,
+ * or the standard one, if the ARN is not valid.
+ */
+func PartitionFromArn(arn string) string {
+	parsed, err := ParseArn(arn)
+	if err != nil {
+		return PartitionAWS
+	}
+
+	return parsed.Partition
+}
+
+func DefaultRegionForPartition(name string) string {
+	p, ok := partitions[name]
+	if !ok {
+		return partitions[PartitionAWS].defaultRegion
+	}
+
+	return p.defaultRegion
+}
+
+/*
+ * Returns the public regional endpoint of a service,
+ * like https://ecs.us-east-1.amazonaws.com or https://ecs.cn-north-1.amazonaws.com.cn.
+ * The endpoint has no trailing slash.
+ */
+func Endpoint(service, region string) string {
+	region = strings.TrimSpace(region)
+	p := partitions[PartitionForRegion(region)]
+	return fmt.Sprintf("https://%s.%s.%s", service, region, p.dnsSuffix)
+}
+
+/*
+ * IAM is a global service, with a single endpoint per partition,
+ * and requests to it are signed for the default region of the partition.
+ */
+func IAMEndpoint(name string) (string, string) {
+	p, ok := partitions[name]
+	if !ok {
+		p = partitions[PartitionAWS]
+	}
+
+	return p.iamEndpoint, p.defaultRegion
+}
+
+/*
+ * ARNs look like arn:<partition>:<service>:<region>:<account-id>:<resource>.
+ * The resource might contain colons itself, e.g. arn:aws:lambda:us-east-1:123456789012:function:my-function.
+ */
+type Arn struct {
+	Partition string
+	Service   string
+	Region    string
+	AccountID string
+	Resource  string
+}
+
+func ParseArn(arn string) (*Arn, error) {
+	parts := strings.SplitN(strings.TrimSpace(arn), ":", 6)
+	if len(parts) < 6 || parts[0] != "arn" {
+		return nil, fmt.Errorf("invalid ARN: %s", arn)
+	}
+
+	if _, ok := partitions[parts[1]]; !ok {
+		return nil, fmt.Errorf("invalid ARN: unsupported partition %q", parts[1])
+	}
+
+	return &Arn{
+		Partition: parts[1],
+		Service:   parts[2],
+		Region:    parts[3],
+		AccountID: strings.TrimSpace(parts[4]),
+		Resource:  parts[5],
+	}, nil
+}
+
+func (a Arn) String() string {
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", a.Partition, a.Service, a.Region, a.AccountID, a.Resource)
+}
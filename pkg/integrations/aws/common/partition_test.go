@@ -0,0 +1,71 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpoint(t *testing.T) {
+	assert.Equal(t, "https://ecs.us-east-1.amazonaws.com", Endpoint("ecs", "us-east-1"))
+	assert.Equal(t, "https://ecs.us-gov-west-1.amazonaws.com", Endpoint("ecs", "us-gov-west-1"))
+	assert.Equal(t, "https://ecs.cn-north-1.amazonaws.com.cn", Endpoint("ecs", "cn-north-1"))
+	assert.Equal(t, "https://api.ecr.cn-northwest-1.amazonaws.com.cn", Endpoint("api.ecr", " cn-northwest-1 "))
+}
+
+func TestIAMEndpoint(t *testing.T) {
+	endpoint, region := IAMEndpoint(PartitionAWS)
+	assert.Equal(t, "https://iam.amazonaws.com/", endpoint)
+	assert.Equal(t, "us-east-1", region)
+
+	endpoint, region = IAMEndpoint(PartitionGovCloud)
+	assert.Equal(t, "https://iam.us-gov.amazonaws.com/", endpoint)
+	assert.Equal(t, "us-gov-west-1", region)
+
+	endpoint, region = IAMEndpoint(PartitionChina)
+	assert.Equal(t, "https://iam.cn-north-1.amazonaws.com.cn/", endpoint)
+	assert.Equal(t, "cn-north-1", region)
+}
+
+func TestParseArn(t *testing.T) {
+	t.Run("resource with colons is kept together", func(t *testing.T) {
+		arn, err := ParseArn("arn:aws-cn:lambda:cn-north-1:123456789012:function:my-function:live")
+		require.NoError(t, err)
+		assert.Equal(t, PartitionChina, arn.Partition)
+		assert.Equal(t, "lambda", arn.Service)
+		assert.Equal(t, "cn-north-1", arn.Region)
+		assert.Equal(t, "123456789012", arn.AccountID)
+		assert.Equal(t, "function:my-function:live", arn.Resource)
+		assert.Equal(t, "arn:aws-cn:lambda:cn-north-1:123456789012:function:my-function:live", arn.String())
+	})
+
+	t.Run("unknown partition -> error", func(t *testing.T) {
+		_, err := ParseArn("arn:aws-iso:iam::123456789012:role/test")
+		require.ErrorContains(t, err, `unsupported partition "aws-iso"`)
+	})
+
+	t.Run("not an ARN -> error", func(t *testing.T) {
+		_, err := ParseArn("my-function")
+		require.Error(t, err)
+	})
+}
+
+func TestRoleArnHelpers(t *testing.T) {
+	for _, roleArn := range []string{
+		"arn:aws:iam::123456789012:role/path/test-role",
+		"arn:aws-us-gov:iam::123456789012:role/path/test-role",
+		"arn:aws-cn:iam::123456789012:role/path/test-role",
+	} {
+		accountID, err := AccountIDFromRoleArn(roleArn)
+		require.NoError(t, err)
+		assert.Equal(t, "123456789012", accountID)
+
+		name, err := RoleNameFromRoleArn(roleArn)
+		require.NoError(t, err)
+		assert.Equal(t, "test-role", name)
+	}
+
+	assert.Equal(t, PartitionGovCloud, PartitionFromArn("arn:aws-us-gov:iam::123456789012:role/test-role"))
+	assert.Equal(t, PartitionAWS, PartitionFromArn("not-an-arn"))
+}
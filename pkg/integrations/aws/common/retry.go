@@ -0,0 +1,213 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+/*
+ * Error codes AWS services use when a request is rejected
+ * because the caller is sending requests too fast.
+ */
+var throttlingCodes = []string{
+	"Throttling",
+	"ThrottlingException",
+	"ThrottledException",
+	"TooManyRequestsException",
+	"RequestLimitExceeded",
+	"RequestThrottled",
+	"RequestThrottledException",
+	"SlowDown",
+	"Rate exceeded",
+}
+
+type RetryPolicy struct {
+	MaxAttempts   int
+	BaseDelay     time.Duration
+	MaxDelay      time.Duration
+	MaxTotalDelay time.Duration
+
+	//
+	// Used to wait between attempts.
+	// If nil, time.Sleep is used.
+	//
+	Sleep func(time.Duration)
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:   5,
+	BaseDelay:     200 * time.Millisecond,
+	MaxDelay:      5 * time.Second,
+	MaxTotalDelay: 20 * time.Second,
+}
+
+/*
+ * Response of a request sent with DoWithRetry.
+ * The body is already read and closed.
+ */
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	Attempts   int
+
+	idempotent bool
+}
+
+/*
+ * Wraps the error built from a failed response with the number
+ * of attempts made, if the request was retried at all.
+ *
+ * If the request could still succeed later, the error is also
+ * marked as retryable, so the execution is attempted again.
+ */
+func (r *Response) Failed(err error) error {
+	if shouldRetry(r, r.idempotent) {
+		err = core.RetryableAfter(err, parseRetryAfter(r.Header.Get("Retry-After")))
+	}
+
+	if r.Attempts <= 1 {
+		return err
+	}
+
+	return &RetryError{Attempts: r.Attempts, Err: err}
+}
+
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("giving up after %d attempts: %v", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+/*
+ * Sends the request built by build, retrying it while AWS is throttling
+ * or failing with a 5xx, with exponential backoff and full jitter.
+ * The request is built and signed again on every attempt,
+ * so signatures never expire between retries.
+ *
+ * A throttled request was rejected before being processed,
+ * so it is safe to retry it even if it is not idempotent.
+ * 5xx responses are only retried for idempotent requests,
+ * since we cannot tell if the request was processed or not.
+ *
+ * Transport errors are returned right away.
+ */
+func DoWithRetry(httpCtx core.HTTPContext, policy RetryPolicy, idempotent bool, build func() (*http.Request, error)) (*Response, error) {
+	sleep := policy.Sleep
+	if sleep == nil {
+		sleep = time.Sleep
+	}
+
+	maxAttempts := max(policy.MaxAttempts, 1)
+	var waited time.Duration
+
+	for attempt := 1; ; attempt++ {
+		req, err := build()
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := httpCtx.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("request failed: %w", err)
+		}
+
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response: %w", err)
+		}
+
+		response := &Response{
+			StatusCode: res.StatusCode,
+			Header:     res.Header,
+			Body:       body,
+			Attempts:   attempt,
+			idempotent: idempotent,
+		}
+
+		if attempt >= maxAttempts || !shouldRetry(response, idempotent) {
+			return response, nil
+		}
+
+		delay := policy.backoff(attempt, response.Header)
+		if policy.MaxTotalDelay > 0 && waited+delay > policy.MaxTotalDelay {
+			return response, nil
+		}
+
+		sleep(delay)
+		waited += delay
+	}
+}
+
+func shouldRetry(response *Response, idempotent bool) bool {
+	if IsThrottlingResponse(response.StatusCode, response.Body) {
+		return true
+	}
+
+	return idempotent && response.StatusCode >= http.StatusInternalServerError
+}
+
+func IsThrottlingResponse(statusCode int, body []byte) bool {
+	if statusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	if statusCode < http.StatusBadRequest {
+		return false
+	}
+
+	for _, code := range throttlingCodes {
+		if bytes.Contains(body, []byte(code)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+ * Full jitter: a random delay between zero and the exponential backoff for the attempt.
+ * If AWS tells us how long to wait with Retry-After, we wait at least that long.
+ */
+func (p RetryPolicy) backoff(attempt int, header http.Header) time.Duration {
+	ceiling := p.BaseDelay << (attempt - 1)
+	if p.MaxDelay > 0 && (ceiling > p.MaxDelay || ceiling <= 0) {
+		ceiling = p.MaxDelay
+	}
+
+	var delay time.Duration
+	if ceiling > 0 {
+		delay = time.Duration(rand.Int64N(int64(ceiling) + 1))
+	}
+
+	retryAfter := parseRetryAfter(header.Get("Retry-After"))
+	if p.MaxDelay > 0 {
+		retryAfter = min(retryAfter, p.MaxDelay)
+	}
+
+	return max(delay, retryAfter)
+}
+
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
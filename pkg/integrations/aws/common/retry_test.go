@@ -0,0 +1,207 @@
+package common
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func mockResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func testPolicy(delays *[]time.Duration) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:   3,
+		BaseDelay:     100 * time.Millisecond,
+		MaxDelay:      time.Second,
+		MaxTotalDelay: 10 * time.Second,
+		Sleep: func(d time.Duration) {
+			*delays = append(*delays, d)
+		},
+	}
+}
+
+func buildRequest(builds *int) func() (*http.Request, error) {
+	return func() (*http.Request, error) {
+		*builds++
+		return http.NewRequest(http.MethodPost, "https://sns.us-east-1.amazonaws.com/", strings.NewReader("Action=Publish"))
+	}
+}
+
+func TestDoWithRetry(t *testing.T) {
+	t.Run("throttled requests are retried and rebuilt on every attempt", func(t *testing.T) {
+		delays := []time.Duration{}
+		builds := 0
+		httpCtx := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				mockResponse(http.StatusBadRequest, `{"__type":"ThrottlingException","message":"Rate exceeded"}`),
+				mockResponse(http.StatusTooManyRequests, `{}`),
+				mockResponse(http.StatusOK, `{"ok":true}`),
+			},
+		}
+
+		res, err := DoWithRetry(httpCtx, testPolicy(&delays), true, buildRequest(&builds))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, `{"ok":true}`, string(res.Body))
+		assert.Equal(t, 3, res.Attempts)
+		assert.Equal(t, 3, builds)
+		assert.Len(t, httpCtx.Requests, 3)
+		require.Len(t, delays, 2)
+		assert.LessOrEqual(t, delays[0], 100*time.Millisecond)
+		assert.LessOrEqual(t, delays[1], 200*time.Millisecond)
+	})
+
+	t.Run("5xx is retried for idempotent requests", func(t *testing.T) {
+		delays := []time.Duration{}
+		builds := 0
+		httpCtx := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				mockResponse(http.StatusServiceUnavailable, `{}`),
+				mockResponse(http.StatusOK, `{}`),
+			},
+		}
+
+		res, err := DoWithRetry(httpCtx, testPolicy(&delays), true, buildRequest(&builds))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, 2, res.Attempts)
+	})
+
+	t.Run("5xx is not retried for non-idempotent requests", func(t *testing.T) {
+		delays := []time.Duration{}
+		builds := 0
+		httpCtx := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				mockResponse(http.StatusInternalServerError, `{}`),
+			},
+		}
+
+		res, err := DoWithRetry(httpCtx, testPolicy(&delays), false, buildRequest(&builds))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+		assert.Equal(t, 1, res.Attempts)
+		assert.Empty(t, delays)
+		assert.False(t, core.IsRetryable(res.Failed(errors.New("internal error"))))
+	})
+
+	t.Run("throttling is retried for non-idempotent requests", func(t *testing.T) {
+		delays := []time.Duration{}
+		builds := 0
+		httpCtx := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				mockResponse(http.StatusBadRequest, `<ErrorResponse><Error><Code>Throttling</Code></Error></ErrorResponse>`),
+				mockResponse(http.StatusOK, `{}`),
+			},
+		}
+
+		res, err := DoWithRetry(httpCtx, testPolicy(&delays), false, buildRequest(&builds))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, 2, res.Attempts)
+	})
+
+	t.Run("other client errors are not retried", func(t *testing.T) {
+		delays := []time.Duration{}
+		builds := 0
+		httpCtx := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				mockResponse(http.StatusBadRequest, `{"__type":"ValidationException","message":"bad"}`),
+			},
+		}
+
+		res, err := DoWithRetry(httpCtx, testPolicy(&delays), true, buildRequest(&builds))
+		require.NoError(t, err)
+		assert.Equal(t, 1, res.Attempts)
+		assert.Empty(t, delays)
+		assert.False(t, core.IsRetryable(res.Failed(ParseError(res.Body))))
+	})
+
+	t.Run("gives up after max attempts and reports them", func(t *testing.T) {
+		delays := []time.Duration{}
+		builds := 0
+		httpCtx := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				mockResponse(http.StatusTooManyRequests, `{"__type":"TooManyRequestsException","message":"slow down"}`),
+				mockResponse(http.StatusTooManyRequests, `{"__type":"TooManyRequestsException","message":"slow down"}`),
+				mockResponse(http.StatusTooManyRequests, `{"__type":"TooManyRequestsException","message":"slow down"}`),
+			},
+		}
+
+		res, err := DoWithRetry(httpCtx, testPolicy(&delays), true, buildRequest(&builds))
+		require.NoError(t, err)
+		assert.Equal(t, 3, res.Attempts)
+
+		err = res.Failed(ParseError(res.Body))
+		assert.EqualError(t, err, "giving up after 3 attempts: TooManyRequestsException: slow down")
+
+		var awsErr *Error
+		require.True(t, errors.As(err, &awsErr))
+		assert.Equal(t, "TooManyRequestsException", awsErr.Code)
+		assert.True(t, core.IsRetryable(err))
+	})
+
+	t.Run("max total delay stops retries early", func(t *testing.T) {
+		delays := []time.Duration{}
+		builds := 0
+		policy := testPolicy(&delays)
+		policy.MaxTotalDelay = 500 * time.Millisecond
+
+		throttled := mockResponse(http.StatusTooManyRequests, `{}`)
+		throttled.Header.Set("Retry-After", "1")
+		httpCtx := &contexts.HTTPContext{Responses: []*http.Response{throttled}}
+
+		res, err := DoWithRetry(httpCtx, policy, true, buildRequest(&builds))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+		assert.Equal(t, 1, res.Attempts)
+		assert.Empty(t, delays)
+
+		err = res.Failed(errors.New("throttled"))
+		assert.True(t, core.IsRetryable(err))
+		assert.Equal(t, time.Second, core.RetryDelay(err))
+	})
+
+	t.Run("Retry-After is honored", func(t *testing.T) {
+		delays := []time.Duration{}
+		builds := 0
+		throttled := mockResponse(http.StatusTooManyRequests, `{}`)
+		throttled.Header.Set("Retry-After", "1")
+		httpCtx := &contexts.HTTPContext{
+			Responses: []*http.Response{throttled, mockResponse(http.StatusOK, `{}`)},
+		}
+
+		_, err := DoWithRetry(httpCtx, testPolicy(&delays), true, buildRequest(&builds))
+		require.NoError(t, err)
+		assert.Equal(t, []time.Duration{time.Second}, delays)
+	})
+
+	t.Run("transport errors are not retried", func(t *testing.T) {
+		delays := []time.Duration{}
+		builds := 0
+		httpCtx := &contexts.HTTPContext{}
+
+		_, err := DoWithRetry(httpCtx, testPolicy(&delays), true, buildRequest(&builds))
+		require.ErrorContains(t, err, "request failed")
+		assert.Equal(t, 1, builds)
+	})
+}
+
+func TestResponse_Failed(t *testing.T) {
+	err := errors.New("boom")
+	assert.Equal(t, err, (&Response{Attempts: 1}).Failed(err))
+	assert.EqualError(t, (&Response{Attempts: 2}).Failed(err), "giving up after 2 attempts: boom")
+}
@@ -0,0 +1,133 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	MinSessionDurationSeconds = 900
+	MaxSessionDurationSeconds = 43200
+
+	/*
+	 * The max session duration of an IAM role is at least one hour,
+	 * so sessions of one hour can be requested for any role.
+	 */
+	MinRoleMaxSessionDurationSeconds = 3600
+)
+
+var maxSessionDurationRegexp = regexp.MustCompile(`less than or equal to (\d+)`)
+
+/*
+ * STS rejects durations above the max session duration of the role
+ * with a ValidationError. Some of those messages include the maximum:
+ *
+ *   Member must have value less than or equal to 43200
+ *
+ * but the one for the role limit does not:
+ *
+ *   The requested DurationSeconds exceeds the MaxSessionDuration set for this role.
+ *
+ * in which case the duration every role allows is used.
+ * False is returned if the error is not about the session duration,
+ * or if the allowed duration is not shorter than the requested one.
+ */
+func AllowedSessionDuration(err error, requestedSeconds int) (int, bool) {
+	var awsErr *Error
+	if !errors.As(err, &awsErr) || awsErr.Code != STSErrorValidation {
+		return 0, false
+	}
+
+	message := strings.ToLower(awsErr.Message)
+	if !strings.Contains(message, "maxsessionduration") && !strings.Contains(message, "durationseconds") {
+		return 0, false
+	}
+
+	allowed := MinRoleMaxSessionDurationSeconds
+	if match := maxSessionDurationRegexp.FindStringSubmatch(awsErr.Message); match != nil {
+		if value, err := strconv.Atoi(match[1]); err == nil {
+			allowed = value
+		}
+	}
+
+	if allowed <= 0 || allowed >= requestedSeconds {
+		return 0, false
+	}
+
+	return allowed, true
+}
+
+/*
+ * Returns credentials that remain valid for at least durationSeconds.
+ *
+ * The integration's session is used if it lasts long enough.
+ * Otherwise, the role is assumed again with the requested duration,
+ * and the new session is used only by this execution.
+ * AWS still caps the duration at the role's max session duration.
+ */
+func CredentialsForExecution(ctx core.ExecutionContext, durationSeconds int) (*aws.Credentials, error) {
+	snapshot, err := CurrentCredentialsSnapshot(ctx.Integration)
+	if err != nil {
+		return nil, err
+	}
+
+	if durationSeconds <= 0 {
+		if err := checkSessionExpiration(ctx.Integration, snapshot); err != nil {
+			return nil, err
+		}
+
+		return snapshot.Credentials(), nil
+	}
+
+	requested := time.Duration(durationSeconds) * time.Second
+	if !snapshot.Expiration.IsZero() && time.Until(snapshot.Expiration) >= requested {
+		return snapshot.Credentials(), nil
+	}
+
+	if ctx.OIDC == nil {
+		return nil, fmt.Errorf("unable to request a longer AWS session: OIDC provider is missing")
+	}
+
+	roleArn, err := ctx.Integration.GetConfig("roleArn")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role ARN: %w", err)
+	}
+
+	//
+	// The STS region is optional in the integration configuration.
+	//
+	region := ""
+	regionBytes, err := ctx.Integration.GetConfig("region")
+	if err == nil {
+		region = strings.TrimSpace(string(regionBytes))
+	}
+
+	integrationID := ctx.Integration.ID().String()
+	subject := fmt.Sprintf("app-installation:%s", integrationID)
+	token, err := ctx.OIDC.Sign(subject, 5*time.Minute, integrationID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate OIDC token: %w", err)
+	}
+
+	sessionName := fmt.Sprintf("SuperPlane-%s", ctx.ID)
+	credentials, err := AssumeRoleWithWebIdentity(ctx.HTTP, region, strings.TrimSpace(string(roleArn)), sessionName, token, durationSeconds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assume role for a longer session: %w", err)
+	}
+
+	return &aws.Credentials{
+		AccessKeyID:     credentials.AccessKeyID,
+		SecretAccessKey: credentials.SecretAccessKey,
+		SessionToken:    credentials.SessionToken,
+		Source:          "superplane",
+		CanExpire:       true,
+		Expires:         credentials.Expiration,
+	}, nil
+}
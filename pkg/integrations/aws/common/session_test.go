@@ -0,0 +1,164 @@
+package common
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func TestCredentialsForExecution(t *testing.T) {
+	newIntegration := func(t *testing.T, expiration time.Time) *contexts.IntegrationContext {
+		integrationCtx := &contexts.IntegrationContext{
+			IntegrationID: uuid.NewString(),
+			Configuration: map[string]any{
+				"roleArn": "arn:aws:iam::123456789012:role/test-role",
+				"region":  "us-east-1",
+			},
+			Secrets: map[string]core.IntegrationSecret{},
+		}
+
+		_, err := StoreCredentials(integrationCtx, "AKIA_INTEGRATION", "secret", "token", expiration)
+		require.NoError(t, err)
+		return integrationCtx
+	}
+
+	t.Run("no duration requested -> integration credentials", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{}
+		credentials, err := CredentialsForExecution(core.ExecutionContext{
+			Integration: newIntegration(t, time.Now().Add(30*time.Minute)),
+			HTTP:        httpContext,
+			OIDC:        support.NewOIDCProvider(),
+		}, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, "AKIA_INTEGRATION", credentials.AccessKeyID)
+		assert.Empty(t, httpContext.Requests)
+	})
+
+	t.Run("no duration requested and integration session expired -> typed error", func(t *testing.T) {
+		integrationCtx := newIntegration(t, time.Now().Add(-time.Minute))
+		httpContext := &contexts.HTTPContext{}
+		_, err := CredentialsForExecution(core.ExecutionContext{
+			Integration: integrationCtx,
+			HTTP:        httpContext,
+			OIDC:        support.NewOIDCProvider(),
+		}, 0)
+
+		require.ErrorIs(t, err, ErrCredentialsExpired)
+		assert.Len(t, integrationCtx.ResyncRequests, 1)
+		assert.Empty(t, httpContext.Requests)
+	})
+
+	t.Run("integration session lasts long enough -> integration credentials", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{}
+		credentials, err := CredentialsForExecution(core.ExecutionContext{
+			Integration: newIntegration(t, time.Now().Add(3*time.Hour)),
+			HTTP:        httpContext,
+			OIDC:        support.NewOIDCProvider(),
+		}, 7200)
+
+		require.NoError(t, err)
+		assert.Equal(t, "AKIA_INTEGRATION", credentials.AccessKeyID)
+		assert.Empty(t, httpContext.Requests)
+	})
+
+	t.Run("longer session requested -> role is assumed with requested duration", func(t *testing.T) {
+		expiration := time.Now().Add(2 * time.Hour).UTC().Truncate(time.Second)
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(assumeRoleWithWebIdentityXML(expiration))),
+				},
+			},
+		}
+
+		integrationCtx := newIntegration(t, time.Now().Add(30*time.Minute))
+		executionID := uuid.New()
+		credentials, err := CredentialsForExecution(core.ExecutionContext{
+			ID:          executionID,
+			Integration: integrationCtx,
+			HTTP:        httpContext,
+			OIDC:        support.NewOIDCProvider(),
+		}, 7200)
+
+		require.NoError(t, err)
+		assert.Equal(t, "AKIA_EXECUTION", credentials.AccessKeyID)
+		assert.Equal(t, "execution-secret", credentials.SecretAccessKey)
+		assert.Equal(t, "execution-token", credentials.SessionToken)
+		assert.True(t, credentials.CanExpire)
+		assert.True(t, credentials.Expires.Equal(expiration))
+
+		require.Len(t, httpContext.Requests, 1)
+		body, err := io.ReadAll(httpContext.Requests[0].Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "DurationSeconds=7200")
+		assert.Contains(t, string(body), "RoleSessionName=SuperPlane-"+executionID.String())
+
+		//
+		// The execution session is not stored on the integration.
+		//
+		stored, err := CredentialsFromInstallation(integrationCtx)
+		require.NoError(t, err)
+		assert.Equal(t, "AKIA_INTEGRATION", stored.AccessKeyID)
+	})
+
+	t.Run("longer session requested without OIDC provider -> error", func(t *testing.T) {
+		_, err := CredentialsForExecution(core.ExecutionContext{
+			Integration: newIntegration(t, time.Now().Add(30*time.Minute)),
+			HTTP:        &contexts.HTTPContext{},
+		}, 7200)
+
+		require.ErrorContains(t, err, "OIDC provider is missing")
+	})
+}
+
+func assumeRoleWithWebIdentityXML(expiration time.Time) string {
+	return fmt.Sprintf(`
+<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>AKIA_EXECUTION</AccessKeyId>
+      <SecretAccessKey>execution-secret</SecretAccessKey>
+      <SessionToken>execution-token</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+</AssumeRoleWithWebIdentityResponse>
+`, expiration.Format(time.RFC3339))
+}
+
+func TestAllowedSessionDuration(t *testing.T) {
+	roleLimit := &Error{Code: STSErrorValidation, Message: "The requested DurationSeconds exceeds the MaxSessionDuration set for this role."}
+	withMaximum := &Error{Code: STSErrorValidation, Message: "Value '50000' at 'durationSeconds' failed to satisfy constraint: Member must have value less than or equal to 43200"}
+
+	allowed, ok := AllowedSessionDuration(fmt.Errorf("failed: %w", roleLimit), 7200)
+	assert.True(t, ok)
+	assert.Equal(t, MinRoleMaxSessionDurationSeconds, allowed)
+
+	allowed, ok = AllowedSessionDuration(withMaximum, 50000)
+	assert.True(t, ok)
+	assert.Equal(t, 43200, allowed)
+
+	_, ok = AllowedSessionDuration(roleLimit, 3600)
+	assert.False(t, ok)
+
+	_, ok = AllowedSessionDuration(&Error{Code: STSErrorValidation, Message: "Request ARN is invalid"}, 7200)
+	assert.False(t, ok)
+
+	_, ok = AllowedSessionDuration(&Error{Code: STSErrorAccessDenied, Message: "DurationSeconds"}, 7200)
+	assert.False(t, ok)
+
+	_, ok = AllowedSessionDuration(nil, 7200)
+	assert.False(t, ok)
+}
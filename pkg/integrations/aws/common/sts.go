@@ -0,0 +1,262 @@
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const defaultSTSRegion = "us-east-1"
+
+type STSCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      time.Time
+}
+
+/*
+ * STS error codes returned by AssumeRoleWithWebIdentity.
+ * See: https://docs.aws.amazon.com/STS/latest/APIReference/API_AssumeRoleWithWebIdentity.html#API_AssumeRoleWithWebIdentity_Errors
+ */
+const (
+	STSErrorAccessDenied          = "AccessDenied"
+	STSErrorInvalidIdentityToken  = "InvalidIdentityToken"
+	STSErrorExpiredToken          = "ExpiredTokenException"
+	STSErrorIDPCommunicationError = "IDPCommunicationError"
+	STSErrorIDPRejectedClaim      = "IDPRejectedClaim"
+	STSErrorRegionDisabled        = "RegionDisabledException"
+	STSErrorValidation            = "ValidationError"
+)
+
+type assumeRoleResponse struct {
+	Result assumeRoleResult `xml:"AssumeRoleWithWebIdentityResult"`
+}
+
+type assumeRoleResult struct {
+	Credentials stsCredentialsResponse `xml:"Credentials"`
+}
+
+type stsCredentialsResponse struct {
+	AccessKeyID     string `xml:"AccessKeyId"`
+	SecretAccessKey string `xml:"SecretAccessKey"`
+	SessionToken    string `xml:"SessionToken"`
+	Expiration      string `xml:"Expiration"`
+}
+
+func AssumeRoleWithWebIdentity(httpCtx core.HTTPContext, region string, roleArn string, sessionName string, token string, durationSeconds int) (STSCredentials, error) {
+	//
+	// The global STS endpoint only serves the standard partition,
+	// so roles in other partitions use the default region of theirs.
+	//
+	if strings.TrimSpace(region) == "" {
+		if partition := PartitionFromArn(roleArn); partition != PartitionAWS {
+			region = DefaultRegionForPartition(partition)
+		}
+	}
+
+	endpoint := stsEndpoint(region)
+
+	values := url.Values{}
+	values.Set("Action", "AssumeRoleWithWebIdentity")
+	values.Set("Version", "2011-06-15")
+	values.Set("RoleArn", roleArn)
+	values.Set("RoleSessionName", sessionName)
+	values.Set("WebIdentityToken", token)
+	if durationSeconds > 0 {
+		values.Set("DurationSeconds", strconv.Itoa(durationSeconds))
+	}
+
+	req, err := http.NewRequestWithContext(httpCtx.Context(), http.MethodPost, endpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return STSCredentials{}, fmt.Errorf("error building STS request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/xml")
+
+	res, err := httpCtx.Do(req)
+	if err != nil {
+		return STSCredentials{}, fmt.Errorf("error executing STS request: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return STSCredentials{}, fmt.Errorf("error reading STS response: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		if stsErr := parseSTSError(body); stsErr != nil {
+			return STSCredentials{}, stsErr
+		}
+
+		return STSCredentials{}, fmt.Errorf("STS request failed with %d: %s", res.StatusCode, string(body))
+	}
+
+	var response assumeRoleResponse
+	if err := xml.Unmarshal(body, &response); err != nil {
+		return STSCredentials{}, fmt.Errorf("error parsing STS response: %w", err)
+	}
+
+	expiration, err := time.Parse(time.RFC3339, strings.TrimSpace(response.Result.Credentials.Expiration))
+	if err != nil {
+		return STSCredentials{}, fmt.Errorf("error parsing STS expiration: %w", err)
+	}
+
+	credentials := STSCredentials{
+		AccessKeyID:     response.Result.Credentials.AccessKeyID,
+		SecretAccessKey: response.Result.Credentials.SecretAccessKey,
+		SessionToken:    response.Result.Credentials.SessionToken,
+		Expiration:      expiration,
+	}
+
+	if credentials.AccessKeyID == "" || credentials.SecretAccessKey == "" || credentials.SessionToken == "" {
+		return STSCredentials{}, fmt.Errorf("STS response missing credentials")
+	}
+
+	return credentials, nil
+}
+
+type CallerIdentity struct {
+	Account string
+	Arn     string
+	UserID  string
+}
+
+type getCallerIdentityResponse struct {
+	Result struct {
+		Account string `xml:"Account"`
+		Arn     string `xml:"Arn"`
+		UserID  string `xml:"UserId"`
+	} `xml:"GetCallerIdentityResult"`
+}
+
+type stsErrorResponse struct {
+	Error struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	} `xml:"Error"`
+}
+
+/*
+ * STS responds to failed requests with an XML document:
+ *
+ * <ErrorResponse>
+ *   <Error>
+ *     <Type>Sender</Type>
+ *     <Code>AccessDenied</Code>
+ *     <Message>Not authorized to perform sts:AssumeRoleWithWebIdentity</Message>
+ *   </Error>
+ *   <RequestId>...</RequestId>
+ * </ErrorResponse>
+ *
+ * Nil is returned if the body is not an STS error.
+ */
+func parseSTSError(body []byte) *Error {
+	var response stsErrorResponse
+	if xml.Unmarshal(body, &response) != nil || strings.TrimSpace(response.Error.Code) == "" {
+		return nil
+	}
+
+	return &Error{
+		Code:    strings.TrimSpace(response.Error.Code),
+		Message: strings.TrimSpace(response.Error.Message),
+	}
+}
+
+func IsSTSErr(err error, code string) bool {
+	var awsErr *Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code == code
+	}
+
+	return false
+}
+
+/*
+ * Returns the identity for the given credentials.
+ * This call requires no permissions, so it only fails
+ * if the credentials themselves are invalid or expired.
+ */
+func GetCallerIdentity(httpCtx core.HTTPContext, credentials *aws.Credentials, region string) (*CallerIdentity, error) {
+	values := url.Values{}
+	values.Set("Action", "GetCallerIdentity")
+	values.Set("Version", "2011-06-15")
+
+	body := values.Encode()
+	req, err := http.NewRequestWithContext(httpCtx.Context(), http.MethodPost, stsEndpoint(region), strings.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error building STS request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	req.Header.Set("Accept", "application/xml")
+
+	signingRegion := strings.TrimSpace(region)
+	if signingRegion == "" || strings.Contains(signingRegion, "://") {
+		signingRegion = defaultSTSRegion
+	}
+
+	hash := sha256.Sum256([]byte(body))
+	err = v4.NewSigner().SignHTTP(context.Background(), *credentials, req, hex.EncodeToString(hash[:]), "sts", signingRegion, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error signing STS request: %w", err)
+	}
+
+	res, err := httpCtx.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing STS request: %w", err)
+	}
+	defer res.Body.Close()
+
+	responseBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading STS response: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		if stsErr := parseSTSError(responseBody); stsErr != nil {
+			return nil, stsErr
+		}
+
+		return nil, fmt.Errorf("STS request failed with %d: %s", res.StatusCode, string(responseBody))
+	}
+
+	var response getCallerIdentityResponse
+	if err := xml.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("error parsing STS response: %w", err)
+	}
+
+	return &CallerIdentity{
+		Account: strings.TrimSpace(response.Result.Account),
+		Arn:     strings.TrimSpace(response.Result.Arn),
+		UserID:  strings.TrimSpace(response.Result.UserID),
+	}, nil
+}
+
+func stsEndpoint(region string) string {
+	region = strings.TrimSpace(region)
+	if region == "" {
+		return "https://sts.amazonaws.com"
+	}
+
+	if strings.HasPrefix(region, "http://") || strings.HasPrefix(region, "https://") {
+		return region
+	}
+
+	return Endpoint("sts", region)
+}
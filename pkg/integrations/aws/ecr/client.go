@@ -8,8 +8,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -21,19 +21,36 @@ import (
 
 const targetPrefix = "AmazonEC2ContainerRegistry_V20150921."
 
+/*
+ * Actions that start work on every call,
+ * so they are only retried when AWS throttles them.
+ */
+var nonIdempotentActions = []string{"StartImageScan"}
+
 type Client struct {
 	http        core.HTTPContext
 	region      string
+	endpoint    string
 	credentials *aws.Credentials
 	signer      *v4.Signer
+	retry       common.RetryPolicy
 }
 
-func NewClient(httpCtx core.HTTPContext, credentials *aws.Credentials, region string) *Client {
+/*
+ * If endpoint is empty, the public regional endpoint is used.
+ */
+func NewClient(httpCtx core.HTTPContext, credentials *aws.Credentials, region string, endpoint string) *Client {
+	if endpoint == "" {
+		endpoint = common.Endpoint("api.ecr", region) + "/"
+	}
+
 	return &Client{
 		http:        httpCtx,
 		region:      region,
+		endpoint:    endpoint,
 		credentials: credentials,
 		signer:      v4.NewSigner(),
+		retry:       common.DefaultRetryPolicy,
 	}
 }
 
@@ -159,6 +176,7 @@ type ImageScanFindings struct {
 	ImageScanCompletedAt         common.FloatTime   `json:"imageScanCompletedAt,omitempty"`
 	VulnerabilitySourceUpdatedAt common.FloatTime   `json:"vulnerabilitySourceUpdatedAt,omitempty"`
 	FindingSeverityCounts        map[string]int     `json:"findingSeverityCounts"`
+	TotalCount                   int                `json:"totalCount"`
 }
 
 type ImageScanFinding struct {
@@ -186,17 +204,64 @@ func (c *Client) DescribeImageScanFindings(repositoryName string, imageDigest st
 		return nil, errors.New("image digest or image tag is required")
 	}
 
-	payload := map[string]any{
-		"repositoryName": repositoryName,
-		"imageId":        imageID,
+	var response *DescribeImageScanFindingsResponse
+	findings := []ImageScanFinding{}
+	nextToken := ""
+
+	for {
+		payload := map[string]any{
+			"repositoryName": repositoryName,
+			"imageId":        imageID,
+			"maxResults":     1000,
+		}
+		if nextToken != "" {
+			payload["nextToken"] = nextToken
+		}
+
+		var page struct {
+			DescribeImageScanFindingsResponse
+			NextToken string `json:"nextToken"`
+		}
+
+		if err := c.postJSON("DescribeImageScanFindings", payload, &page); err != nil {
+			return nil, err
+		}
+
+		if response == nil {
+			response = &page.DescribeImageScanFindingsResponse
+		}
+
+		findings = append(findings, page.ImageScanFindings.Findings...)
+		if page.NextToken == "" {
+			break
+		}
+		nextToken = page.NextToken
 	}
 
-	response := DescribeImageScanFindingsResponse{}
-	if err := c.postJSON("DescribeImageScanFindings", payload, &response); err != nil {
-		return nil, err
+	response.ImageScanFindings.Findings = findings
+	response.ImageScanFindings.TotalCount = len(findings)
+	if len(findings) > 0 {
+		response.ImageScanFindings.FindingSeverityCounts = countFindingsBySeverity(findings)
 	}
 
-	return &response, nil
+	return response, nil
+}
+
+/*
+ * The severity counts are computed from all the pages we fetched,
+ * so they always match the findings we return.
+ */
+func countFindingsBySeverity(findings []ImageScanFinding) map[string]int {
+	counts := map[string]int{}
+	for _, finding := range findings {
+		severity := finding.Severity
+		if severity == "" {
+			severity = "UNDEFINED"
+		}
+		counts[severity]++
+	}
+
+	return counts
 }
 
 type ScanImageResponse struct {
@@ -234,42 +299,34 @@ func (c *Client) postJSON(action string, payload any, out any) error {
 		return fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	endpoint := fmt.Sprintf("https://api.ecr.%s.amazonaws.com/", c.region)
-	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
-	if err != nil {
-		return fmt.Errorf("failed to build request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
-	req.Header.Set("X-Amz-Target", targetPrefix+action)
-
-	if err := c.signRequest(req, body); err != nil {
-		return err
-	}
+	idempotent := !slices.Contains(nonIdempotentActions, action)
+	res, err := common.DoWithRetry(c.http, c.retry, idempotent, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(c.http.Context(), http.MethodPost, c.endpoint, bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("failed to build request: %w", err)
+		}
 
-	res, err := c.http.Do(req)
-	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
-	}
-	defer res.Body.Close()
+		req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+		req.Header.Set("X-Amz-Target", targetPrefix+action)
+		return req, c.signRequest(req, body)
+	})
 
-	responseBody, err := io.ReadAll(res.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
+		return err
 	}
 
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		if awsErr := common.ParseError(responseBody); awsErr != nil {
-			return awsErr
+		if awsErr := common.ParseError(res.Body); awsErr != nil {
+			return res.Failed(awsErr)
 		}
-		return fmt.Errorf("ECR API request failed with %d: %s", res.StatusCode, string(responseBody))
+		return res.Failed(fmt.Errorf("ECR API request failed with %d: %s", res.StatusCode, string(res.Body)))
 	}
 
 	if out == nil {
 		return nil
 	}
 
-	if err := json.Unmarshal(responseBody, out); err != nil {
+	if err := json.Unmarshal(res.Body, out); err != nil {
 		return fmt.Errorf("failed to decode response: %w", err)
 	}
 
@@ -56,7 +56,7 @@ func validateRepositoryWithIntegration(httpCtx core.HTTPContext, integration cor
 		return nil, fmt.Errorf("failed to get AWS credentials: %w", err)
 	}
 
-	client := NewClient(httpCtx, credentials, region)
+	client := NewClient(httpCtx, credentials, region, common.EndpointOverrideFor(integration, common.ServiceECR))
 	repositoryName, err := repositoryNameFromRef(repositoryRef)
 	if err != nil {
 		return nil, err
@@ -44,7 +44,7 @@ func (c *GetImage) Documentation() string {
 
 ## Configuration
 
-- **Region**: AWS region of the ECR repository
+- **Region**: AWS region of the ECR repository. Defaults to the integration region
 - **Repository**: ECR repository name or ARN
 - **Image Digest**: Digest of the image (optional)
 - **Image Tag**: Tag of the image (optional)
@@ -67,11 +67,11 @@ func (c *GetImage) OutputChannels(configuration any) []core.OutputChannel {
 func (c *GetImage) Configuration() []configuration.Field {
 	return []configuration.Field{
 		{
-			Name:     "region",
-			Label:    "Region",
-			Type:     configuration.FieldTypeSelect,
-			Required: true,
-			Default:  "us-east-1",
+			Name:        "region",
+			Label:       "Region",
+			Type:        configuration.FieldTypeSelect,
+			Required:    false,
+			Description: "AWS region. Defaults to the integration region",
 			TypeOptions: &configuration.TypeOptions{
 				Select: &configuration.SelectTypeOptions{
 					Options: common.AllRegions,
@@ -84,12 +84,6 @@ func (c *GetImage) Configuration() []configuration.Field {
 			Type:        configuration.FieldTypeIntegrationResource,
 			Required:    true,
 			Description: "ECR repository name or ARN",
-			VisibilityConditions: []configuration.VisibilityCondition{
-				{
-					Field:  "region",
-					Values: []string{"*"},
-				},
-			},
 			TypeOptions: &configuration.TypeOptions{
 				Resource: &configuration.ResourceTypeOptions{
 					Type:           "ecr.repository",
@@ -112,10 +106,6 @@ func (c *GetImage) Configuration() []configuration.Field {
 			Required:    false,
 			Placeholder: "sha256:...",
 			VisibilityConditions: []configuration.VisibilityCondition{
-				{
-					Field:  "region",
-					Values: []string{"*"},
-				},
 				{
 					Field:  "repository",
 					Values: []string{"*"},
@@ -129,10 +119,6 @@ func (c *GetImage) Configuration() []configuration.Field {
 			Required:    false,
 			Placeholder: "latest",
 			VisibilityConditions: []configuration.VisibilityCondition{
-				{
-					Field:  "region",
-					Values: []string{"*"},
-				},
 				{
 					Field:  "repository",
 					Values: []string{"*"},
@@ -148,6 +134,8 @@ func (c *GetImage) Setup(ctx core.SetupContext) error {
 		return fmt.Errorf("failed to decode configuration: %w", err)
 	}
 
+	config.Region = common.ResolveRegion(ctx.Integration, config.Region)
+
 	region := strings.TrimSpace(config.Region)
 	if region == "" {
 		return fmt.Errorf("region is required")
@@ -174,12 +162,14 @@ func (c *GetImage) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to decode configuration: %w", err)
 	}
 
+	config.Region = common.ResolveRegion(ctx.Integration, config.Region)
+
 	creds, err := common.CredentialsFromInstallation(ctx.Integration)
 	if err != nil {
 		return fmt.Errorf("failed to get AWS credentials: %w", err)
 	}
 
-	client := NewClient(ctx.HTTP, creds, config.Region)
+	client := NewClient(ctx.HTTP, creds, config.Region, common.EndpointOverrideFor(ctx.Integration, common.ServiceECR))
 	imageDetail, err := client.DescribeImage(config.Repository, config.ImageDigest, config.ImageTag)
 	if err != nil {
 		return fmt.Errorf("failed to describe image: %w", err)
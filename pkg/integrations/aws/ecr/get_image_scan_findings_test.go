@@ -139,4 +139,72 @@ func Test__GetImageScanFindings__Execute(t *testing.T) {
 		require.Len(t, httpContext.Requests, 1)
 		assert.Equal(t, "https://api.ecr.us-east-1.amazonaws.com/", httpContext.Requests[0].URL.String())
 	})
+	t.Run("multiple pages -> emits all findings", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(strings.NewReader(`
+						{
+							"imageScanStatus": {"status": "COMPLETE"},
+							"imageScanFindings": {
+								"findings": [
+									{"name": "CVE-2024-0001", "severity": "HIGH"},
+									{"name": "CVE-2024-0002", "severity": "LOW"}
+								],
+								"findingSeverityCounts": {"HIGH": 2, "LOW": 1}
+							},
+							"nextToken": "page-2"
+						}
+					`)),
+				},
+				{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(strings.NewReader(`
+						{
+							"imageScanStatus": {"status": "COMPLETE"},
+							"imageScanFindings": {
+								"findings": [
+									{"name": "CVE-2024-0003", "severity": "HIGH"}
+								],
+								"findingSeverityCounts": {"HIGH": 2, "LOW": 1}
+							}
+						}
+					`)),
+				},
+			},
+		}
+
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"region":     "us-east-1",
+				"repository": "backend",
+				"imageTag":   "latest",
+			},
+			HTTP:           httpContext,
+			ExecutionState: execState,
+			Integration: &contexts.IntegrationContext{
+				Secrets: map[string]core.IntegrationSecret{
+					"accessKeyId":     {Name: "accessKeyId", Value: []byte("key")},
+					"secretAccessKey": {Name: "secretAccessKey", Value: []byte("secret")},
+					"sessionToken":    {Name: "sessionToken", Value: []byte("token")},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, execState.Payloads, 1)
+		findings, ok := execState.Payloads[0].(map[string]any)["data"].(*DescribeImageScanFindingsResponse)
+		require.True(t, ok)
+		require.Len(t, findings.ImageScanFindings.Findings, 3)
+		assert.Equal(t, "CVE-2024-0003", findings.ImageScanFindings.Findings[2].Name)
+		assert.Equal(t, 3, findings.ImageScanFindings.TotalCount)
+		assert.Equal(t, map[string]int{"HIGH": 2, "LOW": 1}, findings.ImageScanFindings.FindingSeverityCounts)
+
+		require.Len(t, httpContext.Requests, 2)
+		body, err := io.ReadAll(httpContext.Requests[1].Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), `"nextToken":"page-2"`)
+	})
 }
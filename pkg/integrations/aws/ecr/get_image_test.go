@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
 	"github.com/superplanehq/superplane/test/support/contexts"
 )
 
@@ -146,4 +147,83 @@ func Test__GetImage__Execute(t *testing.T) {
 		require.Len(t, httpContext.Requests, 1)
 		assert.Equal(t, "https://api.ecr.us-east-1.amazonaws.com/", httpContext.Requests[0].URL.String())
 	})
+
+	t.Run("endpoint override -> request goes to the override, signed for the region", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"imageDetails": [{"repositoryName": "backend", "imageDigest": "sha256:abc"}]}`)),
+				},
+			},
+		}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"region":     "us-gov-west-1",
+				"repository": "backend",
+				"imageTag":   "latest",
+			},
+			HTTP:           httpContext,
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			Integration: &contexts.IntegrationContext{
+				Metadata: common.IntegrationMetadata{
+					EndpointOverrides: map[string]string{
+						common.ServiceECR: "https://ecr-fips.us-gov-west-1.amazonaws.com/",
+					},
+				},
+				Secrets: map[string]core.IntegrationSecret{
+					"accessKeyId":     {Name: "accessKeyId", Value: []byte("key")},
+					"secretAccessKey": {Name: "secretAccessKey", Value: []byte("secret")},
+					"sessionToken":    {Name: "sessionToken", Value: []byte("token")},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, httpContext.Requests, 1)
+		assert.Equal(t, "https://ecr-fips.us-gov-west-1.amazonaws.com/", httpContext.Requests[0].URL.String())
+		assert.Contains(t, httpContext.Requests[0].Header.Get("Authorization"), "/us-gov-west-1/ecr/aws4_request")
+	})
+	for _, tc := range []struct {
+		name     string
+		region   string
+		expected string
+	}{
+		{name: "empty region -> uses integration region", region: "", expected: "eu-central-1"},
+		{name: "explicit region -> overrides integration region", region: "us-west-2", expected: "us-west-2"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			httpContext := &contexts.HTTPContext{
+				Responses: []*http.Response{
+					{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{"imageDetails": [{"repositoryName": "backend", "imageDigest": "sha256:abc"}]}`)),
+					},
+				},
+			}
+
+			err := component.Execute(core.ExecutionContext{
+				Configuration: map[string]any{
+					"region":     tc.region,
+					"repository": "backend",
+					"imageTag":   "latest",
+				},
+				HTTP:           httpContext,
+				ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+				Integration: &contexts.IntegrationContext{
+					Configuration: map[string]any{"region": "eu-central-1"},
+					Secrets: map[string]core.IntegrationSecret{
+						"accessKeyId":     {Name: "accessKeyId", Value: []byte("key")},
+						"secretAccessKey": {Name: "secretAccessKey", Value: []byte("secret")},
+						"sessionToken":    {Name: "sessionToken", Value: []byte("token")},
+					},
+				},
+			})
+
+			require.NoError(t, err)
+			require.Len(t, httpContext.Requests, 1)
+			assert.Equal(t, "https://api.ecr."+tc.expected+".amazonaws.com/", httpContext.Requests[0].URL.String())
+		})
+	}
 }
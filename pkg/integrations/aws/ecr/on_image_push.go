@@ -71,11 +71,11 @@ func (p *OnImagePush) Color() string {
 func (p *OnImagePush) Configuration() []configuration.Field {
 	return []configuration.Field{
 		{
-			Name:     "region",
-			Label:    "Region",
-			Type:     configuration.FieldTypeSelect,
-			Required: true,
-			Default:  "us-east-1",
+			Name:        "region",
+			Label:       "Region",
+			Type:        configuration.FieldTypeSelect,
+			Required:    false,
+			Description: "AWS region. Defaults to the integration region",
 			TypeOptions: &configuration.TypeOptions{
 				Select: &configuration.SelectTypeOptions{
 					Options: common.AllRegions,
@@ -88,12 +88,6 @@ func (p *OnImagePush) Configuration() []configuration.Field {
 			Type:        configuration.FieldTypeIntegrationResource,
 			Required:    true,
 			Description: "Filter by ECR repository name",
-			VisibilityConditions: []configuration.VisibilityCondition{
-				{
-					Field:  "region",
-					Values: []string{"*"},
-				},
-			},
 			TypeOptions: &configuration.TypeOptions{
 				Resource: &configuration.ResourceTypeOptions{
 					Type: "ecr.repository",
@@ -108,6 +102,7 @@ func (p *OnImagePush) Configuration() []configuration.Field {
 				},
 			},
 		},
+		core.DeduplicationField(),
 	}
 }
 
@@ -123,6 +118,11 @@ func (p *OnImagePush) Setup(ctx core.TriggerContext) error {
 		return fmt.Errorf("failed to decode configuration: %w", err)
 	}
 
+	config.Region = common.ResolveRegion(ctx.Integration, config.Region)
+	if config.Region == "" {
+		return fmt.Errorf("region is required")
+	}
+
 	repository, err := validateRepository(ctx, config.Region, config.Repository, metadata.Repository)
 	if err != nil {
 		return fmt.Errorf("failed to validate repository: %w", err)
@@ -308,7 +308,12 @@ func (p *OnImagePush) OnIntegrationMessage(ctx core.IntegrationMessageContext) e
 		return nil
 	}
 
-	return ctx.Events.Emit("aws.ecr.image.push", ctx.Message)
+	payload, err := common.EventPayload(ctx.Message)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Events.Emit("aws.ecr.image.push", payload)
 }
 
 func (p *OnImagePush) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
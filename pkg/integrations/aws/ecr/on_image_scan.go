@@ -76,11 +76,11 @@ func (p *OnImageScan) Color() string {
 func (p *OnImageScan) Configuration() []configuration.Field {
 	return []configuration.Field{
 		{
-			Name:     "region",
-			Label:    "Region",
-			Type:     configuration.FieldTypeSelect,
-			Required: true,
-			Default:  "us-east-1",
+			Name:        "region",
+			Label:       "Region",
+			Type:        configuration.FieldTypeSelect,
+			Required:    false,
+			Description: "AWS region. Defaults to the integration region",
 			TypeOptions: &configuration.TypeOptions{
 				Select: &configuration.SelectTypeOptions{
 					Options: common.AllRegions,
@@ -93,12 +93,6 @@ func (p *OnImageScan) Configuration() []configuration.Field {
 			Type:        configuration.FieldTypeIntegrationResource,
 			Required:    true,
 			Description: "Filter by ECR repository name",
-			VisibilityConditions: []configuration.VisibilityCondition{
-				{
-					Field:  "region",
-					Values: []string{"*"},
-				},
-			},
 			TypeOptions: &configuration.TypeOptions{
 				Resource: &configuration.ResourceTypeOptions{
 					Type: "ecr.repository",
@@ -113,6 +107,7 @@ func (p *OnImageScan) Configuration() []configuration.Field {
 				},
 			},
 		},
+		core.DeduplicationField(),
 	}
 }
 
@@ -128,6 +123,11 @@ func (p *OnImageScan) Setup(ctx core.TriggerContext) error {
 		return fmt.Errorf("failed to decode configuration: %w", err)
 	}
 
+	config.Region = common.ResolveRegion(ctx.Integration, config.Region)
+	if config.Region == "" {
+		return fmt.Errorf("region is required")
+	}
+
 	repository, err := validateRepository(ctx, config.Region, config.Repository, metadata.Repository)
 	if err != nil {
 		return fmt.Errorf("failed to validate repository: %w", err)
@@ -309,7 +309,12 @@ func (p *OnImageScan) OnIntegrationMessage(ctx core.IntegrationMessageContext) e
 		return nil
 	}
 
-	return ctx.Events.Emit("aws.ecr.image.scan", ctx.Message)
+	payload, err := common.EventPayload(ctx.Message)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Events.Emit("aws.ecr.image.scan", payload)
 }
 
 func (p *OnImageScan) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
@@ -216,7 +216,7 @@ func Test__OnImageScan__OnIntegrationMessage(t *testing.T) {
 		assert.Equal(t, 0, eventContext.Count())
 	})
 
-	t.Run("repository match -> emits event", func(t *testing.T) {
+	t.Run("repository match -> emits event with account ID", func(t *testing.T) {
 		eventContext := &contexts.EventContext{}
 		err := trigger.OnIntegrationMessage(core.IntegrationMessageContext{
 			Logger: logrus.NewEntry(logrus.New()),
@@ -227,12 +227,16 @@ func Test__OnImageScan__OnIntegrationMessage(t *testing.T) {
 				},
 			},
 			Message: common.EventBridgeEvent{
-				Detail: map[string]any{"repository-name": "backend"},
+				Account: "999988887777",
+				Detail:  map[string]any{"repository-name": "backend"},
 			},
 		})
 
 		require.NoError(t, err)
-		assert.Equal(t, 1, eventContext.Count())
+		require.Equal(t, 1, eventContext.Count())
 		assert.Equal(t, "aws.ecr.image.scan", eventContext.Payloads[0].Type)
+
+		payload := eventContext.Payloads[0].Data.(map[string]any)
+		assert.Equal(t, "999988887777", payload["accountId"])
 	})
 }
@@ -13,12 +13,12 @@ func ListRepositories(ctx core.ListResourcesContext, resourceType string) ([]cor
 		return nil, err
 	}
 
-	region := ctx.Parameters["region"]
+	region := common.ResolveRegion(ctx.Integration, ctx.Parameters["region"])
 	if region == "" {
 		return nil, fmt.Errorf("region is required")
 	}
 
-	client := NewClient(ctx.HTTP, creds, region)
+	client := NewClient(ctx.HTTP, creds, region, common.EndpointOverrideFor(ctx.Integration, common.ServiceECR))
 	repositories, err := client.ListRepositories()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list ECR repositories: %w", err)
@@ -26,6 +26,12 @@ type ScanImageMetadata struct {
 	Region      string `json:"region" mapstructure:"region"`
 	Repository  string `json:"repository" mapstructure:"repository"`
 	ImageDigest string `json:"imageDigest" mapstructure:"imageDigest"`
+
+	//
+	// Version of the credentials the scan was started with,
+	// used again while polling, as long as it is readable.
+	//
+	CredentialsVersion int `json:"credentialsVersion,omitempty" mapstructure:"credentialsVersion"`
 }
 
 func (c *ScanImage) Name() string {
@@ -51,7 +57,7 @@ func (c *ScanImage) Documentation() string {
 
 ## Configuration
 
-- **Region**: AWS region of the ECR repository
+- **Region**: AWS region of the ECR repository. Defaults to the integration region
 - **Repository**: ECR repository name or ARN
 - **Image Digest**: Digest of the image (optional)
 - **Image Tag**: Tag of the image (optional)
@@ -74,11 +80,11 @@ func (c *ScanImage) OutputChannels(configuration any) []core.OutputChannel {
 func (c *ScanImage) Configuration() []configuration.Field {
 	return []configuration.Field{
 		{
-			Name:     "region",
-			Label:    "Region",
-			Type:     configuration.FieldTypeSelect,
-			Required: true,
-			Default:  "us-east-1",
+			Name:        "region",
+			Label:       "Region",
+			Type:        configuration.FieldTypeSelect,
+			Required:    false,
+			Description: "AWS region. Defaults to the integration region",
 			TypeOptions: &configuration.TypeOptions{
 				Select: &configuration.SelectTypeOptions{
 					Options: common.AllRegions,
@@ -91,12 +97,6 @@ func (c *ScanImage) Configuration() []configuration.Field {
 			Type:        configuration.FieldTypeIntegrationResource,
 			Required:    true,
 			Description: "ECR repository name or ARN",
-			VisibilityConditions: []configuration.VisibilityCondition{
-				{
-					Field:  "region",
-					Values: []string{"*"},
-				},
-			},
 			TypeOptions: &configuration.TypeOptions{
 				Resource: &configuration.ResourceTypeOptions{
 					Type: "ecr.repository",
@@ -118,10 +118,6 @@ func (c *ScanImage) Configuration() []configuration.Field {
 			Required:    false,
 			Placeholder: "sha256:...",
 			VisibilityConditions: []configuration.VisibilityCondition{
-				{
-					Field:  "region",
-					Values: []string{"*"},
-				},
 				{
 					Field:  "repository",
 					Values: []string{"*"},
@@ -135,10 +131,6 @@ func (c *ScanImage) Configuration() []configuration.Field {
 			Required:    false,
 			Placeholder: "latest",
 			VisibilityConditions: []configuration.VisibilityCondition{
-				{
-					Field:  "region",
-					Values: []string{"*"},
-				},
 				{
 					Field:  "repository",
 					Values: []string{"*"},
@@ -154,6 +146,8 @@ func (c *ScanImage) Setup(ctx core.SetupContext) error {
 		return fmt.Errorf("failed to decode configuration: %w", err)
 	}
 
+	config.Region = common.ResolveRegion(ctx.Integration, config.Region)
+
 	region := strings.TrimSpace(config.Region)
 	if region == "" {
 		return fmt.Errorf("region is required")
@@ -180,12 +174,14 @@ func (c *ScanImage) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to decode configuration: %w", err)
 	}
 
-	creds, err := common.CredentialsFromInstallation(ctx.Integration)
+	config.Region = common.ResolveRegion(ctx.Integration, config.Region)
+
+	creds, credentialsVersion, err := common.VersionedCredentialsFromInstallation(ctx.Integration)
 	if err != nil {
 		return fmt.Errorf("failed to get AWS credentials: %w", err)
 	}
 
-	client := NewClient(ctx.HTTP, creds, config.Region)
+	client := NewClient(ctx.HTTP, creds, config.Region, common.EndpointOverrideFor(ctx.Integration, common.ServiceECR))
 	response, err := client.ScanImage(config.Repository, config.ImageDigest, config.ImageTag)
 	if err != nil {
 		return fmt.Errorf("failed to scan image: %w", err)
@@ -196,9 +192,10 @@ func (c *ScanImage) Execute(ctx core.ExecutionContext) error {
 	//
 	if response.ScanStatus.Status != "COMPLETE" {
 		err = ctx.Metadata.Set(ScanImageMetadata{
-			Region:      config.Region,
-			Repository:  config.Repository,
-			ImageDigest: config.ImageDigest,
+			Region:             config.Region,
+			Repository:         config.Repository,
+			ImageDigest:        config.ImageDigest,
+			CredentialsVersion: credentialsVersion,
 		})
 
 		if err != nil {
@@ -250,12 +247,12 @@ func (c *ScanImage) pollFindings(ctx core.ActionContext) error {
 		return fmt.Errorf("failed to decode metadata: %w", err)
 	}
 
-	creds, err := common.CredentialsFromInstallation(ctx.Integration)
+	creds, err := common.ResumeCredentials(ctx.Integration, metadata.CredentialsVersion)
 	if err != nil {
 		return fmt.Errorf("failed to get AWS credentials: %w", err)
 	}
 
-	client := NewClient(ctx.HTTP, creds, metadata.Region)
+	client := NewClient(ctx.HTTP, creds, metadata.Region, common.EndpointOverrideFor(ctx.Integration, common.ServiceECR))
 	findings, err := client.DescribeImageScanFindings(metadata.Repository, metadata.ImageDigest, "")
 	if err != nil {
 		return fmt.Errorf("failed to describe image scan findings: %w", err)
@@ -0,0 +1,188 @@
+package ecs
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
+)
+
+const (
+	targetPrefix = "AmazonEC2ContainerServiceV20141113."
+
+	//
+	// ECS does not accept more than 100 tasks
+	// in a single ListTasks or DescribeTasks call.
+	//
+	maxTasksPerRequest = 100
+)
+
+type Client struct {
+	http        core.HTTPContext
+	region      string
+	endpoint    string
+	credentials *aws.Credentials
+	signer      *v4.Signer
+	retry       common.RetryPolicy
+}
+
+/*
+ * If endpoint is empty, the public regional endpoint is used.
+ */
+func NewClient(httpCtx core.HTTPContext, credentials *aws.Credentials, region string, endpoint string) *Client {
+	if endpoint == "" {
+		endpoint = common.Endpoint("ecs", region) + "/"
+	}
+
+	return &Client{
+		http:        httpCtx,
+		region:      region,
+		endpoint:    endpoint,
+		credentials: credentials,
+		signer:      v4.NewSigner(),
+		retry:       common.DefaultRetryPolicy,
+	}
+}
+
+type Task struct {
+	TaskArn       string `json:"taskArn" mapstructure:"taskArn"`
+	ClusterArn    string `json:"clusterArn" mapstructure:"clusterArn"`
+	LastStatus    string `json:"lastStatus" mapstructure:"lastStatus"`
+	DesiredStatus string `json:"desiredStatus" mapstructure:"desiredStatus"`
+	StoppedReason string `json:"stoppedReason,omitempty" mapstructure:"stoppedReason"`
+}
+
+/*
+ * Lists the ARNs of the running tasks started by a service.
+ */
+func (c *Client) ListServiceTasks(cluster string, service string) ([]string, error) {
+	taskArns := []string{}
+	nextToken := ""
+
+	for {
+		payload := map[string]any{
+			"cluster":       cluster,
+			"serviceName":   service,
+			"desiredStatus": "RUNNING",
+			"maxResults":    maxTasksPerRequest,
+		}
+		if nextToken != "" {
+			payload["nextToken"] = nextToken
+		}
+
+		var response struct {
+			TaskArns  []string `json:"taskArns"`
+			NextToken string   `json:"nextToken"`
+		}
+
+		if err := c.postJSON("ListTasks", payload, &response); err != nil {
+			return nil, err
+		}
+
+		taskArns = append(taskArns, response.TaskArns...)
+		if response.NextToken == "" {
+			break
+		}
+		nextToken = response.NextToken
+	}
+
+	return taskArns, nil
+}
+
+func (c *Client) DescribeTasks(cluster string, taskArns []string) ([]Task, error) {
+	tasks := []Task{}
+
+	for start := 0; start < len(taskArns); start += maxTasksPerRequest {
+		end := min(start+maxTasksPerRequest, len(taskArns))
+		payload := map[string]any{
+			"cluster": cluster,
+			"tasks":   taskArns[start:end],
+		}
+
+		var response struct {
+			Tasks []Task `json:"tasks"`
+		}
+
+		if err := c.postJSON("DescribeTasks", payload, &response); err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, response.Tasks...)
+	}
+
+	return tasks, nil
+}
+
+func (c *Client) StopTask(cluster string, taskArn string, reason string) (*Task, error) {
+	payload := map[string]any{
+		"cluster": cluster,
+		"task":    taskArn,
+	}
+	if reason != "" {
+		payload["reason"] = reason
+	}
+
+	var response struct {
+		Task Task `json:"task"`
+	}
+
+	if err := c.postJSON("StopTask", payload, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Task, nil
+}
+
+func (c *Client) postJSON(action string, payload any, out any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	res, err := common.DoWithRetry(c.http, c.retry, true, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(c.http.Context(), http.MethodPost, c.endpoint, bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("failed to build request: %w", err)
+		}
+
+		req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+		req.Header.Set("X-Amz-Target", targetPrefix+action)
+		return req, c.signRequest(req, body)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		if awsErr := common.ParseError(res.Body); awsErr != nil {
+			return res.Failed(awsErr)
+		}
+		return res.Failed(fmt.Errorf("ECS API request failed with %d: %s", res.StatusCode, string(res.Body)))
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(res.Body, out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) signRequest(req *http.Request, payload []byte) error {
+	hash := sha256.Sum256(payload)
+	payloadHash := hex.EncodeToString(hash[:])
+	return c.signer.SignHTTP(context.Background(), *c.credentials, req, payloadHash, "ecs", c.region, time.Now())
+}
@@ -0,0 +1,18 @@
+package ecs
+
+import (
+	_ "embed"
+	"sync"
+
+	"github.com/superplanehq/superplane/pkg/utils"
+)
+
+//go:embed example_output_stop_service_tasks.json
+var exampleOutputStopServiceTasksBytes []byte
+
+var exampleOutputStopServiceTasksOnce sync.Once
+var exampleOutputStopServiceTasks map[string]any
+
+func (c *StopServiceTasks) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleOutputStopServiceTasksOnce, exampleOutputStopServiceTasksBytes, &exampleOutputStopServiceTasks)
+}
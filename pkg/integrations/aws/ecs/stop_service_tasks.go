@@ -0,0 +1,393 @@
+package ecs
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const (
+	StopServiceTasksPayloadType    = "aws.ecs.service.tasksStopped"
+	StopServiceTasksPollAction     = "pollTasks"
+	StopServiceTasksPollInterval   = 10 * time.Second
+	DefaultStopServiceTasksReason  = "Stopped by SuperPlane"
+	DefaultStopServiceTasksMax     = 50
+	MaxStopServiceTasks            = 500
+	MinStopServiceTasksTimeout     = 10
+	DefaultStopServiceTasksTimeout = 600
+	MaxStopServiceTasksTimeout     = 3600
+	TaskStatusStopped              = "STOPPED"
+)
+
+type StopServiceTasks struct{}
+
+type StopServiceTasksConfiguration struct {
+	Region         string `json:"region" mapstructure:"region"`
+	Cluster        string `json:"cluster" mapstructure:"cluster"`
+	Service        string `json:"service" mapstructure:"service"`
+	Reason         string `json:"reason" mapstructure:"reason"`
+	MaxTasks       int    `json:"maxTasks" mapstructure:"maxTasks"`
+	TimeoutSeconds int    `json:"timeoutSeconds" mapstructure:"timeoutSeconds"`
+}
+
+type StopServiceTasksMetadata struct {
+	Region       string           `json:"region" mapstructure:"region"`
+	Cluster      string           `json:"cluster" mapstructure:"cluster"`
+	Service      string           `json:"service" mapstructure:"service"`
+	Reason       string           `json:"reason" mapstructure:"reason"`
+	TaskArns     []string         `json:"taskArns" mapstructure:"taskArns"`
+	FailedTasks  []FailedTaskStop `json:"failedTasks" mapstructure:"failedTasks"`
+	SkippedTasks int              `json:"skippedTasks" mapstructure:"skippedTasks"`
+	Deadline     string           `json:"deadline" mapstructure:"deadline"`
+
+	//
+	// Version of the credentials the tasks were stopped with,
+	// used again while polling, as long as it is readable.
+	//
+	CredentialsVersion int `json:"credentialsVersion,omitempty" mapstructure:"credentialsVersion"`
+}
+
+type FailedTaskStop struct {
+	TaskArn string `json:"taskArn" mapstructure:"taskArn"`
+	Error   string `json:"error" mapstructure:"error"`
+}
+
+func (c *StopServiceTasks) Name() string {
+	return "aws.ecs.stopServiceTasks"
+}
+
+func (c *StopServiceTasks) Label() string {
+	return "ECS • Stop Service Tasks"
+}
+
+func (c *StopServiceTasks) Description() string {
+	return "Stop all running tasks of an ECS service and wait for them to stop"
+}
+
+func (c *StopServiceTasks) Documentation() string {
+	return `The Stop Service Tasks component stops every running task of an ECS service and waits for all of them to reach the STOPPED state.
+
+## Use Cases
+
+- **Incident response**: Stop all tasks of a misbehaving service
+- **Forced restarts**: Make the service replace all of its tasks at once
+
+## How It Works
+
+1. Lists the running tasks of the service
+2. Sends a StopTask request for each of them, with the configured reason
+3. Polls the tasks every 10 seconds until all of them are STOPPED
+4. Emits a summary with the ARNs of the stopped tasks. If the tasks do not stop before the timeout, the execution fails.
+
+The service scheduler starts new tasks to replace the stopped ones, unless the desired count of the service is 0.
+
+## Configuration
+
+- **Region**: AWS region of the ECS cluster. Defaults to the integration region
+- **Cluster**: Name or ARN of the ECS cluster
+- **Service**: Name of the ECS service
+- **Reason**: Reason recorded on the stopped tasks
+- **Max Tasks**: Maximum number of tasks stopped by a single execution. Tasks above this limit are left running and counted in the output
+- **Timeout (seconds)**: How long to wait for the tasks to stop`
+}
+
+func (c *StopServiceTasks) Icon() string {
+	return "aws"
+}
+
+func (c *StopServiceTasks) Color() string {
+	return "gray"
+}
+
+func (c *StopServiceTasks) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *StopServiceTasks) Configuration() []configuration.Field {
+	minTasks := 1
+	maxTasks := MaxStopServiceTasks
+	minTimeout := MinStopServiceTasksTimeout
+	maxTimeout := MaxStopServiceTasksTimeout
+
+	return []configuration.Field{
+		{
+			Name:        "region",
+			Label:       "Region",
+			Type:        configuration.FieldTypeSelect,
+			Required:    false,
+			Description: "AWS region. Defaults to the integration region",
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: common.AllRegions,
+				},
+			},
+		},
+		{
+			Name:        "cluster",
+			Label:       "Cluster",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Description: "ECS cluster name or ARN",
+			Placeholder: "production",
+		},
+		{
+			Name:        "service",
+			Label:       "Service",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Description: "ECS service name",
+			Placeholder: "api",
+		},
+		{
+			Name:        "reason",
+			Label:       "Reason",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Default:     DefaultStopServiceTasksReason,
+			Description: "Reason recorded on the stopped tasks",
+		},
+		{
+			Name:        "maxTasks",
+			Label:       "Max Tasks",
+			Type:        configuration.FieldTypeNumber,
+			Required:    false,
+			Default:     DefaultStopServiceTasksMax,
+			Description: "Maximum number of tasks stopped by a single execution",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: &minTasks,
+					Max: &maxTasks,
+				},
+			},
+		},
+		{
+			Name:        "timeoutSeconds",
+			Label:       "Timeout (seconds)",
+			Type:        configuration.FieldTypeNumber,
+			Required:    false,
+			Default:     DefaultStopServiceTasksTimeout,
+			Description: "How long to wait for the tasks to stop",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: &minTimeout,
+					Max: &maxTimeout,
+				},
+			},
+		},
+	}
+}
+
+func (c *StopServiceTasks) Setup(ctx core.SetupContext) error {
+	config, err := decodeStopServiceTasksConfiguration(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	if common.ResolveRegion(ctx.Integration, config.Region) == "" {
+		return fmt.Errorf("region is required")
+	}
+
+	if config.Cluster == "" {
+		return fmt.Errorf("cluster is required")
+	}
+
+	if config.Service == "" {
+		return fmt.Errorf("service is required")
+	}
+
+	if config.MaxTasks < 1 || config.MaxTasks > MaxStopServiceTasks {
+		return fmt.Errorf("max tasks must be between 1 and %d", MaxStopServiceTasks)
+	}
+
+	if config.TimeoutSeconds < MinStopServiceTasksTimeout || config.TimeoutSeconds > MaxStopServiceTasksTimeout {
+		return fmt.Errorf("timeout must be between %d and %d seconds", MinStopServiceTasksTimeout, MaxStopServiceTasksTimeout)
+	}
+
+	return nil
+}
+
+func (c *StopServiceTasks) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *StopServiceTasks) Execute(ctx core.ExecutionContext) error {
+	config, err := decodeStopServiceTasksConfiguration(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	config.Region = common.ResolveRegion(ctx.Integration, config.Region)
+
+	creds, credentialsVersion, err := common.VersionedCredentialsFromInstallation(ctx.Integration)
+	if err != nil {
+		return fmt.Errorf("failed to get AWS credentials: %w", err)
+	}
+
+	client := NewClient(ctx.HTTP, creds, config.Region, common.EndpointOverrideFor(ctx.Integration, common.ServiceECS))
+	taskArns, err := client.ListServiceTasks(config.Cluster, config.Service)
+	if err != nil {
+		return fmt.Errorf("failed to list service tasks: %w", err)
+	}
+
+	metadata := StopServiceTasksMetadata{
+		Region:             config.Region,
+		CredentialsVersion: credentialsVersion,
+		Cluster:            config.Cluster,
+		Service:            config.Service,
+		Reason:             config.Reason,
+		TaskArns:           []string{},
+		FailedTasks:        []FailedTaskStop{},
+		SkippedTasks:       max(len(taskArns)-config.MaxTasks, 0),
+		Deadline:           time.Now().Add(time.Duration(config.TimeoutSeconds) * time.Second).Format(time.RFC3339),
+	}
+
+	for _, taskArn := range taskArns[:min(len(taskArns), config.MaxTasks)] {
+		if _, err := client.StopTask(config.Cluster, taskArn, config.Reason); err != nil {
+			metadata.FailedTasks = append(metadata.FailedTasks, FailedTaskStop{TaskArn: taskArn, Error: err.Error()})
+			continue
+		}
+
+		metadata.TaskArns = append(metadata.TaskArns, taskArn)
+	}
+
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return fmt.Errorf("failed to set metadata: %w", err)
+	}
+
+	if len(metadata.TaskArns) == 0 && len(metadata.FailedTasks) > 0 {
+		return fmt.Errorf("failed to stop tasks: %s", metadata.FailedTasks[0].Error)
+	}
+
+	//
+	// Nothing to wait for if the service has no running tasks.
+	//
+	if len(metadata.TaskArns) == 0 {
+		return emitStoppedTasks(ctx.ExecutionState, metadata)
+	}
+
+	return ctx.Requests.ScheduleActionCall(StopServiceTasksPollAction, map[string]any{}, StopServiceTasksPollInterval)
+}
+
+func (c *StopServiceTasks) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:        StopServiceTasksPollAction,
+			Description: "Poll the stopped tasks",
+		},
+	}
+}
+
+func (c *StopServiceTasks) HandleAction(ctx core.ActionContext) error {
+	switch ctx.Name {
+	case StopServiceTasksPollAction:
+		return c.pollTasks(ctx)
+
+	default:
+		return fmt.Errorf("unknown action: %s", ctx.Name)
+	}
+}
+
+func (c *StopServiceTasks) pollTasks(ctx core.ActionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	metadata := StopServiceTasksMetadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	creds, err := common.ResumeCredentials(ctx.Integration, metadata.CredentialsVersion)
+	if err != nil {
+		return fmt.Errorf("failed to get AWS credentials: %w", err)
+	}
+
+	client := NewClient(ctx.HTTP, creds, metadata.Region, common.EndpointOverrideFor(ctx.Integration, common.ServiceECS))
+	tasks, err := client.DescribeTasks(metadata.Cluster, metadata.TaskArns)
+	if err != nil {
+		return fmt.Errorf("failed to describe tasks: %w", err)
+	}
+
+	//
+	// Tasks that are no longer returned by ECS
+	// were stopped long enough ago to be removed.
+	//
+	running := []string{}
+	for _, task := range tasks {
+		if task.LastStatus != TaskStatusStopped {
+			running = append(running, task.TaskArn)
+		}
+	}
+
+	if len(running) == 0 {
+		return emitStoppedTasks(ctx.ExecutionState, metadata)
+	}
+
+	deadline, err := time.Parse(time.RFC3339, metadata.Deadline)
+	if err != nil {
+		return fmt.Errorf("failed to parse deadline: %w", err)
+	}
+
+	if time.Now().After(deadline) {
+		return ctx.ExecutionState.Fail(
+			models.CanvasNodeExecutionResultReasonError,
+			fmt.Sprintf("Timed out waiting for %d tasks to stop: %s", len(running), strings.Join(running, ", ")),
+		)
+	}
+
+	return ctx.Requests.ScheduleActionCall(StopServiceTasksPollAction, map[string]any{}, StopServiceTasksPollInterval)
+}
+
+func emitStoppedTasks(state core.ExecutionStateContext, metadata StopServiceTasksMetadata) error {
+	return state.Emit(
+		core.DefaultOutputChannel.Name,
+		StopServiceTasksPayloadType,
+		[]any{
+			map[string]any{
+				"cluster":         metadata.Cluster,
+				"service":         metadata.Service,
+				"reason":          metadata.Reason,
+				"stoppedTaskArns": metadata.TaskArns,
+				"failedTasks":     metadata.FailedTasks,
+				"skippedTasks":    metadata.SkippedTasks,
+			},
+		},
+	)
+}
+
+func decodeStopServiceTasksConfiguration(rawConfiguration any) (*StopServiceTasksConfiguration, error) {
+	config := StopServiceTasksConfiguration{
+		Reason:         DefaultStopServiceTasksReason,
+		MaxTasks:       DefaultStopServiceTasksMax,
+		TimeoutSeconds: DefaultStopServiceTasksTimeout,
+	}
+
+	if err := mapstructure.Decode(rawConfiguration, &config); err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	config.Cluster = strings.TrimSpace(config.Cluster)
+	config.Service = strings.TrimSpace(config.Service)
+	config.Reason = strings.TrimSpace(config.Reason)
+	return &config, nil
+}
+
+func (c *StopServiceTasks) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (c *StopServiceTasks) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *StopServiceTasks) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,363 @@
+package ecs
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func jsonResponse(body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func testIntegration() *contexts.IntegrationContext {
+	return &contexts.IntegrationContext{
+		Secrets: map[string]core.IntegrationSecret{
+			"accessKeyId":     {Name: "accessKeyId", Value: []byte("key")},
+			"secretAccessKey": {Name: "secretAccessKey", Value: []byte("secret")},
+			"sessionToken":    {Name: "sessionToken", Value: []byte("token")},
+		},
+	}
+}
+
+func requestPayload(t *testing.T, request *http.Request) map[string]any {
+	body, err := io.ReadAll(request.Body)
+	require.NoError(t, err)
+
+	payload := map[string]any{}
+	require.NoError(t, json.Unmarshal(body, &payload))
+	return payload
+}
+
+func Test__StopServiceTasks__Setup(t *testing.T) {
+	component := &StopServiceTasks{}
+
+	t.Run("invalid configuration -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: "invalid",
+		})
+
+		require.ErrorContains(t, err, "failed to decode configuration")
+	})
+
+	t.Run("missing cluster -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{
+				"region":  "us-east-1",
+				"service": "api",
+			},
+		})
+
+		require.ErrorContains(t, err, "cluster is required")
+	})
+
+	t.Run("missing service -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{
+				"region":  "us-east-1",
+				"cluster": "production",
+			},
+		})
+
+		require.ErrorContains(t, err, "service is required")
+	})
+
+	t.Run("max tasks out of range -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{
+				"region":   "us-east-1",
+				"cluster":  "production",
+				"service":  "api",
+				"maxTasks": MaxStopServiceTasks + 1,
+			},
+		})
+
+		require.ErrorContains(t, err, "max tasks must be between")
+	})
+
+	t.Run("valid configuration -> ok", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{
+				"region":  "us-east-1",
+				"cluster": "production",
+				"service": "api",
+			},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func Test__StopServiceTasks__Execute(t *testing.T) {
+	component := &StopServiceTasks{}
+
+	t.Run("stops every running task and waits for them", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				jsonResponse(`{"taskArns": ["task-1", "task-2"], "nextToken": "next"}`),
+				jsonResponse(`{"taskArns": ["task-3"]}`),
+				jsonResponse(`{"task": {"taskArn": "task-1", "desiredStatus": "STOPPED"}}`),
+				jsonResponse(`{"task": {"taskArn": "task-2", "desiredStatus": "STOPPED"}}`),
+				jsonResponse(`{"task": {"taskArn": "task-3", "desiredStatus": "STOPPED"}}`),
+			},
+		}
+
+		metadata := &contexts.MetadataContext{}
+		requests := &contexts.RequestContext{}
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"region":  "us-east-1",
+				"cluster": "production",
+				"service": "api",
+				"reason":  "incident",
+			},
+			HTTP:           httpContext,
+			Metadata:       metadata,
+			Requests:       requests,
+			ExecutionState: execState,
+			Integration:    testIntegration(),
+		})
+
+		require.NoError(t, err)
+		assert.False(t, execState.Finished)
+		assert.Equal(t, StopServiceTasksPollAction, requests.Action)
+		assert.Equal(t, StopServiceTasksPollInterval, requests.Duration)
+
+		require.Len(t, httpContext.Requests, 5)
+		assert.Equal(t, "https://ecs.us-east-1.amazonaws.com/", httpContext.Requests[0].URL.String())
+		assert.Equal(t, targetPrefix+"ListTasks", httpContext.Requests[0].Header.Get("X-Amz-Target"))
+		listPayload := requestPayload(t, httpContext.Requests[0])
+		assert.Equal(t, "api", listPayload["serviceName"])
+		assert.Equal(t, "RUNNING", listPayload["desiredStatus"])
+		assert.Equal(t, "next", requestPayload(t, httpContext.Requests[1])["nextToken"])
+
+		for i, taskArn := range []string{"task-1", "task-2", "task-3"} {
+			request := httpContext.Requests[i+2]
+			assert.Equal(t, targetPrefix+"StopTask", request.Header.Get("X-Amz-Target"))
+			payload := requestPayload(t, request)
+			assert.Equal(t, "production", payload["cluster"])
+			assert.Equal(t, taskArn, payload["task"])
+			assert.Equal(t, "incident", payload["reason"])
+		}
+
+		stored, ok := metadata.Metadata.(StopServiceTasksMetadata)
+		require.True(t, ok)
+		assert.Equal(t, []string{"task-1", "task-2", "task-3"}, stored.TaskArns)
+		assert.Equal(t, 0, stored.SkippedTasks)
+	})
+
+	t.Run("tasks above the limit are not stopped", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				jsonResponse(`{"taskArns": ["task-1", "task-2", "task-3"]}`),
+				jsonResponse(`{"task": {"taskArn": "task-1"}}`),
+			},
+		}
+
+		metadata := &contexts.MetadataContext{}
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"region":   "us-east-1",
+				"cluster":  "production",
+				"service":  "api",
+				"maxTasks": 1,
+			},
+			HTTP:           httpContext,
+			Metadata:       metadata,
+			Requests:       &contexts.RequestContext{},
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			Integration:    testIntegration(),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, httpContext.Requests, 2)
+		stored, ok := metadata.Metadata.(StopServiceTasksMetadata)
+		require.True(t, ok)
+		assert.Equal(t, []string{"task-1"}, stored.TaskArns)
+		assert.Equal(t, 2, stored.SkippedTasks)
+	})
+
+	t.Run("no running tasks -> emits right away", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				jsonResponse(`{"taskArns": []}`),
+			},
+		}
+
+		requests := &contexts.RequestContext{}
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"region":  "us-east-1",
+				"cluster": "production",
+				"service": "api",
+			},
+			HTTP:           httpContext,
+			Metadata:       &contexts.MetadataContext{},
+			Requests:       requests,
+			ExecutionState: execState,
+			Integration:    testIntegration(),
+		})
+
+		require.NoError(t, err)
+		assert.True(t, execState.Passed)
+		assert.Equal(t, StopServiceTasksPayloadType, execState.Type)
+		assert.Empty(t, requests.Action)
+	})
+}
+
+func Test__StopServiceTasks__HandleAction(t *testing.T) {
+	component := &StopServiceTasks{}
+
+	metadata := func(deadline time.Time) *contexts.MetadataContext {
+		return &contexts.MetadataContext{
+			Metadata: StopServiceTasksMetadata{
+				Region:   "us-east-1",
+				Cluster:  "production",
+				Service:  "api",
+				TaskArns: []string{"task-1", "task-2"},
+				Deadline: deadline.Format(time.RFC3339),
+			},
+		}
+	}
+
+	t.Run("unknown action -> error", func(t *testing.T) {
+		err := component.HandleAction(core.ActionContext{
+			Name: "unknown",
+		})
+
+		require.ErrorContains(t, err, "unknown action")
+	})
+
+	t.Run("tasks still stopping -> schedules poll", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				jsonResponse(`{"tasks": [
+					{"taskArn": "task-1", "lastStatus": "STOPPED"},
+					{"taskArn": "task-2", "lastStatus": "DEACTIVATING"}
+				]}`),
+			},
+		}
+
+		requests := &contexts.RequestContext{}
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.HandleAction(core.ActionContext{
+			Name:           StopServiceTasksPollAction,
+			HTTP:           httpContext,
+			Requests:       requests,
+			ExecutionState: execState,
+			Metadata:       metadata(time.Now().Add(time.Minute)),
+			Integration:    testIntegration(),
+		})
+
+		require.NoError(t, err)
+		assert.False(t, execState.Finished)
+		assert.Equal(t, StopServiceTasksPollAction, requests.Action)
+
+		require.Len(t, httpContext.Requests, 1)
+		assert.Equal(t, targetPrefix+"DescribeTasks", httpContext.Requests[0].Header.Get("X-Amz-Target"))
+		assert.Equal(t, []any{"task-1", "task-2"}, requestPayload(t, httpContext.Requests[0])["tasks"])
+	})
+
+	t.Run("credentials rotated since execution -> polls with the recorded version", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{Secrets: map[string]core.IntegrationSecret{}}
+		first, err := common.StoreCredentials(integrationCtx, "AKIA_1", "secret-1", "token-1", time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		_, err = common.StoreCredentials(integrationCtx, "AKIA_2", "secret-2", "token-2", time.Now().Add(2*time.Hour))
+		require.NoError(t, err)
+
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				jsonResponse(`{"tasks": [{"taskArn": "task-1", "lastStatus": "RUNNING"}]}`),
+			},
+		}
+
+		metadataCtx := metadata(time.Now().Add(time.Minute))
+		stored := metadataCtx.Metadata.(StopServiceTasksMetadata)
+		stored.CredentialsVersion = first.Version
+		metadataCtx.Metadata = stored
+
+		err = component.HandleAction(core.ActionContext{
+			Name:           StopServiceTasksPollAction,
+			HTTP:           httpContext,
+			Requests:       &contexts.RequestContext{},
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			Metadata:       metadataCtx,
+			Integration:    integrationCtx,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, httpContext.Requests, 1)
+		assert.Equal(t, "token-1", httpContext.Requests[0].Header.Get("X-Amz-Security-Token"))
+	})
+
+	t.Run("all tasks stopped -> emits summary", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				jsonResponse(`{"tasks": [
+					{"taskArn": "task-1", "lastStatus": "STOPPED"},
+					{"taskArn": "task-2", "lastStatus": "STOPPED"}
+				]}`),
+			},
+		}
+
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.HandleAction(core.ActionContext{
+			Name:           StopServiceTasksPollAction,
+			HTTP:           httpContext,
+			Requests:       &contexts.RequestContext{},
+			ExecutionState: execState,
+			Metadata:       metadata(time.Now().Add(time.Minute)),
+			Integration:    testIntegration(),
+		})
+
+		require.NoError(t, err)
+		assert.True(t, execState.Passed)
+		require.Len(t, execState.Payloads, 1)
+		payload := execState.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, []string{"task-1", "task-2"}, payload["stoppedTaskArns"])
+	})
+
+	t.Run("tasks not stopped before the deadline -> fails", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				jsonResponse(`{"tasks": [
+					{"taskArn": "task-1", "lastStatus": "STOPPED"},
+					{"taskArn": "task-2", "lastStatus": "RUNNING"}
+				]}`),
+			},
+		}
+
+		requests := &contexts.RequestContext{}
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.HandleAction(core.ActionContext{
+			Name:           StopServiceTasksPollAction,
+			HTTP:           httpContext,
+			Requests:       requests,
+			ExecutionState: execState,
+			Metadata:       metadata(time.Now().Add(-time.Minute)),
+			Integration:    testIntegration(),
+		})
+
+		require.NoError(t, err)
+		assert.True(t, execState.Finished)
+		assert.False(t, execState.Passed)
+		assert.Equal(t, models.CanvasNodeExecutionResultReasonError, execState.FailureReason)
+		assert.Contains(t, execState.FailureMessage, "task-2")
+		assert.Empty(t, requests.Action)
+	})
+}
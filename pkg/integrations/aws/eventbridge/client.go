@@ -7,8 +7,8 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -21,11 +21,18 @@ const (
 	TargetPrefix = "AWSEvents."
 )
 
+/*
+ * Actions that create a new resource on every call,
+ * so they are only retried when AWS throttles them.
+ */
+var nonIdempotentActions = []string{"CreateConnection", "CreateApiDestination"}
+
 type Client struct {
 	http        core.HTTPContext
 	region      string
 	credentials *aws.Credentials
 	signer      *v4.Signer
+	retry       common.RetryPolicy
 }
 
 type Target struct {
@@ -40,6 +47,7 @@ func NewClient(httpCtx core.HTTPContext, credentials *aws.Credentials, region st
 		region:      region,
 		credentials: credentials,
 		signer:      v4.NewSigner(),
+		retry:       common.DefaultRetryPolicy,
 	}
 }
 
@@ -71,6 +79,33 @@ func (c *Client) CreateConnection(name, apiKeyHeader, apiKeyValue string, tags [
 	return response.ConnectionArn, nil
 }
 
+/*
+ * Replaces the API key EventBridge sends to the API destinations of the connection.
+ */
+func (c *Client) UpdateConnection(name, apiKeyHeader, apiKeyValue string) (string, error) {
+	payload := map[string]any{
+		"Name":              name,
+		"AuthorizationType": "API_KEY",
+		"AuthParameters": map[string]any{
+			"ApiKeyAuthParameters": map[string]any{
+				"ApiKeyName":  apiKeyHeader,
+				"ApiKeyValue": apiKeyValue,
+			},
+		},
+	}
+
+	var response struct {
+		ConnectionArn string `json:"ConnectionArn"`
+	}
+
+	err := c.postJSON("UpdateConnection", payload, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.ConnectionArn, nil
+}
+
 func (c *Client) DescribeConnection(name string) (string, error) {
 	payload := map[string]any{"Name": name}
 	var response struct {
@@ -153,6 +188,19 @@ func (c *Client) PutRule(name, pattern string, tags []common.Tag) (string, error
 	return response.RuleArn, nil
 }
 
+func (c *Client) DescribeRule(name string) (string, error) {
+	payload := map[string]any{"Name": name}
+	var response struct {
+		Arn string `json:"Arn"`
+	}
+
+	if err := c.postJSON("DescribeRule", payload, &response); err != nil {
+		return "", err
+	}
+
+	return response.Arn, nil
+}
+
 func (c *Client) PutTargets(rule string, targets []Target) error {
 	payload := map[string]any{
 		"Rule":    rule,
@@ -185,44 +233,35 @@ func (c *Client) postJSON(action string, payload any, out any) error {
 		return fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	endpoint := fmt.Sprintf("https://events.%s.amazonaws.com/", c.region)
-	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
-	if err != nil {
-		return fmt.Errorf("failed to build request: %w", err)
-	}
+	endpoint := common.Endpoint("events", c.region) + "/"
+	idempotent := !slices.Contains(nonIdempotentActions, action)
+	res, err := common.DoWithRetry(c.http, c.retry, idempotent, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(c.http.Context(), http.MethodPost, endpoint, bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("failed to build request: %w", err)
+		}
 
-	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
-	req.Header.Set("X-Amz-Target", TargetPrefix+action)
+		req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+		req.Header.Set("X-Amz-Target", TargetPrefix+action)
+		return req, c.signRequest(req, body)
+	})
 
-	err = c.signRequest(req, body)
 	if err != nil {
 		return err
 	}
 
-	res, err := c.http.Do(req)
-	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
-	}
-
-	defer res.Body.Close()
-
-	responseBody, err := io.ReadAll(res.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
-	}
-
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		if awsErr := common.ParseError(responseBody); awsErr != nil {
-			return awsErr
+		if awsErr := common.ParseError(res.Body); awsErr != nil {
+			return res.Failed(awsErr)
 		}
-		return fmt.Errorf("EventBridge API request failed with %d: %s", res.StatusCode, string(responseBody))
+		return res.Failed(fmt.Errorf("EventBridge API request failed with %d: %s", res.StatusCode, string(res.Body)))
 	}
 
 	if out == nil {
 		return nil
 	}
 
-	err = json.Unmarshal(responseBody, out)
+	err = json.Unmarshal(res.Body, out)
 	if err != nil {
 		return fmt.Errorf("failed to decode response: %w", err)
 	}
@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/eventbridge"
+)
+
+const (
+	HealthCheckCredentials       = "credentials"
+	HealthCheckCallerIdentity    = "sts:GetCallerIdentity"
+	HealthCheckEventBridgeAccess = "events:DescribeRule"
+
+	/*
+	 * Rule name used to check EventBridge permissions
+	 * when the integration has not created any rules yet.
+	 */
+	healthCheckProbeSource = "superplane.healthcheck"
+)
+
+/*
+ * Runs a set of read-only checks against AWS, and stores
+ * the result in the integration metadata, so the UI can show it.
+ * Failed checks are not returned as errors, since the action
+ * itself succeeded in determining the integration status.
+ */
+func (a *AWS) handleHealthCheck(ctx core.IntegrationActionContext) error {
+	metadata := common.IntegrationMetadata{}
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %v", err)
+	}
+
+	checks := a.runHealthChecks(ctx, &metadata)
+	healthy := !slices.ContainsFunc(checks, func(check common.HealthCheckResult) bool {
+		return !check.Healthy
+	})
+
+	metadata.HealthCheck = &common.HealthCheckMetadata{
+		Healthy:   healthy,
+		CheckedAt: time.Now().UTC().Format(time.RFC3339),
+		Checks:    checks,
+	}
+
+	if !healthy {
+		ctx.Logger.Warnf("AWS health check failed: %v", checks)
+	}
+
+	ctx.Integration.SetMetadata(metadata)
+	return nil
+}
+
+func (a *AWS) runHealthChecks(ctx core.IntegrationActionContext, metadata *common.IntegrationMetadata) []common.HealthCheckResult {
+	credentials, err := common.CredentialsFromInstallation(ctx.Integration)
+	if err != nil {
+		return []common.HealthCheckResult{
+			failedHealthCheck(HealthCheckCredentials, err),
+		}
+	}
+
+	return []common.HealthCheckResult{
+		{Name: HealthCheckCredentials, Healthy: true},
+		a.checkCallerIdentity(ctx, credentials, metadata),
+		a.checkEventBridgeAccess(ctx, credentials, metadata),
+	}
+}
+
+func (a *AWS) checkCallerIdentity(ctx core.IntegrationActionContext, credentials *aws.Credentials, metadata *common.IntegrationMetadata) common.HealthCheckResult {
+	identity, err := common.GetCallerIdentity(ctx.HTTP, credentials, common.RegionFromInstallation(ctx.Integration))
+	if err != nil {
+		return failedHealthCheck(HealthCheckCallerIdentity, err)
+	}
+
+	if metadata.Session != nil && metadata.Session.AccountID != "" && identity.Account != metadata.Session.AccountID {
+		return common.HealthCheckResult{
+			Name:    HealthCheckCallerIdentity,
+			Healthy: false,
+			Message: fmt.Sprintf("credentials belong to account %s, expected %s", identity.Account, metadata.Session.AccountID),
+		}
+	}
+
+	return common.HealthCheckResult{
+		Name:    HealthCheckCallerIdentity,
+		Healthy: true,
+		Message: fmt.Sprintf("authenticated as %s", identity.Arn),
+	}
+}
+
+/*
+ * DescribeRule is read-only, so it is used as a dry run
+ * to confirm the role still has access to EventBridge.
+ * If no rules were created yet, a rule that does not exist is described,
+ * and a not found error still means the call was authorized.
+ */
+func (a *AWS) checkEventBridgeAccess(ctx core.IntegrationActionContext, credentials *aws.Credentials, metadata *common.IntegrationMetadata) common.HealthCheckResult {
+	ruleName, region, err := a.healthCheckRule(ctx.Integration, metadata)
+	if err != nil {
+		return failedHealthCheck(HealthCheckEventBridgeAccess, err)
+	}
+
+	client := eventbridge.NewClient(ctx.HTTP, credentials, region)
+	_, err = client.DescribeRule(ruleName)
+	if err == nil || common.IsNotFoundErr(err) {
+		return common.HealthCheckResult{Name: HealthCheckEventBridgeAccess, Healthy: true}
+	}
+
+	if common.IsAccessDeniedErr(err) {
+		return common.HealthCheckResult{
+			Name:    HealthCheckEventBridgeAccess,
+			Healthy: false,
+			Message: fmt.Sprintf("missing EventBridge permissions: %v", err),
+		}
+	}
+
+	return failedHealthCheck(HealthCheckEventBridgeAccess, err)
+}
+
+func (a *AWS) healthCheckRule(integration core.IntegrationContext, metadata *common.IntegrationMetadata) (string, string, error) {
+	if metadata.EventBridge != nil && len(metadata.EventBridge.Rules) > 0 {
+		sources := make([]string, 0, len(metadata.EventBridge.Rules))
+		for source := range metadata.EventBridge.Rules {
+			sources = append(sources, source)
+		}
+
+		slices.Sort(sources)
+		rule := metadata.EventBridge.Rules[sources[0]]
+		return rule.Name, rule.Region, nil
+	}
+
+	region := common.RegionFromInstallation(integration)
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	ruleName, err := a.ruleName(integration, healthCheckProbeSource)
+	if err != nil {
+		return "", "", err
+	}
+
+	return ruleName, region, nil
+}
+
+func failedHealthCheck(name string, err error) common.HealthCheckResult {
+	return common.HealthCheckResult{
+		Name:    name,
+		Healthy: false,
+		Message: err.Error(),
+	}
+}
@@ -0,0 +1,161 @@
+package aws
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__AWS__HealthCheck(t *testing.T) {
+	a := &AWS{}
+
+	newIntegration := func() *contexts.IntegrationContext {
+		return &contexts.IntegrationContext{
+			Configuration: map[string]any{
+				"roleArn": "arn:aws:iam::123456789012:role/test-role",
+				"region":  "us-east-1",
+			},
+			Secrets: map[string]core.IntegrationSecret{
+				"accessKeyId":     {Name: "accessKeyId", Value: []byte("AKIA_TEST")},
+				"secretAccessKey": {Name: "secretAccessKey", Value: []byte("secret")},
+				"sessionToken":    {Name: "sessionToken", Value: []byte("token")},
+			},
+			Metadata: common.IntegrationMetadata{
+				Session: &common.SessionMetadata{
+					AccountID: "123456789012",
+				},
+				EventBridge: &common.EventBridgeMetadata{
+					Rules: map[string]common.EventBridgeRuleMetadata{
+						"aws.ecr": {
+							Name:    "superplane-test-ecr",
+							Source:  "aws.ecr",
+							Region:  "us-west-2",
+							RuleArn: "arn:aws:events:us-west-2:123456789012:rule/superplane-test-ecr",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	callerIdentityResponse := func() *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:sts::123456789012:assumed-role/test-role/superplane</Arn>
+    <UserId>AROAEXAMPLE:superplane</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+</GetCallerIdentityResponse>
+`)),
+		}
+	}
+
+	healthCheck := func(integrationCtx *contexts.IntegrationContext, httpContext *contexts.HTTPContext, logContext *contexts.LogContext) common.HealthCheckMetadata {
+		err := a.HandleAction(core.IntegrationActionContext{
+			Name:        "healthCheck",
+			Parameters:  map[string]any{},
+			Logger:      logContext.Logger(),
+			Integration: integrationCtx,
+			HTTP:        httpContext,
+		})
+
+		require.NoError(t, err)
+		metadata, ok := integrationCtx.Metadata.(common.IntegrationMetadata)
+		require.True(t, ok)
+		require.NotNil(t, metadata.HealthCheck)
+		return *metadata.HealthCheck
+	}
+
+	t.Run("all checks pass -> healthy", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				callerIdentityResponse(),
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"Name":"superplane-test-ecr","Arn":"arn:aws:events:us-west-2:123456789012:rule/superplane-test-ecr"}`)),
+				},
+			},
+		}
+
+		result := healthCheck(newIntegration(), httpContext, &contexts.LogContext{})
+		assert.True(t, result.Healthy)
+		assert.NotEmpty(t, result.CheckedAt)
+		require.Len(t, result.Checks, 3)
+		for _, check := range result.Checks {
+			assert.True(t, check.Healthy, check.Name)
+		}
+
+		assert.Equal(t, "authenticated as arn:aws:sts::123456789012:assumed-role/test-role/superplane", result.Checks[1].Message)
+
+		require.Len(t, httpContext.Requests, 2)
+		assert.Equal(t, "https://sts.us-east-1.amazonaws.com", httpContext.Requests[0].URL.String())
+		assert.Equal(t, "https://events.us-west-2.amazonaws.com/", httpContext.Requests[1].URL.String())
+		assert.Equal(t, "AWSEvents.DescribeRule", httpContext.Requests[1].Header.Get("X-Amz-Target"))
+	})
+
+	t.Run("missing EventBridge permissions -> unhealthy", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				callerIdentityResponse(),
+				{
+					StatusCode: http.StatusBadRequest,
+					Body:       io.NopCloser(strings.NewReader(`{"__type":"AccessDeniedException","message":"not authorized to perform: events:DescribeRule"}`)),
+				},
+			},
+		}
+
+		logContext := &contexts.LogContext{}
+		result := healthCheck(newIntegration(), httpContext, logContext)
+		assert.False(t, result.Healthy)
+		require.Len(t, logContext.Entries, 1)
+		assert.Equal(t, "warning", logContext.Entries[0].Level)
+		assert.Contains(t, logContext.Entries[0].Message, "AWS health check failed")
+		require.Len(t, result.Checks, 3)
+		assert.True(t, result.Checks[0].Healthy)
+		assert.True(t, result.Checks[1].Healthy)
+		assert.Equal(t, HealthCheckEventBridgeAccess, result.Checks[2].Name)
+		assert.False(t, result.Checks[2].Healthy)
+		assert.Contains(t, result.Checks[2].Message, "missing EventBridge permissions")
+	})
+
+	t.Run("no rules yet and rule not found -> healthy", func(t *testing.T) {
+		integrationCtx := newIntegration()
+		integrationCtx.Metadata = common.IntegrationMetadata{}
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				callerIdentityResponse(),
+				{
+					StatusCode: http.StatusBadRequest,
+					Body:       io.NopCloser(strings.NewReader(`{"__type":"ResourceNotFoundException","message":"Rule does not exist"}`)),
+				},
+			},
+		}
+
+		result := healthCheck(integrationCtx, httpContext, &contexts.LogContext{})
+		assert.True(t, result.Healthy)
+		require.Len(t, httpContext.Requests, 2)
+		assert.Equal(t, "https://events.us-east-1.amazonaws.com/", httpContext.Requests[1].URL.String())
+	})
+
+	t.Run("missing credentials -> unhealthy without calling AWS", func(t *testing.T) {
+		integrationCtx := newIntegration()
+		integrationCtx.Secrets = map[string]core.IntegrationSecret{}
+		httpContext := &contexts.HTTPContext{}
+
+		result := healthCheck(integrationCtx, httpContext, &contexts.LogContext{})
+		assert.False(t, result.Healthy)
+		require.Len(t, result.Checks, 1)
+		assert.Equal(t, HealthCheckCredentials, result.Checks[0].Name)
+		assert.Empty(t, httpContext.Requests)
+	})
+}
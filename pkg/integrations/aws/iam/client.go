@@ -7,9 +7,9 @@ import (
 	"encoding/xml"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -20,26 +20,39 @@ import (
 )
 
 const (
-	serviceName   = "iam"
-	defaultRegion = "us-east-1"
-	apiVersion    = "2010-05-08"
-	endpoint      = "https://iam.amazonaws.com/"
-	contentType   = "application/x-www-form-urlencoded; charset=utf-8"
+	serviceName = "iam"
+	apiVersion  = "2010-05-08"
+	contentType = "application/x-www-form-urlencoded; charset=utf-8"
 )
 
+/*
+ * Actions that create a new resource on every call,
+ * so they are only retried when AWS throttles them.
+ */
+var nonIdempotentActions = []string{"CreateRole"}
+
 type Client struct {
 	http        core.HTTPContext
+	endpoint    string
 	region      string
 	credentials *aws.Credentials
 	signer      *v4.Signer
+	retry       common.RetryPolicy
 }
 
-func NewClient(httpCtx core.HTTPContext, credentials *aws.Credentials) *Client {
+/*
+ * IAM has a single endpoint per partition,
+ * so the client is created for the partition of the roles it manages.
+ */
+func NewClient(httpCtx core.HTTPContext, credentials *aws.Credentials, partition string) *Client {
+	endpoint, region := common.IAMEndpoint(partition)
 	return &Client{
 		http:        httpCtx,
-		region:      defaultRegion,
+		endpoint:    endpoint,
+		region:      region,
 		credentials: credentials,
 		signer:      v4.NewSigner(),
+		retry:       common.DefaultRetryPolicy,
 	}
 }
 
@@ -100,6 +113,30 @@ func (c *Client) GetRole(name string) (string, error) {
 	return response.Arn, nil
 }
 
+/*
+ * IAM returns the trust policy of the role URL-encoded.
+ */
+func (c *Client) GetRoleTrustPolicy(name string) (string, error) {
+	params := map[string]string{
+		"RoleName": name,
+	}
+
+	var response struct {
+		AssumeRolePolicyDocument string `xml:"GetRoleResult>Role>AssumeRolePolicyDocument"`
+	}
+
+	if err := c.postForm("GetRole", params, &response); err != nil {
+		return "", err
+	}
+
+	policy, err := url.QueryUnescape(response.AssumeRolePolicyDocument)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode trust policy: %w", err)
+	}
+
+	return policy, nil
+}
+
 func (c *Client) PutRolePolicy(roleName, policyName, policyDocument string) error {
 	params := map[string]string{
 		"RoleName":       roleName,
@@ -136,41 +173,33 @@ func (c *Client) postForm(action string, params map[string]string, out any) erro
 	}
 
 	body := values.Encode()
-	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(body))
-	if err != nil {
-		return fmt.Errorf("failed to build request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", contentType)
-
-	if err := c.signRequest(req, []byte(body)); err != nil {
-		return err
-	}
-
-	res, err := c.http.Do(req)
-	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
-	}
+	idempotent := !slices.Contains(nonIdempotentActions, action)
+	res, err := common.DoWithRetry(c.http, c.retry, idempotent, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(c.http.Context(), http.MethodPost, c.endpoint, strings.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("failed to build request: %w", err)
+		}
 
-	defer res.Body.Close()
+		req.Header.Set("Content-Type", contentType)
+		return req, c.signRequest(req, []byte(body))
+	})
 
-	responseBody, err := io.ReadAll(res.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
+		return err
 	}
 
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		if awsErr := parseError(responseBody); awsErr != nil {
-			return awsErr
+		if awsErr := parseError(res.Body); awsErr != nil {
+			return res.Failed(awsErr)
 		}
-		return fmt.Errorf("IAM API request failed with %d: %s", res.StatusCode, string(responseBody))
+		return res.Failed(fmt.Errorf("IAM API request failed with %d: %s", res.StatusCode, string(res.Body)))
 	}
 
 	if out == nil {
 		return nil
 	}
 
-	if err := xml.Unmarshal(responseBody, out); err != nil {
+	if err := xml.Unmarshal(res.Body, out); err != nil {
 		return fmt.Errorf("failed to decode response: %w", err)
 	}
 
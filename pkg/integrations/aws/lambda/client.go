@@ -7,7 +7,6 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -16,20 +15,39 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
 )
 
 type Client struct {
 	http        core.HTTPContext
 	region      string
+	endpoint    string
 	credentials *aws.Credentials
 	signer      *v4.Signer
+	retry       common.RetryPolicy
 }
 
 type InvokeResult struct {
-	FunctionError string
-	LogResult     string
-	RequestID     string
-	Payload       []byte
+	FunctionError   string
+	LogResult       string
+	RequestID       string
+	ExecutedVersion string
+	Payload         []byte
+}
+
+/*
+ * Returned when Lambda rejects the invocation itself,
+ * with the error type from the X-Amzn-ErrorType header, and the response body.
+ */
+type InvokeError struct {
+	StatusCode int
+	ErrorType  string
+	RequestID  string
+	Payload    []byte
+}
+
+func (e *InvokeError) Error() string {
+	return fmt.Sprintf("invoke failed with %d: %s", e.StatusCode, string(e.Payload))
 }
 
 type FunctionSummary struct {
@@ -37,54 +55,90 @@ type FunctionSummary struct {
 	FunctionArn  string `json:"FunctionArn"`
 }
 
+type AliasSummary struct {
+	Name            string `json:"Name"`
+	AliasArn        string `json:"AliasArn"`
+	FunctionVersion string `json:"FunctionVersion"`
+	Description     string `json:"Description"`
+}
+
+type listAliasesResponse struct {
+	Aliases    []AliasSummary `json:"Aliases"`
+	NextMarker string         `json:"NextMarker"`
+}
+
 type listFunctionsResponse struct {
 	Functions  []FunctionSummary `json:"Functions"`
 	NextMarker string            `json:"NextMarker"`
 }
 
-func NewClient(httpCtx core.HTTPContext, credentials *aws.Credentials, region string) *Client {
+/*
+ * If endpoint is empty, the public regional endpoint is used.
+ */
+func NewClient(httpCtx core.HTTPContext, credentials *aws.Credentials, region string, endpoint string) *Client {
+	if endpoint == "" {
+		endpoint = common.Endpoint("lambda", region)
+	}
+
 	return &Client{
 		http:        httpCtx,
 		region:      region,
+		endpoint:    strings.TrimSuffix(endpoint, "/"),
 		credentials: credentials,
 		signer:      v4.NewSigner(),
+		retry:       common.DefaultRetryPolicy,
 	}
 }
 
-func (c *Client) Invoke(functionArn string, payload []byte) (*InvokeResult, error) {
-	endpoint := fmt.Sprintf("https://lambda.%s.amazonaws.com/2015-03-31/functions/%s/invocations", c.region, url.PathEscape(functionArn))
-	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(payload))
-	if err != nil {
-		return nil, fmt.Errorf("failed to build invoke request: %w", err)
+/*
+ * If qualifier is not empty, the given alias or version is invoked,
+ * instead of the unqualified function.
+ */
+func (c *Client) Invoke(functionArn string, qualifier string, payload []byte) (*InvokeResult, error) {
+	endpoint := fmt.Sprintf("%s/2015-03-31/functions/%s/invocations", c.endpoint, url.PathEscape(functionArn))
+	if qualifier != "" {
+		endpoint += "?Qualifier=" + url.QueryEscape(qualifier)
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-Amz-Invocation-Type", "RequestResponse")
-	req.Header.Set("X-Amz-Log-Type", "Tail")
+	//
+	// Invoking a function twice runs it twice,
+	// so we only retry if Lambda throttled the invocation.
+	//
+	res, err := common.DoWithRetry(c.http, c.retry, false, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(c.http.Context(), http.MethodPost, endpoint, bytes.NewReader(payload))
+		if err != nil {
+			return nil, fmt.Errorf("failed to build invoke request: %w", err)
+		}
 
-	if err := c.signRequest(req, payload); err != nil {
-		return nil, err
-	}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Amz-Invocation-Type", "RequestResponse")
+		req.Header.Set("X-Amz-Log-Type", "Tail")
+		return req, c.signRequest(req, payload)
+	})
 
-	res, err := c.http.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("invoke request failed: %w", err)
 	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read invoke response: %w", err)
-	}
 
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		return nil, fmt.Errorf("invoke failed with %d: %s", res.StatusCode, string(body))
+		//
+		// The error type header looks like "ResourceNotFoundException:http://internal.amazon.com/...".
+		//
+		errorType, _, _ := strings.Cut(res.Header.Get("X-Amzn-Errortype"), ":")
+		return nil, res.Failed(&InvokeError{
+			StatusCode: res.StatusCode,
+			ErrorType:  errorType,
+			RequestID:  res.Header.Get("X-Amzn-Requestid"),
+			Payload:    res.Body,
+		})
 	}
+
 	return &InvokeResult{
-		RequestID:     res.Header.Get("X-Amzn-Requestid"),
-		LogResult:     res.Header.Get("X-Amz-Log-Result"),
-		FunctionError: res.Header.Get("X-Amz-Function-Error"),
-		Payload:       body,
+		RequestID:       res.Header.Get("X-Amzn-Requestid"),
+		LogResult:       res.Header.Get("X-Amz-Log-Result"),
+		FunctionError:   res.Header.Get("X-Amz-Function-Error"),
+		ExecutedVersion: res.Header.Get("X-Amz-Executed-Version"),
+		Payload:         res.Body,
 	}, nil
 }
 
@@ -95,44 +149,83 @@ func (c *Client) ListFunctions() ([]FunctionSummary, error) {
 	)
 
 	for {
-		endpoint := fmt.Sprintf("https://lambda.%s.amazonaws.com/2015-03-31/functions", c.region)
-		req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+		endpoint := c.endpoint + "/2015-03-31/functions"
+		res, err := common.DoWithRetry(c.http, c.retry, true, func() (*http.Request, error) {
+			req, err := http.NewRequestWithContext(c.http.Context(), http.MethodGet, endpoint, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to build list functions request: %w", err)
+			}
+
+			query := req.URL.Query()
+			query.Set("MaxItems", "50")
+			if strings.TrimSpace(marker) != "" {
+				query.Set("Marker", marker)
+			}
+			req.URL.RawQuery = query.Encode()
+			return req, c.signRequest(req, []byte{})
+		})
+
 		if err != nil {
-			return nil, fmt.Errorf("failed to build list functions request: %w", err)
+			return nil, fmt.Errorf("list functions request failed: %w", err)
 		}
 
-		query := req.URL.Query()
-		query.Set("MaxItems", "50")
-		if strings.TrimSpace(marker) != "" {
-			query.Set("Marker", marker)
+		if res.StatusCode < 200 || res.StatusCode >= 300 {
+			return nil, res.Failed(fmt.Errorf("list functions failed with %d: %s", res.StatusCode, string(res.Body)))
 		}
-		req.URL.RawQuery = query.Encode()
 
-		if err := c.signRequest(req, []byte{}); err != nil {
-			return nil, err
+		var response listFunctionsResponse
+		if err := json.Unmarshal(res.Body, &response); err != nil {
+			return nil, fmt.Errorf("failed to decode list functions response: %w", err)
 		}
 
-		res, err := c.http.Do(req)
-		if err != nil {
-			return nil, fmt.Errorf("list functions request failed: %w", err)
+		functions = append(functions, response.Functions...)
+
+		if strings.TrimSpace(response.NextMarker) == "" {
+			break
 		}
+		marker = response.NextMarker
+	}
+
+	return functions, nil
+}
+
+func (c *Client) ListAliases(functionArn string) ([]AliasSummary, error) {
+	var (
+		aliases []AliasSummary
+		marker  string
+	)
+
+	for {
+		endpoint := fmt.Sprintf("%s/2015-03-31/functions/%s/aliases", c.endpoint, url.PathEscape(functionArn))
+		res, err := common.DoWithRetry(c.http, c.retry, true, func() (*http.Request, error) {
+			req, err := http.NewRequestWithContext(c.http.Context(), http.MethodGet, endpoint, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to build list aliases request: %w", err)
+			}
+
+			query := req.URL.Query()
+			query.Set("MaxItems", "50")
+			if strings.TrimSpace(marker) != "" {
+				query.Set("Marker", marker)
+			}
+			req.URL.RawQuery = query.Encode()
+			return req, c.signRequest(req, []byte{})
+		})
 
-		body, err := io.ReadAll(res.Body)
-		res.Body.Close()
 		if err != nil {
-			return nil, fmt.Errorf("failed to read list functions response: %w", err)
+			return nil, fmt.Errorf("list aliases request failed: %w", err)
 		}
 
 		if res.StatusCode < 200 || res.StatusCode >= 300 {
-			return nil, fmt.Errorf("list functions failed with %d: %s", res.StatusCode, string(body))
+			return nil, res.Failed(fmt.Errorf("list aliases failed with %d: %s", res.StatusCode, string(res.Body)))
 		}
 
-		var response listFunctionsResponse
-		if err := json.Unmarshal(body, &response); err != nil {
-			return nil, fmt.Errorf("failed to decode list functions response: %w", err)
+		var response listAliasesResponse
+		if err := json.Unmarshal(res.Body, &response); err != nil {
+			return nil, fmt.Errorf("failed to decode list aliases response: %w", err)
 		}
 
-		functions = append(functions, response.Functions...)
+		aliases = append(aliases, response.Aliases...)
 
 		if strings.TrimSpace(response.NextMarker) == "" {
 			break
@@ -140,7 +233,7 @@ func (c *Client) ListFunctions() ([]FunctionSummary, error) {
 		marker = response.NextMarker
 	}
 
-	return functions, nil
+	return aliases, nil
 }
 
 func (c *Client) signRequest(req *http.Request, payload []byte) error {
@@ -18,7 +18,7 @@ func ListFunctions(ctx core.ListResourcesContext, resourceType string) ([]core.I
 		return nil, fmt.Errorf("region is required")
 	}
 
-	client := NewClient(ctx.HTTP, credentials, region)
+	client := NewClient(ctx.HTTP, credentials, region, common.EndpointOverrideFor(ctx.Integration, common.ServiceLambda))
 	functions, err := client.ListFunctions()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list lambda functions: %w", err)
@@ -35,3 +35,41 @@ func ListFunctions(ctx core.ListResourcesContext, resourceType string) ([]core.I
 
 	return resources, nil
 }
+
+func ListAliases(ctx core.ListResourcesContext, resourceType string) ([]core.IntegrationResource, error) {
+	credentials, err := common.CredentialsFromInstallation(ctx.Integration)
+	if err != nil {
+		return nil, err
+	}
+
+	functionArn := ctx.Parameters["functionArn"]
+	if functionArn == "" {
+		return []core.IntegrationResource{}, nil
+	}
+
+	region := ctx.Parameters["region"]
+	if region == "" {
+		region, _ = regionFromArn(functionArn)
+	}
+
+	if region == "" {
+		return nil, fmt.Errorf("region is required")
+	}
+
+	client := NewClient(ctx.HTTP, credentials, region, common.EndpointOverrideFor(ctx.Integration, common.ServiceLambda))
+	aliases, err := client.ListAliases(functionArn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lambda aliases: %w", err)
+	}
+
+	resources := make([]core.IntegrationResource, 0, len(aliases))
+	for _, alias := range aliases {
+		resources = append(resources, core.IntegrationResource{
+			Type: resourceType,
+			Name: alias.Name,
+			ID:   alias.AliasArn,
+		})
+	}
+
+	return resources, nil
+}
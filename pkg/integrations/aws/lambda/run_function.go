@@ -3,8 +3,10 @@ package lambda
 import (
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 	"strings"
 
 	"github.com/google/uuid"
@@ -14,11 +16,25 @@ import (
 	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
 )
 
+const (
+	RunFunctionFailedOutputChannel = "failed"
+	RunFunctionPayloadType         = "aws.lambda.run"
+)
+
 type RunFunction struct{}
 
+/*
+ * A qualifier is either a version number, $LATEST, or an alias name.
+ * See: https://docs.aws.amazon.com/lambda/latest/api/API_Invoke.html
+ */
+var qualifierPattern = regexp.MustCompile(`^(\$LATEST|[a-zA-Z0-9-_]{1,128})$`)
+
 type RunFunctionConfiguration struct {
-	FunctionArn string `json:"functionArn" mapstructure:"functionArn"`
-	Payload     any    `json:"payload" mapstructure:"payload"`
+	FunctionArn            string `json:"functionArn" mapstructure:"functionArn"`
+	Qualifier              string `json:"qualifier" mapstructure:"qualifier"`
+	Payload                any    `json:"payload" mapstructure:"payload"`
+	SessionDurationSeconds int    `json:"sessionDurationSeconds" mapstructure:"sessionDurationSeconds"`
+	IncludeLogTail         bool   `json:"includeLogTail" mapstructure:"includeLogTail"`
 }
 
 type RunFunctionMetadata struct {
@@ -51,8 +67,18 @@ The Run Lambda component invokes a Lambda function.
 ## How It Works
 
 1. Invokes the specified Lambda function with the provided payload
+   - If a qualifier is set, the given alias or version of the function is invoked
 2. Returns the function's response including status code, payload, and log output
 3. Optionally creates a new Lambda function from inline JavaScript code
+
+## Output Channels
+
+- **Default**: The function ran successfully. Includes the request ID, the executed version, the execution report and the function response
+- **Failed**: The function returned an error, or Lambda rejected the invocation. Includes the error type and message, the stack trace for unhandled errors, and the raw response payload
+
+## Log Tail
+
+If **Include Log Tail** is enabled, the last 4 KB of the function logs are included in the output, on both channels.
 `
 }
 
@@ -65,7 +91,10 @@ func (c *RunFunction) Color() string {
 }
 
 func (c *RunFunction) OutputChannels(configuration any) []core.OutputChannel {
-	return []core.OutputChannel{core.DefaultOutputChannel}
+	return []core.OutputChannel{
+		core.DefaultOutputChannel,
+		{Name: RunFunctionFailedOutputChannel, Label: "Failed"},
+	}
 }
 
 func (c *RunFunction) Configuration() []configuration.Field {
@@ -108,6 +137,39 @@ func (c *RunFunction) Configuration() []configuration.Field {
 				},
 			},
 		},
+		{
+			Name:        "qualifier",
+			Label:       "Alias or Version",
+			Type:        configuration.FieldTypeIntegrationResource,
+			Required:    false,
+			Description: "Alias or version of the function to invoke. If not set, $LATEST is invoked",
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "lambda.alias",
+					UseNameAsValue: true,
+					Parameters: []configuration.ParameterRef{
+						{
+							Name: "region",
+							ValueFrom: &configuration.ParameterValueFrom{
+								Field: "region",
+							},
+						},
+						{
+							Name: "functionArn",
+							ValueFrom: &configuration.ParameterValueFrom{
+								Field: "functionArn",
+							},
+						},
+					},
+				},
+			},
+			VisibilityConditions: []configuration.VisibilityCondition{
+				{
+					Field:  "functionArn",
+					Values: []string{"*"},
+				},
+			},
+		},
 		{
 			Name:        "payload",
 			Label:       "Payload",
@@ -115,6 +177,28 @@ func (c *RunFunction) Configuration() []configuration.Field {
 			Required:    false,
 			Description: "Payload to send to the Lambda function",
 		},
+		{
+			Name:        "includeLogTail",
+			Label:       "Include Log Tail",
+			Type:        configuration.FieldTypeBool,
+			Required:    false,
+			Default:     false,
+			Description: "Include the last 4 KB of the function logs in the output",
+		},
+		{
+			Name:        "sessionDurationSeconds",
+			Label:       "Session Duration (seconds)",
+			Type:        configuration.FieldTypeNumber,
+			Required:    false,
+			Togglable:   true,
+			Description: "Minimum AWS session duration needed by this execution. If the integration session expires sooner, a new session is requested for this execution (up to the role max session duration)",
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := common.MinSessionDurationSeconds; return &min }(),
+					Max: func() *int { max := common.MaxSessionDurationSeconds; return &max }(),
+				},
+			},
+		},
 	}
 }
 
@@ -129,6 +213,10 @@ func (c *RunFunction) Setup(ctx core.SetupContext) error {
 		return fmt.Errorf("Function ARN is required")
 	}
 
+	if err := validateQualifier(config.Qualifier); err != nil {
+		return err
+	}
+
 	return ctx.Metadata.Set(RunFunctionMetadata{
 		FunctionArn: functionArn,
 	})
@@ -149,7 +237,12 @@ func (c *RunFunction) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to decode metadata: %w", err)
 	}
 
-	creds, err := common.CredentialsFromInstallation(ctx.Integration)
+	qualifier := strings.TrimSpace(config.Qualifier)
+	if err := validateQualifier(qualifier); err != nil {
+		return err
+	}
+
+	creds, err := common.CredentialsForExecution(ctx, config.SessionDurationSeconds)
 	if err != nil {
 		return err
 	}
@@ -160,26 +253,44 @@ func (c *RunFunction) Execute(ctx core.ExecutionContext) error {
 		return err
 	}
 
-	client := NewClient(ctx.HTTP, creds, region)
+	client := NewClient(ctx.HTTP, creds, region, common.EndpointOverrideFor(ctx.Integration, common.ServiceLambda))
 	payload, err := json.Marshal(config.Payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal payload: %w", err)
 	}
 
-	result, err := client.Invoke(metadata.FunctionArn, payload)
+	result, err := client.Invoke(metadata.FunctionArn, qualifier, payload)
+
+	//
+	// Invocations rejected by Lambda go to the failed channel,
+	// unless they can be retried, e.g. when Lambda is throttling them.
+	//
+	var invokeErr *InvokeError
+	if errors.As(err, &invokeErr) && !core.IsRetryable(err) {
+		return ctx.ExecutionState.Emit(RunFunctionFailedOutputChannel, RunFunctionPayloadType, []any{invokeErrorOutput(invokeErr)})
+	}
+
 	if err != nil {
 		return err
 	}
 
 	if result.FunctionError != "" {
-		return c.handleFunctionError(result)
+		output := functionErrorOutput(result)
+		addLogTail(output, result, config.IncludeLogTail)
+		return ctx.ExecutionState.Emit(RunFunctionFailedOutputChannel, RunFunctionPayloadType, []any{output})
 	}
 
 	output := map[string]any{"requestId": result.RequestID}
+	if result.ExecutedVersion != "" {
+		output["executedVersion"] = result.ExecutedVersion
+	}
+
 	if report, err := parseLambdaLogReport(result.LogResult); err == nil {
 		output["report"] = report
 	}
 
+	addLogTail(output, result, config.IncludeLogTail)
+
 	var parsed any
 	if len(result.Payload) > 0 && json.Unmarshal(result.Payload, &parsed) == nil {
 		output["payload"] = parsed
@@ -187,16 +298,88 @@ func (c *RunFunction) Execute(ctx core.ExecutionContext) error {
 		output["payloadRaw"] = string(result.Payload)
 	}
 
-	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "aws.lambda.run", []any{output})
+	return ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, RunFunctionPayloadType, []any{output})
 }
 
-func (c *RunFunction) handleFunctionError(result *InvokeResult) error {
+/*
+ * The function ran, but returned an error, which Lambda reports in the payload:
+ *
+ * {"errorType": "Error", "errorMessage": "...", "stackTrace": ["..."]}
+ *
+ * Unhandled errors from the Node.js runtime use "trace" instead of "stackTrace".
+ */
+func functionErrorOutput(result *InvokeResult) map[string]any {
+	output := map[string]any{
+		"requestId":     result.RequestID,
+		"functionError": result.FunctionError,
+		"payloadRaw":    string(result.Payload),
+	}
+
+	if result.ExecutedVersion != "" {
+		output["executedVersion"] = result.ExecutedVersion
+	}
+
 	var errorResponse ErrorResponse
 	if err := json.Unmarshal(result.Payload, &errorResponse); err != nil {
-		return fmt.Errorf("failed to unmarshal error response: %w", err)
+		return output
+	}
+
+	lambdaError := map[string]any{
+		"errorType":    errorResponse.ErrorType,
+		"errorMessage": errorResponse.ErrorMessage,
+	}
+
+	stackTrace := errorResponse.StackTrace
+	if len(stackTrace) == 0 {
+		stackTrace = errorResponse.Trace
+	}
+
+	if len(stackTrace) > 0 {
+		lambdaError["stackTrace"] = stackTrace
 	}
 
-	return fmt.Errorf("Lambda function error: %s: %s", errorResponse.ErrorType, errorResponse.ErrorMessage)
+	output["error"] = lambdaError
+	return output
+}
+
+/*
+ * Lambda API errors look like {"Type": "User", "message": "..."}.
+ */
+func invokeErrorOutput(invokeErr *InvokeError) map[string]any {
+	output := map[string]any{
+		"requestId":  invokeErr.RequestID,
+		"statusCode": invokeErr.StatusCode,
+		"payloadRaw": string(invokeErr.Payload),
+	}
+
+	//
+	// Field names are matched case-insensitively,
+	// so this also covers errors using "Message".
+	//
+	var apiError struct {
+		Message string `json:"message"`
+	}
+
+	_ = json.Unmarshal(invokeErr.Payload, &apiError)
+	output["error"] = map[string]any{
+		"errorType":    invokeErr.ErrorType,
+		"errorMessage": apiError.Message,
+	}
+
+	return output
+}
+
+func addLogTail(output map[string]any, result *InvokeResult, include bool) {
+	if !include || result.LogResult == "" {
+		return
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(result.LogResult)
+	if err != nil {
+		return
+	}
+
+	output["logTail"] = string(decoded)
 }
 
 func (c *RunFunction) Cancel(ctx core.ExecutionContext) error {
@@ -231,17 +414,35 @@ func resolveLambdaRegion(appRegion string, functionArn string) (string, error) {
 	return "", fmt.Errorf("region is required")
 }
 
+/*
+ * The qualifier is optional, and can be an expression,
+ * which is only validated once it is resolved, on execution.
+ */
+func validateQualifier(qualifier string) error {
+	qualifier = strings.TrimSpace(qualifier)
+	if qualifier == "" || strings.Contains(qualifier, "{{") {
+		return nil
+	}
+
+	if !qualifierPattern.MatchString(qualifier) {
+		return fmt.Errorf("invalid qualifier %q: must be $LATEST, a version number or an alias name", qualifier)
+	}
+
+	return nil
+}
+
 func regionFromArn(arn string) (string, bool) {
-	parts := strings.Split(arn, ":")
-	if len(parts) < 6 || parts[0] != "arn" {
+	parsed, err := common.ParseArn(arn)
+	if err != nil {
 		return "", false
 	}
-	return parts[3], strings.TrimSpace(parts[3]) != ""
+	return parsed.Region, strings.TrimSpace(parsed.Region) != ""
 }
 
 type ErrorResponse struct {
 	ErrorType    string   `json:"errorType"`
 	ErrorMessage string   `json:"errorMessage"`
+	StackTrace   []string `json:"stackTrace"`
 	Trace        []string `json:"trace"`
 }
 
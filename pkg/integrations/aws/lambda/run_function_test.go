@@ -36,6 +36,32 @@ func Test__RunFunction__Setup(t *testing.T) {
 		require.ErrorContains(t, err, "Function ARN is required")
 	})
 
+	t.Run("invalid qualifier -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{},
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: map[string]any{
+				"functionArn": "arn:aws:lambda:us-east-1:123:function:test",
+				"qualifier":   "prod:1",
+			},
+		})
+
+		require.ErrorContains(t, err, `invalid qualifier "prod:1"`)
+	})
+
+	t.Run("qualifier expression -> validated on execution", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration: &contexts.IntegrationContext{},
+			Metadata:    &contexts.MetadataContext{},
+			Configuration: map[string]any{
+				"functionArn": "arn:aws:lambda:us-east-1:123:function:test",
+				"qualifier":   "{{ $.data.alias }}",
+			},
+		})
+
+		require.NoError(t, err)
+	})
+
 	t.Run("valid configuration -> stores metadata", func(t *testing.T) {
 		metadata := &contexts.MetadataContext{}
 		err := component.Setup(core.SetupContext{
@@ -178,20 +204,20 @@ func Test__RunFunction__Execute(t *testing.T) {
 		assert.Equal(t, "160.97 ms", report.InitDuration)
 	})
 
-	t.Run("function error -> returns error", func(t *testing.T) {
+	t.Run("qualifier -> invokes alias", func(t *testing.T) {
 		httpContext := &contexts.HTTPContext{
 			Responses: []*http.Response{
 				{
 					StatusCode: http.StatusOK,
-					Body:       io.NopCloser(strings.NewReader(`{"errorType":"Boom","errorMessage":"failed"}`)),
-					Header:     http.Header{"X-Amz-Function-Error": []string{"Unhandled"}},
+					Body:       io.NopCloser(strings.NewReader(`{"message":"ok"}`)),
+					Header:     http.Header{"X-Amzn-Requestid": []string{"req-123"}},
 				},
 			},
 		}
 
 		err := component.Execute(core.ExecutionContext{
-			Configuration:  map[string]any{"payload": map[string]any{"hello": "world"}},
-			NodeMetadata:   &contexts.MetadataContext{Metadata: RunFunctionMetadata{FunctionArn: "arn:aws:lambda:us-east-1:123:function:test"}},
+			Configuration:  map[string]any{"qualifier": "prod", "payload": map[string]any{}},
+			NodeMetadata:   &contexts.MetadataContext{Metadata: RunFunctionMetadata{FunctionArn: "test"}},
 			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
 			HTTP:           httpContext,
 			Integration: &contexts.IntegrationContext{
@@ -204,8 +230,197 @@ func Test__RunFunction__Execute(t *testing.T) {
 			},
 		})
 
-		require.ErrorContains(t, err, "Lambda function error: Boom: failed")
+		require.NoError(t, err)
+		require.Len(t, httpContext.Requests, 1)
+		assert.Equal(t, "https://lambda.us-east-1.amazonaws.com/2015-03-31/functions/test/invocations?Qualifier=prod", httpContext.Requests[0].URL.String())
+	})
+
+	t.Run("invalid qualifier -> error", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{}
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"qualifier": "not/valid"},
+			NodeMetadata:   &contexts.MetadataContext{Metadata: RunFunctionMetadata{FunctionArn: "test"}},
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			HTTP:           httpContext,
+			Integration:    &contexts.IntegrationContext{},
+		})
+
+		require.ErrorContains(t, err, `invalid qualifier "not/valid"`)
+		assert.Empty(t, httpContext.Requests)
+	})
+
+	t.Run("handled function error -> emits on failed channel", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"errorType":"Boom","errorMessage":"failed"}`)),
+					Header: http.Header{
+						"X-Amzn-Requestid":       []string{"req-123"},
+						"X-Amz-Function-Error":   []string{"Handled"},
+						"X-Amz-Executed-Version": []string{"3"},
+					},
+				},
+			},
+		}
+
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"payload": map[string]any{"hello": "world"}},
+			NodeMetadata:   &contexts.MetadataContext{Metadata: RunFunctionMetadata{FunctionArn: "arn:aws:lambda:us-east-1:123:function:test"}},
+			ExecutionState: execState,
+			HTTP:           httpContext,
+			Integration:    testIntegration(),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, RunFunctionFailedOutputChannel, execState.Channel)
+		require.Len(t, execState.Payloads, 1)
+		payload := execState.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, "req-123", payload["requestId"])
+		assert.Equal(t, "Handled", payload["functionError"])
+		assert.Equal(t, "3", payload["executedVersion"])
+		assert.Equal(t, `{"errorType":"Boom","errorMessage":"failed"}`, payload["payloadRaw"])
+		assert.Equal(t, map[string]any{"errorType": "Boom", "errorMessage": "failed"}, payload["error"])
+		assert.NotContains(t, payload, "payload")
 	})
+
+	t.Run("unhandled function error -> emits stack trace on failed channel", func(t *testing.T) {
+		body := `{"errorType":"ZeroDivisionError","errorMessage":"division by zero","stackTrace":["  File \"/var/task/app.py\", line 3, in handler\n"]}`
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(body)),
+					Header:     http.Header{"X-Amz-Function-Error": []string{"Unhandled"}},
+				},
+			},
+		}
+
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"payload": map[string]any{}},
+			NodeMetadata:   &contexts.MetadataContext{Metadata: RunFunctionMetadata{FunctionArn: "arn:aws:lambda:us-east-1:123:function:test"}},
+			ExecutionState: execState,
+			HTTP:           httpContext,
+			Integration:    testIntegration(),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, RunFunctionFailedOutputChannel, execState.Channel)
+		payload := execState.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, "Unhandled", payload["functionError"])
+		assert.Equal(t, body, payload["payloadRaw"])
+		assert.Equal(t, map[string]any{
+			"errorType":    "ZeroDivisionError",
+			"errorMessage": "division by zero",
+			"stackTrace":   []string{"  File \"/var/task/app.py\", line 3, in handler\n"},
+		}, payload["error"])
+	})
+
+	t.Run("rejected invocation -> emits on failed channel", func(t *testing.T) {
+		body := `{"Type":"User","message":"Function not found: arn:aws:lambda:us-east-1:123:function:test"}`
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusNotFound,
+					Body:       io.NopCloser(strings.NewReader(body)),
+					Header: http.Header{
+						"X-Amzn-Requestid": []string{"req-123"},
+						"X-Amzn-Errortype": []string{"ResourceNotFoundException:http://internal.amazon.com/coral/com.amazon.coral.service/"},
+					},
+				},
+			},
+		}
+
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"payload": map[string]any{}},
+			NodeMetadata:   &contexts.MetadataContext{Metadata: RunFunctionMetadata{FunctionArn: "arn:aws:lambda:us-east-1:123:function:test"}},
+			ExecutionState: execState,
+			HTTP:           httpContext,
+			Integration:    testIntegration(),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, RunFunctionFailedOutputChannel, execState.Channel)
+		payload := execState.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, "req-123", payload["requestId"])
+		assert.Equal(t, http.StatusNotFound, payload["statusCode"])
+		assert.Equal(t, body, payload["payloadRaw"])
+		assert.Equal(t, map[string]any{
+			"errorType":    "ResourceNotFoundException",
+			"errorMessage": "Function not found: arn:aws:lambda:us-east-1:123:function:test",
+		}, payload["error"])
+	})
+
+	t.Run("include log tail -> emits decoded log tail and executed version", func(t *testing.T) {
+		logText := "START RequestId: req-123 Version: 3\nhello\nEND RequestId: req-123"
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"message":"ok"}`)),
+					Header: http.Header{
+						"X-Amzn-Requestid":       []string{"req-123"},
+						"X-Amz-Log-Result":       []string{base64.StdEncoding.EncodeToString([]byte(logText))},
+						"X-Amz-Executed-Version": []string{"3"},
+					},
+				},
+			},
+		}
+
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"payload": map[string]any{}, "includeLogTail": true},
+			NodeMetadata:   &contexts.MetadataContext{Metadata: RunFunctionMetadata{FunctionArn: "arn:aws:lambda:us-east-1:123:function:test"}},
+			ExecutionState: execState,
+			HTTP:           httpContext,
+			Integration:    testIntegration(),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, core.DefaultOutputChannel.Name, execState.Channel)
+		payload := execState.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, "3", payload["executedVersion"])
+		assert.Equal(t, logText, payload["logTail"])
+	})
+
+	t.Run("log tail not included by default", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"message":"ok"}`)),
+					Header:     http.Header{"X-Amz-Log-Result": []string{base64.StdEncoding.EncodeToString([]byte("hello"))}},
+				},
+			},
+		}
+
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"payload": map[string]any{}},
+			NodeMetadata:   &contexts.MetadataContext{Metadata: RunFunctionMetadata{FunctionArn: "arn:aws:lambda:us-east-1:123:function:test"}},
+			ExecutionState: execState,
+			HTTP:           httpContext,
+			Integration:    testIntegration(),
+		})
+
+		require.NoError(t, err)
+		payload := execState.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.NotContains(t, payload, "logTail")
+	})
+}
+
+func testIntegration() *contexts.IntegrationContext {
+	return &contexts.IntegrationContext{
+		Configuration: map[string]any{"region": "us-east-1"},
+		Secrets: map[string]core.IntegrationSecret{
+			"accessKeyId":     {Name: "accessKeyId", Value: []byte("key")},
+			"secretAccessKey": {Name: "secretAccessKey", Value: []byte("secret")},
+			"sessionToken":    {Name: "sessionToken", Value: []byte("token")},
+		},
+	}
 }
 
 func Test__ResolveLambdaRegion(t *testing.T) {
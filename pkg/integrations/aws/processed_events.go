@@ -0,0 +1,77 @@
+package aws
+
+import (
+	"sync"
+	"time"
+)
+
+/*
+ * EventBridge delivers events at least once, and the API destination
+ * retries deliveries it considers failed, so the same event can reach us
+ * more than once. Its ID is kept for a while after it is processed,
+ * so duplicate deliveries are not sent to the subscriptions again.
+ * IDs are kept in memory, so duplicates reaching different
+ * API replicas are only dropped by triggers with deduplication enabled.
+ */
+const ProcessedEventTTL = 15 * time.Minute
+
+var processedEvents = NewProcessedEvents(ProcessedEventTTL)
+
+type ProcessedEvents struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	expiresAt map[string]time.Time
+	lastPrune time.Time
+}
+
+func NewProcessedEvents(ttl time.Duration) *ProcessedEvents {
+	return &ProcessedEvents{
+		ttl:       ttl,
+		expiresAt: map[string]time.Time{},
+		lastPrune: time.Now(),
+	}
+}
+
+/*
+ * Records the event for the integration.
+ * Returns false if the event was already recorded,
+ * in which case it should not be processed again.
+ */
+func (p *ProcessedEvents) Record(integrationID, eventID string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(p.lastPrune) > p.ttl {
+		p.prune(now)
+	}
+
+	key := integrationID + "/" + eventID
+	if expiresAt, ok := p.expiresAt[key]; ok && now.Before(expiresAt) {
+		return false
+	}
+
+	p.expiresAt[key] = now.Add(p.ttl)
+	return true
+}
+
+/*
+ * Forgets the event for the integration,
+ * so a retried delivery for it is processed.
+ */
+func (p *ProcessedEvents) Forget(integrationID, eventID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.expiresAt, integrationID+"/"+eventID)
+}
+
+func (p *ProcessedEvents) prune(now time.Time) {
+	for key, expiresAt := range p.expiresAt {
+		if !now.Before(expiresAt) {
+			delete(p.expiresAt, key)
+		}
+	}
+
+	p.lastPrune = now
+}
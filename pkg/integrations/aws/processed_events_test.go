@@ -0,0 +1,32 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test__ProcessedEvents(t *testing.T) {
+	t.Run("events are recorded per integration", func(t *testing.T) {
+		events := NewProcessedEvents(time.Minute)
+		assert.True(t, events.Record("integration-1", "event-1"))
+		assert.False(t, events.Record("integration-1", "event-1"))
+		assert.True(t, events.Record("integration-2", "event-1"))
+	})
+
+	t.Run("forgotten events can be recorded again", func(t *testing.T) {
+		events := NewProcessedEvents(time.Minute)
+		assert.True(t, events.Record("integration-1", "event-1"))
+		events.Forget("integration-1", "event-1")
+		assert.True(t, events.Record("integration-1", "event-1"))
+	})
+
+	t.Run("expired events can be recorded again", func(t *testing.T) {
+		events := NewProcessedEvents(10 * time.Millisecond)
+		assert.True(t, events.Record("integration-1", "event-1"))
+		time.Sleep(20 * time.Millisecond)
+		assert.True(t, events.Record("integration-1", "event-1"))
+		assert.Len(t, events.expiresAt, 1)
+	})
+}
@@ -13,6 +13,9 @@ func (a *AWS) ListResources(resourceType string, ctx core.ListResourcesContext)
 	case "lambda.function":
 		return lambda.ListFunctions(ctx, resourceType)
 
+	case "lambda.alias":
+		return lambda.ListAliases(ctx, resourceType)
+
 	case "ecr.repository":
 		return ecr.ListRepositories(ctx, resourceType)
 
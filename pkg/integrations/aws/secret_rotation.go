@@ -0,0 +1,237 @@
+package aws
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/eventbridge"
+)
+
+const (
+	RotateEventBridgeSecretAction = "rotateEventBridgeSecret"
+
+	EventBridgeConnectionPreviousSecretName = "eventbridge.connection.secret.previous"
+
+	/*
+	 * EventBridge retries failed deliveries for up to 24 hours,
+	 * so the previous secret is accepted for as long by default.
+	 */
+	DefaultSecretRotationGracePeriod = 24 * time.Hour
+)
+
+type RotateEventBridgeSecretParameters struct {
+	GracePeriod string `json:"gracePeriod" mapstructure:"gracePeriod"`
+}
+
+func rotateEventBridgeSecretParameters() []configuration.Field {
+	minGracePeriod := 0
+
+	return []configuration.Field{
+		{
+			Name:        "gracePeriod",
+			Label:       "Grace period",
+			Type:        configuration.FieldTypeDuration,
+			Default:     DefaultSecretRotationGracePeriod.String(),
+			Description: "How long events sent with the previous secret are still accepted",
+			Placeholder: "e.g. 1h, 24h",
+			TypeOptions: &configuration.TypeOptions{
+				Duration: &configuration.DurationTypeOptions{
+					Min: &minGracePeriod,
+				},
+			},
+		},
+	}
+}
+
+/*
+ * Generates a new connection secret, and updates the connections
+ * of all regions with it. The result for each region is stored
+ * in the integration metadata, so the UI can show it.
+ *
+ * The secret is only replaced if at least one region was updated.
+ * Regions which failed keep sending the previous secret,
+ * which is only accepted until the grace period ends,
+ * so the action should be invoked again for them.
+ */
+func (a *AWS) handleRotateEventBridgeSecret(ctx core.IntegrationActionContext) error {
+	parameters := RotateEventBridgeSecretParameters{}
+	if err := mapstructure.Decode(ctx.Parameters, &parameters); err != nil {
+		return fmt.Errorf("failed to decode parameters: %v", err)
+	}
+
+	gracePeriod := DefaultSecretRotationGracePeriod
+	if parameters.GracePeriod != "" {
+		d, err := configuration.ParseDuration(parameters.GracePeriod)
+		if err != nil {
+			return fmt.Errorf("gracePeriod: %w", err)
+		}
+
+		gracePeriod = d
+	}
+
+	metadata := common.IntegrationMetadata{}
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %v", err)
+	}
+
+	if metadata.EventBridge == nil {
+		return fmt.Errorf("EventBridge is not configured for this integration")
+	}
+
+	currentSecret, err := findSecret(ctx.Integration, EventBridgeConnectionSecretName)
+	if err != nil {
+		return fmt.Errorf("failed to get integration secrets: %w", err)
+	}
+
+	newSecret, err := crypto.Base64String(32)
+	if err != nil {
+		return fmt.Errorf("failed to generate random string for connection secret: %w", err)
+	}
+
+	results := a.updateConnections(ctx, &metadata, newSecret)
+	rotated := slices.ContainsFunc(results, func(result common.SecretRotationResult) bool {
+		return result.Rotated
+	})
+
+	now := time.Now().UTC()
+	rotation := &common.SecretRotationMetadata{Regions: results}
+	if metadata.EventBridge.SecretRotation != nil {
+		rotation.RotatedAt = metadata.EventBridge.SecretRotation.RotatedAt
+		rotation.PreviousSecretExpiresAt = metadata.EventBridge.SecretRotation.PreviousSecretExpiresAt
+	}
+
+	if rotated || len(results) == 0 {
+		err = ctx.Integration.SetSecret(EventBridgeConnectionPreviousSecretName, []byte(currentSecret))
+		if err != nil {
+			return fmt.Errorf("failed to save previous connection secret: %w", err)
+		}
+
+		err = ctx.Integration.SetSecret(EventBridgeConnectionSecretName, []byte(newSecret))
+		if err != nil {
+			return fmt.Errorf("failed to save connection secret: %w", err)
+		}
+
+		rotation.RotatedAt = now.Format(time.RFC3339)
+		rotation.PreviousSecretExpiresAt = now.Add(gracePeriod).Format(time.RFC3339)
+	}
+
+	metadata.EventBridge.SecretRotation = rotation
+	ctx.Integration.SetMetadata(metadata)
+
+	failed := []string{}
+	for _, result := range results {
+		if !result.Rotated {
+			failed = append(failed, result.Region)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to update the connection secret in regions %v", failed)
+	}
+
+	return nil
+}
+
+func (a *AWS) updateConnections(ctx core.IntegrationActionContext, metadata *common.IntegrationMetadata, secret string) []common.SecretRotationResult {
+	regions := []string{}
+	for region := range metadata.EventBridge.APIDestinations {
+		regions = append(regions, region)
+	}
+
+	slices.Sort(regions)
+	results := []common.SecretRotationResult{}
+	if len(regions) == 0 {
+		return results
+	}
+
+	credentials, err := common.CredentialsFromInstallation(ctx.Integration)
+	if err != nil {
+		for _, region := range regions {
+			results = append(results, common.SecretRotationResult{Region: region, Message: err.Error()})
+		}
+
+		return results
+	}
+
+	for _, region := range regions {
+		destination := metadata.EventBridge.APIDestinations[region]
+		client := eventbridge.NewClient(ctx.HTTP, credentials, region)
+		_, err := client.UpdateConnection(destination.Name, APIKeyHeaderName, secret)
+		if err != nil {
+			ctx.Logger.Errorf("failed to update connection %s in region %s: %v", destination.Name, region, err)
+			results = append(results, common.SecretRotationResult{Region: region, Message: err.Error()})
+			continue
+		}
+
+		results = append(results, common.SecretRotationResult{Region: region, Rotated: true})
+	}
+
+	return results
+}
+
+/*
+ * The current connection secret is always accepted,
+ * and the previous one only until its grace period ends.
+ */
+func (a *AWS) acceptsAPIKey(integration core.IntegrationContext, apiKey string, now time.Time) (bool, error) {
+	secrets, err := integration.GetSecrets()
+	if err != nil {
+		return false, err
+	}
+
+	var current, previous string
+	for _, s := range secrets {
+		switch s.Name {
+		case EventBridgeConnectionSecretName:
+			current = string(s.Value)
+		case EventBridgeConnectionPreviousSecretName:
+			previous = string(s.Value)
+		}
+	}
+
+	if current != "" && subtle.ConstantTimeCompare([]byte(apiKey), []byte(current)) == 1 {
+		return true, nil
+	}
+
+	if previous == "" || subtle.ConstantTimeCompare([]byte(apiKey), []byte(previous)) != 1 {
+		return false, nil
+	}
+
+	metadata := common.IntegrationMetadata{}
+	if err := mapstructure.Decode(integration.GetMetadata(), &metadata); err != nil {
+		return false, err
+	}
+
+	if metadata.EventBridge == nil || metadata.EventBridge.SecretRotation == nil {
+		return false, nil
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, metadata.EventBridge.SecretRotation.PreviousSecretExpiresAt)
+	if err != nil {
+		return false, nil
+	}
+
+	return now.Before(expiresAt), nil
+}
+
+func findSecret(integration core.IntegrationContext, name string) (string, error) {
+	secrets, err := integration.GetSecrets()
+	if err != nil {
+		return "", err
+	}
+
+	for _, s := range secrets {
+		if s.Name == name {
+			return string(s.Value), nil
+		}
+	}
+
+	return "", nil
+}
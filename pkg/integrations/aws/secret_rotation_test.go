@@ -0,0 +1,197 @@
+package aws
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__AWS__RotateEventBridgeSecret(t *testing.T) {
+	a := &AWS{}
+
+	newIntegration := func() *contexts.IntegrationContext {
+		return &contexts.IntegrationContext{
+			IntegrationID: uuid.NewString(),
+			Secrets: map[string]core.IntegrationSecret{
+				"accessKeyId":                   {Name: "accessKeyId", Value: []byte("AKIA_TEST")},
+				"secretAccessKey":               {Name: "secretAccessKey", Value: []byte("secret")},
+				"sessionToken":                  {Name: "sessionToken", Value: []byte("token")},
+				EventBridgeConnectionSecretName: {Name: EventBridgeConnectionSecretName, Value: []byte("old-secret")},
+			},
+			Metadata: common.IntegrationMetadata{
+				EventBridge: &common.EventBridgeMetadata{
+					APIDestinations: map[string]common.APIDestinationMetadata{
+						"us-east-1": {Name: "superplane-test", Region: "us-east-1"},
+						"us-west-2": {Name: "superplane-test", Region: "us-west-2"},
+					},
+				},
+			},
+		}
+	}
+
+	rotate := func(integrationCtx *contexts.IntegrationContext, httpContext *contexts.HTTPContext, parameters map[string]any) (common.SecretRotationMetadata, error) {
+		err := a.HandleAction(core.IntegrationActionContext{
+			Name:        RotateEventBridgeSecretAction,
+			Parameters:  parameters,
+			Logger:      logrus.NewEntry(logrus.New()),
+			Integration: integrationCtx,
+			HTTP:        httpContext,
+		})
+
+		metadata, ok := integrationCtx.Metadata.(common.IntegrationMetadata)
+		require.True(t, ok)
+		require.NotNil(t, metadata.EventBridge.SecretRotation)
+		return *metadata.EventBridge.SecretRotation, err
+	}
+
+	connectionResponse := func() *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"ConnectionArn":"arn:aws:events:us-east-1:123456789012:connection/superplane-test/abc"}`)),
+		}
+	}
+
+	t.Run("all regions updated -> secret rotated and previous one kept", func(t *testing.T) {
+		integrationCtx := newIntegration()
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{connectionResponse(), connectionResponse()},
+		}
+
+		rotation, err := rotate(integrationCtx, httpContext, map[string]any{"gracePeriod": "1h"})
+		require.NoError(t, err)
+
+		assert.Equal(t, []common.SecretRotationResult{
+			{Region: "us-east-1", Rotated: true},
+			{Region: "us-west-2", Rotated: true},
+		}, rotation.Regions)
+
+		expiresAt, err := time.Parse(time.RFC3339, rotation.PreviousSecretExpiresAt)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, 5*time.Second)
+
+		newSecret := string(integrationCtx.Secrets[EventBridgeConnectionSecretName].Value)
+		assert.NotEqual(t, "old-secret", newSecret)
+		assert.Equal(t, "old-secret", string(integrationCtx.Secrets[EventBridgeConnectionPreviousSecretName].Value))
+
+		require.Len(t, httpContext.Requests, 2)
+		assert.Equal(t, "https://events.us-east-1.amazonaws.com/", httpContext.Requests[0].URL.String())
+		assert.Equal(t, "https://events.us-west-2.amazonaws.com/", httpContext.Requests[1].URL.String())
+		assert.Equal(t, "AWSEvents.UpdateConnection", httpContext.Requests[0].Header.Get("X-Amz-Target"))
+
+		body, err := io.ReadAll(httpContext.Requests[0].Body)
+		require.NoError(t, err)
+		payload := map[string]any{}
+		require.NoError(t, json.Unmarshal(body, &payload))
+		assert.Equal(t, "superplane-test", payload["Name"])
+		assert.Equal(t, map[string]any{"ApiKeyName": APIKeyHeaderName, "ApiKeyValue": newSecret}, payload["AuthParameters"].(map[string]any)["ApiKeyAuthParameters"])
+	})
+
+	t.Run("one region fails -> secret rotated and failure reported", func(t *testing.T) {
+		integrationCtx := newIntegration()
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				connectionResponse(),
+				{
+					StatusCode: http.StatusBadRequest,
+					Body:       io.NopCloser(strings.NewReader(`{"__type":"AccessDeniedException","message":"not allowed"}`)),
+				},
+			},
+		}
+
+		rotation, err := rotate(integrationCtx, httpContext, map[string]any{})
+		require.ErrorContains(t, err, "us-west-2")
+
+		require.Len(t, rotation.Regions, 2)
+		assert.True(t, rotation.Regions[0].Rotated)
+		assert.False(t, rotation.Regions[1].Rotated)
+		assert.Contains(t, rotation.Regions[1].Message, "not allowed")
+
+		expiresAt, err := time.Parse(time.RFC3339, rotation.PreviousSecretExpiresAt)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(DefaultSecretRotationGracePeriod), expiresAt, 5*time.Second)
+		assert.Equal(t, "old-secret", string(integrationCtx.Secrets[EventBridgeConnectionPreviousSecretName].Value))
+	})
+
+	t.Run("all regions fail -> secret is not rotated", func(t *testing.T) {
+		integrationCtx := newIntegration()
+		failure := func() *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Body:       io.NopCloser(strings.NewReader(`{"__type":"AccessDeniedException","message":"not allowed"}`)),
+			}
+		}
+
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{failure(), failure()},
+		}
+
+		rotation, err := rotate(integrationCtx, httpContext, map[string]any{})
+		require.Error(t, err)
+		assert.Empty(t, rotation.RotatedAt)
+		assert.Equal(t, "old-secret", string(integrationCtx.Secrets[EventBridgeConnectionSecretName].Value))
+		assert.NotContains(t, integrationCtx.Secrets, EventBridgeConnectionPreviousSecretName)
+	})
+}
+
+func Test__AWS__HandleEvent__PreviousSecret(t *testing.T) {
+	deliver := func(integrationCtx core.IntegrationContext, apiKey string) int {
+		body := `{"id":"` + uuid.NewString() + `","source":"aws.ecr","detail-type":"ECR Image Action","detail":{}}`
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/integrations/aws/events", strings.NewReader(body))
+		request.Header.Set(APIKeyHeaderName, apiKey)
+		response := httptest.NewRecorder()
+
+		a := &AWS{}
+		a.HandleRequest(core.HTTPRequestContext{
+			Logger:      logrus.NewEntry(logrus.New()),
+			Request:     request,
+			Response:    response,
+			Integration: integrationCtx,
+		})
+
+		return response.Code
+	}
+
+	newIntegration := func(previousSecretExpiresAt time.Time) *subscriptionsIntegrationContext {
+		return &subscriptionsIntegrationContext{
+			IntegrationContext: &contexts.IntegrationContext{
+				IntegrationID: uuid.NewString(),
+				Secrets: map[string]core.IntegrationSecret{
+					EventBridgeConnectionSecretName:         {Name: EventBridgeConnectionSecretName, Value: []byte("new-secret")},
+					EventBridgeConnectionPreviousSecretName: {Name: EventBridgeConnectionPreviousSecretName, Value: []byte("old-secret")},
+				},
+				Metadata: common.IntegrationMetadata{
+					EventBridge: &common.EventBridgeMetadata{
+						SecretRotation: &common.SecretRotationMetadata{
+							PreviousSecretExpiresAt: previousSecretExpiresAt.Format(time.RFC3339),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("within the grace period -> both secrets accepted", func(t *testing.T) {
+		integrationCtx := newIntegration(time.Now().Add(time.Hour))
+		assert.Equal(t, http.StatusOK, deliver(integrationCtx, "new-secret"))
+		assert.Equal(t, http.StatusOK, deliver(integrationCtx, "old-secret"))
+		assert.Equal(t, http.StatusForbidden, deliver(integrationCtx, "other-secret"))
+	})
+
+	t.Run("after the grace period -> previous secret rejected", func(t *testing.T) {
+		integrationCtx := newIntegration(time.Now().Add(-time.Minute))
+		assert.Equal(t, http.StatusOK, deliver(integrationCtx, "new-secret"))
+		assert.Equal(t, http.StatusForbidden, deliver(integrationCtx, "old-secret"))
+	})
+}
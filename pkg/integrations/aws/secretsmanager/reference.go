@@ -0,0 +1,120 @@
+package secretsmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
+	"github.com/superplanehq/superplane/pkg/secrets"
+)
+
+const ReferenceScheme = "aws-secretsmanager"
+
+/*
+ * A secret value can reference a Secrets Manager secret,
+ * instead of storing a copy of it in SuperPlane:
+ *
+ *   aws-secretsmanager://<region>/<secret-name>[?integration=<name>][#<json-key>]
+ *
+ * The JSON key selects a field from a JSON secret string.
+ * The integration name is only needed when the organization
+ * has more than one AWS integration.
+ */
+type Reference struct {
+	Region      string
+	SecretID    string
+	Key         string
+	Integration string
+}
+
+/*
+ * ReferenceResolver reads referenced secrets
+ * with the credentials of one of the organization's AWS integrations.
+ */
+type ReferenceResolver struct{}
+
+func (r *ReferenceResolver) Resolve(ctx secrets.ReferenceContext, value string) ([]byte, error) {
+	reference, err := ParseReference(value)
+	if err != nil {
+		return nil, err
+	}
+
+	integration, err := ctx.FindIntegration("aws", reference.Integration)
+	if err != nil {
+		return nil, err
+	}
+
+	return reference.Resolve(ctx.HTTP, integration)
+}
+
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, ReferenceScheme+"://")
+}
+
+func ParseReference(value string) (*Reference, error) {
+	u, err := url.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Secrets Manager reference: %w", err)
+	}
+
+	if u.Scheme != ReferenceScheme {
+		return nil, fmt.Errorf("invalid Secrets Manager reference: unexpected scheme %q", u.Scheme)
+	}
+
+	region := strings.TrimSpace(u.Host)
+	if region == "" {
+		return nil, fmt.Errorf("invalid Secrets Manager reference: region is required")
+	}
+
+	secretID := strings.TrimPrefix(u.Path, "/")
+	if secretID == "" {
+		return nil, fmt.Errorf("invalid Secrets Manager reference: secret name is required")
+	}
+
+	return &Reference{
+		Region:      region,
+		SecretID:    secretID,
+		Key:         u.Fragment,
+		Integration: u.Query().Get("integration"),
+	}, nil
+}
+
+func (r *Reference) Resolve(httpCtx core.HTTPContext, integration core.IntegrationContext) ([]byte, error) {
+	credentials, err := common.CredentialsFromInstallation(integration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS credentials from integration: %w", err)
+	}
+
+	client := NewClient(httpCtx, credentials, r.Region)
+	secret, err := client.GetSecretValue(r.SecretID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %q: %w", r.SecretID, err)
+	}
+
+	if secret.SecretString == "" {
+		return nil, fmt.Errorf("secret %q has no string value", r.SecretID)
+	}
+
+	if r.Key == "" {
+		return []byte(secret.SecretString), nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(secret.SecretString), &fields); err != nil {
+		return nil, fmt.Errorf("secret %q is not a JSON object: %w", r.SecretID, err)
+	}
+
+	field, ok := fields[r.Key]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found in secret %q", r.Key, r.SecretID)
+	}
+
+	if s, ok := field.(string); ok {
+		return []byte(s), nil
+	}
+
+	return json.Marshal(field)
+}
@@ -0,0 +1,178 @@
+package secretsmanager
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
+	"github.com/superplanehq/superplane/pkg/secrets"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__ParseReference(t *testing.T) {
+	t.Run("region and name", func(t *testing.T) {
+		reference, err := ParseReference("aws-secretsmanager://us-east-1/prod/db-password")
+		require.NoError(t, err)
+		assert.Equal(t, "us-east-1", reference.Region)
+		assert.Equal(t, "prod/db-password", reference.SecretID)
+		assert.Empty(t, reference.Key)
+		assert.Empty(t, reference.Integration)
+	})
+
+	t.Run("integration and JSON key", func(t *testing.T) {
+		reference, err := ParseReference("aws-secretsmanager://eu-west-1/prod/db?integration=production#password")
+		require.NoError(t, err)
+		assert.Equal(t, "eu-west-1", reference.Region)
+		assert.Equal(t, "prod/db", reference.SecretID)
+		assert.Equal(t, "password", reference.Key)
+		assert.Equal(t, "production", reference.Integration)
+	})
+
+	t.Run("missing region -> error", func(t *testing.T) {
+		_, err := ParseReference("aws-secretsmanager:///prod/db")
+		require.ErrorContains(t, err, "region is required")
+	})
+
+	t.Run("missing name -> error", func(t *testing.T) {
+		_, err := ParseReference("aws-secretsmanager://us-east-1/")
+		require.ErrorContains(t, err, "secret name is required")
+	})
+
+	t.Run("other scheme -> error", func(t *testing.T) {
+		assert.False(t, IsReference("vault://secret/db"))
+		_, err := ParseReference("vault://secret/db")
+		require.ErrorContains(t, err, "unexpected scheme")
+	})
+}
+
+func Test__Reference__Resolve(t *testing.T) {
+	integrationCtx := &contexts.IntegrationContext{Secrets: map[string]core.IntegrationSecret{}}
+	_, err := common.StoreCredentials(integrationCtx, "AKIA_TEST", "secret", "token", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	secretResponse := func(body string) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	}
+
+	t.Run("plain secret string", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				secretResponse(`{"Name":"prod/db-password","SecretString":"hunter2"}`),
+			},
+		}
+
+		reference, err := ParseReference("aws-secretsmanager://us-east-1/prod/db-password")
+		require.NoError(t, err)
+
+		value, err := reference.Resolve(httpContext, integrationCtx)
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", string(value))
+
+		require.Len(t, httpContext.Requests, 1)
+		request := httpContext.Requests[0]
+		assert.Equal(t, "https://secretsmanager.us-east-1.amazonaws.com/", request.URL.String())
+		assert.Equal(t, "secretsmanager.GetSecretValue", request.Header.Get("X-Amz-Target"))
+
+		body, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"SecretId":"prod/db-password"}`, string(body))
+	})
+
+	t.Run("JSON key", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				secretResponse(`{"Name":"prod/db","SecretString":"{\"username\":\"admin\",\"password\":\"hunter2\"}"}`),
+			},
+		}
+
+		reference, err := ParseReference("aws-secretsmanager://us-east-1/prod/db#password")
+		require.NoError(t, err)
+
+		value, err := reference.Resolve(httpContext, integrationCtx)
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", string(value))
+	})
+
+	t.Run("missing JSON key -> error", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				secretResponse(`{"Name":"prod/db","SecretString":"{\"username\":\"admin\"}"}`),
+			},
+		}
+
+		reference, err := ParseReference("aws-secretsmanager://us-east-1/prod/db#password")
+		require.NoError(t, err)
+
+		_, err = reference.Resolve(httpContext, integrationCtx)
+		require.ErrorContains(t, err, `key "password" not found`)
+	})
+
+	t.Run("API error -> error", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusBadRequest,
+					Body:       io.NopCloser(strings.NewReader(`{"__type":"ResourceNotFoundException","Message":"Secrets Manager can't find the specified secret."}`)),
+				},
+			},
+		}
+
+		reference, err := ParseReference("aws-secretsmanager://us-east-1/prod/missing")
+		require.NoError(t, err)
+
+		_, err = reference.Resolve(httpContext, integrationCtx)
+		require.ErrorContains(t, err, "ResourceNotFoundException")
+	})
+}
+
+func Test__ReferenceResolver__Resolve(t *testing.T) {
+	integrationCtx := &contexts.IntegrationContext{Secrets: map[string]core.IntegrationSecret{}}
+	_, err := common.StoreCredentials(integrationCtx, "AKIA_TEST", "secret", "token", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	t.Run("uses the AWS integration named in the reference", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"Name":"prod/db","SecretString":"hunter2"}`)),
+				},
+			},
+		}
+
+		var requestedApp, requestedName string
+		value, err := (&ReferenceResolver{}).Resolve(secrets.ReferenceContext{
+			HTTP: httpContext,
+			FindIntegration: func(appName, name string) (core.IntegrationContext, error) {
+				requestedApp, requestedName = appName, name
+				return integrationCtx, nil
+			},
+		}, "aws-secretsmanager://us-east-1/prod/db?integration=production")
+
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", string(value))
+		assert.Equal(t, "aws", requestedApp)
+		assert.Equal(t, "production", requestedName)
+	})
+
+	t.Run("integration not found -> error", func(t *testing.T) {
+		_, err := (&ReferenceResolver{}).Resolve(secrets.ReferenceContext{
+			HTTP: &contexts.HTTPContext{},
+			FindIntegration: func(appName, name string) (core.IntegrationContext, error) {
+				return nil, errors.New("no aws integration found to resolve secret reference")
+			},
+		}, "aws-secretsmanager://us-east-1/prod/db")
+
+		require.EqualError(t, err, "no aws integration found to resolve secret reference")
+	})
+}
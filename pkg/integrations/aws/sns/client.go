@@ -6,9 +6,9 @@ import (
 	"encoding/hex"
 	"encoding/xml"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -26,6 +26,10 @@ const (
 	snsContentType = "application/x-www-form-urlencoded; charset=utf-8"
 )
 
+// nonIdempotentActions are only retried when SNS throttles them,
+// since retrying them after a 5xx could deliver a message twice.
+var nonIdempotentActions = []string{"Publish"}
+
 // Client provides lightweight SNS API operations through signed HTTP requests.
 type Client struct {
 	http        core.HTTPContext
@@ -33,17 +37,24 @@ type Client struct {
 	endpoint    string
 	credentials *aws.Credentials
 	signer      *v4.Signer
+	retry       common.RetryPolicy
 }
 
 // NewClient creates a region-scoped SNS client.
-func NewClient(httpCtx core.HTTPContext, credentials *aws.Credentials, region string) *Client {
+// If endpoint is empty, the public regional endpoint is used.
+func NewClient(httpCtx core.HTTPContext, credentials *aws.Credentials, region string, endpoint string) *Client {
 	normalizedRegion := strings.TrimSpace(region)
+	if endpoint == "" {
+		endpoint = common.Endpoint("sns", normalizedRegion) + "/"
+	}
+
 	return &Client{
 		http:        httpCtx,
 		region:      normalizedRegion,
-		endpoint:    fmt.Sprintf("https://sns.%s.amazonaws.com/", normalizedRegion),
+		endpoint:    endpoint,
 		credentials: credentials,
 		signer:      v4.NewSigner(),
+		retry:       common.DefaultRetryPolicy,
 	}
 }
 
@@ -71,11 +82,19 @@ func (c *Client) GetTopic(topicArn string) (*Topic, error) {
 	}, nil
 }
 
-func (c *Client) CreateTopic(name string) (*Topic, error) {
+// CreateTopic creates a topic with the given attributes and returns its metadata.
+func (c *Client) CreateTopic(parameters CreateTopicParameters) (*Topic, error) {
+	name := parameters.Name
 	params := map[string]string{
 		"Name": name,
 	}
 
+	for index, key := range sortedKeys(parameters.Attributes) {
+		entry := strconv.Itoa(index + 1)
+		params["Attributes.entry."+entry+".key"] = key
+		params["Attributes.entry."+entry+".value"] = parameters.Attributes[key]
+	}
+
 	var response createTopicResponse
 	if err := c.postForm("CreateTopic", params, &response); err != nil {
 		return nil, fmt.Errorf("sns client: failed to create topic %q: %w", name, err)
@@ -272,7 +291,8 @@ func (c *Client) ListSubscriptionsByTopic(topicArn string) ([]Subscription, erro
 			})
 		}
 
-		if response.NextToken == "" {
+		nextToken = strings.TrimSpace(response.NextTokenTopic)
+		if nextToken == "" {
 			return subscriptions, nil
 		}
 	}
@@ -288,40 +308,38 @@ func (c *Client) postForm(action string, params map[string]string, out any) erro
 	}
 
 	body := values.Encode()
-	request, err := http.NewRequest(http.MethodPost, c.endpoint, strings.NewReader(body))
-	if err != nil {
-		return fmt.Errorf("sns client: failed to build %s request: %w", action, err)
-	}
+	idempotent := !slices.Contains(nonIdempotentActions, action)
+	response, err := common.DoWithRetry(c.http, c.retry, idempotent, func() (*http.Request, error) {
+		request, err := http.NewRequestWithContext(c.http.Context(), http.MethodPost, c.endpoint, strings.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("sns client: failed to build %s request: %w", action, err)
+		}
 
-	request.Header.Set("Content-Type", snsContentType)
+		request.Header.Set("Content-Type", snsContentType)
 
-	if err := c.signRequest(request, []byte(body)); err != nil {
-		return fmt.Errorf("sns client: failed to sign %s request: %w", action, err)
-	}
+		if err := c.signRequest(request, []byte(body)); err != nil {
+			return nil, fmt.Errorf("sns client: failed to sign %s request: %w", action, err)
+		}
 
-	response, err := c.http.Do(request)
-	if err != nil {
-		return fmt.Errorf("sns client: %s request failed: %w", action, err)
-	}
-	defer response.Body.Close()
+		return request, nil
+	})
 
-	responseBody, err := io.ReadAll(response.Body)
 	if err != nil {
-		return fmt.Errorf("sns client: failed to read %s response body: %w", action, err)
+		return fmt.Errorf("sns client: %s request failed: %w", action, err)
 	}
 
 	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
-		if awsErr := parseSNSError(responseBody); awsErr != nil {
-			return fmt.Errorf("sns client: %s request failed: %w", action, awsErr)
+		if awsErr := parseSNSError(response.Body); awsErr != nil {
+			return response.Failed(fmt.Errorf("sns client: %s request failed: %w", action, awsErr))
 		}
-		return fmt.Errorf("sns client: %s request failed with status %d: %s", action, response.StatusCode, string(responseBody))
+		return response.Failed(fmt.Errorf("sns client: %s request failed with status %d: %s", action, response.StatusCode, string(response.Body)))
 	}
 
 	if out == nil {
 		return nil
 	}
 
-	if err := xml.Unmarshal(responseBody, out); err != nil {
+	if err := xml.Unmarshal(response.Body, out); err != nil {
 		return fmt.Errorf("sns client: failed to decode %s response: %w", action, err)
 	}
 
@@ -14,9 +14,14 @@ import (
 
 type CreateTopic struct{}
 
+const fifoTopicSuffix = ".fifo"
+
 type CreateTopicConfiguration struct {
-	Region string `json:"region" mapstructure:"region"`
-	Name   string `json:"name" mapstructure:"name"`
+	Region                    string            `json:"region" mapstructure:"region"`
+	Name                      string            `json:"name" mapstructure:"name"`
+	FifoTopic                 bool              `json:"fifoTopic" mapstructure:"fifoTopic"`
+	ContentBasedDeduplication bool              `json:"contentBasedDeduplication" mapstructure:"contentBasedDeduplication"`
+	Attributes                map[string]string `json:"attributes" mapstructure:"attributes"`
 }
 
 func (c *CreateTopic) Name() string {
@@ -38,7 +43,14 @@ func (c *CreateTopic) Documentation() string {
 
 - **Provisioning workflows**: Create topics as part of environment setup
 - **Automation bootstrap**: Prepare topics before publishing messages
-- **Self-service operations**: Provision messaging resources on demand`
+- **Self-service operations**: Provision messaging resources on demand
+
+## Configuration
+
+- **Topic Name**: Name of the topic. For FIFO topics, ` + "`.fifo`" + ` is appended if missing
+- **FIFO Topic**: Create a FIFO topic, with strict message ordering and deduplication
+- **Content-Based Deduplication**: Deduplicate FIFO messages using a hash of their body
+- **Attributes**: Additional topic attributes, like ` + "`DisplayName`" + ` or ` + "`KmsMasterKeyId`" + ``
 }
 
 func (c *CreateTopic) Icon() string {
@@ -62,13 +74,37 @@ func (c *CreateTopic) Configuration() []configuration.Field {
 			Type:        configuration.FieldTypeString,
 			Required:    true,
 			Description: "Name of the SNS topic to create",
+		},
+		{
+			Name:        "fifoTopic",
+			Label:       "FIFO Topic",
+			Type:        configuration.FieldTypeBool,
+			Required:    false,
+			Default:     false,
+			Description: "Create a FIFO topic",
+		},
+		{
+			Name:        "contentBasedDeduplication",
+			Label:       "Content-Based Deduplication",
+			Type:        configuration.FieldTypeBool,
+			Required:    false,
+			Default:     false,
+			Description: "Deduplicate messages using a hash of their body",
 			VisibilityConditions: []configuration.VisibilityCondition{
 				{
-					Field:  "region",
-					Values: []string{"*"},
+					Field:  "fifoTopic",
+					Values: []string{"true"},
 				},
 			},
 		},
+		{
+			Name:        "attributes",
+			Label:       "Attributes",
+			Type:        configuration.FieldTypeObject,
+			Required:    false,
+			Togglable:   true,
+			Description: "Additional topic attributes, like DisplayName or KmsMasterKeyId",
+		},
 	}
 }
 
@@ -78,16 +114,49 @@ func (c *CreateTopic) Setup(ctx core.SetupContext) error {
 		return fmt.Errorf("failed to decode setup configuration: %w", err)
 	}
 
-	if _, err := requireRegion(config.Region); err != nil {
+	if _, err := requireRegion(ctx.Integration, config.Region); err != nil {
 		return fmt.Errorf("invalid region: %w", err)
 	}
 
+	_, err := createTopicParameters(config)
+	return err
+}
+
+// createTopicParameters turns the configuration into CreateTopic parameters.
+// The FIFO toggles take precedence over the same keys in the attributes map.
+func createTopicParameters(config CreateTopicConfiguration) (CreateTopicParameters, error) {
 	name := strings.TrimSpace(config.Name)
 	if name == "" {
-		return fmt.Errorf("topic name is required")
+		return CreateTopicParameters{}, fmt.Errorf("topic name is required")
 	}
 
-	return nil
+	attributes := map[string]string{}
+	for key, value := range config.Attributes {
+		attributes[key] = value
+	}
+
+	if !config.FifoTopic {
+		if strings.HasSuffix(name, fifoTopicSuffix) {
+			return CreateTopicParameters{}, fmt.Errorf("topic names ending in %s are only allowed for FIFO topics", fifoTopicSuffix)
+		}
+
+		if config.ContentBasedDeduplication {
+			return CreateTopicParameters{}, fmt.Errorf("content-based deduplication is only supported for FIFO topics")
+		}
+
+		return CreateTopicParameters{Name: name, Attributes: attributes}, nil
+	}
+
+	if !strings.HasSuffix(name, fifoTopicSuffix) {
+		name += fifoTopicSuffix
+	}
+
+	attributes["FifoTopic"] = "true"
+	if config.ContentBasedDeduplication {
+		attributes["ContentBasedDeduplication"] = "true"
+	}
+
+	return CreateTopicParameters{Name: name, Attributes: attributes}, nil
 }
 
 func (c *CreateTopic) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
@@ -105,10 +174,15 @@ func (c *CreateTopic) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to load AWS credentials from integration: %w", err)
 	}
 
-	client := NewClient(ctx.HTTP, credentials, config.Region)
-	topic, err := client.CreateTopic(config.Name)
+	parameters, err := createTopicParameters(config)
+	if err != nil {
+		return err
+	}
+
+	client := NewClient(ctx.HTTP, credentials, common.ResolveRegion(ctx.Integration, config.Region), common.EndpointOverrideFor(ctx.Integration, common.ServiceSNS))
+	topic, err := client.CreateTopic(parameters)
 	if err != nil {
-		return fmt.Errorf("failed to create topic %q: %w", config.Name, err)
+		return fmt.Errorf("failed to create topic %q: %w", parameters.Name, err)
 	}
 
 	if err := ctx.ExecutionState.Emit(core.DefaultOutputChannel.Name, "aws.sns.topic", []any{topic}); err != nil {
@@ -3,6 +3,7 @@ package sns
 import (
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -23,6 +24,40 @@ func Test__CreateTopic__Setup(t *testing.T) {
 		})
 		require.ErrorContains(t, err, "topic name is required")
 	})
+
+	t.Run("fifo suffix on a standard topic -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{
+				"region": "us-east-1",
+				"name":   "orders.fifo",
+			},
+		})
+		require.ErrorContains(t, err, "topic names ending in .fifo are only allowed for FIFO topics")
+	})
+
+	t.Run("content-based deduplication on a standard topic -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{
+				"region":                    "us-east-1",
+				"name":                      "orders",
+				"contentBasedDeduplication": true,
+			},
+		})
+		require.ErrorContains(t, err, "content-based deduplication is only supported for FIFO topics")
+	})
+
+	t.Run("fifo topic with or without suffix -> ok", func(t *testing.T) {
+		for _, name := range []string{"orders", "orders.fifo"} {
+			err := component.Setup(core.SetupContext{
+				Configuration: map[string]any{
+					"region":    "us-east-1",
+					"name":      name,
+					"fifoTopic": true,
+				},
+			})
+			require.NoError(t, err)
+		}
+	})
 }
 
 func Test__CreateTopic__Execute(t *testing.T) {
@@ -84,4 +119,79 @@ func Test__CreateTopic__Execute(t *testing.T) {
 		assert.Equal(t, "orders-events", topic.Name)
 		assert.Equal(t, "Orders Events", topic.DisplayName)
 	})
+
+	t.Run("fifo topic -> suffix is appended and fifo attributes are sent", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(strings.NewReader(`
+						<CreateTopicResponse>
+						  <CreateTopicResult>
+							<TopicArn>arn:aws:sns:us-east-1:123456789012:orders.fifo</TopicArn>
+						  </CreateTopicResult>
+						</CreateTopicResponse>
+					`)),
+				},
+				{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(strings.NewReader(`
+						<GetTopicAttributesResponse>
+						  <GetTopicAttributesResult>
+							<Attributes>
+							  <entry><key>FifoTopic</key><value>true</value></entry>
+							  <entry><key>ContentBasedDeduplication</key><value>true</value></entry>
+							</Attributes>
+						  </GetTopicAttributesResult>
+						</GetTopicAttributesResponse>
+					`)),
+				},
+			},
+		}
+
+		executionState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"region":                    "us-east-1",
+				"name":                      "orders",
+				"fifoTopic":                 true,
+				"contentBasedDeduplication": true,
+				"attributes": map[string]any{
+					"DisplayName": "Orders",
+					"FifoTopic":   "false",
+				},
+			},
+			HTTP:           httpContext,
+			ExecutionState: executionState,
+			Integration: &contexts.IntegrationContext{
+				Secrets: map[string]core.IntegrationSecret{
+					"accessKeyId":     {Name: "accessKeyId", Value: []byte("key")},
+					"secretAccessKey": {Name: "secretAccessKey", Value: []byte("secret")},
+					"sessionToken":    {Name: "sessionToken", Value: []byte("token")},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, httpContext.Requests, 2)
+
+		body, err := io.ReadAll(httpContext.Requests[0].Body)
+		require.NoError(t, err)
+		values, err := url.ParseQuery(string(body))
+		require.NoError(t, err)
+		assert.Equal(t, "CreateTopic", values.Get("Action"))
+		assert.Equal(t, "orders.fifo", values.Get("Name"))
+		assert.Equal(t, "ContentBasedDeduplication", values.Get("Attributes.entry.1.key"))
+		assert.Equal(t, "true", values.Get("Attributes.entry.1.value"))
+		assert.Equal(t, "DisplayName", values.Get("Attributes.entry.2.key"))
+		assert.Equal(t, "Orders", values.Get("Attributes.entry.2.value"))
+		assert.Equal(t, "FifoTopic", values.Get("Attributes.entry.3.key"))
+		assert.Equal(t, "true", values.Get("Attributes.entry.3.value"))
+
+		require.Len(t, executionState.Payloads, 1)
+		topic := executionState.Payloads[0].(map[string]any)["data"].(*Topic)
+		assert.Equal(t, "arn:aws:sns:us-east-1:123456789012:orders.fifo", topic.TopicArn)
+		assert.True(t, topic.FifoTopic)
+		assert.True(t, topic.ContentBasedDeduplication)
+	})
 }
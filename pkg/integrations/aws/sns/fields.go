@@ -7,11 +7,11 @@ import (
 
 func regionField() configuration.Field {
 	return configuration.Field{
-		Name:     "region",
-		Label:    "Region",
-		Type:     configuration.FieldTypeSelect,
-		Required: true,
-		Default:  "us-east-1",
+		Name:        "region",
+		Label:       "Region",
+		Type:        configuration.FieldTypeSelect,
+		Required:    false,
+		Description: "AWS region. Defaults to the integration region",
 		TypeOptions: &configuration.TypeOptions{
 			Select: &configuration.SelectTypeOptions{
 				Options: common.AllRegions,
@@ -27,12 +27,6 @@ func topicField() configuration.Field {
 		Type:        configuration.FieldTypeIntegrationResource,
 		Required:    true,
 		Description: "ARN of the SNS topic",
-		VisibilityConditions: []configuration.VisibilityCondition{
-			{
-				Field:  "region",
-				Values: []string{"*"},
-			},
-		},
 		TypeOptions: &configuration.TypeOptions{
 			Resource: &configuration.ResourceTypeOptions{
 				Type: "sns.topic",
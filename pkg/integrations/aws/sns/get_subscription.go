@@ -64,10 +64,6 @@ func (c *GetSubscription) Configuration() []configuration.Field {
 			Required:    true,
 			Description: "ARN of the SNS subscription",
 			VisibilityConditions: []configuration.VisibilityCondition{
-				{
-					Field:  "region",
-					Values: []string{"*"},
-				},
 				{
 					Field:  "topicArn",
 					Values: []string{"*"},
@@ -102,7 +98,7 @@ func (c *GetSubscription) Setup(ctx core.SetupContext) error {
 		return fmt.Errorf("failed to decode setup configuration: %w", err)
 	}
 
-	if config.Region == "" {
+	if common.ResolveRegion(ctx.Integration, config.Region) == "" {
 		return fmt.Errorf("region is required")
 	}
 
@@ -132,7 +128,7 @@ func (c *GetSubscription) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("%s: failed to load AWS credentials from integration: %w", c.Name(), err)
 	}
 
-	client := NewClient(ctx.HTTP, credentials, config.Region)
+	client := NewClient(ctx.HTTP, credentials, common.ResolveRegion(ctx.Integration, config.Region), common.EndpointOverrideFor(ctx.Integration, common.ServiceSNS))
 	subscription, err := client.GetSubscription(config.SubscriptionArn)
 	if err != nil {
 		return fmt.Errorf("failed to get subscription %q: %w", config.SubscriptionArn, err)
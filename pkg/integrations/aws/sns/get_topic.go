@@ -65,7 +65,7 @@ func (c *GetTopic) Setup(ctx core.SetupContext) error {
 		return fmt.Errorf("%s: failed to decode setup configuration: %w", c.Name(), err)
 	}
 
-	if _, err := requireRegion(config.Region); err != nil {
+	if _, err := requireRegion(ctx.Integration, config.Region); err != nil {
 		return fmt.Errorf("%s: invalid region: %w", c.Name(), err)
 	}
 
@@ -86,7 +86,7 @@ func (c *GetTopic) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("%s: failed to decode execution configuration: %w", c.Name(), err)
 	}
 
-	region, err := requireRegion(config.Region)
+	region, err := requireRegion(ctx.Integration, config.Region)
 	if err != nil {
 		return fmt.Errorf("%s: invalid region: %w", c.Name(), err)
 	}
@@ -101,7 +101,7 @@ func (c *GetTopic) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("%s: failed to load AWS credentials from integration: %w", c.Name(), err)
 	}
 
-	client := NewClient(ctx.HTTP, credentials, region)
+	client := NewClient(ctx.HTTP, credentials, region, common.EndpointOverrideFor(ctx.Integration, common.ServiceSNS))
 	topic, err := client.GetTopic(topicArn)
 	if err != nil {
 		return fmt.Errorf("%s: failed to get topic %q: %w", c.Name(), topicArn, err)
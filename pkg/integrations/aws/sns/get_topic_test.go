@@ -123,4 +123,34 @@ func Test__GetTopic__Execute(t *testing.T) {
 		assert.Equal(t, "Orders Events", topic.DisplayName)
 		assert.Equal(t, "https://sns.us-east-1.amazonaws.com/", httpContext.Requests[0].URL.String())
 	})
+	t.Run("empty region -> uses integration region", func(t *testing.T) {
+		httpContext := &contexts.HTTPContext{
+			Responses: []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`<GetTopicAttributesResponse><GetTopicAttributesResult><Attributes></Attributes></GetTopicAttributesResult></GetTopicAttributesResponse>`)),
+				},
+			},
+		}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"topicArn": "arn:aws:sns:eu-west-1:123456789012:orders-events",
+			},
+			HTTP:           httpContext,
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			Integration: &contexts.IntegrationContext{
+				Configuration: map[string]any{"region": "eu-west-1"},
+				Secrets: map[string]core.IntegrationSecret{
+					"accessKeyId":     {Name: "accessKeyId", Value: []byte("key")},
+					"secretAccessKey": {Name: "secretAccessKey", Value: []byte("secret")},
+					"sessionToken":    {Name: "sessionToken", Value: []byte("token")},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, httpContext.Requests, 1)
+		assert.Equal(t, "https://sns.eu-west-1.amazonaws.com/", httpContext.Requests[0].URL.String())
+	})
 }
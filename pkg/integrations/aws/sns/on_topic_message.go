@@ -86,7 +86,7 @@ func (t *OnTopicMessage) Setup(ctx core.TriggerContext) error {
 		return fmt.Errorf("failed to decode trigger metadata: %w", err)
 	}
 
-	region, err := requireRegion(config.Region)
+	region, err := requireRegion(ctx.Integration, config.Region)
 	if err != nil {
 		return fmt.Errorf("invalid region: %w", err)
 	}
@@ -105,7 +105,7 @@ func (t *OnTopicMessage) Setup(ctx core.TriggerContext) error {
 		return fmt.Errorf("failed to load AWS credentials from integration: %w", err)
 	}
 
-	client := NewClient(ctx.HTTP, credentials, region)
+	client := NewClient(ctx.HTTP, credentials, region, common.EndpointOverrideFor(ctx.Integration, common.ServiceSNS))
 	topic, err := client.GetTopic(topicArn)
 	if err != nil {
 		return fmt.Errorf("failed to get topic %q in region %q: %w", topicArn, region, err)
@@ -118,7 +118,7 @@ func (c *PublishMessage) Setup(ctx core.SetupContext) error {
 		return fmt.Errorf("failed to decode setup configuration: %w", err)
 	}
 
-	if _, err := requireRegion(config.Region); err != nil {
+	if _, err := requireRegion(ctx.Integration, config.Region); err != nil {
 		return fmt.Errorf("invalid region: %w", err)
 	}
 
@@ -161,7 +161,7 @@ func (c *PublishMessage) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to build publish message parameters: %w", err)
 	}
 
-	client := NewClient(ctx.HTTP, credentials, config.Region)
+	client := NewClient(ctx.HTTP, credentials, common.ResolveRegion(ctx.Integration, config.Region), common.EndpointOverrideFor(ctx.Integration, common.ServiceSNS))
 	result, err := client.PublishMessage(*params)
 	if err != nil {
 		return fmt.Errorf("failed to publish message to topic %q: %w", config.TopicArn, err)
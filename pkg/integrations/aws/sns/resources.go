@@ -9,7 +9,7 @@ import (
 )
 
 func ListTopics(ctx core.ListResourcesContext, resourceType string) ([]core.IntegrationResource, error) {
-	region := strings.TrimSpace(ctx.Parameters["region"])
+	region := common.ResolveRegion(ctx.Integration, ctx.Parameters["region"])
 	if region == "" {
 		return nil, fmt.Errorf("list SNS topics: region is required")
 	}
@@ -19,7 +19,7 @@ func ListTopics(ctx core.ListResourcesContext, resourceType string) ([]core.Inte
 		return nil, fmt.Errorf("list SNS topics: failed to load AWS credentials from integration: %w", err)
 	}
 
-	client := NewClient(ctx.HTTP, credentials, region)
+	client := NewClient(ctx.HTTP, credentials, region, common.EndpointOverrideFor(ctx.Integration, common.ServiceSNS))
 	topics, err := client.ListTopics()
 	if err != nil {
 		return nil, fmt.Errorf("list SNS topics: failed to list topics in region %q: %w", region, err)
@@ -38,7 +38,7 @@ func ListTopics(ctx core.ListResourcesContext, resourceType string) ([]core.Inte
 }
 
 func ListSubscriptions(ctx core.ListResourcesContext, resourceType string) ([]core.IntegrationResource, error) {
-	region := ctx.Parameters["region"]
+	region := common.ResolveRegion(ctx.Integration, ctx.Parameters["region"])
 	if region == "" {
 		return nil, fmt.Errorf("region is required")
 	}
@@ -55,7 +55,7 @@ func ListSubscriptions(ctx core.ListResourcesContext, resourceType string) ([]co
 		return nil, fmt.Errorf("failed to load AWS credentials from integration: %w", err)
 	}
 
-	client := NewClient(ctx.HTTP, credentials, region)
+	client := NewClient(ctx.HTTP, credentials, region, common.EndpointOverrideFor(ctx.Integration, common.ServiceSNS))
 	subscriptions, err := client.ListSubscriptionsByTopic(topicArn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list subscriptions in region %q: %w", region, err)
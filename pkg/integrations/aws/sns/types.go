@@ -39,6 +39,12 @@ type PublishMessageParameters struct {
 	MessageAttributes map[string]string
 }
 
+// CreateTopicParameters defines the arguments for a create topic operation.
+type CreateTopicParameters struct {
+	Name       string
+	Attributes map[string]string
+}
+
 // SubscribeParameters defines the arguments for a subscribe operation.
 type SubscribeParameters struct {
 	TopicArn              string
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
 )
 
 // requireRegion validates and normalizes region values.
-func requireRegion(region string) (string, error) {
-	normalized := strings.TrimSpace(region)
+// An empty region falls back to the integration region.
+func requireRegion(integration core.IntegrationContext, region string) (string, error) {
+	normalized := common.ResolveRegion(integration, region)
 	if normalized == "" {
 		return "", fmt.Errorf("region is required")
 	}
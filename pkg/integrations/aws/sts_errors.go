@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
+)
+
+/*
+ * A role that cannot be assumed leaves the integration without credentials,
+ * so the STS error is turned into a message telling the user what to fix,
+ * which ends up in the integration status.
+ * If the identity provider or the trust policy of the role are misconfigured,
+ * the browser action is shown again, with a hint on what to check.
+ */
+func (a *AWS) credentialsError(ctx core.SyncContext, config Configuration, err error) error {
+	provider := oidcProviderHost(ctx.BaseURL)
+	audience := ctx.Integration.ID().String()
+
+	switch {
+	case common.IsSTSErr(err, common.STSErrorInvalidIdentityToken), common.IsSTSErr(err, common.STSErrorIDPRejectedClaim):
+		a.showIdentityProviderBrowserAction(ctx)
+		return fmt.Errorf("AWS rejected the SuperPlane identity token, check that the IAM identity provider for %s exists with audience %s: %w", ctx.BaseURL, audience, err)
+
+	case common.IsSTSErr(err, common.STSErrorAccessDenied):
+		a.showTrustPolicyBrowserAction(ctx, config, provider, audience)
+		return fmt.Errorf("AWS denied assuming role %s, check that its trust policy allows the %s identity provider with audience %s: %w", config.RoleArn, provider, audience, err)
+
+	case common.IsSTSErr(err, common.STSErrorExpiredToken):
+		return fmt.Errorf("the SuperPlane identity token expired before AWS accepted it, check the clock of the SuperPlane server: %w", err)
+
+	case common.IsSTSErr(err, common.STSErrorIDPCommunicationError):
+		return fmt.Errorf("AWS could not reach the SuperPlane identity provider, check that %s is publicly reachable: %w", ctx.BaseURL, err)
+
+	case common.IsSTSErr(err, common.STSErrorRegionDisabled):
+		return fmt.Errorf("STS is not active in region %s, activate it in the IAM account settings: %w", config.Region, err)
+	}
+
+	return fmt.Errorf("failed to generate credentials: %v", err)
+}
+
+func (a *AWS) showIdentityProviderBrowserAction(ctx core.SyncContext) {
+	ctx.Integration.NewBrowserAction(core.BrowserAction{
+		Description: `
+**AWS rejected the SuperPlane identity token**
+
+Make sure the identity provider exists in the AWS account of the role,
+with the exact provider URL and audience below, and that the role trusts it.
+` + setupInstructions(ctx),
+	})
+}
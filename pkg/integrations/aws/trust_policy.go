@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/iam"
+)
+
+type trustPolicy struct {
+	Statement trustPolicyStatements `json:"Statement"`
+}
+
+type trustPolicyStatement struct {
+	Effect    string                    `json:"Effect"`
+	Action    any                       `json:"Action"`
+	Principal map[string]any            `json:"Principal"`
+	Condition map[string]map[string]any `json:"Condition"`
+}
+
+/*
+ * IAM policies accept a single statement object
+ * in place of the list of statements.
+ */
+type trustPolicyStatements []trustPolicyStatement
+
+func (s *trustPolicyStatements) UnmarshalJSON(data []byte) error {
+	var statements []trustPolicyStatement
+	if err := json.Unmarshal(data, &statements); err == nil {
+		*s = statements
+		return nil
+	}
+
+	var statement trustPolicyStatement
+	if err := json.Unmarshal(data, &statement); err != nil {
+		return err
+	}
+
+	*s = []trustPolicyStatement{statement}
+	return nil
+}
+
+/*
+ * A role whose trust policy does not point to our OIDC provider and audience
+ * only fails later, when the integration uses it, so we check it right after assuming it.
+ * The role itself might not be allowed to read its own trust policy,
+ * and in that case we cannot tell, so the check is skipped.
+ */
+func (a *AWS) validateTrustPolicy(ctx core.SyncContext, config Configuration, credentials *aws.Credentials) error {
+	roleName, err := common.RoleNameFromRoleArn(config.RoleArn)
+	if err != nil {
+		return err
+	}
+
+	document, err := iam.NewClient(ctx.HTTP, credentials, common.PartitionFromArn(config.RoleArn)).GetRoleTrustPolicy(roleName)
+	if err != nil {
+		ctx.Logger.Warnf("Skipping trust policy validation for role %s: %v", config.RoleArn, err)
+		return nil
+	}
+
+	policy := trustPolicy{}
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		return fmt.Errorf("failed to parse trust policy of role %s: %w", config.RoleArn, err)
+	}
+
+	provider := oidcProviderHost(ctx.BaseURL)
+	audience := ctx.Integration.ID().String()
+	if policy.trusts(provider, audience) {
+		return nil
+	}
+
+	a.showTrustPolicyBrowserAction(ctx, config, provider, audience)
+	return fmt.Errorf("trust policy of role %s does not allow the %s identity provider with audience %s", config.RoleArn, provider, audience)
+}
+
+func (p *trustPolicy) trusts(provider, audience string) bool {
+	for _, statement := range p.Statement {
+		if statement.trusts(provider, audience) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *trustPolicyStatement) trusts(provider, audience string) bool {
+	if s.Effect != "Allow" {
+		return false
+	}
+
+	if !slices.Contains(policyValues(s.Action), "sts:AssumeRoleWithWebIdentity") {
+		return false
+	}
+
+	federated := policyValues(s.Principal["Federated"])
+	if !slices.ContainsFunc(federated, func(principal string) bool {
+		return strings.HasSuffix(principal, ":oidc-provider/"+provider)
+	}) {
+		return false
+	}
+
+	audienceKey := provider + ":aud"
+	for _, operator := range []string{"StringEquals", "StringLike"} {
+		if slices.Contains(policyValues(s.Condition[operator][audienceKey]), audience) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+ * Policy values can either be a single string or a list of strings.
+ */
+func policyValues(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := []string{}
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+
+		return values
+	}
+
+	return nil
+}
+
+/*
+ * IAM identifies OIDC providers by their URL without the scheme.
+ */
+func oidcProviderHost(baseURL string) string {
+	host := strings.TrimPrefix(baseURL, "https://")
+	host = strings.TrimPrefix(host, "http://")
+	return strings.TrimSuffix(host, "/")
+}
+
+func (a *AWS) showTrustPolicyBrowserAction(ctx core.SyncContext, config Configuration, provider, audience string) {
+	ctx.Integration.NewBrowserAction(core.BrowserAction{
+		Description: fmt.Sprintf(`
+**The trust policy of the IAM role does not allow SuperPlane to assume it**
+
+- Go to AWS IAM Console → Roles → **%s** → Trust relationships → Edit trust policy
+- Make sure it has a statement allowing the **sts:AssumeRoleWithWebIdentity** action
+- The statement principal must be the identity provider for **%s**
+- The statement must have a **StringEquals** condition on **%s:aud** with the value **%s**
+- Save the trust policy, and update the installation to check it again
+`, config.RoleArn, ctx.BaseURL, provider, audience),
+	})
+}
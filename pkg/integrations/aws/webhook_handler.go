@@ -31,7 +31,7 @@ func (h *WebhookHandler) setupSNS(ctx core.WebhookHandlerContext, config common.
 		return nil, fmt.Errorf("failed to load AWS credentials from integration: %w", err)
 	}
 
-	client := sns.NewClient(ctx.HTTP, credentials, config.Region)
+	client := sns.NewClient(ctx.HTTP, credentials, config.Region, common.EndpointOverrideFor(ctx.Integration, common.ServiceSNS))
 	subscription, err := client.Subscribe(sns.SubscribeParameters{
 		TopicArn:              config.SNS.TopicArn,
 		Protocol:              "https",
@@ -73,7 +73,7 @@ func (h *WebhookHandler) cleanupSNS(ctx core.WebhookHandlerContext, region strin
 		return fmt.Errorf("cleanup SNS: failed to load AWS credentials from integration: %w", err)
 	}
 
-	client := sns.NewClient(ctx.HTTP, credentials, region)
+	client := sns.NewClient(ctx.HTTP, credentials, region, common.EndpointOverrideFor(ctx.Integration, common.ServiceSNS))
 	err = client.Unsubscribe(metadata.SubscriptionArn)
 	if err != nil && !common.IsNotFoundErr(err) {
 		return fmt.Errorf("cleanup SNS: failed to unsubscribe existing subscription %q in region %q: %w", metadata.SubscriptionArn, region, err)
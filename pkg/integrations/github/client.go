@@ -1,6 +1,7 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -49,3 +50,33 @@ func findSecret(ctx core.IntegrationContext, secretName string) (string, error)
 
 	return "", fmt.Errorf("secret %s not found", secretName)
 }
+
+/*
+ * Lists all the repositories the app installation has access to,
+ * going through all the pages of the GitHub API response.
+ */
+func listInstallationRepositories(client *github.Client) ([]Repository, error) {
+	repos := []Repository{}
+	options := &github.ListOptions{PerPage: 100}
+
+	for {
+		response, httpResponse, err := client.Apps.ListRepos(context.Background(), options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range response.Repositories {
+			repos = append(repos, Repository{
+				ID:   r.GetID(),
+				Name: r.GetName(),
+				URL:  r.GetHTMLURL(),
+			})
+		}
+
+		if httpResponse.NextPage == 0 {
+			return repos, nil
+		}
+
+		options.Page = httpResponse.NextPage
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/go-github/v74/github"
 	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
 )
@@ -19,8 +20,15 @@ type Repository struct {
 	URL  string `json:"url"`
 }
 
+/*
+ * Number of webhook delivery IDs kept in the node metadata,
+ * used to detect redeliveries.
+ */
+const MaxRecentDeliveries = 50
+
 type NodeMetadata struct {
-	Repository *Repository `json:"repository"`
+	Repository       *Repository `json:"repository"`
+	RecentDeliveries []string    `json:"recentDeliveries,omitempty" mapstructure:"recentDeliveries"`
 }
 
 func ensureRepoInMetadata(ctx core.MetadataContext, app core.IntegrationContext, configuration any) error {
@@ -103,6 +111,66 @@ func verifySignature(ctx core.WebhookRequestContext) (int, error) {
 	return http.StatusOK, nil
 }
 
+/*
+ * GitHub can deliver the same webhook more than once, e.g. when a delivery
+ * is retried manually. Every delivery has a unique X-GitHub-Delivery ID,
+ * and the most recent ones are kept in the node metadata,
+ * so the same delivery does not start more than one execution.
+ */
+func isRedelivery(ctx core.WebhookRequestContext) (bool, error) {
+	deliveryID := ctx.Headers.Get("X-GitHub-Delivery")
+	if deliveryID == "" {
+		return false, nil
+	}
+
+	var nodeMetadata NodeMetadata
+	err := mapstructure.Decode(ctx.Metadata.Get(), &nodeMetadata)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode node metadata: %w", err)
+	}
+
+	return slices.Contains(nodeMetadata.RecentDeliveries, deliveryID), nil
+}
+
+func recordDelivery(ctx core.WebhookRequestContext) error {
+	deliveryID := ctx.Headers.Get("X-GitHub-Delivery")
+	if deliveryID == "" {
+		return nil
+	}
+
+	var nodeMetadata NodeMetadata
+	err := mapstructure.Decode(ctx.Metadata.Get(), &nodeMetadata)
+	if err != nil {
+		return fmt.Errorf("failed to decode node metadata: %w", err)
+	}
+
+	deliveries := append(nodeMetadata.RecentDeliveries, deliveryID)
+	if len(deliveries) > MaxRecentDeliveries {
+		deliveries = deliveries[len(deliveries)-MaxRecentDeliveries:]
+	}
+
+	nodeMetadata.RecentDeliveries = deliveries
+	return ctx.Metadata.Set(nodeMetadata)
+}
+
+/*
+ * Returns true if there are no path predicates configured,
+ * or if any of the changed files matches one of them.
+ */
+func matchesAnyPath(predicates []configuration.Predicate, files []string) bool {
+	if len(predicates) == 0 {
+		return true
+	}
+
+	for _, file := range files {
+		if configuration.MatchesAnyPredicate(predicates, file) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func fetchReleaseByStrategy(client *github.Client, owner, repo, strategy, tagName string) (*github.RepositoryRelease, error) {
 	switch strategy {
 	case "specific":
@@ -0,0 +1,286 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v74/github"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type CreateDeploymentStatus struct{}
+
+type CreateDeploymentStatusConfiguration struct {
+	Repository     string `mapstructure:"repository"`
+	DeploymentID   any    `mapstructure:"deploymentId"`
+	State          string `mapstructure:"state"`
+	Environment    string `mapstructure:"environment"`
+	Description    string `mapstructure:"description"`
+	LogURL         string `mapstructure:"logUrl"`
+	EnvironmentURL string `mapstructure:"environmentUrl"`
+}
+
+var deploymentStates = []string{
+	"success",
+	"failure",
+	"error",
+	"inactive",
+	"in_progress",
+	"queued",
+	"pending",
+}
+
+func (c *CreateDeploymentStatus) Name() string {
+	return "github.createDeploymentStatus"
+}
+
+func (c *CreateDeploymentStatus) Label() string {
+	return "Create Deployment Status"
+}
+
+func (c *CreateDeploymentStatus) Description() string {
+	return "Report the status of a GitHub deployment"
+}
+
+func (c *CreateDeploymentStatus) Documentation() string {
+	return `The Create Deployment Status component posts a status for an existing GitHub deployment, so the result of a deploy orchestrated by SuperPlane shows up in GitHub.
+
+## Use Cases
+
+- **Deployment tracking**: Mark GitHub deployments as in progress, successful or failed
+- **Environment links**: Point the deployment to the environment URL and the deployment logs
+- **Rollbacks**: Mark previous deployments as inactive
+
+## Configuration
+
+- **Repository**: Select the GitHub repository
+- **Deployment ID**: The ID of the GitHub deployment (supports expressions)
+- **State**: The deployment state - success, failure, error, inactive, in_progress, queued or pending
+- **Environment**: Name of the environment the deployment was made to (optional)
+- **Description**: Short description of the status (max 140 characters, optional)
+- **Log URL**: Link to the deployment logs (optional)
+- **Environment URL**: Link to the deployed environment (optional)
+
+## Output
+
+Returns the created deployment status object.`
+}
+
+func (c *CreateDeploymentStatus) Icon() string {
+	return "github"
+}
+
+func (c *CreateDeploymentStatus) Color() string {
+	return "gray"
+}
+
+func (c *CreateDeploymentStatus) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{core.DefaultOutputChannel}
+}
+
+func (c *CreateDeploymentStatus) Configuration() []configuration.Field {
+	stateOptions := make([]configuration.FieldOption, 0, len(deploymentStates))
+	for _, state := range deploymentStates {
+		stateOptions = append(stateOptions, configuration.FieldOption{
+			Label: deploymentStateLabel(state),
+			Value: state,
+		})
+	}
+
+	return []configuration.Field{
+		{
+			Name:     "repository",
+			Label:    "Repository",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type:           "repository",
+					UseNameAsValue: true,
+				},
+			},
+		},
+		{
+			Name:        "deploymentId",
+			Label:       "Deployment ID",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Placeholder: "e.g., 1234567 or {{event.data.deployment.id}}",
+			Description: "The ID of the GitHub deployment",
+		},
+		{
+			Name:     "state",
+			Label:    "State",
+			Type:     configuration.FieldTypeSelect,
+			Required: true,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: stateOptions,
+				},
+			},
+		},
+		{
+			Name:        "environment",
+			Label:       "Environment",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Placeholder: "e.g., production",
+			Description: "Name of the environment the deployment was made to",
+		},
+		{
+			Name:        "description",
+			Label:       "Description",
+			Type:        configuration.FieldTypeText,
+			Required:    false,
+			Placeholder: "e.g., Deployed by SuperPlane",
+			Description: "Short description of the status (max 140 characters)",
+		},
+		{
+			Name:        "logUrl",
+			Label:       "Log URL",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Placeholder: "https://...",
+			Description: "Link to the deployment logs",
+		},
+		{
+			Name:        "environmentUrl",
+			Label:       "Environment URL",
+			Type:        configuration.FieldTypeString,
+			Required:    false,
+			Placeholder: "https://...",
+			Description: "Link to the deployed environment",
+		},
+	}
+}
+
+func deploymentStateLabel(state string) string {
+	label := strings.ReplaceAll(state, "_", " ")
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+/*
+ * The deployment ID usually comes from an expression,
+ * so it can either be a string or a number.
+ */
+func parseDeploymentID(value any) (int64, error) {
+	switch v := value.(type) {
+	case float64:
+		return int64(v), nil
+	case int:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case string:
+		id, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid deployment ID %q: must be a number", v)
+		}
+
+		return id, nil
+	default:
+		return 0, fmt.Errorf("deployment ID is required")
+	}
+}
+
+func (c *CreateDeploymentStatus) Setup(ctx core.SetupContext) error {
+	return ensureRepoInMetadata(
+		ctx.Metadata,
+		ctx.Integration,
+		ctx.Configuration,
+	)
+}
+
+func (c *CreateDeploymentStatus) Execute(ctx core.ExecutionContext) error {
+	var config CreateDeploymentStatusConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	deploymentID, err := parseDeploymentID(config.DeploymentID)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(deploymentStates, config.State) {
+		return fmt.Errorf("invalid deployment state %q", config.State)
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &appMetadata); err != nil {
+		return fmt.Errorf("failed to decode integration metadata: %w", err)
+	}
+
+	client, err := NewClient(ctx.Integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	request := &github.DeploymentStatusRequest{
+		State: &config.State,
+	}
+
+	if config.Environment != "" {
+		request.Environment = &config.Environment
+	}
+
+	if config.Description != "" {
+		request.Description = &config.Description
+	}
+
+	if config.LogURL != "" {
+		request.LogURL = &config.LogURL
+	}
+
+	if config.EnvironmentURL != "" {
+		request.EnvironmentURL = &config.EnvironmentURL
+	}
+
+	status, _, err := client.Repositories.CreateDeploymentStatus(
+		context.Background(),
+		appMetadata.Owner,
+		config.Repository,
+		deploymentID,
+		request,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create deployment status: %w", err)
+	}
+
+	return ctx.ExecutionState.Emit(
+		core.DefaultOutputChannel.Name,
+		"github.deploymentStatus",
+		[]any{status},
+	)
+}
+
+func (c *CreateDeploymentStatus) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CreateDeploymentStatus) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return 200, nil
+}
+
+func (c *CreateDeploymentStatus) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (c *CreateDeploymentStatus) HandleAction(ctx core.ActionContext) error {
+	return nil
+}
+
+func (c *CreateDeploymentStatus) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CreateDeploymentStatus) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
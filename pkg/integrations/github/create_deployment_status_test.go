@@ -0,0 +1,93 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	contexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__CreateDeploymentStatus__Setup(t *testing.T) {
+	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
+	component := CreateDeploymentStatus{}
+
+	t.Run("repository is required", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{}
+		err := component.Setup(core.SetupContext{
+			Integration:   integrationCtx,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": ""},
+		})
+
+		require.ErrorContains(t, err, "repository is required")
+	})
+
+	t.Run("repository is not accessible", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			Metadata: Metadata{
+				Repositories: []Repository{helloRepo},
+			},
+		}
+		err := component.Setup(core.SetupContext{
+			Integration:   integrationCtx,
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"repository": "world"},
+		})
+
+		require.ErrorContains(t, err, "repository world is not accessible to app installation")
+	})
+
+	t.Run("metadata is set successfully", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			Metadata: Metadata{
+				Repositories: []Repository{helloRepo},
+			},
+		}
+
+		nodeMetadataCtx := contexts.MetadataContext{}
+		require.NoError(t, component.Setup(core.SetupContext{
+			Integration:   integrationCtx,
+			Metadata:      &nodeMetadataCtx,
+			Configuration: map[string]any{"repository": "hello"},
+		}))
+
+		require.Equal(t, nodeMetadataCtx.Get(), NodeMetadata{Repository: &helloRepo})
+	})
+}
+
+func Test__CreateDeploymentStatus__Execute(t *testing.T) {
+	component := CreateDeploymentStatus{}
+
+	t.Run("invalid deployment ID -> error", func(t *testing.T) {
+		err := component.Execute(core.ExecutionContext{
+			Integration:   &contexts.IntegrationContext{},
+			Configuration: map[string]any{"repository": "hello", "deploymentId": "abc", "state": "success"},
+		})
+
+		require.ErrorContains(t, err, `invalid deployment ID "abc"`)
+	})
+
+	t.Run("invalid state -> error", func(t *testing.T) {
+		err := component.Execute(core.ExecutionContext{
+			Integration:   &contexts.IntegrationContext{},
+			Configuration: map[string]any{"repository": "hello", "deploymentId": "123", "state": "done"},
+		})
+
+		require.ErrorContains(t, err, `invalid deployment state "done"`)
+	})
+}
+
+func Test__ParseDeploymentID(t *testing.T) {
+	id, err := parseDeploymentID("1234567")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1234567), id)
+
+	id, err = parseDeploymentID(float64(1234567))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1234567), id)
+
+	_, err = parseDeploymentID(nil)
+	require.ErrorContains(t, err, "deployment ID is required")
+}
@@ -22,6 +22,9 @@ var exampleOutputUpdateIssueBytes []byte
 //go:embed example_output_publish_commit_status.json
 var exampleOutputPublishCommitStatusBytes []byte
 
+//go:embed example_output_create_deployment_status.json
+var exampleOutputCreateDeploymentStatusBytes []byte
+
 //go:embed example_output_create_release.json
 var exampleOutputCreateReleaseBytes []byte
 
@@ -82,6 +85,9 @@ var exampleOutputUpdateIssue map[string]any
 var exampleOutputPublishCommitStatusOnce sync.Once
 var exampleOutputPublishCommitStatus map[string]any
 
+var exampleOutputCreateDeploymentStatusOnce sync.Once
+var exampleOutputCreateDeploymentStatus map[string]any
+
 var exampleOutputCreateReleaseOnce sync.Once
 var exampleOutputCreateRelease map[string]any
 
@@ -151,6 +157,14 @@ func (c *PublishCommitStatus) ExampleOutput() map[string]any {
 	)
 }
 
+func (c *CreateDeploymentStatus) ExampleOutput() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(
+		&exampleOutputCreateDeploymentStatusOnce,
+		exampleOutputCreateDeploymentStatusBytes,
+		&exampleOutputCreateDeploymentStatus,
+	)
+}
+
 func (c *CreateRelease) ExampleOutput() map[string]any {
 	return utils.UnmarshalEmbeddedJSON(&exampleOutputCreateReleaseOnce, exampleOutputCreateReleaseBytes, &exampleOutputCreateRelease)
 }
@@ -100,6 +100,7 @@ func (g *GitHub) Components() []core.Component {
 		&CreateReview{},
 		&RunWorkflow{},
 		&PublishCommitStatus{},
+		&CreateDeploymentStatus{},
 		&CreateRelease{},
 		&GetRelease{},
 		&UpdateRelease{},
@@ -292,22 +293,13 @@ func (g *GitHub) handleInstallationRepositoriesEvent(ctx core.HTTPRequestContext
 		return
 	}
 
-	response, _, err := client.Apps.ListRepos(context.Background(), &github.ListOptions{})
+	repos, err := listInstallationRepositories(client)
 	if err != nil {
 		ctx.Logger.Errorf("failed to list repos: %v", err)
 		http.Error(ctx.Response, "internal server error", http.StatusInternalServerError)
 		return
 	}
 
-	repos := []Repository{}
-	for _, r := range response.Repositories {
-		repos = append(repos, Repository{
-			ID:   *r.ID,
-			Name: r.GetName(),
-			URL:  r.GetHTMLURL(),
-		})
-	}
-
 	ctx.Logger.Infof("Updated repositories: %v", repos)
 
 	metadata.Repositories = repos
@@ -436,22 +428,13 @@ func (g *GitHub) afterAppInstallation(ctx core.HTTPRequestContext, metadata Meta
 		metadata.Owner = ghApp.Owner.GetLogin()
 	}
 
-	response, _, err := client.Apps.ListRepos(context.Background(), &github.ListOptions{})
+	repos, err := listInstallationRepositories(client)
 	if err != nil {
 		ctx.Logger.Errorf("failed to list repos: %v", err)
 		http.Error(ctx.Response, "internal server error", http.StatusInternalServerError)
 		return
 	}
 
-	repos := []Repository{}
-	for _, r := range response.Repositories {
-		repos = append(repos, Repository{
-			ID:   *r.ID,
-			Name: r.GetName(),
-			URL:  r.GetHTMLURL(),
-		})
-	}
-
 	metadata.Repositories = repos
 	metadata.State = ""
 
@@ -492,6 +475,7 @@ func (g *GitHub) appManifest(ctx core.SyncContext) string {
 			"pull_requests":    "write",
 			"repository_hooks": "write",
 			"statuses":         "write",
+			"deployments":      "write",
 		},
 		"setup_url":    fmt.Sprintf(`%s/api/v1/integrations/%s/setup`, ctx.BaseURL, ctx.Integration.ID().String()),
 		"redirect_url": fmt.Sprintf(`%s/api/v1/integrations/%s/redirect`, ctx.BaseURL, ctx.Integration.ID().String()),
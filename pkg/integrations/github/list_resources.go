@@ -17,8 +17,25 @@ func (g *GitHub) ListResources(resourceType string, ctx core.ListResourcesContex
 		return nil, fmt.Errorf("failed to decode application metadata: %w", err)
 	}
 
-	resources := make([]core.IntegrationResource, 0, len(metadata.Repositories))
-	for _, repo := range metadata.Repositories {
+	//
+	// The app is not installed yet, so there are no repositories to list.
+	//
+	if metadata.InstallationID == "" {
+		return []core.IntegrationResource{}, nil
+	}
+
+	client, err := NewClient(ctx.Integration, metadata.GitHubApp.ID, metadata.InstallationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitHub client: %w", err)
+	}
+
+	repos, err := listInstallationRepositories(client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	resources := make([]core.IntegrationResource, 0, len(repos))
+	for _, repo := range repos {
 		resources = append(resources, core.IntegrationResource{
 			Type: resourceType,
 			Name: repo.Name,
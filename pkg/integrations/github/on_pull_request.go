@@ -1,12 +1,14 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 	"slices"
 
+	"github.com/google/go-github/v74/github"
 	"github.com/mitchellh/mapstructure"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
@@ -15,8 +17,10 @@ import (
 type OnPullRequest struct{}
 
 type OnPullRequestConfiguration struct {
-	Repository string   `json:"repository" mapstructure:"repository"`
-	Actions    []string `json:"actions" mapstructure:"actions"`
+	Repository string                    `json:"repository" mapstructure:"repository"`
+	Actions    []string                  `json:"actions" mapstructure:"actions"`
+	Branches   []configuration.Predicate `json:"branches" mapstructure:"branches"`
+	Paths      []configuration.Predicate `json:"paths" mapstructure:"paths"`
 }
 
 func (p *OnPullRequest) Name() string {
@@ -45,6 +49,8 @@ func (p *OnPullRequest) Documentation() string {
 
 - **Repository**: Select the GitHub repository to monitor
 - **Actions**: Select which PR actions to listen for (opened, closed, synchronize, etc.)
+- **Branches**: Optional filter on the base branch of the PR (e.g., ` + "`main`" + `)
+- **Paths**: Optional filter on the files changed by the PR (e.g., ` + "`^services/api/`" + `). If set, only PRs changing at least one matching file trigger
 
 ## Event Data
 
@@ -56,7 +62,9 @@ Each PR event includes:
 
 ## Webhook Setup
 
-This trigger automatically sets up a GitHub webhook when configured. The webhook is managed by SuperPlane and will be cleaned up when the trigger is removed.`
+This trigger automatically sets up a GitHub webhook when configured. The webhook is managed by SuperPlane and will be cleaned up when the trigger is removed.
+
+Redeliveries of the same webhook, identified by the ` + "`X-GitHub-Delivery`" + ` header, are ignored.`
 }
 
 func (p *OnPullRequest) Icon() string {
@@ -102,6 +110,30 @@ func (p *OnPullRequest) Configuration() []configuration.Field {
 				},
 			},
 		},
+		{
+			Name:        "branches",
+			Label:       "Base branches",
+			Type:        configuration.FieldTypeAnyPredicateList,
+			Description: "Only trigger for PRs targeting one of these branches",
+			Required:    false,
+			TypeOptions: &configuration.TypeOptions{
+				AnyPredicateList: &configuration.AnyPredicateListTypeOptions{
+					Operators: configuration.AllPredicateOperators,
+				},
+			},
+		},
+		{
+			Name:        "paths",
+			Label:       "Paths",
+			Type:        configuration.FieldTypeAnyPredicateList,
+			Description: "Only trigger if the PR changes a file matching one of these",
+			Required:    false,
+			TypeOptions: &configuration.TypeOptions{
+				AnyPredicateList: &configuration.AnyPredicateListTypeOptions{
+					Operators: configuration.AllPredicateOperators,
+				},
+			},
+		},
 	}
 }
 
@@ -166,15 +198,115 @@ func (p *OnPullRequest) HandleWebhook(ctx core.WebhookRequestContext) (int, erro
 		return http.StatusOK, nil
 	}
 
+	if len(config.Branches) > 0 && !configuration.MatchesAnyPredicate(config.Branches, pullRequestBaseBranch(data)) {
+		return http.StatusOK, nil
+	}
+
+	redelivery, err := isRedelivery(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if redelivery {
+		ctx.Logger.Infof("Ignoring redelivery %s", ctx.Headers.Get("X-GitHub-Delivery"))
+		return http.StatusOK, nil
+	}
+
+	//
+	// The webhook payload does not include the files changed by the PR,
+	// so we only fetch them from the GitHub API if a path filter is configured.
+	//
+	if len(config.Paths) > 0 {
+		files, err := pullRequestFiles(ctx.Integration, data)
+		if err != nil {
+			return http.StatusInternalServerError, fmt.Errorf("error listing pull request files: %v", err)
+		}
+
+		if !matchesAnyPath(config.Paths, files) {
+			return http.StatusOK, nil
+		}
+	}
+
 	err = ctx.Events.Emit("github.pullRequest", data)
 
 	if err != nil {
 		return http.StatusInternalServerError, fmt.Errorf("error emitting event: %v", err)
 	}
 
+	err = recordDelivery(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("error recording delivery: %v", err)
+	}
+
 	return http.StatusOK, nil
 }
 
+func pullRequestBaseBranch(data map[string]any) string {
+	pr, ok := data["pull_request"].(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	base, ok := pr["base"].(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	ref, _ := base["ref"].(string)
+	return ref
+}
+
+func pullRequestFiles(integration core.IntegrationContext, data map[string]any) ([]string, error) {
+	number, ok := data["number"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("missing pull request number")
+	}
+
+	repository, ok := data["repository"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("missing repository")
+	}
+
+	repo, _ := repository["name"].(string)
+	owner := ""
+	if o, ok := repository["owner"].(map[string]any); ok {
+		owner, _ = o["login"].(string)
+	}
+
+	if owner == "" || repo == "" {
+		return nil, fmt.Errorf("missing repository owner or name")
+	}
+
+	var appMetadata Metadata
+	if err := mapstructure.Decode(integration.GetMetadata(), &appMetadata); err != nil {
+		return nil, fmt.Errorf("failed to decode integration metadata: %w", err)
+	}
+
+	client, err := NewClient(integration, appMetadata.GitHubApp.ID, appMetadata.InstallationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize GitHub client: %w", err)
+	}
+
+	files := []string{}
+	options := &github.ListOptions{PerPage: 100}
+	for {
+		page, response, err := client.PullRequests.ListFiles(context.Background(), owner, repo, int(number), options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range page {
+			files = append(files, file.GetFilename())
+		}
+
+		if response.NextPage == 0 {
+			return files, nil
+		}
+
+		options.Page = response.NextPage
+	}
+}
+
 func whitelistedAction(data map[string]any, allowed []string) bool {
 	action, ok := data["action"]
 	if !ok {
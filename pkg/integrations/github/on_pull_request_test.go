@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	contexts "github.com/superplanehq/superplane/test/support/contexts"
 )
@@ -123,6 +125,77 @@ func Test__OnPullRequest__HandleWebhook(t *testing.T) {
 	})
 }
 
+func Test__OnPullRequest__HandleWebhook__BranchesAndRedeliveries(t *testing.T) {
+	trigger := &OnPullRequest{}
+	secret := "test-secret"
+	body := []byte(`{"action":"opened","number":1,"pull_request":{"base":{"ref":"main"}}}`)
+
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(body)
+	signature := fmt.Sprintf("%x", h.Sum(nil))
+
+	newRequest := func(branches []configuration.Predicate, metadata *contexts.MetadataContext, events *contexts.EventContext) core.WebhookRequestContext {
+		headers := http.Header{}
+		headers.Set("X-Hub-Signature-256", "sha256="+signature)
+		headers.Set("X-GitHub-Event", "pull_request")
+		headers.Set("X-GitHub-Delivery", "delivery-1")
+
+		return core.WebhookRequestContext{
+			Body:    body,
+			Headers: headers,
+			Configuration: map[string]any{
+				"repository": "test",
+				"actions":    []string{"opened"},
+				"branches":   branches,
+			},
+			Metadata: metadata,
+			Logger:   logrus.NewEntry(logrus.New()),
+			Webhook:  &contexts.WebhookContext{Secret: secret},
+			Events:   events,
+		}
+	}
+
+	t.Run("base branch matches -> event is emitted", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		branches := []configuration.Predicate{{Type: configuration.PredicateTypeEquals, Value: "main"}}
+		code, err := trigger.HandleWebhook(newRequest(branches, &contexts.MetadataContext{}, events))
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, events.Count())
+	})
+
+	t.Run("base branch does not match -> event is not emitted", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		branches := []configuration.Predicate{{Type: configuration.PredicateTypeEquals, Value: "release"}}
+		code, err := trigger.HandleWebhook(newRequest(branches, &contexts.MetadataContext{}, events))
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.NoError(t, err)
+		assert.Zero(t, events.Count())
+	})
+
+	t.Run("redelivery -> event is emitted only once", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		metadata := &contexts.MetadataContext{}
+
+		_, err := trigger.HandleWebhook(newRequest(nil, metadata, events))
+		require.NoError(t, err)
+		_, err = trigger.HandleWebhook(newRequest(nil, metadata, events))
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, events.Count())
+	})
+}
+
+func Test__PullRequestBaseBranch(t *testing.T) {
+	assert.Equal(t, "main", pullRequestBaseBranch(map[string]any{
+		"pull_request": map[string]any{"base": map[string]any{"ref": "main"}},
+	}))
+
+	assert.Empty(t, pullRequestBaseBranch(map[string]any{}))
+}
+
 func Test__OnPullRequest__Setup(t *testing.T) {
 	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
 	trigger := OnPullRequest{}
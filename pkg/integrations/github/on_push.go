@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/superplanehq/superplane/pkg/configuration"
@@ -15,6 +16,7 @@ type OnPush struct{}
 type OnPushConfiguration struct {
 	Repository string                    `json:"repository" mapstructure:"repository"`
 	Refs       []configuration.Predicate `json:"refs" mapstructure:"refs"`
+	Paths      []configuration.Predicate `json:"paths" mapstructure:"paths"`
 }
 
 func (p *OnPush) Name() string {
@@ -43,6 +45,7 @@ func (p *OnPush) Documentation() string {
 
 - **Repository**: Select the GitHub repository to monitor
 - **Refs**: Configure which branches/tags to monitor (e.g., ` + "`refs/heads/main`" + `, ` + "`refs/tags/*`" + `)
+- **Paths**: Optional filter on the files changed by the push (e.g., ` + "`^services/api/`" + `). If set, only pushes changing at least one matching file trigger
 
 ## Event Data
 
@@ -55,7 +58,9 @@ Each push event includes:
 
 ## Webhook Setup
 
-This trigger automatically sets up a GitHub webhook when configured. The webhook is managed by SuperPlane and will be cleaned up when the trigger is removed.`
+This trigger automatically sets up a GitHub webhook when configured. The webhook is managed by SuperPlane and will be cleaned up when the trigger is removed.
+
+Redeliveries of the same webhook, identified by the ` + "`X-GitHub-Delivery`" + ` header, are ignored.`
 }
 
 func (p *OnPush) Icon() string {
@@ -97,6 +102,18 @@ func (p *OnPush) Configuration() []configuration.Field {
 				},
 			},
 		},
+		{
+			Name:        "paths",
+			Label:       "Paths",
+			Type:        configuration.FieldTypeAnyPredicateList,
+			Description: "Only trigger if the push changes a file matching one of these",
+			Required:    false,
+			TypeOptions: &configuration.TypeOptions{
+				AnyPredicateList: &configuration.AnyPredicateListTypeOptions{
+					Operators: configuration.AllPredicateOperators,
+				},
+			},
+		},
 	}
 }
 
@@ -178,15 +195,67 @@ func (p *OnPush) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 		return http.StatusOK, nil
 	}
 
+	if !matchesAnyPath(config.Paths, changedFiles(data)) {
+		return http.StatusOK, nil
+	}
+
+	redelivery, err := isRedelivery(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if redelivery {
+		ctx.Logger.Infof("Ignoring redelivery %s", ctx.Headers.Get("X-GitHub-Delivery"))
+		return http.StatusOK, nil
+	}
+
 	err = ctx.Events.Emit("github.push", data)
 
 	if err != nil {
 		return http.StatusInternalServerError, fmt.Errorf("error emitting event: %v", err)
 	}
 
+	err = recordDelivery(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("error recording delivery: %v", err)
+	}
+
 	return http.StatusOK, nil
 }
 
+/*
+ * Returns the files added, modified or removed by the commits in a push.
+ */
+func changedFiles(data map[string]any) []string {
+	commits, ok := data["commits"].([]any)
+	if !ok {
+		return []string{}
+	}
+
+	files := []string{}
+	for _, c := range commits {
+		commit, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, key := range []string{"added", "modified", "removed"} {
+			changed, ok := commit[key].([]any)
+			if !ok {
+				continue
+			}
+
+			for _, file := range changed {
+				if f, ok := file.(string); ok && !slices.Contains(files, f) {
+					files = append(files, f)
+				}
+			}
+		}
+	}
+
+	return files
+}
+
 func isBranchDeletionEvent(data map[string]any) bool {
 	v, ok := data["deleted"]
 	if !ok {
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/configuration"
@@ -251,6 +252,103 @@ func Test__OnPush__HandleWebhook(t *testing.T) {
 	})
 }
 
+func Test__OnPush__HandleWebhook__PathsAndRedeliveries(t *testing.T) {
+	trigger := &OnPush{}
+	secret := "test-secret"
+	body := []byte(`{"ref":"refs/heads/main","commits":[{"added":["docs/README.md"],"modified":["services/api/main.go"],"removed":[]}]}`)
+
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(body)
+	signature := fmt.Sprintf("%x", h.Sum(nil))
+
+	newRequest := func(paths []configuration.Predicate, metadata *contexts.MetadataContext, events *contexts.EventContext) core.WebhookRequestContext {
+		headers := http.Header{}
+		headers.Set("X-Hub-Signature-256", "sha256="+signature)
+		headers.Set("X-GitHub-Event", "push")
+		headers.Set("X-GitHub-Delivery", "delivery-1")
+
+		return core.WebhookRequestContext{
+			Body:    body,
+			Headers: headers,
+			Configuration: map[string]any{
+				"repository": "test",
+				"refs": []configuration.Predicate{
+					{Type: configuration.PredicateTypeEquals, Value: "refs/heads/main"},
+				},
+				"paths": paths,
+			},
+			Metadata: metadata,
+			Logger:   logrus.NewEntry(logrus.New()),
+			Webhook:  &contexts.WebhookContext{Secret: secret},
+			Events:   events,
+		}
+	}
+
+	t.Run("changed file matches path -> event is emitted", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		paths := []configuration.Predicate{{Type: configuration.PredicateTypeMatches, Value: "^services/api/"}}
+		code, err := trigger.HandleWebhook(newRequest(paths, &contexts.MetadataContext{}, events))
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, events.Count())
+	})
+
+	t.Run("no changed file matches path -> event is not emitted", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		paths := []configuration.Predicate{{Type: configuration.PredicateTypeMatches, Value: "^services/web/"}}
+		code, err := trigger.HandleWebhook(newRequest(paths, &contexts.MetadataContext{}, events))
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.NoError(t, err)
+		assert.Zero(t, events.Count())
+	})
+
+	t.Run("redelivery -> event is emitted only once", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		metadata := &contexts.MetadataContext{Metadata: NodeMetadata{Repository: &Repository{Name: "test"}}}
+
+		code, err := trigger.HandleWebhook(newRequest(nil, metadata, events))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, code)
+
+		code, err = trigger.HandleWebhook(newRequest(nil, metadata, events))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, code)
+
+		assert.Equal(t, 1, events.Count())
+		stored := metadata.Get().(NodeMetadata)
+		assert.Equal(t, []string{"delivery-1"}, stored.RecentDeliveries)
+		assert.Equal(t, "test", stored.Repository.Name)
+	})
+}
+
+func Test__ChangedFiles(t *testing.T) {
+	data := map[string]any{
+		"commits": []any{
+			map[string]any{"added": []any{"a.go"}, "modified": []any{"b.go"}, "removed": []any{}},
+			map[string]any{"added": []any{}, "modified": []any{"b.go"}, "removed": []any{"c.go"}},
+		},
+	}
+
+	assert.Equal(t, []string{"a.go", "b.go", "c.go"}, changedFiles(data))
+	assert.Empty(t, changedFiles(map[string]any{}))
+}
+
+func Test__RecordDelivery__KeepsMostRecent(t *testing.T) {
+	metadata := &contexts.MetadataContext{}
+	for i := 0; i < MaxRecentDeliveries+5; i++ {
+		headers := http.Header{}
+		headers.Set("X-GitHub-Delivery", fmt.Sprintf("delivery-%d", i))
+		require.NoError(t, recordDelivery(core.WebhookRequestContext{Headers: headers, Metadata: metadata}))
+	}
+
+	stored := metadata.Get().(NodeMetadata)
+	require.Len(t, stored.RecentDeliveries, MaxRecentDeliveries)
+	assert.Equal(t, "delivery-5", stored.RecentDeliveries[0])
+	assert.Equal(t, fmt.Sprintf("delivery-%d", MaxRecentDeliveries+4), stored.RecentDeliveries[MaxRecentDeliveries-1])
+}
+
 func Test__OnPush__Setup(t *testing.T) {
 	helloRepo := Repository{ID: 123456, Name: "hello", URL: "https://github.com/testhq/hello"}
 	trigger := OnPush{}
@@ -21,6 +21,12 @@ const (
 	PipelineStatusBlocked   = "blocked"
 )
 
+/*
+ * Pipeline and merge request events include every job,
+ * commit and label, so they are often larger than the default limit.
+ */
+const LargeEventMaxBodySize = 512 * 1024
+
 type WebhookConfiguration struct {
 	EventType string `json:"eventType" mapstructure:"eventType"`
 	ProjectID string `json:"projectId" mapstructure:"projectId"`
@@ -90,6 +90,10 @@ func (m *OnMergeRequest) Configuration() []configuration.Field {
 	}
 }
 
+func (m *OnMergeRequest) MaxWebhookBodySize() int64 {
+	return LargeEventMaxBodySize
+}
+
 func (m *OnMergeRequest) Setup(ctx core.TriggerContext) error {
 	var config OnMergeRequestConfiguration
 	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
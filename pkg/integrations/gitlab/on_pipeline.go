@@ -90,6 +90,10 @@ func (p *OnPipeline) Configuration() []configuration.Field {
 	}
 }
 
+func (p *OnPipeline) MaxWebhookBodySize() int64 {
+	return LargeEventMaxBodySize
+}
+
 func (p *OnPipeline) Setup(ctx core.TriggerContext) error {
 	var config OnPipelineConfiguration
 	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
@@ -220,3 +220,7 @@ func (s *setupWebhookContext) Setup() (string, error) {
 func (s *setupWebhookContext) GetBaseURL() string {
 	return "https://superplane.example.com/api/v1"
 }
+
+func (s *setupWebhookContext) SetMaxBodySize(bytes int64) error {
+	return nil
+}
@@ -182,3 +182,7 @@ func (t *testNodeWebhookContext) ResetSecret() ([]byte, []byte, error) {
 func (t *testNodeWebhookContext) GetBaseURL() string {
 	return ""
 }
+
+func (t *testNodeWebhookContext) SetMaxBodySize(bytes int64) error {
+	return nil
+}
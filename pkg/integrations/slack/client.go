@@ -2,35 +2,169 @@ package slack
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/superplanehq/superplane/pkg/core"
 )
 
+const DefaultRetryAfter = 30 * time.Second
+
 type Client struct {
 	BotToken string
+	http     core.HTTPContext
 }
 
-func NewClient(ctx core.IntegrationContext) (*Client, error) {
-	botToken, err := ctx.GetConfig("botToken")
+func NewClient(httpCtx core.HTTPContext, ctx core.IntegrationContext) (*Client, error) {
+	token, err := findBotToken(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get bot token: %w", err)
 	}
 
-	token := string(botToken)
 	if token == "" {
 		return nil, fmt.Errorf("bot token is required")
 	}
 
 	return &Client{
 		BotToken: token,
+		http:     withDefaultHTTP(httpCtx),
 	}, nil
 }
 
+/*
+ * Not every context carries an HTTP context,
+ * so requests fall back to the default client when none is given.
+ */
+type defaultHTTPContext struct{}
+
+func (defaultHTTPContext) Do(request *http.Request) (*http.Response, error) {
+	return http.DefaultClient.Do(request)
+}
+
+func (defaultHTTPContext) Context() context.Context {
+	return context.Background()
+}
+
+func withDefaultHTTP(httpCtx core.HTTPContext) core.HTTPContext {
+	if httpCtx == nil {
+		return defaultHTTPContext{}
+	}
+
+	return httpCtx
+}
+
+/*
+ * The bot token is either configured manually,
+ * or stored as a secret after the OAuth installation.
+ */
+func findBotToken(ctx core.IntegrationContext) (string, error) {
+	botToken, _ := ctx.GetConfig("botToken")
+	if len(botToken) > 0 {
+		return string(botToken), nil
+	}
+
+	secrets, err := ctx.GetSecrets()
+	if err != nil {
+		return "", err
+	}
+
+	for _, secret := range secrets {
+		if secret.Name == OAuthBotToken {
+			return string(secret.Value), nil
+		}
+	}
+
+	return "", nil
+}
+
+/*
+ * RateLimitError is returned when Slack responds with 429.
+ * RetryAfter is the delay Slack asks for before the next request.
+ */
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limited by Slack, retry after %s", e.RetryAfter)
+}
+
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || seconds <= 0 {
+		return DefaultRetryAfter
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+type OAuthAccessResponse struct {
+	OK          bool   `json:"ok"`
+	Error       string `json:"error,omitempty"`
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	Scope       string `json:"scope"`
+	BotUserID   string `json:"bot_user_id"`
+	AppID       string `json:"app_id"`
+}
+
+/*
+ * ExchangeOAuthCode exchanges the code received in the OAuth callback
+ * for a bot token, using oauth.v2.access.
+ */
+func ExchangeOAuthCode(httpCtx core.HTTPContext, clientID, clientSecret, code, redirectURI string) (*OAuthAccessResponse, error) {
+	httpCtx = withDefaultHTTP(httpCtx)
+	form := url.Values{}
+	form.Set("client_id", clientID)
+	form.Set("client_secret", clientSecret)
+	form.Set("code", code)
+	form.Set("redirect_uri", redirectURI)
+
+	req, err := http.NewRequestWithContext(httpCtx.Context(), http.MethodPost, "https://slack.com/api/oauth.v2.access", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := httpCtx.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %v", err)
+	}
+
+	var result OAuthAccessResponse
+
+	if err := json.Unmarshal(responseBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %v", err)
+	}
+
+	if !result.OK {
+		if result.Error != "" {
+			return nil, fmt.Errorf("oauth access failed: %s", result.Error)
+		}
+		return nil, fmt.Errorf("oauth access failed")
+	}
+
+	if result.AccessToken == "" {
+		return nil, fmt.Errorf("oauth access response has no access token")
+	}
+
+	return &result, nil
+}
+
 type AuthTestResponse struct {
 	OK     bool   `json:"ok"`
 	URL    string `json:"url"`
@@ -168,7 +302,7 @@ func (c *Client) PostMessage(req ChatPostMessageRequest) (*ChatPostMessageRespon
 }
 
 func (c *Client) execRequest(method, URL string, body io.Reader) ([]byte, error) {
-	req, err := http.NewRequest(method, URL, body)
+	req, err := http.NewRequestWithContext(c.http.Context(), method, URL, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %v", err)
 	}
@@ -176,13 +310,16 @@ func (c *Client) execRequest(method, URL string, body io.Reader) ([]byte, error)
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.BotToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := c.http.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send request: %v", err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, &RateLimitError{RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"))}
+	}
+
 	responseBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response: %v", err)
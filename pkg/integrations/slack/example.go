@@ -13,12 +13,18 @@ var exampleOutputSendTextMessageBytes []byte
 //go:embed example_data_on_app_mention.json
 var exampleDataOnAppMentionBytes []byte
 
+//go:embed example_data_on_message.json
+var exampleDataOnMessageBytes []byte
+
 var exampleOutputOnce sync.Once
 var exampleOutput map[string]any
 
 var exampleDataOnce sync.Once
 var exampleData map[string]any
 
+var exampleDataOnMessageOnce sync.Once
+var exampleDataOnMessage map[string]any
+
 func (c *SendTextMessage) ExampleOutput() map[string]any {
 	return utils.UnmarshalEmbeddedJSON(&exampleOutputOnce, exampleOutputSendTextMessageBytes, &exampleOutput)
 }
@@ -26,3 +32,7 @@ func (c *SendTextMessage) ExampleOutput() map[string]any {
 func (t *OnAppMention) ExampleData() map[string]any {
 	return utils.UnmarshalEmbeddedJSON(&exampleDataOnce, exampleDataOnAppMentionBytes, &exampleData)
 }
+
+func (t *OnMessage) ExampleData() map[string]any {
+	return utils.UnmarshalEmbeddedJSON(&exampleDataOnMessageOnce, exampleDataOnMessageBytes, &exampleDataOnMessage)
+}
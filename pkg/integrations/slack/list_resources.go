@@ -9,7 +9,7 @@ func (s *Slack) ListResources(resourceType string, ctx core.ListResourcesContext
 		return []core.IntegrationResource{}, nil
 	}
 
-	client, err := NewClient(ctx.Integration)
+	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
 		return nil, err
 	}
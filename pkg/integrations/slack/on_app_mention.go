@@ -148,7 +148,7 @@ func (t *OnAppMention) validateChannel(ctx core.TriggerContext, config OnAppMent
 		return metadata.Channel, nil
 	}
 
-	client, err := NewClient(ctx.Integration)
+	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Slack client: %w", err)
 	}
@@ -0,0 +1,213 @@
+package slack
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+type OnMessage struct{}
+
+type OnMessageConfiguration struct {
+	Channel string `json:"channel" mapstructure:"channel"`
+}
+
+type OnMessageMetadata struct {
+	Channel           *ChannelMetadata `json:"channel,omitempty" mapstructure:"channel,omitempty"`
+	AppSubscriptionID *string          `json:"appSubscriptionID,omitempty" mapstructure:"appSubscriptionID,omitempty"`
+}
+
+func (t *OnMessage) Name() string {
+	return "slack.onMessage"
+}
+
+func (t *OnMessage) Label() string {
+	return "On Message"
+}
+
+func (t *OnMessage) Description() string {
+	return "Listen to messages posted in Slack channels"
+}
+
+func (t *OnMessage) Documentation() string {
+	return `The On Message trigger starts a workflow execution when a message is posted in a Slack channel the app is a member of.
+
+## Use Cases
+
+- **ChatOps**: Trigger workflows from messages posted in a channel
+- **Alert processing**: React to alerts posted by other tools in a channel
+- **Team workflows**: Start workflows from team conversations
+
+## Configuration
+
+- **Channel**: Optional channel filter - if specified, only messages in this channel will trigger (leave empty to listen to all channels)
+
+## Event Data
+
+Each message event includes:
+- **user**: User who posted the message
+- **channel**: Channel where the message was posted
+- **text**: The message text
+- **ts**: The message timestamp
+
+## Notes
+
+- Messages posted by bots, including SuperPlane, and message edits or deletions are ignored
+- The Slack app must be a member of the channel to receive its messages
+
+## Setup
+
+This trigger automatically sets up a Slack event subscription when configured. The subscription is managed by SuperPlane and will be cleaned up when the trigger is removed.`
+}
+
+func (t *OnMessage) Icon() string {
+	return "slack"
+}
+
+func (t *OnMessage) Color() string {
+	return "gray"
+}
+
+func (t *OnMessage) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:     "channel",
+			Label:    "Channel",
+			Type:     configuration.FieldTypeIntegrationResource,
+			Required: false,
+			TypeOptions: &configuration.TypeOptions{
+				Resource: &configuration.ResourceTypeOptions{
+					Type: "channel",
+				},
+			},
+		},
+	}
+}
+
+func (t *OnMessage) Setup(ctx core.TriggerContext) error {
+	var metadata OnMessageMetadata
+	err := mapstructure.Decode(ctx.Metadata.Get(), &metadata)
+	if err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	var config OnMessageConfiguration
+	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	channel, err := t.validateChannel(ctx, config, metadata)
+	if err != nil {
+		return fmt.Errorf("failed to validate channel: %w", err)
+	}
+
+	subscriptionID, err := t.subscribe(ctx, metadata)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to app events: %w", err)
+	}
+
+	return ctx.Metadata.Set(OnMessageMetadata{
+		AppSubscriptionID: subscriptionID,
+		Channel:           channel,
+	})
+}
+
+func (t *OnMessage) subscribe(ctx core.TriggerContext, metadata OnMessageMetadata) (*string, error) {
+	if metadata.AppSubscriptionID != nil {
+		logrus.Infof("using existing subscription %s", *metadata.AppSubscriptionID)
+		return metadata.AppSubscriptionID, nil
+	}
+
+	logrus.Infof("creating new subscription")
+
+	subscriptionID, err := ctx.Integration.Subscribe(SubscriptionConfiguration{
+		EventTypes: []string{"message"},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to app events: %w", err)
+	}
+
+	s := subscriptionID.String()
+	return &s, nil
+}
+
+func (t *OnMessage) validateChannel(ctx core.TriggerContext, config OnMessageConfiguration, metadata OnMessageMetadata) (*ChannelMetadata, error) {
+	if config.Channel == "" {
+		return nil, nil
+	}
+
+	if metadata.Channel != nil && config.Channel == metadata.Channel.ID {
+		return metadata.Channel, nil
+	}
+
+	client, err := NewClient(ctx.HTTP, ctx.Integration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Slack client: %w", err)
+	}
+
+	channelInfo, err := client.GetChannelInfo(config.Channel)
+	if err != nil {
+		return nil, fmt.Errorf("channel validation failed: %w", err)
+	}
+
+	return &ChannelMetadata{
+		ID:   channelInfo.ID,
+		Name: channelInfo.Name,
+	}, nil
+}
+
+func (t *OnMessage) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (t *OnMessage) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
+	return nil, nil
+}
+
+func (t *OnMessage) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (t *OnMessage) OnIntegrationMessage(ctx core.IntegrationMessageContext) error {
+	config := OnMessageConfiguration{}
+	err := mapstructure.Decode(ctx.Configuration, &config)
+	if err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	message, ok := ctx.Message.(map[string]any)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", ctx.Message)
+	}
+
+	//
+	// Messages from bots, including the ones we post ourselves,
+	// and edits, deletions and other message subtypes are ignored.
+	//
+	if _, ok := message["bot_id"]; ok {
+		ctx.Logger.Infof("ignoring message from bot")
+		return nil
+	}
+
+	if subtype, _ := message["subtype"].(string); subtype != "" {
+		ctx.Logger.Infof("ignoring message with subtype %s", subtype)
+		return nil
+	}
+
+	channel, _ := message["channel"].(string)
+	if config.Channel != "" && config.Channel != channel {
+		ctx.Logger.Infof("message channel %s does not match configuration channel %s, ignoring", channel, config.Channel)
+		return nil
+	}
+
+	return ctx.Events.Emit("slack.message", ctx.Message)
+}
+
+func (t *OnMessage) Cleanup(ctx core.TriggerContext) error {
+	return nil
+}
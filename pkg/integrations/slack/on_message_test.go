@@ -0,0 +1,96 @@
+package slack
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__OnMessage__Setup(t *testing.T) {
+	trigger := &OnMessage{}
+
+	t.Run("empty channel -> subscribes to message events", func(t *testing.T) {
+		metadata := &contexts.MetadataContext{}
+		integrationCtx := &contexts.IntegrationContext{}
+		err := trigger.Setup(core.TriggerContext{
+			Integration:   integrationCtx,
+			Metadata:      metadata,
+			Configuration: map[string]any{"channel": ""},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, integrationCtx.Subscriptions, 1)
+
+		subConfig, ok := integrationCtx.Subscriptions[0].Configuration.(SubscriptionConfiguration)
+		require.True(t, ok)
+		assert.Equal(t, []string{"message"}, subConfig.EventTypes)
+
+		stored, ok := metadata.Metadata.(OnMessageMetadata)
+		require.True(t, ok)
+		require.NotNil(t, stored.AppSubscriptionID)
+		assert.Nil(t, stored.Channel)
+	})
+}
+
+func Test__OnMessage__OnIntegrationMessage(t *testing.T) {
+	trigger := &OnMessage{}
+
+	t.Run("bot message -> ignore", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		err := trigger.OnIntegrationMessage(core.IntegrationMessageContext{
+			Configuration: map[string]any{},
+			Message:       map[string]any{"channel": "C123", "text": "hi", "bot_id": "B123"},
+			Logger:        logrus.NewEntry(logrus.New()),
+			Events:        events,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, events.Count())
+	})
+
+	t.Run("message subtype -> ignore", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		err := trigger.OnIntegrationMessage(core.IntegrationMessageContext{
+			Configuration: map[string]any{},
+			Message:       map[string]any{"channel": "C123", "subtype": "message_changed"},
+			Logger:        logrus.NewEntry(logrus.New()),
+			Events:        events,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, events.Count())
+	})
+
+	t.Run("channel mismatch -> ignore", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		err := trigger.OnIntegrationMessage(core.IntegrationMessageContext{
+			Configuration: map[string]any{"channel": "C999"},
+			Message:       map[string]any{"channel": "C123", "text": "hi"},
+			Logger:        logrus.NewEntry(logrus.New()),
+			Events:        events,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, events.Count())
+	})
+
+	t.Run("channel match -> emit", func(t *testing.T) {
+		message := map[string]any{"channel": "C123", "text": "hi", "user": "U123"}
+		events := &contexts.EventContext{}
+		err := trigger.OnIntegrationMessage(core.IntegrationMessageContext{
+			Configuration: map[string]any{"channel": "C123"},
+			Message:       message,
+			Logger:        logrus.NewEntry(logrus.New()),
+			Events:        events,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, events.Count())
+		assert.Equal(t, "slack.message", events.Payloads[0].Type)
+		assert.Equal(t, message, events.Payloads[0].Data)
+	})
+}
@@ -1,13 +1,22 @@
 package slack
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const (
+	SendTextMessagePayloadType = "slack.message.sent"
+	SendTextMessageRetryAction = "retry"
+	MaxRateLimitedAttempts     = 5
 )
 
 type SendTextMessage struct{}
@@ -15,12 +24,17 @@ type SendTextMessage struct{}
 type SendTextMessageConfiguration struct {
 	Channel string `json:"channel" mapstructure:"channel"`
 	Text    string `json:"text" mapstructure:"text"`
+	Blocks  string `json:"blocks" mapstructure:"blocks"`
 }
 
 type SendTextMessageMetadata struct {
 	Channel *ChannelMetadata `json:"channel" mapstructure:"channel"`
 }
 
+type SendTextMessageExecutionMetadata struct {
+	Attempts int `json:"attempts" mapstructure:"attempts"`
+}
+
 type ChannelMetadata struct {
 	ID   string `json:"id" mapstructure:"id"`
 	Name string `json:"name" mapstructure:"name"`
@@ -35,15 +49,16 @@ func (c *SendTextMessage) Label() string {
 }
 
 func (c *SendTextMessage) Description() string {
-	return "Send a text message to a Slack channel"
+	return "Send a message with text or blocks to a Slack channel"
 }
 
 func (c *SendTextMessage) Documentation() string {
-	return `The Send Text Message component sends a text message to a Slack channel.
+	return `The Send Text Message component sends a message to a Slack channel, using plain text, Block Kit blocks, or both.
 
 ## Use Cases
 
 - **Notifications**: Send notifications about workflow events or system status
+- **Rich messages**: Send formatted messages with sections, fields and buttons using Block Kit
 - **Alerts**: Alert teams about important events or errors
 - **Updates**: Provide status updates on long-running processes
 - **Team communication**: Automate team communications from workflows
@@ -51,11 +66,17 @@ func (c *SendTextMessage) Documentation() string {
 ## Configuration
 
 - **Channel**: Select the Slack channel to send the message to
-- **Text**: The message text to send (supports expressions and Slack markdown formatting)
+- **Text**: The message text to send (supports expressions and Slack markdown formatting). When blocks are set, it is used as the notification fallback
+- **Blocks**: Optional Block Kit blocks, as a JSON array or as an object with a ` + "`blocks`" + ` array
 
 ## Output
 
-Returns metadata about the sent message including channel information.
+Returns the message posted to Slack.
+
+## Rate Limits
+
+If Slack rate limits the request, the message is sent again after the delay Slack returns in the Retry-After header.
+The execution fails if Slack keeps rate limiting the message after 5 attempts.
 
 ## Notes
 
@@ -90,10 +111,19 @@ func (c *SendTextMessage) Configuration() []configuration.Field {
 			},
 		},
 		{
-			Name:     "text",
-			Label:    "Text",
-			Type:     configuration.FieldTypeText,
-			Required: true,
+			Name:        "text",
+			Label:       "Text",
+			Type:        configuration.FieldTypeText,
+			Required:    false,
+			Description: "Message text. Required if no blocks are set.",
+		},
+		{
+			Name:        "blocks",
+			Label:       "Blocks",
+			Type:        configuration.FieldTypeText,
+			Required:    false,
+			Description: "Block Kit blocks as JSON",
+			Placeholder: `[{"type": "section", "text": {"type": "mrkdwn", "text": "Hello"}}]`,
 		},
 	}
 }
@@ -108,7 +138,12 @@ func (c *SendTextMessage) Setup(ctx core.SetupContext) error {
 		return errors.New("channel is required")
 	}
 
-	client, err := NewClient(ctx.Integration)
+	_, err := parseBlocks(config.Blocks)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(ctx.HTTP, ctx.Integration)
 	if err != nil {
 		return fmt.Errorf("failed to create Slack client: %w", err)
 	}
@@ -133,8 +168,19 @@ func (c *SendTextMessage) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.
 }
 
 func (c *SendTextMessage) Execute(ctx core.ExecutionContext) error {
+	return c.send(ctx.HTTP, ctx.Configuration, ctx.Integration, ctx.Metadata, ctx.ExecutionState, ctx.Requests)
+}
+
+func (c *SendTextMessage) send(
+	httpCtx core.HTTPContext,
+	configuration any,
+	integration core.IntegrationContext,
+	metadataCtx core.MetadataContext,
+	state core.ExecutionStateContext,
+	requests core.RequestContext,
+) error {
 	var config SendTextMessageConfiguration
-	if err := mapstructure.Decode(ctx.Configuration, &config); err != nil {
+	if err := mapstructure.Decode(configuration, &config); err != nil {
 		return fmt.Errorf("failed to decode configuration: %w", err)
 	}
 
@@ -142,7 +188,16 @@ func (c *SendTextMessage) Execute(ctx core.ExecutionContext) error {
 		return errors.New("channel is required")
 	}
 
-	client, err := NewClient(ctx.Integration)
+	blocks, err := parseBlocks(config.Blocks)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(config.Text) == "" && len(blocks) == 0 {
+		return errors.New("text or blocks is required")
+	}
+
+	client, err := NewClient(httpCtx, integration)
 	if err != nil {
 		return fmt.Errorf("failed to create Slack client: %w", err)
 	}
@@ -150,29 +205,97 @@ func (c *SendTextMessage) Execute(ctx core.ExecutionContext) error {
 	response, err := client.PostMessage(ChatPostMessageRequest{
 		Channel: config.Channel,
 		Text:    config.Text,
+		Blocks:  blocks,
 	})
 
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return c.retryLater(metadataCtx, state, requests, rateLimitErr)
+	}
+
 	if err != nil {
 		return fmt.Errorf("failed to send message: %w", err)
 	}
 
-	return ctx.ExecutionState.Emit(
+	return state.Emit(
 		core.DefaultOutputChannel.Name,
-		"slack.message.sent",
+		SendTextMessagePayloadType,
 		[]any{response.Message},
 	)
 }
 
+/*
+ * Rate limited messages are not failures, so instead of failing the execution,
+ * we schedule the message to be sent again after the delay Slack asked for.
+ */
+func (c *SendTextMessage) retryLater(metadataCtx core.MetadataContext, state core.ExecutionStateContext, requests core.RequestContext, rateLimitErr *RateLimitError) error {
+	metadata := SendTextMessageExecutionMetadata{}
+	if err := mapstructure.Decode(metadataCtx.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	metadata.Attempts++
+	if metadata.Attempts >= MaxRateLimitedAttempts {
+		return state.Fail(models.CanvasNodeExecutionResultReasonError, fmt.Sprintf("Slack kept rate limiting the message after %d attempts", metadata.Attempts))
+	}
+
+	if err := metadataCtx.Set(metadata); err != nil {
+		return err
+	}
+
+	return requests.ScheduleActionCall(SendTextMessageRetryAction, map[string]any{}, rateLimitErr.RetryAfter)
+}
+
+/*
+ * Blocks can be given as a JSON array,
+ * or as the object exported by the Block Kit Builder, with a "blocks" array.
+ */
+func parseBlocks(value string) ([]any, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	var blocks []any
+	if err := json.Unmarshal([]byte(value), &blocks); err == nil {
+		return blocks, nil
+	}
+
+	var payload struct {
+		Blocks []any `json:"blocks"`
+	}
+
+	if err := json.Unmarshal([]byte(value), &payload); err != nil || payload.Blocks == nil {
+		return nil, errors.New("blocks must be a JSON array, or an object with a blocks array")
+	}
+
+	return payload.Blocks, nil
+}
+
 func (c *SendTextMessage) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 	return 200, nil
 }
 
 func (c *SendTextMessage) Actions() []core.Action {
-	return []core.Action{}
+	return []core.Action{
+		{
+			Name:           SendTextMessageRetryAction,
+			UserAccessible: false,
+		},
+	}
 }
 
 func (c *SendTextMessage) HandleAction(ctx core.ActionContext) error {
-	return nil
+	switch ctx.Name {
+	case SendTextMessageRetryAction:
+		if ctx.ExecutionState.IsFinished() {
+			return nil
+		}
+
+		return c.send(ctx.HTTP, ctx.Configuration, ctx.Integration, ctx.Metadata, ctx.ExecutionState, ctx.Requests)
+	}
+
+	return fmt.Errorf("unknown action: %s", ctx.Name)
 }
 
 func (c *SendTextMessage) Cancel(ctx core.ExecutionContext) error {
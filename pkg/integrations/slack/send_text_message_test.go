@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -116,3 +117,194 @@ func Test__SendTextMessage__Execute(t *testing.T) {
 		assert.NotNil(t, payload["timestamp"])
 	})
 }
+
+func Test__SendTextMessage__SetupBlocks(t *testing.T) {
+	component := &SendTextMessage{}
+
+	t.Run("invalid blocks -> error", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Integration:   &contexts.IntegrationContext{},
+			Metadata:      &contexts.MetadataContext{},
+			Configuration: map[string]any{"channel": "C123", "blocks": "not json"},
+		})
+
+		require.ErrorContains(t, err, "blocks must be a JSON array")
+	})
+
+	t.Run("bot token from OAuth secret -> stores metadata", func(t *testing.T) {
+		withDefaultTransport(t, func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "Bearer xoxb-oauth", req.Header.Get("Authorization"))
+			return jsonResponse(http.StatusOK, `{"ok": true, "channel": {"id": "C123", "name": "general"}}`), nil
+		})
+
+		metadata := &contexts.MetadataContext{}
+		integrationCtx := &contexts.IntegrationContext{
+			Secrets: map[string]core.IntegrationSecret{
+				OAuthBotToken: {Name: OAuthBotToken, Value: []byte("xoxb-oauth")},
+			},
+		}
+
+		err := component.Setup(core.SetupContext{
+			Integration:   integrationCtx,
+			Metadata:      metadata,
+			Configuration: map[string]any{"channel": "C123", "text": "hello"},
+		})
+
+		require.NoError(t, err)
+		stored, ok := metadata.Metadata.(SendTextMessageMetadata)
+		require.True(t, ok)
+		require.NotNil(t, stored.Channel)
+		assert.Equal(t, "general", stored.Channel.Name)
+	})
+}
+
+func Test__SendTextMessage__ExecuteBlocks(t *testing.T) {
+	component := &SendTextMessage{}
+	integrationCtx := &contexts.IntegrationContext{
+		Configuration: map[string]any{"botToken": "token-123"},
+	}
+
+	t.Run("missing text and blocks -> error", func(t *testing.T) {
+		err := component.Execute(core.ExecutionContext{
+			Integration:    integrationCtx,
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			Requests:       &contexts.RequestContext{},
+			Configuration:  map[string]any{"channel": "C123"},
+		})
+
+		require.ErrorContains(t, err, "text or blocks is required")
+	})
+
+	t.Run("invalid blocks -> error", func(t *testing.T) {
+		err := component.Execute(core.ExecutionContext{
+			Integration:    integrationCtx,
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: &contexts.ExecutionStateContext{KVs: map[string]string{}},
+			Requests:       &contexts.RequestContext{},
+			Configuration:  map[string]any{"channel": "C123", "blocks": "not json"},
+		})
+
+		require.ErrorContains(t, err, "blocks must be a JSON array")
+	})
+
+	t.Run("text and blocks -> sends message and emits", func(t *testing.T) {
+		withDefaultTransport(t, func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+
+			var payload map[string]any
+			require.NoError(t, json.Unmarshal(body, &payload))
+			assert.Equal(t, "C123", payload["channel"])
+			assert.Equal(t, "hello", payload["text"])
+			require.Len(t, payload["blocks"], 1)
+			return jsonResponse(http.StatusOK, `{"ok": true, "message": {"text": "hello"}}`), nil
+		})
+
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.Execute(core.ExecutionContext{
+			Integration:    integrationCtx,
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: execState,
+			Requests:       &contexts.RequestContext{},
+			Configuration: map[string]any{
+				"channel": "C123",
+				"text":    "hello",
+				"blocks":  `{"blocks": [{"type": "section", "text": {"type": "mrkdwn", "text": "hello"}}]}`,
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, core.DefaultOutputChannel.Name, execState.Channel)
+		assert.Equal(t, SendTextMessagePayloadType, execState.Type)
+		require.Len(t, execState.Payloads, 1)
+	})
+
+	t.Run("rate limited -> schedules retry with Retry-After delay", func(t *testing.T) {
+		withDefaultTransport(t, func(req *http.Request) (*http.Response, error) {
+			response := jsonResponse(http.StatusTooManyRequests, `{"ok": false, "error": "ratelimited"}`)
+			response.Header.Set("Retry-After", "12")
+			return response, nil
+		})
+
+		metadata := &contexts.MetadataContext{}
+		requests := &contexts.RequestContext{}
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.Execute(core.ExecutionContext{
+			Integration:    integrationCtx,
+			Metadata:       metadata,
+			ExecutionState: execState,
+			Requests:       requests,
+			Configuration:  map[string]any{"channel": "C123", "text": "hello"},
+		})
+
+		require.NoError(t, err)
+		assert.False(t, execState.Finished)
+		assert.Equal(t, SendTextMessageRetryAction, requests.Action)
+		assert.Equal(t, 12*time.Second, requests.Duration)
+		assert.Equal(t, SendTextMessageExecutionMetadata{Attempts: 1}, metadata.Metadata)
+	})
+}
+
+func Test__SendTextMessage__HandleAction(t *testing.T) {
+	component := &SendTextMessage{}
+	integrationCtx := &contexts.IntegrationContext{
+		Configuration: map[string]any{"botToken": "token-123"},
+	}
+
+	t.Run("retry -> sends message and emits", func(t *testing.T) {
+		withDefaultTransport(t, func(req *http.Request) (*http.Response, error) {
+			return jsonResponse(http.StatusOK, `{"ok": true, "message": {"text": "hello"}}`), nil
+		})
+
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.HandleAction(core.ActionContext{
+			Name:           SendTextMessageRetryAction,
+			Integration:    integrationCtx,
+			Metadata:       &contexts.MetadataContext{Metadata: SendTextMessageExecutionMetadata{Attempts: 1}},
+			ExecutionState: execState,
+			Requests:       &contexts.RequestContext{},
+			Configuration:  map[string]any{"channel": "C123", "text": "hello"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, SendTextMessagePayloadType, execState.Type)
+	})
+
+	t.Run("rate limited too many times -> fails execution", func(t *testing.T) {
+		withDefaultTransport(t, func(req *http.Request) (*http.Response, error) {
+			return jsonResponse(http.StatusTooManyRequests, `{"ok": false}`), nil
+		})
+
+		requests := &contexts.RequestContext{}
+		execState := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.HandleAction(core.ActionContext{
+			Name:           SendTextMessageRetryAction,
+			Integration:    integrationCtx,
+			Metadata:       &contexts.MetadataContext{Metadata: SendTextMessageExecutionMetadata{Attempts: MaxRateLimitedAttempts - 1}},
+			ExecutionState: execState,
+			Requests:       requests,
+			Configuration:  map[string]any{"channel": "C123", "text": "hello"},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, execState.Finished)
+		assert.False(t, execState.Passed)
+		assert.Empty(t, requests.Action)
+	})
+
+	t.Run("execution finished -> no-op", func(t *testing.T) {
+		execState := &contexts.ExecutionStateContext{Finished: true, KVs: map[string]string{}}
+		err := component.HandleAction(core.ActionContext{
+			Name:           SendTextMessageRetryAction,
+			Integration:    integrationCtx,
+			Metadata:       &contexts.MetadataContext{},
+			ExecutionState: execState,
+			Requests:       &contexts.RequestContext{},
+			Configuration:  map[string]any{"channel": "C123", "text": "hello"},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, execState.Payloads)
+	})
+}
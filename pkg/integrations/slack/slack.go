@@ -17,6 +17,7 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/registry"
 )
 
@@ -30,7 +31,13 @@ To complete the Slack app setup:
 4.  **Install App**: In OAuth & Permissions, click "**Install to Workspace**" and authorize
 5.  **Get Bot Token**: In "OAuth & Permissions", copy the "**Bot User OAuth Token**"
 6.  **Update Configuration**: Paste the "Bot User OAuth Token" and "Signing Secret" into the app installation configuration fields in SuperPlane and save
+
+To install through OAuth instead, skip steps 4 and 5, and paste the "**Client ID**", "**Client Secret**" and "**Signing Secret**" from "Basic Information".
 `
+
+	appConnectDescription = `Click **Continue** to install the SuperPlane app in your Slack workspace.`
+
+	OAuthBotToken = "botToken"
 )
 
 func init() {
@@ -40,12 +47,13 @@ func init() {
 type Slack struct{}
 
 type Metadata struct {
-	URL    string `mapstructure:"url" json:"url"`
-	TeamID string `mapstructure:"team_id" json:"team_id"`
-	Team   string `mapstructure:"team" json:"team"`
-	UserID string `mapstructure:"user_id" json:"user_id"`
-	User   string `mapstructure:"user" json:"user"`
-	BotID  string `mapstructure:"bot_id" json:"bot_id"`
+	State  *string `mapstructure:"state,omitempty" json:"state,omitempty"`
+	URL    string  `mapstructure:"url" json:"url"`
+	TeamID string  `mapstructure:"team_id" json:"team_id"`
+	Team   string  `mapstructure:"team" json:"team"`
+	UserID string  `mapstructure:"user_id" json:"user_id"`
+	User   string  `mapstructure:"user" json:"user"`
+	BotID  string  `mapstructure:"bot_id" json:"bot_id"`
 }
 
 func (s *Slack) Name() string {
@@ -68,6 +76,9 @@ func (s *Slack) Instructions() string {
 	return `
 You can install the Slack app without the **Bot Token** and **Signing Secret**.
 After installation, follow the setup prompt to create the Slack app and add those values.
+
+If you provide the **Client ID** and **Client Secret** instead of the **Bot Token**,
+SuperPlane installs the app in your workspace through OAuth and stores the bot token for you.
 `
 }
 
@@ -92,6 +103,21 @@ func (s *Slack) Configuration() []configuration.Field {
 			Sensitive:   true,
 			Required:    false,
 		},
+		{
+			Name:        "clientId",
+			Label:       "Client ID",
+			Type:        configuration.FieldTypeString,
+			Description: "The client ID for the Slack app, used to install it through OAuth",
+			Required:    false,
+		},
+		{
+			Name:        "clientSecret",
+			Label:       "Client Secret",
+			Type:        configuration.FieldTypeString,
+			Description: "The client secret for the Slack app, used to install it through OAuth",
+			Sensitive:   true,
+			Required:    false,
+		},
 	}
 }
 
@@ -112,6 +138,7 @@ func (s *Slack) Components() []core.Component {
 func (s *Slack) Triggers() []core.Trigger {
 	return []core.Trigger{
 		&OnAppMention{},
+		&OnMessage{},
 	}
 }
 
@@ -133,6 +160,24 @@ func (s *Slack) Sync(ctx core.SyncContext) error {
 		return nil
 	}
 
+	//
+	// If the app credentials are configured, install the app through OAuth.
+	//
+	clientID, _ := ctx.Integration.GetConfig("clientId")
+	clientSecret, _ := ctx.Integration.GetConfig("clientSecret")
+	if len(clientID) > 0 && len(clientSecret) > 0 {
+		botToken, err := findBotToken(ctx.Integration)
+		if err != nil {
+			return fmt.Errorf("failed to find bot token: %v", err)
+		}
+
+		if botToken == "" {
+			return s.createOAuthPrompt(ctx, metadata, string(clientID))
+		}
+
+		return s.verifyAuth(ctx.HTTP, ctx.Integration)
+	}
+
 	botToken, _ := ctx.Integration.GetConfig("botToken")
 	signingSecret, _ := ctx.Integration.GetConfig("signingSecret")
 
@@ -141,31 +186,67 @@ func (s *Slack) Sync(ctx core.SyncContext) error {
 	// by using the bot token to send a message to the channel.
 	//
 	if botToken != nil && signingSecret != nil {
-		client, err := NewClient(ctx.Integration)
-		if err != nil {
-			return err
-		}
+		return s.verifyAuth(ctx.HTTP, ctx.Integration)
+	}
+
+	return s.createAppCreationPrompt(ctx)
+}
+
+func (s *Slack) verifyAuth(httpCtx core.HTTPContext, integration core.IntegrationContext) error {
+	client, err := NewClient(httpCtx, integration)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.AuthTest()
+	if err != nil {
+		return fmt.Errorf("error verifying slack auth: %v", err)
+	}
 
-		result, err := client.AuthTest()
+	integration.SetMetadata(Metadata{
+		URL:    result.URL,
+		TeamID: result.TeamID,
+		Team:   result.Team,
+		UserID: result.UserID,
+		User:   result.User,
+		BotID:  result.BotID,
+	})
+
+	integration.RemoveBrowserAction()
+	integration.Ready()
+	return nil
+}
+
+func (s *Slack) createOAuthPrompt(ctx core.SyncContext, metadata Metadata, clientID string) error {
+	if metadata.State == nil {
+		state, err := crypto.Base64String(32)
 		if err != nil {
-			return fmt.Errorf("error verifying slack auth: %v", err)
+			return fmt.Errorf("failed to generate state: %v", err)
 		}
 
-		ctx.Integration.SetMetadata(Metadata{
-			URL:    result.URL,
-			TeamID: result.TeamID,
-			Team:   result.Team,
-			UserID: result.UserID,
-			User:   result.User,
-			BotID:  result.BotID,
-		})
-
-		ctx.Integration.RemoveBrowserAction()
-		ctx.Integration.Ready()
-		return nil
+		metadata.State = &state
+		ctx.Integration.SetMetadata(metadata)
 	}
 
-	return s.createAppCreationPrompt(ctx)
+	authURL := fmt.Sprintf(
+		"https://slack.com/oauth/v2/authorize?client_id=%s&scope=%s&redirect_uri=%s&state=%s",
+		url.QueryEscape(clientID),
+		url.QueryEscape(strings.Join(botScopes, ",")),
+		url.QueryEscape(callbackURL(ctx.BaseURL, ctx.Integration)),
+		url.QueryEscape(*metadata.State),
+	)
+
+	ctx.Integration.NewBrowserAction(core.BrowserAction{
+		Description: appConnectDescription,
+		URL:         authURL,
+		Method:      "GET",
+	})
+
+	return nil
+}
+
+func callbackURL(baseURL string, integration core.IntegrationContext) string {
+	return fmt.Sprintf("%s/api/v1/integrations/%s/callback", baseURL, integration.ID().String())
 }
 
 func (s *Slack) createAppCreationPrompt(ctx core.SyncContext) error {
@@ -185,6 +266,25 @@ func (s *Slack) createAppCreationPrompt(ctx core.SyncContext) error {
 	return nil
 }
 
+var botScopes = []string{
+	"app_mentions:read",
+	"chat:write",
+	"chat:write.public",
+	"channels:history",
+	"groups:history",
+	"im:history",
+	"mpim:history",
+	"reactions:write",
+	"reactions:read",
+	"usergroups:write",
+	"usergroups:read",
+	"channels:manage",
+	"groups:write",
+	"channels:read",
+	"groups:read",
+	"users:read",
+}
+
 func (s *Slack) appManifest(ctx core.SyncContext) ([]byte, error) {
 	appURL := ctx.WebhooksBaseURL
 	if appURL == "" {
@@ -222,25 +322,9 @@ func (s *Slack) appManifest(ctx core.SyncContext) ([]byte, error) {
 			},
 		},
 		"oauth_config": map[string]any{
+			"redirect_urls": []string{callbackURL(ctx.BaseURL, ctx.Integration)},
 			"scopes": map[string]any{
-				"bot": []string{
-					"app_mentions:read",
-					"chat:write",
-					"chat:write.public",
-					"channels:history",
-					"groups:history",
-					"im:history",
-					"mpim:history",
-					"reactions:write",
-					"reactions:read",
-					"usergroups:write",
-					"usergroups:read",
-					"channels:manage",
-					"groups:write",
-					"channels:read",
-					"groups:read",
-					"users:read",
-				},
+				"bot": botScopes,
 			},
 		},
 		"settings": map[string]any{
@@ -269,6 +353,15 @@ func (s *Slack) appManifest(ctx core.SyncContext) ([]byte, error) {
 }
 
 func (s *Slack) HandleRequest(ctx core.HTTPRequestContext) {
+	//
+	// The OAuth callback comes from the user's browser, not from Slack,
+	// so it is not signed with the signing secret.
+	//
+	if strings.HasSuffix(ctx.Request.URL.Path, "/callback") {
+		s.handleCallback(ctx)
+		return
+	}
+
 	body, err := s.readAndVerify(ctx)
 	if err != nil {
 		ctx.Logger.Errorf("error verifying slack request: %v", err)
@@ -290,6 +383,61 @@ func (s *Slack) HandleRequest(ctx core.HTTPRequestContext) {
 	ctx.Response.WriteHeader(http.StatusNotFound)
 }
 
+func (s *Slack) handleCallback(ctx core.HTTPRequestContext) {
+	settingsURL := fmt.Sprintf("%s/%s/settings/integrations/%s", ctx.BaseURL, ctx.OrganizationID, ctx.Integration.ID().String())
+
+	metadata := Metadata{}
+	if err := mapstructure.Decode(ctx.Integration.GetMetadata(), &metadata); err != nil {
+		ctx.Response.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	query := ctx.Request.URL.Query()
+	if metadata.State == nil || query.Get("state") != *metadata.State {
+		ctx.Logger.Errorf("Callback error: invalid state")
+		ctx.Response.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if errorCode := query.Get("error"); errorCode != "" {
+		ctx.Logger.Errorf("Callback error: %s", errorCode)
+		http.Redirect(ctx.Response, ctx.Request, settingsURL, http.StatusSeeOther)
+		return
+	}
+
+	clientID, err := ctx.Integration.GetConfig("clientId")
+	if err != nil {
+		ctx.Response.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	clientSecret, err := ctx.Integration.GetConfig("clientSecret")
+	if err != nil {
+		ctx.Response.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	response, err := ExchangeOAuthCode(ctx.HTTP, string(clientID), string(clientSecret), query.Get("code"), callbackURL(ctx.BaseURL, ctx.Integration))
+	if err != nil {
+		ctx.Logger.Errorf("Callback error: %v", err)
+		http.Redirect(ctx.Response, ctx.Request, settingsURL, http.StatusSeeOther)
+		return
+	}
+
+	if err := ctx.Integration.SetSecret(OAuthBotToken, []byte(response.AccessToken)); err != nil {
+		ctx.Response.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if err := s.verifyAuth(ctx.HTTP, ctx.Integration); err != nil {
+		ctx.Logger.Errorf("Callback error: %v", err)
+		ctx.Response.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(ctx.Response, ctx.Request, settingsURL, http.StatusSeeOther)
+}
+
 type EventPayload struct {
 	Type      string         `json:"type"`
 	Event     map[string]any `json:"event"`
@@ -322,7 +470,7 @@ func (s *Slack) handleEvent(ctx core.HTTPRequestContext, body []byte) {
 		return
 	}
 
-	subscriptions, err := ctx.Integration.ListSubscriptions()
+	subscriptions, err := ctx.Integration.ListSubscriptions(core.SubscriptionFilter{})
 	if err != nil {
 		ctx.Logger.Errorf("error listing subscriptions: %v", err)
 		ctx.Response.WriteHeader(500)
@@ -200,3 +200,106 @@ func Test__Slack__HandleEvent__Challenge(t *testing.T) {
 		assert.Equal(t, "challenge-token", recorder.Body.String())
 	})
 }
+
+func Test__Slack__OAuth(t *testing.T) {
+	s := &Slack{}
+	integrationID := uuid.NewString()
+	oauthConfig := map[string]any{
+		"clientId":      "client-123",
+		"clientSecret":  "client-secret",
+		"signingSecret": "secret-123",
+	}
+
+	t.Run("client credentials without token -> authorize prompt", func(t *testing.T) {
+		integrationCtx := &contexts.IntegrationContext{
+			IntegrationID: integrationID,
+			Configuration: oauthConfig,
+		}
+
+		err := s.Sync(core.SyncContext{
+			Integration: integrationCtx,
+			BaseURL:     "https://app.example.com",
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, integrationCtx.BrowserAction)
+
+		authURL, err := url.Parse(integrationCtx.BrowserAction.URL)
+		require.NoError(t, err)
+		assert.Equal(t, "slack.com", authURL.Host)
+		assert.Equal(t, "/oauth/v2/authorize", authURL.Path)
+		assert.Equal(t, "client-123", authURL.Query().Get("client_id"))
+		assert.Equal(t, fmt.Sprintf("https://app.example.com/api/v1/integrations/%s/callback", integrationID), authURL.Query().Get("redirect_uri"))
+
+		metadata, ok := integrationCtx.Metadata.(Metadata)
+		require.True(t, ok)
+		require.NotNil(t, metadata.State)
+		assert.Equal(t, *metadata.State, authURL.Query().Get("state"))
+	})
+
+	t.Run("callback with invalid state -> 400", func(t *testing.T) {
+		state := "state-123"
+		integrationCtx := &contexts.IntegrationContext{
+			IntegrationID: integrationID,
+			Configuration: oauthConfig,
+			Metadata:      Metadata{State: &state},
+		}
+
+		recorder := httptest.NewRecorder()
+		s.HandleRequest(core.HTTPRequestContext{
+			Logger:      logrus.NewEntry(logrus.New()),
+			Request:     httptest.NewRequest(http.MethodGet, "https://app.example.com/api/v1/integrations/"+integrationID+"/callback?code=abc&state=other", nil),
+			Response:    recorder,
+			Integration: integrationCtx,
+		})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Empty(t, integrationCtx.Secrets)
+	})
+
+	t.Run("callback with valid state -> stores bot token secret and ready", func(t *testing.T) {
+		withDefaultTransport(t, func(req *http.Request) (*http.Response, error) {
+			switch req.URL.String() {
+			case "https://slack.com/api/oauth.v2.access":
+				require.NoError(t, req.ParseForm())
+				assert.Equal(t, "abc", req.PostForm.Get("code"))
+				assert.Equal(t, "client-123", req.PostForm.Get("client_id"))
+				assert.Equal(t, "client-secret", req.PostForm.Get("client_secret"))
+				return jsonResponse(http.StatusOK, `{"ok": true, "access_token": "xoxb-oauth", "token_type": "bot"}`), nil
+			case "https://slack.com/api/auth.test":
+				assert.Equal(t, "Bearer xoxb-oauth", req.Header.Get("Authorization"))
+				return jsonResponse(http.StatusOK, `{"ok": true, "url": "https://workspace.slack.com", "team_id": "T123"}`), nil
+			}
+
+			return jsonResponse(http.StatusNotFound, `{"ok": false}`), nil
+		})
+
+		state := "state-123"
+		integrationCtx := &contexts.IntegrationContext{
+			IntegrationID: integrationID,
+			Configuration: oauthConfig,
+			Metadata:      Metadata{State: &state},
+			Secrets:       map[string]core.IntegrationSecret{},
+		}
+
+		recorder := httptest.NewRecorder()
+		s.HandleRequest(core.HTTPRequestContext{
+			Logger:         logrus.NewEntry(logrus.New()),
+			Request:        httptest.NewRequest(http.MethodGet, "https://app.example.com/api/v1/integrations/"+integrationID+"/callback?code=abc&state=state-123", nil),
+			Response:       recorder,
+			Integration:    integrationCtx,
+			BaseURL:        "https://app.example.com",
+			OrganizationID: "org-123",
+		})
+
+		assert.Equal(t, http.StatusSeeOther, recorder.Code)
+		assert.Equal(t, fmt.Sprintf("https://app.example.com/org-123/settings/integrations/%s", integrationID), recorder.Header().Get("Location"))
+		assert.Equal(t, []byte("xoxb-oauth"), integrationCtx.Secrets[OAuthBotToken].Value)
+		assert.Equal(t, "ready", integrationCtx.State)
+
+		metadata, ok := integrationCtx.Metadata.(Metadata)
+		require.True(t, ok)
+		assert.Nil(t, metadata.State)
+		assert.Equal(t, "T123", metadata.TeamID)
+	})
+}
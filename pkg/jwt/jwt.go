@@ -33,6 +33,26 @@ func (s *Signer) Generate(subject string, duration time.Duration) (string, error
 	return tokenString, nil
 }
 
+/*
+ * GenerateWithClaims signs a token for the subject,
+ * including additional claims. Registered claims are not overridden.
+ */
+func (s *Signer) GenerateWithClaims(subject string, duration time.Duration, claims map[string]any) (string, error) {
+	now := time.Now()
+	mapClaims := jwt.MapClaims{}
+	for key, value := range claims {
+		mapClaims[key] = value
+	}
+
+	mapClaims["iat"] = now.Unix()
+	mapClaims["nbf"] = now.Unix()
+	mapClaims["exp"] = now.Add(duration).Unix()
+	mapClaims["sub"] = subject
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, mapClaims)
+	return token.SignedString([]byte(s.Secret))
+}
+
 func (s *Signer) Validate(tokenString, subject string) error {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
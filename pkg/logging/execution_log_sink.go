@@ -0,0 +1,144 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+/*
+ * ExecutionLogSink is a logrus hook that keeps the entries
+ * logged for a node execution in memory, so they can be stored
+ * once the execution is processed, and shown to canvas authors.
+ * Only the last models.MaxLogsPerExecution entries are kept.
+ */
+type ExecutionLogSink struct {
+	mu        sync.Mutex
+	execution *models.CanvasNodeExecution
+	entries   []models.CanvasNodeExecutionLog
+}
+
+func NewExecutionLogSink() *ExecutionLogSink {
+	return &ExecutionLogSink{}
+}
+
+/*
+ * Returns a copy of the logger that also sends its entries to the sink.
+ * The entries are still written to the original logger output.
+ */
+func WithSink(logger *log.Entry, sink *ExecutionLogSink) *log.Entry {
+	hooks := make(log.LevelHooks)
+	for level, levelHooks := range logger.Logger.Hooks {
+		hooks[level] = append([]log.Hook{}, levelHooks...)
+	}
+
+	hooks.Add(sink)
+
+	clone := &log.Logger{
+		Out:          logger.Logger.Out,
+		Hooks:        hooks,
+		Formatter:    logger.Logger.Formatter,
+		ReportCaller: logger.Logger.ReportCaller,
+		Level:        logger.Logger.GetLevel(),
+		ExitFunc:     logger.Logger.ExitFunc,
+		BufferPool:   logger.Logger.BufferPool,
+	}
+
+	return clone.WithFields(logger.Data)
+}
+
+func (s *ExecutionLogSink) Levels() []log.Level {
+	return log.AllLevels
+}
+
+func (s *ExecutionLogSink) Fire(entry *log.Entry) error {
+	createdAt := entry.Time.UTC()
+	record := models.CanvasNodeExecutionLog{
+		Level:     entry.Level.String(),
+		Message:   entry.Message,
+		Fields:    datatypes.NewJSONType(serializableFields(entry.Data)),
+		CreatedAt: &createdAt,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = append(s.entries, record)
+	if len(s.entries) > models.MaxLogsPerExecution {
+		s.entries = s.entries[len(s.entries)-models.MaxLogsPerExecution:]
+	}
+
+	return nil
+}
+
+func (s *ExecutionLogSink) Entries() []models.CanvasNodeExecutionLog {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]models.CanvasNodeExecutionLog{}, s.entries...)
+}
+
+/*
+ * Sets the execution the entries are stored for.
+ * The sink is usually created before the execution is loaded,
+ * so the entries can be flushed after the transaction loading it finishes.
+ */
+func (s *ExecutionLogSink) Bind(execution *models.CanvasNodeExecution) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.execution = execution
+}
+
+/*
+ * Stores the buffered entries for the bound execution,
+ * and clears the buffer, so the sink can be flushed again.
+ *
+ * This must be called after the transaction processing the execution finishes,
+ * and not with it, so the entries are still stored when that transaction is rolled back,
+ * which is when they are the most useful.
+ */
+func (s *ExecutionLogSink) Flush(db *gorm.DB) error {
+	s.mu.Lock()
+	execution := s.execution
+	entries := s.entries
+	s.entries = nil
+	s.mu.Unlock()
+
+	if execution == nil || len(entries) == 0 {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		return models.CreateNodeExecutionLogsInTransaction(tx, execution, entries)
+	})
+}
+
+/*
+ * Log fields can hold any value, but they are stored as JSON.
+ * Errors are stored with their message, and values
+ * that cannot be marshaled are stored with their string representation.
+ */
+func serializableFields(data log.Fields) map[string]any {
+	fields := make(map[string]any, len(data))
+	for key, value := range data {
+		if err, ok := value.(error); ok {
+			fields[key] = err.Error()
+			continue
+		}
+
+		if _, err := json.Marshal(value); err != nil {
+			fields[key] = fmt.Sprintf("%v", value)
+			continue
+		}
+
+		fields[key] = value
+	}
+
+	return fields
+}
@@ -0,0 +1,96 @@
+package logging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+func Test__ExecutionLogSink(t *testing.T) {
+	newLogger := func(out *bytes.Buffer) *log.Entry {
+		logger := log.New()
+		logger.SetOutput(out)
+		return log.NewEntry(logger).WithField("execution", "exec-1")
+	}
+
+	t.Run("entries are sent to the sink and to the original output", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		sink := NewExecutionLogSink()
+		logger := WithSink(newLogger(out), sink)
+
+		logger.WithField("attempt", 2).Warnf("retrying %s", "request")
+
+		assert.Contains(t, out.String(), "retrying request")
+
+		entries := sink.Entries()
+		require.Len(t, entries, 1)
+		assert.Equal(t, "warning", entries[0].Level)
+		assert.Equal(t, "retrying request", entries[0].Message)
+		assert.Equal(t, map[string]any{"execution": "exec-1", "attempt": 2}, entries[0].Fields.Data())
+		assert.NotNil(t, entries[0].CreatedAt)
+	})
+
+	t.Run("original logger is not changed", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		sink := NewExecutionLogSink()
+		original := newLogger(out)
+		_ = WithSink(original, sink)
+
+		original.Info("not captured")
+
+		assert.Empty(t, sink.Entries())
+		assert.Contains(t, out.String(), "not captured")
+	})
+
+	t.Run("levels disabled in the original logger are not captured", func(t *testing.T) {
+		sink := NewExecutionLogSink()
+		logger := WithSink(newLogger(&bytes.Buffer{}), sink)
+
+		logger.Debug("debug message")
+		logger.Info("info message")
+
+		entries := sink.Entries()
+		require.Len(t, entries, 1)
+		assert.Equal(t, "info message", entries[0].Message)
+	})
+
+	t.Run("only the most recent entries are kept", func(t *testing.T) {
+		sink := NewExecutionLogSink()
+		logger := WithSink(newLogger(&bytes.Buffer{}), sink)
+
+		for i := range models.MaxLogsPerExecution + 10 {
+			logger.Infof("message %d", i)
+		}
+
+		entries := sink.Entries()
+		require.Len(t, entries, models.MaxLogsPerExecution)
+		assert.Equal(t, "message 10", entries[0].Message)
+		assert.Equal(t, fmt.Sprintf("message %d", models.MaxLogsPerExecution+9), entries[len(entries)-1].Message)
+	})
+
+	t.Run("fields that cannot be stored as JSON are converted", func(t *testing.T) {
+		sink := NewExecutionLogSink()
+		logger := WithSink(newLogger(&bytes.Buffer{}), sink)
+
+		id := uuid.New()
+		logger.WithFields(log.Fields{
+			"id":      id,
+			"channel": make(chan int),
+		}).WithError(errors.New("boom")).Error("failed")
+
+		entries := sink.Entries()
+		require.Len(t, entries, 1)
+
+		fields := entries[0].Fields.Data()
+		assert.Equal(t, "boom", fields["error"])
+		assert.Equal(t, id, fields["id"])
+		assert.IsType(t, "", fields["channel"])
+	})
+}
@@ -0,0 +1,98 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+)
+
+const (
+	AuditEventResultSuccess = "success"
+	AuditEventResultDenied  = "denied"
+)
+
+type AuditEvent struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null"`
+	UserID         uuid.UUID `gorm:"type:uuid;not null"`
+	Method         string
+	Resource       string
+	Action         string
+	Request        datatypes.JSONType[map[string]any]
+	Result         string
+	CreatedAt      *time.Time
+}
+
+func (a *AuditEvent) TableName() string {
+	return "audit_events"
+}
+
+type AuditEventFilters struct {
+	UserID   *uuid.UUID
+	Resource string
+	Result   string
+	After    *time.Time
+	Before   *time.Time
+}
+
+func CreateAuditEvent(event *AuditEvent) error {
+	if event.CreatedAt == nil {
+		now := time.Now()
+		event.CreatedAt = &now
+	}
+
+	return database.Conn().Create(event).Error
+}
+
+func ListAuditEvents(organizationID uuid.UUID, filters AuditEventFilters, limit int) ([]AuditEvent, error) {
+	var events []AuditEvent
+
+	query := database.Conn().
+		Where("organization_id = ?", organizationID)
+
+	if filters.UserID != nil {
+		query = query.Where("user_id = ?", *filters.UserID)
+	}
+
+	if filters.Resource != "" {
+		query = query.Where("resource = ?", filters.Resource)
+	}
+
+	if filters.Result != "" {
+		query = query.Where("result = ?", filters.Result)
+	}
+
+	if filters.After != nil {
+		query = query.Where("created_at > ?", *filters.After)
+	}
+
+	if filters.Before != nil {
+		query = query.Where("created_at < ?", *filters.Before)
+	}
+
+	err := query.
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&events).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func DeleteAuditEventsBefore(before time.Time, limit int) (int64, error) {
+	result := database.Conn().
+		Where("id IN (?)", database.Conn().
+			Model(&AuditEvent{}).
+			Select("id").
+			Where("created_at < ?", before).
+			Limit(limit)).
+		Delete(&AuditEvent{})
+
+	return result.RowsAffected, result.Error
+}
@@ -94,18 +94,46 @@ func (c *Canvas) FindEdges(sourceID string, channel string) []Edge {
 }
 
 func (c *Canvas) SoftDelete() error {
-	return c.SoftDeleteInTransaction(database.Conn())
+	return database.Conn().Transaction(func(tx *gorm.DB) error {
+		return c.SoftDeleteInTransaction(tx)
+	})
 }
 
+/*
+ * Soft deletes the canvas, and with it, its nodes, node requests
+ * and executions that are not finished yet, so nothing else picks them up.
+ * Finished executions are kept visible, since they are history.
+ * The cleanup worker deletes all of them for good later.
+ */
 func (c *Canvas) SoftDeleteInTransaction(tx *gorm.DB) error {
 	now := time.Now()
 	timestamp := now.Unix()
 
 	newName := fmt.Sprintf("%s (deleted-%d)", c.Name, timestamp)
-	return tx.Model(c).Updates(map[string]interface{}{
+	err := tx.Model(c).Updates(map[string]interface{}{
 		"deleted_at": now,
 		"name":       newName,
 	}).Error
+
+	if err != nil {
+		return err
+	}
+
+	err = tx.Where("workflow_id = ?", c.ID).Delete(&CanvasNode{}).Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Where("workflow_id = ?", c.ID).Delete(&CanvasNodeRequest{}).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.
+		Where("workflow_id = ?", c.ID).
+		Where("state <> ?", CanvasNodeExecutionStateFinished).
+		Delete(&CanvasNodeExecution{}).
+		Error
 }
 
 func FindCanvas(orgID, id uuid.UUID) (*Canvas, error) {
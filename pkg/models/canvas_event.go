@@ -131,21 +131,79 @@ func CountCanvasEvents(canvasID uuid.UUID, nodeID string) (int64, error) {
 	return count, nil
 }
 
-func ListRootCanvasEvents(canvasID uuid.UUID, limit int, before *time.Time) ([]CanvasEvent, error) {
-	var events []CanvasEvent
+/*
+ * Filters for the root events of a canvas.
+ * Empty fields do not filter anything.
+ */
+type CanvasEventFilters struct {
+	NodeID      string
+	Channel     string
+	PayloadType string
+	After       *time.Time
+	Before      *time.Time
+}
+
+/*
+ * Position of the last event of a page. Events are ordered
+ * by creation time and ID, so events created at the same time
+ * are not skipped nor repeated between pages.
+ */
+type CanvasEventCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+type CanvasEventNodeCount struct {
+	NodeID string
+	Count  int64
+}
+
+func rootCanvasEventsQuery(canvasID uuid.UUID, filters CanvasEventFilters) *gorm.DB {
 	query := database.Conn().
+		Model(&CanvasEvent{}).
 		Where("workflow_id = ?", canvasID).
 		Where("execution_id IS NULL")
 
-	if limit > 0 {
-		query = query.Limit(limit)
+	if filters.NodeID != "" {
+		query = query.Where("node_id = ?", filters.NodeID)
 	}
 
-	if before != nil {
-		query = query.Where("created_at < ?", before)
+	if filters.Channel != "" {
+		query = query.Where("channel = ?", filters.Channel)
 	}
 
-	err := query.Order("created_at DESC").Find(&events).Error
+	if filters.PayloadType != "" {
+		query = query.Where("data->>'type' = ?", filters.PayloadType)
+	}
+
+	if filters.After != nil {
+		query = query.Where("created_at > ?", filters.After)
+	}
+
+	if filters.Before != nil {
+		query = query.Where("created_at < ?", filters.Before)
+	}
+
+	return query
+}
+
+/*
+ * Lists the root events of a canvas, most recent first,
+ * starting after the cursor, if one is given.
+ */
+func ListRootCanvasEvents(canvasID uuid.UUID, filters CanvasEventFilters, cursor *CanvasEventCursor, limit int) ([]CanvasEvent, error) {
+	var events []CanvasEvent
+	query := rootCanvasEventsQuery(canvasID, filters)
+
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	err := query.Order("created_at DESC, id DESC").Find(&events).Error
 	if err != nil {
 		return nil, err
 	}
@@ -153,13 +211,10 @@ func ListRootCanvasEvents(canvasID uuid.UUID, limit int, before *time.Time) ([]C
 	return events, nil
 }
 
-func CountRootCanvasEvents(canvasID uuid.UUID) (int64, error) {
+func CountRootCanvasEvents(canvasID uuid.UUID, filters CanvasEventFilters) (int64, error) {
 	var count int64
 
-	err := database.Conn().
-		Model(&CanvasEvent{}).
-		Where("workflow_id = ?", canvasID).
-		Where("execution_id IS NULL").
+	err := rootCanvasEventsQuery(canvasID, filters).
 		Count(&count).
 		Error
 
@@ -170,6 +225,23 @@ func CountRootCanvasEvents(canvasID uuid.UUID) (int64, error) {
 	return count, nil
 }
 
+func CountRootCanvasEventsByNode(canvasID uuid.UUID, filters CanvasEventFilters) ([]CanvasEventNodeCount, error) {
+	var counts []CanvasEventNodeCount
+
+	err := rootCanvasEventsQuery(canvasID, filters).
+		Select("node_id, COUNT(*) AS count").
+		Group("node_id").
+		Order("node_id").
+		Scan(&counts).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
 func ListPendingCanvasEvents() ([]CanvasEvent, error) {
 	var events []CanvasEvent
 	err := database.Conn().
@@ -237,3 +309,46 @@ func FindLastEventPerNode(canvasID uuid.UUID) ([]CanvasEvent, error) {
 
 	return events, nil
 }
+
+/*
+ * Counts what is still being processed downstream of an event:
+ * events not routed yet, queue items waiting for their node,
+ * and executions not finished yet.
+ *
+ * Descendants are found by following the events emitted
+ * by the executions created for each event, recursively.
+ */
+func CountUnfinishedDescendantsInTransaction(tx *gorm.DB, workflowID, eventID uuid.UUID) (int64, error) {
+	var count int64
+
+	err := tx.Raw(`
+		WITH RECURSIVE descendant_events AS (
+			SELECT id, state
+			FROM workflow_events
+			WHERE id = ? AND workflow_id = ?
+
+			UNION
+
+			SELECT we.id, we.state
+			FROM workflow_events we
+			INNER JOIN workflow_node_executions wne ON we.execution_id = wne.id
+			INNER JOIN descendant_events de ON wne.event_id = de.id
+		)
+		SELECT
+			(SELECT COUNT(*) FROM descendant_events WHERE state = ?) +
+			(SELECT COUNT(*) FROM workflow_node_queue_items WHERE event_id IN (SELECT id FROM descendant_events)) +
+			(SELECT COUNT(*) FROM workflow_node_executions
+				WHERE event_id IN (SELECT id FROM descendant_events) AND state IN ?)
+	`,
+		eventID,
+		workflowID,
+		CanvasEventStatePending,
+		[]string{CanvasNodeExecutionStatePending, CanvasNodeExecutionStateStarted},
+	).Scan(&count).Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
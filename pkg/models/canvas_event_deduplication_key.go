@@ -0,0 +1,85 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+)
+
+/*
+ * Name of the node metadata field counting
+ * the events dropped as duplicates.
+ */
+const DeduplicatedEventsMetadataField = "deduplicatedEvents"
+
+/*
+ * Keys of events recently emitted by trigger nodes
+ * with deduplication enabled. Events whose key is
+ * already here, and not expired, are dropped.
+ */
+type CanvasEventDeduplicationKey struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	WorkflowID uuid.UUID
+	NodeID     string
+	Key        string
+	ExpiresAt  *time.Time
+	CreatedAt  *time.Time
+}
+
+func (k *CanvasEventDeduplicationKey) TableName() string {
+	return "workflow_event_deduplication_keys"
+}
+
+/*
+ * Records the key for the node, unless it was recorded before
+ * and has not expired yet, in which case false is returned.
+ * Concurrent calls for the same key are serialized by the unique index,
+ * so only one of them records it.
+ */
+func RecordEventDeduplicationKeyInTransaction(tx *gorm.DB, workflowID uuid.UUID, nodeID, key string, window time.Duration) (bool, error) {
+	now := time.Now()
+	var recorded []string
+
+	err := tx.Raw(`
+		INSERT INTO workflow_event_deduplication_keys (workflow_id, node_id, key, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (workflow_id, node_id, key) DO UPDATE
+		SET expires_at = EXCLUDED.expires_at, created_at = EXCLUDED.created_at
+		WHERE workflow_event_deduplication_keys.expires_at <= ?
+		RETURNING key
+	`, workflowID, nodeID, key, now.Add(window), now, now).
+		Scan(&recorded).
+		Error
+
+	if err != nil {
+		return false, err
+	}
+
+	return len(recorded) > 0, nil
+}
+
+func IncrementDeduplicatedEventsInTransaction(tx *gorm.DB, workflowID uuid.UUID, nodeID string) error {
+	return tx.Exec(`
+		UPDATE workflow_nodes
+		SET metadata = jsonb_set(
+			CASE WHEN jsonb_typeof(metadata) = 'object' THEN metadata ELSE '{}'::jsonb END,
+			ARRAY[?::text],
+			to_jsonb(COALESCE((metadata->>?)::bigint, 0) + 1)
+		)
+		WHERE workflow_id = ? AND node_id = ?
+	`, DeduplicatedEventsMetadataField, DeduplicatedEventsMetadataField, workflowID, nodeID).Error
+}
+
+func DeleteExpiredEventDeduplicationKeys(now time.Time, limit int) (int64, error) {
+	result := database.Conn().
+		Where("id IN (?)", database.Conn().
+			Model(&CanvasEventDeduplicationKey{}).
+			Select("id").
+			Where("expires_at < ?", now).
+			Limit(limit)).
+		Delete(&CanvasEventDeduplicationKey{})
+
+	return result.RowsAffected, result.Error
+}
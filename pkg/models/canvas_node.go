@@ -286,7 +286,7 @@ func (c *CanvasNode) FirstQueueItem(tx *gorm.DB) (*CanvasNodeQueueItem, error) {
 }
 
 func (c *CanvasNode) CreateRequest(tx *gorm.DB, reqType string, spec NodeExecutionRequestSpec, runAt *time.Time) error {
-	return tx.Create(&CanvasNodeRequest{
+	return createNodeRequest(tx, &CanvasNodeRequest{
 		WorkflowID: c.WorkflowID,
 		NodeID:     c.NodeID,
 		ID:         uuid.New(),
@@ -296,7 +296,7 @@ func (c *CanvasNode) CreateRequest(tx *gorm.DB, reqType string, spec NodeExecuti
 		RunAt:      *runAt,
 		CreatedAt:  time.Now(),
 		UpdatedAt:  time.Now(),
-	}).Error
+	})
 }
 
 type CanvasNodeQueueItem struct {
@@ -365,6 +365,33 @@ func CountNodeQueueItems(workflowID uuid.UUID, nodeID string) (int64, error) {
 	return totalCount, nil
 }
 
+func CountNodeQueueItemsForNodes(workflowID uuid.UUID, nodeIDs []string) (map[string]int64, error) {
+	var rows []struct {
+		NodeID string
+		Count  int64
+	}
+
+	err := database.Conn().
+		Model(&CanvasNodeQueueItem{}).
+		Select("node_id, COUNT(*) AS count").
+		Where("workflow_id = ?", workflowID).
+		Where("node_id IN ?", nodeIDs).
+		Group("node_id").
+		Scan(&rows).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.NodeID] = row.Count
+	}
+
+	return counts, nil
+}
+
 // FindNextQueueItemPerNode finds the next (oldest) queue item for each node in a workflow
 // using DISTINCT ON to get one queue item per node_id, ordered by created_at ASC
 // Only returns queue items for nodes that have not been deleted
@@ -3,6 +3,7 @@ package models
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,6 +25,8 @@ const (
 	CanvasNodeExecutionResultReasonOk            = "ok"
 	CanvasNodeExecutionResultReasonError         = "error"
 	CanvasNodeExecutionResultReasonErrorResolved = "error_resolved"
+
+	ExecutionMetadataAttemptsKey = "attempts"
 )
 
 type CanvasNodeExecution struct {
@@ -71,6 +74,19 @@ type CanvasNodeExecution struct {
 	ResultMessage string
 	CancelledBy   *uuid.UUID
 
+	//
+	// Executions that fail with a retryable error go back to pending,
+	// and are only picked up again after NextAttemptAt.
+	//
+	RetryCount    int
+	NextAttemptAt *time.Time
+
+	//
+	// Executions that are not finished when their canvas is deleted
+	// are soft deleted with it, until the cleanup worker removes them.
+	//
+	DeletedAt gorm.DeletedAt
+
 	//
 	// Components can store metadata about each execution here.
 	// This allows them to control the behavior of each execution.
@@ -133,6 +149,7 @@ func ListPendingNodeExecutions() ([]CanvasNodeExecution, error) {
 	var executions []CanvasNodeExecution
 	query := database.Conn().
 		Where("state = ?", CanvasNodeExecutionStatePending).
+		Where("next_attempt_at IS NULL OR next_attempt_at <= ?", time.Now()).
 		Order("created_at DESC")
 
 	err := query.Find(&executions).Error
@@ -211,6 +228,57 @@ func CountNodeExecutions(workflowID uuid.UUID, nodeID string, states []string, r
 	return totalCount, nil
 }
 
+/*
+ * Number of executions of a node in a given state and result,
+ * as returned by CountNodeExecutionsByResult.
+ */
+type NodeExecutionCount struct {
+	NodeID string
+	State  string
+	Result string
+	Count  int64
+}
+
+func CountNodeExecutionsByResult(workflowID uuid.UUID, nodeIDs []string, since time.Time) ([]NodeExecutionCount, error) {
+	var counts []NodeExecutionCount
+	err := database.Conn().
+		Model(&CanvasNodeExecution{}).
+		Select("node_id, state, result, COUNT(*) AS count").
+		Where("workflow_id = ?", workflowID).
+		Where("node_id IN ?", nodeIDs).
+		Where("created_at >= ?", since).
+		Group("node_id, state, result").
+		Scan(&counts).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+/*
+ * Returns the most recent execution of each of the nodes,
+ * for nodes that have executions.
+ */
+func FindLastNodeExecutions(workflowID uuid.UUID, nodeIDs []string) ([]CanvasNodeExecution, error) {
+	var executions []CanvasNodeExecution
+	err := database.Conn().
+		Select("DISTINCT ON (node_id) *").
+		Where("workflow_id = ?", workflowID).
+		Where("node_id IN ?", nodeIDs).
+		Order("node_id, created_at DESC").
+		Find(&executions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
 func CountRunningExecutionsForNode(workflowID uuid.UUID, nodeID string) (int64, error) {
 	return CountRunningExecutionsForNodeInTransaction(database.Conn(), workflowID, nodeID)
 }
@@ -250,6 +318,40 @@ func FindNodeExecutionInTransaction(tx *gorm.DB, workflowID, id uuid.UUID) (*Can
 	return &execution, nil
 }
 
+func FindNodeExecutionWithoutWorkflowScope(id uuid.UUID) (*CanvasNodeExecution, error) {
+	var execution CanvasNodeExecution
+	err := database.Conn().
+		Where("id = ?", id).
+		First(&execution).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &execution, nil
+}
+
+/*
+ * Unlike LockCanvasNodeExecution, this waits for the lock to be released,
+ * so concurrent updates to the same execution are applied one after the other.
+ */
+func FindNodeExecutionForUpdateInTransaction(tx *gorm.DB, workflowID, id uuid.UUID) (*CanvasNodeExecution, error) {
+	var execution CanvasNodeExecution
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		Where("workflow_id = ?", workflowID).
+		First(&execution).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &execution, nil
+}
+
 func FindNodeExecutionWithNodeID(workflowID, id uuid.UUID, nodeID string) (*CanvasNodeExecution, error) {
 	return FindNodeExecutionWithNodeIDInTransaction(database.Conn(), workflowID, id, nodeID)
 }
@@ -386,12 +488,13 @@ func (e *CanvasNodeExecution) Pass(outputs map[string][]any) ([]CanvasEvent, err
 	return events, err
 }
 
-func (e *CanvasNodeExecution) PassInTransaction(tx *gorm.DB, channelOutputs map[string][]any) ([]CanvasEvent, error) {
+/*
+ * Creates the output events for an execution, without changing its state.
+ * Used directly by components that emit events while their execution is still running.
+ */
+func (e *CanvasNodeExecution) CreateEventsInTransaction(tx *gorm.DB, channelOutputs map[string][]any) ([]CanvasEvent, error) {
 	now := time.Now()
 
-	//
-	// Create events for outputs
-	//
 	events := []CanvasEvent{}
 	for channel, outputs := range channelOutputs {
 		for _, event := range outputs {
@@ -414,6 +517,20 @@ func (e *CanvasNodeExecution) PassInTransaction(tx *gorm.DB, channelOutputs map[
 		}
 	}
 
+	return events, nil
+}
+
+func (e *CanvasNodeExecution) PassInTransaction(tx *gorm.DB, channelOutputs map[string][]any) ([]CanvasEvent, error) {
+	now := time.Now()
+
+	//
+	// Create events for outputs
+	//
+	events, err := e.CreateEventsInTransaction(tx, channelOutputs)
+	if err != nil {
+		return nil, err
+	}
+
 	//
 	// Update the workflow node state to ready.
 	//
@@ -445,6 +562,11 @@ func (e *CanvasNodeExecution) PassInTransaction(tx *gorm.DB, channelOutputs map[
 		return nil, err
 	}
 
+	err = DeleteNodeExecutionAttachmentsInTransaction(tx, e.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	return events, nil
 }
 
@@ -470,6 +592,11 @@ func (e *CanvasNodeExecution) FailInTransaction(tx *gorm.DB, reason, message str
 		return err
 	}
 
+	err = DeleteNodeExecutionAttachmentsInTransaction(tx, e.ID)
+	if err != nil {
+		return err
+	}
+
 	//
 	// Update the workflow node state to ready.
 	//
@@ -504,6 +631,80 @@ func (e *CanvasNodeExecution) FailInTransaction(tx *gorm.DB, reason, message str
 	return nil
 }
 
+/*
+ * Fails the execution after its retry policy gave up on it,
+ * either because the error is permanent, or because it ran out of attempts.
+ * Unlike other failures, these go to the dead-letter queue,
+ * so they can be re-enqueued once the cause is fixed.
+ */
+func (e *CanvasNodeExecution) FailPermanently(reason, message string) error {
+	return database.Conn().Transaction(func(tx *gorm.DB) error {
+		return e.FailPermanentlyInTransaction(tx, reason, message)
+	})
+}
+
+func (e *CanvasNodeExecution) FailPermanentlyInTransaction(tx *gorm.DB, reason, message string) error {
+	err := e.FailInTransaction(tx, reason, message)
+	if err != nil {
+		return err
+	}
+
+	//
+	// Child executions of blueprint nodes fail their parent execution,
+	// and only the top-level one goes to the dead-letter queue.
+	//
+	execution := e
+	for execution.ParentExecutionID != nil {
+		execution, err = FindNodeExecutionInTransaction(tx, execution.WorkflowID, *execution.ParentExecutionID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = CreateNodeExecutionDeadLetterInTransaction(tx, execution, reason, message)
+	if err != nil {
+		return fmt.Errorf("failed to dead-letter execution: %w", err)
+	}
+
+	return nil
+}
+
+/*
+ * Puts a started execution back in the pending state,
+ * so it is executed again once nextAttemptAt is reached.
+ * The error that caused the retry is kept in the result message.
+ */
+func (e *CanvasNodeExecution) RescheduleInTransaction(tx *gorm.DB, nextAttemptAt time.Time, message string) error {
+	now := time.Now()
+
+	return tx.Model(e).
+		Updates(map[string]any{
+			"state":           CanvasNodeExecutionStatePending,
+			"retry_count":     e.RetryCount + 1,
+			"next_attempt_at": &nextAttemptAt,
+			"result_message":  message,
+			"updated_at":      &now,
+		}).Error
+}
+
+/*
+ * Keeps the number of attempts made so far in the execution metadata,
+ * next to the metadata stored by the component itself.
+ */
+func (e *CanvasNodeExecution) RecordAttemptsInTransaction(tx *gorm.DB, attempts int) error {
+	metadata := map[string]any{}
+	maps.Copy(metadata, e.Metadata.Data())
+	metadata[ExecutionMetadataAttemptsKey] = attempts
+
+	err := tx.Model(e).Update("metadata", datatypes.NewJSONType(metadata)).Error
+	if err != nil {
+		return err
+	}
+
+	e.Metadata = datatypes.NewJSONType(metadata)
+	return nil
+}
+
 func (e *CanvasNodeExecution) Cancel(cancelledBy *uuid.UUID) error {
 	return e.CancelInTransaction(database.Conn(), cancelledBy)
 }
@@ -523,6 +724,11 @@ func (e *CanvasNodeExecution) CancelInTransaction(tx *gorm.DB, cancelledBy *uuid
 		return err
 	}
 
+	err = DeleteNodeExecutionAttachmentsInTransaction(tx, e.ID)
+	if err != nil {
+		return err
+	}
+
 	node, err := FindCanvasNode(tx, e.WorkflowID, e.NodeID)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return err
@@ -591,7 +797,7 @@ func ListCanvasEventsForExecutionsInTransaction(tx *gorm.DB, executionIDs []uuid
 }
 
 func (e *CanvasNodeExecution) CreateRequest(tx *gorm.DB, reqType string, spec NodeExecutionRequestSpec, runAt *time.Time) error {
-	return tx.Create(&CanvasNodeRequest{
+	return createNodeRequest(tx, &CanvasNodeRequest{
 		WorkflowID:  e.WorkflowID,
 		NodeID:      e.NodeID,
 		ExecutionID: &e.ID,
@@ -602,7 +808,7 @@ func (e *CanvasNodeExecution) CreateRequest(tx *gorm.DB, reqType string, spec No
 		RunAt:       *runAt,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
-	}).Error
+	})
 }
 
 // FindLastExecutionPerNode finds the most recent execution for each node in a workflow
@@ -619,6 +825,7 @@ func FindLastExecutionPerNode(workflowID uuid.UUID) ([]CanvasNodeExecution, erro
 				AND wne.node_id = wn.node_id
 			WHERE wne.workflow_id = ?
 			AND wne.parent_execution_id IS NULL
+			AND wne.deleted_at IS NULL
 			AND wn.deleted_at IS NULL
 			ORDER BY wne.node_id, wne.created_at DESC
 		`, workflowID).
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//
+// Attachments hold blobs that are too large to be stored
+// in the execution metadata. They only live while the execution
+// is running, and are deleted when the execution finishes.
+//
+
+type CanvasNodeExecutionAttachment struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	ExecutionID uuid.UUID `gorm:"type:uuid;not null"`
+	Name        string    `gorm:"type:varchar(128);not null"`
+	Data        []byte
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
+
+func (a *CanvasNodeExecutionAttachment) TableName() string {
+	return "workflow_node_execution_attachments"
+}
+
+/*
+ * Creates the attachment, or replaces its data
+ * if the execution already has one with the same name.
+ */
+func UpsertNodeExecutionAttachmentInTransaction(tx *gorm.DB, executionID uuid.UUID, name string, data []byte) error {
+	now := time.Now()
+	attachment := CanvasNodeExecutionAttachment{
+		ExecutionID: executionID,
+		Name:        name,
+		Data:        data,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+
+	return tx.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "execution_id"}, {Name: "name"}},
+			DoUpdates: clause.AssignmentColumns([]string{"data", "updated_at"}),
+		}).
+		Create(&attachment).
+		Error
+}
+
+func FindNodeExecutionAttachmentInTransaction(tx *gorm.DB, executionID uuid.UUID, name string) (*CanvasNodeExecutionAttachment, error) {
+	var attachment CanvasNodeExecutionAttachment
+
+	err := tx.
+		Where("execution_id = ?", executionID).
+		Where("name = ?", name).
+		First(&attachment).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &attachment, nil
+}
+
+func DeleteNodeExecutionAttachmentInTransaction(tx *gorm.DB, executionID uuid.UUID, name string) error {
+	return tx.
+		Where("execution_id = ?", executionID).
+		Where("name = ?", name).
+		Delete(&CanvasNodeExecutionAttachment{}).
+		Error
+}
+
+func DeleteNodeExecutionAttachmentsInTransaction(tx *gorm.DB, executionID uuid.UUID) error {
+	return tx.
+		Where("execution_id = ?", executionID).
+		Delete(&CanvasNodeExecutionAttachment{}).
+		Error
+}
@@ -0,0 +1,210 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrDeadLetterAlreadyRequeued = errors.New("dead-lettered execution was already re-enqueued")
+
+//
+// Dead letters keep the context of executions that failed permanently:
+// the configuration they ran with, the input they received, and the error,
+// so they can be inspected and re-enqueued, after the cause is fixed.
+//
+
+type CanvasNodeExecutionDeadLetter struct {
+	ID                  uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	WorkflowID          uuid.UUID
+	NodeID              string
+	ExecutionID         uuid.UUID
+	RootEventID         uuid.UUID
+	EventID             uuid.UUID
+	ConfigHash          string
+	Configuration       datatypes.JSONType[map[string]any]
+	Input               datatypes.JSONType[any]
+	ResultReason        string
+	ResultMessage       string
+	RequeuedExecutionID *uuid.UUID
+	RequeuedAt          *time.Time
+	CreatedAt           *time.Time
+}
+
+func (d *CanvasNodeExecutionDeadLetter) TableName() string {
+	return "workflow_node_execution_dead_letters"
+}
+
+/*
+ * Records the failed execution in the dead-letter queue.
+ * An execution is only dead-lettered once.
+ */
+func CreateNodeExecutionDeadLetterInTransaction(tx *gorm.DB, execution *CanvasNodeExecution, reason, message string) error {
+	//
+	// The input is kept even if the event is removed later,
+	// but an execution whose event is already gone is still dead-lettered.
+	//
+	var input any
+	event, err := FindCanvasEventInTransaction(tx, execution.EventID)
+	switch {
+	case err == nil:
+		input = event.Data.Data()
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return fmt.Errorf("failed to find input event %s: %w", execution.EventID, err)
+	}
+
+	configuration := execution.Configuration.Data()
+	configHash, err := ConfigurationHash(configuration)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	deadLetter := CanvasNodeExecutionDeadLetter{
+		WorkflowID:    execution.WorkflowID,
+		NodeID:        execution.NodeID,
+		ExecutionID:   execution.ID,
+		RootEventID:   execution.RootEventID,
+		EventID:       execution.EventID,
+		ConfigHash:    configHash,
+		Configuration: datatypes.NewJSONType(configuration),
+		Input:         datatypes.NewJSONType(input),
+		ResultReason:  reason,
+		ResultMessage: message,
+		CreatedAt:     &now,
+	}
+
+	return tx.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "execution_id"}},
+			DoNothing: true,
+		}).
+		Create(&deadLetter).
+		Error
+}
+
+/*
+ * JSON objects are encoded with their keys sorted,
+ * so the same configuration always has the same hash.
+ */
+func ConfigurationHash(configuration map[string]any) (string, error) {
+	data, err := json.Marshal(configuration)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode configuration: %w", err)
+	}
+
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+/*
+ * Lists the dead letters of a canvas, most recent first.
+ * Dead letters that were already re-enqueued are only included if requested.
+ */
+func ListNodeExecutionDeadLetters(workflowID uuid.UUID, nodeID string, includeRequeued bool, limit int) ([]CanvasNodeExecutionDeadLetter, error) {
+	var deadLetters []CanvasNodeExecutionDeadLetter
+	query := database.Conn().
+		Where("workflow_id = ?", workflowID).
+		Order("created_at DESC").
+		Limit(limit)
+
+	if nodeID != "" {
+		query = query.Where("node_id = ?", nodeID)
+	}
+
+	if !includeRequeued {
+		query = query.Where("requeued_at IS NULL")
+	}
+
+	err := query.Find(&deadLetters).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return deadLetters, nil
+}
+
+func LockNodeExecutionDeadLetterInTransaction(tx *gorm.DB, workflowID, id uuid.UUID) (*CanvasNodeExecutionDeadLetter, error) {
+	var deadLetter CanvasNodeExecutionDeadLetter
+
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("workflow_id = ?", workflowID).
+		Where("id = ?", id).
+		First(&deadLetter).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &deadLetter, nil
+}
+
+/*
+ * Creates a new pending execution, with the same input and configuration
+ * as the dead-lettered one, which the node executor picks up as any other.
+ */
+func (d *CanvasNodeExecutionDeadLetter) RequeueInTransaction(tx *gorm.DB) (*CanvasNodeExecution, error) {
+	if d.RequeuedAt != nil {
+		return nil, ErrDeadLetterAlreadyRequeued
+	}
+
+	failed, err := FindNodeExecutionInTransaction(tx, d.WorkflowID, d.ExecutionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find execution %s: %w", d.ExecutionID, err)
+	}
+
+	node, err := FindCanvasNode(tx, d.WorkflowID, d.NodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find node %s: %w", d.NodeID, err)
+	}
+
+	now := time.Now()
+	execution := CanvasNodeExecution{
+		WorkflowID:          d.WorkflowID,
+		NodeID:              d.NodeID,
+		RootEventID:         d.RootEventID,
+		EventID:             d.EventID,
+		PreviousExecutionID: failed.PreviousExecutionID,
+		State:               CanvasNodeExecutionStatePending,
+		Configuration:       datatypes.NewJSONType(d.Configuration.Data()),
+		CreatedAt:           &now,
+		UpdatedAt:           &now,
+	}
+
+	err = tx.Create(&execution).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if node.State == CanvasNodeStateReady {
+		err = node.UpdateState(tx, CanvasNodeStateProcessing)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Model(d).
+		Updates(map[string]any{
+			"requeued_execution_id": execution.ID,
+			"requeued_at":           &now,
+		}).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.RequeuedExecutionID = &execution.ID
+	d.RequeuedAt = &now
+	return &execution, nil
+}
@@ -1,9 +1,11 @@
 package models
 
 import (
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
 	"gorm.io/gorm"
 )
 
@@ -45,6 +47,54 @@ func CreateNodeExecutionKVInTransaction(tx *gorm.DB, workflowID uuid.UUID, nodeI
 	return tx.Create(&rec).Error
 }
 
+/*
+ * Creates all the pairs with a single statement.
+ */
+func CreateNodeExecutionKVsInTransaction(tx *gorm.DB, workflowID uuid.UUID, nodeID string, executionID uuid.UUID, pairs map[string]string) error {
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(pairs))
+	for key := range pairs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	records := make([]CanvasNodeExecutionKV, 0, len(pairs))
+	for _, key := range keys {
+		records = append(records, CanvasNodeExecutionKV{
+			WorkflowID:  workflowID,
+			NodeID:      nodeID,
+			ExecutionID: executionID,
+			Key:         key,
+			Value:       pairs[key],
+		})
+	}
+
+	return tx.Create(&records).Error
+}
+
+/*
+ * Key/values are only needed to correlate incoming events with executions,
+ * so they are deleted some time after the execution finishes.
+ * They are not deleted right away, since components like join
+ * still look up finished executions to drop events arriving late.
+ */
+func DeleteFinishedNodeExecutionKVsBefore(before time.Time, limit int) (int64, error) {
+	result := database.Conn().
+		Where("id IN (?)", database.Conn().
+			Table("workflow_node_execution_kvs AS kvs").
+			Select("kvs.id").
+			Joins("JOIN workflow_node_executions AS executions ON executions.id = kvs.execution_id").
+			Where("executions.state = ?", CanvasNodeExecutionStateFinished).
+			Where("executions.updated_at < ?", before).
+			Limit(limit)).
+		Delete(&CanvasNodeExecutionKV{})
+
+	return result.RowsAffected, result.Error
+}
+
 func FirstNodeExecutionByKVInTransaction(tx *gorm.DB, workflowID uuid.UUID, nodeID, key, value string) (*CanvasNodeExecution, error) {
 	var execution CanvasNodeExecution
 
@@ -2,6 +2,7 @@ package models
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -29,6 +30,57 @@ func Test__CanvasNodeExecutionKV(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("CreateNodeExecutionKVsInTransaction stores all pairs", func(t *testing.T) {
+		tx := database.Conn().Begin()
+		defer tx.Rollback()
+
+		exec := steps.CreateExecution()
+
+		err := CreateNodeExecutionKVsInTransaction(tx, exec.WorkflowID, exec.NodeID, exec.ID, map[string]string{
+			"task": "arn:aws:ecs:us-east-1:123456789012:task/cluster/1",
+			"tag":  "v1",
+		})
+		require.NoError(t, err)
+
+		var count int64
+		require.NoError(t, tx.Model(&CanvasNodeExecutionKV{}).Where("execution_id = ?", exec.ID).Count(&count).Error)
+		require.Equal(t, int64(2), count)
+
+		foundExec, err := FirstNodeExecutionByKVInTransaction(tx, exec.WorkflowID, exec.NodeID, "tag", "v1")
+		require.NoError(t, err)
+		require.Equal(t, exec.ID, foundExec.ID)
+
+		require.NoError(t, CreateNodeExecutionKVsInTransaction(tx, exec.WorkflowID, exec.NodeID, exec.ID, nil))
+	})
+
+	t.Run("DeleteFinishedNodeExecutionKVsBefore only deletes key/values of executions finished before", func(t *testing.T) {
+		finished := steps.CreateExecution()
+		recentlyFinished := steps.CreateExecution()
+		pending := steps.CreateExecution()
+
+		old := time.Now().Add(-48 * time.Hour)
+		now := time.Now()
+		require.NoError(t, database.Conn().Model(finished).Updates(map[string]any{"state": CanvasNodeExecutionStateFinished, "updated_at": old}).Error)
+		require.NoError(t, database.Conn().Model(recentlyFinished).Updates(map[string]any{"state": CanvasNodeExecutionStateFinished, "updated_at": now}).Error)
+		require.NoError(t, database.Conn().Model(pending).Update("updated_at", old).Error)
+
+		for _, exec := range []*CanvasNodeExecution{finished, recentlyFinished, pending} {
+			err := CreateNodeExecutionKVsInTransaction(database.Conn(), exec.WorkflowID, exec.NodeID, exec.ID, map[string]string{"a": "1", "b": "2"})
+			require.NoError(t, err)
+		}
+
+		deleted, err := DeleteFinishedNodeExecutionKVsBefore(time.Now().Add(-24*time.Hour), 10)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), deleted)
+
+		var remaining []CanvasNodeExecutionKV
+		require.NoError(t, database.Conn().Where("execution_id IN ?", []uuid.UUID{finished.ID, recentlyFinished.ID, pending.ID}).Find(&remaining).Error)
+		require.Len(t, remaining, 4)
+		for _, kv := range remaining {
+			require.NotEqual(t, finished.ID, kv.ExecutionID)
+		}
+	})
+
 	t.Run("FirstNodeExecutionByKVInTransaction returns the first created execution with that key", func(t *testing.T) {
 		tx := database.Conn().Begin()
 		defer tx.Rollback()
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+//
+// Only the most recent entries are kept for each execution,
+// so a noisy component cannot grow the table without bounds.
+//
+
+const MaxLogsPerExecution = 500
+
+type CanvasNodeExecutionLog struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	WorkflowID  uuid.UUID `gorm:"type:uuid;not null"`
+	NodeID      string    `gorm:"type:varchar(128);not null"`
+	ExecutionID uuid.UUID `gorm:"type:uuid;not null"`
+	Level       string
+	Message     string
+	Fields      datatypes.JSONType[map[string]any]
+	CreatedAt   *time.Time
+}
+
+func (c *CanvasNodeExecutionLog) TableName() string {
+	return "workflow_node_execution_logs"
+}
+
+func CreateNodeExecutionLogsInTransaction(tx *gorm.DB, execution *CanvasNodeExecution, logs []CanvasNodeExecutionLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	for i := range logs {
+		logs[i].WorkflowID = execution.WorkflowID
+		logs[i].NodeID = execution.NodeID
+		logs[i].ExecutionID = execution.ID
+	}
+
+	if err := tx.Create(&logs).Error; err != nil {
+		return err
+	}
+
+	return tx.
+		Where("execution_id = ?", execution.ID).
+		Where("id NOT IN (?)", tx.
+			Model(&CanvasNodeExecutionLog{}).
+			Select("id").
+			Where("execution_id = ?", execution.ID).
+			Order("created_at DESC, id DESC").
+			Limit(MaxLogsPerExecution)).
+		Delete(&CanvasNodeExecutionLog{}).
+		Error
+}
+
+func ListNodeExecutionLogs(workflowID, executionID uuid.UUID) ([]CanvasNodeExecutionLog, error) {
+	return ListNodeExecutionLogsInTransaction(database.Conn(), workflowID, executionID)
+}
+
+func ListNodeExecutionLogsInTransaction(tx *gorm.DB, workflowID, executionID uuid.UUID) ([]CanvasNodeExecutionLog, error) {
+	var logs []CanvasNodeExecutionLog
+
+	err := tx.
+		Where("workflow_id = ?", workflowID).
+		Where("execution_id = ?", executionID).
+		Order("created_at ASC, id ASC").
+		Find(&logs).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+func DeleteNodeExecutionLogsBefore(before time.Time, limit int) (int64, error) {
+	result := database.Conn().
+		Where("id IN (?)", database.Conn().
+			Model(&CanvasNodeExecutionLog{}).
+			Select("id").
+			Where("created_at < ?", before).
+			Limit(limit)).
+		Delete(&CanvasNodeExecutionLog{})
+
+	return result.RowsAffected, result.Error
+}
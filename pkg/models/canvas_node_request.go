@@ -14,20 +14,29 @@ const (
 	NodeRequestTypeInvokeAction = "invoke-action"
 
 	NodeExecutionRequestStatePending   = "pending"
+	NodeExecutionRequestStateClaimed   = "claimed"
 	NodeExecutionRequestStateCompleted = "completed"
 )
 
+/*
+ * Postgres channel notified when a node request is created.
+ * The payload is the time the request should run at.
+ */
+const NodeRequestsChannel = "workflow_node_requests"
+
 type CanvasNodeRequest struct {
-	ID          uuid.UUID
-	WorkflowID  uuid.UUID
-	NodeID      string
-	ExecutionID *uuid.UUID
-	State       string
-	Type        string
-	Spec        datatypes.JSONType[NodeExecutionRequestSpec]
-	RunAt       time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID           uuid.UUID
+	WorkflowID   uuid.UUID
+	NodeID       string
+	ExecutionID  *uuid.UUID
+	State        string
+	Type         string
+	Spec         datatypes.JSONType[NodeExecutionRequestSpec]
+	RunAt        time.Time
+	CreatedAt    time.Time
+	ClaimedUntil *time.Time
+	UpdatedAt    time.Time
+	DeletedAt    gorm.DeletedAt
 }
 
 func (r *CanvasNodeRequest) TableName() string {
@@ -43,12 +52,30 @@ type InvokeAction struct {
 	Parameters map[string]any `json:"parameters"`
 }
 
+/*
+ * The notification is only delivered when tx commits,
+ * so the worker never wakes up for a request it cannot see yet.
+ */
+func createNodeRequest(tx *gorm.DB, request *CanvasNodeRequest) error {
+	if err := tx.Create(request).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec("SELECT pg_notify(?, ?)", NodeRequestsChannel, request.RunAt.UTC().Format(time.RFC3339Nano)).Error
+}
+
+/*
+ * Locks a request that was not processed yet.
+ * Returns an error if another transaction holds the lock,
+ * or if the request was already completed.
+ */
 func LockNodeRequest(tx *gorm.DB, id uuid.UUID) (*CanvasNodeRequest, error) {
 	var request CanvasNodeRequest
 
 	err := tx.
 		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
 		Where("id = ?", id).
+		Where("state IN ?", []string{NodeExecutionRequestStatePending, NodeExecutionRequestStateClaimed}).
 		First(&request).
 		Error
 
@@ -59,18 +86,45 @@ func LockNodeRequest(tx *gorm.DB, id uuid.UUID) (*CanvasNodeRequest, error) {
 	return &request, nil
 }
 
-func ListNodeRequests() ([]CanvasNodeRequest, error) {
+/*
+ * Claims up to limit due requests in a single statement, so workers
+ * don't need one transaction per request just to find out what to process.
+ *
+ * Claimed requests are not claimed again by other workers until the lease expires.
+ * Rows locked by other transactions are skipped, instead of waited on.
+ */
+func ClaimNodeRequests(limit int, lease time.Duration) ([]CanvasNodeRequest, error) {
 	var requests []CanvasNodeRequest
 
 	now := time.Now()
 	err := database.Conn().
-		Joins("JOIN workflow_nodes ON workflow_node_requests.workflow_id = workflow_nodes.workflow_id AND workflow_node_requests.node_id = workflow_nodes.node_id").
-		Joins("JOIN workflows ON workflow_node_requests.workflow_id = workflows.id").
-		Where("workflow_node_requests.state = ?", NodeExecutionRequestStatePending).
-		Where("workflow_node_requests.run_at <= ?", now).
-		Where("workflow_nodes.deleted_at IS NULL").
-		Where("workflows.deleted_at IS NULL").
-		Find(&requests).
+		Raw(`
+			UPDATE workflow_node_requests
+			SET state = ?, claimed_until = ?, updated_at = ?
+			WHERE id IN (
+				SELECT workflow_node_requests.id
+				FROM workflow_node_requests
+				JOIN workflow_nodes ON workflow_node_requests.workflow_id = workflow_nodes.workflow_id AND workflow_node_requests.node_id = workflow_nodes.node_id
+				JOIN workflows ON workflow_node_requests.workflow_id = workflows.id
+				WHERE workflow_node_requests.state = ?
+				AND workflow_node_requests.run_at <= ?
+				AND workflow_node_requests.deleted_at IS NULL
+				AND workflow_nodes.deleted_at IS NULL
+				AND workflows.deleted_at IS NULL
+				ORDER BY workflow_node_requests.run_at ASC
+				LIMIT ?
+				FOR UPDATE OF workflow_node_requests SKIP LOCKED
+			)
+			RETURNING *
+		`,
+			NodeExecutionRequestStateClaimed,
+			now.Add(lease),
+			now,
+			NodeExecutionRequestStatePending,
+			now,
+			limit,
+		).
+		Scan(&requests).
 		Error
 
 	if err != nil {
@@ -80,6 +134,56 @@ func ListNodeRequests() ([]CanvasNodeRequest, error) {
 	return requests, nil
 }
 
+/*
+ * Returns claimed requests whose lease expired to pending,
+ * so they are picked up again if the worker that claimed them died.
+ * Requests still being processed are locked, so they are skipped.
+ */
+func ReleaseExpiredNodeRequestClaims() (int64, error) {
+	now := time.Now()
+	result := database.Conn().
+		Exec(`
+			UPDATE workflow_node_requests
+			SET state = ?, claimed_until = NULL, updated_at = ?
+			WHERE id IN (
+				SELECT id
+				FROM workflow_node_requests
+				WHERE state = ?
+				AND claimed_until < ?
+				FOR UPDATE SKIP LOCKED
+			)
+		`,
+			NodeExecutionRequestStatePending,
+			now,
+			NodeExecutionRequestStateClaimed,
+			now,
+		)
+
+	return result.RowsAffected, result.Error
+}
+
+/*
+ * Returns when the next pending request scheduled
+ * for the future is due, or nil if there is none.
+ */
+func FindNextNodeRequestRunAt() (*time.Time, error) {
+	var runAt *time.Time
+
+	err := database.Conn().
+		Model(&CanvasNodeRequest{}).
+		Select("MIN(run_at)").
+		Where("state = ?", NodeExecutionRequestStatePending).
+		Where("run_at > ?", time.Now()).
+		Scan(&runAt).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return runAt, nil
+}
+
 func FindPendingRequestForNode(tx *gorm.DB, workflowID uuid.UUID, nodeID string) (*CanvasNodeRequest, error) {
 	var request CanvasNodeRequest
 
@@ -87,7 +191,7 @@ func FindPendingRequestForNode(tx *gorm.DB, workflowID uuid.UUID, nodeID string)
 		Where("workflow_id = ?", workflowID).
 		Where("node_id = ?", nodeID).
 		Where("execution_id IS NULL").
-		Where("state = ?", NodeExecutionRequestStatePending).
+		Where("state IN ?", []string{NodeExecutionRequestStatePending, NodeExecutionRequestStateClaimed}).
 		First(&request).
 		Error
 
@@ -98,6 +202,21 @@ func FindPendingRequestForNode(tx *gorm.DB, workflowID uuid.UUID, nodeID string)
 	return &request, nil
 }
 
+/*
+ * Returns a claimed request to pending, so it is attempted again.
+ */
+func (r *CanvasNodeRequest) ReleaseClaim() error {
+	return database.Conn().
+		Model(r).
+		Where("state = ?", NodeExecutionRequestStateClaimed).
+		Updates(map[string]any{
+			"state":         NodeExecutionRequestStatePending,
+			"claimed_until": nil,
+			"updated_at":    time.Now(),
+		}).
+		Error
+}
+
 func (r *CanvasNodeRequest) Complete(tx *gorm.DB) error {
 	return tx.Model(r).
 		Update("state", NodeExecutionRequestStateCompleted).
@@ -7,6 +7,7 @@ const (
 	ProviderGoogle = "google"
 
 	DomainTypeOrganization = "org"
+	DomainTypeCanvas       = "canvas"
 
 	DisplayNameOwner  = "Owner"
 	DisplayNameAdmin  = "Admin"
@@ -16,11 +17,19 @@ const (
 	RoleOrgAdmin  = "org_admin"
 	RoleOrgViewer = "org_viewer"
 
+	RoleCanvasAdmin  = "canvas_admin"
+	RoleCanvasEditor = "canvas_editor"
+	RoleCanvasViewer = "canvas_viewer"
+
 	// Role descriptions
 	DescOrgOwner  = "Complete control over the organization including settings and deletion"
 	DescOrgAdmin  = "Full management access to organization resources including canvases and users"
 	DescOrgViewer = "Read-only access to organization resources"
 
+	DescCanvasAdmin  = "Can view, edit and delete a single canvas, and manage who has access to it"
+	DescCanvasEditor = "Can view and edit a single canvas"
+	DescCanvasViewer = "Read-only access to a single canvas"
+
 	// Metadata descriptions
 	MetaDescOrgOwner  = "Full control over organization settings, billing, and member management."
 	MetaDescOrgAdmin  = "Can manage canvases, users, groups, and roles within the organization."
@@ -2,6 +2,7 @@ package models
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,6 +16,14 @@ const (
 	IntegrationStatePending = "pending"
 	IntegrationStateReady   = "ready"
 	IntegrationStateError   = "error"
+
+	//
+	// Integrations that fail to sync are retried with exponential backoff,
+	// and are put in the error state after a number of consecutive failures.
+	//
+	IntegrationSyncFailuresBeforeError = 5
+	IntegrationMinSyncBackoff          = 30 * time.Second
+	IntegrationMaxSyncBackoff          = time.Hour
 )
 
 type Integration struct {
@@ -30,6 +39,13 @@ type Integration struct {
 	CreatedAt        *time.Time
 	UpdatedAt        *time.Time
 	DeletedAt        gorm.DeletedAt `gorm:"index"`
+
+	//
+	// Consecutive sync failures, reset by a successful sync.
+	//
+	SyncFailureCount int
+	LastSyncError    *string
+	LastSyncFailedAt *time.Time
 }
 
 func (a *Integration) TableName() string {
@@ -79,8 +95,12 @@ func CreateIntegration(id, orgID uuid.UUID, appName string, installationName str
 }
 
 func ListIntegrations(orgID uuid.UUID) ([]Integration, error) {
+	return ListIntegrationsInTransaction(database.Conn(), orgID)
+}
+
+func ListIntegrationsInTransaction(tx *gorm.DB, orgID uuid.UUID) ([]Integration, error) {
 	var integrations []Integration
-	err := database.Conn().Where("organization_id = ?", orgID).Find(&integrations).Error
+	err := tx.Where("organization_id = ?", orgID).Find(&integrations).Error
 	if err != nil {
 		return nil, err
 	}
@@ -121,8 +141,12 @@ type CanvasNodeReference struct {
 }
 
 func ListIntegrationNodeReferences(integrationID uuid.UUID) ([]CanvasNodeReference, error) {
+	return ListIntegrationNodeReferencesInTransaction(database.Conn(), integrationID)
+}
+
+func ListIntegrationNodeReferencesInTransaction(tx *gorm.DB, integrationID uuid.UUID) ([]CanvasNodeReference, error) {
 	var nodeReferences []CanvasNodeReference
-	err := database.Conn().
+	err := tx.
 		Table("workflow_nodes AS wn").
 		Joins("JOIN workflows AS w ON w.id = wn.workflow_id").
 		Select("w.id as canvas_id, w.name as canvas_name, wn.node_id as node_id, wn.name as node_name").
@@ -137,6 +161,69 @@ func ListIntegrationNodeReferences(integrationID uuid.UUID) ([]CanvasNodeReferen
 	return nodeReferences, nil
 }
 
+/*
+ * Removes the integration from the nodes using it, in both the nodes
+ * and the canvas specs, so saving the canvas does not attach it again.
+ * Component and blueprint nodes are paused, and trigger nodes,
+ * which cannot be paused, are moved to the error state.
+ */
+func DetachIntegrationFromNodesInTransaction(tx *gorm.DB, integrationID uuid.UUID, reason string) error {
+	references, err := ListIntegrationNodeReferencesInTransaction(tx, integrationID)
+	if err != nil {
+		return err
+	}
+
+	if len(references) == 0 {
+		return nil
+	}
+
+	err = tx.Exec(`
+		UPDATE workflow_nodes
+		SET app_installation_id = NULL,
+			state = CASE WHEN type IN (?, ?) THEN ? ELSE ? END,
+			state_reason = ?,
+			updated_at = ?
+		WHERE app_installation_id = ? AND deleted_at IS NULL
+	`, NodeTypeComponent, NodeTypeBlueprint, CanvasNodeStatePaused, CanvasNodeStateError, reason, time.Now(), integrationID).Error
+
+	if err != nil {
+		return err
+	}
+
+	canvasIDs := []uuid.UUID{}
+	for _, reference := range references {
+		if !slices.Contains(canvasIDs, reference.CanvasID) {
+			canvasIDs = append(canvasIDs, reference.CanvasID)
+		}
+	}
+
+	for _, canvasID := range canvasIDs {
+		var canvas Canvas
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", canvasID).
+			First(&canvas).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		for i, node := range canvas.Nodes {
+			if node.IntegrationID != nil && *node.IntegrationID == integrationID.String() {
+				canvas.Nodes[i].IntegrationID = nil
+			}
+		}
+
+		err = tx.Model(&canvas).Update("nodes", canvas.Nodes).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func FindUnscopedIntegration(integrationID uuid.UUID) (*Integration, error) {
 	return FindUnscopedIntegrationInTransaction(database.Conn(), integrationID)
 }
@@ -229,6 +316,51 @@ func LockIntegration(tx *gorm.DB, ID uuid.UUID) (*Integration, error) {
 	return &integration, nil
 }
 
+func (a *Integration) RecordSyncFailure(syncErr error) {
+	now := time.Now()
+	message := syncErr.Error()
+	a.SyncFailureCount++
+	a.LastSyncError = &message
+	a.LastSyncFailedAt = &now
+}
+
+func (a *Integration) ResetSyncFailures() {
+	a.SyncFailureCount = 0
+	a.LastSyncError = nil
+	a.LastSyncFailedAt = nil
+}
+
+/*
+ * The backoff doubles with every consecutive failure,
+ * starting at IntegrationMinSyncBackoff, up to IntegrationMaxSyncBackoff.
+ */
+func (a *Integration) SyncBackoff() time.Duration {
+	if a.SyncFailureCount == 0 {
+		return 0
+	}
+
+	backoff := IntegrationMinSyncBackoff
+	for i := 1; i < a.SyncFailureCount; i++ {
+		backoff *= 2
+		if backoff >= IntegrationMaxSyncBackoff {
+			return IntegrationMaxSyncBackoff
+		}
+	}
+
+	return backoff
+}
+
+/*
+ * How long to wait before the next sync, after the last failed one.
+ */
+func (a *Integration) SyncRetryAfter(now time.Time) time.Duration {
+	if a.SyncFailureCount == 0 || a.LastSyncFailedAt == nil {
+		return 0
+	}
+
+	return max(a.LastSyncFailedAt.Add(a.SyncBackoff()).Sub(now), 0)
+}
+
 func (a *Integration) SoftDelete() error {
 	return a.SoftDeleteInTransaction(database.Conn())
 }
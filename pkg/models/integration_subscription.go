@@ -17,23 +17,55 @@ type IntegrationSubscription struct {
 	Configuration  datatypes.JSONType[any]
 	CreatedAt      *time.Time
 	UpdatedAt      *time.Time
+
+	//
+	// Source and type of the events the subscription wants,
+	// used to only load the subscriptions that can match an event.
+	// Empty for subscriptions that are not indexed.
+	//
+	EventSource *string
+	EventType   *string
+}
+
+type SubscriptionIndex struct {
+	Source string
+	Type   string
+}
+
+func (i *SubscriptionIndex) columns() (*string, *string) {
+	if i == nil {
+		return nil, nil
+	}
+
+	return nullableString(i.Source), nullableString(i.Type)
+}
+
+func nullableString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
 }
 
 func (a *IntegrationSubscription) TableName() string {
 	return "app_installation_subscriptions"
 }
 
-func CreateIntegrationSubscription(node *CanvasNode, integration *Integration, configuration any) (*IntegrationSubscription, error) {
-	return CreateIntegrationSubscriptionInTransaction(database.Conn(), node, integration, configuration)
+func CreateIntegrationSubscription(node *CanvasNode, integration *Integration, configuration any, index *SubscriptionIndex) (*IntegrationSubscription, error) {
+	return CreateIntegrationSubscriptionInTransaction(database.Conn(), node, integration, configuration, index)
 }
 
-func CreateIntegrationSubscriptionInTransaction(tx *gorm.DB, node *CanvasNode, integration *Integration, configuration any) (*IntegrationSubscription, error) {
+func CreateIntegrationSubscriptionInTransaction(tx *gorm.DB, node *CanvasNode, integration *Integration, configuration any, index *SubscriptionIndex) (*IntegrationSubscription, error) {
 	now := time.Now()
+	source, eventType := index.columns()
 	s := IntegrationSubscription{
 		InstallationID: integration.ID,
 		WorkflowID:     node.WorkflowID,
 		NodeID:         node.NodeID,
 		Configuration:  datatypes.NewJSONType(configuration),
+		EventSource:    source,
+		EventType:      eventType,
 		CreatedAt:      &now,
 		UpdatedAt:      &now,
 	}
@@ -46,6 +78,29 @@ func CreateIntegrationSubscriptionInTransaction(tx *gorm.DB, node *CanvasNode, i
 	return &s, nil
 }
 
+func UpdateIntegrationSubscriptionConfigurationInTransaction(tx *gorm.DB, id uuid.UUID, workflowID uuid.UUID, nodeID string, configuration any, index *SubscriptionIndex) error {
+	source, eventType := index.columns()
+	result := tx.
+		Model(&IntegrationSubscription{}).
+		Where("id = ? AND workflow_id = ? AND node_id = ?", id, workflowID, nodeID).
+		Updates(map[string]any{
+			"configuration": datatypes.NewJSONType(configuration),
+			"event_source":  source,
+			"event_type":    eventType,
+			"updated_at":    time.Now(),
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
 func DeleteIntegrationSubscriptionsForNodeInTransaction(tx *gorm.DB, workflowID uuid.UUID, nodeID string) error {
 	return tx.
 		Where("workflow_id = ? AND node_id = ?", workflowID, nodeID).
@@ -61,17 +116,30 @@ type NodeSubscription struct {
 	Configuration datatypes.JSONType[any]
 }
 
-func ListIntegrationSubscriptions(tx *gorm.DB, installationID uuid.UUID) ([]NodeSubscription, error) {
+/*
+ * Lists the subscriptions of an integration that can match the filter.
+ * Empty filter fields match everything, and subscriptions
+ * that are not indexed are always returned.
+ */
+func ListIntegrationSubscriptions(tx *gorm.DB, installationID uuid.UUID, filter SubscriptionIndex) ([]NodeSubscription, error) {
 	var subscriptions []NodeSubscription
 
-	err := tx.
+	query := tx.
 		Table("app_installation_subscriptions AS s").
 		Select("wn.workflow_id as workflow_id, wn.node_id as node_id, wn.type as node_type, wn.ref as node_ref, s.configuration as configuration").
 		Joins("INNER JOIN workflow_nodes AS wn ON wn.workflow_id = s.workflow_id AND wn.node_id = s.node_id").
 		Where("s.installation_id = ?", installationID).
-		Where("wn.deleted_at IS NULL").
-		Scan(&subscriptions).
-		Error
+		Where("wn.deleted_at IS NULL")
+
+	if filter.Source != "" {
+		query = query.Where("s.event_source IS NULL OR s.event_source = ?", filter.Source)
+	}
+
+	if filter.Type != "" {
+		query = query.Where("s.event_type IS NULL OR s.event_type = ?", filter.Type)
+	}
+
+	err := query.Scan(&subscriptions).Error
 
 	if err != nil {
 		return nil, err
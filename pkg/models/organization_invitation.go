@@ -21,10 +21,23 @@ type OrganizationInvitation struct {
 	Email          string
 	InvitedBy      uuid.UUID
 	State          string
+	RoleName       *string
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
 
+/*
+ * The organization role the invited user gets when the invitation is accepted.
+ * Invitations created without one give the viewer role.
+ */
+func (i *OrganizationInvitation) Role() string {
+	if i.RoleName == nil || *i.RoleName == "" {
+		return RoleOrgViewer
+	}
+
+	return *i.RoleName
+}
+
 func FindPendingInvitation(email, organizationID string) (*OrganizationInvitation, error) {
 	return FindPendingInvitationInTransaction(database.Conn(), email, organizationID)
 }
@@ -83,6 +96,10 @@ func CreateInvitation(organizationID, invitedBy uuid.UUID, email, state string)
 }
 
 func CreateInvitationInTransaction(tx *gorm.DB, organizationID, invitedBy uuid.UUID, email, state string) (*OrganizationInvitation, error) {
+	return CreateInvitationWithRoleInTransaction(tx, organizationID, invitedBy, email, state, "")
+}
+
+func CreateInvitationWithRoleInTransaction(tx *gorm.DB, organizationID, invitedBy uuid.UUID, email, state, roleName string) (*OrganizationInvitation, error) {
 	normalizedEmail := utils.NormalizeEmail(email)
 	_, err := FindPendingInvitationInTransaction(tx, normalizedEmail, organizationID.String())
 	if err == nil {
@@ -96,6 +113,10 @@ func CreateInvitationInTransaction(tx *gorm.DB, organizationID, invitedBy uuid.U
 		State:          state,
 	}
 
+	if roleName != "" {
+		invitation.RoleName = &roleName
+	}
+
 	err = tx.Create(invitation).Error
 	if err != nil {
 		return nil, err
@@ -68,7 +68,11 @@ func (s *Secret) UpdateName(name string) (*Secret, error) {
 }
 
 func (s *Secret) Delete() error {
-	return database.Conn().Delete(s).Error
+	return s.DeleteInTransaction(database.Conn())
+}
+
+func (s *Secret) DeleteInTransaction(tx *gorm.DB) error {
+	return tx.Delete(s).Error
 }
 
 func FindSecretByName(domainType string, domainID uuid.UUID, name string) (*Secret, error) {
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+/*
+ * Matches configurations with an object referencing the secret at any level,
+ * like the values of secret-key fields. Which of those objects are really
+ * secret references depends on the configuration fields, so the rows
+ * returned by the queries below still need to be checked against them.
+ */
+const secretReferenceJSONPath = `$.** ? (@.secret == $name)`
+
+/*
+ * Canvases of the organization, without their spec,
+ * for going through their nodes one canvas at a time.
+ */
+func ListCanvasSummariesInTransaction(tx *gorm.DB, orgID uuid.UUID) ([]Canvas, error) {
+	var canvases []Canvas
+	err := tx.
+		Select("id", "organization_id", "name").
+		Where("organization_id = ?", orgID).
+		Order("name ASC").
+		Find(&canvases).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return canvases, nil
+}
+
+func FindCanvasNodesReferencingSecretInTransaction(tx *gorm.DB, canvasID uuid.UUID, secretName string) ([]CanvasNode, error) {
+	var nodes []CanvasNode
+	err := tx.
+		Select("workflow_id", "node_id", "name", "type", "ref", "configuration").
+		Where("workflow_id = ?", canvasID).
+		Where("jsonb_path_exists(configuration, ?::jsonpath, jsonb_build_object('name', ?::text))", secretReferenceJSONPath, secretName).
+		Order("node_id ASC").
+		Find(&nodes).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+func FindIntegrationsReferencingSecretInTransaction(tx *gorm.DB, orgID uuid.UUID, secretName string) ([]Integration, error) {
+	var integrations []Integration
+	err := tx.
+		Where("organization_id = ?", orgID).
+		Where("jsonb_path_exists(configuration, ?::jsonpath, jsonb_build_object('name', ?::text))", secretReferenceJSONPath, secretName).
+		Order("installation_name ASC").
+		Find(&integrations).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return integrations, nil
+}
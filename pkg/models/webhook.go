@@ -25,6 +25,7 @@ type Webhook struct {
 	AppInstallationID *uuid.UUID
 	RetryCount        int `gorm:"default:0"`
 	MaxRetries        int `gorm:"default:3"`
+	MaxBodySize       int64
 	CreatedAt         *time.Time
 	UpdatedAt         *time.Time
 	DeletedAt         gorm.DeletedAt `gorm:"index"`
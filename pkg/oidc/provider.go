@@ -12,17 +12,36 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
 )
 
+/*
+ * Retired keys are still published for this long after a rotation,
+ * so tokens signed before the rotation can still be validated.
+ */
+const DefaultRetiredKeyGracePeriod = 24 * time.Hour
+
 type RSAProvider struct {
 	issuer      string
-	privateKey  *rsa.PrivateKey
-	publicKeys  map[string]*rsa.PublicKey
-	publicJWKs  []PublicJWK
-	activeKeyID string
+	gracePeriod time.Duration
+	now         func() time.Time
+
+	mu      sync.RWMutex
+	current signingKey
+	retired []retiredKey
+}
+
+type signingKey struct {
+	id  string
+	key *rsa.PrivateKey
+}
+
+type retiredKey struct {
+	signingKey
+	retiredAt time.Time
 }
 
 type keyEntry struct {
@@ -30,7 +49,12 @@ type keyEntry struct {
 	key  *rsa.PrivateKey
 }
 
-func NewProviderFromKeyDir(issuer, keysPath string) (Provider, error) {
+/*
+ * Keys are sorted by file name, and the last one is used for signing.
+ * The other keys are treated as retired at load time,
+ * and are published until the grace period expires.
+ */
+func NewProviderFromKeyDir(issuer, keysPath string, gracePeriod time.Duration) (*RSAProvider, error) {
 	entries, err := os.ReadDir(keysPath)
 	if err != nil {
 		return nil, err
@@ -70,15 +94,88 @@ func NewProviderFromKeyDir(issuer, keysPath string) (Provider, error) {
 		return keys[i].name < keys[j].name
 	})
 
-	activeKey := keys[len(keys)-1].key
-	return newSignerFromKeys(issuer, activeKey, keys)
+	return newProviderFromKeys(issuer, gracePeriod, keys)
+}
+
+func newProviderFromKeys(issuer string, gracePeriod time.Duration, keys []keyEntry) (*RSAProvider, error) {
+	provider := &RSAProvider{
+		issuer:      issuer,
+		gracePeriod: gracePeriod,
+		now:         time.Now,
+	}
+
+	for _, entry := range keys {
+		if err := provider.Rotate(entry.key); err != nil {
+			return nil, err
+		}
+	}
+
+	return provider, nil
+}
+
+/*
+ * Rotate makes the given key the signing key.
+ * The previous signing key is retired, and is still
+ * published in PublicJWKs() until the grace period expires.
+ */
+func (s *RSAProvider) Rotate(key *rsa.PrivateKey) error {
+	keyID, err := keyIDForPublicKey(&key.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.current.key != nil && s.current.id == keyID {
+		return fmt.Errorf("duplicate OIDC key id: %s", keyID)
+	}
+
+	now := s.now()
+	retired := make([]retiredKey, 0, len(s.retired)+1)
+	for _, r := range s.retired {
+		if r.id == keyID || s.isExpired(r, now) {
+			continue
+		}
+
+		retired = append(retired, r)
+	}
+
+	if s.current.key != nil {
+		retired = append(retired, retiredKey{signingKey: s.current, retiredAt: now})
+	}
+
+	s.current = signingKey{id: keyID, key: key}
+	s.retired = retired
+	return nil
+}
+
+func (s *RSAProvider) isExpired(key retiredKey, now time.Time) bool {
+	return !now.Before(key.retiredAt.Add(s.gracePeriod))
 }
 
 func (s *RSAProvider) PublicJWKs() []PublicJWK {
-	return s.publicJWKs
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := s.now()
+	publicJWKs := make([]PublicJWK, 0, len(s.retired)+1)
+	for _, r := range s.retired {
+		if s.isExpired(r, now) {
+			continue
+		}
+
+		publicJWKs = append(publicJWKs, publicJWKFromKey(r.id, &r.key.PublicKey))
+	}
+
+	return append(publicJWKs, publicJWKFromKey(s.current.id, &s.current.key.PublicKey))
 }
 
 func (s *RSAProvider) Sign(subject string, duration time.Duration, audience string, additionalClaims map[string]any) (string, error) {
+	s.mu.RLock()
+	current := s.current
+	s.mu.RUnlock()
+
 	now := time.Now()
 	claims := jwt.MapClaims{
 		"iss": s.issuer,
@@ -99,8 +196,8 @@ func (s *RSAProvider) Sign(subject string, duration time.Duration, audience stri
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
-	token.Header["kid"] = s.activeKeyID
-	tokenString, err := token.SignedString(s.privateKey)
+	token.Header["kid"] = current.id
+	tokenString, err := token.SignedString(current.key)
 	if err != nil {
 		return "", err
 	}
@@ -108,40 +205,6 @@ func (s *RSAProvider) Sign(subject string, duration time.Duration, audience stri
 	return tokenString, nil
 }
 
-func newSignerFromKeys(issuer string, activeKey *rsa.PrivateKey, keys []keyEntry) (Provider, error) {
-	publicKeys := make(map[string]*rsa.PublicKey, len(keys))
-	publicJWKs := make([]PublicJWK, 0, len(keys))
-
-	for _, entry := range keys {
-		publicKey := &entry.key.PublicKey
-		keyID, err := keyIDForPublicKey(publicKey)
-		if err != nil {
-			return nil, err
-		}
-		if _, exists := publicKeys[keyID]; exists {
-			return nil, fmt.Errorf("duplicate OIDC key id: %s", keyID)
-		}
-		publicKeys[keyID] = publicKey
-		publicJWKs = append(publicJWKs, publicJWKFromKey(keyID, publicKey))
-	}
-
-	activeKeyID, err := keyIDForPublicKey(&activeKey.PublicKey)
-	if err != nil {
-		return nil, err
-	}
-	if _, exists := publicKeys[activeKeyID]; !exists {
-		return nil, errors.New("active OIDC key is not registered")
-	}
-
-	return &RSAProvider{
-		issuer:      issuer,
-		privateKey:  activeKey,
-		publicKeys:  publicKeys,
-		publicJWKs:  publicJWKs,
-		activeKeyID: activeKeyID,
-	}, nil
-}
-
 func parsePrivateKeyPEM(privateKeyPEM []byte) (*rsa.PrivateKey, error) {
 	block, _ := pem.Decode(privateKeyPEM)
 	if block == nil {
@@ -4,11 +4,16 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/pem"
+	"fmt"
+	"math/big"
 	"os"
 	"path/filepath"
 	"testing"
 	"time"
+
+	"github.com/golang-jwt/jwt/v4"
 )
 
 func TestNewProviderFromKeyDirLoadsSymlinkedKey(t *testing.T) {
@@ -31,7 +36,7 @@ func TestNewProviderFromKeyDirLoadsSymlinkedKey(t *testing.T) {
 		t.Fatalf("symlink key: %v", err)
 	}
 
-	provider, err := NewProviderFromKeyDir("test", dir)
+	provider, err := NewProviderFromKeyDir("test", dir, DefaultRetiredKeyGracePeriod)
 	if err != nil {
 		t.Fatalf("NewProviderFromKeyDir: %v", err)
 	}
@@ -58,7 +63,7 @@ func TestNewProviderFromKeyDirSkipsNonRegularFiles(t *testing.T) {
 		t.Fatalf("write key: %v", err)
 	}
 
-	provider, err := NewProviderFromKeyDir("test", dir)
+	provider, err := NewProviderFromKeyDir("test", dir, DefaultRetiredKeyGracePeriod)
 	if err != nil {
 		t.Fatalf("NewProviderFromKeyDir: %v", err)
 	}
@@ -67,6 +72,172 @@ func TestNewProviderFromKeyDirSkipsNonRegularFiles(t *testing.T) {
 	}
 }
 
+func TestNewProviderFromKeyDirSignsWithLastKey(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	oldKey := mustGenerateKey(t)
+	newKey := mustGenerateKey(t)
+	if err := os.WriteFile(filepath.Join(dir, "1769117887.pem"), pemEncodeKey(oldKey), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "1769117999.pem"), pemEncodeKey(newKey), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+
+	provider, err := NewProviderFromKeyDir("test", dir, DefaultRetiredKeyGracePeriod)
+	if err != nil {
+		t.Fatalf("NewProviderFromKeyDir: %v", err)
+	}
+	if len(provider.PublicJWKs()) != 2 {
+		t.Fatalf("expected 2 public JWKs, got %d", len(provider.PublicJWKs()))
+	}
+
+	token, err := provider.Sign("subject", time.Minute, "test", nil)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+
+	newKeyID, err := keyIDForPublicKey(&newKey.PublicKey)
+	if err != nil {
+		t.Fatalf("keyIDForPublicKey: %v", err)
+	}
+	if kid := mustValidate(t, token, provider.PublicJWKs()); kid != newKeyID {
+		t.Fatalf("expected token to be signed with %s, got %s", newKeyID, kid)
+	}
+}
+
+func TestRotateKeepsRetiredKeysDuringGracePeriod(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	provider, err := newProviderFromKeys("test", time.Hour, []keyEntry{{name: "first", key: mustGenerateKey(t)}})
+	if err != nil {
+		t.Fatalf("newProviderFromKeys: %v", err)
+	}
+	provider.now = func() time.Time { return now }
+
+	tokenBeforeRotation, err := provider.Sign("subject", time.Minute, "test", nil)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+
+	if err := provider.Rotate(mustGenerateKey(t)); err != nil {
+		t.Fatalf("Rotate: %v", err)
+	}
+
+	jwks := provider.PublicJWKs()
+	if len(jwks) != 2 {
+		t.Fatalf("expected 2 public JWKs, got %d", len(jwks))
+	}
+	if jwks[0].Kid == jwks[1].Kid {
+		t.Fatalf("expected distinct key ids, got %s", jwks[0].Kid)
+	}
+
+	oldKeyID := mustValidate(t, tokenBeforeRotation, jwks)
+
+	tokenAfterRotation, err := provider.Sign("subject", time.Minute, "test", nil)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if kid := mustValidate(t, tokenAfterRotation, jwks); kid == oldKeyID {
+		t.Fatalf("expected new tokens to be signed with the current key")
+	}
+}
+
+func TestRotateDropsExpiredKeys(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	provider, err := newProviderFromKeys("test", time.Hour, []keyEntry{{name: "first", key: mustGenerateKey(t)}})
+	if err != nil {
+		t.Fatalf("newProviderFromKeys: %v", err)
+	}
+	provider.now = func() time.Time { return now }
+
+	tokenBeforeRotation, err := provider.Sign("subject", time.Minute, "test", nil)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+
+	if err := provider.Rotate(mustGenerateKey(t)); err != nil {
+		t.Fatalf("Rotate: %v", err)
+	}
+
+	now = now.Add(time.Hour)
+	jwks := provider.PublicJWKs()
+	if len(jwks) != 1 {
+		t.Fatalf("expected 1 public JWK, got %d", len(jwks))
+	}
+	if _, err := validate(tokenBeforeRotation, jwks); err == nil {
+		t.Fatalf("expected token signed with expired key to be rejected")
+	}
+
+	//
+	// Expired keys are also removed on the next rotation.
+	//
+	if err := provider.Rotate(mustGenerateKey(t)); err != nil {
+		t.Fatalf("Rotate: %v", err)
+	}
+	if len(provider.retired) != 1 {
+		t.Fatalf("expected 1 retired key, got %d", len(provider.retired))
+	}
+}
+
+func TestRotateRejectsCurrentKey(t *testing.T) {
+	t.Parallel()
+
+	key := mustGenerateKey(t)
+	provider, err := newProviderFromKeys("test", time.Hour, []keyEntry{{name: "first", key: key}})
+	if err != nil {
+		t.Fatalf("newProviderFromKeys: %v", err)
+	}
+
+	if err := provider.Rotate(key); err == nil {
+		t.Fatalf("expected error when rotating to the current key")
+	}
+}
+
+func mustValidate(t *testing.T, token string, jwks []PublicJWK) string {
+	t.Helper()
+
+	kid, err := validate(token, jwks)
+	if err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	return kid
+}
+
+func validate(tokenString string, jwks []PublicJWK) (string, error) {
+	var kid string
+	_, err := jwt.Parse(tokenString, func(token *jwt.Token) (any, error) {
+		kid, _ = token.Header["kid"].(string)
+		for _, jwk := range jwks {
+			if jwk.Kid != kid {
+				continue
+			}
+
+			n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+			if err != nil {
+				return nil, err
+			}
+			e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+			if err != nil {
+				return nil, err
+			}
+
+			return &rsa.PublicKey{
+				N: new(big.Int).SetBytes(n),
+				E: int(new(big.Int).SetBytes(e).Int64()),
+			}, nil
+		}
+
+		return nil, fmt.Errorf("key %s not found", kid)
+	})
+
+	return kid, err
+}
+
 func mustGenerateKey(t *testing.T) *rsa.PrivateKey {
 	t.Helper()
 
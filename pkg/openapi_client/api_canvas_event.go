@@ -25,11 +25,17 @@ import (
 type CanvasEventAPIService service
 
 type ApiCanvasesListCanvasEventsRequest struct {
-	ctx        context.Context
-	ApiService *CanvasEventAPIService
-	canvasId   string
-	limit      *int64
-	before     *time.Time
+	ctx         context.Context
+	ApiService  *CanvasEventAPIService
+	canvasId    string
+	limit       *int64
+	before      *time.Time
+	nodeId      *string
+	channel     *string
+	after       *time.Time
+	payloadType *string
+	cursor      *string
+	countOnly   *bool
 }
 
 func (r ApiCanvasesListCanvasEventsRequest) Limit(limit int64) ApiCanvasesListCanvasEventsRequest {
@@ -42,6 +48,36 @@ func (r ApiCanvasesListCanvasEventsRequest) Before(before time.Time) ApiCanvases
 	return r
 }
 
+func (r ApiCanvasesListCanvasEventsRequest) NodeId(nodeId string) ApiCanvasesListCanvasEventsRequest {
+	r.nodeId = &nodeId
+	return r
+}
+
+func (r ApiCanvasesListCanvasEventsRequest) Channel(channel string) ApiCanvasesListCanvasEventsRequest {
+	r.channel = &channel
+	return r
+}
+
+func (r ApiCanvasesListCanvasEventsRequest) After(after time.Time) ApiCanvasesListCanvasEventsRequest {
+	r.after = &after
+	return r
+}
+
+func (r ApiCanvasesListCanvasEventsRequest) PayloadType(payloadType string) ApiCanvasesListCanvasEventsRequest {
+	r.payloadType = &payloadType
+	return r
+}
+
+func (r ApiCanvasesListCanvasEventsRequest) Cursor(cursor string) ApiCanvasesListCanvasEventsRequest {
+	r.cursor = &cursor
+	return r
+}
+
+func (r ApiCanvasesListCanvasEventsRequest) CountOnly(countOnly bool) ApiCanvasesListCanvasEventsRequest {
+	r.countOnly = &countOnly
+	return r
+}
+
 func (r ApiCanvasesListCanvasEventsRequest) Execute() (*CanvasesListCanvasEventsResponse, *http.Response, error) {
 	return r.ApiService.CanvasesListCanvasEventsExecute(r)
 }
@@ -49,7 +85,7 @@ func (r ApiCanvasesListCanvasEventsRequest) Execute() (*CanvasesListCanvasEvents
 /*
 CanvasesListCanvasEvents List canvas events
 
-Returns a list of root events that triggered executions in a canvas
+Returns a list of root events that triggered executions in a canvas, or only their counts per node
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param canvasId
@@ -92,6 +128,24 @@ func (a *CanvasEventAPIService) CanvasesListCanvasEventsExecute(r ApiCanvasesLis
 	if r.before != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "before", r.before, "", "")
 	}
+	if r.nodeId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "nodeId", r.nodeId, "", "")
+	}
+	if r.channel != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "channel", r.channel, "", "")
+	}
+	if r.after != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "after", r.after, "", "")
+	}
+	if r.payloadType != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "payloadType", r.payloadType, "", "")
+	}
+	if r.cursor != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "cursor", r.cursor, "", "")
+	}
+	if r.countOnly != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "countOnly", r.countOnly, "", "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -272,6 +272,131 @@ func (a *CanvasNodeAPIService) CanvasesEmitNodeEventExecute(r ApiCanvasesEmitNod
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiCanvasesGetNodeStatusRequest struct {
+	ctx           context.Context
+	ApiService    *CanvasNodeAPIService
+	canvasId      string
+	nodeId        string
+	windowSeconds *int64
+}
+
+func (r ApiCanvasesGetNodeStatusRequest) WindowSeconds(windowSeconds int64) ApiCanvasesGetNodeStatusRequest {
+	r.windowSeconds = &windowSeconds
+	return r
+}
+
+func (r ApiCanvasesGetNodeStatusRequest) Execute() (*CanvasesGetNodeStatusResponse, *http.Response, error) {
+	return r.ApiService.CanvasesGetNodeStatusExecute(r)
+}
+
+/*
+CanvasesGetNodeStatus Get node status
+
+Returns a summary of the recent executions, queue and pause state of a canvas node
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param nodeId
+	@return ApiCanvasesGetNodeStatusRequest
+*/
+func (a *CanvasNodeAPIService) CanvasesGetNodeStatus(ctx context.Context, canvasId string, nodeId string) ApiCanvasesGetNodeStatusRequest {
+	return ApiCanvasesGetNodeStatusRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+		nodeId:     nodeId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesGetNodeStatusResponse
+func (a *CanvasNodeAPIService) CanvasesGetNodeStatusExecute(r ApiCanvasesGetNodeStatusRequest) (*CanvasesGetNodeStatusResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesGetNodeStatusResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeAPIService.CanvasesGetNodeStatus")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/nodes/{nodeId}/status"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"nodeId"+"}", url.PathEscape(parameterValueToString(r.nodeId, "nodeId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.windowSeconds != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "windowSeconds", r.windowSeconds, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCanvasesInvokeNodeTriggerActionRequest struct {
 	ctx        context.Context
 	ApiService *CanvasNodeAPIService
@@ -839,6 +964,144 @@ func (a *CanvasNodeAPIService) CanvasesListNodeQueueItemsExecute(r ApiCanvasesLi
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiCanvasesListNodeStatusesRequest struct {
+	ctx           context.Context
+	ApiService    *CanvasNodeAPIService
+	canvasId      string
+	nodeIds       *[]string
+	windowSeconds *int64
+}
+
+func (r ApiCanvasesListNodeStatusesRequest) NodeIds(nodeIds []string) ApiCanvasesListNodeStatusesRequest {
+	r.nodeIds = &nodeIds
+	return r
+}
+
+func (r ApiCanvasesListNodeStatusesRequest) WindowSeconds(windowSeconds int64) ApiCanvasesListNodeStatusesRequest {
+	r.windowSeconds = &windowSeconds
+	return r
+}
+
+func (r ApiCanvasesListNodeStatusesRequest) Execute() (*CanvasesListNodeStatusesResponse, *http.Response, error) {
+	return r.ApiService.CanvasesListNodeStatusesExecute(r)
+}
+
+/*
+CanvasesListNodeStatuses List node statuses
+
+Returns the status summary of multiple canvas nodes, or of all nodes in the canvas if no node IDs are given
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesListNodeStatusesRequest
+*/
+func (a *CanvasNodeAPIService) CanvasesListNodeStatuses(ctx context.Context, canvasId string) ApiCanvasesListNodeStatusesRequest {
+	return ApiCanvasesListNodeStatusesRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesListNodeStatusesResponse
+func (a *CanvasNodeAPIService) CanvasesListNodeStatusesExecute(r ApiCanvasesListNodeStatusesRequest) (*CanvasesListNodeStatusesResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesListNodeStatusesResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeAPIService.CanvasesListNodeStatuses")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/node-statuses"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.nodeIds != nil {
+		t := *r.nodeIds
+		if reflect.TypeOf(t).Kind() == reflect.Slice {
+			s := reflect.ValueOf(t)
+			for i := 0; i < s.Len(); i++ {
+				parameterAddToHeaderOrQuery(localVarQueryParams, "nodeIds", s.Index(i).Interface(), "form", "multi")
+			}
+		} else {
+			parameterAddToHeaderOrQuery(localVarQueryParams, "nodeIds", t, "form", "multi")
+		}
+	}
+	if r.windowSeconds != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "windowSeconds", r.windowSeconds, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCanvasesUpdateNodePauseRequest struct {
 	ctx        context.Context
 	ApiService *CanvasNodeAPIService
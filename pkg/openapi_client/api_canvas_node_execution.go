@@ -408,6 +408,388 @@ func (a *CanvasNodeExecutionAPIService) CanvasesListChildExecutionsExecute(r Api
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiCanvasesListDeadLetteredExecutionsRequest struct {
+	ctx             context.Context
+	ApiService      *CanvasNodeExecutionAPIService
+	canvasId        string
+	nodeId          *string
+	includeRequeued *bool
+	limit           *int64
+}
+
+func (r ApiCanvasesListDeadLetteredExecutionsRequest) NodeId(nodeId string) ApiCanvasesListDeadLetteredExecutionsRequest {
+	r.nodeId = &nodeId
+	return r
+}
+
+func (r ApiCanvasesListDeadLetteredExecutionsRequest) IncludeRequeued(includeRequeued bool) ApiCanvasesListDeadLetteredExecutionsRequest {
+	r.includeRequeued = &includeRequeued
+	return r
+}
+
+func (r ApiCanvasesListDeadLetteredExecutionsRequest) Limit(limit int64) ApiCanvasesListDeadLetteredExecutionsRequest {
+	r.limit = &limit
+	return r
+}
+
+func (r ApiCanvasesListDeadLetteredExecutionsRequest) Execute() (*CanvasesListDeadLetteredExecutionsResponse, *http.Response, error) {
+	return r.ApiService.CanvasesListDeadLetteredExecutionsExecute(r)
+}
+
+/*
+CanvasesListDeadLetteredExecutions List dead-lettered executions
+
+Returns the canvas node executions that failed permanently, with the context they failed in
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesListDeadLetteredExecutionsRequest
+*/
+func (a *CanvasNodeExecutionAPIService) CanvasesListDeadLetteredExecutions(ctx context.Context, canvasId string) ApiCanvasesListDeadLetteredExecutionsRequest {
+	return ApiCanvasesListDeadLetteredExecutionsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesListDeadLetteredExecutionsResponse
+func (a *CanvasNodeExecutionAPIService) CanvasesListDeadLetteredExecutionsExecute(r ApiCanvasesListDeadLetteredExecutionsRequest) (*CanvasesListDeadLetteredExecutionsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesListDeadLetteredExecutionsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeExecutionAPIService.CanvasesListDeadLetteredExecutions")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/dead-letters"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.nodeId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "nodeId", r.nodeId, "", "")
+	}
+	if r.includeRequeued != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "includeRequeued", r.includeRequeued, "", "")
+	}
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesListExecutionLogsRequest struct {
+	ctx         context.Context
+	ApiService  *CanvasNodeExecutionAPIService
+	canvasId    string
+	executionId string
+}
+
+func (r ApiCanvasesListExecutionLogsRequest) Execute() (*CanvasesListExecutionLogsResponse, *http.Response, error) {
+	return r.ApiService.CanvasesListExecutionLogsExecute(r)
+}
+
+/*
+CanvasesListExecutionLogs List execution logs
+
+Returns the most recent log entries written by a canvas node execution
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param executionId
+	@return ApiCanvasesListExecutionLogsRequest
+*/
+func (a *CanvasNodeExecutionAPIService) CanvasesListExecutionLogs(ctx context.Context, canvasId string, executionId string) ApiCanvasesListExecutionLogsRequest {
+	return ApiCanvasesListExecutionLogsRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		canvasId:    canvasId,
+		executionId: executionId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesListExecutionLogsResponse
+func (a *CanvasNodeExecutionAPIService) CanvasesListExecutionLogsExecute(r ApiCanvasesListExecutionLogsRequest) (*CanvasesListExecutionLogsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesListExecutionLogsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeExecutionAPIService.CanvasesListExecutionLogs")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/executions/{executionId}/logs"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"executionId"+"}", url.PathEscape(parameterValueToString(r.executionId, "executionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesRequeueDeadLetteredExecutionRequest struct {
+	ctx          context.Context
+	ApiService   *CanvasNodeExecutionAPIService
+	canvasId     string
+	deadLetterId string
+	body         *map[string]interface{}
+}
+
+func (r ApiCanvasesRequeueDeadLetteredExecutionRequest) Body(body map[string]interface{}) ApiCanvasesRequeueDeadLetteredExecutionRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesRequeueDeadLetteredExecutionRequest) Execute() (*CanvasesRequeueDeadLetteredExecutionResponse, *http.Response, error) {
+	return r.ApiService.CanvasesRequeueDeadLetteredExecutionExecute(r)
+}
+
+/*
+CanvasesRequeueDeadLetteredExecution Re-enqueue dead-lettered execution
+
+Creates a new pending execution with the input and configuration of a dead-lettered execution
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param deadLetterId
+	@return ApiCanvasesRequeueDeadLetteredExecutionRequest
+*/
+func (a *CanvasNodeExecutionAPIService) CanvasesRequeueDeadLetteredExecution(ctx context.Context, canvasId string, deadLetterId string) ApiCanvasesRequeueDeadLetteredExecutionRequest {
+	return ApiCanvasesRequeueDeadLetteredExecutionRequest{
+		ApiService:   a,
+		ctx:          ctx,
+		canvasId:     canvasId,
+		deadLetterId: deadLetterId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesRequeueDeadLetteredExecutionResponse
+func (a *CanvasNodeExecutionAPIService) CanvasesRequeueDeadLetteredExecutionExecute(r ApiCanvasesRequeueDeadLetteredExecutionRequest) (*CanvasesRequeueDeadLetteredExecutionResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesRequeueDeadLetteredExecutionResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeExecutionAPIService.CanvasesRequeueDeadLetteredExecution")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/dead-letters/{deadLetterId}/requeue"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"deadLetterId"+"}", url.PathEscape(parameterValueToString(r.deadLetterId, "deadLetterId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCanvasesResolveExecutionErrorsRequest struct {
 	ctx        context.Context
 	ApiService *CanvasNodeExecutionAPIService
@@ -181,6 +181,129 @@ func (a *OrganizationAPIService) OrganizationsAcceptInviteLinkExecute(r ApiOrgan
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiOrganizationsBulkCreateInvitationsRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+	body       *OrganizationsBulkCreateInvitationsBody
+}
+
+func (r ApiOrganizationsBulkCreateInvitationsRequest) Body(body OrganizationsBulkCreateInvitationsBody) ApiOrganizationsBulkCreateInvitationsRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiOrganizationsBulkCreateInvitationsRequest) Execute() (*OrganizationsBulkCreateInvitationsResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsBulkCreateInvitationsExecute(r)
+}
+
+/*
+OrganizationsBulkCreateInvitations Create organization invitations in bulk
+
+Invites multiple users to join an organization by email, each with an organization role
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsBulkCreateInvitationsRequest
+*/
+func (a *OrganizationAPIService) OrganizationsBulkCreateInvitations(ctx context.Context, id string) ApiOrganizationsBulkCreateInvitationsRequest {
+	return ApiOrganizationsBulkCreateInvitationsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsBulkCreateInvitationsResponse
+func (a *OrganizationAPIService) OrganizationsBulkCreateInvitationsExecute(r ApiOrganizationsBulkCreateInvitationsRequest) (*OrganizationsBulkCreateInvitationsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsBulkCreateInvitationsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsBulkCreateInvitations")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/invitations/bulk"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiOrganizationsCreateIntegrationRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
@@ -432,6 +555,12 @@ type ApiOrganizationsDeleteIntegrationRequest struct {
 	ApiService    *OrganizationAPIService
 	id            string
 	integrationId string
+	force         *bool
+}
+
+func (r ApiOrganizationsDeleteIntegrationRequest) Force(force bool) ApiOrganizationsDeleteIntegrationRequest {
+	r.force = &force
+	return r
 }
 
 func (r ApiOrganizationsDeleteIntegrationRequest) Execute() (map[string]interface{}, *http.Response, error) {
@@ -441,7 +570,7 @@ func (r ApiOrganizationsDeleteIntegrationRequest) Execute() (map[string]interfac
 /*
 OrganizationsDeleteIntegration Delete organization integration
 
-Deletes an integration from an organization
+Deletes an integration from an organization. Fails if canvas nodes still use the integration, unless force is set, in which case the nodes are detached from it and paused
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
@@ -481,6 +610,9 @@ func (a *OrganizationAPIService) OrganizationsDeleteIntegrationExecute(r ApiOrga
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.force != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "force", r.force, "", "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -995,6 +1127,172 @@ func (a *OrganizationAPIService) OrganizationsGetInviteLinkExecute(r ApiOrganiza
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiOrganizationsListAuditEventsRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+	userId     *string
+	resource   *string
+	after      *time.Time
+	before     *time.Time
+	limit      *int64
+	result     *string
+}
+
+func (r ApiOrganizationsListAuditEventsRequest) UserId(userId string) ApiOrganizationsListAuditEventsRequest {
+	r.userId = &userId
+	return r
+}
+
+func (r ApiOrganizationsListAuditEventsRequest) Resource(resource string) ApiOrganizationsListAuditEventsRequest {
+	r.resource = &resource
+	return r
+}
+
+func (r ApiOrganizationsListAuditEventsRequest) After(after time.Time) ApiOrganizationsListAuditEventsRequest {
+	r.after = &after
+	return r
+}
+
+func (r ApiOrganizationsListAuditEventsRequest) Before(before time.Time) ApiOrganizationsListAuditEventsRequest {
+	r.before = &before
+	return r
+}
+
+func (r ApiOrganizationsListAuditEventsRequest) Limit(limit int64) ApiOrganizationsListAuditEventsRequest {
+	r.limit = &limit
+	return r
+}
+
+func (r ApiOrganizationsListAuditEventsRequest) Result(result string) ApiOrganizationsListAuditEventsRequest {
+	r.result = &result
+	return r
+}
+
+func (r ApiOrganizationsListAuditEventsRequest) Execute() (*OrganizationsListAuditEventsResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsListAuditEventsExecute(r)
+}
+
+/*
+OrganizationsListAuditEvents List audit events
+
+Returns the changes made in an organization, and the requests denied by the authorization check, most recent first
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsListAuditEventsRequest
+*/
+func (a *OrganizationAPIService) OrganizationsListAuditEvents(ctx context.Context, id string) ApiOrganizationsListAuditEventsRequest {
+	return ApiOrganizationsListAuditEventsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsListAuditEventsResponse
+func (a *OrganizationAPIService) OrganizationsListAuditEventsExecute(r ApiOrganizationsListAuditEventsRequest) (*OrganizationsListAuditEventsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsListAuditEventsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListAuditEvents")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/audit-events"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.userId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "userId", r.userId, "", "")
+	}
+	if r.resource != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "resource", r.resource, "", "")
+	}
+	if r.after != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "after", r.after, "", "")
+	}
+	if r.before != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "before", r.before, "", "")
+	}
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "", "")
+	}
+	if r.result != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "result", r.result, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiOrganizationsListIntegrationResourcesRequest struct {
 	ctx           context.Context
 	ApiService    *OrganizationAPIService
@@ -148,6 +148,7 @@ type ApiSecretsDeleteSecretRequest struct {
 	idOrName   string
 	domainType *string
 	domainId   *string
+	force      *bool
 }
 
 func (r ApiSecretsDeleteSecretRequest) DomainType(domainType string) ApiSecretsDeleteSecretRequest {
@@ -160,6 +161,11 @@ func (r ApiSecretsDeleteSecretRequest) DomainId(domainId string) ApiSecretsDelet
 	return r
 }
 
+func (r ApiSecretsDeleteSecretRequest) Force(force bool) ApiSecretsDeleteSecretRequest {
+	r.force = &force
+	return r
+}
+
 func (r ApiSecretsDeleteSecretRequest) Execute() (map[string]interface{}, *http.Response, error) {
 	return r.ApiService.SecretsDeleteSecretExecute(r)
 }
@@ -167,7 +173,7 @@ func (r ApiSecretsDeleteSecretRequest) Execute() (map[string]interface{}, *http.
 /*
 SecretsDeleteSecret Deletes a secret
 
-Deletes the specified secret
+Deletes the specified secret. Fails if canvas nodes or integrations still reference the secret, unless force is set
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param idOrName
@@ -213,6 +219,9 @@ func (a *SecretAPIService) SecretsDeleteSecretExecute(r ApiSecretsDeleteSecretRe
 	if r.domainId != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "domainId", r.domainId, "", "")
 	}
+	if r.force != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "force", r.force, "", "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -545,6 +554,139 @@ func (a *SecretAPIService) SecretsDescribeSecretExecute(r ApiSecretsDescribeSecr
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiSecretsListSecretUsagesRequest struct {
+	ctx        context.Context
+	ApiService *SecretAPIService
+	idOrName   string
+	domainType *string
+	domainId   *string
+}
+
+func (r ApiSecretsListSecretUsagesRequest) DomainType(domainType string) ApiSecretsListSecretUsagesRequest {
+	r.domainType = &domainType
+	return r
+}
+
+func (r ApiSecretsListSecretUsagesRequest) DomainId(domainId string) ApiSecretsListSecretUsagesRequest {
+	r.domainId = &domainId
+	return r
+}
+
+func (r ApiSecretsListSecretUsagesRequest) Execute() (*SecretsListSecretUsagesResponse, *http.Response, error) {
+	return r.ApiService.SecretsListSecretUsagesExecute(r)
+}
+
+/*
+SecretsListSecretUsages List secret usages
+
+Returns the canvas nodes and integrations whose configuration references the secret
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param idOrName
+	@return ApiSecretsListSecretUsagesRequest
+*/
+func (a *SecretAPIService) SecretsListSecretUsages(ctx context.Context, idOrName string) ApiSecretsListSecretUsagesRequest {
+	return ApiSecretsListSecretUsagesRequest{
+		ApiService: a,
+		ctx:        ctx,
+		idOrName:   idOrName,
+	}
+}
+
+// Execute executes the request
+//
+//	@return SecretsListSecretUsagesResponse
+func (a *SecretAPIService) SecretsListSecretUsagesExecute(r ApiSecretsListSecretUsagesRequest) (*SecretsListSecretUsagesResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *SecretsListSecretUsagesResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "SecretAPIService.SecretsListSecretUsages")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/secrets/{idOrName}/usages"
+	localVarPath = strings.Replace(localVarPath, "{"+"idOrName"+"}", url.PathEscape(parameterValueToString(r.idOrName, "idOrName")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.domainType != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "domainType", r.domainType, "", "")
+	} else {
+		var defaultValue string = "DOMAIN_TYPE_UNSPECIFIED"
+		r.domainType = &defaultValue
+	}
+	if r.domainId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "domainId", r.domainId, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiSecretsListSecretsRequest struct {
 	ctx        context.Context
 	ApiService *SecretAPIService
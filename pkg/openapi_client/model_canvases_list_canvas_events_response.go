@@ -25,6 +25,8 @@ type CanvasesListCanvasEventsResponse struct {
 	TotalCount    *int64                              `json:"totalCount,omitempty"`
 	HasNextPage   *bool                               `json:"hasNextPage,omitempty"`
 	LastTimestamp *time.Time                          `json:"lastTimestamp,omitempty"`
+	NextCursor    *string                             `json:"nextCursor,omitempty"`
+	NodeCounts    []CanvasesCanvasEventNodeCount      `json:"nodeCounts,omitempty"`
 }
 
 // NewCanvasesListCanvasEventsResponse instantiates a new CanvasesListCanvasEventsResponse object
@@ -172,6 +174,70 @@ func (o *CanvasesListCanvasEventsResponse) SetLastTimestamp(v time.Time) {
 	o.LastTimestamp = &v
 }
 
+// GetNextCursor returns the NextCursor field value if set, zero value otherwise.
+func (o *CanvasesListCanvasEventsResponse) GetNextCursor() string {
+	if o == nil || IsNil(o.NextCursor) {
+		var ret string
+		return ret
+	}
+	return *o.NextCursor
+}
+
+// GetNextCursorOk returns a tuple with the NextCursor field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesListCanvasEventsResponse) GetNextCursorOk() (*string, bool) {
+	if o == nil || IsNil(o.NextCursor) {
+		return nil, false
+	}
+	return o.NextCursor, true
+}
+
+// HasNextCursor returns a boolean if a field has been set.
+func (o *CanvasesListCanvasEventsResponse) HasNextCursor() bool {
+	if o != nil && !IsNil(o.NextCursor) {
+		return true
+	}
+
+	return false
+}
+
+// SetNextCursor gets a reference to the given string and assigns it to the NextCursor field.
+func (o *CanvasesListCanvasEventsResponse) SetNextCursor(v string) {
+	o.NextCursor = &v
+}
+
+// GetNodeCounts returns the NodeCounts field value if set, zero value otherwise.
+func (o *CanvasesListCanvasEventsResponse) GetNodeCounts() []CanvasesCanvasEventNodeCount {
+	if o == nil || IsNil(o.NodeCounts) {
+		var ret []CanvasesCanvasEventNodeCount
+		return ret
+	}
+	return o.NodeCounts
+}
+
+// GetNodeCountsOk returns a tuple with the NodeCounts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesListCanvasEventsResponse) GetNodeCountsOk() ([]CanvasesCanvasEventNodeCount, bool) {
+	if o == nil || IsNil(o.NodeCounts) {
+		return nil, false
+	}
+	return o.NodeCounts, true
+}
+
+// HasNodeCounts returns a boolean if a field has been set.
+func (o *CanvasesListCanvasEventsResponse) HasNodeCounts() bool {
+	if o != nil && !IsNil(o.NodeCounts) {
+		return true
+	}
+
+	return false
+}
+
+// SetNodeCounts gets a reference to the given []CanvasesCanvasEventNodeCount and assigns it to the NodeCounts field.
+func (o *CanvasesListCanvasEventsResponse) SetNodeCounts(v []CanvasesCanvasEventNodeCount) {
+	o.NodeCounts = v
+}
+
 func (o CanvasesListCanvasEventsResponse) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -194,6 +260,12 @@ func (o CanvasesListCanvasEventsResponse) ToMap() (map[string]interface{}, error
 	if !IsNil(o.LastTimestamp) {
 		toSerialize["lastTimestamp"] = o.LastTimestamp
 	}
+	if !IsNil(o.NextCursor) {
+		toSerialize["nextCursor"] = o.NextCursor
+	}
+	if !IsNil(o.NodeCounts) {
+		toSerialize["nodeCounts"] = o.NodeCounts
+	}
 	return toSerialize, nil
 }
 
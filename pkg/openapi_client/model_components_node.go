@@ -35,6 +35,8 @@ type ComponentsNode struct {
 	ErrorMessage   *string                   `json:"errorMessage,omitempty"`
 	WarningMessage *string                   `json:"warningMessage,omitempty"`
 	Paused         *bool                     `json:"paused,omitempty"`
+	// Parameters of the user accessible actions of trigger nodes, resolved with the node configuration. Only included when describing a canvas.
+	ActionParameters []NodeActionParameters `json:"actionParameters,omitempty"`
 }
 
 // NewComponentsNode instantiates a new ComponentsNode object
@@ -538,6 +540,38 @@ func (o *ComponentsNode) SetPaused(v bool) {
 	o.Paused = &v
 }
 
+// GetActionParameters returns the ActionParameters field value if set, zero value otherwise.
+func (o *ComponentsNode) GetActionParameters() []NodeActionParameters {
+	if o == nil || IsNil(o.ActionParameters) {
+		var ret []NodeActionParameters
+		return ret
+	}
+	return o.ActionParameters
+}
+
+// GetActionParametersOk returns a tuple with the ActionParameters field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ComponentsNode) GetActionParametersOk() ([]NodeActionParameters, bool) {
+	if o == nil || IsNil(o.ActionParameters) {
+		return nil, false
+	}
+	return o.ActionParameters, true
+}
+
+// HasActionParameters returns a boolean if a field has been set.
+func (o *ComponentsNode) HasActionParameters() bool {
+	if o != nil && !IsNil(o.ActionParameters) {
+		return true
+	}
+
+	return false
+}
+
+// SetActionParameters gets a reference to the given []NodeActionParameters and assigns it to the ActionParameters field.
+func (o *ComponentsNode) SetActionParameters(v []NodeActionParameters) {
+	o.ActionParameters = v
+}
+
 func (o ComponentsNode) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -593,6 +627,9 @@ func (o ComponentsNode) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Paused) {
 		toSerialize["paused"] = o.Paused
 	}
+	if !IsNil(o.ActionParameters) {
+		toSerialize["actionParameters"] = o.ActionParameters
+	}
 	return toSerialize, nil
 }
 
@@ -13,6 +13,7 @@ package openapi_client
 
 import (
 	"encoding/json"
+	"time"
 )
 
 // checks if the OrganizationsIntegrationStatus type satisfies the MappedNullable interface at compile time
@@ -25,6 +26,9 @@ type OrganizationsIntegrationStatus struct {
 	Metadata         map[string]interface{}      `json:"metadata,omitempty"`
 	BrowserAction    *OrganizationsBrowserAction `json:"browserAction,omitempty"`
 	UsedIn           []IntegrationNodeRef        `json:"usedIn,omitempty"`
+	SyncFailureCount *int64                      `json:"syncFailureCount,omitempty"`
+	LastSyncError    *string                     `json:"lastSyncError,omitempty"`
+	LastSyncFailedAt *time.Time                  `json:"lastSyncFailedAt,omitempty"`
 }
 
 // NewOrganizationsIntegrationStatus instantiates a new OrganizationsIntegrationStatus object
@@ -204,6 +208,102 @@ func (o *OrganizationsIntegrationStatus) SetUsedIn(v []IntegrationNodeRef) {
 	o.UsedIn = v
 }
 
+// GetSyncFailureCount returns the SyncFailureCount field value if set, zero value otherwise.
+func (o *OrganizationsIntegrationStatus) GetSyncFailureCount() int64 {
+	if o == nil || IsNil(o.SyncFailureCount) {
+		var ret int64
+		return ret
+	}
+	return *o.SyncFailureCount
+}
+
+// GetSyncFailureCountOk returns a tuple with the SyncFailureCount field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OrganizationsIntegrationStatus) GetSyncFailureCountOk() (*int64, bool) {
+	if o == nil || IsNil(o.SyncFailureCount) {
+		return nil, false
+	}
+	return o.SyncFailureCount, true
+}
+
+// HasSyncFailureCount returns a boolean if a field has been set.
+func (o *OrganizationsIntegrationStatus) HasSyncFailureCount() bool {
+	if o != nil && !IsNil(o.SyncFailureCount) {
+		return true
+	}
+
+	return false
+}
+
+// SetSyncFailureCount gets a reference to the given int64 and assigns it to the SyncFailureCount field.
+func (o *OrganizationsIntegrationStatus) SetSyncFailureCount(v int64) {
+	o.SyncFailureCount = &v
+}
+
+// GetLastSyncError returns the LastSyncError field value if set, zero value otherwise.
+func (o *OrganizationsIntegrationStatus) GetLastSyncError() string {
+	if o == nil || IsNil(o.LastSyncError) {
+		var ret string
+		return ret
+	}
+	return *o.LastSyncError
+}
+
+// GetLastSyncErrorOk returns a tuple with the LastSyncError field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OrganizationsIntegrationStatus) GetLastSyncErrorOk() (*string, bool) {
+	if o == nil || IsNil(o.LastSyncError) {
+		return nil, false
+	}
+	return o.LastSyncError, true
+}
+
+// HasLastSyncError returns a boolean if a field has been set.
+func (o *OrganizationsIntegrationStatus) HasLastSyncError() bool {
+	if o != nil && !IsNil(o.LastSyncError) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastSyncError gets a reference to the given string and assigns it to the LastSyncError field.
+func (o *OrganizationsIntegrationStatus) SetLastSyncError(v string) {
+	o.LastSyncError = &v
+}
+
+// GetLastSyncFailedAt returns the LastSyncFailedAt field value if set, zero value otherwise.
+func (o *OrganizationsIntegrationStatus) GetLastSyncFailedAt() time.Time {
+	if o == nil || IsNil(o.LastSyncFailedAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.LastSyncFailedAt
+}
+
+// GetLastSyncFailedAtOk returns a tuple with the LastSyncFailedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OrganizationsIntegrationStatus) GetLastSyncFailedAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.LastSyncFailedAt) {
+		return nil, false
+	}
+	return o.LastSyncFailedAt, true
+}
+
+// HasLastSyncFailedAt returns a boolean if a field has been set.
+func (o *OrganizationsIntegrationStatus) HasLastSyncFailedAt() bool {
+	if o != nil && !IsNil(o.LastSyncFailedAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastSyncFailedAt gets a reference to the given time.Time and assigns it to the LastSyncFailedAt field.
+func (o *OrganizationsIntegrationStatus) SetLastSyncFailedAt(v time.Time) {
+	o.LastSyncFailedAt = &v
+}
+
 func (o OrganizationsIntegrationStatus) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -229,6 +329,15 @@ func (o OrganizationsIntegrationStatus) ToMap() (map[string]interface{}, error)
 	if !IsNil(o.UsedIn) {
 		toSerialize["usedIn"] = o.UsedIn
 	}
+	if !IsNil(o.SyncFailureCount) {
+		toSerialize["syncFailureCount"] = o.SyncFailureCount
+	}
+	if !IsNil(o.LastSyncError) {
+		toSerialize["lastSyncError"] = o.LastSyncError
+	}
+	if !IsNil(o.LastSyncFailedAt) {
+		toSerialize["lastSyncFailedAt"] = o.LastSyncFailedAt
+	}
 	return toSerialize, nil
 }
 
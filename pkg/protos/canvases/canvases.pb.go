@@ -75,7 +75,7 @@ func (x CanvasNodeExecution_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_State.Descriptor instead.
 func (CanvasNodeExecution_State) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{26, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{33, 0}
 }
 
 type CanvasNodeExecution_Result int32
@@ -127,7 +127,7 @@ func (x CanvasNodeExecution_Result) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_Result.Descriptor instead.
 func (CanvasNodeExecution_Result) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{26, 1}
+	return file_canvases_proto_rawDescGZIP(), []int{33, 1}
 }
 
 type CanvasNodeExecution_ResultReason int32
@@ -176,7 +176,7 @@ func (x CanvasNodeExecution_ResultReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_ResultReason.Descriptor instead.
 func (CanvasNodeExecution_ResultReason) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{26, 2}
+	return file_canvases_proto_rawDescGZIP(), []int{33, 2}
 }
 
 type ListCanvasesRequest struct {
@@ -1467,28 +1467,29 @@ func (x *ListNodeExecutionsResponse) GetLastTimestamp() *timestamp.Timestamp {
 	return nil
 }
 
-type ListChildExecutionsRequest struct {
+type GetNodeStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	WindowSeconds uint32                 `protobuf:"varint,3,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListChildExecutionsRequest) Reset() {
-	*x = ListChildExecutionsRequest{}
+func (x *GetNodeStatusRequest) Reset() {
+	*x = GetNodeStatusRequest{}
 	mi := &file_canvases_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListChildExecutionsRequest) String() string {
+func (x *GetNodeStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChildExecutionsRequest) ProtoMessage() {}
+func (*GetNodeStatusRequest) ProtoMessage() {}
 
-func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetNodeStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1500,46 +1501,53 @@ func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChildExecutionsRequest.ProtoReflect.Descriptor instead.
-func (*ListChildExecutionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNodeStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeStatusRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListChildExecutionsRequest) GetCanvasId() string {
+func (x *GetNodeStatusRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *ListChildExecutionsRequest) GetExecutionId() string {
+func (x *GetNodeStatusRequest) GetNodeId() string {
 	if x != nil {
-		return x.ExecutionId
+		return x.NodeId
 	}
 	return ""
 }
 
-type ListChildExecutionsResponse struct {
+func (x *GetNodeStatusRequest) GetWindowSeconds() uint32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+type GetNodeStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	Status        *NodeStatus            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListChildExecutionsResponse) Reset() {
-	*x = ListChildExecutionsResponse{}
+func (x *GetNodeStatusResponse) Reset() {
+	*x = GetNodeStatusResponse{}
 	mi := &file_canvases_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListChildExecutionsResponse) String() string {
+func (x *GetNodeStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChildExecutionsResponse) ProtoMessage() {}
+func (*GetNodeStatusResponse) ProtoMessage() {}
 
-func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetNodeStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1551,56 +1559,41 @@ func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChildExecutionsResponse.ProtoReflect.Descriptor instead.
-func (*ListChildExecutionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNodeStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeStatusResponse) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ListChildExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+func (x *GetNodeStatusResponse) GetStatus() *NodeStatus {
 	if x != nil {
-		return x.Executions
+		return x.Status
 	}
 	return nil
 }
 
-type CanvasNodeExecution struct {
-	state               protoimpl.MessageState           `protogen:"open.v1"`
-	Id                  string                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId            string                           `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId              string                           `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	ParentExecutionId   string                           `protobuf:"bytes,4,opt,name=parent_execution_id,json=parentExecutionId,proto3" json:"parent_execution_id,omitempty"`
-	PreviousExecutionId string                           `protobuf:"bytes,5,opt,name=previous_execution_id,json=previousExecutionId,proto3" json:"previous_execution_id,omitempty"`
-	State               CanvasNodeExecution_State        `protobuf:"varint,6,opt,name=state,proto3,enum=Superplane.Canvases.CanvasNodeExecution_State" json:"state,omitempty"`
-	Result              CanvasNodeExecution_Result       `protobuf:"varint,7,opt,name=result,proto3,enum=Superplane.Canvases.CanvasNodeExecution_Result" json:"result,omitempty"`
-	ResultReason        CanvasNodeExecution_ResultReason `protobuf:"varint,8,opt,name=result_reason,json=resultReason,proto3,enum=Superplane.Canvases.CanvasNodeExecution_ResultReason" json:"result_reason,omitempty"`
-	ResultMessage       string                           `protobuf:"bytes,9,opt,name=result_message,json=resultMessage,proto3" json:"result_message,omitempty"`
-	Input               *_struct.Struct                  `protobuf:"bytes,10,opt,name=input,proto3" json:"input,omitempty"`
-	Outputs             *_struct.Struct                  `protobuf:"bytes,11,opt,name=outputs,proto3" json:"outputs,omitempty"`
-	CreatedAt           *timestamp.Timestamp             `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt           *timestamp.Timestamp             `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Metadata            *_struct.Struct                  `protobuf:"bytes,14,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Configuration       *_struct.Struct                  `protobuf:"bytes,15,opt,name=configuration,proto3" json:"configuration,omitempty"`
-	ChildExecutions     []*CanvasNodeExecution           `protobuf:"bytes,16,rep,name=child_executions,json=childExecutions,proto3" json:"child_executions,omitempty"`
-	RootEvent           *CanvasEvent                     `protobuf:"bytes,17,opt,name=root_event,json=rootEvent,proto3" json:"root_event,omitempty"`
-	CancelledBy         *UserRef                         `protobuf:"bytes,18,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type ListNodeStatusesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeIds       []string               `protobuf:"bytes,2,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	WindowSeconds uint32                 `protobuf:"varint,3,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeExecution) Reset() {
-	*x = CanvasNodeExecution{}
+func (x *ListNodeStatusesRequest) Reset() {
+	*x = ListNodeStatusesRequest{}
 	mi := &file_canvases_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeExecution) String() string {
+func (x *ListNodeStatusesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasNodeExecution) ProtoMessage() {}
+func (*ListNodeStatusesRequest) ProtoMessage() {}
 
-func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
+func (x *ListNodeStatusesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1612,164 +1605,194 @@ func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasNodeExecution.ProtoReflect.Descriptor instead.
-func (*CanvasNodeExecution) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListNodeStatusesRequest.ProtoReflect.Descriptor instead.
+func (*ListNodeStatusesRequest) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CanvasNodeExecution) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CanvasNodeExecution) GetCanvasId() string {
+func (x *ListNodeStatusesRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *CanvasNodeExecution) GetNodeId() string {
+func (x *ListNodeStatusesRequest) GetNodeIds() []string {
 	if x != nil {
-		return x.NodeId
+		return x.NodeIds
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasNodeExecution) GetParentExecutionId() string {
+func (x *ListNodeStatusesRequest) GetWindowSeconds() uint32 {
 	if x != nil {
-		return x.ParentExecutionId
+		return x.WindowSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *CanvasNodeExecution) GetPreviousExecutionId() string {
-	if x != nil {
-		return x.PreviousExecutionId
-	}
-	return ""
+type ListNodeStatusesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*NodeStatus          `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeExecution) GetState() CanvasNodeExecution_State {
-	if x != nil {
-		return x.State
-	}
-	return CanvasNodeExecution_STATE_UNKNOWN
+func (x *ListNodeStatusesResponse) Reset() {
+	*x = ListNodeStatusesResponse{}
+	mi := &file_canvases_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeExecution) GetResult() CanvasNodeExecution_Result {
-	if x != nil {
-		return x.Result
-	}
-	return CanvasNodeExecution_RESULT_UNKNOWN
+func (x *ListNodeStatusesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CanvasNodeExecution) GetResultReason() CanvasNodeExecution_ResultReason {
+func (*ListNodeStatusesResponse) ProtoMessage() {}
+
+func (x *ListNodeStatusesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[27]
 	if x != nil {
-		return x.ResultReason
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return CanvasNodeExecution_RESULT_REASON_OK
+	return mi.MessageOf(x)
 }
 
-func (x *CanvasNodeExecution) GetResultMessage() string {
-	if x != nil {
-		return x.ResultMessage
-	}
-	return ""
+// Deprecated: Use ListNodeStatusesResponse.ProtoReflect.Descriptor instead.
+func (*ListNodeStatusesResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CanvasNodeExecution) GetInput() *_struct.Struct {
+func (x *ListNodeStatusesResponse) GetStatuses() []*NodeStatus {
 	if x != nil {
-		return x.Input
+		return x.Statuses
 	}
 	return nil
 }
 
-func (x *CanvasNodeExecution) GetOutputs() *_struct.Struct {
+type NodeStatus struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	NodeId           string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Paused           bool                   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	QueueDepth       uint32                 `protobuf:"varint,3,opt,name=queue_depth,json=queueDepth,proto3" json:"queue_depth,omitempty"`
+	ExecutionCounts  *NodeExecutionCounts   `protobuf:"bytes,4,opt,name=execution_counts,json=executionCounts,proto3" json:"execution_counts,omitempty"`
+	LastExecution    *NodeStatusExecution   `protobuf:"bytes,5,opt,name=last_execution,json=lastExecution,proto3" json:"last_execution,omitempty"`
+	LastErrorMessage string                 `protobuf:"bytes,6,opt,name=last_error_message,json=lastErrorMessage,proto3" json:"last_error_message,omitempty"`
+	WindowStart      *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NodeStatus) Reset() {
+	*x = NodeStatus{}
+	mi := &file_canvases_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeStatus) ProtoMessage() {}
+
+func (x *NodeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[28]
 	if x != nil {
-		return x.Outputs
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CanvasNodeExecution) GetCreatedAt() *timestamp.Timestamp {
+// Deprecated: Use NodeStatus.ProtoReflect.Descriptor instead.
+func (*NodeStatus) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *NodeStatus) GetNodeId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.NodeId
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasNodeExecution) GetUpdatedAt() *timestamp.Timestamp {
+func (x *NodeStatus) GetPaused() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Paused
 	}
-	return nil
+	return false
 }
 
-func (x *CanvasNodeExecution) GetMetadata() *_struct.Struct {
+func (x *NodeStatus) GetQueueDepth() uint32 {
 	if x != nil {
-		return x.Metadata
+		return x.QueueDepth
 	}
-	return nil
+	return 0
 }
 
-func (x *CanvasNodeExecution) GetConfiguration() *_struct.Struct {
+func (x *NodeStatus) GetExecutionCounts() *NodeExecutionCounts {
 	if x != nil {
-		return x.Configuration
+		return x.ExecutionCounts
 	}
 	return nil
 }
 
-func (x *CanvasNodeExecution) GetChildExecutions() []*CanvasNodeExecution {
+func (x *NodeStatus) GetLastExecution() *NodeStatusExecution {
 	if x != nil {
-		return x.ChildExecutions
+		return x.LastExecution
 	}
 	return nil
 }
 
-func (x *CanvasNodeExecution) GetRootEvent() *CanvasEvent {
+func (x *NodeStatus) GetLastErrorMessage() string {
 	if x != nil {
-		return x.RootEvent
+		return x.LastErrorMessage
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasNodeExecution) GetCancelledBy() *UserRef {
+func (x *NodeStatus) GetWindowStart() *timestamp.Timestamp {
 	if x != nil {
-		return x.CancelledBy
+		return x.WindowStart
 	}
 	return nil
 }
 
-type CanvasNodeQueueItem struct {
+type NodeExecutionCounts struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Input         *_struct.Struct        `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
-	RootEvent     *CanvasEvent           `protobuf:"bytes,5,opt,name=root_event,json=rootEvent,proto3" json:"root_event,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Pending       uint32                 `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	Started       uint32                 `protobuf:"varint,2,opt,name=started,proto3" json:"started,omitempty"`
+	Passed        uint32                 `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
+	Failed        uint32                 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Cancelled     uint32                 `protobuf:"varint,5,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeQueueItem) Reset() {
-	*x = CanvasNodeQueueItem{}
-	mi := &file_canvases_proto_msgTypes[27]
+func (x *NodeExecutionCounts) Reset() {
+	*x = NodeExecutionCounts{}
+	mi := &file_canvases_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeQueueItem) String() string {
+func (x *NodeExecutionCounts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasNodeQueueItem) ProtoMessage() {}
+func (*NodeExecutionCounts) ProtoMessage() {}
 
-func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[27]
+func (x *NodeExecutionCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1780,78 +1803,73 @@ func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasNodeQueueItem.ProtoReflect.Descriptor instead.
-func (*CanvasNodeQueueItem) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *CanvasNodeQueueItem) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use NodeExecutionCounts.ProtoReflect.Descriptor instead.
+func (*NodeExecutionCounts) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CanvasNodeQueueItem) GetCanvasId() string {
+func (x *NodeExecutionCounts) GetPending() uint32 {
 	if x != nil {
-		return x.CanvasId
+		return x.Pending
 	}
-	return ""
+	return 0
 }
 
-func (x *CanvasNodeQueueItem) GetNodeId() string {
+func (x *NodeExecutionCounts) GetStarted() uint32 {
 	if x != nil {
-		return x.NodeId
+		return x.Started
 	}
-	return ""
+	return 0
 }
 
-func (x *CanvasNodeQueueItem) GetInput() *_struct.Struct {
+func (x *NodeExecutionCounts) GetPassed() uint32 {
 	if x != nil {
-		return x.Input
+		return x.Passed
 	}
-	return nil
+	return 0
 }
 
-func (x *CanvasNodeQueueItem) GetRootEvent() *CanvasEvent {
+func (x *NodeExecutionCounts) GetFailed() uint32 {
 	if x != nil {
-		return x.RootEvent
+		return x.Failed
 	}
-	return nil
+	return 0
 }
 
-func (x *CanvasNodeQueueItem) GetCreatedAt() *timestamp.Timestamp {
+func (x *NodeExecutionCounts) GetCancelled() uint32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Cancelled
 	}
-	return nil
+	return 0
 }
 
-type InvokeNodeExecutionActionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
-	ActionName    string                 `protobuf:"bytes,3,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
-	Parameters    *_struct.Struct        `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+type NodeStatusExecution struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Id            string                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State         CanvasNodeExecution_State        `protobuf:"varint,2,opt,name=state,proto3,enum=Superplane.Canvases.CanvasNodeExecution_State" json:"state,omitempty"`
+	Result        CanvasNodeExecution_Result       `protobuf:"varint,3,opt,name=result,proto3,enum=Superplane.Canvases.CanvasNodeExecution_Result" json:"result,omitempty"`
+	ResultReason  CanvasNodeExecution_ResultReason `protobuf:"varint,4,opt,name=result_reason,json=resultReason,proto3,enum=Superplane.Canvases.CanvasNodeExecution_ResultReason" json:"result_reason,omitempty"`
+	CreatedAt     *timestamp.Timestamp             `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamp.Timestamp             `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvokeNodeExecutionActionRequest) Reset() {
-	*x = InvokeNodeExecutionActionRequest{}
-	mi := &file_canvases_proto_msgTypes[28]
+func (x *NodeStatusExecution) Reset() {
+	*x = NodeStatusExecution{}
+	mi := &file_canvases_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvokeNodeExecutionActionRequest) String() string {
+func (x *NodeStatusExecution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvokeNodeExecutionActionRequest) ProtoMessage() {}
+func (*NodeStatusExecution) ProtoMessage() {}
 
-func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[28]
+func (x *NodeStatusExecution) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1862,37 +1880,479 @@ func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeNodeExecutionActionRequest.ProtoReflect.Descriptor instead.
-func (*InvokeNodeExecutionActionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use NodeStatusExecution.ProtoReflect.Descriptor instead.
+func (*NodeStatusExecution) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *InvokeNodeExecutionActionRequest) GetCanvasId() string {
+func (x *NodeStatusExecution) GetId() string {
 	if x != nil {
-		return x.CanvasId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *InvokeNodeExecutionActionRequest) GetExecutionId() string {
+func (x *NodeStatusExecution) GetState() CanvasNodeExecution_State {
 	if x != nil {
-		return x.ExecutionId
+		return x.State
 	}
-	return ""
+	return CanvasNodeExecution_STATE_UNKNOWN
 }
 
-func (x *InvokeNodeExecutionActionRequest) GetActionName() string {
+func (x *NodeStatusExecution) GetResult() CanvasNodeExecution_Result {
 	if x != nil {
-		return x.ActionName
+		return x.Result
 	}
-	return ""
+	return CanvasNodeExecution_RESULT_UNKNOWN
 }
 
-func (x *InvokeNodeExecutionActionRequest) GetParameters() *_struct.Struct {
+func (x *NodeStatusExecution) GetResultReason() CanvasNodeExecution_ResultReason {
 	if x != nil {
-		return x.Parameters
+		return x.ResultReason
 	}
-	return nil
+	return CanvasNodeExecution_RESULT_REASON_OK
+}
+
+func (x *NodeStatusExecution) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NodeStatusExecution) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListChildExecutionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChildExecutionsRequest) Reset() {
+	*x = ListChildExecutionsRequest{}
+	mi := &file_canvases_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChildExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChildExecutionsRequest) ProtoMessage() {}
+
+func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChildExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListChildExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListChildExecutionsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ListChildExecutionsRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+type ListChildExecutionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChildExecutionsResponse) Reset() {
+	*x = ListChildExecutionsResponse{}
+	mi := &file_canvases_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChildExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChildExecutionsResponse) ProtoMessage() {}
+
+func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChildExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListChildExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListChildExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+type CanvasNodeExecution struct {
+	state               protoimpl.MessageState           `protogen:"open.v1"`
+	Id                  string                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId            string                           `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId              string                           `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ParentExecutionId   string                           `protobuf:"bytes,4,opt,name=parent_execution_id,json=parentExecutionId,proto3" json:"parent_execution_id,omitempty"`
+	PreviousExecutionId string                           `protobuf:"bytes,5,opt,name=previous_execution_id,json=previousExecutionId,proto3" json:"previous_execution_id,omitempty"`
+	State               CanvasNodeExecution_State        `protobuf:"varint,6,opt,name=state,proto3,enum=Superplane.Canvases.CanvasNodeExecution_State" json:"state,omitempty"`
+	Result              CanvasNodeExecution_Result       `protobuf:"varint,7,opt,name=result,proto3,enum=Superplane.Canvases.CanvasNodeExecution_Result" json:"result,omitempty"`
+	ResultReason        CanvasNodeExecution_ResultReason `protobuf:"varint,8,opt,name=result_reason,json=resultReason,proto3,enum=Superplane.Canvases.CanvasNodeExecution_ResultReason" json:"result_reason,omitempty"`
+	ResultMessage       string                           `protobuf:"bytes,9,opt,name=result_message,json=resultMessage,proto3" json:"result_message,omitempty"`
+	Input               *_struct.Struct                  `protobuf:"bytes,10,opt,name=input,proto3" json:"input,omitempty"`
+	Outputs             *_struct.Struct                  `protobuf:"bytes,11,opt,name=outputs,proto3" json:"outputs,omitempty"`
+	CreatedAt           *timestamp.Timestamp             `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamp.Timestamp             `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Metadata            *_struct.Struct                  `protobuf:"bytes,14,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Configuration       *_struct.Struct                  `protobuf:"bytes,15,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	ChildExecutions     []*CanvasNodeExecution           `protobuf:"bytes,16,rep,name=child_executions,json=childExecutions,proto3" json:"child_executions,omitempty"`
+	RootEvent           *CanvasEvent                     `protobuf:"bytes,17,opt,name=root_event,json=rootEvent,proto3" json:"root_event,omitempty"`
+	CancelledBy         *UserRef                         `protobuf:"bytes,18,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CanvasNodeExecution) Reset() {
+	*x = CanvasNodeExecution{}
+	mi := &file_canvases_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeExecution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeExecution) ProtoMessage() {}
+
+func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeExecution.ProtoReflect.Descriptor instead.
+func (*CanvasNodeExecution) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CanvasNodeExecution) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetParentExecutionId() string {
+	if x != nil {
+		return x.ParentExecutionId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetPreviousExecutionId() string {
+	if x != nil {
+		return x.PreviousExecutionId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetState() CanvasNodeExecution_State {
+	if x != nil {
+		return x.State
+	}
+	return CanvasNodeExecution_STATE_UNKNOWN
+}
+
+func (x *CanvasNodeExecution) GetResult() CanvasNodeExecution_Result {
+	if x != nil {
+		return x.Result
+	}
+	return CanvasNodeExecution_RESULT_UNKNOWN
+}
+
+func (x *CanvasNodeExecution) GetResultReason() CanvasNodeExecution_ResultReason {
+	if x != nil {
+		return x.ResultReason
+	}
+	return CanvasNodeExecution_RESULT_REASON_OK
+}
+
+func (x *CanvasNodeExecution) GetResultMessage() string {
+	if x != nil {
+		return x.ResultMessage
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetInput() *_struct.Struct {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetOutputs() *_struct.Struct {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetMetadata() *_struct.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetConfiguration() *_struct.Struct {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetChildExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.ChildExecutions
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetRootEvent() *CanvasEvent {
+	if x != nil {
+		return x.RootEvent
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetCancelledBy() *UserRef {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return nil
+}
+
+type CanvasNodeQueueItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Input         *_struct.Struct        `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	RootEvent     *CanvasEvent           `protobuf:"bytes,5,opt,name=root_event,json=rootEvent,proto3" json:"root_event,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeQueueItem) Reset() {
+	*x = CanvasNodeQueueItem{}
+	mi := &file_canvases_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeQueueItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeQueueItem) ProtoMessage() {}
+
+func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeQueueItem.ProtoReflect.Descriptor instead.
+func (*CanvasNodeQueueItem) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CanvasNodeQueueItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeQueueItem) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeQueueItem) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeQueueItem) GetInput() *_struct.Struct {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *CanvasNodeQueueItem) GetRootEvent() *CanvasEvent {
+	if x != nil {
+		return x.RootEvent
+	}
+	return nil
+}
+
+func (x *CanvasNodeQueueItem) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type InvokeNodeExecutionActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	ActionName    string                 `protobuf:"bytes,3,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
+	Parameters    *_struct.Struct        `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeNodeExecutionActionRequest) Reset() {
+	*x = InvokeNodeExecutionActionRequest{}
+	mi := &file_canvases_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeNodeExecutionActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeNodeExecutionActionRequest) ProtoMessage() {}
+
+func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeNodeExecutionActionRequest.ProtoReflect.Descriptor instead.
+func (*InvokeNodeExecutionActionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *InvokeNodeExecutionActionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *InvokeNodeExecutionActionRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *InvokeNodeExecutionActionRequest) GetActionName() string {
+	if x != nil {
+		return x.ActionName
+	}
+	return ""
+}
+
+func (x *InvokeNodeExecutionActionRequest) GetParameters() *_struct.Struct {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
 }
 
 type InvokeNodeExecutionActionResponse struct {
@@ -1901,21 +2361,658 @@ type InvokeNodeExecutionActionResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvokeNodeExecutionActionResponse) Reset() {
-	*x = InvokeNodeExecutionActionResponse{}
-	mi := &file_canvases_proto_msgTypes[29]
+func (x *InvokeNodeExecutionActionResponse) Reset() {
+	*x = InvokeNodeExecutionActionResponse{}
+	mi := &file_canvases_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeNodeExecutionActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeNodeExecutionActionResponse) ProtoMessage() {}
+
+func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeNodeExecutionActionResponse.ProtoReflect.Descriptor instead.
+func (*InvokeNodeExecutionActionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{36}
+}
+
+type InvokeNodeTriggerActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ActionName    string                 `protobuf:"bytes,3,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
+	Parameters    *_struct.Struct        `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeNodeTriggerActionRequest) Reset() {
+	*x = InvokeNodeTriggerActionRequest{}
+	mi := &file_canvases_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeNodeTriggerActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeNodeTriggerActionRequest) ProtoMessage() {}
+
+func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeNodeTriggerActionRequest.ProtoReflect.Descriptor instead.
+func (*InvokeNodeTriggerActionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *InvokeNodeTriggerActionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *InvokeNodeTriggerActionRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *InvokeNodeTriggerActionRequest) GetActionName() string {
+	if x != nil {
+		return x.ActionName
+	}
+	return ""
+}
+
+func (x *InvokeNodeTriggerActionRequest) GetParameters() *_struct.Struct {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type InvokeNodeTriggerActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *_struct.Struct        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvokeNodeTriggerActionResponse) Reset() {
+	*x = InvokeNodeTriggerActionResponse{}
+	mi := &file_canvases_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeNodeTriggerActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeNodeTriggerActionResponse) ProtoMessage() {}
+
+func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeNodeTriggerActionResponse.ProtoReflect.Descriptor instead.
+func (*InvokeNodeTriggerActionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *InvokeNodeTriggerActionResponse) GetResult() *_struct.Struct {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ListCanvasEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	NodeId        string                 `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"`
+	After         *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	PayloadType   string                 `protobuf:"bytes,7,opt,name=payload_type,json=payloadType,proto3" json:"payload_type,omitempty"`
+	Cursor        string                 `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	CountOnly     bool                   `protobuf:"varint,9,opt,name=count_only,json=countOnly,proto3" json:"count_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasEventsRequest) Reset() {
+	*x = ListCanvasEventsRequest{}
+	mi := &file_canvases_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasEventsRequest) ProtoMessage() {}
+
+func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasEventsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListCanvasEventsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ListCanvasEventsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCanvasEventsRequest) GetBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListCanvasEventsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ListCanvasEventsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ListCanvasEventsRequest) GetAfter() *timestamp.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ListCanvasEventsRequest) GetPayloadType() string {
+	if x != nil {
+		return x.PayloadType
+	}
+	return ""
+}
+
+func (x *ListCanvasEventsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListCanvasEventsRequest) GetCountOnly() bool {
+	if x != nil {
+		return x.CountOnly
+	}
+	return false
+}
+
+type ListCanvasEventsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Events        []*CanvasEventWithExecutions `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	TotalCount    uint32                       `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasNextPage   bool                         `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp *timestamp.Timestamp         `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	NextCursor    string                       `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	NodeCounts    []*CanvasEventNodeCount      `protobuf:"bytes,6,rep,name=node_counts,json=nodeCounts,proto3" json:"node_counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCanvasEventsResponse) Reset() {
+	*x = ListCanvasEventsResponse{}
+	mi := &file_canvases_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCanvasEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCanvasEventsResponse) ProtoMessage() {}
+
+func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCanvasEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListCanvasEventsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListCanvasEventsResponse) GetEvents() []*CanvasEventWithExecutions {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListCanvasEventsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListCanvasEventsResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListCanvasEventsResponse) GetLastTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
+func (x *ListCanvasEventsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListCanvasEventsResponse) GetNodeCounts() []*CanvasEventNodeCount {
+	if x != nil {
+		return x.NodeCounts
+	}
+	return nil
+}
+
+type CanvasEventNodeCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasEventNodeCount) Reset() {
+	*x = CanvasEventNodeCount{}
+	mi := &file_canvases_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasEventNodeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasEventNodeCount) ProtoMessage() {}
+
+func (x *CanvasEventNodeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasEventNodeCount.ProtoReflect.Descriptor instead.
+func (*CanvasEventNodeCount) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CanvasEventNodeCount) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasEventNodeCount) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CanvasEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	CustomName    string                 `protobuf:"bytes,5,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
+	Data          *_struct.Struct        `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasEvent) Reset() {
+	*x = CanvasEvent{}
+	mi := &file_canvases_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasEvent) ProtoMessage() {}
+
+func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasEvent.ProtoReflect.Descriptor instead.
+func (*CanvasEvent) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CanvasEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasEvent) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasEvent) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasEvent) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CanvasEvent) GetCustomName() string {
+	if x != nil {
+		return x.CustomName
+	}
+	return ""
+}
+
+func (x *CanvasEvent) GetData() *_struct.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CanvasEvent) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CanvasEventWithExecutions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Data          *_struct.Struct        `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Executions    []*CanvasNodeExecution `protobuf:"bytes,7,rep,name=executions,proto3" json:"executions,omitempty"`
+	CustomName    string                 `protobuf:"bytes,8,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasEventWithExecutions) Reset() {
+	*x = CanvasEventWithExecutions{}
+	mi := &file_canvases_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasEventWithExecutions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasEventWithExecutions) ProtoMessage() {}
+
+func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasEventWithExecutions.ProtoReflect.Descriptor instead.
+func (*CanvasEventWithExecutions) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CanvasEventWithExecutions) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasEventWithExecutions) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasEventWithExecutions) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasEventWithExecutions) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CanvasEventWithExecutions) GetData() *_struct.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CanvasEventWithExecutions) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CanvasEventWithExecutions) GetExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+func (x *CanvasEventWithExecutions) GetCustomName() string {
+	if x != nil {
+		return x.CustomName
+	}
+	return ""
+}
+
+type ListEventExecutionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventExecutionsRequest) Reset() {
+	*x = ListEventExecutionsRequest{}
+	mi := &file_canvases_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventExecutionsRequest) ProtoMessage() {}
+
+func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListEventExecutionsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ListEventExecutionsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListEventExecutionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventExecutionsResponse) Reset() {
+	*x = ListEventExecutionsResponse{}
+	mi := &file_canvases_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvokeNodeExecutionActionResponse) String() string {
+func (x *ListEventExecutionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvokeNodeExecutionActionResponse) ProtoMessage() {}
+func (*ListEventExecutionsResponse) ProtoMessage() {}
 
-func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[29]
+func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1926,36 +3023,41 @@ func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeNodeExecutionActionResponse.ProtoReflect.Descriptor instead.
-func (*InvokeNodeExecutionActionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListEventExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{45}
 }
 
-type InvokeNodeTriggerActionRequest struct {
+func (x *ListEventExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+type CancelExecutionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	ActionName    string                 `protobuf:"bytes,3,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
-	Parameters    *_struct.Struct        `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvokeNodeTriggerActionRequest) Reset() {
-	*x = InvokeNodeTriggerActionRequest{}
-	mi := &file_canvases_proto_msgTypes[30]
+func (x *CancelExecutionRequest) Reset() {
+	*x = CancelExecutionRequest{}
+	mi := &file_canvases_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvokeNodeTriggerActionRequest) String() string {
+func (x *CancelExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvokeNodeTriggerActionRequest) ProtoMessage() {}
+func (*CancelExecutionRequest) ProtoMessage() {}
 
-func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[30]
+func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1966,61 +3068,46 @@ func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeNodeTriggerActionRequest.ProtoReflect.Descriptor instead.
-func (*InvokeNodeTriggerActionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use CancelExecutionRequest.ProtoReflect.Descriptor instead.
+func (*CancelExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *InvokeNodeTriggerActionRequest) GetCanvasId() string {
+func (x *CancelExecutionRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *InvokeNodeTriggerActionRequest) GetNodeId() string {
-	if x != nil {
-		return x.NodeId
-	}
-	return ""
-}
-
-func (x *InvokeNodeTriggerActionRequest) GetActionName() string {
+func (x *CancelExecutionRequest) GetExecutionId() string {
 	if x != nil {
-		return x.ActionName
+		return x.ExecutionId
 	}
 	return ""
 }
 
-func (x *InvokeNodeTriggerActionRequest) GetParameters() *_struct.Struct {
-	if x != nil {
-		return x.Parameters
-	}
-	return nil
-}
-
-type InvokeNodeTriggerActionResponse struct {
+type CancelExecutionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        *_struct.Struct        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InvokeNodeTriggerActionResponse) Reset() {
-	*x = InvokeNodeTriggerActionResponse{}
-	mi := &file_canvases_proto_msgTypes[31]
+func (x *CancelExecutionResponse) Reset() {
+	*x = CancelExecutionResponse{}
+	mi := &file_canvases_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvokeNodeTriggerActionResponse) String() string {
+func (x *CancelExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvokeNodeTriggerActionResponse) ProtoMessage() {}
+func (*CancelExecutionResponse) ProtoMessage() {}
 
-func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[31]
+func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2031,42 +3118,34 @@ func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeNodeTriggerActionResponse.ProtoReflect.Descriptor instead.
-func (*InvokeNodeTriggerActionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *InvokeNodeTriggerActionResponse) GetResult() *_struct.Struct {
-	if x != nil {
-		return x.Result
-	}
-	return nil
+// Deprecated: Use CancelExecutionResponse.ProtoReflect.Descriptor instead.
+func (*CancelExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{47}
 }
 
-type ListCanvasEventsRequest struct {
+type ListExecutionLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Before        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCanvasEventsRequest) Reset() {
-	*x = ListCanvasEventsRequest{}
-	mi := &file_canvases_proto_msgTypes[32]
+func (x *ListExecutionLogsRequest) Reset() {
+	*x = ListExecutionLogsRequest{}
+	mi := &file_canvases_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCanvasEventsRequest) String() string {
+func (x *ListExecutionLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCanvasEventsRequest) ProtoMessage() {}
+func (*ListExecutionLogsRequest) ProtoMessage() {}
 
-func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[32]
+func (x *ListExecutionLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2077,57 +3156,47 @@ func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCanvasEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListCanvasEventsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ListExecutionLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListExecutionLogsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ListCanvasEventsRequest) GetCanvasId() string {
+func (x *ListExecutionLogsRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *ListCanvasEventsRequest) GetLimit() uint32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListCanvasEventsRequest) GetBefore() *timestamp.Timestamp {
+func (x *ListExecutionLogsRequest) GetExecutionId() string {
 	if x != nil {
-		return x.Before
+		return x.ExecutionId
 	}
-	return nil
+	return ""
 }
 
-type ListCanvasEventsResponse struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Events        []*CanvasEventWithExecutions `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	TotalCount    uint32                       `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	HasNextPage   bool                         `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
-	LastTimestamp *timestamp.Timestamp         `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+type ListExecutionLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          []*ExecutionLog        `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCanvasEventsResponse) Reset() {
-	*x = ListCanvasEventsResponse{}
-	mi := &file_canvases_proto_msgTypes[33]
+func (x *ListExecutionLogsResponse) Reset() {
+	*x = ListExecutionLogsResponse{}
+	mi := &file_canvases_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCanvasEventsResponse) String() string {
+func (x *ListExecutionLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCanvasEventsResponse) ProtoMessage() {}
+func (*ListExecutionLogsResponse) ProtoMessage() {}
 
-func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[33]
+func (x *ListExecutionLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2138,67 +3207,44 @@ func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCanvasEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListCanvasEventsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *ListCanvasEventsResponse) GetEvents() []*CanvasEventWithExecutions {
-	if x != nil {
-		return x.Events
-	}
-	return nil
-}
-
-func (x *ListCanvasEventsResponse) GetTotalCount() uint32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *ListCanvasEventsResponse) GetHasNextPage() bool {
-	if x != nil {
-		return x.HasNextPage
-	}
-	return false
+// Deprecated: Use ListExecutionLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListExecutionLogsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListCanvasEventsResponse) GetLastTimestamp() *timestamp.Timestamp {
+func (x *ListExecutionLogsResponse) GetLogs() []*ExecutionLog {
 	if x != nil {
-		return x.LastTimestamp
+		return x.Logs
 	}
 	return nil
 }
 
-type CanvasEvent struct {
+type ExecutionLog struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
-	CustomName    string                 `protobuf:"bytes,5,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
-	Data          *_struct.Struct        `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Fields        *_struct.Struct        `protobuf:"bytes,4,opt,name=fields,proto3" json:"fields,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasEvent) Reset() {
-	*x = CanvasEvent{}
-	mi := &file_canvases_proto_msgTypes[34]
+func (x *ExecutionLog) Reset() {
+	*x = ExecutionLog{}
+	mi := &file_canvases_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasEvent) String() string {
+func (x *ExecutionLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasEvent) ProtoMessage() {}
+func (*ExecutionLog) ProtoMessage() {}
 
-func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[34]
+func (x *ExecutionLog) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2209,89 +3255,169 @@ func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasEvent.ProtoReflect.Descriptor instead.
-func (*CanvasEvent) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ExecutionLog.ProtoReflect.Descriptor instead.
+func (*ExecutionLog) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *CanvasEvent) GetId() string {
+func (x *ExecutionLog) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CanvasEvent) GetCanvasId() string {
+func (x *ExecutionLog) GetLevel() string {
 	if x != nil {
-		return x.CanvasId
+		return x.Level
 	}
 	return ""
 }
 
-func (x *CanvasEvent) GetNodeId() string {
+func (x *ExecutionLog) GetMessage() string {
 	if x != nil {
-		return x.NodeId
+		return x.Message
 	}
 	return ""
 }
 
-func (x *CanvasEvent) GetChannel() string {
+func (x *ExecutionLog) GetFields() *_struct.Struct {
 	if x != nil {
-		return x.Channel
+		return x.Fields
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasEvent) GetCustomName() string {
+func (x *ExecutionLog) GetTimestamp() *timestamp.Timestamp {
 	if x != nil {
-		return x.CustomName
+		return x.Timestamp
+	}
+	return nil
+}
+
+type ResolveExecutionErrorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionIds  []string               `protobuf:"bytes,2,rep,name=execution_ids,json=executionIds,proto3" json:"execution_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveExecutionErrorsRequest) Reset() {
+	*x = ResolveExecutionErrorsRequest{}
+	mi := &file_canvases_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveExecutionErrorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveExecutionErrorsRequest) ProtoMessage() {}
+
+func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveExecutionErrorsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveExecutionErrorsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ResolveExecutionErrorsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *CanvasEvent) GetData() *_struct.Struct {
+func (x *ResolveExecutionErrorsRequest) GetExecutionIds() []string {
 	if x != nil {
-		return x.Data
+		return x.ExecutionIds
 	}
 	return nil
 }
 
-func (x *CanvasEvent) GetCreatedAt() *timestamp.Timestamp {
+type ResolveExecutionErrorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveExecutionErrorsResponse) Reset() {
+	*x = ResolveExecutionErrorsResponse{}
+	mi := &file_canvases_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveExecutionErrorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveExecutionErrorsResponse) ProtoMessage() {}
+
+func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[52]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type CanvasEventWithExecutions struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
-	Data          *_struct.Struct        `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Executions    []*CanvasNodeExecution `protobuf:"bytes,7,rep,name=executions,proto3" json:"executions,omitempty"`
-	CustomName    string                 `protobuf:"bytes,8,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Deprecated: Use ResolveExecutionErrorsResponse.ProtoReflect.Descriptor instead.
+func (*ResolveExecutionErrorsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{52}
+}
+
+type DeadLetteredExecution struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId            string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId              string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ExecutionId         string                 `protobuf:"bytes,4,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	RootEventId         string                 `protobuf:"bytes,5,opt,name=root_event_id,json=rootEventId,proto3" json:"root_event_id,omitempty"`
+	EventId             string                 `protobuf:"bytes,6,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ConfigHash          string                 `protobuf:"bytes,7,opt,name=config_hash,json=configHash,proto3" json:"config_hash,omitempty"`
+	Configuration       *_struct.Struct        `protobuf:"bytes,8,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Input               *_struct.Struct        `protobuf:"bytes,9,opt,name=input,proto3" json:"input,omitempty"`
+	ResultReason        string                 `protobuf:"bytes,10,opt,name=result_reason,json=resultReason,proto3" json:"result_reason,omitempty"`
+	ResultMessage       string                 `protobuf:"bytes,11,opt,name=result_message,json=resultMessage,proto3" json:"result_message,omitempty"`
+	RequeuedExecutionId string                 `protobuf:"bytes,12,opt,name=requeued_execution_id,json=requeuedExecutionId,proto3" json:"requeued_execution_id,omitempty"`
+	RequeuedAt          *timestamp.Timestamp   `protobuf:"bytes,13,opt,name=requeued_at,json=requeuedAt,proto3" json:"requeued_at,omitempty"`
+	CreatedAt           *timestamp.Timestamp   `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *CanvasEventWithExecutions) Reset() {
-	*x = CanvasEventWithExecutions{}
-	mi := &file_canvases_proto_msgTypes[35]
+func (x *DeadLetteredExecution) Reset() {
+	*x = DeadLetteredExecution{}
+	mi := &file_canvases_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasEventWithExecutions) String() string {
+func (x *DeadLetteredExecution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasEventWithExecutions) ProtoMessage() {}
+func (*DeadLetteredExecution) ProtoMessage() {}
 
-func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[35]
+func (x *DeadLetteredExecution) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2302,186 +3428,134 @@ func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasEventWithExecutions.ProtoReflect.Descriptor instead.
-func (*CanvasEventWithExecutions) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use DeadLetteredExecution.ProtoReflect.Descriptor instead.
+func (*DeadLetteredExecution) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *CanvasEventWithExecutions) GetId() string {
+func (x *DeadLetteredExecution) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CanvasEventWithExecutions) GetCanvasId() string {
+func (x *DeadLetteredExecution) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *CanvasEventWithExecutions) GetNodeId() string {
+func (x *DeadLetteredExecution) GetNodeId() string {
 	if x != nil {
 		return x.NodeId
 	}
 	return ""
 }
 
-func (x *CanvasEventWithExecutions) GetChannel() string {
+func (x *DeadLetteredExecution) GetExecutionId() string {
 	if x != nil {
-		return x.Channel
+		return x.ExecutionId
 	}
 	return ""
 }
 
-func (x *CanvasEventWithExecutions) GetData() *_struct.Struct {
+func (x *DeadLetteredExecution) GetRootEventId() string {
 	if x != nil {
-		return x.Data
+		return x.RootEventId
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasEventWithExecutions) GetCreatedAt() *timestamp.Timestamp {
+func (x *DeadLetteredExecution) GetEventId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.EventId
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasEventWithExecutions) GetExecutions() []*CanvasNodeExecution {
+func (x *DeadLetteredExecution) GetConfigHash() string {
 	if x != nil {
-		return x.Executions
+		return x.ConfigHash
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasEventWithExecutions) GetCustomName() string {
+func (x *DeadLetteredExecution) GetConfiguration() *_struct.Struct {
 	if x != nil {
-		return x.CustomName
+		return x.Configuration
 	}
-	return ""
-}
-
-type ListEventExecutionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListEventExecutionsRequest) Reset() {
-	*x = ListEventExecutionsRequest{}
-	mi := &file_canvases_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListEventExecutionsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*ListEventExecutionsRequest) ProtoMessage() {}
-
-func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[36]
+func (x *DeadLetteredExecution) GetInput() *_struct.Struct {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Input
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListEventExecutionsRequest.ProtoReflect.Descriptor instead.
-func (*ListEventExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{36}
+	return nil
 }
 
-func (x *ListEventExecutionsRequest) GetCanvasId() string {
+func (x *DeadLetteredExecution) GetResultReason() string {
 	if x != nil {
-		return x.CanvasId
+		return x.ResultReason
 	}
 	return ""
 }
 
-func (x *ListEventExecutionsRequest) GetEventId() string {
+func (x *DeadLetteredExecution) GetResultMessage() string {
 	if x != nil {
-		return x.EventId
+		return x.ResultMessage
 	}
 	return ""
 }
 
-type ListEventExecutionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListEventExecutionsResponse) Reset() {
-	*x = ListEventExecutionsResponse{}
-	mi := &file_canvases_proto_msgTypes[37]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListEventExecutionsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListEventExecutionsResponse) ProtoMessage() {}
-
-func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[37]
+func (x *DeadLetteredExecution) GetRequeuedExecutionId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RequeuedExecutionId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListEventExecutionsResponse.ProtoReflect.Descriptor instead.
-func (*ListEventExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{37}
+func (x *DeadLetteredExecution) GetRequeuedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RequeuedAt
+	}
+	return nil
 }
 
-func (x *ListEventExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+func (x *DeadLetteredExecution) GetCreatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Executions
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type CancelExecutionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListDeadLetteredExecutionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId        string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId          string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	IncludeRequeued bool                   `protobuf:"varint,3,opt,name=include_requeued,json=includeRequeued,proto3" json:"include_requeued,omitempty"`
+	Limit           uint32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CancelExecutionRequest) Reset() {
-	*x = CancelExecutionRequest{}
-	mi := &file_canvases_proto_msgTypes[38]
+func (x *ListDeadLetteredExecutionsRequest) Reset() {
+	*x = ListDeadLetteredExecutionsRequest{}
+	mi := &file_canvases_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelExecutionRequest) String() string {
+func (x *ListDeadLetteredExecutionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelExecutionRequest) ProtoMessage() {}
+func (*ListDeadLetteredExecutionsRequest) ProtoMessage() {}
 
-func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[38]
+func (x *ListDeadLetteredExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2492,46 +3566,61 @@ func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelExecutionRequest.ProtoReflect.Descriptor instead.
-func (*CancelExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ListDeadLetteredExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLetteredExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *CancelExecutionRequest) GetCanvasId() string {
+func (x *ListDeadLetteredExecutionsRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *CancelExecutionRequest) GetExecutionId() string {
+func (x *ListDeadLetteredExecutionsRequest) GetNodeId() string {
 	if x != nil {
-		return x.ExecutionId
+		return x.NodeId
 	}
 	return ""
 }
 
-type CancelExecutionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *ListDeadLetteredExecutionsRequest) GetIncludeRequeued() bool {
+	if x != nil {
+		return x.IncludeRequeued
+	}
+	return false
+}
+
+func (x *ListDeadLetteredExecutionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDeadLetteredExecutionsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	DeadLetters   []*DeadLetteredExecution `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelExecutionResponse) Reset() {
-	*x = CancelExecutionResponse{}
-	mi := &file_canvases_proto_msgTypes[39]
+func (x *ListDeadLetteredExecutionsResponse) Reset() {
+	*x = ListDeadLetteredExecutionsResponse{}
+	mi := &file_canvases_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelExecutionResponse) String() string {
+func (x *ListDeadLetteredExecutionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelExecutionResponse) ProtoMessage() {}
+func (*ListDeadLetteredExecutionsResponse) ProtoMessage() {}
 
-func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[39]
+func (x *ListDeadLetteredExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2542,34 +3631,41 @@ func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelExecutionResponse.ProtoReflect.Descriptor instead.
-func (*CancelExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ListDeadLetteredExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLetteredExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{55}
 }
 
-type ResolveExecutionErrorsRequest struct {
+func (x *ListDeadLetteredExecutionsResponse) GetDeadLetters() []*DeadLetteredExecution {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type RequeueDeadLetteredExecutionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionIds  []string               `protobuf:"bytes,2,rep,name=execution_ids,json=executionIds,proto3" json:"execution_ids,omitempty"`
+	DeadLetterId  string                 `protobuf:"bytes,2,opt,name=dead_letter_id,json=deadLetterId,proto3" json:"dead_letter_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResolveExecutionErrorsRequest) Reset() {
-	*x = ResolveExecutionErrorsRequest{}
-	mi := &file_canvases_proto_msgTypes[40]
+func (x *RequeueDeadLetteredExecutionRequest) Reset() {
+	*x = RequeueDeadLetteredExecutionRequest{}
+	mi := &file_canvases_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveExecutionErrorsRequest) String() string {
+func (x *RequeueDeadLetteredExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveExecutionErrorsRequest) ProtoMessage() {}
+func (*RequeueDeadLetteredExecutionRequest) ProtoMessage() {}
 
-func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[40]
+func (x *RequeueDeadLetteredExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2580,46 +3676,47 @@ func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveExecutionErrorsRequest.ProtoReflect.Descriptor instead.
-func (*ResolveExecutionErrorsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use RequeueDeadLetteredExecutionRequest.ProtoReflect.Descriptor instead.
+func (*RequeueDeadLetteredExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ResolveExecutionErrorsRequest) GetCanvasId() string {
+func (x *RequeueDeadLetteredExecutionRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *ResolveExecutionErrorsRequest) GetExecutionIds() []string {
+func (x *RequeueDeadLetteredExecutionRequest) GetDeadLetterId() string {
 	if x != nil {
-		return x.ExecutionIds
+		return x.DeadLetterId
 	}
-	return nil
+	return ""
 }
 
-type ResolveExecutionErrorsResponse struct {
+type RequeueDeadLetteredExecutionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetter    *DeadLetteredExecution `protobuf:"bytes,1,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResolveExecutionErrorsResponse) Reset() {
-	*x = ResolveExecutionErrorsResponse{}
-	mi := &file_canvases_proto_msgTypes[41]
+func (x *RequeueDeadLetteredExecutionResponse) Reset() {
+	*x = RequeueDeadLetteredExecutionResponse{}
+	mi := &file_canvases_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveExecutionErrorsResponse) String() string {
+func (x *RequeueDeadLetteredExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveExecutionErrorsResponse) ProtoMessage() {}
+func (*RequeueDeadLetteredExecutionResponse) ProtoMessage() {}
 
-func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[41]
+func (x *RequeueDeadLetteredExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2630,9 +3727,16 @@ func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveExecutionErrorsResponse.ProtoReflect.Descriptor instead.
-func (*ResolveExecutionErrorsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use RequeueDeadLetteredExecutionResponse.ProtoReflect.Descriptor instead.
+func (*RequeueDeadLetteredExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RequeueDeadLetteredExecutionResponse) GetDeadLetter() *DeadLetteredExecution {
+	if x != nil {
+		return x.DeadLetter
+	}
+	return nil
 }
 
 type CanvasNodeEventMessage struct {
@@ -2647,7 +3751,7 @@ type CanvasNodeEventMessage struct {
 
 func (x *CanvasNodeEventMessage) Reset() {
 	*x = CanvasNodeEventMessage{}
-	mi := &file_canvases_proto_msgTypes[42]
+	mi := &file_canvases_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2659,7 +3763,7 @@ func (x *CanvasNodeEventMessage) String() string {
 func (*CanvasNodeEventMessage) ProtoMessage() {}
 
 func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[42]
+	mi := &file_canvases_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2672,7 +3776,7 @@ func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeEventMessage.ProtoReflect.Descriptor instead.
 func (*CanvasNodeEventMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{42}
+	return file_canvases_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *CanvasNodeEventMessage) GetId() string {
@@ -2715,7 +3819,7 @@ type CanvasNodeExecutionMessage struct {
 
 func (x *CanvasNodeExecutionMessage) Reset() {
 	*x = CanvasNodeExecutionMessage{}
-	mi := &file_canvases_proto_msgTypes[43]
+	mi := &file_canvases_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2727,7 +3831,7 @@ func (x *CanvasNodeExecutionMessage) String() string {
 func (*CanvasNodeExecutionMessage) ProtoMessage() {}
 
 func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[43]
+	mi := &file_canvases_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2740,7 +3844,7 @@ func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeExecutionMessage.ProtoReflect.Descriptor instead.
 func (*CanvasNodeExecutionMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{43}
+	return file_canvases_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *CanvasNodeExecutionMessage) GetId() string {
@@ -2783,7 +3887,7 @@ type CanvasNodeQueueItemMessage struct {
 
 func (x *CanvasNodeQueueItemMessage) Reset() {
 	*x = CanvasNodeQueueItemMessage{}
-	mi := &file_canvases_proto_msgTypes[44]
+	mi := &file_canvases_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2795,7 +3899,7 @@ func (x *CanvasNodeQueueItemMessage) String() string {
 func (*CanvasNodeQueueItemMessage) ProtoMessage() {}
 
 func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[44]
+	mi := &file_canvases_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2808,7 +3912,7 @@ func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeQueueItemMessage.ProtoReflect.Descriptor instead.
 func (*CanvasNodeQueueItemMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{44}
+	return file_canvases_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *CanvasNodeQueueItemMessage) GetId() string {
@@ -2855,7 +3959,7 @@ type Canvas_Metadata struct {
 
 func (x *Canvas_Metadata) Reset() {
 	*x = Canvas_Metadata{}
-	mi := &file_canvases_proto_msgTypes[45]
+	mi := &file_canvases_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2867,7 +3971,7 @@ func (x *Canvas_Metadata) String() string {
 func (*Canvas_Metadata) ProtoMessage() {}
 
 func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[45]
+	mi := &file_canvases_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2949,7 +4053,7 @@ type Canvas_Spec struct {
 
 func (x *Canvas_Spec) Reset() {
 	*x = Canvas_Spec{}
-	mi := &file_canvases_proto_msgTypes[46]
+	mi := &file_canvases_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2961,7 +4065,7 @@ func (x *Canvas_Spec) String() string {
 func (*Canvas_Spec) ProtoMessage() {}
 
 func (x *Canvas_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[46]
+	mi := &file_canvases_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3002,7 +4106,7 @@ type Canvas_Status struct {
 
 func (x *Canvas_Status) Reset() {
 	*x = Canvas_Status{}
-	mi := &file_canvases_proto_msgTypes[47]
+	mi := &file_canvases_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3014,7 +4118,7 @@ func (x *Canvas_Status) String() string {
 func (*Canvas_Status) ProtoMessage() {}
 
 func (x *Canvas_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[47]
+	mi := &file_canvases_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3158,7 +4262,44 @@ const file_canvases_proto_rawDesc = "" +
 	"\vtotal_count\x18\x02 \x01(\rR\n" +
 	"totalCount\x12\"\n" +
 	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\x12A\n" +
-	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"\\\n" +
+	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"s\n" +
+	"\x14GetNodeStatusRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12%\n" +
+	"\x0ewindow_seconds\x18\x03 \x01(\rR\rwindowSeconds\"P\n" +
+	"\x15GetNodeStatusResponse\x127\n" +
+	"\x06status\x18\x01 \x01(\v2\x1f.Superplane.Canvases.NodeStatusR\x06status\"x\n" +
+	"\x17ListNodeStatusesRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x19\n" +
+	"\bnode_ids\x18\x02 \x03(\tR\anodeIds\x12%\n" +
+	"\x0ewindow_seconds\x18\x03 \x01(\rR\rwindowSeconds\"W\n" +
+	"\x18ListNodeStatusesResponse\x12;\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x1f.Superplane.Canvases.NodeStatusR\bstatuses\"\xf1\x02\n" +
+	"\n" +
+	"NodeStatus\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06paused\x18\x02 \x01(\bR\x06paused\x12\x1f\n" +
+	"\vqueue_depth\x18\x03 \x01(\rR\n" +
+	"queueDepth\x12S\n" +
+	"\x10execution_counts\x18\x04 \x01(\v2(.Superplane.Canvases.NodeExecutionCountsR\x0fexecutionCounts\x12O\n" +
+	"\x0elast_execution\x18\x05 \x01(\v2(.Superplane.Canvases.NodeStatusExecutionR\rlastExecution\x12,\n" +
+	"\x12last_error_message\x18\x06 \x01(\tR\x10lastErrorMessage\x12=\n" +
+	"\fwindow_start\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\"\x97\x01\n" +
+	"\x13NodeExecutionCounts\x12\x18\n" +
+	"\apending\x18\x01 \x01(\rR\apending\x12\x18\n" +
+	"\astarted\x18\x02 \x01(\rR\astarted\x12\x16\n" +
+	"\x06passed\x18\x03 \x01(\rR\x06passed\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\rR\x06failed\x12\x1c\n" +
+	"\tcancelled\x18\x05 \x01(\rR\tcancelled\"\x86\x03\n" +
+	"\x13NodeStatusExecution\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12D\n" +
+	"\x05state\x18\x02 \x01(\x0e2..Superplane.Canvases.CanvasNodeExecution.StateR\x05state\x12G\n" +
+	"\x06result\x18\x03 \x01(\x0e2/.Superplane.Canvases.CanvasNodeExecution.ResultR\x06result\x12Z\n" +
+	"\rresult_reason\x18\x04 \x01(\x0e25.Superplane.Canvases.CanvasNodeExecution.ResultReasonR\fresultReason\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\\\n" +
 	"\x1aListChildExecutionsRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
 	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"g\n" +
@@ -3231,17 +4372,31 @@ const file_canvases_proto_rawDesc = "" +
 	"parameters\x18\x04 \x01(\v2\x17.google.protobuf.StructR\n" +
 	"parameters\"R\n" +
 	"\x1fInvokeNodeTriggerActionResponse\x12/\n" +
-	"\x06result\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x06result\"\x80\x01\n" +
+	"\x06result\x18\x01 \x01(\v2\x17.google.protobuf.StructR\x06result\"\xbf\x02\n" +
 	"\x17ListCanvasEventsRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\rR\x05limit\x122\n" +
-	"\x06before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"\xea\x01\n" +
+	"\x06before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x12\x17\n" +
+	"\anode_id\x18\x04 \x01(\tR\x06nodeId\x12\x18\n" +
+	"\achannel\x18\x05 \x01(\tR\achannel\x120\n" +
+	"\x05after\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05after\x12!\n" +
+	"\fpayload_type\x18\a \x01(\tR\vpayloadType\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"count_only\x18\t \x01(\bR\tcountOnly\"\xd7\x02\n" +
 	"\x18ListCanvasEventsResponse\x12F\n" +
 	"\x06events\x18\x01 \x03(\v2..Superplane.Canvases.CanvasEventWithExecutionsR\x06events\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\rR\n" +
 	"totalCount\x12\"\n" +
 	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\x12A\n" +
-	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"\xf6\x01\n" +
+	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\x12J\n" +
+	"\vnode_counts\x18\x06 \x03(\v2).Superplane.Canvases.CanvasEventNodeCountR\n" +
+	"nodeCounts\"E\n" +
+	"\x14CanvasEventNodeCount\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\"\xf6\x01\n" +
 	"\vCanvasEvent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
@@ -3275,11 +4430,54 @@ const file_canvases_proto_rawDesc = "" +
 	"\x16CancelExecutionRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
 	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"\x19\n" +
-	"\x17CancelExecutionResponse\"a\n" +
+	"\x17CancelExecutionResponse\"Z\n" +
+	"\x18ListExecutionLogsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"R\n" +
+	"\x19ListExecutionLogsResponse\x125\n" +
+	"\x04logs\x18\x01 \x03(\v2!.Superplane.Canvases.ExecutionLogR\x04logs\"\xb9\x01\n" +
+	"\fExecutionLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12/\n" +
+	"\x06fields\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x06fields\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"a\n" +
 	"\x1dResolveExecutionErrorsRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12#\n" +
 	"\rexecution_ids\x18\x02 \x03(\tR\fexecutionIds\" \n" +
-	"\x1eResolveExecutionErrorsResponse\"\x98\x01\n" +
+	"\x1eResolveExecutionErrorsResponse\"\xc6\x04\n" +
+	"\x15DeadLetteredExecution\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12!\n" +
+	"\fexecution_id\x18\x04 \x01(\tR\vexecutionId\x12\"\n" +
+	"\rroot_event_id\x18\x05 \x01(\tR\vrootEventId\x12\x19\n" +
+	"\bevent_id\x18\x06 \x01(\tR\aeventId\x12\x1f\n" +
+	"\vconfig_hash\x18\a \x01(\tR\n" +
+	"configHash\x12=\n" +
+	"\rconfiguration\x18\b \x01(\v2\x17.google.protobuf.StructR\rconfiguration\x12-\n" +
+	"\x05input\x18\t \x01(\v2\x17.google.protobuf.StructR\x05input\x12#\n" +
+	"\rresult_reason\x18\n" +
+	" \x01(\tR\fresultReason\x12%\n" +
+	"\x0eresult_message\x18\v \x01(\tR\rresultMessage\x122\n" +
+	"\x15requeued_execution_id\x18\f \x01(\tR\x13requeuedExecutionId\x12;\n" +
+	"\vrequeued_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"requeuedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9a\x01\n" +
+	"!ListDeadLetteredExecutionsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12)\n" +
+	"\x10include_requeued\x18\x03 \x01(\bR\x0fincludeRequeued\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\rR\x05limit\"s\n" +
+	"\"ListDeadLetteredExecutionsResponse\x12M\n" +
+	"\fdead_letters\x18\x01 \x03(\v2*.Superplane.Canvases.DeadLetteredExecutionR\vdeadLetters\"h\n" +
+	"#RequeueDeadLetteredExecutionRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12$\n" +
+	"\x0edead_letter_id\x18\x02 \x01(\tR\fdeadLetterId\"s\n" +
+	"$RequeueDeadLetteredExecutionResponse\x12K\n" +
+	"\vdead_letter\x18\x01 \x01(\v2*.Superplane.Canvases.DeadLetteredExecutionR\n" +
+	"deadLetter\"\x98\x01\n" +
 	"\x16CanvasNodeEventMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
@@ -3294,7 +4492,7 @@ const file_canvases_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
 	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x128\n" +
-	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xb7$\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xb51\n" +
 	"\bCanvases\x12\xb7\x01\n" +
 	"\fListCanvases\x12(.Superplane.Canvases.ListCanvasesRequest\x1a).Superplane.Canvases.ListCanvasesResponse\"R\x92A7\n" +
 	"\x06Canvas\x12\rList canvases\x1a\x1eReturns a list of all canvases\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/canvases\x12\xb0\x01\n" +
@@ -3317,7 +4515,13 @@ const file_canvases_proto_rawDesc = "" +
 	"CanvasNode\x12\x1fPause or resume node processing\x1aWPauses or resumes processing for a canvas node while continuing to queue incoming items\x82\xd3\xe4\x93\x027:\x01*22/api/v1/canvases/{canvas_id}/nodes/{node_id}/pause\x12\x95\x02\n" +
 	"\x12ListNodeExecutions\x12..Superplane.Canvases.ListNodeExecutionsRequest\x1a/.Superplane.Canvases.ListNodeExecutionsResponse\"\x9d\x01\x92A[\n" +
 	"\n" +
-	"CanvasNode\x12\x14List node executions\x1a7Returns a list of executions for a specific canvas node\x82\xd3\xe4\x93\x029\x127/api/v1/canvases/{canvas_id}/nodes/{node_id}/executions\x12\xfd\x01\n" +
+	"CanvasNode\x12\x14List node executions\x1a7Returns a list of executions for a specific canvas node\x82\xd3\xe4\x93\x029\x127/api/v1/canvases/{canvas_id}/nodes/{node_id}/executions\x12\x98\x02\n" +
+	"\rGetNodeStatus\x12).Superplane.Canvases.GetNodeStatusRequest\x1a*.Superplane.Canvases.GetNodeStatusResponse\"\xaf\x01\x92Aq\n" +
+	"\n" +
+	"CanvasNode\x12\x0fGet node status\x1aRReturns a summary of the recent executions, queue and pause state of a canvas node\x82\xd3\xe4\x93\x025\x123/api/v1/canvases/{canvas_id}/nodes/{node_id}/status\x12\xb5\x02\n" +
+	"\x10ListNodeStatuses\x12,.Superplane.Canvases.ListNodeStatusesRequest\x1a-.Superplane.Canvases.ListNodeStatusesResponse\"\xc3\x01\x92A\x8d\x01\n" +
+	"\n" +
+	"CanvasNode\x12\x12List node statuses\x1akReturns the status summary of multiple canvas nodes, or of all nodes in the canvas if no node IDs are given\x82\xd3\xe4\x93\x02,\x12*/api/v1/canvases/{canvas_id}/node-statuses\x12\xfd\x01\n" +
 	"\x0eListNodeEvents\x12*.Superplane.Canvases.ListNodeEventsRequest\x1a+.Superplane.Canvases.ListNodeEventsResponse\"\x91\x01\x92AS\n" +
 	"\n" +
 	"CanvasNode\x12\x10List node events\x1a3Returns a list of events for a specific canvas node\x82\xd3\xe4\x93\x025\x123/api/v1/canvases/{canvas_id}/nodes/{node_id}/events\x12\xfc\x01\n" +
@@ -3332,11 +4536,17 @@ const file_canvases_proto_rawDesc = "" +
 	"\x13ListChildExecutions\x12/.Superplane.Canvases.ListChildExecutionsRequest\x1a0.Superplane.Canvases.ListChildExecutionsResponse\"\xb2\x01\x92Ae\n" +
 	"\x13CanvasNodeExecution\x12&List child executions for an execution\x1a&List child executions for an execution\x82\xd3\xe4\x93\x02D:\x01*\"?/api/v1/canvases/{canvas_id}/executions/{execution_id}/children\x12\x8a\x02\n" +
 	"\x0fCancelExecution\x12+.Superplane.Canvases.CancelExecutionRequest\x1a,.Superplane.Canvases.CancelExecutionResponse\"\x9b\x01\x92AP\n" +
-	"\x13CanvasNodeExecution\x12\x10Cancel execution\x1a'Cancels a running canvas node execution\x82\xd3\xe4\x93\x02B:\x01*2=/api/v1/canvases/{canvas_id}/executions/{execution_id}/cancel\x12\xa0\x02\n" +
+	"\x13CanvasNodeExecution\x12\x10Cancel execution\x1a'Cancels a running canvas node execution\x82\xd3\xe4\x93\x02B:\x01*2=/api/v1/canvases/{canvas_id}/executions/{execution_id}/cancel\x12\xad\x02\n" +
+	"\x11ListExecutionLogs\x12-.Superplane.Canvases.ListExecutionLogsRequest\x1a..Superplane.Canvases.ListExecutionLogsResponse\"\xb8\x01\x92Ar\n" +
+	"\x13CanvasNodeExecution\x12\x13List execution logs\x1aFReturns the most recent log entries written by a canvas node execution\x82\xd3\xe4\x93\x02=\x12;/api/v1/canvases/{canvas_id}/executions/{execution_id}/logs\x12\xa0\x02\n" +
 	"\x16ResolveExecutionErrors\x122.Superplane.Canvases.ResolveExecutionErrorsRequest\x1a3.Superplane.Canvases.ResolveExecutionErrorsResponse\"\x9c\x01\x92A_\n" +
-	"\x13CanvasNodeExecution\x12\x18Resolve execution errors\x1a.Marks canvas node execution errors as resolved\x82\xd3\xe4\x93\x024:\x01*2//api/v1/canvases/{canvas_id}/executions/resolve\x12\x86\x02\n" +
-	"\x10ListCanvasEvents\x12,.Superplane.Canvases.ListCanvasEventsRequest\x1a-.Superplane.Canvases.ListCanvasEventsResponse\"\x94\x01\x92Af\n" +
-	"\vCanvasEvent\x12\x12List canvas events\x1aCReturns a list of root events that triggered executions in a canvas\x82\xd3\xe4\x93\x02%\x12#/api/v1/canvases/{canvas_id}/events\x12\xa4\x02\n" +
+	"\x13CanvasNodeExecution\x12\x18Resolve execution errors\x1a.Marks canvas node execution errors as resolved\x82\xd3\xe4\x93\x024:\x01*2//api/v1/canvases/{canvas_id}/executions/resolve\x12\xd6\x02\n" +
+	"\x1aListDeadLetteredExecutions\x126.Superplane.Canvases.ListDeadLetteredExecutionsRequest\x1a7.Superplane.Canvases.ListDeadLetteredExecutionsResponse\"\xc6\x01\x92A\x91\x01\n" +
+	"\x13CanvasNodeExecution\x12\x1dList dead-lettered executions\x1a[Returns the canvas node executions that failed permanently, with the context they failed in\x82\xd3\xe4\x93\x02+\x12)/api/v1/canvases/{canvas_id}/dead-letters\x12\xff\x02\n" +
+	"\x1cRequeueDeadLetteredExecution\x128.Superplane.Canvases.RequeueDeadLetteredExecutionRequest\x1a9.Superplane.Canvases.RequeueDeadLetteredExecutionResponse\"\xe9\x01\x92A\x98\x01\n" +
+	"\x13CanvasNodeExecution\x12\"Re-enqueue dead-lettered execution\x1a]Creates a new pending execution with the input and configuration of a dead-lettered execution\x82\xd3\xe4\x93\x02G:\x01*\"B/api/v1/canvases/{canvas_id}/dead-letters/{dead_letter_id}/requeue\x12\xa6\x02\n" +
+	"\x10ListCanvasEvents\x12,.Superplane.Canvases.ListCanvasEventsRequest\x1a-.Superplane.Canvases.ListCanvasEventsResponse\"\xb4\x01\x92A\x85\x01\n" +
+	"\vCanvasEvent\x12\x12List canvas events\x1abReturns a list of root events that triggered executions in a canvas, or only their counts per node\x82\xd3\xe4\x93\x02%\x12#/api/v1/canvases/{canvas_id}/events\x12\xa4\x02\n" +
 	"\x13ListEventExecutions\x12/.Superplane.Canvases.ListEventExecutionsRequest\x1a0.Superplane.Canvases.ListEventExecutionsResponse\"\xa9\x01\x92Ae\n" +
 	"\vCanvasEvent\x12\x15List event executions\x1a?Returns a list of all node executions triggered by a root event\x82\xd3\xe4\x93\x02;\x129/api/v1/canvases/{canvas_id}/events/{event_id}/executionsB\xc8\x01\x92A\x8c\x01\x12b\n" +
 	"\x17Superplane Canvases API\x12\x1bAPI for Superplane canvases\"%\n" +
@@ -3355,167 +4565,214 @@ func file_canvases_proto_rawDescGZIP() []byte {
 }
 
 var file_canvases_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_canvases_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_canvases_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_canvases_proto_goTypes = []any{
-	(CanvasNodeExecution_State)(0),            // 0: Superplane.Canvases.CanvasNodeExecution.State
-	(CanvasNodeExecution_Result)(0),           // 1: Superplane.Canvases.CanvasNodeExecution.Result
-	(CanvasNodeExecution_ResultReason)(0),     // 2: Superplane.Canvases.CanvasNodeExecution.ResultReason
-	(*ListCanvasesRequest)(nil),               // 3: Superplane.Canvases.ListCanvasesRequest
-	(*ListCanvasesResponse)(nil),              // 4: Superplane.Canvases.ListCanvasesResponse
-	(*DescribeCanvasRequest)(nil),             // 5: Superplane.Canvases.DescribeCanvasRequest
-	(*DescribeCanvasResponse)(nil),            // 6: Superplane.Canvases.DescribeCanvasResponse
-	(*CreateCanvasRequest)(nil),               // 7: Superplane.Canvases.CreateCanvasRequest
-	(*CreateCanvasResponse)(nil),              // 8: Superplane.Canvases.CreateCanvasResponse
-	(*UpdateCanvasRequest)(nil),               // 9: Superplane.Canvases.UpdateCanvasRequest
-	(*UpdateCanvasResponse)(nil),              // 10: Superplane.Canvases.UpdateCanvasResponse
-	(*DeleteCanvasRequest)(nil),               // 11: Superplane.Canvases.DeleteCanvasRequest
-	(*DeleteCanvasResponse)(nil),              // 12: Superplane.Canvases.DeleteCanvasResponse
-	(*UserRef)(nil),                           // 13: Superplane.Canvases.UserRef
-	(*Canvas)(nil),                            // 14: Superplane.Canvases.Canvas
-	(*ListNodeEventsRequest)(nil),             // 15: Superplane.Canvases.ListNodeEventsRequest
-	(*ListNodeEventsResponse)(nil),            // 16: Superplane.Canvases.ListNodeEventsResponse
-	(*EmitNodeEventRequest)(nil),              // 17: Superplane.Canvases.EmitNodeEventRequest
-	(*EmitNodeEventResponse)(nil),             // 18: Superplane.Canvases.EmitNodeEventResponse
-	(*ListNodeQueueItemsRequest)(nil),         // 19: Superplane.Canvases.ListNodeQueueItemsRequest
-	(*ListNodeQueueItemsResponse)(nil),        // 20: Superplane.Canvases.ListNodeQueueItemsResponse
-	(*DeleteNodeQueueItemRequest)(nil),        // 21: Superplane.Canvases.DeleteNodeQueueItemRequest
-	(*DeleteNodeQueueItemResponse)(nil),       // 22: Superplane.Canvases.DeleteNodeQueueItemResponse
-	(*UpdateNodePauseRequest)(nil),            // 23: Superplane.Canvases.UpdateNodePauseRequest
-	(*UpdateNodePauseResponse)(nil),           // 24: Superplane.Canvases.UpdateNodePauseResponse
-	(*ListNodeExecutionsRequest)(nil),         // 25: Superplane.Canvases.ListNodeExecutionsRequest
-	(*ListNodeExecutionsResponse)(nil),        // 26: Superplane.Canvases.ListNodeExecutionsResponse
-	(*ListChildExecutionsRequest)(nil),        // 27: Superplane.Canvases.ListChildExecutionsRequest
-	(*ListChildExecutionsResponse)(nil),       // 28: Superplane.Canvases.ListChildExecutionsResponse
-	(*CanvasNodeExecution)(nil),               // 29: Superplane.Canvases.CanvasNodeExecution
-	(*CanvasNodeQueueItem)(nil),               // 30: Superplane.Canvases.CanvasNodeQueueItem
-	(*InvokeNodeExecutionActionRequest)(nil),  // 31: Superplane.Canvases.InvokeNodeExecutionActionRequest
-	(*InvokeNodeExecutionActionResponse)(nil), // 32: Superplane.Canvases.InvokeNodeExecutionActionResponse
-	(*InvokeNodeTriggerActionRequest)(nil),    // 33: Superplane.Canvases.InvokeNodeTriggerActionRequest
-	(*InvokeNodeTriggerActionResponse)(nil),   // 34: Superplane.Canvases.InvokeNodeTriggerActionResponse
-	(*ListCanvasEventsRequest)(nil),           // 35: Superplane.Canvases.ListCanvasEventsRequest
-	(*ListCanvasEventsResponse)(nil),          // 36: Superplane.Canvases.ListCanvasEventsResponse
-	(*CanvasEvent)(nil),                       // 37: Superplane.Canvases.CanvasEvent
-	(*CanvasEventWithExecutions)(nil),         // 38: Superplane.Canvases.CanvasEventWithExecutions
-	(*ListEventExecutionsRequest)(nil),        // 39: Superplane.Canvases.ListEventExecutionsRequest
-	(*ListEventExecutionsResponse)(nil),       // 40: Superplane.Canvases.ListEventExecutionsResponse
-	(*CancelExecutionRequest)(nil),            // 41: Superplane.Canvases.CancelExecutionRequest
-	(*CancelExecutionResponse)(nil),           // 42: Superplane.Canvases.CancelExecutionResponse
-	(*ResolveExecutionErrorsRequest)(nil),     // 43: Superplane.Canvases.ResolveExecutionErrorsRequest
-	(*ResolveExecutionErrorsResponse)(nil),    // 44: Superplane.Canvases.ResolveExecutionErrorsResponse
-	(*CanvasNodeEventMessage)(nil),            // 45: Superplane.Canvases.CanvasNodeEventMessage
-	(*CanvasNodeExecutionMessage)(nil),        // 46: Superplane.Canvases.CanvasNodeExecutionMessage
-	(*CanvasNodeQueueItemMessage)(nil),        // 47: Superplane.Canvases.CanvasNodeQueueItemMessage
-	(*Canvas_Metadata)(nil),                   // 48: Superplane.Canvases.Canvas.Metadata
-	(*Canvas_Spec)(nil),                       // 49: Superplane.Canvases.Canvas.Spec
-	(*Canvas_Status)(nil),                     // 50: Superplane.Canvases.Canvas.Status
-	(*timestamp.Timestamp)(nil),               // 51: google.protobuf.Timestamp
-	(*_struct.Struct)(nil),                    // 52: google.protobuf.Struct
-	(*components.Node)(nil),                   // 53: Superplane.Components.Node
-	(*components.Edge)(nil),                   // 54: Superplane.Components.Edge
+	(CanvasNodeExecution_State)(0),               // 0: Superplane.Canvases.CanvasNodeExecution.State
+	(CanvasNodeExecution_Result)(0),              // 1: Superplane.Canvases.CanvasNodeExecution.Result
+	(CanvasNodeExecution_ResultReason)(0),        // 2: Superplane.Canvases.CanvasNodeExecution.ResultReason
+	(*ListCanvasesRequest)(nil),                  // 3: Superplane.Canvases.ListCanvasesRequest
+	(*ListCanvasesResponse)(nil),                 // 4: Superplane.Canvases.ListCanvasesResponse
+	(*DescribeCanvasRequest)(nil),                // 5: Superplane.Canvases.DescribeCanvasRequest
+	(*DescribeCanvasResponse)(nil),               // 6: Superplane.Canvases.DescribeCanvasResponse
+	(*CreateCanvasRequest)(nil),                  // 7: Superplane.Canvases.CreateCanvasRequest
+	(*CreateCanvasResponse)(nil),                 // 8: Superplane.Canvases.CreateCanvasResponse
+	(*UpdateCanvasRequest)(nil),                  // 9: Superplane.Canvases.UpdateCanvasRequest
+	(*UpdateCanvasResponse)(nil),                 // 10: Superplane.Canvases.UpdateCanvasResponse
+	(*DeleteCanvasRequest)(nil),                  // 11: Superplane.Canvases.DeleteCanvasRequest
+	(*DeleteCanvasResponse)(nil),                 // 12: Superplane.Canvases.DeleteCanvasResponse
+	(*UserRef)(nil),                              // 13: Superplane.Canvases.UserRef
+	(*Canvas)(nil),                               // 14: Superplane.Canvases.Canvas
+	(*ListNodeEventsRequest)(nil),                // 15: Superplane.Canvases.ListNodeEventsRequest
+	(*ListNodeEventsResponse)(nil),               // 16: Superplane.Canvases.ListNodeEventsResponse
+	(*EmitNodeEventRequest)(nil),                 // 17: Superplane.Canvases.EmitNodeEventRequest
+	(*EmitNodeEventResponse)(nil),                // 18: Superplane.Canvases.EmitNodeEventResponse
+	(*ListNodeQueueItemsRequest)(nil),            // 19: Superplane.Canvases.ListNodeQueueItemsRequest
+	(*ListNodeQueueItemsResponse)(nil),           // 20: Superplane.Canvases.ListNodeQueueItemsResponse
+	(*DeleteNodeQueueItemRequest)(nil),           // 21: Superplane.Canvases.DeleteNodeQueueItemRequest
+	(*DeleteNodeQueueItemResponse)(nil),          // 22: Superplane.Canvases.DeleteNodeQueueItemResponse
+	(*UpdateNodePauseRequest)(nil),               // 23: Superplane.Canvases.UpdateNodePauseRequest
+	(*UpdateNodePauseResponse)(nil),              // 24: Superplane.Canvases.UpdateNodePauseResponse
+	(*ListNodeExecutionsRequest)(nil),            // 25: Superplane.Canvases.ListNodeExecutionsRequest
+	(*ListNodeExecutionsResponse)(nil),           // 26: Superplane.Canvases.ListNodeExecutionsResponse
+	(*GetNodeStatusRequest)(nil),                 // 27: Superplane.Canvases.GetNodeStatusRequest
+	(*GetNodeStatusResponse)(nil),                // 28: Superplane.Canvases.GetNodeStatusResponse
+	(*ListNodeStatusesRequest)(nil),              // 29: Superplane.Canvases.ListNodeStatusesRequest
+	(*ListNodeStatusesResponse)(nil),             // 30: Superplane.Canvases.ListNodeStatusesResponse
+	(*NodeStatus)(nil),                           // 31: Superplane.Canvases.NodeStatus
+	(*NodeExecutionCounts)(nil),                  // 32: Superplane.Canvases.NodeExecutionCounts
+	(*NodeStatusExecution)(nil),                  // 33: Superplane.Canvases.NodeStatusExecution
+	(*ListChildExecutionsRequest)(nil),           // 34: Superplane.Canvases.ListChildExecutionsRequest
+	(*ListChildExecutionsResponse)(nil),          // 35: Superplane.Canvases.ListChildExecutionsResponse
+	(*CanvasNodeExecution)(nil),                  // 36: Superplane.Canvases.CanvasNodeExecution
+	(*CanvasNodeQueueItem)(nil),                  // 37: Superplane.Canvases.CanvasNodeQueueItem
+	(*InvokeNodeExecutionActionRequest)(nil),     // 38: Superplane.Canvases.InvokeNodeExecutionActionRequest
+	(*InvokeNodeExecutionActionResponse)(nil),    // 39: Superplane.Canvases.InvokeNodeExecutionActionResponse
+	(*InvokeNodeTriggerActionRequest)(nil),       // 40: Superplane.Canvases.InvokeNodeTriggerActionRequest
+	(*InvokeNodeTriggerActionResponse)(nil),      // 41: Superplane.Canvases.InvokeNodeTriggerActionResponse
+	(*ListCanvasEventsRequest)(nil),              // 42: Superplane.Canvases.ListCanvasEventsRequest
+	(*ListCanvasEventsResponse)(nil),             // 43: Superplane.Canvases.ListCanvasEventsResponse
+	(*CanvasEventNodeCount)(nil),                 // 44: Superplane.Canvases.CanvasEventNodeCount
+	(*CanvasEvent)(nil),                          // 45: Superplane.Canvases.CanvasEvent
+	(*CanvasEventWithExecutions)(nil),            // 46: Superplane.Canvases.CanvasEventWithExecutions
+	(*ListEventExecutionsRequest)(nil),           // 47: Superplane.Canvases.ListEventExecutionsRequest
+	(*ListEventExecutionsResponse)(nil),          // 48: Superplane.Canvases.ListEventExecutionsResponse
+	(*CancelExecutionRequest)(nil),               // 49: Superplane.Canvases.CancelExecutionRequest
+	(*CancelExecutionResponse)(nil),              // 50: Superplane.Canvases.CancelExecutionResponse
+	(*ListExecutionLogsRequest)(nil),             // 51: Superplane.Canvases.ListExecutionLogsRequest
+	(*ListExecutionLogsResponse)(nil),            // 52: Superplane.Canvases.ListExecutionLogsResponse
+	(*ExecutionLog)(nil),                         // 53: Superplane.Canvases.ExecutionLog
+	(*ResolveExecutionErrorsRequest)(nil),        // 54: Superplane.Canvases.ResolveExecutionErrorsRequest
+	(*ResolveExecutionErrorsResponse)(nil),       // 55: Superplane.Canvases.ResolveExecutionErrorsResponse
+	(*DeadLetteredExecution)(nil),                // 56: Superplane.Canvases.DeadLetteredExecution
+	(*ListDeadLetteredExecutionsRequest)(nil),    // 57: Superplane.Canvases.ListDeadLetteredExecutionsRequest
+	(*ListDeadLetteredExecutionsResponse)(nil),   // 58: Superplane.Canvases.ListDeadLetteredExecutionsResponse
+	(*RequeueDeadLetteredExecutionRequest)(nil),  // 59: Superplane.Canvases.RequeueDeadLetteredExecutionRequest
+	(*RequeueDeadLetteredExecutionResponse)(nil), // 60: Superplane.Canvases.RequeueDeadLetteredExecutionResponse
+	(*CanvasNodeEventMessage)(nil),               // 61: Superplane.Canvases.CanvasNodeEventMessage
+	(*CanvasNodeExecutionMessage)(nil),           // 62: Superplane.Canvases.CanvasNodeExecutionMessage
+	(*CanvasNodeQueueItemMessage)(nil),           // 63: Superplane.Canvases.CanvasNodeQueueItemMessage
+	(*Canvas_Metadata)(nil),                      // 64: Superplane.Canvases.Canvas.Metadata
+	(*Canvas_Spec)(nil),                          // 65: Superplane.Canvases.Canvas.Spec
+	(*Canvas_Status)(nil),                        // 66: Superplane.Canvases.Canvas.Status
+	(*timestamp.Timestamp)(nil),                  // 67: google.protobuf.Timestamp
+	(*_struct.Struct)(nil),                       // 68: google.protobuf.Struct
+	(*components.Node)(nil),                      // 69: Superplane.Components.Node
+	(*components.Edge)(nil),                      // 70: Superplane.Components.Edge
 }
 var file_canvases_proto_depIdxs = []int32{
-	14, // 0: Superplane.Canvases.ListCanvasesResponse.canvases:type_name -> Superplane.Canvases.Canvas
-	14, // 1: Superplane.Canvases.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	14, // 2: Superplane.Canvases.CreateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	14, // 3: Superplane.Canvases.CreateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	14, // 4: Superplane.Canvases.UpdateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	14, // 5: Superplane.Canvases.UpdateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	48, // 6: Superplane.Canvases.Canvas.metadata:type_name -> Superplane.Canvases.Canvas.Metadata
-	49, // 7: Superplane.Canvases.Canvas.spec:type_name -> Superplane.Canvases.Canvas.Spec
-	50, // 8: Superplane.Canvases.Canvas.status:type_name -> Superplane.Canvases.Canvas.Status
-	51, // 9: Superplane.Canvases.ListNodeEventsRequest.before:type_name -> google.protobuf.Timestamp
-	37, // 10: Superplane.Canvases.ListNodeEventsResponse.events:type_name -> Superplane.Canvases.CanvasEvent
-	51, // 11: Superplane.Canvases.ListNodeEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	52, // 12: Superplane.Canvases.EmitNodeEventRequest.data:type_name -> google.protobuf.Struct
-	51, // 13: Superplane.Canvases.ListNodeQueueItemsRequest.before:type_name -> google.protobuf.Timestamp
-	30, // 14: Superplane.Canvases.ListNodeQueueItemsResponse.items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
-	51, // 15: Superplane.Canvases.ListNodeQueueItemsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	53, // 16: Superplane.Canvases.UpdateNodePauseResponse.node:type_name -> Superplane.Components.Node
-	0,  // 17: Superplane.Canvases.ListNodeExecutionsRequest.states:type_name -> Superplane.Canvases.CanvasNodeExecution.State
-	1,  // 18: Superplane.Canvases.ListNodeExecutionsRequest.results:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
-	51, // 19: Superplane.Canvases.ListNodeExecutionsRequest.before:type_name -> google.protobuf.Timestamp
-	29, // 20: Superplane.Canvases.ListNodeExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	51, // 21: Superplane.Canvases.ListNodeExecutionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	29, // 22: Superplane.Canvases.ListChildExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	0,  // 23: Superplane.Canvases.CanvasNodeExecution.state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
-	1,  // 24: Superplane.Canvases.CanvasNodeExecution.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
-	2,  // 25: Superplane.Canvases.CanvasNodeExecution.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
-	52, // 26: Superplane.Canvases.CanvasNodeExecution.input:type_name -> google.protobuf.Struct
-	52, // 27: Superplane.Canvases.CanvasNodeExecution.outputs:type_name -> google.protobuf.Struct
-	51, // 28: Superplane.Canvases.CanvasNodeExecution.created_at:type_name -> google.protobuf.Timestamp
-	51, // 29: Superplane.Canvases.CanvasNodeExecution.updated_at:type_name -> google.protobuf.Timestamp
-	52, // 30: Superplane.Canvases.CanvasNodeExecution.metadata:type_name -> google.protobuf.Struct
-	52, // 31: Superplane.Canvases.CanvasNodeExecution.configuration:type_name -> google.protobuf.Struct
-	29, // 32: Superplane.Canvases.CanvasNodeExecution.child_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	37, // 33: Superplane.Canvases.CanvasNodeExecution.root_event:type_name -> Superplane.Canvases.CanvasEvent
-	13, // 34: Superplane.Canvases.CanvasNodeExecution.cancelled_by:type_name -> Superplane.Canvases.UserRef
-	52, // 35: Superplane.Canvases.CanvasNodeQueueItem.input:type_name -> google.protobuf.Struct
-	37, // 36: Superplane.Canvases.CanvasNodeQueueItem.root_event:type_name -> Superplane.Canvases.CanvasEvent
-	51, // 37: Superplane.Canvases.CanvasNodeQueueItem.created_at:type_name -> google.protobuf.Timestamp
-	52, // 38: Superplane.Canvases.InvokeNodeExecutionActionRequest.parameters:type_name -> google.protobuf.Struct
-	52, // 39: Superplane.Canvases.InvokeNodeTriggerActionRequest.parameters:type_name -> google.protobuf.Struct
-	52, // 40: Superplane.Canvases.InvokeNodeTriggerActionResponse.result:type_name -> google.protobuf.Struct
-	51, // 41: Superplane.Canvases.ListCanvasEventsRequest.before:type_name -> google.protobuf.Timestamp
-	38, // 42: Superplane.Canvases.ListCanvasEventsResponse.events:type_name -> Superplane.Canvases.CanvasEventWithExecutions
-	51, // 43: Superplane.Canvases.ListCanvasEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	52, // 44: Superplane.Canvases.CanvasEvent.data:type_name -> google.protobuf.Struct
-	51, // 45: Superplane.Canvases.CanvasEvent.created_at:type_name -> google.protobuf.Timestamp
-	52, // 46: Superplane.Canvases.CanvasEventWithExecutions.data:type_name -> google.protobuf.Struct
-	51, // 47: Superplane.Canvases.CanvasEventWithExecutions.created_at:type_name -> google.protobuf.Timestamp
-	29, // 48: Superplane.Canvases.CanvasEventWithExecutions.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	29, // 49: Superplane.Canvases.ListEventExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	51, // 50: Superplane.Canvases.CanvasNodeEventMessage.timestamp:type_name -> google.protobuf.Timestamp
-	51, // 51: Superplane.Canvases.CanvasNodeExecutionMessage.timestamp:type_name -> google.protobuf.Timestamp
-	51, // 52: Superplane.Canvases.CanvasNodeQueueItemMessage.timestamp:type_name -> google.protobuf.Timestamp
-	51, // 53: Superplane.Canvases.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	51, // 54: Superplane.Canvases.Canvas.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 55: Superplane.Canvases.Canvas.Metadata.created_by:type_name -> Superplane.Canvases.UserRef
-	53, // 56: Superplane.Canvases.Canvas.Spec.nodes:type_name -> Superplane.Components.Node
-	54, // 57: Superplane.Canvases.Canvas.Spec.edges:type_name -> Superplane.Components.Edge
-	29, // 58: Superplane.Canvases.Canvas.Status.last_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	30, // 59: Superplane.Canvases.Canvas.Status.next_queue_items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
-	37, // 60: Superplane.Canvases.Canvas.Status.last_events:type_name -> Superplane.Canvases.CanvasEvent
-	3,  // 61: Superplane.Canvases.Canvases.ListCanvases:input_type -> Superplane.Canvases.ListCanvasesRequest
-	7,  // 62: Superplane.Canvases.Canvases.CreateCanvas:input_type -> Superplane.Canvases.CreateCanvasRequest
-	5,  // 63: Superplane.Canvases.Canvases.DescribeCanvas:input_type -> Superplane.Canvases.DescribeCanvasRequest
-	9,  // 64: Superplane.Canvases.Canvases.UpdateCanvas:input_type -> Superplane.Canvases.UpdateCanvasRequest
-	11, // 65: Superplane.Canvases.Canvases.DeleteCanvas:input_type -> Superplane.Canvases.DeleteCanvasRequest
-	19, // 66: Superplane.Canvases.Canvases.ListNodeQueueItems:input_type -> Superplane.Canvases.ListNodeQueueItemsRequest
-	21, // 67: Superplane.Canvases.Canvases.DeleteNodeQueueItem:input_type -> Superplane.Canvases.DeleteNodeQueueItemRequest
-	23, // 68: Superplane.Canvases.Canvases.UpdateNodePause:input_type -> Superplane.Canvases.UpdateNodePauseRequest
-	25, // 69: Superplane.Canvases.Canvases.ListNodeExecutions:input_type -> Superplane.Canvases.ListNodeExecutionsRequest
-	15, // 70: Superplane.Canvases.Canvases.ListNodeEvents:input_type -> Superplane.Canvases.ListNodeEventsRequest
-	17, // 71: Superplane.Canvases.Canvases.EmitNodeEvent:input_type -> Superplane.Canvases.EmitNodeEventRequest
-	31, // 72: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:input_type -> Superplane.Canvases.InvokeNodeExecutionActionRequest
-	33, // 73: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:input_type -> Superplane.Canvases.InvokeNodeTriggerActionRequest
-	27, // 74: Superplane.Canvases.Canvases.ListChildExecutions:input_type -> Superplane.Canvases.ListChildExecutionsRequest
-	41, // 75: Superplane.Canvases.Canvases.CancelExecution:input_type -> Superplane.Canvases.CancelExecutionRequest
-	43, // 76: Superplane.Canvases.Canvases.ResolveExecutionErrors:input_type -> Superplane.Canvases.ResolveExecutionErrorsRequest
-	35, // 77: Superplane.Canvases.Canvases.ListCanvasEvents:input_type -> Superplane.Canvases.ListCanvasEventsRequest
-	39, // 78: Superplane.Canvases.Canvases.ListEventExecutions:input_type -> Superplane.Canvases.ListEventExecutionsRequest
-	4,  // 79: Superplane.Canvases.Canvases.ListCanvases:output_type -> Superplane.Canvases.ListCanvasesResponse
-	8,  // 80: Superplane.Canvases.Canvases.CreateCanvas:output_type -> Superplane.Canvases.CreateCanvasResponse
-	6,  // 81: Superplane.Canvases.Canvases.DescribeCanvas:output_type -> Superplane.Canvases.DescribeCanvasResponse
-	10, // 82: Superplane.Canvases.Canvases.UpdateCanvas:output_type -> Superplane.Canvases.UpdateCanvasResponse
-	12, // 83: Superplane.Canvases.Canvases.DeleteCanvas:output_type -> Superplane.Canvases.DeleteCanvasResponse
-	20, // 84: Superplane.Canvases.Canvases.ListNodeQueueItems:output_type -> Superplane.Canvases.ListNodeQueueItemsResponse
-	22, // 85: Superplane.Canvases.Canvases.DeleteNodeQueueItem:output_type -> Superplane.Canvases.DeleteNodeQueueItemResponse
-	24, // 86: Superplane.Canvases.Canvases.UpdateNodePause:output_type -> Superplane.Canvases.UpdateNodePauseResponse
-	26, // 87: Superplane.Canvases.Canvases.ListNodeExecutions:output_type -> Superplane.Canvases.ListNodeExecutionsResponse
-	16, // 88: Superplane.Canvases.Canvases.ListNodeEvents:output_type -> Superplane.Canvases.ListNodeEventsResponse
-	18, // 89: Superplane.Canvases.Canvases.EmitNodeEvent:output_type -> Superplane.Canvases.EmitNodeEventResponse
-	32, // 90: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:output_type -> Superplane.Canvases.InvokeNodeExecutionActionResponse
-	34, // 91: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:output_type -> Superplane.Canvases.InvokeNodeTriggerActionResponse
-	28, // 92: Superplane.Canvases.Canvases.ListChildExecutions:output_type -> Superplane.Canvases.ListChildExecutionsResponse
-	42, // 93: Superplane.Canvases.Canvases.CancelExecution:output_type -> Superplane.Canvases.CancelExecutionResponse
-	44, // 94: Superplane.Canvases.Canvases.ResolveExecutionErrors:output_type -> Superplane.Canvases.ResolveExecutionErrorsResponse
-	36, // 95: Superplane.Canvases.Canvases.ListCanvasEvents:output_type -> Superplane.Canvases.ListCanvasEventsResponse
-	40, // 96: Superplane.Canvases.Canvases.ListEventExecutions:output_type -> Superplane.Canvases.ListEventExecutionsResponse
-	79, // [79:97] is the sub-list for method output_type
-	61, // [61:79] is the sub-list for method input_type
-	61, // [61:61] is the sub-list for extension type_name
-	61, // [61:61] is the sub-list for extension extendee
-	0,  // [0:61] is the sub-list for field type_name
+	14,  // 0: Superplane.Canvases.ListCanvasesResponse.canvases:type_name -> Superplane.Canvases.Canvas
+	14,  // 1: Superplane.Canvases.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	14,  // 2: Superplane.Canvases.CreateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	14,  // 3: Superplane.Canvases.CreateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	14,  // 4: Superplane.Canvases.UpdateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	14,  // 5: Superplane.Canvases.UpdateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	64,  // 6: Superplane.Canvases.Canvas.metadata:type_name -> Superplane.Canvases.Canvas.Metadata
+	65,  // 7: Superplane.Canvases.Canvas.spec:type_name -> Superplane.Canvases.Canvas.Spec
+	66,  // 8: Superplane.Canvases.Canvas.status:type_name -> Superplane.Canvases.Canvas.Status
+	67,  // 9: Superplane.Canvases.ListNodeEventsRequest.before:type_name -> google.protobuf.Timestamp
+	45,  // 10: Superplane.Canvases.ListNodeEventsResponse.events:type_name -> Superplane.Canvases.CanvasEvent
+	67,  // 11: Superplane.Canvases.ListNodeEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	68,  // 12: Superplane.Canvases.EmitNodeEventRequest.data:type_name -> google.protobuf.Struct
+	67,  // 13: Superplane.Canvases.ListNodeQueueItemsRequest.before:type_name -> google.protobuf.Timestamp
+	37,  // 14: Superplane.Canvases.ListNodeQueueItemsResponse.items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	67,  // 15: Superplane.Canvases.ListNodeQueueItemsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	69,  // 16: Superplane.Canvases.UpdateNodePauseResponse.node:type_name -> Superplane.Components.Node
+	0,   // 17: Superplane.Canvases.ListNodeExecutionsRequest.states:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	1,   // 18: Superplane.Canvases.ListNodeExecutionsRequest.results:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	67,  // 19: Superplane.Canvases.ListNodeExecutionsRequest.before:type_name -> google.protobuf.Timestamp
+	36,  // 20: Superplane.Canvases.ListNodeExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	67,  // 21: Superplane.Canvases.ListNodeExecutionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	31,  // 22: Superplane.Canvases.GetNodeStatusResponse.status:type_name -> Superplane.Canvases.NodeStatus
+	31,  // 23: Superplane.Canvases.ListNodeStatusesResponse.statuses:type_name -> Superplane.Canvases.NodeStatus
+	32,  // 24: Superplane.Canvases.NodeStatus.execution_counts:type_name -> Superplane.Canvases.NodeExecutionCounts
+	33,  // 25: Superplane.Canvases.NodeStatus.last_execution:type_name -> Superplane.Canvases.NodeStatusExecution
+	67,  // 26: Superplane.Canvases.NodeStatus.window_start:type_name -> google.protobuf.Timestamp
+	0,   // 27: Superplane.Canvases.NodeStatusExecution.state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	1,   // 28: Superplane.Canvases.NodeStatusExecution.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	2,   // 29: Superplane.Canvases.NodeStatusExecution.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
+	67,  // 30: Superplane.Canvases.NodeStatusExecution.created_at:type_name -> google.protobuf.Timestamp
+	67,  // 31: Superplane.Canvases.NodeStatusExecution.updated_at:type_name -> google.protobuf.Timestamp
+	36,  // 32: Superplane.Canvases.ListChildExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	0,   // 33: Superplane.Canvases.CanvasNodeExecution.state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	1,   // 34: Superplane.Canvases.CanvasNodeExecution.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	2,   // 35: Superplane.Canvases.CanvasNodeExecution.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
+	68,  // 36: Superplane.Canvases.CanvasNodeExecution.input:type_name -> google.protobuf.Struct
+	68,  // 37: Superplane.Canvases.CanvasNodeExecution.outputs:type_name -> google.protobuf.Struct
+	67,  // 38: Superplane.Canvases.CanvasNodeExecution.created_at:type_name -> google.protobuf.Timestamp
+	67,  // 39: Superplane.Canvases.CanvasNodeExecution.updated_at:type_name -> google.protobuf.Timestamp
+	68,  // 40: Superplane.Canvases.CanvasNodeExecution.metadata:type_name -> google.protobuf.Struct
+	68,  // 41: Superplane.Canvases.CanvasNodeExecution.configuration:type_name -> google.protobuf.Struct
+	36,  // 42: Superplane.Canvases.CanvasNodeExecution.child_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	45,  // 43: Superplane.Canvases.CanvasNodeExecution.root_event:type_name -> Superplane.Canvases.CanvasEvent
+	13,  // 44: Superplane.Canvases.CanvasNodeExecution.cancelled_by:type_name -> Superplane.Canvases.UserRef
+	68,  // 45: Superplane.Canvases.CanvasNodeQueueItem.input:type_name -> google.protobuf.Struct
+	45,  // 46: Superplane.Canvases.CanvasNodeQueueItem.root_event:type_name -> Superplane.Canvases.CanvasEvent
+	67,  // 47: Superplane.Canvases.CanvasNodeQueueItem.created_at:type_name -> google.protobuf.Timestamp
+	68,  // 48: Superplane.Canvases.InvokeNodeExecutionActionRequest.parameters:type_name -> google.protobuf.Struct
+	68,  // 49: Superplane.Canvases.InvokeNodeTriggerActionRequest.parameters:type_name -> google.protobuf.Struct
+	68,  // 50: Superplane.Canvases.InvokeNodeTriggerActionResponse.result:type_name -> google.protobuf.Struct
+	67,  // 51: Superplane.Canvases.ListCanvasEventsRequest.before:type_name -> google.protobuf.Timestamp
+	67,  // 52: Superplane.Canvases.ListCanvasEventsRequest.after:type_name -> google.protobuf.Timestamp
+	46,  // 53: Superplane.Canvases.ListCanvasEventsResponse.events:type_name -> Superplane.Canvases.CanvasEventWithExecutions
+	67,  // 54: Superplane.Canvases.ListCanvasEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	44,  // 55: Superplane.Canvases.ListCanvasEventsResponse.node_counts:type_name -> Superplane.Canvases.CanvasEventNodeCount
+	68,  // 56: Superplane.Canvases.CanvasEvent.data:type_name -> google.protobuf.Struct
+	67,  // 57: Superplane.Canvases.CanvasEvent.created_at:type_name -> google.protobuf.Timestamp
+	68,  // 58: Superplane.Canvases.CanvasEventWithExecutions.data:type_name -> google.protobuf.Struct
+	67,  // 59: Superplane.Canvases.CanvasEventWithExecutions.created_at:type_name -> google.protobuf.Timestamp
+	36,  // 60: Superplane.Canvases.CanvasEventWithExecutions.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	36,  // 61: Superplane.Canvases.ListEventExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	53,  // 62: Superplane.Canvases.ListExecutionLogsResponse.logs:type_name -> Superplane.Canvases.ExecutionLog
+	68,  // 63: Superplane.Canvases.ExecutionLog.fields:type_name -> google.protobuf.Struct
+	67,  // 64: Superplane.Canvases.ExecutionLog.timestamp:type_name -> google.protobuf.Timestamp
+	68,  // 65: Superplane.Canvases.DeadLetteredExecution.configuration:type_name -> google.protobuf.Struct
+	68,  // 66: Superplane.Canvases.DeadLetteredExecution.input:type_name -> google.protobuf.Struct
+	67,  // 67: Superplane.Canvases.DeadLetteredExecution.requeued_at:type_name -> google.protobuf.Timestamp
+	67,  // 68: Superplane.Canvases.DeadLetteredExecution.created_at:type_name -> google.protobuf.Timestamp
+	56,  // 69: Superplane.Canvases.ListDeadLetteredExecutionsResponse.dead_letters:type_name -> Superplane.Canvases.DeadLetteredExecution
+	56,  // 70: Superplane.Canvases.RequeueDeadLetteredExecutionResponse.dead_letter:type_name -> Superplane.Canvases.DeadLetteredExecution
+	67,  // 71: Superplane.Canvases.CanvasNodeEventMessage.timestamp:type_name -> google.protobuf.Timestamp
+	67,  // 72: Superplane.Canvases.CanvasNodeExecutionMessage.timestamp:type_name -> google.protobuf.Timestamp
+	67,  // 73: Superplane.Canvases.CanvasNodeQueueItemMessage.timestamp:type_name -> google.protobuf.Timestamp
+	67,  // 74: Superplane.Canvases.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	67,  // 75: Superplane.Canvases.Canvas.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	13,  // 76: Superplane.Canvases.Canvas.Metadata.created_by:type_name -> Superplane.Canvases.UserRef
+	69,  // 77: Superplane.Canvases.Canvas.Spec.nodes:type_name -> Superplane.Components.Node
+	70,  // 78: Superplane.Canvases.Canvas.Spec.edges:type_name -> Superplane.Components.Edge
+	36,  // 79: Superplane.Canvases.Canvas.Status.last_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	37,  // 80: Superplane.Canvases.Canvas.Status.next_queue_items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	45,  // 81: Superplane.Canvases.Canvas.Status.last_events:type_name -> Superplane.Canvases.CanvasEvent
+	3,   // 82: Superplane.Canvases.Canvases.ListCanvases:input_type -> Superplane.Canvases.ListCanvasesRequest
+	7,   // 83: Superplane.Canvases.Canvases.CreateCanvas:input_type -> Superplane.Canvases.CreateCanvasRequest
+	5,   // 84: Superplane.Canvases.Canvases.DescribeCanvas:input_type -> Superplane.Canvases.DescribeCanvasRequest
+	9,   // 85: Superplane.Canvases.Canvases.UpdateCanvas:input_type -> Superplane.Canvases.UpdateCanvasRequest
+	11,  // 86: Superplane.Canvases.Canvases.DeleteCanvas:input_type -> Superplane.Canvases.DeleteCanvasRequest
+	19,  // 87: Superplane.Canvases.Canvases.ListNodeQueueItems:input_type -> Superplane.Canvases.ListNodeQueueItemsRequest
+	21,  // 88: Superplane.Canvases.Canvases.DeleteNodeQueueItem:input_type -> Superplane.Canvases.DeleteNodeQueueItemRequest
+	23,  // 89: Superplane.Canvases.Canvases.UpdateNodePause:input_type -> Superplane.Canvases.UpdateNodePauseRequest
+	25,  // 90: Superplane.Canvases.Canvases.ListNodeExecutions:input_type -> Superplane.Canvases.ListNodeExecutionsRequest
+	27,  // 91: Superplane.Canvases.Canvases.GetNodeStatus:input_type -> Superplane.Canvases.GetNodeStatusRequest
+	29,  // 92: Superplane.Canvases.Canvases.ListNodeStatuses:input_type -> Superplane.Canvases.ListNodeStatusesRequest
+	15,  // 93: Superplane.Canvases.Canvases.ListNodeEvents:input_type -> Superplane.Canvases.ListNodeEventsRequest
+	17,  // 94: Superplane.Canvases.Canvases.EmitNodeEvent:input_type -> Superplane.Canvases.EmitNodeEventRequest
+	38,  // 95: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:input_type -> Superplane.Canvases.InvokeNodeExecutionActionRequest
+	40,  // 96: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:input_type -> Superplane.Canvases.InvokeNodeTriggerActionRequest
+	34,  // 97: Superplane.Canvases.Canvases.ListChildExecutions:input_type -> Superplane.Canvases.ListChildExecutionsRequest
+	49,  // 98: Superplane.Canvases.Canvases.CancelExecution:input_type -> Superplane.Canvases.CancelExecutionRequest
+	51,  // 99: Superplane.Canvases.Canvases.ListExecutionLogs:input_type -> Superplane.Canvases.ListExecutionLogsRequest
+	54,  // 100: Superplane.Canvases.Canvases.ResolveExecutionErrors:input_type -> Superplane.Canvases.ResolveExecutionErrorsRequest
+	57,  // 101: Superplane.Canvases.Canvases.ListDeadLetteredExecutions:input_type -> Superplane.Canvases.ListDeadLetteredExecutionsRequest
+	59,  // 102: Superplane.Canvases.Canvases.RequeueDeadLetteredExecution:input_type -> Superplane.Canvases.RequeueDeadLetteredExecutionRequest
+	42,  // 103: Superplane.Canvases.Canvases.ListCanvasEvents:input_type -> Superplane.Canvases.ListCanvasEventsRequest
+	47,  // 104: Superplane.Canvases.Canvases.ListEventExecutions:input_type -> Superplane.Canvases.ListEventExecutionsRequest
+	4,   // 105: Superplane.Canvases.Canvases.ListCanvases:output_type -> Superplane.Canvases.ListCanvasesResponse
+	8,   // 106: Superplane.Canvases.Canvases.CreateCanvas:output_type -> Superplane.Canvases.CreateCanvasResponse
+	6,   // 107: Superplane.Canvases.Canvases.DescribeCanvas:output_type -> Superplane.Canvases.DescribeCanvasResponse
+	10,  // 108: Superplane.Canvases.Canvases.UpdateCanvas:output_type -> Superplane.Canvases.UpdateCanvasResponse
+	12,  // 109: Superplane.Canvases.Canvases.DeleteCanvas:output_type -> Superplane.Canvases.DeleteCanvasResponse
+	20,  // 110: Superplane.Canvases.Canvases.ListNodeQueueItems:output_type -> Superplane.Canvases.ListNodeQueueItemsResponse
+	22,  // 111: Superplane.Canvases.Canvases.DeleteNodeQueueItem:output_type -> Superplane.Canvases.DeleteNodeQueueItemResponse
+	24,  // 112: Superplane.Canvases.Canvases.UpdateNodePause:output_type -> Superplane.Canvases.UpdateNodePauseResponse
+	26,  // 113: Superplane.Canvases.Canvases.ListNodeExecutions:output_type -> Superplane.Canvases.ListNodeExecutionsResponse
+	28,  // 114: Superplane.Canvases.Canvases.GetNodeStatus:output_type -> Superplane.Canvases.GetNodeStatusResponse
+	30,  // 115: Superplane.Canvases.Canvases.ListNodeStatuses:output_type -> Superplane.Canvases.ListNodeStatusesResponse
+	16,  // 116: Superplane.Canvases.Canvases.ListNodeEvents:output_type -> Superplane.Canvases.ListNodeEventsResponse
+	18,  // 117: Superplane.Canvases.Canvases.EmitNodeEvent:output_type -> Superplane.Canvases.EmitNodeEventResponse
+	39,  // 118: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:output_type -> Superplane.Canvases.InvokeNodeExecutionActionResponse
+	41,  // 119: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:output_type -> Superplane.Canvases.InvokeNodeTriggerActionResponse
+	35,  // 120: Superplane.Canvases.Canvases.ListChildExecutions:output_type -> Superplane.Canvases.ListChildExecutionsResponse
+	50,  // 121: Superplane.Canvases.Canvases.CancelExecution:output_type -> Superplane.Canvases.CancelExecutionResponse
+	52,  // 122: Superplane.Canvases.Canvases.ListExecutionLogs:output_type -> Superplane.Canvases.ListExecutionLogsResponse
+	55,  // 123: Superplane.Canvases.Canvases.ResolveExecutionErrors:output_type -> Superplane.Canvases.ResolveExecutionErrorsResponse
+	58,  // 124: Superplane.Canvases.Canvases.ListDeadLetteredExecutions:output_type -> Superplane.Canvases.ListDeadLetteredExecutionsResponse
+	60,  // 125: Superplane.Canvases.Canvases.RequeueDeadLetteredExecution:output_type -> Superplane.Canvases.RequeueDeadLetteredExecutionResponse
+	43,  // 126: Superplane.Canvases.Canvases.ListCanvasEvents:output_type -> Superplane.Canvases.ListCanvasEventsResponse
+	48,  // 127: Superplane.Canvases.Canvases.ListEventExecutions:output_type -> Superplane.Canvases.ListEventExecutionsResponse
+	105, // [105:128] is the sub-list for method output_type
+	82,  // [82:105] is the sub-list for method input_type
+	82,  // [82:82] is the sub-list for extension type_name
+	82,  // [82:82] is the sub-list for extension extendee
+	0,   // [0:82] is the sub-list for field type_name
 }
 
 func init() { file_canvases_proto_init() }
@@ -3529,7 +4786,7 @@ func file_canvases_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_canvases_proto_rawDesc), len(file_canvases_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   48,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
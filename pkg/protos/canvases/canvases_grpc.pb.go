@@ -19,24 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Canvases_ListCanvases_FullMethodName              = "/Superplane.Canvases.Canvases/ListCanvases"
-	Canvases_CreateCanvas_FullMethodName              = "/Superplane.Canvases.Canvases/CreateCanvas"
-	Canvases_DescribeCanvas_FullMethodName            = "/Superplane.Canvases.Canvases/DescribeCanvas"
-	Canvases_UpdateCanvas_FullMethodName              = "/Superplane.Canvases.Canvases/UpdateCanvas"
-	Canvases_DeleteCanvas_FullMethodName              = "/Superplane.Canvases.Canvases/DeleteCanvas"
-	Canvases_ListNodeQueueItems_FullMethodName        = "/Superplane.Canvases.Canvases/ListNodeQueueItems"
-	Canvases_DeleteNodeQueueItem_FullMethodName       = "/Superplane.Canvases.Canvases/DeleteNodeQueueItem"
-	Canvases_UpdateNodePause_FullMethodName           = "/Superplane.Canvases.Canvases/UpdateNodePause"
-	Canvases_ListNodeExecutions_FullMethodName        = "/Superplane.Canvases.Canvases/ListNodeExecutions"
-	Canvases_ListNodeEvents_FullMethodName            = "/Superplane.Canvases.Canvases/ListNodeEvents"
-	Canvases_EmitNodeEvent_FullMethodName             = "/Superplane.Canvases.Canvases/EmitNodeEvent"
-	Canvases_InvokeNodeExecutionAction_FullMethodName = "/Superplane.Canvases.Canvases/InvokeNodeExecutionAction"
-	Canvases_InvokeNodeTriggerAction_FullMethodName   = "/Superplane.Canvases.Canvases/InvokeNodeTriggerAction"
-	Canvases_ListChildExecutions_FullMethodName       = "/Superplane.Canvases.Canvases/ListChildExecutions"
-	Canvases_CancelExecution_FullMethodName           = "/Superplane.Canvases.Canvases/CancelExecution"
-	Canvases_ResolveExecutionErrors_FullMethodName    = "/Superplane.Canvases.Canvases/ResolveExecutionErrors"
-	Canvases_ListCanvasEvents_FullMethodName          = "/Superplane.Canvases.Canvases/ListCanvasEvents"
-	Canvases_ListEventExecutions_FullMethodName       = "/Superplane.Canvases.Canvases/ListEventExecutions"
+	Canvases_ListCanvases_FullMethodName                 = "/Superplane.Canvases.Canvases/ListCanvases"
+	Canvases_CreateCanvas_FullMethodName                 = "/Superplane.Canvases.Canvases/CreateCanvas"
+	Canvases_DescribeCanvas_FullMethodName               = "/Superplane.Canvases.Canvases/DescribeCanvas"
+	Canvases_UpdateCanvas_FullMethodName                 = "/Superplane.Canvases.Canvases/UpdateCanvas"
+	Canvases_DeleteCanvas_FullMethodName                 = "/Superplane.Canvases.Canvases/DeleteCanvas"
+	Canvases_ListNodeQueueItems_FullMethodName           = "/Superplane.Canvases.Canvases/ListNodeQueueItems"
+	Canvases_DeleteNodeQueueItem_FullMethodName          = "/Superplane.Canvases.Canvases/DeleteNodeQueueItem"
+	Canvases_UpdateNodePause_FullMethodName              = "/Superplane.Canvases.Canvases/UpdateNodePause"
+	Canvases_ListNodeExecutions_FullMethodName           = "/Superplane.Canvases.Canvases/ListNodeExecutions"
+	Canvases_GetNodeStatus_FullMethodName                = "/Superplane.Canvases.Canvases/GetNodeStatus"
+	Canvases_ListNodeStatuses_FullMethodName             = "/Superplane.Canvases.Canvases/ListNodeStatuses"
+	Canvases_ListNodeEvents_FullMethodName               = "/Superplane.Canvases.Canvases/ListNodeEvents"
+	Canvases_EmitNodeEvent_FullMethodName                = "/Superplane.Canvases.Canvases/EmitNodeEvent"
+	Canvases_InvokeNodeExecutionAction_FullMethodName    = "/Superplane.Canvases.Canvases/InvokeNodeExecutionAction"
+	Canvases_InvokeNodeTriggerAction_FullMethodName      = "/Superplane.Canvases.Canvases/InvokeNodeTriggerAction"
+	Canvases_ListChildExecutions_FullMethodName          = "/Superplane.Canvases.Canvases/ListChildExecutions"
+	Canvases_CancelExecution_FullMethodName              = "/Superplane.Canvases.Canvases/CancelExecution"
+	Canvases_ListExecutionLogs_FullMethodName            = "/Superplane.Canvases.Canvases/ListExecutionLogs"
+	Canvases_ResolveExecutionErrors_FullMethodName       = "/Superplane.Canvases.Canvases/ResolveExecutionErrors"
+	Canvases_ListDeadLetteredExecutions_FullMethodName   = "/Superplane.Canvases.Canvases/ListDeadLetteredExecutions"
+	Canvases_RequeueDeadLetteredExecution_FullMethodName = "/Superplane.Canvases.Canvases/RequeueDeadLetteredExecution"
+	Canvases_ListCanvasEvents_FullMethodName             = "/Superplane.Canvases.Canvases/ListCanvasEvents"
+	Canvases_ListEventExecutions_FullMethodName          = "/Superplane.Canvases.Canvases/ListEventExecutions"
 )
 
 // CanvasesClient is the client API for Canvases service.
@@ -52,13 +57,18 @@ type CanvasesClient interface {
 	DeleteNodeQueueItem(ctx context.Context, in *DeleteNodeQueueItemRequest, opts ...grpc.CallOption) (*DeleteNodeQueueItemResponse, error)
 	UpdateNodePause(ctx context.Context, in *UpdateNodePauseRequest, opts ...grpc.CallOption) (*UpdateNodePauseResponse, error)
 	ListNodeExecutions(ctx context.Context, in *ListNodeExecutionsRequest, opts ...grpc.CallOption) (*ListNodeExecutionsResponse, error)
+	GetNodeStatus(ctx context.Context, in *GetNodeStatusRequest, opts ...grpc.CallOption) (*GetNodeStatusResponse, error)
+	ListNodeStatuses(ctx context.Context, in *ListNodeStatusesRequest, opts ...grpc.CallOption) (*ListNodeStatusesResponse, error)
 	ListNodeEvents(ctx context.Context, in *ListNodeEventsRequest, opts ...grpc.CallOption) (*ListNodeEventsResponse, error)
 	EmitNodeEvent(ctx context.Context, in *EmitNodeEventRequest, opts ...grpc.CallOption) (*EmitNodeEventResponse, error)
 	InvokeNodeExecutionAction(ctx context.Context, in *InvokeNodeExecutionActionRequest, opts ...grpc.CallOption) (*InvokeNodeExecutionActionResponse, error)
 	InvokeNodeTriggerAction(ctx context.Context, in *InvokeNodeTriggerActionRequest, opts ...grpc.CallOption) (*InvokeNodeTriggerActionResponse, error)
 	ListChildExecutions(ctx context.Context, in *ListChildExecutionsRequest, opts ...grpc.CallOption) (*ListChildExecutionsResponse, error)
 	CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...grpc.CallOption) (*CancelExecutionResponse, error)
+	ListExecutionLogs(ctx context.Context, in *ListExecutionLogsRequest, opts ...grpc.CallOption) (*ListExecutionLogsResponse, error)
 	ResolveExecutionErrors(ctx context.Context, in *ResolveExecutionErrorsRequest, opts ...grpc.CallOption) (*ResolveExecutionErrorsResponse, error)
+	ListDeadLetteredExecutions(ctx context.Context, in *ListDeadLetteredExecutionsRequest, opts ...grpc.CallOption) (*ListDeadLetteredExecutionsResponse, error)
+	RequeueDeadLetteredExecution(ctx context.Context, in *RequeueDeadLetteredExecutionRequest, opts ...grpc.CallOption) (*RequeueDeadLetteredExecutionResponse, error)
 	ListCanvasEvents(ctx context.Context, in *ListCanvasEventsRequest, opts ...grpc.CallOption) (*ListCanvasEventsResponse, error)
 	ListEventExecutions(ctx context.Context, in *ListEventExecutionsRequest, opts ...grpc.CallOption) (*ListEventExecutionsResponse, error)
 }
@@ -161,6 +171,26 @@ func (c *canvasesClient) ListNodeExecutions(ctx context.Context, in *ListNodeExe
 	return out, nil
 }
 
+func (c *canvasesClient) GetNodeStatus(ctx context.Context, in *GetNodeStatusRequest, opts ...grpc.CallOption) (*GetNodeStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNodeStatusResponse)
+	err := c.cc.Invoke(ctx, Canvases_GetNodeStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ListNodeStatuses(ctx context.Context, in *ListNodeStatusesRequest, opts ...grpc.CallOption) (*ListNodeStatusesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNodeStatusesResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListNodeStatuses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) ListNodeEvents(ctx context.Context, in *ListNodeEventsRequest, opts ...grpc.CallOption) (*ListNodeEventsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListNodeEventsResponse)
@@ -221,6 +251,16 @@ func (c *canvasesClient) CancelExecution(ctx context.Context, in *CancelExecutio
 	return out, nil
 }
 
+func (c *canvasesClient) ListExecutionLogs(ctx context.Context, in *ListExecutionLogsRequest, opts ...grpc.CallOption) (*ListExecutionLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExecutionLogsResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListExecutionLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) ResolveExecutionErrors(ctx context.Context, in *ResolveExecutionErrorsRequest, opts ...grpc.CallOption) (*ResolveExecutionErrorsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResolveExecutionErrorsResponse)
@@ -231,6 +271,26 @@ func (c *canvasesClient) ResolveExecutionErrors(ctx context.Context, in *Resolve
 	return out, nil
 }
 
+func (c *canvasesClient) ListDeadLetteredExecutions(ctx context.Context, in *ListDeadLetteredExecutionsRequest, opts ...grpc.CallOption) (*ListDeadLetteredExecutionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadLetteredExecutionsResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListDeadLetteredExecutions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) RequeueDeadLetteredExecution(ctx context.Context, in *RequeueDeadLetteredExecutionRequest, opts ...grpc.CallOption) (*RequeueDeadLetteredExecutionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequeueDeadLetteredExecutionResponse)
+	err := c.cc.Invoke(ctx, Canvases_RequeueDeadLetteredExecution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) ListCanvasEvents(ctx context.Context, in *ListCanvasEventsRequest, opts ...grpc.CallOption) (*ListCanvasEventsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListCanvasEventsResponse)
@@ -264,13 +324,18 @@ type CanvasesServer interface {
 	DeleteNodeQueueItem(context.Context, *DeleteNodeQueueItemRequest) (*DeleteNodeQueueItemResponse, error)
 	UpdateNodePause(context.Context, *UpdateNodePauseRequest) (*UpdateNodePauseResponse, error)
 	ListNodeExecutions(context.Context, *ListNodeExecutionsRequest) (*ListNodeExecutionsResponse, error)
+	GetNodeStatus(context.Context, *GetNodeStatusRequest) (*GetNodeStatusResponse, error)
+	ListNodeStatuses(context.Context, *ListNodeStatusesRequest) (*ListNodeStatusesResponse, error)
 	ListNodeEvents(context.Context, *ListNodeEventsRequest) (*ListNodeEventsResponse, error)
 	EmitNodeEvent(context.Context, *EmitNodeEventRequest) (*EmitNodeEventResponse, error)
 	InvokeNodeExecutionAction(context.Context, *InvokeNodeExecutionActionRequest) (*InvokeNodeExecutionActionResponse, error)
 	InvokeNodeTriggerAction(context.Context, *InvokeNodeTriggerActionRequest) (*InvokeNodeTriggerActionResponse, error)
 	ListChildExecutions(context.Context, *ListChildExecutionsRequest) (*ListChildExecutionsResponse, error)
 	CancelExecution(context.Context, *CancelExecutionRequest) (*CancelExecutionResponse, error)
+	ListExecutionLogs(context.Context, *ListExecutionLogsRequest) (*ListExecutionLogsResponse, error)
 	ResolveExecutionErrors(context.Context, *ResolveExecutionErrorsRequest) (*ResolveExecutionErrorsResponse, error)
+	ListDeadLetteredExecutions(context.Context, *ListDeadLetteredExecutionsRequest) (*ListDeadLetteredExecutionsResponse, error)
+	RequeueDeadLetteredExecution(context.Context, *RequeueDeadLetteredExecutionRequest) (*RequeueDeadLetteredExecutionResponse, error)
 	ListCanvasEvents(context.Context, *ListCanvasEventsRequest) (*ListCanvasEventsResponse, error)
 	ListEventExecutions(context.Context, *ListEventExecutionsRequest) (*ListEventExecutionsResponse, error)
 }
@@ -309,6 +374,12 @@ func (UnimplementedCanvasesServer) UpdateNodePause(context.Context, *UpdateNodeP
 func (UnimplementedCanvasesServer) ListNodeExecutions(context.Context, *ListNodeExecutionsRequest) (*ListNodeExecutionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListNodeExecutions not implemented")
 }
+func (UnimplementedCanvasesServer) GetNodeStatus(context.Context, *GetNodeStatusRequest) (*GetNodeStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNodeStatus not implemented")
+}
+func (UnimplementedCanvasesServer) ListNodeStatuses(context.Context, *ListNodeStatusesRequest) (*ListNodeStatusesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNodeStatuses not implemented")
+}
 func (UnimplementedCanvasesServer) ListNodeEvents(context.Context, *ListNodeEventsRequest) (*ListNodeEventsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListNodeEvents not implemented")
 }
@@ -327,9 +398,18 @@ func (UnimplementedCanvasesServer) ListChildExecutions(context.Context, *ListChi
 func (UnimplementedCanvasesServer) CancelExecution(context.Context, *CancelExecutionRequest) (*CancelExecutionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelExecution not implemented")
 }
+func (UnimplementedCanvasesServer) ListExecutionLogs(context.Context, *ListExecutionLogsRequest) (*ListExecutionLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListExecutionLogs not implemented")
+}
 func (UnimplementedCanvasesServer) ResolveExecutionErrors(context.Context, *ResolveExecutionErrorsRequest) (*ResolveExecutionErrorsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResolveExecutionErrors not implemented")
 }
+func (UnimplementedCanvasesServer) ListDeadLetteredExecutions(context.Context, *ListDeadLetteredExecutionsRequest) (*ListDeadLetteredExecutionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeadLetteredExecutions not implemented")
+}
+func (UnimplementedCanvasesServer) RequeueDeadLetteredExecution(context.Context, *RequeueDeadLetteredExecutionRequest) (*RequeueDeadLetteredExecutionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequeueDeadLetteredExecution not implemented")
+}
 func (UnimplementedCanvasesServer) ListCanvasEvents(context.Context, *ListCanvasEventsRequest) (*ListCanvasEventsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListCanvasEvents not implemented")
 }
@@ -518,6 +598,42 @@ func _Canvases_ListNodeExecutions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_GetNodeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).GetNodeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_GetNodeStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).GetNodeStatus(ctx, req.(*GetNodeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ListNodeStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodeStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListNodeStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListNodeStatuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListNodeStatuses(ctx, req.(*ListNodeStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_ListNodeEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListNodeEventsRequest)
 	if err := dec(in); err != nil {
@@ -626,6 +742,24 @@ func _Canvases_CancelExecution_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_ListExecutionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExecutionLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListExecutionLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListExecutionLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListExecutionLogs(ctx, req.(*ListExecutionLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_ResolveExecutionErrors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResolveExecutionErrorsRequest)
 	if err := dec(in); err != nil {
@@ -644,6 +778,42 @@ func _Canvases_ResolveExecutionErrors_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_ListDeadLetteredExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLetteredExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListDeadLetteredExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListDeadLetteredExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListDeadLetteredExecutions(ctx, req.(*ListDeadLetteredExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_RequeueDeadLetteredExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequeueDeadLetteredExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).RequeueDeadLetteredExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_RequeueDeadLetteredExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).RequeueDeadLetteredExecution(ctx, req.(*RequeueDeadLetteredExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_ListCanvasEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCanvasEventsRequest)
 	if err := dec(in); err != nil {
@@ -723,6 +893,14 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListNodeExecutions",
 			Handler:    _Canvases_ListNodeExecutions_Handler,
 		},
+		{
+			MethodName: "GetNodeStatus",
+			Handler:    _Canvases_GetNodeStatus_Handler,
+		},
+		{
+			MethodName: "ListNodeStatuses",
+			Handler:    _Canvases_ListNodeStatuses_Handler,
+		},
 		{
 			MethodName: "ListNodeEvents",
 			Handler:    _Canvases_ListNodeEvents_Handler,
@@ -747,10 +925,22 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelExecution",
 			Handler:    _Canvases_CancelExecution_Handler,
 		},
+		{
+			MethodName: "ListExecutionLogs",
+			Handler:    _Canvases_ListExecutionLogs_Handler,
+		},
 		{
 			MethodName: "ResolveExecutionErrors",
 			Handler:    _Canvases_ResolveExecutionErrors_Handler,
 		},
+		{
+			MethodName: "ListDeadLetteredExecutions",
+			Handler:    _Canvases_ListDeadLetteredExecutions_Handler,
+		},
+		{
+			MethodName: "RequeueDeadLetteredExecution",
+			Handler:    _Canvases_RequeueDeadLetteredExecution_Handler,
+		},
 		{
 			MethodName: "ListCanvasEvents",
 			Handler:    _Canvases_ListCanvasEvents_Handler,
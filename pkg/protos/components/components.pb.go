@@ -75,7 +75,7 @@ func (x Node_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Node_Type.Descriptor instead.
 func (Node_Type) EnumDescriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{9, 0}
+	return file_components_proto_rawDescGZIP(), []int{12, 0}
 }
 
 type ListComponentsRequest struct {
@@ -554,6 +554,187 @@ func (x *ListComponentActionsResponse) GetActions() []*ComponentAction {
 	return nil
 }
 
+type ListComponentCatalogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComponentCatalogRequest) Reset() {
+	*x = ListComponentCatalogRequest{}
+	mi := &file_components_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComponentCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComponentCatalogRequest) ProtoMessage() {}
+
+func (x *ListComponentCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComponentCatalogRequest.ProtoReflect.Descriptor instead.
+func (*ListComponentCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{9}
+}
+
+type ListComponentCatalogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Components    []*CatalogEntry        `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	Triggers      []*CatalogEntry        `protobuf:"bytes,2,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListComponentCatalogResponse) Reset() {
+	*x = ListComponentCatalogResponse{}
+	mi := &file_components_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListComponentCatalogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListComponentCatalogResponse) ProtoMessage() {}
+
+func (x *ListComponentCatalogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListComponentCatalogResponse.ProtoReflect.Descriptor instead.
+func (*ListComponentCatalogResponse) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListComponentCatalogResponse) GetComponents() []*CatalogEntry {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *ListComponentCatalogResponse) GetTriggers() []*CatalogEntry {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+type CatalogEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Label          string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Icon           string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Color          string                 `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	OutputChannels []*OutputChannel       `protobuf:"bytes,6,rep,name=output_channels,json=outputChannels,proto3" json:"output_channels,omitempty"`
+	// JSON encoded list of configuration fields, as defined by the component.
+	ConfigurationSchema string `protobuf:"bytes,7,opt,name=configuration_schema,json=configurationSchema,proto3" json:"configuration_schema,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CatalogEntry) Reset() {
+	*x = CatalogEntry{}
+	mi := &file_components_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogEntry) ProtoMessage() {}
+
+func (x *CatalogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogEntry.ProtoReflect.Descriptor instead.
+func (*CatalogEntry) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CatalogEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CatalogEntry) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CatalogEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CatalogEntry) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *CatalogEntry) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *CatalogEntry) GetOutputChannels() []*OutputChannel {
+	if x != nil {
+		return x.OutputChannels
+	}
+	return nil
+}
+
+func (x *CatalogEntry) GetConfigurationSchema() string {
+	if x != nil {
+		return x.ConfigurationSchema
+	}
+	return ""
+}
+
 type Node struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -571,13 +752,16 @@ type Node struct {
 	ErrorMessage   string                 `protobuf:"bytes,13,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	WarningMessage string                 `protobuf:"bytes,14,opt,name=warning_message,json=warningMessage,proto3" json:"warning_message,omitempty"`
 	Paused         bool                   `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Parameters of the user accessible actions of trigger nodes,
+	// resolved with the node configuration. Only included when describing a canvas.
+	ActionParameters []*Node_ActionParameters `protobuf:"bytes,16,rep,name=action_parameters,json=actionParameters,proto3" json:"action_parameters,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Node) Reset() {
 	*x = Node{}
-	mi := &file_components_proto_msgTypes[9]
+	mi := &file_components_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -589,7 +773,7 @@ func (x *Node) String() string {
 func (*Node) ProtoMessage() {}
 
 func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[9]
+	mi := &file_components_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,7 +786,7 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Node.ProtoReflect.Descriptor instead.
 func (*Node) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{9}
+	return file_components_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Node) GetId() string {
@@ -710,6 +894,13 @@ func (x *Node) GetPaused() bool {
 	return false
 }
 
+func (x *Node) GetActionParameters() []*Node_ActionParameters {
+	if x != nil {
+		return x.ActionParameters
+	}
+	return nil
+}
+
 type Position struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
@@ -720,7 +911,7 @@ type Position struct {
 
 func (x *Position) Reset() {
 	*x = Position{}
-	mi := &file_components_proto_msgTypes[10]
+	mi := &file_components_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -732,7 +923,7 @@ func (x *Position) String() string {
 func (*Position) ProtoMessage() {}
 
 func (x *Position) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[10]
+	mi := &file_components_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,7 +936,7 @@ func (x *Position) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Position.ProtoReflect.Descriptor instead.
 func (*Position) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{10}
+	return file_components_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Position) GetX() int32 {
@@ -773,7 +964,7 @@ type Edge struct {
 
 func (x *Edge) Reset() {
 	*x = Edge{}
-	mi := &file_components_proto_msgTypes[11]
+	mi := &file_components_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -785,7 +976,7 @@ func (x *Edge) String() string {
 func (*Edge) ProtoMessage() {}
 
 func (x *Edge) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[11]
+	mi := &file_components_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,7 +989,7 @@ func (x *Edge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Edge.ProtoReflect.Descriptor instead.
 func (*Edge) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{11}
+	return file_components_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Edge) GetSourceId() string {
@@ -832,7 +1023,7 @@ type IntegrationRef struct {
 
 func (x *IntegrationRef) Reset() {
 	*x = IntegrationRef{}
-	mi := &file_components_proto_msgTypes[12]
+	mi := &file_components_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -844,7 +1035,7 @@ func (x *IntegrationRef) String() string {
 func (*IntegrationRef) ProtoMessage() {}
 
 func (x *IntegrationRef) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[12]
+	mi := &file_components_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,7 +1048,7 @@ func (x *IntegrationRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntegrationRef.ProtoReflect.Descriptor instead.
 func (*IntegrationRef) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{12}
+	return file_components_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *IntegrationRef) GetId() string {
@@ -892,7 +1083,7 @@ type NotificationEmailRequested struct {
 
 func (x *NotificationEmailRequested) Reset() {
 	*x = NotificationEmailRequested{}
-	mi := &file_components_proto_msgTypes[13]
+	mi := &file_components_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -904,7 +1095,7 @@ func (x *NotificationEmailRequested) String() string {
 func (*NotificationEmailRequested) ProtoMessage() {}
 
 func (x *NotificationEmailRequested) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[13]
+	mi := &file_components_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -917,7 +1108,7 @@ func (x *NotificationEmailRequested) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationEmailRequested.ProtoReflect.Descriptor instead.
 func (*NotificationEmailRequested) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{13}
+	return file_components_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *NotificationEmailRequested) GetOrganizationId() string {
@@ -992,7 +1183,7 @@ type Node_ComponentRef struct {
 
 func (x *Node_ComponentRef) Reset() {
 	*x = Node_ComponentRef{}
-	mi := &file_components_proto_msgTypes[14]
+	mi := &file_components_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1004,7 +1195,7 @@ func (x *Node_ComponentRef) String() string {
 func (*Node_ComponentRef) ProtoMessage() {}
 
 func (x *Node_ComponentRef) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[14]
+	mi := &file_components_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,7 +1208,7 @@ func (x *Node_ComponentRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Node_ComponentRef.ProtoReflect.Descriptor instead.
 func (*Node_ComponentRef) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{9, 0}
+	return file_components_proto_rawDescGZIP(), []int{12, 0}
 }
 
 func (x *Node_ComponentRef) GetName() string {
@@ -1036,7 +1227,7 @@ type Node_TriggerRef struct {
 
 func (x *Node_TriggerRef) Reset() {
 	*x = Node_TriggerRef{}
-	mi := &file_components_proto_msgTypes[15]
+	mi := &file_components_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1048,7 +1239,7 @@ func (x *Node_TriggerRef) String() string {
 func (*Node_TriggerRef) ProtoMessage() {}
 
 func (x *Node_TriggerRef) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[15]
+	mi := &file_components_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1061,7 +1252,7 @@ func (x *Node_TriggerRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Node_TriggerRef.ProtoReflect.Descriptor instead.
 func (*Node_TriggerRef) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{9, 1}
+	return file_components_proto_rawDescGZIP(), []int{12, 1}
 }
 
 func (x *Node_TriggerRef) GetName() string {
@@ -1080,7 +1271,7 @@ type Node_WidgetRef struct {
 
 func (x *Node_WidgetRef) Reset() {
 	*x = Node_WidgetRef{}
-	mi := &file_components_proto_msgTypes[16]
+	mi := &file_components_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1092,7 +1283,7 @@ func (x *Node_WidgetRef) String() string {
 func (*Node_WidgetRef) ProtoMessage() {}
 
 func (x *Node_WidgetRef) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[16]
+	mi := &file_components_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1105,7 +1296,7 @@ func (x *Node_WidgetRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Node_WidgetRef.ProtoReflect.Descriptor instead.
 func (*Node_WidgetRef) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{9, 2}
+	return file_components_proto_rawDescGZIP(), []int{12, 2}
 }
 
 func (x *Node_WidgetRef) GetName() string {
@@ -1124,7 +1315,7 @@ type Node_BlueprintRef struct {
 
 func (x *Node_BlueprintRef) Reset() {
 	*x = Node_BlueprintRef{}
-	mi := &file_components_proto_msgTypes[17]
+	mi := &file_components_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1136,7 +1327,7 @@ func (x *Node_BlueprintRef) String() string {
 func (*Node_BlueprintRef) ProtoMessage() {}
 
 func (x *Node_BlueprintRef) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[17]
+	mi := &file_components_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1149,7 +1340,7 @@ func (x *Node_BlueprintRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Node_BlueprintRef.ProtoReflect.Descriptor instead.
 func (*Node_BlueprintRef) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{9, 3}
+	return file_components_proto_rawDescGZIP(), []int{12, 3}
 }
 
 func (x *Node_BlueprintRef) GetId() string {
@@ -1159,6 +1350,58 @@ func (x *Node_BlueprintRef) GetId() string {
 	return ""
 }
 
+type Node_ActionParameters struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Parameters    []*configuration.Field `protobuf:"bytes,2,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Node_ActionParameters) Reset() {
+	*x = Node_ActionParameters{}
+	mi := &file_components_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node_ActionParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node_ActionParameters) ProtoMessage() {}
+
+func (x *Node_ActionParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node_ActionParameters.ProtoReflect.Descriptor instead.
+func (*Node_ActionParameters) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{12, 4}
+}
+
+func (x *Node_ActionParameters) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Node_ActionParameters) GetParameters() []*configuration.Field {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
 var File_components_proto protoreflect.FileDescriptor
 
 const file_components_proto_rawDesc = "" +
@@ -1195,7 +1438,21 @@ const file_components_proto_rawDesc = "" +
 	"parameters\x18\x03 \x03(\v2\x1f.Superplane.Configuration.FieldR\n" +
 	"parameters\"`\n" +
 	"\x1cListComponentActionsResponse\x12@\n" +
-	"\aactions\x18\x01 \x03(\v2&.Superplane.Components.ComponentActionR\aactions\"\xce\a\n" +
+	"\aactions\x18\x01 \x03(\v2&.Superplane.Components.ComponentActionR\aactions\"\x1d\n" +
+	"\x1bListComponentCatalogRequest\"\xa4\x01\n" +
+	"\x1cListComponentCatalogResponse\x12C\n" +
+	"\n" +
+	"components\x18\x01 \x03(\v2#.Superplane.Components.CatalogEntryR\n" +
+	"components\x12?\n" +
+	"\btriggers\x18\x02 \x03(\v2#.Superplane.Components.CatalogEntryR\btriggers\"\x86\x02\n" +
+	"\fCatalogEntry\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04icon\x18\x04 \x01(\tR\x04icon\x12\x14\n" +
+	"\x05color\x18\x05 \x01(\tR\x05color\x12M\n" +
+	"\x0foutput_channels\x18\x06 \x03(\v2$.Superplane.Components.OutputChannelR\x0eoutputChannels\x121\n" +
+	"\x14configuration_schema\x18\a \x01(\tR\x13configurationSchema\"\x96\t\n" +
 	"\x04Node\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x124\n" +
@@ -1212,7 +1469,8 @@ const file_components_proto_rawDesc = "" +
 	"\vintegration\x18\f \x01(\v2%.Superplane.Components.IntegrationRefR\vintegration\x12#\n" +
 	"\rerror_message\x18\r \x01(\tR\ferrorMessage\x12'\n" +
 	"\x0fwarning_message\x18\x0e \x01(\tR\x0ewarningMessage\x12\x16\n" +
-	"\x06paused\x18\x0f \x01(\bR\x06paused\x1a\"\n" +
+	"\x06paused\x18\x0f \x01(\bR\x06paused\x12Y\n" +
+	"\x11action_parameters\x18\x10 \x03(\v2,.Superplane.Components.Node.ActionParametersR\x10actionParameters\x1a\"\n" +
 	"\fComponentRef\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x1a \n" +
 	"\n" +
@@ -1221,7 +1479,12 @@ const file_components_proto_rawDesc = "" +
 	"\tWidgetRef\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x1a\x1e\n" +
 	"\fBlueprintRef\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"Q\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x1ak\n" +
+	"\x10ActionParameters\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12?\n" +
+	"\n" +
+	"parameters\x18\x02 \x03(\v2\x1f.Superplane.Configuration.FieldR\n" +
+	"parameters\"Q\n" +
 	"\x04Type\x12\x12\n" +
 	"\x0eTYPE_COMPONENT\x10\x00\x12\x12\n" +
 	"\x0eTYPE_BLUEPRINT\x10\x01\x12\x10\n" +
@@ -1246,7 +1509,7 @@ const file_components_proto_rawDesc = "" +
 	"\x06emails\x18\x06 \x03(\tR\x06emails\x12\x16\n" +
 	"\x06groups\x18\a \x03(\tR\x06groups\x12\x14\n" +
 	"\x05roles\x18\b \x03(\tR\x05roles\x128\n" +
-	"\ttimestamp\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xb6\x05\n" +
+	"\ttimestamp\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xd5\a\n" +
 	"\n" +
 	"Components\x12\xca\x01\n" +
 	"\x0eListComponents\x12,.Superplane.Components.ListComponentsRequest\x1a-.Superplane.Components.ListComponentsResponse\"[\x92A>\n" +
@@ -1254,7 +1517,9 @@ const file_components_proto_rawDesc = "" +
 	"\x11DescribeComponent\x12/.Superplane.Components.DescribeComponentRequest\x1a0.Superplane.Components.DescribeComponentResponse\"d\x92A@\n" +
 	"\tComponent\x12\x12Describe component\x1a\x1fReturns a component by its name\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/components/{name}\x12\xfb\x01\n" +
 	"\x14ListComponentActions\x122.Superplane.Components.ListComponentActionsRequest\x1a3.Superplane.Components.ListComponentActionsResponse\"z\x92AN\n" +
-	"\tComponent\x12\x16List component actions\x1a)Returns available actions for a component\x82\xd3\xe4\x93\x02#\x12!/api/v1/components/{name}/actionsB\xce\x01\x92A\x90\x01\x12f\n" +
+	"\tComponent\x12\x16List component actions\x1a)Returns available actions for a component\x82\xd3\xe4\x93\x02#\x12!/api/v1/components/{name}/actions\x12\x9c\x02\n" +
+	"\x14ListComponentCatalog\x122.Superplane.Components.ListComponentCatalogRequest\x1a3.Superplane.Components.ListComponentCatalogResponse\"\x9a\x01\x92Au\n" +
+	"\tComponent\x12\x16List component catalog\x1aPReturns all registered components and triggers, with their configuration schemas\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/components/catalogB\xce\x01\x92A\x90\x01\x12f\n" +
 	"\x19Superplane Components API\x12\x1dAPI for Superplane Components\"%\n" +
 	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ8github.com/superplanehq/superplane/pkg/protos/componentsb\x06proto3"
 
@@ -1271,7 +1536,7 @@ func file_components_proto_rawDescGZIP() []byte {
 }
 
 var file_components_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_components_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_components_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_components_proto_goTypes = []any{
 	(Node_Type)(0),                       // 0: Superplane.Components.Node.Type
 	(*ListComponentsRequest)(nil),        // 1: Superplane.Components.ListComponentsRequest
@@ -1283,48 +1548,59 @@ var file_components_proto_goTypes = []any{
 	(*ListComponentActionsRequest)(nil),  // 7: Superplane.Components.ListComponentActionsRequest
 	(*ComponentAction)(nil),              // 8: Superplane.Components.ComponentAction
 	(*ListComponentActionsResponse)(nil), // 9: Superplane.Components.ListComponentActionsResponse
-	(*Node)(nil),                         // 10: Superplane.Components.Node
-	(*Position)(nil),                     // 11: Superplane.Components.Position
-	(*Edge)(nil),                         // 12: Superplane.Components.Edge
-	(*IntegrationRef)(nil),               // 13: Superplane.Components.IntegrationRef
-	(*NotificationEmailRequested)(nil),   // 14: Superplane.Components.NotificationEmailRequested
-	(*Node_ComponentRef)(nil),            // 15: Superplane.Components.Node.ComponentRef
-	(*Node_TriggerRef)(nil),              // 16: Superplane.Components.Node.TriggerRef
-	(*Node_WidgetRef)(nil),               // 17: Superplane.Components.Node.WidgetRef
-	(*Node_BlueprintRef)(nil),            // 18: Superplane.Components.Node.BlueprintRef
-	(*configuration.Field)(nil),          // 19: Superplane.Configuration.Field
-	(*_struct.Struct)(nil),               // 20: google.protobuf.Struct
-	(*timestamp.Timestamp)(nil),          // 21: google.protobuf.Timestamp
+	(*ListComponentCatalogRequest)(nil),  // 10: Superplane.Components.ListComponentCatalogRequest
+	(*ListComponentCatalogResponse)(nil), // 11: Superplane.Components.ListComponentCatalogResponse
+	(*CatalogEntry)(nil),                 // 12: Superplane.Components.CatalogEntry
+	(*Node)(nil),                         // 13: Superplane.Components.Node
+	(*Position)(nil),                     // 14: Superplane.Components.Position
+	(*Edge)(nil),                         // 15: Superplane.Components.Edge
+	(*IntegrationRef)(nil),               // 16: Superplane.Components.IntegrationRef
+	(*NotificationEmailRequested)(nil),   // 17: Superplane.Components.NotificationEmailRequested
+	(*Node_ComponentRef)(nil),            // 18: Superplane.Components.Node.ComponentRef
+	(*Node_TriggerRef)(nil),              // 19: Superplane.Components.Node.TriggerRef
+	(*Node_WidgetRef)(nil),               // 20: Superplane.Components.Node.WidgetRef
+	(*Node_BlueprintRef)(nil),            // 21: Superplane.Components.Node.BlueprintRef
+	(*Node_ActionParameters)(nil),        // 22: Superplane.Components.Node.ActionParameters
+	(*configuration.Field)(nil),          // 23: Superplane.Configuration.Field
+	(*_struct.Struct)(nil),               // 24: google.protobuf.Struct
+	(*timestamp.Timestamp)(nil),          // 25: google.protobuf.Timestamp
 }
 var file_components_proto_depIdxs = []int32{
 	5,  // 0: Superplane.Components.ListComponentsResponse.components:type_name -> Superplane.Components.Component
 	5,  // 1: Superplane.Components.DescribeComponentResponse.component:type_name -> Superplane.Components.Component
-	19, // 2: Superplane.Components.Component.configuration:type_name -> Superplane.Configuration.Field
+	23, // 2: Superplane.Components.Component.configuration:type_name -> Superplane.Configuration.Field
 	6,  // 3: Superplane.Components.Component.output_channels:type_name -> Superplane.Components.OutputChannel
-	20, // 4: Superplane.Components.Component.example_output:type_name -> google.protobuf.Struct
-	19, // 5: Superplane.Components.ComponentAction.parameters:type_name -> Superplane.Configuration.Field
+	24, // 4: Superplane.Components.Component.example_output:type_name -> google.protobuf.Struct
+	23, // 5: Superplane.Components.ComponentAction.parameters:type_name -> Superplane.Configuration.Field
 	8,  // 6: Superplane.Components.ListComponentActionsResponse.actions:type_name -> Superplane.Components.ComponentAction
-	0,  // 7: Superplane.Components.Node.type:type_name -> Superplane.Components.Node.Type
-	20, // 8: Superplane.Components.Node.configuration:type_name -> google.protobuf.Struct
-	20, // 9: Superplane.Components.Node.metadata:type_name -> google.protobuf.Struct
-	11, // 10: Superplane.Components.Node.position:type_name -> Superplane.Components.Position
-	15, // 11: Superplane.Components.Node.component:type_name -> Superplane.Components.Node.ComponentRef
-	18, // 12: Superplane.Components.Node.blueprint:type_name -> Superplane.Components.Node.BlueprintRef
-	16, // 13: Superplane.Components.Node.trigger:type_name -> Superplane.Components.Node.TriggerRef
-	17, // 14: Superplane.Components.Node.widget:type_name -> Superplane.Components.Node.WidgetRef
-	13, // 15: Superplane.Components.Node.integration:type_name -> Superplane.Components.IntegrationRef
-	21, // 16: Superplane.Components.NotificationEmailRequested.timestamp:type_name -> google.protobuf.Timestamp
-	1,  // 17: Superplane.Components.Components.ListComponents:input_type -> Superplane.Components.ListComponentsRequest
-	3,  // 18: Superplane.Components.Components.DescribeComponent:input_type -> Superplane.Components.DescribeComponentRequest
-	7,  // 19: Superplane.Components.Components.ListComponentActions:input_type -> Superplane.Components.ListComponentActionsRequest
-	2,  // 20: Superplane.Components.Components.ListComponents:output_type -> Superplane.Components.ListComponentsResponse
-	4,  // 21: Superplane.Components.Components.DescribeComponent:output_type -> Superplane.Components.DescribeComponentResponse
-	9,  // 22: Superplane.Components.Components.ListComponentActions:output_type -> Superplane.Components.ListComponentActionsResponse
-	20, // [20:23] is the sub-list for method output_type
-	17, // [17:20] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	12, // 7: Superplane.Components.ListComponentCatalogResponse.components:type_name -> Superplane.Components.CatalogEntry
+	12, // 8: Superplane.Components.ListComponentCatalogResponse.triggers:type_name -> Superplane.Components.CatalogEntry
+	6,  // 9: Superplane.Components.CatalogEntry.output_channels:type_name -> Superplane.Components.OutputChannel
+	0,  // 10: Superplane.Components.Node.type:type_name -> Superplane.Components.Node.Type
+	24, // 11: Superplane.Components.Node.configuration:type_name -> google.protobuf.Struct
+	24, // 12: Superplane.Components.Node.metadata:type_name -> google.protobuf.Struct
+	14, // 13: Superplane.Components.Node.position:type_name -> Superplane.Components.Position
+	18, // 14: Superplane.Components.Node.component:type_name -> Superplane.Components.Node.ComponentRef
+	21, // 15: Superplane.Components.Node.blueprint:type_name -> Superplane.Components.Node.BlueprintRef
+	19, // 16: Superplane.Components.Node.trigger:type_name -> Superplane.Components.Node.TriggerRef
+	20, // 17: Superplane.Components.Node.widget:type_name -> Superplane.Components.Node.WidgetRef
+	16, // 18: Superplane.Components.Node.integration:type_name -> Superplane.Components.IntegrationRef
+	22, // 19: Superplane.Components.Node.action_parameters:type_name -> Superplane.Components.Node.ActionParameters
+	25, // 20: Superplane.Components.NotificationEmailRequested.timestamp:type_name -> google.protobuf.Timestamp
+	23, // 21: Superplane.Components.Node.ActionParameters.parameters:type_name -> Superplane.Configuration.Field
+	1,  // 22: Superplane.Components.Components.ListComponents:input_type -> Superplane.Components.ListComponentsRequest
+	3,  // 23: Superplane.Components.Components.DescribeComponent:input_type -> Superplane.Components.DescribeComponentRequest
+	7,  // 24: Superplane.Components.Components.ListComponentActions:input_type -> Superplane.Components.ListComponentActionsRequest
+	10, // 25: Superplane.Components.Components.ListComponentCatalog:input_type -> Superplane.Components.ListComponentCatalogRequest
+	2,  // 26: Superplane.Components.Components.ListComponents:output_type -> Superplane.Components.ListComponentsResponse
+	4,  // 27: Superplane.Components.Components.DescribeComponent:output_type -> Superplane.Components.DescribeComponentResponse
+	9,  // 28: Superplane.Components.Components.ListComponentActions:output_type -> Superplane.Components.ListComponentActionsResponse
+	11, // 29: Superplane.Components.Components.ListComponentCatalog:output_type -> Superplane.Components.ListComponentCatalogResponse
+	26, // [26:30] is the sub-list for method output_type
+	22, // [22:26] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_components_proto_init() }
@@ -1338,7 +1614,7 @@ func file_components_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_components_proto_rawDesc), len(file_components_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   18,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,7 @@ const (
 	Components_ListComponents_FullMethodName       = "/Superplane.Components.Components/ListComponents"
 	Components_DescribeComponent_FullMethodName    = "/Superplane.Components.Components/DescribeComponent"
 	Components_ListComponentActions_FullMethodName = "/Superplane.Components.Components/ListComponentActions"
+	Components_ListComponentCatalog_FullMethodName = "/Superplane.Components.Components/ListComponentCatalog"
 )
 
 // ComponentsClient is the client API for Components service.
@@ -31,6 +32,9 @@ type ComponentsClient interface {
 	ListComponents(ctx context.Context, in *ListComponentsRequest, opts ...grpc.CallOption) (*ListComponentsResponse, error)
 	DescribeComponent(ctx context.Context, in *DescribeComponentRequest, opts ...grpc.CallOption) (*DescribeComponentResponse, error)
 	ListComponentActions(ctx context.Context, in *ListComponentActionsRequest, opts ...grpc.CallOption) (*ListComponentActionsResponse, error)
+	// Declared after DescribeComponent, so the gateway
+	// matches this path before /api/v1/components/{name}.
+	ListComponentCatalog(ctx context.Context, in *ListComponentCatalogRequest, opts ...grpc.CallOption) (*ListComponentCatalogResponse, error)
 }
 
 type componentsClient struct {
@@ -71,6 +75,16 @@ func (c *componentsClient) ListComponentActions(ctx context.Context, in *ListCom
 	return out, nil
 }
 
+func (c *componentsClient) ListComponentCatalog(ctx context.Context, in *ListComponentCatalogRequest, opts ...grpc.CallOption) (*ListComponentCatalogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListComponentCatalogResponse)
+	err := c.cc.Invoke(ctx, Components_ListComponentCatalog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ComponentsServer is the server API for Components service.
 // All implementations should embed UnimplementedComponentsServer
 // for forward compatibility.
@@ -78,6 +92,9 @@ type ComponentsServer interface {
 	ListComponents(context.Context, *ListComponentsRequest) (*ListComponentsResponse, error)
 	DescribeComponent(context.Context, *DescribeComponentRequest) (*DescribeComponentResponse, error)
 	ListComponentActions(context.Context, *ListComponentActionsRequest) (*ListComponentActionsResponse, error)
+	// Declared after DescribeComponent, so the gateway
+	// matches this path before /api/v1/components/{name}.
+	ListComponentCatalog(context.Context, *ListComponentCatalogRequest) (*ListComponentCatalogResponse, error)
 }
 
 // UnimplementedComponentsServer should be embedded to have
@@ -96,6 +113,9 @@ func (UnimplementedComponentsServer) DescribeComponent(context.Context, *Describ
 func (UnimplementedComponentsServer) ListComponentActions(context.Context, *ListComponentActionsRequest) (*ListComponentActionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListComponentActions not implemented")
 }
+func (UnimplementedComponentsServer) ListComponentCatalog(context.Context, *ListComponentCatalogRequest) (*ListComponentCatalogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListComponentCatalog not implemented")
+}
 func (UnimplementedComponentsServer) testEmbeddedByValue() {}
 
 // UnsafeComponentsServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +190,24 @@ func _Components_ListComponentActions_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Components_ListComponentCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListComponentCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComponentsServer).ListComponentCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Components_ListComponentCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComponentsServer).ListComponentCatalog(ctx, req.(*ListComponentCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Components_ServiceDesc is the grpc.ServiceDesc for Components service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +227,10 @@ var Components_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListComponentActions",
 			Handler:    _Components_ListComponentActions_Handler,
 		},
+		{
+			MethodName: "ListComponentCatalog",
+			Handler:    _Components_ListComponentCatalog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "components.proto",
@@ -25,6 +25,7 @@ const (
 	Organizations_DeleteOrganization_FullMethodName       = "/Superplane.Organizations.Organizations/DeleteOrganization"
 	Organizations_RemoveUser_FullMethodName               = "/Superplane.Organizations.Organizations/RemoveUser"
 	Organizations_CreateInvitation_FullMethodName         = "/Superplane.Organizations.Organizations/CreateInvitation"
+	Organizations_BulkCreateInvitations_FullMethodName    = "/Superplane.Organizations.Organizations/BulkCreateInvitations"
 	Organizations_ListInvitations_FullMethodName          = "/Superplane.Organizations.Organizations/ListInvitations"
 	Organizations_RemoveInvitation_FullMethodName         = "/Superplane.Organizations.Organizations/RemoveInvitation"
 	Organizations_GetInviteLink_FullMethodName            = "/Superplane.Organizations.Organizations/GetInviteLink"
@@ -37,6 +38,7 @@ const (
 	Organizations_CreateIntegration_FullMethodName        = "/Superplane.Organizations.Organizations/CreateIntegration"
 	Organizations_UpdateIntegration_FullMethodName        = "/Superplane.Organizations.Organizations/UpdateIntegration"
 	Organizations_DeleteIntegration_FullMethodName        = "/Superplane.Organizations.Organizations/DeleteIntegration"
+	Organizations_ListAuditEvents_FullMethodName          = "/Superplane.Organizations.Organizations/ListAuditEvents"
 )
 
 // OrganizationsClient is the client API for Organizations service.
@@ -48,6 +50,7 @@ type OrganizationsClient interface {
 	DeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*DeleteOrganizationResponse, error)
 	RemoveUser(ctx context.Context, in *RemoveUserRequest, opts ...grpc.CallOption) (*RemoveUserResponse, error)
 	CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*CreateInvitationResponse, error)
+	BulkCreateInvitations(ctx context.Context, in *BulkCreateInvitationsRequest, opts ...grpc.CallOption) (*BulkCreateInvitationsResponse, error)
 	ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error)
 	RemoveInvitation(ctx context.Context, in *RemoveInvitationRequest, opts ...grpc.CallOption) (*RemoveInvitationResponse, error)
 	GetInviteLink(ctx context.Context, in *GetInviteLinkRequest, opts ...grpc.CallOption) (*GetInviteLinkResponse, error)
@@ -60,6 +63,7 @@ type OrganizationsClient interface {
 	CreateIntegration(ctx context.Context, in *CreateIntegrationRequest, opts ...grpc.CallOption) (*CreateIntegrationResponse, error)
 	UpdateIntegration(ctx context.Context, in *UpdateIntegrationRequest, opts ...grpc.CallOption) (*UpdateIntegrationResponse, error)
 	DeleteIntegration(ctx context.Context, in *DeleteIntegrationRequest, opts ...grpc.CallOption) (*DeleteIntegrationResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 }
 
 type organizationsClient struct {
@@ -120,6 +124,16 @@ func (c *organizationsClient) CreateInvitation(ctx context.Context, in *CreateIn
 	return out, nil
 }
 
+func (c *organizationsClient) BulkCreateInvitations(ctx context.Context, in *BulkCreateInvitationsRequest, opts ...grpc.CallOption) (*BulkCreateInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkCreateInvitationsResponse)
+	err := c.cc.Invoke(ctx, Organizations_BulkCreateInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *organizationsClient) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListInvitationsResponse)
@@ -240,6 +254,16 @@ func (c *organizationsClient) DeleteIntegration(ctx context.Context, in *DeleteI
 	return out, nil
 }
 
+func (c *organizationsClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, Organizations_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrganizationsServer is the server API for Organizations service.
 // All implementations should embed UnimplementedOrganizationsServer
 // for forward compatibility.
@@ -249,6 +273,7 @@ type OrganizationsServer interface {
 	DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
 	RemoveUser(context.Context, *RemoveUserRequest) (*RemoveUserResponse, error)
 	CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationResponse, error)
+	BulkCreateInvitations(context.Context, *BulkCreateInvitationsRequest) (*BulkCreateInvitationsResponse, error)
 	ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error)
 	RemoveInvitation(context.Context, *RemoveInvitationRequest) (*RemoveInvitationResponse, error)
 	GetInviteLink(context.Context, *GetInviteLinkRequest) (*GetInviteLinkResponse, error)
@@ -261,6 +286,7 @@ type OrganizationsServer interface {
 	CreateIntegration(context.Context, *CreateIntegrationRequest) (*CreateIntegrationResponse, error)
 	UpdateIntegration(context.Context, *UpdateIntegrationRequest) (*UpdateIntegrationResponse, error)
 	DeleteIntegration(context.Context, *DeleteIntegrationRequest) (*DeleteIntegrationResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 }
 
 // UnimplementedOrganizationsServer should be embedded to have
@@ -285,6 +311,9 @@ func (UnimplementedOrganizationsServer) RemoveUser(context.Context, *RemoveUserR
 func (UnimplementedOrganizationsServer) CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateInvitation not implemented")
 }
+func (UnimplementedOrganizationsServer) BulkCreateInvitations(context.Context, *BulkCreateInvitationsRequest) (*BulkCreateInvitationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkCreateInvitations not implemented")
+}
 func (UnimplementedOrganizationsServer) ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListInvitations not implemented")
 }
@@ -321,6 +350,9 @@ func (UnimplementedOrganizationsServer) UpdateIntegration(context.Context, *Upda
 func (UnimplementedOrganizationsServer) DeleteIntegration(context.Context, *DeleteIntegrationRequest) (*DeleteIntegrationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteIntegration not implemented")
 }
+func (UnimplementedOrganizationsServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedOrganizationsServer) testEmbeddedByValue() {}
 
 // UnsafeOrganizationsServer may be embedded to opt out of forward compatibility for this service.
@@ -431,6 +463,24 @@ func _Organizations_CreateInvitation_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Organizations_BulkCreateInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkCreateInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).BulkCreateInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_BulkCreateInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).BulkCreateInvitations(ctx, req.(*BulkCreateInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Organizations_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListInvitationsRequest)
 	if err := dec(in); err != nil {
@@ -647,6 +697,24 @@ func _Organizations_DeleteIntegration_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Organizations_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Organizations_ServiceDesc is the grpc.ServiceDesc for Organizations service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -674,6 +742,10 @@ var Organizations_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateInvitation",
 			Handler:    _Organizations_CreateInvitation_Handler,
 		},
+		{
+			MethodName: "BulkCreateInvitations",
+			Handler:    _Organizations_BulkCreateInvitations_Handler,
+		},
 		{
 			MethodName: "ListInvitations",
 			Handler:    _Organizations_ListInvitations_Handler,
@@ -722,6 +794,10 @@ var Organizations_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteIntegration",
 			Handler:    _Organizations_DeleteIntegration_Handler,
 		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Organizations_ListAuditEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "organizations.proto",
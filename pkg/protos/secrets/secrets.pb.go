@@ -544,6 +544,7 @@ type DeleteSecretRequest struct {
 	DomainType    authorization.DomainType `protobuf:"varint,1,opt,name=domain_type,json=domainType,proto3,enum=Superplane.Authorization.DomainType" json:"domain_type,omitempty"`
 	DomainId      string                   `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	IdOrName      string                   `protobuf:"bytes,3,opt,name=id_or_name,json=idOrName,proto3" json:"id_or_name,omitempty"`
+	Force         bool                     `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -599,6 +600,13 @@ func (x *DeleteSecretRequest) GetIdOrName() string {
 	return ""
 }
 
+func (x *DeleteSecretRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 type DeleteSecretResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -635,6 +643,270 @@ func (*DeleteSecretResponse) Descriptor() ([]byte, []int) {
 	return file_secrets_proto_rawDescGZIP(), []int{10}
 }
 
+type ListSecretUsagesRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	DomainType    authorization.DomainType `protobuf:"varint,1,opt,name=domain_type,json=domainType,proto3,enum=Superplane.Authorization.DomainType" json:"domain_type,omitempty"`
+	DomainId      string                   `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	IdOrName      string                   `protobuf:"bytes,3,opt,name=id_or_name,json=idOrName,proto3" json:"id_or_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretUsagesRequest) Reset() {
+	*x = ListSecretUsagesRequest{}
+	mi := &file_secrets_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretUsagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretUsagesRequest) ProtoMessage() {}
+
+func (x *ListSecretUsagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretUsagesRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretUsagesRequest) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListSecretUsagesRequest) GetDomainType() authorization.DomainType {
+	if x != nil {
+		return x.DomainType
+	}
+	return authorization.DomainType(0)
+}
+
+func (x *ListSecretUsagesRequest) GetDomainId() string {
+	if x != nil {
+		return x.DomainId
+	}
+	return ""
+}
+
+func (x *ListSecretUsagesRequest) GetIdOrName() string {
+	if x != nil {
+		return x.IdOrName
+	}
+	return ""
+}
+
+type ListSecretUsagesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Nodes         []*SecretNodeUsage        `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Integrations  []*SecretIntegrationUsage `protobuf:"bytes,2,rep,name=integrations,proto3" json:"integrations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretUsagesResponse) Reset() {
+	*x = ListSecretUsagesResponse{}
+	mi := &file_secrets_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretUsagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretUsagesResponse) ProtoMessage() {}
+
+func (x *ListSecretUsagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretUsagesResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretUsagesResponse) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListSecretUsagesResponse) GetNodes() []*SecretNodeUsage {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ListSecretUsagesResponse) GetIntegrations() []*SecretIntegrationUsage {
+	if x != nil {
+		return x.Integrations
+	}
+	return nil
+}
+
+type SecretNodeUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	CanvasName    string                 `protobuf:"bytes,2,opt,name=canvas_name,json=canvasName,proto3" json:"canvas_name,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName      string                 `protobuf:"bytes,4,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	FieldPath     string                 `protobuf:"bytes,5,opt,name=field_path,json=fieldPath,proto3" json:"field_path,omitempty"`
+	KeyName       string                 `protobuf:"bytes,6,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretNodeUsage) Reset() {
+	*x = SecretNodeUsage{}
+	mi := &file_secrets_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretNodeUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretNodeUsage) ProtoMessage() {}
+
+func (x *SecretNodeUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretNodeUsage.ProtoReflect.Descriptor instead.
+func (*SecretNodeUsage) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SecretNodeUsage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *SecretNodeUsage) GetCanvasName() string {
+	if x != nil {
+		return x.CanvasName
+	}
+	return ""
+}
+
+func (x *SecretNodeUsage) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *SecretNodeUsage) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *SecretNodeUsage) GetFieldPath() string {
+	if x != nil {
+		return x.FieldPath
+	}
+	return ""
+}
+
+func (x *SecretNodeUsage) GetKeyName() string {
+	if x != nil {
+		return x.KeyName
+	}
+	return ""
+}
+
+type SecretIntegrationUsage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IntegrationId   string                 `protobuf:"bytes,1,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	IntegrationName string                 `protobuf:"bytes,2,opt,name=integration_name,json=integrationName,proto3" json:"integration_name,omitempty"`
+	FieldPath       string                 `protobuf:"bytes,3,opt,name=field_path,json=fieldPath,proto3" json:"field_path,omitempty"`
+	KeyName         string                 `protobuf:"bytes,4,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SecretIntegrationUsage) Reset() {
+	*x = SecretIntegrationUsage{}
+	mi := &file_secrets_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretIntegrationUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretIntegrationUsage) ProtoMessage() {}
+
+func (x *SecretIntegrationUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretIntegrationUsage.ProtoReflect.Descriptor instead.
+func (*SecretIntegrationUsage) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SecretIntegrationUsage) GetIntegrationId() string {
+	if x != nil {
+		return x.IntegrationId
+	}
+	return ""
+}
+
+func (x *SecretIntegrationUsage) GetIntegrationName() string {
+	if x != nil {
+		return x.IntegrationName
+	}
+	return ""
+}
+
+func (x *SecretIntegrationUsage) GetFieldPath() string {
+	if x != nil {
+		return x.FieldPath
+	}
+	return ""
+}
+
+func (x *SecretIntegrationUsage) GetKeyName() string {
+	if x != nil {
+		return x.KeyName
+	}
+	return ""
+}
+
 type SetSecretKeyRequest struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	IdOrName      string                   `protobuf:"bytes,1,opt,name=id_or_name,json=idOrName,proto3" json:"id_or_name,omitempty"`
@@ -648,7 +920,7 @@ type SetSecretKeyRequest struct {
 
 func (x *SetSecretKeyRequest) Reset() {
 	*x = SetSecretKeyRequest{}
-	mi := &file_secrets_proto_msgTypes[11]
+	mi := &file_secrets_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -660,7 +932,7 @@ func (x *SetSecretKeyRequest) String() string {
 func (*SetSecretKeyRequest) ProtoMessage() {}
 
 func (x *SetSecretKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[11]
+	mi := &file_secrets_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,7 +945,7 @@ func (x *SetSecretKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetSecretKeyRequest.ProtoReflect.Descriptor instead.
 func (*SetSecretKeyRequest) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{11}
+	return file_secrets_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SetSecretKeyRequest) GetIdOrName() string {
@@ -720,7 +992,7 @@ type SetSecretKeyResponse struct {
 
 func (x *SetSecretKeyResponse) Reset() {
 	*x = SetSecretKeyResponse{}
-	mi := &file_secrets_proto_msgTypes[12]
+	mi := &file_secrets_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -732,7 +1004,7 @@ func (x *SetSecretKeyResponse) String() string {
 func (*SetSecretKeyResponse) ProtoMessage() {}
 
 func (x *SetSecretKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[12]
+	mi := &file_secrets_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,7 +1017,7 @@ func (x *SetSecretKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetSecretKeyResponse.ProtoReflect.Descriptor instead.
 func (*SetSecretKeyResponse) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{12}
+	return file_secrets_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SetSecretKeyResponse) GetSecret() *Secret {
@@ -767,7 +1039,7 @@ type DeleteSecretKeyRequest struct {
 
 func (x *DeleteSecretKeyRequest) Reset() {
 	*x = DeleteSecretKeyRequest{}
-	mi := &file_secrets_proto_msgTypes[13]
+	mi := &file_secrets_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -779,7 +1051,7 @@ func (x *DeleteSecretKeyRequest) String() string {
 func (*DeleteSecretKeyRequest) ProtoMessage() {}
 
 func (x *DeleteSecretKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[13]
+	mi := &file_secrets_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,7 +1064,7 @@ func (x *DeleteSecretKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSecretKeyRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSecretKeyRequest) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{13}
+	return file_secrets_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DeleteSecretKeyRequest) GetIdOrName() string {
@@ -832,7 +1104,7 @@ type DeleteSecretKeyResponse struct {
 
 func (x *DeleteSecretKeyResponse) Reset() {
 	*x = DeleteSecretKeyResponse{}
-	mi := &file_secrets_proto_msgTypes[14]
+	mi := &file_secrets_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -844,7 +1116,7 @@ func (x *DeleteSecretKeyResponse) String() string {
 func (*DeleteSecretKeyResponse) ProtoMessage() {}
 
 func (x *DeleteSecretKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[14]
+	mi := &file_secrets_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,7 +1129,7 @@ func (x *DeleteSecretKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSecretKeyResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSecretKeyResponse) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{14}
+	return file_secrets_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DeleteSecretKeyResponse) GetSecret() *Secret {
@@ -879,7 +1151,7 @@ type UpdateSecretNameRequest struct {
 
 func (x *UpdateSecretNameRequest) Reset() {
 	*x = UpdateSecretNameRequest{}
-	mi := &file_secrets_proto_msgTypes[15]
+	mi := &file_secrets_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -891,7 +1163,7 @@ func (x *UpdateSecretNameRequest) String() string {
 func (*UpdateSecretNameRequest) ProtoMessage() {}
 
 func (x *UpdateSecretNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[15]
+	mi := &file_secrets_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -904,7 +1176,7 @@ func (x *UpdateSecretNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSecretNameRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSecretNameRequest) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{15}
+	return file_secrets_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateSecretNameRequest) GetIdOrName() string {
@@ -944,7 +1216,7 @@ type UpdateSecretNameResponse struct {
 
 func (x *UpdateSecretNameResponse) Reset() {
 	*x = UpdateSecretNameResponse{}
-	mi := &file_secrets_proto_msgTypes[16]
+	mi := &file_secrets_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -956,7 +1228,7 @@ func (x *UpdateSecretNameResponse) String() string {
 func (*UpdateSecretNameResponse) ProtoMessage() {}
 
 func (x *UpdateSecretNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[16]
+	mi := &file_secrets_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -969,7 +1241,7 @@ func (x *UpdateSecretNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSecretNameResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSecretNameResponse) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{16}
+	return file_secrets_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateSecretNameResponse) GetSecret() *Secret {
@@ -989,7 +1261,7 @@ type Secret_Local struct {
 
 func (x *Secret_Local) Reset() {
 	*x = Secret_Local{}
-	mi := &file_secrets_proto_msgTypes[17]
+	mi := &file_secrets_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1001,7 +1273,7 @@ func (x *Secret_Local) String() string {
 func (*Secret_Local) ProtoMessage() {}
 
 func (x *Secret_Local) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[17]
+	mi := &file_secrets_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1037,7 +1309,7 @@ type Secret_Metadata struct {
 
 func (x *Secret_Metadata) Reset() {
 	*x = Secret_Metadata{}
-	mi := &file_secrets_proto_msgTypes[18]
+	mi := &file_secrets_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1049,7 +1321,7 @@ func (x *Secret_Metadata) String() string {
 func (*Secret_Metadata) ProtoMessage() {}
 
 func (x *Secret_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[18]
+	mi := &file_secrets_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1110,7 +1382,7 @@ type Secret_Spec struct {
 
 func (x *Secret_Spec) Reset() {
 	*x = Secret_Spec{}
-	mi := &file_secrets_proto_msgTypes[19]
+	mi := &file_secrets_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1122,7 +1394,7 @@ func (x *Secret_Spec) String() string {
 func (*Secret_Spec) ProtoMessage() {}
 
 func (x *Secret_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[19]
+	mi := &file_secrets_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1208,14 +1480,39 @@ const file_secrets_proto_rawDesc = "" +
 	"domainType\x12\x1b\n" +
 	"\tdomain_id\x18\x02 \x01(\tR\bdomainId\"K\n" +
 	"\x13ListSecretsResponse\x124\n" +
-	"\asecrets\x18\x01 \x03(\v2\x1a.Superplane.Secrets.SecretR\asecrets\"\x97\x01\n" +
+	"\asecrets\x18\x01 \x03(\v2\x1a.Superplane.Secrets.SecretR\asecrets\"\xad\x01\n" +
 	"\x13DeleteSecretRequest\x12E\n" +
 	"\vdomain_type\x18\x01 \x01(\x0e2$.Superplane.Authorization.DomainTypeR\n" +
 	"domainType\x12\x1b\n" +
 	"\tdomain_id\x18\x02 \x01(\tR\bdomainId\x12\x1c\n" +
 	"\n" +
-	"id_or_name\x18\x03 \x01(\tR\bidOrName\"\x16\n" +
-	"\x14DeleteSecretResponse\"\xc8\x01\n" +
+	"id_or_name\x18\x03 \x01(\tR\bidOrName\x12\x14\n" +
+	"\x05force\x18\x04 \x01(\bR\x05force\"\x16\n" +
+	"\x14DeleteSecretResponse\"\x9b\x01\n" +
+	"\x17ListSecretUsagesRequest\x12E\n" +
+	"\vdomain_type\x18\x01 \x01(\x0e2$.Superplane.Authorization.DomainTypeR\n" +
+	"domainType\x12\x1b\n" +
+	"\tdomain_id\x18\x02 \x01(\tR\bdomainId\x12\x1c\n" +
+	"\n" +
+	"id_or_name\x18\x03 \x01(\tR\bidOrName\"\xa5\x01\n" +
+	"\x18ListSecretUsagesResponse\x129\n" +
+	"\x05nodes\x18\x01 \x03(\v2#.Superplane.Secrets.SecretNodeUsageR\x05nodes\x12N\n" +
+	"\fintegrations\x18\x02 \x03(\v2*.Superplane.Secrets.SecretIntegrationUsageR\fintegrations\"\xbf\x01\n" +
+	"\x0fSecretNodeUsage\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1f\n" +
+	"\vcanvas_name\x18\x02 \x01(\tR\n" +
+	"canvasName\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x04 \x01(\tR\bnodeName\x12\x1d\n" +
+	"\n" +
+	"field_path\x18\x05 \x01(\tR\tfieldPath\x12\x19\n" +
+	"\bkey_name\x18\x06 \x01(\tR\akeyName\"\xa4\x01\n" +
+	"\x16SecretIntegrationUsage\x12%\n" +
+	"\x0eintegration_id\x18\x01 \x01(\tR\rintegrationId\x12)\n" +
+	"\x10integration_name\x18\x02 \x01(\tR\x0fintegrationName\x12\x1d\n" +
+	"\n" +
+	"field_path\x18\x03 \x01(\tR\tfieldPath\x12\x19\n" +
+	"\bkey_name\x18\x04 \x01(\tR\akeyName\"\xc8\x01\n" +
 	"\x13SetSecretKeyRequest\x12\x1c\n" +
 	"\n" +
 	"id_or_name\x18\x01 \x01(\tR\bidOrName\x12\x19\n" +
@@ -1243,7 +1540,7 @@ const file_secrets_proto_rawDesc = "" +
 	"domainType\x12\x1b\n" +
 	"\tdomain_id\x18\x04 \x01(\tR\bdomainId\"N\n" +
 	"\x18UpdateSecretNameResponse\x122\n" +
-	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret2\x9f\x0e\n" +
+	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret2\x8a\x11\n" +
 	"\aSecrets\x12\xb3\x01\n" +
 	"\fCreateSecret\x12'.Superplane.Secrets.CreateSecretRequest\x1a(.Superplane.Secrets.CreateSecretResponse\"P\x92A3\n" +
 	"\x06Secret\x12\x13Create a new secret\x1a\x14Creates a new secret\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/secrets\x12\xd6\x01\n" +
@@ -1252,9 +1549,11 @@ const file_secrets_proto_rawDesc = "" +
 	"\vListSecrets\x12&.Superplane.Secrets.ListSecretsRequest\x1a'.Superplane.Secrets.ListSecretsResponse\"M\x92A3\n" +
 	"\x06Secret\x12\fList secrets\x1a\x1bReturns the list of secrets\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/secrets\x12\xc5\x01\n" +
 	"\fUpdateSecret\x12'.Superplane.Secrets.UpdateSecretRequest\x1a(.Superplane.Secrets.UpdateSecretResponse\"b\x92A8\n" +
-	"\x06Secret\x12\x10Updates a secret\x1a\x1cUpdates the specified secret\x82\xd3\xe4\x93\x02!:\x01*2\x1c/api/v1/secrets/{id_or_name}\x12\xc2\x01\n" +
-	"\fDeleteSecret\x12'.Superplane.Secrets.DeleteSecretRequest\x1a(.Superplane.Secrets.DeleteSecretResponse\"_\x92A8\n" +
-	"\x06Secret\x12\x10Deletes a secret\x1a\x1cDeletes the specified secret\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/secrets/{id_or_name}\x12\xa2\x02\n" +
+	"\x06Secret\x12\x10Updates a secret\x1a\x1cUpdates the specified secret\x82\xd3\xe4\x93\x02!:\x01*2\x1c/api/v1/secrets/{id_or_name}\x12\x9b\x02\n" +
+	"\fDeleteSecret\x12'.Superplane.Secrets.DeleteSecretRequest\x1a(.Superplane.Secrets.DeleteSecretResponse\"\xb7\x01\x92A\x8f\x01\n" +
+	"\x06Secret\x12\x10Deletes a secret\x1asDeletes the specified secret. Fails if canvas nodes or integrations still reference the secret, unless force is set\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/secrets/{id_or_name}\x12\x8f\x02\n" +
+	"\x10ListSecretUsages\x12+.Superplane.Secrets.ListSecretUsagesRequest\x1a,.Superplane.Secrets.ListSecretUsagesResponse\"\x9f\x01\x92Aq\n" +
+	"\x06Secret\x12\x12List secret usages\x1aSReturns the canvas nodes and integrations whose configuration references the secret\x82\xd3\xe4\x93\x02%\x12#/api/v1/secrets/{id_or_name}/usages\x12\xa2\x02\n" +
 	"\fSetSecretKey\x12'.Superplane.Secrets.SetSecretKeyRequest\x1a(.Superplane.Secrets.SetSecretKeyResponse\"\xbe\x01\x92A\x83\x01\n" +
 	"\x06Secret\x12)Set or overwrite a single key in a secret\x1aNSets the value for one key. Creates the key if missing, overwrites if present.\x82\xd3\xe4\x93\x021:\x01*\x1a,/api/v1/secrets/{id_or_name}/keys/{key_name}\x12\x97\x02\n" +
 	"\x0fDeleteSecretKey\x12*.Superplane.Secrets.DeleteSecretKeyRequest\x1a+.Superplane.Secrets.DeleteSecretKeyResponse\"\xaa\x01\x92As\n" +
@@ -1277,7 +1576,7 @@ func file_secrets_proto_rawDescGZIP() []byte {
 }
 
 var file_secrets_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_secrets_proto_goTypes = []any{
 	(Secret_Provider)(0),             // 0: Superplane.Secrets.Secret.Provider
 	(*Secret)(nil),                   // 1: Superplane.Secrets.Secret
@@ -1291,65 +1590,74 @@ var file_secrets_proto_goTypes = []any{
 	(*ListSecretsResponse)(nil),      // 9: Superplane.Secrets.ListSecretsResponse
 	(*DeleteSecretRequest)(nil),      // 10: Superplane.Secrets.DeleteSecretRequest
 	(*DeleteSecretResponse)(nil),     // 11: Superplane.Secrets.DeleteSecretResponse
-	(*SetSecretKeyRequest)(nil),      // 12: Superplane.Secrets.SetSecretKeyRequest
-	(*SetSecretKeyResponse)(nil),     // 13: Superplane.Secrets.SetSecretKeyResponse
-	(*DeleteSecretKeyRequest)(nil),   // 14: Superplane.Secrets.DeleteSecretKeyRequest
-	(*DeleteSecretKeyResponse)(nil),  // 15: Superplane.Secrets.DeleteSecretKeyResponse
-	(*UpdateSecretNameRequest)(nil),  // 16: Superplane.Secrets.UpdateSecretNameRequest
-	(*UpdateSecretNameResponse)(nil), // 17: Superplane.Secrets.UpdateSecretNameResponse
-	(*Secret_Local)(nil),             // 18: Superplane.Secrets.Secret.Local
-	(*Secret_Metadata)(nil),          // 19: Superplane.Secrets.Secret.Metadata
-	(*Secret_Spec)(nil),              // 20: Superplane.Secrets.Secret.Spec
-	nil,                              // 21: Superplane.Secrets.Secret.Local.DataEntry
-	(authorization.DomainType)(0),    // 22: Superplane.Authorization.DomainType
-	(*timestamp.Timestamp)(nil),      // 23: google.protobuf.Timestamp
+	(*ListSecretUsagesRequest)(nil),  // 12: Superplane.Secrets.ListSecretUsagesRequest
+	(*ListSecretUsagesResponse)(nil), // 13: Superplane.Secrets.ListSecretUsagesResponse
+	(*SecretNodeUsage)(nil),          // 14: Superplane.Secrets.SecretNodeUsage
+	(*SecretIntegrationUsage)(nil),   // 15: Superplane.Secrets.SecretIntegrationUsage
+	(*SetSecretKeyRequest)(nil),      // 16: Superplane.Secrets.SetSecretKeyRequest
+	(*SetSecretKeyResponse)(nil),     // 17: Superplane.Secrets.SetSecretKeyResponse
+	(*DeleteSecretKeyRequest)(nil),   // 18: Superplane.Secrets.DeleteSecretKeyRequest
+	(*DeleteSecretKeyResponse)(nil),  // 19: Superplane.Secrets.DeleteSecretKeyResponse
+	(*UpdateSecretNameRequest)(nil),  // 20: Superplane.Secrets.UpdateSecretNameRequest
+	(*UpdateSecretNameResponse)(nil), // 21: Superplane.Secrets.UpdateSecretNameResponse
+	(*Secret_Local)(nil),             // 22: Superplane.Secrets.Secret.Local
+	(*Secret_Metadata)(nil),          // 23: Superplane.Secrets.Secret.Metadata
+	(*Secret_Spec)(nil),              // 24: Superplane.Secrets.Secret.Spec
+	nil,                              // 25: Superplane.Secrets.Secret.Local.DataEntry
+	(authorization.DomainType)(0),    // 26: Superplane.Authorization.DomainType
+	(*timestamp.Timestamp)(nil),      // 27: google.protobuf.Timestamp
 }
 var file_secrets_proto_depIdxs = []int32{
-	19, // 0: Superplane.Secrets.Secret.metadata:type_name -> Superplane.Secrets.Secret.Metadata
-	20, // 1: Superplane.Secrets.Secret.spec:type_name -> Superplane.Secrets.Secret.Spec
+	23, // 0: Superplane.Secrets.Secret.metadata:type_name -> Superplane.Secrets.Secret.Metadata
+	24, // 1: Superplane.Secrets.Secret.spec:type_name -> Superplane.Secrets.Secret.Spec
 	1,  // 2: Superplane.Secrets.CreateSecretRequest.secret:type_name -> Superplane.Secrets.Secret
-	22, // 3: Superplane.Secrets.CreateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	26, // 3: Superplane.Secrets.CreateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
 	1,  // 4: Superplane.Secrets.CreateSecretResponse.secret:type_name -> Superplane.Secrets.Secret
 	1,  // 5: Superplane.Secrets.UpdateSecretRequest.secret:type_name -> Superplane.Secrets.Secret
-	22, // 6: Superplane.Secrets.UpdateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	26, // 6: Superplane.Secrets.UpdateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
 	1,  // 7: Superplane.Secrets.UpdateSecretResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 8: Superplane.Secrets.DescribeSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	26, // 8: Superplane.Secrets.DescribeSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
 	1,  // 9: Superplane.Secrets.DescribeSecretResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 10: Superplane.Secrets.ListSecretsRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	26, // 10: Superplane.Secrets.ListSecretsRequest.domain_type:type_name -> Superplane.Authorization.DomainType
 	1,  // 11: Superplane.Secrets.ListSecretsResponse.secrets:type_name -> Superplane.Secrets.Secret
-	22, // 12: Superplane.Secrets.DeleteSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	22, // 13: Superplane.Secrets.SetSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 14: Superplane.Secrets.SetSecretKeyResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 15: Superplane.Secrets.DeleteSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 16: Superplane.Secrets.DeleteSecretKeyResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 17: Superplane.Secrets.UpdateSecretNameRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 18: Superplane.Secrets.UpdateSecretNameResponse.secret:type_name -> Superplane.Secrets.Secret
-	21, // 19: Superplane.Secrets.Secret.Local.data:type_name -> Superplane.Secrets.Secret.Local.DataEntry
-	22, // 20: Superplane.Secrets.Secret.Metadata.domain_type:type_name -> Superplane.Authorization.DomainType
-	23, // 21: Superplane.Secrets.Secret.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 22: Superplane.Secrets.Secret.Spec.provider:type_name -> Superplane.Secrets.Secret.Provider
-	18, // 23: Superplane.Secrets.Secret.Spec.local:type_name -> Superplane.Secrets.Secret.Local
-	2,  // 24: Superplane.Secrets.Secrets.CreateSecret:input_type -> Superplane.Secrets.CreateSecretRequest
-	6,  // 25: Superplane.Secrets.Secrets.DescribeSecret:input_type -> Superplane.Secrets.DescribeSecretRequest
-	8,  // 26: Superplane.Secrets.Secrets.ListSecrets:input_type -> Superplane.Secrets.ListSecretsRequest
-	4,  // 27: Superplane.Secrets.Secrets.UpdateSecret:input_type -> Superplane.Secrets.UpdateSecretRequest
-	10, // 28: Superplane.Secrets.Secrets.DeleteSecret:input_type -> Superplane.Secrets.DeleteSecretRequest
-	12, // 29: Superplane.Secrets.Secrets.SetSecretKey:input_type -> Superplane.Secrets.SetSecretKeyRequest
-	14, // 30: Superplane.Secrets.Secrets.DeleteSecretKey:input_type -> Superplane.Secrets.DeleteSecretKeyRequest
-	16, // 31: Superplane.Secrets.Secrets.UpdateSecretName:input_type -> Superplane.Secrets.UpdateSecretNameRequest
-	3,  // 32: Superplane.Secrets.Secrets.CreateSecret:output_type -> Superplane.Secrets.CreateSecretResponse
-	7,  // 33: Superplane.Secrets.Secrets.DescribeSecret:output_type -> Superplane.Secrets.DescribeSecretResponse
-	9,  // 34: Superplane.Secrets.Secrets.ListSecrets:output_type -> Superplane.Secrets.ListSecretsResponse
-	5,  // 35: Superplane.Secrets.Secrets.UpdateSecret:output_type -> Superplane.Secrets.UpdateSecretResponse
-	11, // 36: Superplane.Secrets.Secrets.DeleteSecret:output_type -> Superplane.Secrets.DeleteSecretResponse
-	13, // 37: Superplane.Secrets.Secrets.SetSecretKey:output_type -> Superplane.Secrets.SetSecretKeyResponse
-	15, // 38: Superplane.Secrets.Secrets.DeleteSecretKey:output_type -> Superplane.Secrets.DeleteSecretKeyResponse
-	17, // 39: Superplane.Secrets.Secrets.UpdateSecretName:output_type -> Superplane.Secrets.UpdateSecretNameResponse
-	32, // [32:40] is the sub-list for method output_type
-	24, // [24:32] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	26, // 12: Superplane.Secrets.DeleteSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	26, // 13: Superplane.Secrets.ListSecretUsagesRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	14, // 14: Superplane.Secrets.ListSecretUsagesResponse.nodes:type_name -> Superplane.Secrets.SecretNodeUsage
+	15, // 15: Superplane.Secrets.ListSecretUsagesResponse.integrations:type_name -> Superplane.Secrets.SecretIntegrationUsage
+	26, // 16: Superplane.Secrets.SetSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	1,  // 17: Superplane.Secrets.SetSecretKeyResponse.secret:type_name -> Superplane.Secrets.Secret
+	26, // 18: Superplane.Secrets.DeleteSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	1,  // 19: Superplane.Secrets.DeleteSecretKeyResponse.secret:type_name -> Superplane.Secrets.Secret
+	26, // 20: Superplane.Secrets.UpdateSecretNameRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	1,  // 21: Superplane.Secrets.UpdateSecretNameResponse.secret:type_name -> Superplane.Secrets.Secret
+	25, // 22: Superplane.Secrets.Secret.Local.data:type_name -> Superplane.Secrets.Secret.Local.DataEntry
+	26, // 23: Superplane.Secrets.Secret.Metadata.domain_type:type_name -> Superplane.Authorization.DomainType
+	27, // 24: Superplane.Secrets.Secret.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 25: Superplane.Secrets.Secret.Spec.provider:type_name -> Superplane.Secrets.Secret.Provider
+	22, // 26: Superplane.Secrets.Secret.Spec.local:type_name -> Superplane.Secrets.Secret.Local
+	2,  // 27: Superplane.Secrets.Secrets.CreateSecret:input_type -> Superplane.Secrets.CreateSecretRequest
+	6,  // 28: Superplane.Secrets.Secrets.DescribeSecret:input_type -> Superplane.Secrets.DescribeSecretRequest
+	8,  // 29: Superplane.Secrets.Secrets.ListSecrets:input_type -> Superplane.Secrets.ListSecretsRequest
+	4,  // 30: Superplane.Secrets.Secrets.UpdateSecret:input_type -> Superplane.Secrets.UpdateSecretRequest
+	10, // 31: Superplane.Secrets.Secrets.DeleteSecret:input_type -> Superplane.Secrets.DeleteSecretRequest
+	12, // 32: Superplane.Secrets.Secrets.ListSecretUsages:input_type -> Superplane.Secrets.ListSecretUsagesRequest
+	16, // 33: Superplane.Secrets.Secrets.SetSecretKey:input_type -> Superplane.Secrets.SetSecretKeyRequest
+	18, // 34: Superplane.Secrets.Secrets.DeleteSecretKey:input_type -> Superplane.Secrets.DeleteSecretKeyRequest
+	20, // 35: Superplane.Secrets.Secrets.UpdateSecretName:input_type -> Superplane.Secrets.UpdateSecretNameRequest
+	3,  // 36: Superplane.Secrets.Secrets.CreateSecret:output_type -> Superplane.Secrets.CreateSecretResponse
+	7,  // 37: Superplane.Secrets.Secrets.DescribeSecret:output_type -> Superplane.Secrets.DescribeSecretResponse
+	9,  // 38: Superplane.Secrets.Secrets.ListSecrets:output_type -> Superplane.Secrets.ListSecretsResponse
+	5,  // 39: Superplane.Secrets.Secrets.UpdateSecret:output_type -> Superplane.Secrets.UpdateSecretResponse
+	11, // 40: Superplane.Secrets.Secrets.DeleteSecret:output_type -> Superplane.Secrets.DeleteSecretResponse
+	13, // 41: Superplane.Secrets.Secrets.ListSecretUsages:output_type -> Superplane.Secrets.ListSecretUsagesResponse
+	17, // 42: Superplane.Secrets.Secrets.SetSecretKey:output_type -> Superplane.Secrets.SetSecretKeyResponse
+	19, // 43: Superplane.Secrets.Secrets.DeleteSecretKey:output_type -> Superplane.Secrets.DeleteSecretKeyResponse
+	21, // 44: Superplane.Secrets.Secrets.UpdateSecretName:output_type -> Superplane.Secrets.UpdateSecretNameResponse
+	36, // [36:45] is the sub-list for method output_type
+	27, // [27:36] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_secrets_proto_init() }
@@ -1363,7 +1671,7 @@ func file_secrets_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_secrets_proto_rawDesc), len(file_secrets_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   21,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
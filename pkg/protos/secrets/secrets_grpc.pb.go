@@ -24,6 +24,7 @@ const (
 	Secrets_ListSecrets_FullMethodName      = "/Superplane.Secrets.Secrets/ListSecrets"
 	Secrets_UpdateSecret_FullMethodName     = "/Superplane.Secrets.Secrets/UpdateSecret"
 	Secrets_DeleteSecret_FullMethodName     = "/Superplane.Secrets.Secrets/DeleteSecret"
+	Secrets_ListSecretUsages_FullMethodName = "/Superplane.Secrets.Secrets/ListSecretUsages"
 	Secrets_SetSecretKey_FullMethodName     = "/Superplane.Secrets.Secrets/SetSecretKey"
 	Secrets_DeleteSecretKey_FullMethodName  = "/Superplane.Secrets.Secrets/DeleteSecretKey"
 	Secrets_UpdateSecretName_FullMethodName = "/Superplane.Secrets.Secrets/UpdateSecretName"
@@ -38,6 +39,7 @@ type SecretsClient interface {
 	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
 	UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error)
 	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*DeleteSecretResponse, error)
+	ListSecretUsages(ctx context.Context, in *ListSecretUsagesRequest, opts ...grpc.CallOption) (*ListSecretUsagesResponse, error)
 	SetSecretKey(ctx context.Context, in *SetSecretKeyRequest, opts ...grpc.CallOption) (*SetSecretKeyResponse, error)
 	DeleteSecretKey(ctx context.Context, in *DeleteSecretKeyRequest, opts ...grpc.CallOption) (*DeleteSecretKeyResponse, error)
 	UpdateSecretName(ctx context.Context, in *UpdateSecretNameRequest, opts ...grpc.CallOption) (*UpdateSecretNameResponse, error)
@@ -101,6 +103,16 @@ func (c *secretsClient) DeleteSecret(ctx context.Context, in *DeleteSecretReques
 	return out, nil
 }
 
+func (c *secretsClient) ListSecretUsages(ctx context.Context, in *ListSecretUsagesRequest, opts ...grpc.CallOption) (*ListSecretUsagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSecretUsagesResponse)
+	err := c.cc.Invoke(ctx, Secrets_ListSecretUsages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *secretsClient) SetSecretKey(ctx context.Context, in *SetSecretKeyRequest, opts ...grpc.CallOption) (*SetSecretKeyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SetSecretKeyResponse)
@@ -140,6 +152,7 @@ type SecretsServer interface {
 	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
 	UpdateSecret(context.Context, *UpdateSecretRequest) (*UpdateSecretResponse, error)
 	DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error)
+	ListSecretUsages(context.Context, *ListSecretUsagesRequest) (*ListSecretUsagesResponse, error)
 	SetSecretKey(context.Context, *SetSecretKeyRequest) (*SetSecretKeyResponse, error)
 	DeleteSecretKey(context.Context, *DeleteSecretKeyRequest) (*DeleteSecretKeyResponse, error)
 	UpdateSecretName(context.Context, *UpdateSecretNameRequest) (*UpdateSecretNameResponse, error)
@@ -167,6 +180,9 @@ func (UnimplementedSecretsServer) UpdateSecret(context.Context, *UpdateSecretReq
 func (UnimplementedSecretsServer) DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteSecret not implemented")
 }
+func (UnimplementedSecretsServer) ListSecretUsages(context.Context, *ListSecretUsagesRequest) (*ListSecretUsagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSecretUsages not implemented")
+}
 func (UnimplementedSecretsServer) SetSecretKey(context.Context, *SetSecretKeyRequest) (*SetSecretKeyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetSecretKey not implemented")
 }
@@ -286,6 +302,24 @@ func _Secrets_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Secrets_ListSecretUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).ListSecretUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Secrets_ListSecretUsages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).ListSecretUsages(ctx, req.(*ListSecretUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Secrets_SetSecretKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetSecretKeyRequest)
 	if err := dec(in); err != nil {
@@ -367,6 +401,10 @@ var Secrets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSecret",
 			Handler:    _Secrets_DeleteSecret_Handler,
 		},
+		{
+			MethodName: "ListSecretUsages",
+			Handler:    _Secrets_ListSecretUsages_Handler,
+		},
 		{
 			MethodName: "SetSecretKey",
 			Handler:    _Secrets_SetSecretKey_Handler,
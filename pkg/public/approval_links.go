@@ -0,0 +1,200 @@
+package public
+
+import (
+	"html/template"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/approvals"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/canvases"
+	"github.com/superplanehq/superplane/pkg/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var approvalLinkTemplate = template.Must(template.New("approval-link").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>{{.Title}} - SuperPlane</title>
+  <style>
+    body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", sans-serif; background: #f8fafc; color: #1f2937; }
+    main { max-width: 480px; margin: 80px auto; background: #fff; border: 1px solid #e5e7eb; border-radius: 8px; padding: 32px; }
+    h1 { font-size: 20px; margin: 0 0 12px; }
+    p { line-height: 1.5; }
+    textarea { width: 100%; box-sizing: border-box; min-height: 80px; margin: 8px 0 16px; }
+    button { background: #111827; color: #fff; border: 0; border-radius: 6px; padding: 10px 16px; cursor: pointer; }
+    .error { color: #b91c1c; }
+  </style>
+</head>
+<body>
+  <main>
+    <h1>{{.Title}}</h1>
+    <p>{{.Message}}</p>
+    {{if .Error}}<p class="error">{{.Error}}</p>{{end}}
+    {{if .ShowForm}}
+    <form method="POST">
+      {{if eq .Decision "reject"}}
+      <label for="reason">Reason</label>
+      <textarea id="reason" name="reason" required></textarea>
+      {{else}}
+      <label for="comment">Comment (optional)</label>
+      <textarea id="comment" name="comment"></textarea>
+      {{end}}
+      <button type="submit">{{if eq .Decision "reject"}}Reject{{else}}Approve{{end}}</button>
+    </form>
+    {{end}}
+  </main>
+</body>
+</html>
+`))
+
+type approvalLinkPage struct {
+	Title    string
+	Message  string
+	Error    string
+	Decision string
+	ShowForm bool
+}
+
+/*
+ * Approval links are opened from emails or chat messages, without a session.
+ * The signed token identifies the user, the execution and the decision.
+ *
+ * GET only renders a confirmation form, so link previews and email scanners
+ * following the link do not record a decision. The decision is recorded on POST.
+ */
+func (s *Server) HandleApprovalLink(w http.ResponseWriter, r *http.Request) {
+	claims, err := approvals.ParseLinkToken(s.jwt, mux.Vars(r)["token"])
+	if err != nil {
+		renderApprovalLinkPage(w, http.StatusNotFound, approvalLinkPage{
+			Title:   "Link not valid",
+			Message: "This approval link is invalid or has expired.",
+		})
+		return
+	}
+
+	execution, err := models.FindNodeExecutionWithoutWorkflowScope(claims.ExecutionID)
+	if err != nil {
+		renderApprovalLinkPage(w, http.StatusNotFound, approvalLinkPage{
+			Title:   "Link not valid",
+			Message: "This approval link is invalid or has expired.",
+		})
+		return
+	}
+
+	canvas, err := models.FindCanvasWithoutOrgScope(execution.WorkflowID)
+	if err != nil {
+		renderApprovalLinkPage(w, http.StatusNotFound, approvalLinkPage{
+			Title:   "Link not valid",
+			Message: "This approval link is invalid or has expired.",
+		})
+		return
+	}
+
+	user, err := models.FindActiveUserByID(canvas.OrganizationID.String(), claims.UserID.String())
+	if err != nil {
+		renderApprovalLinkPage(w, http.StatusNotFound, approvalLinkPage{
+			Title:   "Link not valid",
+			Message: "This approval link is invalid or has expired.",
+		})
+		return
+	}
+
+	if execution.State == models.CanvasNodeExecutionStateFinished {
+		renderApprovalLinkPage(w, http.StatusGone, approvalLinkPage{
+			Title:   "Approval no longer pending",
+			Message: "A decision was already recorded for this approval.",
+		})
+		return
+	}
+
+	page := approvalLinkPage{
+		Title:    "Approval required",
+		Message:  "Confirm your decision for this approval.",
+		Decision: claims.Decision,
+		ShowForm: true,
+	}
+
+	if r.Method == http.MethodGet {
+		renderApprovalLinkPage(w, http.StatusOK, page)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		page.Error = "Error reading the form."
+		renderApprovalLinkPage(w, http.StatusBadRequest, page)
+		return
+	}
+
+	parameters := map[string]any{"index": float64(claims.Index)}
+	if claims.Decision == approvals.DecisionReject {
+		reason := strings.TrimSpace(r.PostForm.Get("reason"))
+		if reason == "" {
+			page.Error = "A reason is required to reject."
+			renderApprovalLinkPage(w, http.StatusBadRequest, page)
+			return
+		}
+
+		parameters["reason"] = reason
+	} else if comment := strings.TrimSpace(r.PostForm.Get("comment")); comment != "" {
+		parameters["comment"] = comment
+	}
+
+	err = canvases.InvokeNodeExecutionActionAsUser(
+		s.authService,
+		s.encryptor,
+		s.registry,
+		canvas.OrganizationID,
+		canvas.ID,
+		execution.ID,
+		user,
+		claims.Decision,
+		parameters,
+	)
+
+	if err != nil {
+		//
+		// Action errors mean the decision can no longer be recorded,
+		// e.g. the user already decided through another link or the UI.
+		//
+		if status.Code(err) == codes.InvalidArgument {
+			renderApprovalLinkPage(w, http.StatusConflict, approvalLinkPage{
+				Title:   "Decision not recorded",
+				Message: "This approval link can no longer be used.",
+				Error:   status.Convert(err).Message(),
+			})
+			return
+		}
+
+		log.Errorf("error recording decision from approval link for execution %s: %v", execution.ID, err)
+		renderApprovalLinkPage(w, http.StatusInternalServerError, approvalLinkPage{
+			Title:   "Decision not recorded",
+			Message: "Something went wrong while recording your decision. Please try again.",
+		})
+		return
+	}
+
+	title := "Approved"
+	message := "Your approval was recorded."
+	if claims.Decision == approvals.DecisionReject {
+		title = "Rejected"
+		message = "Your rejection was recorded."
+	}
+
+	renderApprovalLinkPage(w, http.StatusOK, approvalLinkPage{Title: title, Message: message})
+}
+
+func renderApprovalLinkPage(w http.ResponseWriter, statusCode int, page approvalLinkPage) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.WriteHeader(statusCode)
+
+	if err := approvalLinkTemplate.Execute(w, page); err != nil {
+		log.Errorf("error rendering approval link page: %v", err)
+	}
+}
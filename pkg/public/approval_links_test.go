@@ -0,0 +1,132 @@
+package public
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/approvals"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__HandleApprovalLink(t *testing.T) {
+	r := support.Setup(t)
+
+	signer := jwt.NewSigner("test")
+	server, err := NewServer(r.Encryptor, r.Registry, signer, support.NewOIDCProvider(), "", "http://localhost", "", "test", "/app/templates", r.AuthService, false)
+	require.NoError(t, err)
+
+	createExecution := func(t *testing.T) *models.CanvasNodeExecution {
+		canvas, _ := support.CreateCanvas(
+			t,
+			r.Organization.ID,
+			r.User,
+			[]models.CanvasNode{
+				{
+					NodeID: "approval",
+					Type:   models.NodeTypeComponent,
+					Ref: datatypes.NewJSONType(models.NodeRef{
+						Component: &models.ComponentRef{Name: "approval"},
+					}),
+				},
+			},
+			[]models.Edge{},
+		)
+
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "approval", uuid.New(), uuid.New(), nil)
+		execution.State = models.CanvasNodeExecutionStateStarted
+		execution.Metadata = datatypes.NewJSONType(map[string]any{
+			"result": "pending",
+			"records": []any{
+				map[string]any{
+					"index": 0,
+					"type":  "user",
+					"state": "pending",
+					"user":  map[string]any{"id": r.User.String()},
+				},
+			},
+		})
+
+		require.NoError(t, database.Conn().Save(execution).Error)
+		return execution
+	}
+
+	linkPath := func(t *testing.T, executionID uuid.UUID, decision string, duration time.Duration) string {
+		token, err := approvals.NewLinkToken(signer, executionID, r.User, decision, 0, duration)
+		require.NoError(t, err)
+		return "/approvals/" + token
+	}
+
+	submit := func(path string, form url.Values) *http.Response {
+		req, _ := http.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		res := httptest.NewRecorder()
+		server.Router.ServeHTTP(res, req)
+		return res.Result()
+	}
+
+	t.Run("invalid token -> not found", func(t *testing.T) {
+		response := execRequest(server, requestParams{method: "GET", path: "/approvals/not-a-token"})
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("expired token -> not found", func(t *testing.T) {
+		execution := createExecution(t)
+		response := execRequest(server, requestParams{method: "GET", path: linkPath(t, execution.ID, approvals.DecisionApprove, -time.Minute)})
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("GET renders a confirmation form without recording a decision", func(t *testing.T) {
+		execution := createExecution(t)
+		response := execRequest(server, requestParams{method: "GET", path: linkPath(t, execution.ID, approvals.DecisionApprove, time.Hour)})
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Contains(t, response.Body.String(), "<form method=\"POST\">")
+
+		execution, err := models.FindNodeExecution(execution.WorkflowID, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateStarted, execution.State)
+	})
+
+	t.Run("POST approves the execution and the link cannot be reused", func(t *testing.T) {
+		execution := createExecution(t)
+		path := linkPath(t, execution.ID, approvals.DecisionApprove, time.Hour)
+
+		response := submit(path, url.Values{"comment": {"looks good"}})
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+
+		execution, err := models.FindNodeExecution(execution.WorkflowID, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateFinished, execution.State)
+		assert.Equal(t, models.CanvasNodeExecutionResultPassed, execution.Result)
+
+		response = submit(path, url.Values{})
+		assert.Equal(t, http.StatusGone, response.StatusCode)
+	})
+
+	t.Run("reject without reason -> bad request", func(t *testing.T) {
+		execution := createExecution(t)
+		response := submit(linkPath(t, execution.ID, approvals.DecisionReject, time.Hour), url.Values{})
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("reject with reason rejects the execution", func(t *testing.T) {
+		execution := createExecution(t)
+		response := submit(linkPath(t, execution.ID, approvals.DecisionReject, time.Hour), url.Values{"reason": {"not now"}})
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+
+		execution, err := models.FindNodeExecution(execution.WorkflowID, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateFinished, execution.State)
+		assert.Equal(t, "rejected", execution.Metadata.Data()["result"])
+	})
+}
@@ -0,0 +1,58 @@
+package public
+
+import (
+	"bytes"
+	"net/http"
+)
+
+/*
+ * bufferedResponse holds what a handler writes,
+ * so the response is only sent after the request is finished.
+ */
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newBufferedResponse() *bufferedResponse {
+	return &bufferedResponse{header: http.Header{}}
+}
+
+func (b *bufferedResponse) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	if b.status != 0 {
+		return
+	}
+
+	b.status = status
+}
+
+func (b *bufferedResponse) Write(data []byte) (int, error) {
+	b.WriteHeader(http.StatusOK)
+	return b.body.Write(data)
+}
+
+func (b *bufferedResponse) StatusCode() int {
+	if b.status == 0 {
+		return http.StatusOK
+	}
+
+	return b.status
+}
+
+func (b *bufferedResponse) Failed() bool {
+	return b.StatusCode() >= http.StatusBadRequest
+}
+
+func (b *bufferedResponse) WriteTo(w http.ResponseWriter) {
+	for name, values := range b.header {
+		w.Header()[name] = values
+	}
+
+	w.WriteHeader(b.StatusCode())
+	_, _ = w.Write(b.body.Bytes())
+}
@@ -0,0 +1,107 @@
+package public
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type gatewayErrorResponse struct {
+	Error gatewayError `json:"error"`
+}
+
+type gatewayError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+/*
+ * All errors returned by the gRPC gateway routes use the same JSON shape:
+ * {"error": {"code": "NOT_FOUND", "message": "..."}}
+ */
+func writeGatewayError(w http.ResponseWriter, httpStatus int, grpcCode codes.Code, message string) {
+	w.Header().Del("Trailer")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+
+	err := json.NewEncoder(w).Encode(gatewayErrorResponse{
+		Error: gatewayError{
+			Code:    code.Code_name[int32(grpcCode)],
+			Message: message,
+		},
+	})
+
+	if err != nil {
+		log.Errorf("Error writing gateway error response: %v", err)
+	}
+}
+
+func gatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	httpStatus := 0
+
+	//
+	// Errors produced by the gateway itself, e.g. an unsupported method,
+	// carry the HTTP status that should be returned.
+	//
+	var httpStatusErr *runtime.HTTPStatusError
+	if errors.As(err, &httpStatusErr) {
+		httpStatus = httpStatusErr.HTTPStatus
+		err = httpStatusErr.Err
+	}
+
+	s := status.Convert(err)
+	grpcCode := s.Code()
+	message := s.Message()
+
+	//
+	// Authorization failures are reported as not found,
+	// to avoid disclosing that the resource exists.
+	//
+	if grpcCode == codes.PermissionDenied {
+		grpcCode = codes.NotFound
+		message = "Not found"
+	}
+
+	if httpStatus == 0 {
+		httpStatus = runtime.HTTPStatusFromCode(grpcCode)
+	}
+
+	writeGatewayError(w, httpStatus, grpcCode, message)
+}
+
+/*
+ * Request bodies are read upfront with a limit,
+ * so oversized requests are rejected before reaching the gRPC server.
+ */
+func (s *Server) limitRequestBody(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Body == nil || r.Body == http.NoBody {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.maxRequestBodyBytes))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				writeGatewayError(w, http.StatusRequestEntityTooLarge, codes.InvalidArgument, "request body too large")
+				return
+			}
+
+			writeGatewayError(w, http.StatusBadRequest, codes.InvalidArgument, "error reading request body")
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,121 @@
+package public
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/jwt"
+	pbSecret "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"github.com/superplanehq/superplane/test/support"
+	grpcLib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__GatewayErrorHandler(t *testing.T) {
+	handle := func(err error) (int, gatewayErrorResponse) {
+		recorder := httptest.NewRecorder()
+		gatewayErrorHandler(context.Background(), nil, nil, recorder, httptest.NewRequest(http.MethodGet, "/", nil), err)
+
+		response := gatewayErrorResponse{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+		return recorder.Code, response
+	}
+
+	t.Run("gRPC status is mapped to HTTP status", func(t *testing.T) {
+		code, response := handle(status.Error(codes.InvalidArgument, "name is required"))
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "INVALID_ARGUMENT", response.Error.Code)
+		assert.Equal(t, "name is required", response.Error.Message)
+	})
+
+	t.Run("permission denied is reported as not found", func(t *testing.T) {
+		code, response := handle(status.Error(codes.PermissionDenied, "missing permission secrets:create"))
+		assert.Equal(t, http.StatusNotFound, code)
+		assert.Equal(t, "NOT_FOUND", response.Error.Code)
+		assert.Equal(t, "Not found", response.Error.Message)
+	})
+
+	t.Run("gateway HTTP status errors keep their status", func(t *testing.T) {
+		code, response := handle(&runtime.HTTPStatusError{
+			HTTPStatus: http.StatusMethodNotAllowed,
+			Err:        status.Error(codes.Unimplemented, "Method Not Allowed"),
+		})
+
+		assert.Equal(t, http.StatusMethodNotAllowed, code)
+		assert.Equal(t, "UNIMPLEMENTED", response.Error.Code)
+	})
+}
+
+func Test__GatewayProtectedRoutes(t *testing.T) {
+	r := support.Setup(t)
+
+	//
+	// The gRPC server only runs the authorization interceptor,
+	// so requests that are authorized reach the unimplemented handlers.
+	//
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpcLib.NewServer(grpcLib.UnaryInterceptor(authorization.NewAuthorizationInterceptor(r.AuthService).UnaryInterceptor()))
+	pbSecret.RegisterSecretsServer(grpcServer, &pbSecret.UnimplementedSecretsServer{})
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	server, err := NewServer(&crypto.NoOpEncryptor{}, r.Registry, jwt.NewSigner("test"), support.NewOIDCProvider(), "", "", "", "test", "/app/templates", r.AuthService, false)
+	require.NoError(t, err)
+	require.NoError(t, server.RegisterGRPCGateway(listener.Addr().String()))
+
+	viewer := support.CreateUser(t, r, r.Organization.ID)
+	token := "viewer-token"
+	require.NoError(t, viewer.UpdateTokenHash(crypto.HashToken(token)))
+
+	decode := func(t *testing.T, body string) gatewayErrorResponse {
+		response := gatewayErrorResponse{}
+		require.NoError(t, json.Unmarshal([]byte(body), &response))
+		return response
+	}
+
+	t.Run("permission failure -> 404 without permission wording", func(t *testing.T) {
+		response := execRequest(server, requestParams{
+			method:      http.MethodPost,
+			path:        "/api/v1/secrets",
+			body:        []byte(`{"secret": {"metadata": {"name": "test"}}}`),
+			contentType: "application/json",
+			authToken:   token,
+		})
+
+		require.Equal(t, http.StatusNotFound, response.Code)
+		body := decode(t, response.Body.String())
+		assert.Equal(t, "NOT_FOUND", body.Error.Code)
+		assert.NotContains(t, strings.ToLower(body.Error.Message), "permission")
+	})
+
+	t.Run("oversized body -> 413", func(t *testing.T) {
+		server.maxRequestBodyBytes = 16
+
+		response := execRequest(server, requestParams{
+			method:      http.MethodPost,
+			path:        "/api/v1/secrets",
+			body:        []byte(`{"secret": {"metadata": {"name": "` + strings.Repeat("a", 64) + `"}}}`),
+			contentType: "application/json",
+			authToken:   token,
+		})
+
+		require.Equal(t, http.StatusRequestEntityTooLarge, response.Code)
+		body := decode(t, response.Body.String())
+		assert.Equal(t, "INVALID_ARGUMENT", body.Error.Code)
+		assert.Equal(t, "request body too large", body.Error.Message)
+	})
+}
@@ -0,0 +1,35 @@
+package public
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/superplanehq/superplane/pkg/telemetry"
+)
+
+/*
+ * Serves the same metrics as the dedicated metrics server,
+ * for deployments where only the public API port is reachable.
+ * Basic auth credentials are always required.
+ */
+func (s *Server) metricsHandler() http.Handler {
+	telemetry.StartPeriodicMetricsReporter()
+	handler := telemetry.MetricsHandler()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || !s.validMetricsCredentials(username, password) {
+			w.Header().Set("WWW-Authenticate", `Basic realm="metrics"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) validMetricsCredentials(username, password string) bool {
+	validUsername := subtle.ConstantTimeCompare([]byte(username), []byte(s.metricsUsername)) == 1
+	validPassword := subtle.ConstantTimeCompare([]byte(password), []byte(s.metricsPassword)) == 1
+	return validUsername && validPassword
+}
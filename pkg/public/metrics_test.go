@@ -0,0 +1,80 @@
+package public
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__MetricsEndpoint(t *testing.T) {
+	newServer := func(t *testing.T) *Server {
+		authService, err := authorization.NewAuthService()
+		require.NoError(t, err)
+
+		registry, err := registry.NewRegistry(&crypto.NoOpEncryptor{}, registry.HTTPOptions{})
+		require.NoError(t, err)
+
+		server, err := NewServer(&crypto.NoOpEncryptor{}, registry, jwt.NewSigner("test"), support.NewOIDCProvider(), "", "", "", "test", "/app/templates", authService, false)
+		require.NoError(t, err)
+		return server
+	}
+
+	scrape := func(server *Server, username, password string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		if username != "" {
+			req.SetBasicAuth(username, password)
+		}
+
+		res := httptest.NewRecorder()
+		server.Router.ServeHTTP(res, req)
+		return res
+	}
+
+	t.Run("disabled -> 404", func(t *testing.T) {
+		t.Setenv("METRICS_ENABLED", "")
+
+		response := scrape(newServer(t), "", "")
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("enabled without credentials -> server does not start", func(t *testing.T) {
+		t.Setenv("METRICS_ENABLED", "yes")
+		t.Setenv("METRICS_USERNAME", "prometheus")
+		t.Setenv("METRICS_PASSWORD", "")
+
+		authService, err := authorization.NewAuthService()
+		require.NoError(t, err)
+
+		registry, err := registry.NewRegistry(&crypto.NoOpEncryptor{}, registry.HTTPOptions{})
+		require.NoError(t, err)
+
+		_, err = NewServer(&crypto.NoOpEncryptor{}, registry, jwt.NewSigner("test"), support.NewOIDCProvider(), "", "", "", "test", "/app/templates", authService, false)
+		require.ErrorContains(t, err, "METRICS_USERNAME and METRICS_PASSWORD are required")
+	})
+
+	t.Run("enabled with credentials -> basic auth is required", func(t *testing.T) {
+		t.Setenv("METRICS_ENABLED", "yes")
+		t.Setenv("METRICS_USERNAME", "prometheus")
+		t.Setenv("METRICS_PASSWORD", "secret")
+		server := newServer(t)
+
+		assert.Equal(t, http.StatusUnauthorized, scrape(server, "", "").Code)
+		assert.Equal(t, http.StatusUnauthorized, scrape(server, "prometheus", "wrong").Code)
+
+		telemetry.RecordExecutionFinished("passed")
+
+		response := scrape(server, "prometheus", "secret")
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.Contains(t, response.Body.String(), "go_goroutines")
+		assert.Contains(t, response.Body.String(), "superplane_executions_finished_total")
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/getsentry/sentry-go"
 	"github.com/gorilla/mux"
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/telemetry"
 )
 
 func LoggingMiddleware(logger *log.Logger) mux.MiddlewareFunc {
@@ -38,6 +39,8 @@ func LoggingMiddleware(logger *log.Logger) mux.MiddlewareFunc {
 					status = http.StatusInternalServerError
 				}
 
+				telemetry.RecordHTTPRequest(r.Method, routeTemplate(r), status, duration)
+
 				fields := log.Fields{
 					"method":   r.Method,
 					"path":     r.URL.Path,
@@ -94,6 +97,20 @@ func captureHTTPError(r *http.Request, status int) {
 	})
 }
 
+func routeTemplate(r *http.Request) string {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return ""
+	}
+
+	template, err := route.GetPathTemplate()
+	if err != nil {
+		return ""
+	}
+
+	return template
+}
+
 func shouldLogRequest(path string) bool {
 	appEnv := os.Getenv("APP_ENV")
 
@@ -0,0 +1,121 @@
+package public
+
+import (
+	"container/list"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+/*
+ * The number of buckets is capped, so requests for many different keys
+ * cannot grow the limiter without bounds. Once the cap is reached,
+ * the bucket that was used least recently is dropped.
+ */
+const maxRateLimiterBuckets = 10000
+
+/*
+ * Token bucket rate limiter, with one bucket per key.
+ * Each bucket holds up to burst tokens, and is refilled
+ * with rate tokens per second. Every request takes one token.
+ */
+type rateLimiter struct {
+	mu         sync.Mutex
+	rate       float64
+	burst      float64
+	maxBuckets int
+	buckets    map[string]*list.Element
+	recent     *list.List
+	now        func() time.Time
+}
+
+type tokenBucket struct {
+	key       string
+	tokens    float64
+	updatedAt time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:       rate,
+		burst:      float64(burst),
+		maxBuckets: maxRateLimiterBuckets,
+		buckets:    map[string]*list.Element{},
+		recent:     list.New(),
+		now:        time.Now,
+	}
+}
+
+/*
+ * Takes a token from the bucket for key, if there is one.
+ * If not, returns how long until the next token is available.
+ */
+func (l *rateLimiter) Allow(key string) (bool, time.Duration) {
+	if l == nil || l.rate <= 0 {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	bucket := l.bucket(key, now)
+
+	l.refill(bucket, now)
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+
+	wait := time.Duration((1 - bucket.tokens) / l.rate * float64(time.Second))
+	return false, wait
+}
+
+/*
+ * Returns the bucket for key, marking it as the most recently used one.
+ * Buckets are kept in a list ordered by use, so finding the one to evict
+ * does not require going through all of them.
+ */
+func (l *rateLimiter) bucket(key string, now time.Time) *tokenBucket {
+	if element, ok := l.buckets[key]; ok {
+		l.recent.MoveToFront(element)
+		return element.Value.(*tokenBucket)
+	}
+
+	for len(l.buckets) >= l.maxBuckets {
+		oldest := l.recent.Back()
+		l.recent.Remove(oldest)
+		delete(l.buckets, oldest.Value.(*tokenBucket).key)
+	}
+
+	bucket := &tokenBucket{key: key, tokens: l.burst, updatedAt: now}
+	l.buckets[key] = l.recent.PushFront(bucket)
+	return bucket
+}
+
+func (l *rateLimiter) refill(bucket *tokenBucket, now time.Time) {
+	elapsed := now.Sub(bucket.updatedAt).Seconds()
+	if elapsed > 0 {
+		bucket.tokens = math.Min(l.burst, bucket.tokens+elapsed*l.rate)
+	}
+
+	bucket.updatedAt = now
+}
+
+/*
+ * Responds with 429 if the rate limit for key is exceeded,
+ * telling the caller when to try again with Retry-After.
+ */
+func (s *Server) allowEventRequest(w http.ResponseWriter, key string) bool {
+	allowed, wait := s.eventsRateLimiter.Allow(key)
+	if allowed {
+		return true
+	}
+
+	seconds := max(int(math.Ceil(wait.Seconds())), 1)
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	http.Error(w, "too many requests", http.StatusTooManyRequests)
+	return false
+}
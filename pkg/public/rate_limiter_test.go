@@ -0,0 +1,123 @@
+package public
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__RateLimiter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newLimiter := func(rate float64, burst int) *rateLimiter {
+		limiter := newRateLimiter(rate, burst)
+		limiter.now = func() time.Time { return now }
+		return limiter
+	}
+
+	t.Run("requests beyond the burst are rejected", func(t *testing.T) {
+		limiter := newLimiter(1, 3)
+
+		for range 3 {
+			allowed, _ := limiter.Allow("webhook-1")
+			require.True(t, allowed)
+		}
+
+		allowed, wait := limiter.Allow("webhook-1")
+		assert.False(t, allowed)
+		assert.Equal(t, time.Second, wait)
+	})
+
+	t.Run("keys have separate buckets", func(t *testing.T) {
+		limiter := newLimiter(1, 1)
+
+		allowed, _ := limiter.Allow("webhook-1")
+		require.True(t, allowed)
+		allowed, _ = limiter.Allow("webhook-1")
+		require.False(t, allowed)
+
+		allowed, _ = limiter.Allow("webhook-2")
+		assert.True(t, allowed)
+	})
+
+	t.Run("bucket refills over time", func(t *testing.T) {
+		limiter := newLimiter(2, 2)
+
+		limiter.Allow("webhook-1")
+		limiter.Allow("webhook-1")
+		allowed, wait := limiter.Allow("webhook-1")
+		require.False(t, allowed)
+		assert.Equal(t, 500*time.Millisecond, wait)
+
+		now = now.Add(500 * time.Millisecond)
+		allowed, _ = limiter.Allow("webhook-1")
+		assert.True(t, allowed)
+		allowed, _ = limiter.Allow("webhook-1")
+		assert.False(t, allowed)
+
+		//
+		// The bucket never holds more than the burst.
+		//
+		now = now.Add(time.Minute)
+		for range 2 {
+			allowed, _ = limiter.Allow("webhook-1")
+			require.True(t, allowed)
+		}
+
+		allowed, _ = limiter.Allow("webhook-1")
+		assert.False(t, allowed)
+	})
+
+	t.Run("zero rate disables the limit", func(t *testing.T) {
+		limiter := newLimiter(0, 1)
+		for range 100 {
+			allowed, _ := limiter.Allow("webhook-1")
+			require.True(t, allowed)
+		}
+	})
+
+	t.Run("least recently used bucket is dropped once too many keys are tracked", func(t *testing.T) {
+		limiter := newLimiter(1, 1)
+		limiter.maxBuckets = 3
+
+		limiter.Allow("webhook-1")
+		limiter.Allow("webhook-2")
+		limiter.Allow("webhook-3")
+
+		//
+		// webhook-1 is used again, so webhook-2 is now the least recently used one.
+		//
+		allowed, _ := limiter.Allow("webhook-1")
+		require.False(t, allowed)
+
+		limiter.Allow("webhook-4")
+		assert.Len(t, limiter.buckets, 3)
+		assert.Equal(t, 3, limiter.recent.Len())
+		assert.NotContains(t, limiter.buckets, "webhook-2")
+
+		//
+		// Buckets that were not dropped keep their tokens.
+		//
+		allowed, _ = limiter.Allow("webhook-1")
+		assert.False(t, allowed)
+		allowed, _ = limiter.Allow("webhook-2")
+		assert.True(t, allowed)
+	})
+}
+
+func Test__AllowEventRequest(t *testing.T) {
+	server := &Server{eventsRateLimiter: newRateLimiter(0.5, 2)}
+
+	for range 2 {
+		recorder := httptest.NewRecorder()
+		require.True(t, server.allowEventRequest(recorder, "webhook:1"))
+	}
+
+	recorder := httptest.NewRecorder()
+	require.False(t, server.allowEventRequest(recorder, "webhook:1"))
+	assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	assert.Equal(t, "2", recorder.Header().Get("Retry-After"))
+}
@@ -21,12 +21,14 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/authentication"
 	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/config"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc"
 	"github.com/superplanehq/superplane/pkg/jwt"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
 	nooptrace "go.opentelemetry.io/otel/trace/noop"
@@ -52,14 +54,17 @@ import (
 	"github.com/superplanehq/superplane/pkg/web/assets"
 	grpcLib "google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"gorm.io/gorm"
 )
 
 const (
-	// Event payload can be up to 64k in size
-	MaxEventSize = 64 * 1024
+	// Event payload can be up to 64k in size by default,
+	// and webhooks can raise that up to 1MB.
+	MaxEventSize       = config.DefaultWebhookMaxBodyBytes
+	MaxWebhookBodySize = config.WebhookMaxBodyBytesCeiling
 
 	// The size of the stage execution outputs can be up to 4k
-	MaxExecutionOutputsSize = 4 * 1024
+	MaxExecutionOutputsSize = core.MaxExecutionOutputsSize
 )
 
 type Server struct {
@@ -70,6 +75,9 @@ type Server struct {
 	oidcProvider          oidc.Provider
 	authService           authorization.Authorization
 	timeoutHandlerTimeout time.Duration
+	maxRequestBodyBytes   int64
+	webhookMaxBodyBytes   int64
+	eventsRateLimiter     *rateLimiter
 	upgrader              *websocket.Upgrader
 	Router                *mux.Router
 	BasePath              string
@@ -78,6 +86,9 @@ type Server struct {
 	wsHub                 *ws.Hub
 	authHandler           *authentication.Handler
 	isDev                 bool
+	metricsEnabled        bool
+	metricsUsername       string
+	metricsPassword       string
 }
 
 // WebsocketHub returns the websocket hub for this server
@@ -106,25 +117,57 @@ func NewServer(
 	providers := getOAuthProviders()
 	authHandler.InitializeProviders(providers)
 
+	maxRequestBodyBytes, err := config.MaxRequestBodyBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	webhookMaxBodyBytes, err := config.WebhookMaxBodyBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	hubOptions, err := websocketHubOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	eventsRateLimiter, err := newEventsRateLimiter()
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// The metrics are served on the public router,
+	// so they are never exposed without credentials.
+	//
+	metricsEnabled := config.MetricsEnabled()
+	metricsUsername, metricsPassword := config.MetricsBasicAuth()
+	if metricsEnabled && (metricsUsername == "" || metricsPassword == "") {
+		return nil, fmt.Errorf("METRICS_USERNAME and METRICS_PASSWORD are required when METRICS_ENABLED is set")
+	}
+
 	server := &Server{
 		BaseURL:               baseURL,
 		WebhooksBaseURL:       webhooksBaseURL,
 		BasePath:              basePath,
-		wsHub:                 ws.NewHub(),
+		wsHub:                 ws.NewHub(hubOptions),
 		authHandler:           authHandler,
 		isDev:                 appEnv == "development",
 		timeoutHandlerTimeout: 15 * time.Second,
+		maxRequestBodyBytes:   maxRequestBodyBytes,
+		webhookMaxBodyBytes:   webhookMaxBodyBytes,
+		eventsRateLimiter:     eventsRateLimiter,
 		encryptor:             encryptor,
 		jwt:                   jwtSigner,
 		oidcProvider:          oidcProvider,
 		registry:              registry,
 		authService:           authorizationService,
+		metricsEnabled:        metricsEnabled,
+		metricsUsername:       metricsUsername,
+		metricsPassword:       metricsPassword,
 		upgrader: &websocket.Upgrader{
-			CheckOrigin: func(r *http.Request) bool {
-				// Allow all connections - you may want to restrict this in production
-				// TODO: implement origin checking
-				return true
-			},
+			CheckOrigin:     newWebsocketOriginChecker(baseURL, config.WebsocketAllowedOrigins()),
 			ReadBufferSize:  1024,
 			WriteBufferSize: 1024,
 		},
@@ -135,6 +178,43 @@ func NewServer(
 	return server, nil
 }
 
+func websocketHubOptions() (ws.HubOptions, error) {
+	maxPerUser, err := config.WebsocketMaxConnectionsPerUser()
+	if err != nil {
+		return ws.HubOptions{}, err
+	}
+
+	maxPerCanvas, err := config.WebsocketMaxConnectionsPerCanvas()
+	if err != nil {
+		return ws.HubOptions{}, err
+	}
+
+	policy, err := config.WebsocketLimitPolicy()
+	if err != nil {
+		return ws.HubOptions{}, err
+	}
+
+	return ws.HubOptions{
+		MaxConnectionsPerUser:     maxPerUser,
+		MaxConnectionsPerWorkflow: maxPerCanvas,
+		EvictOldest:               policy == config.WebsocketLimitPolicyEvictOldest,
+	}, nil
+}
+
+func newEventsRateLimiter() (*rateLimiter, error) {
+	rate, err := config.EventsRateLimit()
+	if err != nil {
+		return nil, err
+	}
+
+	burst, err := config.EventsRateLimitBurst()
+	if err != nil {
+		return nil, err
+	}
+
+	return newRateLimiter(rate, burst), nil
+}
+
 func getOAuthProviders() map[string]authentication.ProviderConfig {
 	baseURL := getBaseURL()
 	providers := make(map[string]authentication.ProviderConfig)
@@ -169,6 +249,7 @@ func (s *Server) RegisterGRPCGateway(grpcServerAddr string) error {
 	grpcGatewayMux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(headersMatcher),
 		runtime.SetQueryParameterParser(&grpc.QueryParser{}),
+		runtime.WithErrorHandler(gatewayErrorHandler),
 	)
 
 	opts := []grpcLib.DialOption{grpcLib.WithTransportCredentials(insecure.NewCredentials())}
@@ -240,10 +321,10 @@ func (s *Server) RegisterGRPCGateway(grpcServerAddr string) error {
 
 	// Protect the gRPC gateway routes with organization authentication
 	orgAuthMiddleware := middleware.OrganizationAuthMiddleware(s.jwt)
-	protectedGRPCHandler := orgAuthMiddleware(s.grpcGatewayHandler(grpcGatewayMux))
+	protectedGRPCHandler := orgAuthMiddleware(s.limitRequestBody(s.grpcGatewayHandler(grpcGatewayMux)))
 
 	accountAuthMiddleware := middleware.AccountAuthMiddleware(s.jwt)
-	protectedAccountGRPCHandler := accountAuthMiddleware(s.grpcGatewayAccountHandler(grpcGatewayMux))
+	protectedAccountGRPCHandler := accountAuthMiddleware(s.limitRequestBody(s.grpcGatewayAccountHandler(grpcGatewayMux)))
 
 	s.Router.PathPrefix("/api/v1/users").Handler(protectedGRPCHandler)
 	s.Router.PathPrefix("/api/v1/groups").Handler(protectedGRPCHandler)
@@ -385,6 +466,13 @@ func (s *Server) InitRouter(additionalMiddlewares ...mux.MiddlewareFunc) {
 
 	// Health check
 	publicRoute.HandleFunc("/health", s.HealthCheck).Methods("GET")
+
+	//
+	// Prometheus metrics, only served if enabled.
+	//
+	if s.metricsEnabled {
+		r.Handle("/metrics", s.metricsHandler()).Methods("GET")
+	}
 	publicRoute.HandleFunc("/api/v1/setup-owner", s.setupOwner).Methods("POST")
 
 	// OIDC discovery endpoints
@@ -411,6 +499,14 @@ func (s *Server) InitRouter(additionalMiddlewares ...mux.MiddlewareFunc) {
 		HandleFunc(s.BasePath+"/webhooks/{webhookID}", s.HandleWebhook).
 		Methods("POST")
 
+	//
+	// Approval links sent in notifications.
+	// The signed token in the path authenticates the request.
+	//
+	publicRoute.
+		HandleFunc(s.BasePath+"/approvals/{token}", s.HandleApprovalLink).
+		Methods("GET", "POST")
+
 	//
 	// HTTP endpoints for app installations
 	// Match all paths under /integrations/{integrationID}/ including subpaths
@@ -489,34 +585,83 @@ func (s *Server) HandleIntegrationRequest(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	//
+	// The limit is only checked for integrations that exist,
+	// so requests with random IDs do not create buckets.
+	//
+	if !s.allowEventRequest(w, "integration:"+integrationID.String()) {
+		return
+	}
+
 	integration, err := s.registry.GetIntegration(integrationInstance.AppName)
 	if err != nil {
 		http.Error(w, "integration not found", http.StatusNotFound)
 		return
 	}
 
+	//
+	// The handler runs in a transaction, and its response is buffered.
+	// Changes are only persisted if the handler succeeds,
+	// and the response is sent after the transaction is finished.
+	//
+	tx := database.Conn().Begin()
+	response := newBufferedResponse()
+	result := &core.HTTPRequestResult{}
+	logger := logging.ForIntegration(*integrationInstance)
+	integrationCtx := contexts.NewIntegrationContext(
+		tx,
+		nil,
+		integrationInstance,
+		s.encryptor,
+		s.registry,
+	)
+
 	integration.HandleRequest(core.HTTPRequestContext{
-		Logger:          logging.ForIntegration(*integrationInstance),
+		Logger:          logger,
 		Request:         r,
-		Response:        w,
+		Response:        response,
 		BaseURL:         s.BaseURL,
 		WebhooksBaseURL: s.WebhooksBaseURL,
 		OrganizationID:  integrationInstance.OrganizationID.String(),
 		HTTP:            s.registry.HTTPContext(),
-		Integration: contexts.NewIntegrationContext(
-			database.Conn(),
-			nil,
-			integrationInstance,
-			s.encryptor,
-			s.registry,
-		),
+		Integration:     integrationCtx,
+		Result:          result,
 	})
 
-	err = database.Conn().Save(&integrationInstance).Error
+	err = finishIntegrationRequest(tx, integrationCtx, response, result)
 	if err != nil {
-		http.Error(w, "integration not found", http.StatusNotFound)
+		logger.Errorf("error finishing integration request: %v", err)
+		http.Error(w, "error processing request", http.StatusInternalServerError)
 		return
 	}
+
+	response.WriteTo(w)
+}
+
+/*
+ * Only the columns the handler changed are written,
+ * so requests that do not change the integration,
+ * like most event deliveries, do not write to it at all.
+ */
+func finishIntegrationRequest(tx *gorm.DB, integrationCtx *contexts.IntegrationContext, response *bufferedResponse, result *core.HTTPRequestResult) error {
+	if response.Failed() {
+		tx.Rollback()
+		result.Finish(false)
+		return nil
+	}
+
+	if !result.PersistenceSkipped() {
+		err := integrationCtx.SaveChanges()
+		if err != nil {
+			tx.Rollback()
+			result.Finish(false)
+			return err
+		}
+	}
+
+	err := tx.Commit().Error
+	result.Finish(err == nil)
+	return err
 }
 
 type OrganizationCreationRequest struct {
@@ -722,6 +867,26 @@ func (s *Server) Close() {
 }
 
 func (s *Server) HandleWebhook(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	sw := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+	defer func() {
+		telemetry.RecordWebhookRequest(sw.statusCode, time.Since(start))
+	}()
+
+	s.handleWebhook(sw, r)
+}
+
+type statusResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (w *statusResponseWriter) WriteHeader(code int) {
+	w.statusCode = code
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (s *Server) handleWebhook(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	webhookIDFromRequest := vars["webhookID"]
 	webhookID, err := uuid.Parse(webhookIDFromRequest)
@@ -730,13 +895,28 @@ func (s *Server) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = models.FindWebhook(webhookID)
+	webhook, err := models.FindWebhook(webhookID)
 	if err != nil {
 		http.Error(w, "webhook not found", http.StatusNotFound)
 		return
 	}
 
-	r.Body = http.MaxBytesReader(w, r.Body, MaxEventSize)
+	//
+	// The limit is only checked for webhooks that exist,
+	// so requests with random IDs do not create buckets.
+	//
+	if !s.allowEventRequest(w, "webhook:"+webhookID.String()) {
+		return
+	}
+
+	nodes, err := models.FindWebhookNodes(webhookID)
+	if err != nil {
+		http.Error(w, "webhook not found", http.StatusNotFound)
+		return
+	}
+
+	maxBodySize := s.webhookBodySizeLimit(webhook, nodes)
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
 	defer r.Body.Close()
 
 	body, err := io.ReadAll(r.Body)
@@ -744,7 +924,7 @@ func (s *Server) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 		if _, ok := err.(*http.MaxBytesError); ok {
 			http.Error(
 				w,
-				fmt.Sprintf("Request body is too large - must be up to %d bytes", MaxEventSize),
+				fmt.Sprintf("Request body is too large - must be up to %d bytes", maxBodySize),
 				http.StatusRequestEntityTooLarge,
 			)
 
@@ -755,12 +935,6 @@ func (s *Server) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	nodes, err := models.FindWebhookNodes(webhookID)
-	if err != nil {
-		http.Error(w, "webhook not found", http.StatusNotFound)
-		return
-	}
-
 	for _, node := range nodes {
 		code, err := s.executeWebhookNode(r.Context(), body, r.Header, node)
 		if err != nil {
@@ -772,6 +946,44 @@ func (s *Server) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+/*
+ * The largest of the server default, the webhook override
+ * and the limits requested by the nodes using the webhook,
+ * but never more than MaxWebhookBodySize.
+ */
+func (s *Server) webhookBodySizeLimit(webhook *models.Webhook, nodes []models.CanvasNode) int64 {
+	limit := max(s.webhookMaxBodyBytes, webhook.MaxBodySize)
+	for _, node := range nodes {
+		limit = max(limit, s.nodeWebhookBodySize(node))
+	}
+
+	return min(limit, MaxWebhookBodySize)
+}
+
+func (s *Server) nodeWebhookBodySize(node models.CanvasNode) int64 {
+	ref := node.Ref.Data()
+
+	var implementation any
+	var err error
+	switch {
+	case node.Type == models.NodeTypeTrigger && ref.Trigger != nil:
+		implementation, err = s.registry.GetTrigger(ref.Trigger.Name)
+	case ref.Component != nil:
+		implementation, err = s.registry.GetComponent(ref.Component.Name)
+	}
+
+	if err != nil {
+		return 0
+	}
+
+	limiter, ok := implementation.(core.WebhookBodySizeLimiter)
+	if !ok {
+		return 0
+	}
+
+	return limiter.MaxWebhookBodySize()
+}
+
 func (s *Server) executeWebhookNode(ctx context.Context, body []byte, headers http.Header, node models.CanvasNode) (int, error) {
 	if node.Type == models.NodeTypeTrigger {
 		return s.executeTriggerNode(ctx, body, headers, node)
@@ -861,6 +1073,7 @@ func (s *Server) executeComponentNode(ctx context.Context, body []byte, headers
 				Configuration:  execution.Configuration.Data(),
 				HTTP:           s.registry.HTTPContext(),
 				Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
+				Attachments:    contexts.NewExecutionAttachmentContext(tx, execution),
 				NodeMetadata:   contexts.NewNodeMetadataContext(tx, &node),
 				ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 				Requests:       contexts.NewExecutionRequestContext(tx, execution),
@@ -904,7 +1117,14 @@ func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	client := s.wsHub.NewClient(ws, workflowID)
+	client, err := s.wsHub.NewClient(ws, user.ID.String(), workflowID)
+	if err != nil {
+		log.Warnf("Refusing WebSocket connection for user %s on workflow %s: %v", user.ID, workflowID, err)
+		message := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too many connections")
+		_ = ws.WriteControl(websocket.CloseMessage, message, time.Now().Add(time.Second))
+		ws.Close()
+		return
+	}
 
 	<-client.Done
 }
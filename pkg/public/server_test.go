@@ -11,9 +11,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/jwt"
@@ -388,3 +390,108 @@ func Test__CreateOrganization(t *testing.T) {
 		assert.Contains(t, response.Body.String(), "Organization name already in use")
 	})
 }
+
+func Test__HandleIntegrationRequest(t *testing.T) {
+	r := support.Setup(t)
+
+	setup := func(t *testing.T, onRequest func(ctx core.HTTPRequestContext)) (*Server, *models.Integration) {
+		r.Registry.Integrations["dummy"] = support.NewDummyIntegration(support.DummyIntegrationOptions{
+			OnRequest: onRequest,
+		})
+
+		integration, err := models.CreateIntegration(uuid.New(), r.Organization.ID, "dummy", support.RandomName("integration"), map[string]any{})
+		require.NoError(t, err)
+
+		signer := jwt.NewSigner("test")
+		server, err := NewServer(r.Encryptor, r.Registry, signer, support.NewOIDCProvider(), "", "http://localhost", "", "test", "/app/templates", r.AuthService, false)
+		require.NoError(t, err)
+		return server, integration
+	}
+
+	t.Run("handler succeeds -> metadata changes are persisted", func(t *testing.T) {
+		server, integration := setup(t, func(ctx core.HTTPRequestContext) {
+			ctx.Integration.SetMetadata(map[string]any{"updated": true})
+			ctx.Response.WriteHeader(http.StatusAccepted)
+			_, _ = ctx.Response.Write([]byte("ok"))
+		})
+
+		response := execRequest(server, requestParams{
+			method: "POST",
+			path:   "/integrations/" + integration.ID.String() + "/events",
+		})
+
+		assert.Equal(t, http.StatusAccepted, response.Code)
+		assert.Equal(t, "ok", response.Body.String())
+
+		updated, err := models.FindUnscopedIntegration(integration.ID)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"updated": true}, updated.Metadata.Data())
+	})
+
+	t.Run("handler does not change the integration -> no update is issued", func(t *testing.T) {
+		server, integration := setup(t, func(ctx core.HTTPRequestContext) {
+			ctx.Integration.SetMetadata(ctx.Integration.GetMetadata())
+			ctx.Response.WriteHeader(http.StatusOK)
+		})
+
+		updates := 0
+		callback := "test:count_integration_updates"
+		require.NoError(t, database.Conn().Callback().Update().After("gorm:update").Register(callback, func(db *gorm.DB) {
+			if db.Statement.Table == integration.TableName() {
+				updates++
+			}
+		}))
+		defer database.Conn().Callback().Update().Remove(callback)
+
+		response := execRequest(server, requestParams{
+			method: "POST",
+			path:   "/integrations/" + integration.ID.String() + "/events",
+		})
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, 0, updates)
+
+		unchanged, err := models.FindUnscopedIntegration(integration.ID)
+		require.NoError(t, err)
+		assert.Equal(t, integration.UpdatedAt.UnixMicro(), unchanged.UpdatedAt.UnixMicro())
+	})
+
+	t.Run("handler fails -> metadata changes are rolled back and response is sent", func(t *testing.T) {
+		server, integration := setup(t, func(ctx core.HTTPRequestContext) {
+			ctx.Integration.SetMetadata(map[string]any{"updated": true})
+			ctx.Response.WriteHeader(http.StatusBadRequest)
+			_, _ = ctx.Response.Write([]byte("bad request"))
+		})
+
+		response := execRequest(server, requestParams{
+			method: "POST",
+			path:   "/integrations/" + integration.ID.String() + "/events",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.Equal(t, "bad request", response.Body.String())
+
+		updated, err := models.FindUnscopedIntegration(integration.ID)
+		require.NoError(t, err)
+		assert.NotContains(t, updated.Metadata.Data(), "updated")
+	})
+
+	t.Run("handler skips persistence -> integration is not saved", func(t *testing.T) {
+		server, integration := setup(t, func(ctx core.HTTPRequestContext) {
+			ctx.Result.SkipPersistence()
+			ctx.Integration.SetMetadata(map[string]any{"updated": true})
+			ctx.Response.WriteHeader(http.StatusOK)
+		})
+
+		response := execRequest(server, requestParams{
+			method: "POST",
+			path:   "/integrations/" + integration.ID.String() + "/events",
+		})
+
+		assert.Equal(t, http.StatusOK, response.Code)
+
+		updated, err := models.FindUnscopedIntegration(integration.ID)
+		require.NoError(t, err)
+		assert.NotContains(t, updated.Metadata.Data(), "updated")
+	})
+}
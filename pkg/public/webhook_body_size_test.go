@@ -0,0 +1,61 @@
+package public
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	_ "github.com/superplanehq/superplane/pkg/integrations/gitlab"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+	_ "github.com/superplanehq/superplane/pkg/triggers/start"
+	"gorm.io/datatypes"
+)
+
+func Test__WebhookBodySizeLimit(t *testing.T) {
+	r, err := registry.NewRegistry(&crypto.NoOpEncryptor{}, registry.HTTPOptions{})
+	require.NoError(t, err)
+
+	server := &Server{registry: r, webhookMaxBodyBytes: MaxEventSize}
+	triggerNode := func(name string) models.CanvasNode {
+		return models.CanvasNode{
+			Type: models.NodeTypeTrigger,
+			Ref:  datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: name}}),
+		}
+	}
+
+	t.Run("server default is used when nothing asks for more", func(t *testing.T) {
+		limit := server.webhookBodySizeLimit(&models.Webhook{}, []models.CanvasNode{triggerNode("start")})
+		assert.Equal(t, int64(MaxEventSize), limit)
+	})
+
+	t.Run("webhook override raises the limit", func(t *testing.T) {
+		limit := server.webhookBodySizeLimit(&models.Webhook{MaxBodySize: 200 * 1024}, nil)
+		assert.Equal(t, int64(200*1024), limit)
+	})
+
+	t.Run("webhook override below the server default is ignored", func(t *testing.T) {
+		limit := server.webhookBodySizeLimit(&models.Webhook{MaxBodySize: 1024}, nil)
+		assert.Equal(t, int64(MaxEventSize), limit)
+	})
+
+	t.Run("limit requested by a node is used", func(t *testing.T) {
+		limit := server.webhookBodySizeLimit(&models.Webhook{}, []models.CanvasNode{
+			triggerNode("start"),
+			triggerNode("gitlab.onPipeline"),
+		})
+
+		assert.Equal(t, int64(512*1024), limit)
+	})
+
+	t.Run("limit never goes above the ceiling", func(t *testing.T) {
+		limit := server.webhookBodySizeLimit(&models.Webhook{MaxBodySize: 10 * 1024 * 1024}, nil)
+		assert.Equal(t, int64(MaxWebhookBodySize), limit)
+	})
+
+	t.Run("unknown node types use the server default", func(t *testing.T) {
+		limit := server.webhookBodySizeLimit(&models.Webhook{}, []models.CanvasNode{triggerNode("does-not-exist")})
+		assert.Equal(t, int64(MaxEventSize), limit)
+	})
+}
@@ -0,0 +1,57 @@
+package public
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+/*
+ * Websocket upgrades are only accepted from the origin of the base URL
+ * and the configured allowlist. Requests without an Origin header
+ * do not come from browsers, so they are accepted.
+ */
+func newWebsocketOriginChecker(baseURL string, allowedOrigins []string) func(r *http.Request) bool {
+	origins := []string{}
+	for _, origin := range append([]string{baseURL}, allowedOrigins...) {
+		if normalized := normalizeOrigin(origin); normalized != "" {
+			origins = append(origins, normalized)
+		}
+	}
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+
+		normalized := normalizeOrigin(origin)
+
+		//
+		// Without a base URL or allowlist, fall back to same-origin checks.
+		//
+		if len(origins) == 0 {
+			parsed, err := url.Parse(origin)
+			return err == nil && strings.EqualFold(parsed.Host, r.Host)
+		}
+
+		if slices.Contains(origins, normalized) {
+			return true
+		}
+
+		log.Warnf("Rejecting websocket connection from origin %s", origin)
+		return false
+	}
+}
+
+func normalizeOrigin(value string) string {
+	parsed, err := url.Parse(strings.TrimSpace(value))
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return ""
+	}
+
+	return strings.ToLower(parsed.Scheme + "://" + parsed.Host)
+}
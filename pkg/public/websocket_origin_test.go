@@ -0,0 +1,65 @@
+package public
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__WebsocketOriginChecker(t *testing.T) {
+	upgrader := websocket.Upgrader{
+		CheckOrigin: newWebsocketOriginChecker("https://app.superplane.com", []string{"https://admin.example.com"}),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		conn.Close()
+	}))
+
+	defer server.Close()
+
+	dial := func(origin string) (*http.Response, error) {
+		header := http.Header{}
+		if origin != "" {
+			header.Set("Origin", origin)
+		}
+
+		conn, response, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), header)
+		if conn != nil {
+			conn.Close()
+		}
+
+		return response, err
+	}
+
+	t.Run("base URL origin is allowed", func(t *testing.T) {
+		_, err := dial("https://APP.superplane.com")
+		require.NoError(t, err)
+	})
+
+	t.Run("allowlisted origin is allowed", func(t *testing.T) {
+		_, err := dial("https://admin.example.com")
+		require.NoError(t, err)
+	})
+
+	t.Run("requests without origin are allowed", func(t *testing.T) {
+		_, err := dial("")
+		require.NoError(t, err)
+	})
+
+	t.Run("cross-origin upgrade is rejected with 403", func(t *testing.T) {
+		response, err := dial("https://evil.example.com")
+		require.Error(t, err)
+		require.NotNil(t, response)
+		assert.Equal(t, http.StatusForbidden, response.StatusCode)
+	})
+}
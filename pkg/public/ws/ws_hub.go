@@ -1,6 +1,7 @@
 package ws
 
 import (
+	"errors"
 	"io"
 	"net"
 	"sync"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/gorilla/websocket"
 	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/telemetry"
 )
 
 const (
@@ -16,13 +18,37 @@ const (
 	pingPeriod = 10 * time.Second // Ping every 10s
 )
 
+var ErrConnectionLimitReached = errors.New("websocket connection limit reached")
+
+// HubOptions configures the connection limits enforced by the hub
+type HubOptions struct {
+	// Maximum number of connections per user, 0 means unlimited
+	MaxConnectionsPerUser int
+
+	// Maximum number of connections per workflow, 0 means unlimited
+	MaxConnectionsPerWorkflow int
+
+	// If true, the oldest connection is closed when a limit is reached,
+	// otherwise the new connection is refused
+	EvictOldest bool
+}
+
+// ConnectionCounts holds the number of connections currently handled by the hub
+type ConnectionCounts struct {
+	Clients   int
+	Users     int
+	Workflows int
+}
+
 // Client represents a connected websocket client
 type Client struct {
-	hub        *Hub
-	conn       *websocket.Conn
-	send       chan []byte
-	Done       chan struct{}
-	workflowID string // Which workflow this client is watching
+	hub         *Hub
+	conn        *websocket.Conn
+	send        chan []byte
+	Done        chan struct{}
+	userID      string    // Which user opened this connection
+	workflowID  string    // Which workflow this client is watching
+	connectedAt time.Time // Used to find the oldest connection when evicting
 }
 
 // Hub maintains the set of active clients and broadcasts messages to them
@@ -33,8 +59,11 @@ type Hub struct {
 	// Map of workflow IDs to clients subscribed to that workflow
 	workflowSubscriptions map[string]map[*Client]bool
 
-	// Register requests from the clients
-	register chan *Client
+	// Map of user IDs to their clients
+	userConnections map[string]map[*Client]bool
+
+	// Connection limits
+	options HubOptions
 
 	// Unregister requests from clients
 	unregister chan *Client
@@ -44,11 +73,12 @@ type Hub struct {
 }
 
 // NewHub creates a new hub
-func NewHub() *Hub {
+func NewHub(options HubOptions) *Hub {
 	return &Hub{
 		clients:               make(map[*Client]bool),
 		workflowSubscriptions: make(map[string]map[*Client]bool),
-		register:              make(chan *Client),
+		userConnections:       make(map[string]map[*Client]bool),
+		options:               options,
 		unregister:            make(chan *Client),
 		mutex:                 sync.RWMutex{},
 	}
@@ -57,22 +87,49 @@ func NewHub() *Hub {
 // Run starts the hub processing loop
 func (h *Hub) Run() {
 	go func() {
-		for {
-			select {
-			case client := <-h.register:
-				h.registerClient(client)
-			case client := <-h.unregister:
-				h.unregisterClient(client)
-			}
+		for client := range h.unregister {
+			h.unregisterClient(client)
 		}
 	}()
 }
 
-// registerClient adds a new client to the hub
-func (h *Hub) registerClient(client *Client) {
+// ConnectionCounts returns the number of connected clients, users and workflows
+func (h *Hub) ConnectionCounts() ConnectionCounts {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	return h.connectionCounts()
+}
+
+func (h *Hub) connectionCounts() ConnectionCounts {
+	return ConnectionCounts{
+		Clients:   len(h.clients),
+		Users:     len(h.userConnections),
+		Workflows: len(h.workflowSubscriptions),
+	}
+}
+
+func (h *Hub) recordConnectionCounts() {
+	counts := h.connectionCounts()
+	telemetry.RecordWebsocketClientsCount(counts.Clients)
+	telemetry.RecordWebsocketWorkflowsCount(counts.Workflows)
+}
+
+// registerClient adds a new client to the hub, enforcing the connection limits
+func (h *Hub) registerClient(client *Client) error {
 	h.mutex.Lock()
 	defer h.mutex.Unlock()
 
+	err := h.enforceLimit(h.userConnections[client.userID], h.options.MaxConnectionsPerUser)
+	if err != nil {
+		return err
+	}
+
+	err = h.enforceLimit(h.workflowSubscriptions[client.workflowID], h.options.MaxConnectionsPerWorkflow)
+	if err != nil {
+		return err
+	}
+
 	h.clients[client] = true
 
 	if _, ok := h.workflowSubscriptions[client.workflowID]; !ok {
@@ -81,7 +138,40 @@ func (h *Hub) registerClient(client *Client) {
 	h.workflowSubscriptions[client.workflowID][client] = true
 	log.Debugf("Client subscribed to workflow: %s", client.workflowID)
 
+	if _, ok := h.userConnections[client.userID]; !ok {
+		h.userConnections[client.userID] = make(map[*Client]bool)
+	}
+	h.userConnections[client.userID][client] = true
+
 	log.Debugf("New client registered %v, total clients: %d", client, len(h.clients))
+	h.recordConnectionCounts()
+	return nil
+}
+
+// enforceLimit makes room for a new connection in a group of clients,
+// or refuses it if the oldest connection should not be evicted
+func (h *Hub) enforceLimit(clients map[*Client]bool, limit int) error {
+	if limit <= 0 || len(clients) < limit {
+		return nil
+	}
+
+	if !h.options.EvictOldest {
+		return ErrConnectionLimitReached
+	}
+
+	for len(clients) >= limit {
+		var oldest *Client
+		for client := range clients {
+			if oldest == nil || client.connectedAt.Before(oldest.connectedAt) {
+				oldest = client
+			}
+		}
+
+		log.Infof("Connection limit reached, evicting oldest client for workflow %s", oldest.workflowID)
+		h.removeClient(oldest)
+	}
+
+	return nil
 }
 
 // unregisterClient removes a client from the hub
@@ -89,6 +179,11 @@ func (h *Hub) unregisterClient(client *Client) {
 	h.mutex.Lock()
 	defer h.mutex.Unlock()
 
+	h.removeClient(client)
+}
+
+// removeClient removes a client from the hub, expects the mutex to be held
+func (h *Hub) removeClient(client *Client) {
 	// If this client has a connection, close it
 	if _, ok := h.clients[client]; ok {
 		delete(h.clients, client)
@@ -105,7 +200,16 @@ func (h *Hub) unregisterClient(client *Client) {
 				}
 			}
 		}
+
+		if clients, ok := h.userConnections[client.userID]; ok {
+			delete(clients, client)
+			if len(clients) == 0 {
+				delete(h.userConnections, client.userID)
+			}
+		}
+
 		log.Debugf("Client unregistered, remaining clients: %d", len(h.clients))
+		h.recordConnectionCounts()
 	}
 }
 
@@ -141,24 +245,31 @@ func (h *Hub) BroadcastToWorkflow(workflowID string, message []byte) {
 	}
 }
 
-// NewClient creates a new websocket client
-func (h *Hub) NewClient(conn *websocket.Conn, workflowID string) *Client {
+// NewClient creates a new websocket client.
+// If a connection limit is reached and the oldest connection is not evicted,
+// ErrConnectionLimitReached is returned and the connection is not registered.
+func (h *Hub) NewClient(conn *websocket.Conn, userID, workflowID string) (*Client, error) {
 	client := &Client{
-		hub:        h,
-		conn:       conn,
-		send:       make(chan []byte, 4096),
-		Done:       make(chan struct{}),
-		workflowID: workflowID,
+		hub:         h,
+		conn:        conn,
+		send:        make(chan []byte, 4096),
+		Done:        make(chan struct{}),
+		userID:      userID,
+		workflowID:  workflowID,
+		connectedAt: time.Now(),
 	}
 
 	// Register this client with the hub
-	h.register <- client
+	err := h.registerClient(client)
+	if err != nil {
+		return nil, err
+	}
 
 	// Start goroutines for reading and writing
 	go client.writePump()
 	go client.readPump()
 
-	return client
+	return client, nil
 }
 
 // writePump pumps messages from the hub to the websocket connection
@@ -0,0 +1,104 @@
+package ws
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * connect opens a real websocket connection and registers
+ * the server side of it in the hub.
+ */
+func connect(t *testing.T, hub *Hub, userID, workflowID string) (*Client, error) {
+	type result struct {
+		client *Client
+		err    error
+	}
+
+	results := make(chan result, 1)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			results <- result{err: err}
+			return
+		}
+
+		client, err := hub.NewClient(conn, userID, workflowID)
+		results <- result{client: client, err: err}
+	}))
+
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	r := <-results
+	return r.client, r.err
+}
+
+func Test__Hub_ConnectionLimits(t *testing.T) {
+	t.Run("connections are counted by user and workflow", func(t *testing.T) {
+		hub := NewHub(HubOptions{})
+		hub.Run()
+
+		_, err := connect(t, hub, "user-1", "workflow-1")
+		require.NoError(t, err)
+		_, err = connect(t, hub, "user-1", "workflow-2")
+		require.NoError(t, err)
+		_, err = connect(t, hub, "user-2", "workflow-2")
+		require.NoError(t, err)
+
+		assert.Equal(t, ConnectionCounts{Clients: 3, Users: 2, Workflows: 2}, hub.ConnectionCounts())
+	})
+
+	t.Run("new connection is refused when user limit is reached", func(t *testing.T) {
+		hub := NewHub(HubOptions{MaxConnectionsPerUser: 2})
+		hub.Run()
+
+		_, err := connect(t, hub, "user-1", "workflow-1")
+		require.NoError(t, err)
+		_, err = connect(t, hub, "user-1", "workflow-2")
+		require.NoError(t, err)
+
+		_, err = connect(t, hub, "user-1", "workflow-3")
+		require.ErrorIs(t, err, ErrConnectionLimitReached)
+
+		_, err = connect(t, hub, "user-2", "workflow-3")
+		require.NoError(t, err)
+		assert.Equal(t, 3, hub.ConnectionCounts().Clients)
+	})
+
+	t.Run("oldest connection is evicted when workflow limit is reached", func(t *testing.T) {
+		hub := NewHub(HubOptions{MaxConnectionsPerWorkflow: 2, EvictOldest: true})
+		hub.Run()
+
+		oldest, err := connect(t, hub, "user-1", "workflow-1")
+		require.NoError(t, err)
+		_, err = connect(t, hub, "user-2", "workflow-1")
+		require.NoError(t, err)
+		newest, err := connect(t, hub, "user-3", "workflow-1")
+		require.NoError(t, err)
+
+		select {
+		case <-oldest.Done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("oldest connection was not closed")
+		}
+
+		assert.Equal(t, ConnectionCounts{Clients: 2, Users: 2, Workflows: 1}, hub.ConnectionCounts())
+
+		hub.mutex.RLock()
+		defer hub.mutex.RUnlock()
+		assert.True(t, hub.clients[newest])
+		assert.False(t, hub.clients[oldest])
+	})
+}
@@ -14,13 +14,22 @@ import (
  * around logic for handling panics.
  */
 type PanicableComponent struct {
-	underlying core.Component
+	underlying  core.Component
+	integration string
 }
 
 func NewPanicableComponent(c core.Component) core.Component {
 	return &PanicableComponent{underlying: c}
 }
 
+/*
+ * Requests made by components of an integration
+ * are counted as requests made by the integration.
+ */
+func NewPanicableIntegrationComponent(c core.Component, integration string) core.Component {
+	return &PanicableComponent{underlying: c, integration: integration}
+}
+
 /*
  * Non-panicking methods.
  * These are mostly definition methods, so they won't panic.
@@ -53,6 +62,24 @@ func (s *PanicableComponent) ExampleOutput() map[string]any {
 	return s.underlying.ExampleOutput()
 }
 
+func (s *PanicableComponent) MaxWebhookBodySize() int64 {
+	limiter, ok := s.underlying.(core.WebhookBodySizeLimiter)
+	if !ok {
+		return 0
+	}
+
+	return limiter.MaxWebhookBodySize()
+}
+
+func (s *PanicableComponent) InputSchema() *core.Schema {
+	provider, ok := s.underlying.(core.InputSchemaProvider)
+	if !ok {
+		return nil
+	}
+
+	return provider.InputSchema()
+}
+
 func (s *PanicableComponent) Configuration() []configuration.Field {
 	return s.underlying.Configuration()
 }
@@ -79,6 +106,7 @@ func (s *PanicableComponent) Setup(ctx core.SetupContext) (err error) {
 				s.underlying.Name(), r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.integration)
 	return s.underlying.Setup(ctx)
 }
 
@@ -91,6 +119,8 @@ func (s *PanicableComponent) Execute(ctx core.ExecutionContext) (err error) {
 				s.underlying.Name(), r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.integration)
+	ctx.ExecutionState = NewOutputChannelExecutionState(ctx.ExecutionState, s.underlying.Name(), s.underlying.OutputChannels(ctx.Configuration))
 	return s.underlying.Execute(ctx)
 }
 
@@ -112,6 +142,8 @@ func (s *PanicableComponent) HandleAction(ctx core.ActionContext) (err error) {
 				s.underlying.Name(), ctx.Name, r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.integration)
+	ctx.ExecutionState = NewOutputChannelExecutionState(ctx.ExecutionState, s.underlying.Name(), s.underlying.OutputChannels(ctx.Configuration))
 	return s.underlying.HandleAction(ctx)
 }
 
@@ -122,6 +154,7 @@ func (s *PanicableComponent) HandleWebhook(ctx core.WebhookRequestContext) (stat
 			err = fmt.Errorf("component panicked in HandleWebhook(): %v", r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.integration)
 	return s.underlying.HandleWebhook(ctx)
 }
 
@@ -134,6 +167,7 @@ func (s *PanicableComponent) Cancel(ctx core.ExecutionContext) (err error) {
 				s.underlying.Name(), r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.integration)
 	return s.underlying.Cancel(ctx)
 }
 
@@ -148,6 +182,7 @@ func (s *PanicableComponent) Cleanup(ctx core.SetupContext) (err error) {
 				s.underlying.Name(), r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.integration)
 	return s.underlying.Cleanup(ctx)
 }
 
@@ -166,5 +201,6 @@ func (s *PanicableComponent) OnIntegrationMessage(ctx core.IntegrationMessageCon
 		return fmt.Errorf("component %s is not an integration component", s.underlying.Name())
 	}
 
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.integration)
 	return integrationComponent.OnIntegrationMessage(ctx)
 }
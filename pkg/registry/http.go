@@ -10,9 +10,14 @@ import (
 	"strings"
 	"syscall"
 	"time"
+
+	"github.com/superplanehq/superplane/pkg/telemetry"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type HTTPContext struct {
+	ctx              context.Context
 	client           *http.Client
 	dialer           *net.Dialer
 	blockedHosts     []string
@@ -70,14 +75,17 @@ func NewHTTPContext(options HTTPOptions) (*HTTPContext, error) {
 
 	httpCtx.client = &http.Client{
 		Timeout: 30 * time.Second,
-		Transport: &http.Transport{
-			ForceAttemptHTTP2:     true,
-			MaxIdleConns:          100,
-			IdleConnTimeout:       90 * time.Second,
-			TLSHandshakeTimeout:   10 * time.Second,
-			ExpectContinueTimeout: 1 * time.Second,
-			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
-				return httpCtx.dialer.DialContext(ctx, network, addr)
+		Transport: &TracePropagatingTransport{
+			propagator: propagation.TraceContext{},
+			base: &http.Transport{
+				ForceAttemptHTTP2:     true,
+				MaxIdleConns:          100,
+				IdleConnTimeout:       90 * time.Second,
+				TLSHandshakeTimeout:   10 * time.Second,
+				ExpectContinueTimeout: 1 * time.Second,
+				DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+					return httpCtx.dialer.DialContext(ctx, network, addr)
+				},
 			},
 		},
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
@@ -96,7 +104,27 @@ func NewHTTPContext(options HTTPOptions) (*HTTPContext, error) {
 	return httpCtx, nil
 }
 
+/*
+ * WithContext returns a copy of the HTTP context bound to ctx,
+ * usually the span of the component call making the requests.
+ * The copy shares the underlying client, so it is cheap to create per call.
+ */
+func (c *HTTPContext) WithContext(ctx context.Context) *HTTPContext {
+	bound := *c
+	bound.ctx = ctx
+	return &bound
+}
+
+func (c *HTTPContext) Context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+
+	return c.ctx
+}
+
 func (c *HTTPContext) Do(request *http.Request) (*http.Response, error) {
+	request = c.withSpan(request)
 	if len(c.privateIPRanges) == 0 && len(c.blockedHosts) == 0 {
 		return c.do(request)
 	}
@@ -109,12 +137,34 @@ func (c *HTTPContext) Do(request *http.Request) (*http.Response, error) {
 	return c.do(request)
 }
 
+/*
+ * Requests created without Context() still carry
+ * the span the HTTP context is bound to,
+ * without losing the deadline or cancellation of their own context.
+ */
+func (c *HTTPContext) withSpan(request *http.Request) *http.Request {
+	if c.ctx == nil || trace.SpanContextFromContext(request.Context()).IsValid() {
+		return request
+	}
+
+	spanCtx := trace.SpanContextFromContext(c.ctx)
+	if !spanCtx.IsValid() {
+		return request
+	}
+
+	return request.WithContext(trace.ContextWithSpanContext(request.Context(), spanCtx))
+}
+
 func (c *HTTPContext) do(request *http.Request) (*http.Response, error) {
+	start := time.Now()
 	resp, err := c.client.Do(request)
 	if err != nil {
+		telemetry.RecordIntegrationHTTPRequest(request.URL.Hostname(), 0, time.Since(start))
 		return nil, err
 	}
 
+	telemetry.RecordIntegrationHTTPRequest(request.URL.Hostname(), resp.StatusCode, time.Since(start))
+
 	if c.maxResponseBytes <= 0 {
 		return resp, nil
 	}
@@ -141,6 +191,36 @@ func (c *HTTPContext) do(request *http.Request) (*http.Response, error) {
 	return resp, nil
 }
 
+/*
+ * TracePropagatingTransport injects the W3C trace context (traceparent / tracestate)
+ * from the request context into the outbound request headers,
+ * so calls made by integrations are linked to the trace that triggered them.
+ * Requests whose context carries no valid span are sent untouched.
+ */
+type TracePropagatingTransport struct {
+	base       http.RoundTripper
+	propagator propagation.TextMapPropagator
+}
+
+func (t *TracePropagatingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	carrier := propagation.MapCarrier{}
+	t.propagator.Inject(request.Context(), carrier)
+	if len(carrier) == 0 {
+		return t.base.RoundTrip(request)
+	}
+
+	//
+	// RoundTrippers must not modify the original request,
+	// so we inject the headers into a clone of it.
+	//
+	request = request.Clone(request.Context())
+	for _, key := range carrier.Keys() {
+		request.Header.Set(key, carrier.Get(key))
+	}
+
+	return t.base.RoundTrip(request)
+}
+
 type LimitedReadCloser struct {
 	reader          io.ReadCloser
 	remaining       int64
@@ -1,6 +1,7 @@
 package registry
 
 import (
+	"context"
 	"io"
 	"net"
 	"net/http"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func Test__NewHTTPContext_InvalidCIDR(t *testing.T) {
@@ -291,6 +293,81 @@ func Test__HTTPContext__Do__ResponseTooLarge_Streaming(t *testing.T) {
 	assert.Len(t, body, 5)
 }
 
+func Test__HTTPContext__Do__TraceContextPropagation(t *testing.T) {
+	var received atomic.Value
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Store(r.Header.Get("traceparent"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(testServer.Close)
+
+	ctx, err := NewHTTPContext(HTTPOptions{})
+	require.NoError(t, err)
+
+	t.Run("request context with span -> traceparent header is sent", func(t *testing.T) {
+		traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		require.NoError(t, err)
+		spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+		require.NoError(t, err)
+
+		spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+			Remote:     true,
+		})
+
+		incoming := trace.ContextWithRemoteSpanContext(context.Background(), spanCtx)
+		req, err := http.NewRequestWithContext(incoming, http.MethodGet, testServer.URL, nil)
+		require.NoError(t, err)
+
+		resp, err := ctx.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = resp.Body.Close() })
+
+		assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", received.Load())
+		assert.Empty(t, req.Header.Get("traceparent"), "original request should not be modified")
+	})
+
+	t.Run("context bound to span -> request without span carries it", func(t *testing.T) {
+		traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		require.NoError(t, err)
+		spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+		require.NoError(t, err)
+
+		spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		})
+
+		bound := ctx.WithContext(trace.ContextWithSpanContext(context.Background(), spanCtx))
+		assert.Equal(t, spanCtx, trace.SpanContextFromContext(bound.Context()))
+
+		req, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+		require.NoError(t, err)
+
+		resp, err := bound.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = resp.Body.Close() })
+
+		assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", received.Load())
+		assert.Nil(t, ctx.ctx, "original context should not be bound")
+	})
+
+	t.Run("request context without span -> no traceparent header", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, testServer.URL, nil)
+		require.NoError(t, err)
+
+		resp, err := ctx.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = resp.Body.Close() })
+
+		assert.Equal(t, "", received.Load())
+	})
+}
+
 func Test__HTTPContext__ValidateIP__DefaultConfiguration(t *testing.T) {
 	ctx, err := NewHTTPContext(defaultHTTPOptions())
 	require.NoError(t, err)
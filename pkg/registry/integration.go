@@ -56,7 +56,7 @@ func (s *PanicableIntegration) Components() []core.Component {
 	components := s.underlying.Components()
 	safe := make([]core.Component, len(components))
 	for i, c := range components {
-		safe[i] = NewPanicableComponent(c)
+		safe[i] = NewPanicableIntegrationComponent(c, s.underlying.Name())
 	}
 	return safe
 }
@@ -65,7 +65,7 @@ func (s *PanicableIntegration) Triggers() []core.Trigger {
 	triggers := s.underlying.Triggers()
 	safe := make([]core.Trigger, len(triggers))
 	for i, t := range triggers {
-		safe[i] = NewPanicableTrigger(t)
+		safe[i] = NewPanicableIntegrationTrigger(t, s.underlying.Name())
 	}
 	return safe
 }
@@ -82,6 +82,7 @@ func (s *PanicableIntegration) Sync(ctx core.SyncContext) (err error) {
 				s.underlying.Name(), r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.underlying.Name())
 	return s.underlying.Sync(ctx)
 }
 
@@ -92,6 +93,7 @@ func (s *PanicableIntegration) Cleanup(ctx core.IntegrationCleanupContext) (err
 				s.underlying.Name(), r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.underlying.Name())
 	return s.underlying.Cleanup(ctx)
 }
 
@@ -104,6 +106,7 @@ func (s *PanicableIntegration) HandleAction(ctx core.IntegrationActionContext) (
 				s.underlying.Name(), r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.underlying.Name())
 	return s.underlying.HandleAction(ctx)
 }
 
@@ -115,6 +118,7 @@ func (s *PanicableIntegration) ListResources(resourceType string, ctx core.ListR
 				s.underlying.Name(), r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.underlying.Name())
 	return s.underlying.ListResources(resourceType, ctx)
 }
 
@@ -126,5 +130,6 @@ func (s *PanicableIntegration) HandleRequest(ctx core.HTTPRequestContext) {
 			ctx.Response.WriteHeader(500)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.underlying.Name())
 	s.underlying.HandleRequest(ctx)
 }
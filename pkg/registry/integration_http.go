@@ -0,0 +1,50 @@
+package registry
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+)
+
+/*
+ * IntegrationHTTPContext counts the requests made on behalf of an integration,
+ * so we can tell how many API calls each integration makes,
+ * e.g. when diagnosing rate limiting.
+ */
+type IntegrationHTTPContext struct {
+	base        core.HTTPContext
+	integration string
+}
+
+func NewIntegrationHTTPContext(base core.HTTPContext, integration string) core.HTTPContext {
+	if base == nil || integration == "" {
+		return base
+	}
+
+	//
+	// Integration components and triggers can receive a context
+	// that is already counted, so we don't count their requests twice.
+	//
+	if _, ok := base.(*IntegrationHTTPContext); ok {
+		return base
+	}
+
+	return &IntegrationHTTPContext{base: base, integration: integration}
+}
+
+func (c *IntegrationHTTPContext) Do(request *http.Request) (*http.Response, error) {
+	response, err := c.base.Do(request)
+	if err != nil {
+		telemetry.RecordIntegrationAPICall(c.integration, request.URL.Hostname(), 0)
+		return nil, err
+	}
+
+	telemetry.RecordIntegrationAPICall(c.integration, request.URL.Hostname(), response.StatusCode)
+	return response, nil
+}
+
+func (c *IntegrationHTTPContext) Context() context.Context {
+	return c.base.Context()
+}
@@ -0,0 +1,136 @@
+package registry_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/components/noop"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/common"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/ecr"
+	"github.com/superplanehq/superplane/pkg/integrations/aws/iam"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func scrapeMetrics(t *testing.T) string {
+	recorder := httptest.NewRecorder()
+	telemetry.MetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	return recorder.Body.String()
+}
+
+func mockResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func Test__IntegrationHTTPContext(t *testing.T) {
+	credentials := &aws.Credentials{AccessKeyID: "key", SecretAccessKey: "secret", SessionToken: "token"}
+
+	t.Run("IAM and ECR requests are counted by integration, host and status class", func(t *testing.T) {
+		httpCtx := registry.NewIntegrationHTTPContext(&contexts.HTTPContext{
+			Responses: []*http.Response{
+				mockResponse(http.StatusNotFound, `<ErrorResponse><Error><Code>NoSuchEntity</Code><Message>not found</Message></Error></ErrorResponse>`),
+				mockResponse(http.StatusOK, `{"repositories":[{"repositoryName":"backend"}]}`),
+			},
+		}, "aws-metrics-test")
+
+		_, err := iam.NewClient(httpCtx, credentials, common.PartitionAWS).GetRole("missing")
+		require.Error(t, err)
+
+		_, err = ecr.NewClient(httpCtx, credentials, "us-east-1", "").DescribeRepository("backend")
+		require.NoError(t, err)
+
+		output := scrapeMetrics(t)
+		assert.Contains(t, output, `superplane_integration_api_calls_total{host="iam.amazonaws.com",integration="aws-metrics-test",status_class="4xx"} 1`)
+		assert.Contains(t, output, `superplane_integration_api_calls_total{host="api.ecr.us-east-1.amazonaws.com",integration="aws-metrics-test",status_class="2xx"} 1`)
+	})
+
+	t.Run("requests without a response use the error class", func(t *testing.T) {
+		httpCtx := registry.NewIntegrationHTTPContext(&contexts.HTTPContext{}, "error-metrics-test")
+		request, err := http.NewRequest(http.MethodGet, "https://api.example.com/path?query=1", nil)
+		require.NoError(t, err)
+
+		_, err = httpCtx.Do(request)
+		require.Error(t, err)
+		assert.Contains(t, scrapeMetrics(t), `superplane_integration_api_calls_total{host="api.example.com",integration="error-metrics-test",status_class="error"} 1`)
+	})
+
+	t.Run("contexts already counted are not wrapped again", func(t *testing.T) {
+		httpCtx := registry.NewIntegrationHTTPContext(&contexts.HTTPContext{}, "first")
+		assert.Same(t, httpCtx, registry.NewIntegrationHTTPContext(httpCtx, "second"))
+		assert.Nil(t, registry.NewIntegrationHTTPContext(nil, "first"))
+	})
+
+	t.Run("integration components use the integration context", func(t *testing.T) {
+		httpCtx := &contexts.HTTPContext{Responses: []*http.Response{mockResponse(http.StatusAccepted, `{}`)}}
+		integration := registry.NewPanicableIntegration(&requestingIntegration{})
+
+		components := integration.Components()
+		require.Len(t, components, 1)
+		require.NoError(t, components[0].Execute(core.ExecutionContext{HTTP: httpCtx}))
+		assert.Contains(t, scrapeMetrics(t), `superplane_integration_api_calls_total{host="api.requesting.test",integration="requesting",status_class="2xx"} 1`)
+	})
+}
+
+type requestingIntegration struct{}
+
+func (i *requestingIntegration) Name() string                         { return "requesting" }
+func (i *requestingIntegration) Label() string                        { return "Requesting" }
+func (i *requestingIntegration) Icon() string                         { return "icon" }
+func (i *requestingIntegration) Description() string                  { return "description" }
+func (i *requestingIntegration) Instructions() string                 { return "instructions" }
+func (i *requestingIntegration) Configuration() []configuration.Field { return nil }
+func (i *requestingIntegration) Components() []core.Component {
+	return []core.Component{&requestingComponent{}}
+}
+func (i *requestingIntegration) Triggers() []core.Trigger        { return nil }
+func (i *requestingIntegration) Sync(ctx core.SyncContext) error { return nil }
+func (i *requestingIntegration) Actions() []core.Action          { return nil }
+
+func (i *requestingIntegration) HandleAction(ctx core.IntegrationActionContext) error {
+	return nil
+}
+
+func (i *requestingIntegration) Cleanup(ctx core.IntegrationCleanupContext) error {
+	return nil
+}
+
+func (i *requestingIntegration) ListResources(resourceType string, ctx core.ListResourcesContext) ([]core.IntegrationResource, error) {
+	return nil, nil
+}
+
+func (i *requestingIntegration) HandleRequest(ctx core.HTTPRequestContext) {}
+
+/*
+ * Component that makes a single request when executed.
+ */
+type requestingComponent struct {
+	noop.NoOp
+}
+
+func (c *requestingComponent) Name() string {
+	return "requesting.component"
+}
+
+func (c *requestingComponent) Execute(ctx core.ExecutionContext) error {
+	request, err := http.NewRequest(http.MethodGet, "https://api.requesting.test/items", nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctx.HTTP.Do(request)
+	return err
+}
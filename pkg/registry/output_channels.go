@@ -0,0 +1,63 @@
+package registry
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+/*
+ * OutputChannelExecutionState only lets components emit
+ * on the output channels they declare for the configuration
+ * of the execution. Without it, a payload emitted on an
+ * undeclared channel would silently go nowhere.
+ */
+type OutputChannelExecutionState struct {
+	core.ExecutionStateContext
+	component string
+	channels  []core.OutputChannel
+}
+
+func NewOutputChannelExecutionState(state core.ExecutionStateContext, component string, channels []core.OutputChannel) core.ExecutionStateContext {
+	if state == nil {
+		return nil
+	}
+
+	//
+	// Components that do not declare any channels use the default one.
+	//
+	if len(channels) == 0 {
+		channels = []core.OutputChannel{core.DefaultOutputChannel}
+	}
+
+	return &OutputChannelExecutionState{
+		ExecutionStateContext: state,
+		component:             component,
+		channels:              channels,
+	}
+}
+
+func (s *OutputChannelExecutionState) Emit(channel, payloadType string, payloads []any) error {
+	if err := s.validateChannel(channel); err != nil {
+		return err
+	}
+
+	return s.ExecutionStateContext.Emit(channel, payloadType, payloads)
+}
+
+func (s *OutputChannelExecutionState) Release(channel, payloadType string, payloads []any) ([]uuid.UUID, error) {
+	if err := s.validateChannel(channel); err != nil {
+		return nil, err
+	}
+
+	return s.ExecutionStateContext.Release(channel, payloadType, payloads)
+}
+
+func (s *OutputChannelExecutionState) validateChannel(channel string) error {
+	if core.HasOutputChannel(s.channels, channel) {
+		return nil
+	}
+
+	return fmt.Errorf("component %s does not declare output channel %s", s.component, channel)
+}
@@ -0,0 +1,109 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+// casesComponent declares one output channel per configured case,
+// and emits on the channel received through its data.
+type casesComponent struct{}
+
+func (c *casesComponent) Name() string                         { return "cases" }
+func (c *casesComponent) Label() string                        { return "Cases" }
+func (c *casesComponent) Description() string                  { return "description" }
+func (c *casesComponent) Documentation() string                { return "" }
+func (c *casesComponent) Icon() string                         { return "icon" }
+func (c *casesComponent) Color() string                        { return "gray" }
+func (c *casesComponent) ExampleOutput() map[string]any        { return nil }
+func (c *casesComponent) Configuration() []configuration.Field { return nil }
+func (c *casesComponent) Actions() []core.Action               { return nil }
+func (c *casesComponent) OutputChannels(config any) []core.OutputChannel {
+	m, ok := config.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	cases, _ := m["cases"].([]any)
+	channels := []core.OutputChannel{}
+	for _, name := range cases {
+		channels = append(channels, core.OutputChannel{Name: name.(string), Label: name.(string)})
+	}
+
+	return channels
+}
+
+func (c *casesComponent) Setup(ctx core.SetupContext) error { return nil }
+func (c *casesComponent) Execute(ctx core.ExecutionContext) error {
+	return ctx.ExecutionState.Emit(ctx.Data.(string), "cases", []any{map[string]any{}})
+}
+func (c *casesComponent) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return nil, nil
+}
+func (c *casesComponent) HandleAction(ctx core.ActionContext) error {
+	_, err := ctx.ExecutionState.Release(ctx.Name, "cases", []any{map[string]any{}})
+	return err
+}
+func (c *casesComponent) HandleWebhook(ctx core.WebhookRequestContext) (int, error) { return 200, nil }
+func (c *casesComponent) Cancel(ctx core.ExecutionContext) error                    { return nil }
+func (c *casesComponent) Cleanup(ctx core.SetupContext) error                       { return nil }
+
+func Test__OutputChannelExecutionState(t *testing.T) {
+	component := NewPanicableComponent(&casesComponent{})
+	config := map[string]any{"cases": []any{"low", "high"}}
+
+	execute := func(config any, channel string) (*contexts.ExecutionStateContext, error) {
+		state := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.Execute(core.ExecutionContext{
+			Logger:         log.NewEntry(log.StandardLogger()),
+			Data:           channel,
+			Configuration:  config,
+			ExecutionState: state,
+		})
+
+		return state, err
+	}
+
+	t.Run("emitting on a channel declared by the configuration -> ok", func(t *testing.T) {
+		state, err := execute(config, "high")
+		require.NoError(t, err)
+		assert.True(t, state.Passed)
+		assert.Equal(t, "high", state.Channel)
+	})
+
+	t.Run("emitting on a channel not declared by the configuration -> error", func(t *testing.T) {
+		state, err := execute(config, "medium")
+		require.ErrorContains(t, err, "component cases does not declare output channel medium")
+		assert.False(t, state.Finished)
+	})
+
+	t.Run("no channels declared -> only default is allowed", func(t *testing.T) {
+		state, err := execute(map[string]any{}, "default")
+		require.NoError(t, err)
+		assert.Equal(t, "default", state.Channel)
+
+		_, err = execute(map[string]any{}, "low")
+		require.ErrorContains(t, err, "does not declare output channel low")
+	})
+
+	t.Run("releasing on a channel not declared by the configuration -> error", func(t *testing.T) {
+		state := &contexts.ExecutionStateContext{KVs: map[string]string{}}
+		err := component.HandleAction(core.ActionContext{
+			Name:           "medium",
+			Logger:         log.NewEntry(log.StandardLogger()),
+			Configuration:  config,
+			ExecutionState: state,
+		})
+
+		require.ErrorContains(t, err, "does not declare output channel medium")
+		assert.Empty(t, state.Released)
+	})
+}
@@ -107,7 +107,7 @@ func (r *Registry) Init() {
 	}
 
 	for name, webhookHandler := range registeredWebhookHandlers {
-		r.WebhookHandlers[name] = NewPanicableWebhookHandler(webhookHandler)
+		r.WebhookHandlers[name] = NewPanicableIntegrationWebhookHandler(webhookHandler, name)
 	}
 
 	//
@@ -13,13 +13,22 @@ import (
  * around logic for handling panics.
  */
 type PanicableTrigger struct {
-	underlying core.Trigger
+	underlying  core.Trigger
+	integration string
 }
 
 func NewPanicableTrigger(t core.Trigger) core.Trigger {
 	return &PanicableTrigger{underlying: t}
 }
 
+/*
+ * Requests made by triggers of an integration
+ * are counted as requests made by the integration.
+ */
+func NewPanicableIntegrationTrigger(t core.Trigger, integration string) core.Trigger {
+	return &PanicableTrigger{underlying: t, integration: integration}
+}
+
 /*
  * Non-panicking methods.
  * These are mostly definition methods, so they won't panic.
@@ -52,6 +61,15 @@ func (s *PanicableTrigger) ExampleData() map[string]any {
 	return s.underlying.ExampleData()
 }
 
+func (s *PanicableTrigger) MaxWebhookBodySize() int64 {
+	limiter, ok := s.underlying.(core.WebhookBodySizeLimiter)
+	if !ok {
+		return 0
+	}
+
+	return limiter.MaxWebhookBodySize()
+}
+
 func (s *PanicableTrigger) Configuration() []configuration.Field {
 	return s.underlying.Configuration()
 }
@@ -60,6 +78,25 @@ func (s *PanicableTrigger) Actions() []core.Action {
 	return s.underlying.Actions()
 }
 
+/*
+ * Triggers not depending on the node configuration
+ * for their action parameters use the ones in the action definition.
+ */
+func (s *PanicableTrigger) ActionParameters(action string, configuration any) ([]configuration.Field, error) {
+	configurable, ok := s.underlying.(core.ConfigurableActionParameters)
+	if ok {
+		return configurable.ActionParameters(action, configuration)
+	}
+
+	for _, a := range s.underlying.Actions() {
+		if a.Name == action {
+			return a.Parameters, nil
+		}
+	}
+
+	return nil, fmt.Errorf("action %s not found", action)
+}
+
 /*
  * Panicking methods.
  * These are where the component logic is implemented,
@@ -74,6 +111,7 @@ func (s *PanicableTrigger) Setup(ctx core.TriggerContext) (err error) {
 				s.underlying.Name(), r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.integration)
 	return s.underlying.Setup(ctx)
 }
 
@@ -85,6 +123,7 @@ func (s *PanicableTrigger) HandleWebhook(ctx core.WebhookRequestContext) (status
 				s.underlying.Name(), r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.integration)
 	return s.underlying.HandleWebhook(ctx)
 }
 
@@ -98,6 +137,7 @@ func (s *PanicableTrigger) HandleAction(ctx core.TriggerActionContext) (result m
 				s.underlying.Name(), ctx.Name, r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.integration)
 	return s.underlying.HandleAction(ctx)
 }
 
@@ -112,6 +152,7 @@ func (s *PanicableTrigger) Cleanup(ctx core.TriggerContext) (err error) {
 				s.underlying.Name(), r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.integration)
 	return s.underlying.Cleanup(ctx)
 }
 
@@ -130,5 +171,6 @@ func (s *PanicableTrigger) OnIntegrationMessage(ctx core.IntegrationMessageConte
 		return fmt.Errorf("trigger %s is not an integration trigger", s.underlying.Name())
 	}
 
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, s.integration)
 	return integrationTrigger.OnIntegrationMessage(ctx)
 }
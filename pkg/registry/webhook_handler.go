@@ -8,13 +8,22 @@ import (
 )
 
 type PanicableWebhookHandler struct {
-	underlying core.WebhookHandler
+	underlying  core.WebhookHandler
+	integration string
 }
 
 func NewPanicableWebhookHandler(underlying core.WebhookHandler) *PanicableWebhookHandler {
 	return &PanicableWebhookHandler{underlying: underlying}
 }
 
+/*
+ * Requests made by the webhook handler of an integration
+ * are counted as requests made by the integration.
+ */
+func NewPanicableIntegrationWebhookHandler(underlying core.WebhookHandler, integration string) *PanicableWebhookHandler {
+	return &PanicableWebhookHandler{underlying: underlying, integration: integration}
+}
+
 func (h *PanicableWebhookHandler) CompareConfig(a, b any) (bool, error) {
 	return h.underlying.CompareConfig(a, b)
 }
@@ -39,6 +48,7 @@ func (h *PanicableWebhookHandler) Setup(ctx core.WebhookHandlerContext) (metadat
 			err = fmt.Errorf("webhook handler panicked in Setup(): %v", r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, h.integration)
 	return h.underlying.Setup(ctx)
 }
 
@@ -50,5 +60,6 @@ func (h *PanicableWebhookHandler) Cleanup(ctx core.WebhookHandlerContext) (err e
 			err = fmt.Errorf("webhook handler panicked in Cleanup(): %v", r)
 		}
 	}()
+	ctx.HTTP = NewIntegrationHTTPContext(ctx.HTTP, h.integration)
 	return h.underlying.Cleanup(ctx)
 }
@@ -0,0 +1,54 @@
+package secrets
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+/*
+ * A secret value can reference a secret kept in an external store,
+ * e.g. aws-secretsmanager://us-east-1/prod/db, instead of storing a copy of it.
+ * Integrations backed by such a store register a ReferenceResolver for their scheme,
+ * which reads the referenced value when a component asks for it.
+ */
+type ReferenceResolver interface {
+	Resolve(ctx ReferenceContext, value string) ([]byte, error)
+}
+
+/*
+ * ReferenceContext is what resolvers use to read a referenced value.
+ * FindIntegration returns the organization's integration of the given app.
+ * The name is only needed if the organization has more than one of them.
+ */
+type ReferenceContext struct {
+	HTTP            core.HTTPContext
+	FindIntegration func(appName, name string) (core.IntegrationContext, error)
+}
+
+var (
+	referenceResolversMu sync.RWMutex
+	referenceResolvers   = map[string]ReferenceResolver{}
+)
+
+func RegisterReferenceResolver(scheme string, resolver ReferenceResolver) {
+	referenceResolversMu.Lock()
+	defer referenceResolversMu.Unlock()
+	referenceResolvers[scheme] = resolver
+}
+
+/*
+ * Returns the resolver for the scheme of the value,
+ * or nil if the value is not a reference to an external store.
+ */
+func FindReferenceResolver(value string) ReferenceResolver {
+	scheme, _, ok := strings.Cut(value, "://")
+	if !ok {
+		return nil
+	}
+
+	referenceResolversMu.RLock()
+	defer referenceResolversMu.RUnlock()
+	return referenceResolvers[scheme]
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/crypto"
 	grpc "github.com/superplanehq/superplane/pkg/grpc"
 	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/oidc"
 	"github.com/superplanehq/superplane/pkg/public"
 	registry "github.com/superplanehq/superplane/pkg/registry"
@@ -27,10 +28,13 @@ import (
 	_ "github.com/superplanehq/superplane/pkg/components/filter"
 	_ "github.com/superplanehq/superplane/pkg/components/http"
 	_ "github.com/superplanehq/superplane/pkg/components/if"
+	_ "github.com/superplanehq/superplane/pkg/components/iterate"
 	_ "github.com/superplanehq/superplane/pkg/components/merge"
 	_ "github.com/superplanehq/superplane/pkg/components/noop"
+	_ "github.com/superplanehq/superplane/pkg/components/split"
 	_ "github.com/superplanehq/superplane/pkg/components/ssh"
 	_ "github.com/superplanehq/superplane/pkg/components/timegate"
+	_ "github.com/superplanehq/superplane/pkg/components/transform"
 	_ "github.com/superplanehq/superplane/pkg/components/wait"
 	_ "github.com/superplanehq/superplane/pkg/integrations/aws"
 	_ "github.com/superplanehq/superplane/pkg/integrations/circleci"
@@ -55,13 +59,14 @@ import (
 	_ "github.com/superplanehq/superplane/pkg/integrations/sendgrid"
 	_ "github.com/superplanehq/superplane/pkg/integrations/slack"
 	_ "github.com/superplanehq/superplane/pkg/integrations/smtp"
+	_ "github.com/superplanehq/superplane/pkg/triggers/manual"
 	_ "github.com/superplanehq/superplane/pkg/triggers/schedule"
 	_ "github.com/superplanehq/superplane/pkg/triggers/start"
 	_ "github.com/superplanehq/superplane/pkg/triggers/webhook"
 	_ "github.com/superplanehq/superplane/pkg/widgets/annotation"
 )
 
-func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcProvider oidc.Provider, baseURL string, authService authorization.Authorization) {
+func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, jwtSigner *jwt.Signer, oidcProvider oidc.Provider, baseURL string, authService authorization.Authorization) {
 	log.Println("Starting Workers")
 
 	rabbitMQURL, err := config.RabbitMQURL()
@@ -84,7 +89,7 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 		log.Println("Starting Node Executor")
 
 		webhookBaseURL := getWebhookBaseURL(baseURL)
-		w := workers.NewNodeExecutor(encryptor, registry, baseURL, webhookBaseURL)
+		w := workers.NewNodeExecutor(encryptor, registry, oidcProvider, authService, jwtSigner, baseURL, webhookBaseURL)
 		go w.Start(context.Background())
 	}
 
@@ -138,6 +143,37 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 		w := workers.NewCanvasCleanupWorker()
 		go w.Start(context.Background())
 	}
+
+	if os.Getenv("START_EXECUTION_LOG_CLEANUP_WORKER") == "yes" {
+		log.Println("Starting Execution Log Cleanup Worker")
+
+		retentionDays, err := config.ExecutionLogsRetentionDays()
+		if err != nil {
+			panic(err)
+		}
+
+		w := workers.NewExecutionLogCleanupWorker(retentionDays)
+		go w.Start(context.Background())
+	}
+
+	if os.Getenv("START_AUDIT_EVENT_CLEANUP_WORKER") == "yes" {
+		log.Println("Starting Audit Event Cleanup Worker")
+
+		retentionDays, err := config.AuditEventsRetentionDays()
+		if err != nil {
+			panic(err)
+		}
+
+		w := workers.NewAuditEventCleanupWorker(retentionDays)
+		go w.Start(context.Background())
+	}
+
+	if os.Getenv("START_EVENT_DEDUPLICATION_CLEANUP_WORKER") == "yes" {
+		log.Println("Starting Event Deduplication Cleanup Worker")
+
+		w := workers.NewEventDeduplicationCleanupWorker()
+		go w.Start(context.Background())
+	}
 }
 
 func startEmailConsumers(rabbitMQURL string, encryptor crypto.Encryptor, baseURL string, authService authorization.Authorization) {
@@ -249,6 +285,29 @@ func lookupPublicAPIPort() int {
 	return port
 }
 
+func lookupMetricsPort() int {
+	port := 9090
+
+	if p := os.Getenv("METRICS_PORT"); p != "" {
+		if v, errConv := strconv.Atoi(p); errConv == nil && v > 0 {
+			port = v
+		} else {
+			log.Warnf("Invalid METRICS_PORT %q, falling back to 9090", p)
+		}
+	}
+
+	return port
+}
+
+func startMetricsServer() {
+	port := lookupMetricsPort()
+	log.Infof("Starting metrics server on port %d", port)
+
+	if err := telemetry.StartMetricsServer(port); err != nil {
+		log.Fatalf("metrics server failed: %v", err)
+	}
+}
+
 func lookupInternalAPIPort() int {
 	port := 50051
 
@@ -299,7 +358,7 @@ func Start() {
 	setupOtelMetrics()
 
 	telemetry.InitSentry()
-	telemetry.StartBeacon()
+	telemetry.StartBeacon(models.GetInstallationID)
 
 	encryptionKey := os.Getenv("ENCRYPTION_KEY")
 	if encryptionKey == "" {
@@ -342,7 +401,7 @@ func Start() {
 	}
 
 	jwtSigner := jwt.NewSigner(jwtSecret)
-	oidcProvider, err := oidc.NewProviderFromKeyDir(baseURL, oidcKeysPath)
+	oidcProvider, err := oidc.NewProviderFromKeyDir(baseURL, oidcKeysPath, getOIDCKeysGracePeriod())
 	if err != nil {
 		panic(fmt.Sprintf("failed to load OIDC keys: %v", err))
 	}
@@ -368,7 +427,11 @@ func Start() {
 		go startInternalAPI(baseURL, webhooksBaseURL, basePath, encryptorInstance, authService, registry, oidcProvider)
 	}
 
-	startWorkers(encryptorInstance, registry, oidcProvider, baseURL, authService)
+	if os.Getenv("START_METRICS_SERVER") == "yes" {
+		go startMetricsServer()
+	}
+
+	startWorkers(encryptorInstance, registry, jwtSigner, oidcProvider, baseURL, authService)
 
 	log.Println("SuperPlane is UP.")
 
@@ -387,6 +450,20 @@ func getWebhookBaseURL(baseURL string) string {
 	return webhookBaseURL
 }
 
+func getOIDCKeysGracePeriod() time.Duration {
+	gracePeriod := os.Getenv("OIDC_KEYS_GRACE_PERIOD")
+	if gracePeriod == "" {
+		return oidc.DefaultRetiredKeyGracePeriod
+	}
+
+	d, err := time.ParseDuration(gracePeriod)
+	if err != nil {
+		panic(fmt.Sprintf("invalid OIDC_KEYS_GRACE_PERIOD: %v", err))
+	}
+
+	return d
+}
+
 /*
  * 512KB is the default maximum response size for HTTP responses.
  * This prevents component/trigger implementations from using too much memory,
@@ -9,7 +9,6 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
-	"github.com/superplanehq/superplane/pkg/models"
 )
 
 const defaultBeaconURL = "https://analytics.superplane.com/beacon"
@@ -19,22 +18,26 @@ type beaconPayload struct {
 	InstallationID   string `json:"installation_id"`
 }
 
-func StartBeacon() {
+/*
+ * The installation ID is loaded through the given function,
+ * since models record metrics through this package.
+ */
+func StartBeacon(installationID func() (string, error)) {
 	if !isBeaconEnabled() {
 		return
 	}
 
-	go beaconSender()
+	go beaconSender(installationID)
 }
 
-func beaconSender() {
-	sendBeacon()
+func beaconSender(installationID func() (string, error)) {
+	sendBeacon(installationID)
 
 	ticker := time.NewTicker(time.Hour)
 	go func() {
 		defer ticker.Stop()
 		for range ticker.C {
-			sendBeacon()
+			sendBeacon(installationID)
 		}
 	}()
 }
@@ -43,7 +46,7 @@ func isBeaconEnabled() bool {
 	return os.Getenv("SUPERPLANE_BEACON_ENABLED") == "yes"
 }
 
-func sendBeacon() {
+func sendBeacon(lookupInstallationID func() (string, error)) {
 	client := &http.Client{Timeout: 5 * time.Second}
 
 	installationType := os.Getenv("SUPERPLANE_INSTALLATION_TYPE")
@@ -52,7 +55,7 @@ func sendBeacon() {
 		return
 	}
 
-	installationID, err := models.GetInstallationID()
+	installationID, err := lookupInstallationID()
 	if err != nil {
 		log.WithError(err).Warn("Failed to load installation ID")
 		return
@@ -0,0 +1,3 @@
+package telemetry
+
+var CountStuckQueueNodes = countStuckQueueNodes
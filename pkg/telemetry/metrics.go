@@ -2,10 +2,12 @@ package telemetry
 
 import (
 	"context"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	"go.opentelemetry.io/otel/metric"
 
@@ -34,6 +36,8 @@ var (
 
 	dbLocksCountHistogram       metric.Int64Histogram
 	dbLongQueriesCountHistogram metric.Int64Histogram
+
+	componentExecutionHistogram metric.Float64Histogram
 )
 
 func InitMetrics(ctx context.Context) error {
@@ -168,6 +172,15 @@ func InitMetrics(ctx context.Context) error {
 		return err
 	}
 
+	componentExecutionHistogram, err = meter.Float64Histogram(
+		"component.execution.duration.seconds",
+		metric.WithDescription("Duration of each component Execute and HandleAction call"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
 	StartPeriodicMetricsReporter()
 
 	metricsReady.Store(true)
@@ -175,12 +188,23 @@ func InitMetrics(ctx context.Context) error {
 	return nil
 }
 
+//
+// Both the OpenTelemetry exporter and the Prometheus endpoint
+// rely on the periodic reporter, but it should only run once.
+//
+
+var periodicReporterOnce sync.Once
+
 func StartPeriodicMetricsReporter() {
-	p := NewPeriodic(context.Background())
-	p.Start()
+	periodicReporterOnce.Do(func() {
+		p := NewPeriodic(context.Background())
+		p.Start()
+	})
 }
 
 func RecordQueueWorkerTickDuration(ctx context.Context, d time.Duration) {
+	workerTickDuration.Record(ctx, d.Seconds(), workerAttribute(WorkerQueue))
+
 	if !metricsReady.Load() {
 		return
 	}
@@ -189,6 +213,8 @@ func RecordQueueWorkerTickDuration(ctx context.Context, d time.Duration) {
 }
 
 func RecordQueueWorkerNodesCount(ctx context.Context, count int) {
+	workerQueueDepth.Record(ctx, float64(count), workerAttribute(WorkerQueue))
+
 	if !metricsReady.Load() {
 		return
 	}
@@ -197,6 +223,8 @@ func RecordQueueWorkerNodesCount(ctx context.Context, count int) {
 }
 
 func RecordExecutorWorkerTickDuration(ctx context.Context, d time.Duration) {
+	workerTickDuration.Record(ctx, d.Seconds(), workerAttribute(WorkerExecutor))
+
 	if !metricsReady.Load() {
 		return
 	}
@@ -205,6 +233,8 @@ func RecordExecutorWorkerTickDuration(ctx context.Context, d time.Duration) {
 }
 
 func RecordExecutorWorkerNodesCount(ctx context.Context, count int) {
+	workerQueueDepth.Record(ctx, float64(count), workerAttribute(WorkerExecutor))
+
 	if !metricsReady.Load() {
 		return
 	}
@@ -213,6 +243,8 @@ func RecordExecutorWorkerNodesCount(ctx context.Context, count int) {
 }
 
 func RecordEventWorkerTickDuration(ctx context.Context, d time.Duration) {
+	workerTickDuration.Record(ctx, d.Seconds(), workerAttribute(WorkerEventRouter))
+
 	if !metricsReady.Load() {
 		return
 	}
@@ -221,6 +253,8 @@ func RecordEventWorkerTickDuration(ctx context.Context, d time.Duration) {
 }
 
 func RecordEventWorkerEventsCount(ctx context.Context, count int) {
+	workerQueueDepth.Record(ctx, float64(count), workerAttribute(WorkerEventRouter))
+
 	if !metricsReady.Load() {
 		return
 	}
@@ -229,6 +263,8 @@ func RecordEventWorkerEventsCount(ctx context.Context, count int) {
 }
 
 func RecordNodeRequestWorkerTickDuration(ctx context.Context, d time.Duration) {
+	workerTickDuration.Record(ctx, d.Seconds(), workerAttribute(WorkerNodeRequest))
+
 	if !metricsReady.Load() {
 		return
 	}
@@ -237,6 +273,8 @@ func RecordNodeRequestWorkerTickDuration(ctx context.Context, d time.Duration) {
 }
 
 func RecordNodeRequestWorkerRequestsCount(ctx context.Context, count int) {
+	workerQueueDepth.Record(ctx, float64(count), workerAttribute(WorkerNodeRequest))
+
 	if !metricsReady.Load() {
 		return
 	}
@@ -245,6 +283,8 @@ func RecordNodeRequestWorkerRequestsCount(ctx context.Context, count int) {
 }
 
 func RecordWorkflowCleanupWorkerTickDuration(ctx context.Context, d time.Duration) {
+	workerTickDuration.Record(ctx, d.Seconds(), workerAttribute(WorkerCanvasCleanup))
+
 	if !metricsReady.Load() {
 		return
 	}
@@ -253,6 +293,8 @@ func RecordWorkflowCleanupWorkerTickDuration(ctx context.Context, d time.Duratio
 }
 
 func RecordWorkflowCleanupWorkerCanvasesCount(ctx context.Context, count int) {
+	workerQueueDepth.Record(ctx, float64(count), workerAttribute(WorkerCanvasCleanup))
+
 	if !metricsReady.Load() {
 		return
 	}
@@ -261,6 +303,8 @@ func RecordWorkflowCleanupWorkerCanvasesCount(ctx context.Context, count int) {
 }
 
 func RecordDBLocksCount(ctx context.Context, count int64) {
+	dbLocks.Record(ctx, float64(count))
+
 	if !metricsReady.Load() {
 		return
 	}
@@ -269,6 +313,8 @@ func RecordDBLocksCount(ctx context.Context, count int64) {
 }
 
 func RecordStuckQueueItemsCount(ctx context.Context, count int) {
+	stuckQueueItems.Record(ctx, float64(count))
+
 	if !metricsReady.Load() {
 		return
 	}
@@ -277,9 +323,31 @@ func RecordStuckQueueItemsCount(ctx context.Context, count int) {
 }
 
 func RecordDBLongQueriesCount(ctx context.Context, count int64) {
+	dbLongQueries.Record(ctx, float64(count))
+
 	if !metricsReady.Load() {
 		return
 	}
 
 	dbLongQueriesCountHistogram.Record(ctx, count)
 }
+
+func RecordComponentExecutionDuration(ctx context.Context, component, operation, result string, d time.Duration) {
+	attrs := metric.WithAttributes(
+		attribute.String("component", component),
+		attribute.String("operation", operation),
+		attribute.String("result", result),
+	)
+
+	componentExecutionDuration.Record(ctx, d.Seconds(), attrs)
+
+	if !metricsReady.Load() {
+		return
+	}
+
+	componentExecutionHistogram.Record(ctx, d.Seconds(), attrs)
+}
+
+func workerAttribute(worker string) metric.MeasurementOption {
+	return metric.WithAttributes(attribute.String("worker", worker))
+}
@@ -84,7 +84,8 @@ func countStuckQueueNodes() (int64, error) {
 		Raw(`
 			SELECT COUNT(*)
 			FROM workflow_nodes n
-			WHERE EXISTS (
+			WHERE n.deleted_at IS NULL
+			AND EXISTS (
 				SELECT 1
 				FROM workflow_node_queue_items q
 				WHERE q.workflow_id = n.workflow_id
@@ -96,6 +97,7 @@ func countStuckQueueNodes() (int64, error) {
 				WHERE e.workflow_id = n.workflow_id
 				  AND e.node_id = n.node_id
 				  AND e.state <> 'finished'
+				  AND e.deleted_at IS NULL
 			)
 		`).
 		Scan(&count).Error; err != nil {
@@ -1,4 +1,4 @@
-package telemetry
+package telemetry_test
 
 import (
 	"testing"
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/telemetry"
 	"gorm.io/datatypes"
 )
 
@@ -29,7 +30,7 @@ func TestCountStuckQueueNodes_NodeWithQueueAndNoExecutionsIsCounted(t *testing.T
 
 	require.NoError(t, db.Create(queueItem).Error)
 
-	count, err := countStuckQueueNodes()
+	count, err := telemetry.CountStuckQueueNodes()
 	require.NoError(t, err)
 	require.Equal(t, int64(1), count)
 }
@@ -63,7 +64,7 @@ func TestCountStuckQueueNodes_NodeWithOnlyFinishedExecutionsIsCounted(t *testing
 
 	require.NoError(t, db.Create(exec).Error)
 
-	count, err := countStuckQueueNodes()
+	count, err := telemetry.CountStuckQueueNodes()
 	require.NoError(t, err)
 	require.Equal(t, int64(1), count)
 }
@@ -97,7 +98,7 @@ func TestCountStuckQueueNodes_NodeWithNonFinishedExecutionIsNotCounted(t *testin
 
 	require.NoError(t, db.Create(exec).Error)
 
-	count, err := countStuckQueueNodes()
+	count, err := telemetry.CountStuckQueueNodes()
 	require.NoError(t, err)
 	require.Equal(t, int64(0), count)
 }
@@ -0,0 +1,278 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	otelprometheus "go.opentelemetry.io/otel/exporters/prometheus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+//
+// The Prometheus exporter is always set up, even if the OpenTelemetry
+// OTLP exporter is not configured, so the /metrics endpoint can be scraped
+// without any additional infrastructure. It has its own meter provider,
+// so the metrics sent with OTLP are not exported twice.
+//
+
+const (
+	WorkerQueue          = "node_queue_worker"
+	WorkerExecutor       = "node_executor"
+	WorkerEventRouter    = "event_router"
+	WorkerNodeRequest    = "node_request_worker"
+	WorkerCanvasCleanup  = "canvas_cleanup_worker"
+	metricsNamespace     = "superplane"
+	unknownRouteTemplate = "unmatched"
+)
+
+var (
+	promRegistry = prometheus.NewRegistry()
+
+	workerTickDuration             metric.Float64Histogram
+	workerQueueDepth               metric.Float64Gauge
+	stuckQueueItems                metric.Float64Gauge
+	dbLocks                        metric.Float64Gauge
+	dbLongQueries                  metric.Float64Gauge
+	executionsFinished             metric.Int64Counter
+	componentExecutionDuration     metric.Float64Histogram
+	webhookRequests                metric.Int64Counter
+	webhookRequestDuration         metric.Float64Histogram
+	websocketClients               metric.Float64Gauge
+	websocketWorkflows             metric.Float64Gauge
+	httpRequestDuration            metric.Float64Histogram
+	integrationHTTPRequestDuration metric.Float64Histogram
+	integrationAPICalls            metric.Int64Counter
+	configurationCacheRequests     metric.Int64Counter
+)
+
+func init() {
+	promRegistry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	exporter, err := otelprometheus.New(
+		otelprometheus.WithRegisterer(promRegistry),
+		otelprometheus.WithNamespace(metricsNamespace),
+		otelprometheus.WithoutScopeInfo(),
+		otelprometheus.WithoutTargetInfo(),
+	)
+
+	if err != nil {
+		panic(fmt.Sprintf("failed to create Prometheus exporter: %v", err))
+	}
+
+	err = registerPrometheusInstruments(sdkmetric.NewMeterProvider(sdkmetric.WithReader(exporter)).Meter("superplane"))
+	if err != nil {
+		panic(fmt.Sprintf("failed to register Prometheus instruments: %v", err))
+	}
+}
+
+func registerPrometheusInstruments(m metric.Meter) error {
+	var err error
+
+	//
+	// Durations use the Prometheus default buckets,
+	// since the OpenTelemetry ones are meant for milliseconds.
+	//
+	seconds := []metric.Float64HistogramOption{
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(prometheus.DefBuckets...),
+	}
+
+	workerTickDuration, err = m.Float64Histogram("worker.tick.duration",
+		append(seconds, metric.WithDescription("Duration of each worker tick"))...)
+	if err != nil {
+		return err
+	}
+
+	workerQueueDepth, err = m.Float64Gauge("worker.queue.depth",
+		metric.WithDescription("Number of items a worker found to process on its last tick"))
+	if err != nil {
+		return err
+	}
+
+	stuckQueueItems, err = m.Float64Gauge("queue.items.stuck",
+		metric.WithDescription("Number of stuck workflow node queue items"))
+	if err != nil {
+		return err
+	}
+
+	dbLocks, err = m.Float64Gauge("db.locks",
+		metric.WithDescription("Number of database locks"))
+	if err != nil {
+		return err
+	}
+
+	dbLongQueries, err = m.Float64Gauge("db.long.queries",
+		metric.WithDescription("Number of long-running database queries"))
+	if err != nil {
+		return err
+	}
+
+	executionsFinished, err = m.Int64Counter("executions.finished",
+		metric.WithDescription("Number of finished node executions, by result"))
+	if err != nil {
+		return err
+	}
+
+	componentExecutionDuration, err = m.Float64Histogram("component.execution.duration",
+		append(seconds, metric.WithDescription("Duration of component Execute and HandleAction calls, by component, operation and result"))...)
+	if err != nil {
+		return err
+	}
+
+	webhookRequests, err = m.Int64Counter("webhook.requests",
+		metric.WithDescription("Number of webhook requests received, by response status"))
+	if err != nil {
+		return err
+	}
+
+	webhookRequestDuration, err = m.Float64Histogram("webhook.request.duration",
+		append(seconds, metric.WithDescription("Duration of webhook requests, by response status"))...)
+	if err != nil {
+		return err
+	}
+
+	websocketClients, err = m.Float64Gauge("websocket.clients",
+		metric.WithDescription("Number of connected websocket clients"))
+	if err != nil {
+		return err
+	}
+
+	websocketWorkflows, err = m.Float64Gauge("websocket.canvases",
+		metric.WithDescription("Number of canvases with at least one connected websocket client"))
+	if err != nil {
+		return err
+	}
+
+	httpRequestDuration, err = m.Float64Histogram("http.request.duration",
+		append(seconds, metric.WithDescription("Duration of HTTP requests handled by the public server"))...)
+	if err != nil {
+		return err
+	}
+
+	integrationHTTPRequestDuration, err = m.Float64Histogram("integration.http.request.duration",
+		append(seconds, metric.WithDescription("Duration of HTTP requests made by components, triggers and integrations"))...)
+	if err != nil {
+		return err
+	}
+
+	integrationAPICalls, err = m.Int64Counter("integration.api.calls",
+		metric.WithDescription("Number of HTTP requests made by integrations, by integration, host and status class"))
+	if err != nil {
+		return err
+	}
+
+	configurationCacheRequests, err = m.Int64Counter("configuration.cache.requests",
+		metric.WithDescription("Number of node configuration cache lookups, by result"))
+
+	return err
+}
+
+func MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(promRegistry, promhttp.HandlerOpts{})
+}
+
+/*
+ * The metrics endpoint is not authenticated,
+ * so it is served on its own listener, which is not exposed publicly.
+ */
+func StartMetricsServer(port int) error {
+	StartPeriodicMetricsReporter()
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", MetricsHandler())
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf("0.0.0.0:%d", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return server.ListenAndServe()
+}
+
+func RecordExecutionFinished(result string) {
+	executionsFinished.Add(context.Background(), 1, metric.WithAttributes(attribute.String("result", result)))
+}
+
+func RecordWebhookRequest(status int, d time.Duration) {
+	attrs := metric.WithAttributes(attribute.String("status", strconv.Itoa(status)))
+	webhookRequests.Add(context.Background(), 1, attrs)
+	webhookRequestDuration.Record(context.Background(), d.Seconds(), attrs)
+}
+
+func RecordWebsocketClientsCount(count int) {
+	websocketClients.Record(context.Background(), float64(count))
+}
+
+func RecordWebsocketWorkflowsCount(count int) {
+	websocketWorkflows.Record(context.Background(), float64(count))
+}
+
+/*
+ * Routes are recorded with their template, e.g. /api/v1/webhooks/{webhookID},
+ * to keep the number of label values bounded.
+ */
+func RecordHTTPRequest(method, route string, status int, d time.Duration) {
+	if route == "" {
+		route = unknownRouteTemplate
+	}
+
+	httpRequestDuration.Record(context.Background(), d.Seconds(), metric.WithAttributes(
+		attribute.String("method", method),
+		attribute.String("route", route),
+		attribute.String("status", strconv.Itoa(status)),
+	))
+}
+
+/*
+ * Failed requests that did not get a response are recorded with the "error" status.
+ */
+func RecordIntegrationHTTPRequest(host string, status int, d time.Duration) {
+	code := "error"
+	if status > 0 {
+		code = strconv.Itoa(status)
+	}
+
+	integrationHTTPRequestDuration.Record(context.Background(), d.Seconds(), metric.WithAttributes(
+		attribute.String("host", host),
+		attribute.String("status", code),
+	))
+}
+
+/*
+ * Hosts are used instead of full URLs, and statuses are grouped
+ * into classes (2xx, 4xx, ...), to keep the number of label values bounded.
+ * Failed requests that did not get a response are recorded with the "error" class.
+ */
+func RecordIntegrationAPICall(integration, host string, status int) {
+	class := "error"
+	if status > 0 {
+		class = fmt.Sprintf("%dxx", status/100)
+	}
+
+	integrationAPICalls.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("integration", integration),
+		attribute.String("host", host),
+		attribute.String("status_class", class),
+	))
+}
+
+func RecordConfigurationCacheHit() {
+	configurationCacheRequests.Add(context.Background(), 1, metric.WithAttributes(attribute.String("result", "hit")))
+}
+
+func RecordConfigurationCacheMiss() {
+	configurationCacheRequests.Add(context.Background(), 1, metric.WithAttributes(attribute.String("result", "miss")))
+}
@@ -0,0 +1,90 @@
+package telemetry_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+)
+
+func Test__MetricsHandler(t *testing.T) {
+	scrape := func(t *testing.T) string {
+		recorder := httptest.NewRecorder()
+		telemetry.MetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		body, err := io.ReadAll(recorder.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	t.Run("worker helpers are exported without OpenTelemetry", func(t *testing.T) {
+		telemetry.RecordNodeRequestWorkerTickDuration(context.Background(), 250*time.Millisecond)
+		telemetry.RecordNodeRequestWorkerRequestsCount(context.Background(), 7)
+
+		output := scrape(t)
+		assert.Contains(t, output, `superplane_worker_tick_duration_seconds_count{worker="node_request_worker"}`)
+		assert.Contains(t, output, `superplane_worker_queue_depth{worker="node_request_worker"} 7`)
+	})
+
+	t.Run("executions are counted by result", func(t *testing.T) {
+		telemetry.RecordExecutionFinished("passed")
+		telemetry.RecordExecutionFinished("passed")
+		telemetry.RecordExecutionFinished("failed")
+
+		output := scrape(t)
+		assert.Contains(t, output, `superplane_executions_finished_total{result="passed"} 2`)
+		assert.Contains(t, output, `superplane_executions_finished_total{result="failed"} 1`)
+	})
+
+	t.Run("webhook requests are counted by status", func(t *testing.T) {
+		telemetry.RecordWebhookRequest(http.StatusNotFound, 10*time.Millisecond)
+
+		output := scrape(t)
+		assert.Contains(t, output, `superplane_webhook_requests_total{status="404"} 1`)
+		assert.Contains(t, output, `superplane_webhook_request_duration_seconds_count{status="404"} 1`)
+	})
+
+	t.Run("websocket clients are reported", func(t *testing.T) {
+		telemetry.RecordWebsocketClientsCount(3)
+		telemetry.RecordWebsocketWorkflowsCount(2)
+
+		output := scrape(t)
+		assert.Contains(t, output, "superplane_websocket_clients 3")
+		assert.Contains(t, output, "superplane_websocket_canvases 2")
+	})
+
+	t.Run("HTTP requests without a route use a fixed label", func(t *testing.T) {
+		telemetry.RecordHTTPRequest(http.MethodGet, "", http.StatusOK, time.Millisecond)
+		telemetry.RecordHTTPRequest(http.MethodPost, "/api/v1/webhooks/{webhookID}", http.StatusOK, time.Millisecond)
+
+		output := scrape(t)
+		assert.Contains(t, output, `superplane_http_request_duration_seconds_count{method="GET",route="unmatched",status="200"} 1`)
+		assert.Contains(t, output, `superplane_http_request_duration_seconds_count{method="POST",route="/api/v1/webhooks/{webhookID}",status="200"} 1`)
+	})
+
+	t.Run("configuration cache lookups are counted by result", func(t *testing.T) {
+		telemetry.RecordConfigurationCacheHit()
+		telemetry.RecordConfigurationCacheMiss()
+		telemetry.RecordConfigurationCacheMiss()
+
+		output := scrape(t)
+		assert.Contains(t, output, `superplane_configuration_cache_requests_total{result="hit"} 1`)
+		assert.Contains(t, output, `superplane_configuration_cache_requests_total{result="miss"} 2`)
+	})
+
+	t.Run("integration requests without a response use the error status", func(t *testing.T) {
+		telemetry.RecordIntegrationHTTPRequest("api.example.com", 0, time.Millisecond)
+		telemetry.RecordIntegrationHTTPRequest("api.example.com", http.StatusCreated, time.Millisecond)
+
+		output := scrape(t)
+		assert.Contains(t, output, `superplane_integration_http_request_duration_seconds_count{host="api.example.com",status="error"} 1`)
+		assert.Contains(t, output, `superplane_integration_http_request_duration_seconds_count{host="api.example.com",status="201"} 1`)
+	})
+}
@@ -0,0 +1,94 @@
+package telemetry
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ComponentOperationExecute = "execute"
+	ComponentOperationAction  = "action"
+
+	ResultSuccess = "success"
+	ResultError   = "error"
+	ResultSkipped = "skipped"
+)
+
+//
+// The tracer is resolved through the global provider,
+// so spans are only exported when a tracer provider is configured.
+//
+
+var tracer = otel.Tracer("superplane")
+
+type ComponentCall struct {
+	WorkflowID string
+	NodeID     string
+	Component  string
+	Operation  string
+}
+
+func (c ComponentCall) attributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("workflow.id", c.WorkflowID),
+		attribute.String("node.id", c.NodeID),
+		attribute.String("component.name", c.Component),
+	}
+}
+
+/*
+ * Wraps a component Execute or HandleAction call in a span,
+ * and records its duration, labeled with the component name and the result.
+ * fn receives the context of the span, so the calls it makes are part of the trace.
+ */
+func TraceComponentCall(ctx context.Context, call ComponentCall, fn func(ctx context.Context) error) error {
+	ctx, span := tracer.Start(ctx, "component."+call.Operation, trace.WithAttributes(call.attributes()...))
+	defer span.End()
+
+	start := time.Now()
+	err := fn(ctx)
+	result := ResultSuccess
+	if err != nil {
+		result = ResultError
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.SetAttributes(attribute.String("result", result))
+	RecordComponentExecutionDuration(ctx, call.Component, call.Operation, result, time.Since(start))
+	return err
+}
+
+/*
+ * Starts the span for a node execution being processed by the executor.
+ * The workflow and node are only known after the execution is locked,
+ * so they are added with SetExecutionSpanAttributes.
+ */
+func StartNodeExecutionSpan(ctx context.Context, executionID string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "node_executor.process", trace.WithAttributes(
+		attribute.String("execution.id", executionID),
+	))
+}
+
+func SetExecutionSpanAttributes(span trace.Span, workflowID, nodeID, component string) {
+	span.SetAttributes(
+		attribute.String("workflow.id", workflowID),
+		attribute.String("node.id", nodeID),
+		attribute.String("component.name", component),
+	)
+}
+
+func EndNodeExecutionSpan(span trace.Span, result string, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.SetAttributes(attribute.String("result", result))
+	span.End()
+}
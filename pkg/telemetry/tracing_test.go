@@ -0,0 +1,48 @@
+package telemetry_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+)
+
+func Test__TraceComponentCall(t *testing.T) {
+	scrape := func(t *testing.T) string {
+		recorder := httptest.NewRecorder()
+		telemetry.MetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		body, err := io.ReadAll(recorder.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	t.Run("successful calls are recorded with the component name", func(t *testing.T) {
+		err := telemetry.TraceComponentCall(context.Background(), telemetry.ComponentCall{
+			WorkflowID: "workflow-1",
+			NodeID:     "node-1",
+			Component:  "approval",
+			Operation:  telemetry.ComponentOperationExecute,
+		}, func(context.Context) error { return nil })
+
+		require.NoError(t, err)
+		assert.Contains(t, scrape(t), `superplane_component_execution_duration_seconds_count{component="approval",operation="execute",result="success"} 1`)
+	})
+
+	t.Run("errors are returned and recorded with the error result", func(t *testing.T) {
+		err := telemetry.TraceComponentCall(context.Background(), telemetry.ComponentCall{
+			WorkflowID: "workflow-1",
+			NodeID:     "node-1",
+			Component:  "approval",
+			Operation:  telemetry.ComponentOperationAction,
+		}, func(context.Context) error { return errors.New("oops") })
+
+		require.EqualError(t, err, "oops")
+		assert.Contains(t, scrape(t), `superplane_component_execution_duration_seconds_count{component="approval",operation="action",result="error"} 1`)
+	})
+}
@@ -0,0 +1,132 @@
+package manual
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const (
+	MaxEventSize = 64 * 1024
+
+	ActionEmitEvent = "emitEvent"
+	EventType       = "manual.event"
+)
+
+func init() {
+	registry.RegisterTrigger("manual", &Manual{})
+}
+
+type Manual struct{}
+
+func (m *Manual) Name() string {
+	return "manual"
+}
+
+func (m *Manual) Label() string {
+	return "Manual Event"
+}
+
+func (m *Manual) Description() string {
+	return "Start a new execution chain with a custom payload, through the UI or the API"
+}
+
+func (m *Manual) Documentation() string {
+	return `The Manual Event trigger starts workflow executions on demand, with a payload you provide.
+
+## Use Cases
+
+- **Replaying events**: Re-run a workflow with the payload of an event you already received
+- **Scripted runs**: Start workflows from scripts or other systems through the SuperPlane API
+- **Testing with real data**: Run a workflow with a specific payload during development
+
+## How It Works
+
+1. Add the Manual Event trigger as the starting node of your workflow
+2. Invoke the **emitEvent** action on the node, with the payload you want
+3. The payload is emitted as an event, and the workflow begins immediately
+
+The action can be invoked through the UI, or through the API with ` + "`InvokeNodeTriggerAction`" + `.
+
+## Configuration
+
+The Manual Event trigger requires no configuration.
+
+## Event Data
+
+The event data is the payload given to the **emitEvent** action, which must be a JSON object of up to 64KB.`
+}
+
+func (m *Manual) Icon() string {
+	return "hand"
+}
+
+func (m *Manual) Color() string {
+	return "purple"
+}
+
+func (m *Manual) Configuration() []configuration.Field {
+	return []configuration.Field{}
+}
+
+func (m *Manual) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
+	return http.StatusOK, nil
+}
+
+func (m *Manual) Setup(ctx core.TriggerContext) error {
+	return nil
+}
+
+func (m *Manual) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name:           ActionEmitEvent,
+			Description:    "Emit an event with the given payload",
+			UserAccessible: true,
+			Parameters: []configuration.Field{
+				{
+					Name:        "payload",
+					Label:       "Payload",
+					Type:        configuration.FieldTypeObject,
+					Required:    true,
+					Description: "JSON payload of the event",
+				},
+			},
+		},
+	}
+}
+
+func (m *Manual) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
+	switch ctx.Name {
+	case ActionEmitEvent:
+		return nil, m.emitEvent(ctx)
+	}
+
+	return nil, fmt.Errorf("action %s not supported", ctx.Name)
+}
+
+func (m *Manual) emitEvent(ctx core.TriggerActionContext) error {
+	payload, ok := ctx.Parameters["payload"].(map[string]any)
+	if !ok {
+		return fmt.Errorf("payload must be an object")
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("invalid payload: %w", err)
+	}
+
+	if len(data) > MaxEventSize {
+		return fmt.Errorf("payload is too large - must be up to %d bytes", MaxEventSize)
+	}
+
+	return ctx.Events.Emit(EventType, payload)
+}
+
+func (m *Manual) Cleanup(ctx core.TriggerContext) error {
+	return nil
+}
@@ -0,0 +1,60 @@
+package manual
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__Manual__HandleAction(t *testing.T) {
+	trigger := &Manual{}
+
+	t.Run("emits the payload as an event", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		payload := map[string]any{"environment": "production", "version": "v1.2.3"}
+
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:       ActionEmitEvent,
+			Parameters: map[string]any{"payload": payload},
+			Events:     events,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, events.Payloads, 1)
+		assert.Equal(t, EventType, events.Payloads[0].Type)
+		assert.Equal(t, payload, events.Payloads[0].Data)
+	})
+
+	t.Run("payload that is not an object -> error", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:       ActionEmitEvent,
+			Parameters: map[string]any{"payload": "hello"},
+			Events:     events,
+		})
+
+		require.ErrorContains(t, err, "payload must be an object")
+		assert.Empty(t, events.Payloads)
+	})
+
+	t.Run("oversized payload -> error", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:       ActionEmitEvent,
+			Parameters: map[string]any{"payload": map[string]any{"data": strings.Repeat("a", MaxEventSize)}},
+			Events:     events,
+		})
+
+		require.ErrorContains(t, err, "payload is too large")
+		assert.Empty(t, events.Payloads)
+	})
+
+	t.Run("unknown action -> error", func(t *testing.T) {
+		_, err := trigger.HandleAction(core.TriggerActionContext{Name: "unknown"})
+		require.ErrorContains(t, err, "action unknown not supported")
+	})
+}
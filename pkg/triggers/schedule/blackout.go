@@ -0,0 +1,177 @@
+package schedule
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	BlackoutTimeFormat   = "2006-01-02T15:04"
+	QuietHoursTimeFormat = "15:04"
+
+	/*
+	 * How many scheduled fires we look at
+	 * when searching for the next one outside of a blackout.
+	 */
+	maxEligibleTriggerSearch = 1000
+)
+
+type Blackout struct {
+	Start  string `json:"start"`
+	End    string `json:"end"`
+	Reason string `json:"reason"`
+}
+
+/*
+ * Quiet hours start on each of the given days (every day, if none),
+ * and end on the same day, or on the next one, if the end is before the start.
+ */
+type QuietHours struct {
+	Days  []string `json:"days"`
+	Start string   `json:"start"`
+	End   string   `json:"end"`
+}
+
+type SkippedRun struct {
+	At           string  `json:"at"`
+	Reason       string  `json:"reason"`
+	NextEligible *string `json:"nextEligible"`
+}
+
+func validateBlackouts(config Configuration) error {
+	_, _, err := blackoutReason(config, time.Now())
+	return err
+}
+
+/*
+ * Returns the reason why the schedule must not fire at the given time, if it is inside a blackout.
+ * Blackout dates and quiet hours are in the blackout timezone,
+ * and include their start, but not their end.
+ */
+func blackoutReason(config Configuration, t time.Time) (string, bool, error) {
+	timezone := parseTimezone(config.BlackoutTimezone)
+	local := t.In(timezone)
+
+	for i, blackout := range config.Blackouts {
+		start, err := time.ParseInLocation(BlackoutTimeFormat, blackout.Start, timezone)
+		if err != nil {
+			return "", false, fmt.Errorf("blackout %d: start must be in format %s", i+1, BlackoutTimeFormat)
+		}
+
+		end, err := time.ParseInLocation(BlackoutTimeFormat, blackout.End, timezone)
+		if err != nil {
+			return "", false, fmt.Errorf("blackout %d: end must be in format %s", i+1, BlackoutTimeFormat)
+		}
+
+		if !start.Before(end) {
+			return "", false, fmt.Errorf("blackout %d: start must be before end", i+1)
+		}
+
+		if local.Before(start) || !local.Before(end) {
+			continue
+		}
+
+		reason := fmt.Sprintf("blackout from %s to %s", blackout.Start, blackout.End)
+		if strings.TrimSpace(blackout.Reason) != "" {
+			reason = fmt.Sprintf("%s: %s", reason, strings.TrimSpace(blackout.Reason))
+		}
+
+		return reason, true, nil
+	}
+
+	if config.QuietHours == nil {
+		return "", false, nil
+	}
+
+	inQuietHours, err := config.QuietHours.includes(local)
+	if err != nil {
+		return "", false, fmt.Errorf("quiet hours: %w", err)
+	}
+
+	if !inQuietHours {
+		return "", false, nil
+	}
+
+	return fmt.Sprintf("quiet hours from %s to %s", config.QuietHours.Start, config.QuietHours.End), true, nil
+}
+
+func (q *QuietHours) includes(t time.Time) (bool, error) {
+	start, err := time.Parse(QuietHoursTimeFormat, q.Start)
+	if err != nil {
+		return false, fmt.Errorf("start must be in format HH:MM")
+	}
+
+	end, err := time.Parse(QuietHoursTimeFormat, q.End)
+	if err != nil {
+		return false, fmt.Errorf("end must be in format HH:MM")
+	}
+
+	if start.Equal(end) {
+		return false, fmt.Errorf("start and end must be different")
+	}
+
+	days := []time.Weekday{}
+	for _, day := range q.Days {
+		weekday, err := parseWeekday(day)
+		if err != nil {
+			return false, err
+		}
+
+		days = append(days, weekday)
+	}
+
+	startsOn := func(day time.Weekday) bool {
+		return len(days) == 0 || slices.Contains(days, day)
+	}
+
+	minute := t.Hour()*60 + t.Minute()
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+
+	if startMinute < endMinute {
+		return startsOn(t.Weekday()) && minute >= startMinute && minute < endMinute, nil
+	}
+
+	//
+	// Quiet hours spanning midnight: the part after midnight
+	// belongs to the quiet hours started on the day before.
+	//
+	if minute >= startMinute {
+		return startsOn(t.Weekday()), nil
+	}
+
+	if minute < endMinute {
+		return startsOn(t.AddDate(0, 0, -1).Weekday()), nil
+	}
+
+	return false, nil
+}
+
+/*
+ * The next scheduled fire after the given time which is not inside a blackout.
+ * Nil is returned if there is none within the next fires we look at.
+ */
+func nextEligibleTrigger(config Configuration, after time.Time, referenceTime *string) (*time.Time, error) {
+	current := after
+	for range maxEligibleTriggerSearch {
+		next, err := getNextTrigger(config, current, referenceTime)
+		if err != nil {
+			return nil, err
+		}
+
+		_, inBlackout, err := blackoutReason(config, *next)
+		if err != nil {
+			return nil, err
+		}
+
+		if !inBlackout {
+			return next, nil
+		}
+
+		current = *next
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,338 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func TestBlackoutReason(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     Configuration
+		at         string
+		inBlackout bool
+		reason     string
+	}{
+		{
+			name: "fire exactly at blackout start is skipped",
+			config: Configuration{
+				Blackouts: []Blackout{{Start: "2026-12-24T00:00", End: "2026-12-27T00:00", Reason: "Holidays"}},
+			},
+			at:         "2026-12-24T00:00:00Z",
+			inBlackout: true,
+			reason:     "blackout from 2026-12-24T00:00 to 2026-12-27T00:00: Holidays",
+		},
+		{
+			name: "fire exactly at blackout end is not skipped",
+			config: Configuration{
+				Blackouts: []Blackout{{Start: "2026-12-24T00:00", End: "2026-12-27T00:00"}},
+			},
+			at:         "2026-12-27T00:00:00Z",
+			inBlackout: false,
+		},
+		{
+			name: "fire right before blackout start is not skipped",
+			config: Configuration{
+				Blackouts: []Blackout{{Start: "2026-12-24T00:00", End: "2026-12-27T00:00"}},
+			},
+			at:         "2026-12-23T23:59:00Z",
+			inBlackout: false,
+		},
+		{
+			name: "blackout spanning midnight in non-UTC timezone - before midnight",
+			config: Configuration{
+				Blackouts:        []Blackout{{Start: "2026-12-31T22:00", End: "2027-01-01T02:00"}},
+				BlackoutTimezone: stringPtr("5.5"),
+			},
+			at:         "2026-12-31T16:30:00Z", // 22:00 local
+			inBlackout: true,
+			reason:     "blackout from 2026-12-31T22:00 to 2027-01-01T02:00",
+		},
+		{
+			name: "blackout spanning midnight in non-UTC timezone - after midnight",
+			config: Configuration{
+				Blackouts:        []Blackout{{Start: "2026-12-31T22:00", End: "2027-01-01T02:00"}},
+				BlackoutTimezone: stringPtr("5.5"),
+			},
+			at:         "2026-12-31T20:29:00Z", // 01:59 local, on the next day
+			inBlackout: true,
+			reason:     "blackout from 2026-12-31T22:00 to 2027-01-01T02:00",
+		},
+		{
+			name: "blackout spanning midnight in non-UTC timezone - at end",
+			config: Configuration{
+				Blackouts:        []Blackout{{Start: "2026-12-31T22:00", End: "2027-01-01T02:00"}},
+				BlackoutTimezone: stringPtr("5.5"),
+			},
+			at:         "2026-12-31T20:30:00Z", // 02:00 local
+			inBlackout: false,
+		},
+		{
+			name: "blackout spanning midnight in non-UTC timezone - same UTC time outside of it",
+			config: Configuration{
+				Blackouts:        []Blackout{{Start: "2026-12-31T22:00", End: "2027-01-01T02:00"}},
+				BlackoutTimezone: stringPtr("5.5"),
+			},
+			at:         "2026-12-31T22:30:00Z", // 04:00 local
+			inBlackout: false,
+		},
+		{
+			name: "quiet hours spanning midnight - at start",
+			config: Configuration{
+				QuietHours:       &QuietHours{Days: []string{WeekDayFriday}, Start: "22:00", End: "06:00"},
+				BlackoutTimezone: stringPtr("-5"),
+			},
+			at:         "2026-10-17T03:00:00Z", // Friday 22:00 local
+			inBlackout: true,
+			reason:     "quiet hours from 22:00 to 06:00",
+		},
+		{
+			name: "quiet hours spanning midnight - after midnight",
+			config: Configuration{
+				QuietHours:       &QuietHours{Days: []string{WeekDayFriday}, Start: "22:00", End: "06:00"},
+				BlackoutTimezone: stringPtr("-5"),
+			},
+			at:         "2026-10-17T08:00:00Z", // Saturday 03:00 local
+			inBlackout: true,
+			reason:     "quiet hours from 22:00 to 06:00",
+		},
+		{
+			name: "quiet hours spanning midnight - at end",
+			config: Configuration{
+				QuietHours:       &QuietHours{Days: []string{WeekDayFriday}, Start: "22:00", End: "06:00"},
+				BlackoutTimezone: stringPtr("-5"),
+			},
+			at:         "2026-10-17T11:00:00Z", // Saturday 06:00 local
+			inBlackout: false,
+		},
+		{
+			name: "quiet hours spanning midnight - started on a day not selected",
+			config: Configuration{
+				QuietHours:       &QuietHours{Days: []string{WeekDayFriday}, Start: "22:00", End: "06:00"},
+				BlackoutTimezone: stringPtr("-5"),
+			},
+			at:         "2026-10-16T08:00:00Z", // Friday 03:00 local, started on Thursday
+			inBlackout: false,
+		},
+		{
+			name: "quiet hours without days apply every day",
+			config: Configuration{
+				QuietHours: &QuietHours{Start: "12:00", End: "13:00"},
+			},
+			at:         "2026-10-14T12:30:00Z",
+			inBlackout: true,
+			reason:     "quiet hours from 12:00 to 13:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, inBlackout, err := blackoutReason(tt.config, mustParseTime(tt.at))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if inBlackout != tt.inBlackout {
+				t.Fatalf("expected in blackout %v, got %v", tt.inBlackout, inBlackout)
+			}
+
+			if reason != tt.reason {
+				t.Errorf("expected reason %q, got %q", tt.reason, reason)
+			}
+		})
+	}
+}
+
+func TestValidateBlackouts(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Configuration
+		err    string
+	}{
+		{
+			name:   "invalid start",
+			config: Configuration{Blackouts: []Blackout{{Start: "2026-12-24", End: "2026-12-27T00:00"}}},
+			err:    "blackout 1: start must be in format 2006-01-02T15:04",
+		},
+		{
+			name:   "end before start",
+			config: Configuration{Blackouts: []Blackout{{Start: "2026-12-27T00:00", End: "2026-12-24T00:00"}}},
+			err:    "blackout 1: start must be before end",
+		},
+		{
+			name:   "quiet hours with same start and end",
+			config: Configuration{QuietHours: &QuietHours{Start: "10:00", End: "10:00"}},
+			err:    "quiet hours: start and end must be different",
+		},
+		{
+			name:   "quiet hours with invalid day",
+			config: Configuration{QuietHours: &QuietHours{Days: []string{"someday"}, Start: "10:00", End: "11:00"}},
+			err:    "quiet hours: invalid weekday: someday",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBlackouts(tt.config)
+			if err == nil {
+				t.Fatalf("expected error %q, got none", tt.err)
+			}
+
+			if err.Error() != tt.err {
+				t.Errorf("expected error %q, got %q", tt.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestNextEligibleTrigger(t *testing.T) {
+	config := Configuration{
+		Type:         TypeDays,
+		DaysInterval: intPtr(1),
+		Hour:         intPtr(9),
+		Minute:       intPtr(0),
+		Timezone:     stringPtr("0"),
+		Blackouts:    []Blackout{{Start: "2026-12-24T00:00", End: "2026-12-27T00:00"}},
+	}
+
+	next, err := nextEligibleTrigger(config, mustParseTime("2026-12-23T10:00:00Z"), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if next == nil {
+		t.Fatalf("expected next eligible trigger")
+	}
+
+	expected := mustParseTime("2026-12-27T09:00:00Z")
+	if !next.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, *next)
+	}
+}
+
+func TestEmitEventInBlackout(t *testing.T) {
+	schedule := &Schedule{}
+	now := time.Now().UTC()
+	blackoutEnd := now.Add(time.Hour).Truncate(time.Minute)
+	config := Configuration{
+		Type:            TypeMinutes,
+		MinutesInterval: intPtr(5),
+		Blackouts: []Blackout{
+			{
+				Start:  now.Add(-time.Hour).Format(BlackoutTimeFormat),
+				End:    blackoutEnd.Format(BlackoutTimeFormat),
+				Reason: "Release freeze",
+			},
+		},
+		BlackoutTimezone: stringPtr("0"),
+	}
+
+	referenceTime := now.Add(-time.Hour).Format(time.RFC3339)
+	metadataCtx := &contexts.MetadataContext{
+		Metadata: Metadata{ReferenceTime: &referenceTime},
+	}
+
+	newCtx := func(action string, events *contexts.EventContext, requests *contexts.RequestContext) core.TriggerActionContext {
+		return core.TriggerActionContext{
+			Name:          action,
+			Configuration: config,
+			Logger:        log.NewEntry(log.StandardLogger()),
+			Events:        events,
+			Metadata:      metadataCtx,
+			Requests:      requests,
+		}
+	}
+
+	t.Run("fire inside blackout is skipped and next one is scheduled", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		requests := &contexts.RequestContext{}
+		_, err := schedule.HandleAction(newCtx(ActionEmitEvent, events, requests))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(events.Payloads) != 0 {
+			t.Errorf("expected no events inside blackout, got %d", len(events.Payloads))
+		}
+
+		if requests.Action != ActionEmitEvent {
+			t.Errorf("expected next fire to be scheduled, got %q", requests.Action)
+		}
+
+		metadata := metadataCtx.Get().(Metadata)
+		if metadata.NextTrigger == nil {
+			t.Fatalf("expected next trigger to be set")
+		}
+
+		if metadata.Skipped == nil {
+			t.Fatalf("expected skipped run to be recorded")
+		}
+
+		if !contains(metadata.Skipped.Reason, "Release freeze") {
+			t.Errorf("expected reason to include the blackout reason, got %q", metadata.Skipped.Reason)
+		}
+
+		if metadata.Skipped.NextEligible == nil {
+			t.Fatalf("expected next eligible time to be recorded")
+		}
+
+		nextEligible := mustParseTime(*metadata.Skipped.NextEligible)
+		if nextEligible.Before(blackoutEnd) {
+			t.Errorf("expected next eligible time after blackout end %v, got %v", blackoutEnd, nextEligible)
+		}
+
+		if nextEligible.Sub(blackoutEnd) >= 5*time.Minute {
+			t.Errorf("expected next eligible time to be the first fire after %v, got %v", blackoutEnd, nextEligible)
+		}
+	})
+
+	t.Run("run now emits inside blackout without rescheduling", func(t *testing.T) {
+		before := metadataCtx.Get().(Metadata)
+		events := &contexts.EventContext{}
+		requests := &contexts.RequestContext{}
+		_, err := schedule.HandleAction(newCtx(ActionRunNow, events, requests))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(events.Payloads) != 1 {
+			t.Fatalf("expected 1 event, got %d", len(events.Payloads))
+		}
+
+		if events.Payloads[0].Type != "scheduler.tick" {
+			t.Errorf("expected scheduler.tick event, got %s", events.Payloads[0].Type)
+		}
+
+		if requests.Action != "" {
+			t.Errorf("expected no action to be scheduled, got %s", requests.Action)
+		}
+
+		after := metadataCtx.Get().(Metadata)
+		if *after.NextTrigger != *before.NextTrigger {
+			t.Errorf("expected next trigger to stay %s, got %s", *before.NextTrigger, *after.NextTrigger)
+		}
+	})
+
+	t.Run("fire outside blackout emits and clears skipped run", func(t *testing.T) {
+		config.Blackouts = nil
+		events := &contexts.EventContext{}
+		requests := &contexts.RequestContext{}
+		_, err := schedule.HandleAction(newCtx(ActionEmitEvent, events, requests))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(events.Payloads) != 1 {
+			t.Fatalf("expected 1 event, got %d", len(events.Payloads))
+		}
+
+		metadata := metadataCtx.Get().(Metadata)
+		if metadata.Skipped != nil {
+			t.Errorf("expected skipped run to be cleared, got %+v", metadata.Skipped)
+		}
+	})
+}
@@ -33,28 +33,38 @@ const (
 	WeekDayFriday    = "friday"
 	WeekDaySaturday  = "saturday"
 	WeekDaySunday    = "sunday"
+
+	ActionEmitEvent = "emitEvent"
+	ActionPause     = "pause"
+	ActionResume    = "resume"
+	ActionRunNow    = "runNow"
 )
 
 type Schedule struct{}
 
 type Metadata struct {
-	NextTrigger   *string `json:"nextTrigger"`
-	ReferenceTime *string `json:"referenceTime"` // For minutes scheduling: time when schedule was first set up
+	NextTrigger   *string     `json:"nextTrigger"`
+	ReferenceTime *string     `json:"referenceTime"` // For minutes scheduling: time when schedule was first set up
+	Paused        bool        `json:"paused"`
+	Skipped       *SkippedRun `json:"skipped,omitempty"` // Last fire skipped because of a blackout
 }
 
 type Configuration struct {
-	Type            string   `json:"type"`
-	MinutesInterval *int     `json:"minutesInterval"` // 1-59 minutes between triggers
-	HoursInterval   *int     `json:"hoursInterval"`   // 1-23 hours between triggers
-	DaysInterval    *int     `json:"daysInterval"`    // 1-31 days between triggers
-	WeeksInterval   *int     `json:"weeksInterval"`   // 1-52 weeks between triggers
-	MonthsInterval  *int     `json:"monthsInterval"`  // 1-24 months between triggers
-	Minute          *int     `json:"minute"`          // 0-59 for hours, days, weeks, months
-	Hour            *int     `json:"hour"`            // 0-23 for days, weeks, months
-	WeekDays        []string `json:"weekDays"`        // For weeks scheduling (multiple days)
-	DayOfMonth      *int     `json:"dayOfMonth"`      // 1-31 for months scheduling
-	CronExpression  *string  `json:"cronExpression"`  // For cron scheduling
-	Timezone        *string  `json:"timezone"`        // Timezone offset (e.g., "0", "-5", "5.5")
+	Type             string      `json:"type"`
+	MinutesInterval  *int        `json:"minutesInterval"`  // 1-59 minutes between triggers
+	HoursInterval    *int        `json:"hoursInterval"`    // 1-23 hours between triggers
+	DaysInterval     *int        `json:"daysInterval"`     // 1-31 days between triggers
+	WeeksInterval    *int        `json:"weeksInterval"`    // 1-52 weeks between triggers
+	MonthsInterval   *int        `json:"monthsInterval"`   // 1-24 months between triggers
+	Minute           *int        `json:"minute"`           // 0-59 for hours, days, weeks, months
+	Hour             *int        `json:"hour"`             // 0-23 for days, weeks, months
+	WeekDays         []string    `json:"weekDays"`         // For weeks scheduling (multiple days)
+	DayOfMonth       *int        `json:"dayOfMonth"`       // 1-31 for months scheduling
+	CronExpression   *string     `json:"cronExpression"`   // For cron scheduling
+	Timezone         *string     `json:"timezone"`         // Timezone offset (e.g., "0", "-5", "5.5")
+	Blackouts        []Blackout  `json:"blackouts"`        // Date ranges in which no events are emitted
+	QuietHours       *QuietHours `json:"quietHours"`       // Weekly window in which no events are emitted
+	BlackoutTimezone *string     `json:"blackoutTimezone"` // Timezone offset for blackouts and quiet hours
 }
 
 func (s *Schedule) Name() string {
@@ -98,6 +108,24 @@ Supports both 5-field and 6-field cron expressions:
 - **5-field**: ` + "`minute hour day month dayofweek`" + ` (e.g., ` + "`30 14 * * MON-FRI`" + `)
 - **6-field**: ` + "`second minute hour day month dayofweek`" + ` (e.g., ` + "`0 30 14 * * MON-FRI`" + `)
 
+## Pausing
+
+The schedule can be paused and resumed from the node actions, without changing or deleting the node.
+While paused, no events are emitted. Fires missed while paused are not backfilled:
+on resume, the schedule continues from its next scheduled time.
+
+## Blackouts
+
+Events can be suppressed during specific periods, without pausing the schedule:
+- **Blackout dates**: date ranges, e.g. a holiday freeze, from a start to an end date and time
+- **Quiet hours**: a weekly window, e.g. 22:00 to 06:00 on weekdays. If the end is before the start, the window ends on the next day
+
+Blackout dates and quiet hours use the blackout timezone, and include their start, but not their end.
+A fire inside a blackout emits nothing, and is recorded in the node metadata with the reason and the next eligible time.
+The schedule then continues as usual.
+
+The **Run now** action emits an event immediately, ignoring blackouts, and does not change the next scheduled time.
+
 ## Event Data
 
 Each scheduled execution includes calendar information:
@@ -349,6 +377,88 @@ func (s *Schedule) Configuration() []configuration.Field {
 				Cron: &configuration.CronTypeOptions{},
 			},
 		},
+		{
+			Name:        "blackouts",
+			Label:       "Blackout Dates",
+			Type:        configuration.FieldTypeList,
+			Description: "Date ranges in which no events are emitted",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Blackout",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "start",
+								Label:    "Start",
+								Type:     configuration.FieldTypeDateTime,
+								Required: true,
+							},
+							{
+								Name:     "end",
+								Label:    "End",
+								Type:     configuration.FieldTypeDateTime,
+								Required: true,
+								ValidationRules: []configuration.ValidationRule{
+									{
+										Type:        configuration.ValidationRuleGreaterThan,
+										CompareWith: "start",
+										Message:     "end must be after start",
+									},
+								},
+							},
+							{
+								Name:        "reason",
+								Label:       "Reason",
+								Type:        configuration.FieldTypeString,
+								Placeholder: "Holiday freeze",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "quietHours",
+			Label:       "Quiet Hours",
+			Type:        configuration.FieldTypeObject,
+			Togglable:   true,
+			Description: "Weekly window in which no events are emitted. If the end is before the start, the window ends on the next day",
+			TypeOptions: &configuration.TypeOptions{
+				Object: &configuration.ObjectTypeOptions{
+					Schema: []configuration.Field{
+						{
+							Name:        "days",
+							Label:       "Days",
+							Type:        configuration.FieldTypeDaysOfWeek,
+							Description: "Days on which the window starts (default: every day)",
+						},
+						{
+							Name:     "start",
+							Label:    "Start",
+							Type:     configuration.FieldTypeTime,
+							Required: true,
+						},
+						{
+							Name:     "end",
+							Label:    "End",
+							Type:     configuration.FieldTypeTime,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "blackoutTimezone",
+			Label:       "Blackout Timezone",
+			Type:        configuration.FieldTypeTimezone,
+			Default:     "current",
+			Description: "Timezone offset for blackout dates and quiet hours (default: your current timezone)",
+			TypeOptions: &configuration.TypeOptions{
+				Timezone: &configuration.TimezoneTypeOptions{},
+			},
+		},
 	}
 }
 
@@ -359,12 +469,24 @@ func (s *Schedule) Setup(ctx core.TriggerContext) error {
 		return fmt.Errorf("failed to decode configuration: %w", err)
 	}
 
+	err = validateBlackouts(config)
+	if err != nil {
+		return err
+	}
+
 	var metadata Metadata
 	err = mapstructure.Decode(ctx.Metadata.Get(), &metadata)
 	if err != nil {
 		return fmt.Errorf("failed to parse metadata: %w", err)
 	}
 
+	//
+	// Paused schedules are only scheduled again when resumed.
+	//
+	if metadata.Paused {
+		return nil
+	}
+
 	now := time.Now()
 
 	if config.Type == TypeMinutes && metadata.ReferenceTime == nil {
@@ -394,7 +516,7 @@ func (s *Schedule) Setup(ctx core.TriggerContext) error {
 	//
 	// Always schedule the next and save the next trigger in the metadata.
 	//
-	err = ctx.Requests.ScheduleActionCall("emitEvent", map[string]any{}, time.Until(*nextTrigger))
+	err = ctx.Requests.ScheduleActionCall(ActionEmitEvent, map[string]any{}, time.Until(*nextTrigger))
 	if err != nil {
 		return err
 	}
@@ -409,58 +531,104 @@ func (s *Schedule) Setup(ctx core.TriggerContext) error {
 func (s *Schedule) Actions() []core.Action {
 	return []core.Action{
 		{
-			Name:           "emitEvent",
+			Name:           ActionEmitEvent,
 			UserAccessible: false,
 		},
+		{
+			Name:           ActionPause,
+			Description:    "Stop emitting events until the schedule is resumed",
+			UserAccessible: true,
+		},
+		{
+			Name:           ActionResume,
+			Description:    "Resume emitting events from the next scheduled time",
+			UserAccessible: true,
+		},
+		{
+			Name:           ActionRunNow,
+			Description:    "Emit an event now, ignoring blackouts",
+			UserAccessible: true,
+		},
 	}
 }
 
 func (s *Schedule) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
 	switch ctx.Name {
-	case "emitEvent":
+	case ActionEmitEvent:
 		return nil, s.emitEvent(ctx)
+	case ActionPause:
+		return nil, s.pause(ctx)
+	case ActionResume:
+		return nil, s.resume(ctx)
+	case ActionRunNow:
+		return nil, s.runNow(ctx)
 	}
 
 	return nil, fmt.Errorf("action %s not supported", ctx.Name)
 }
 
-func (s *Schedule) emitEvent(ctx core.TriggerActionContext) error {
+/*
+ * The emitEvent call that is already scheduled is not removed,
+ * but it will not emit anything, or schedule the next one, while paused.
+ */
+func (s *Schedule) pause(ctx core.TriggerActionContext) error {
+	var metadata Metadata
+	err := mapstructure.Decode(ctx.Metadata.Get(), &metadata)
+	if err != nil {
+		return fmt.Errorf("failed to parse metadata: %w", err)
+	}
+
+	if metadata.Paused {
+		return nil
+	}
+
+	return ctx.Metadata.Set(Metadata{
+		ReferenceTime: metadata.ReferenceTime,
+		Paused:        true,
+	})
+}
+
+func (s *Schedule) resume(ctx core.TriggerActionContext) error {
 	spec := Configuration{}
 	err := mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
 		return err
 	}
 
-	var timezone *time.Location
-	var now time.Time
+	var metadata Metadata
+	err = mapstructure.Decode(ctx.Metadata.Get(), &metadata)
+	if err != nil {
+		return fmt.Errorf("failed to parse metadata: %w", err)
+	}
 
-	// Only use timezone for schedule types that support it
-	if spec.Type == TypeDays || spec.Type == TypeWeeks || spec.Type == TypeMonths || spec.Type == TypeCron {
-		timezone = parseTimezone(spec.Timezone)
-		now = time.Now().In(timezone)
-	} else {
-		now = time.Now()
+	if !metadata.Paused {
+		return nil
 	}
 
-	payload := map[string]any{
-		"calendar": map[string]any{
-			"year":     now.Format("2006"),
-			"month":    now.Format("January"),
-			"day":      now.Format("2"),
-			"hour":     now.Format("15"),
-			"minute":   now.Format("04"),
-			"second":   now.Format("05"),
-			"week_day": now.Format("Monday"),
-		},
+	//
+	// Scheduling the next call replaces the emitEvent call
+	// scheduled before pausing, if it did not run yet.
+	//
+	nextTrigger, err := getNextTrigger(spec, time.Now(), metadata.ReferenceTime)
+	if err != nil {
+		return err
 	}
 
-	// Only include timezone for schedule types that support it
-	if timezone != nil {
-		payload["timezone"] = formatTimezone(timezone)
+	err = ctx.Requests.ScheduleActionCall(ActionEmitEvent, map[string]any{}, time.Until(*nextTrigger))
+	if err != nil {
+		return err
 	}
 
-	err = ctx.Events.Emit("scheduler.tick", payload)
+	formatted := nextTrigger.Format(time.RFC3339)
+	return ctx.Metadata.Set(Metadata{
+		NextTrigger:   &formatted,
+		ReferenceTime: metadata.ReferenceTime,
+	})
+}
 
+func (s *Schedule) emitEvent(ctx core.TriggerActionContext) error {
+	spec := Configuration{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
 	if err != nil {
 		return err
 	}
@@ -471,13 +639,32 @@ func (s *Schedule) emitEvent(ctx core.TriggerActionContext) error {
 		return fmt.Errorf("failed to parse existing metadata: %w", err)
 	}
 
+	if existingMetadata.Paused {
+		ctx.Logger.Infof("Schedule is paused - skipping event")
+		return nil
+	}
+
 	nowUTC := time.Now()
+	reason, inBlackout, err := blackoutReason(spec, nowUTC)
+	if err != nil {
+		return err
+	}
+
+	if inBlackout {
+		return s.skip(ctx, spec, existingMetadata, nowUTC, reason)
+	}
+
+	err = s.emitTick(ctx, spec)
+	if err != nil {
+		return err
+	}
+
 	nextTrigger, err := getNextTrigger(spec, nowUTC, existingMetadata.ReferenceTime)
 	if err != nil {
 		return err
 	}
 
-	err = ctx.Requests.ScheduleActionCall("emitEvent", map[string]any{}, time.Until(*nextTrigger))
+	err = ctx.Requests.ScheduleActionCall(ActionEmitEvent, map[string]any{}, time.Until(*nextTrigger))
 	if err != nil {
 		return err
 	}
@@ -491,6 +678,92 @@ func (s *Schedule) emitEvent(ctx core.TriggerActionContext) error {
 	})
 }
 
+/*
+ * A fire inside a blackout schedules the next one as usual,
+ * and records why it was skipped, and when the schedule emits again.
+ */
+func (s *Schedule) skip(ctx core.TriggerActionContext, spec Configuration, metadata Metadata, now time.Time, reason string) error {
+	ctx.Logger.Infof("Schedule is in a blackout - skipping event: %s", reason)
+
+	nextTrigger, err := getNextTrigger(spec, now, metadata.ReferenceTime)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.Requests.ScheduleActionCall(ActionEmitEvent, map[string]any{}, time.Until(*nextTrigger))
+	if err != nil {
+		return err
+	}
+
+	nextEligible, err := nextEligibleTrigger(spec, now, metadata.ReferenceTime)
+	if err != nil {
+		return err
+	}
+
+	skipped := &SkippedRun{
+		At:     now.Format(time.RFC3339),
+		Reason: reason,
+	}
+
+	if nextEligible != nil {
+		formatted := nextEligible.Format(time.RFC3339)
+		skipped.NextEligible = &formatted
+	}
+
+	formatted := nextTrigger.Format(time.RFC3339)
+	return ctx.Metadata.Set(Metadata{
+		NextTrigger:   &formatted,
+		ReferenceTime: metadata.ReferenceTime,
+		Skipped:       skipped,
+	})
+}
+
+/*
+ * Manual override: emits right away, even inside a blackout.
+ * The next scheduled fire is not changed.
+ */
+func (s *Schedule) runNow(ctx core.TriggerActionContext) error {
+	spec := Configuration{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return err
+	}
+
+	return s.emitTick(ctx, spec)
+}
+
+func (s *Schedule) emitTick(ctx core.TriggerActionContext, spec Configuration) error {
+	var timezone *time.Location
+	var now time.Time
+
+	// Only use timezone for schedule types that support it
+	if spec.Type == TypeDays || spec.Type == TypeWeeks || spec.Type == TypeMonths || spec.Type == TypeCron {
+		timezone = parseTimezone(spec.Timezone)
+		now = time.Now().In(timezone)
+	} else {
+		now = time.Now()
+	}
+
+	payload := map[string]any{
+		"calendar": map[string]any{
+			"year":     now.Format("2006"),
+			"month":    now.Format("January"),
+			"day":      now.Format("2"),
+			"hour":     now.Format("15"),
+			"minute":   now.Format("04"),
+			"second":   now.Format("05"),
+			"week_day": now.Format("Monday"),
+		},
+	}
+
+	// Only include timezone for schedule types that support it
+	if timezone != nil {
+		payload["timezone"] = formatTimezone(timezone)
+	}
+
+	return ctx.Events.Emit("scheduler.tick", payload)
+}
+
 func getNextTrigger(config Configuration, now time.Time, referenceTime *string) (*time.Time, error) {
 	timezone := parseTimezone(config.Timezone)
 	nowInTZ := now.In(timezone)
@@ -553,3 +553,129 @@ func TestEmitEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestPauseAndResume(t *testing.T) {
+	schedule := &Schedule{}
+	config := Configuration{
+		Type:            TypeMinutes,
+		MinutesInterval: intPtr(5),
+	}
+
+	referenceTime := time.Now().Add(-time.Hour).Format(time.RFC3339)
+	nextTrigger := time.Now().Format(time.RFC3339)
+	metadataCtx := &contexts.MetadataContext{
+		Metadata: Metadata{NextTrigger: &nextTrigger, ReferenceTime: &referenceTime},
+	}
+
+	newCtx := func(action string, events *contexts.EventContext, requests *contexts.RequestContext) core.TriggerActionContext {
+		return core.TriggerActionContext{
+			Name:          action,
+			Configuration: config,
+			Logger:        log.NewEntry(log.StandardLogger()),
+			Events:        events,
+			Metadata:      metadataCtx,
+			Requests:      requests,
+		}
+	}
+
+	t.Run("pause sets the paused flag", func(t *testing.T) {
+		_, err := schedule.HandleAction(newCtx(ActionPause, &contexts.EventContext{}, &contexts.RequestContext{}))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		metadata := metadataCtx.Get().(Metadata)
+		if !metadata.Paused {
+			t.Errorf("expected schedule to be paused")
+		}
+
+		if metadata.NextTrigger != nil {
+			t.Errorf("expected no next trigger while paused, got %s", *metadata.NextTrigger)
+		}
+	})
+
+	t.Run("paused schedule does not emit at its fire time", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		requests := &contexts.RequestContext{}
+		_, err := schedule.HandleAction(newCtx(ActionEmitEvent, events, requests))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(events.Payloads) != 0 {
+			t.Errorf("expected no events while paused, got %d", len(events.Payloads))
+		}
+
+		if requests.Action != "" {
+			t.Errorf("expected no action to be scheduled while paused, got %s", requests.Action)
+		}
+	})
+
+	t.Run("paused schedule is not scheduled on setup", func(t *testing.T) {
+		requests := &contexts.RequestContext{}
+		err := schedule.Setup(core.TriggerContext{
+			Configuration: config,
+			Metadata:      metadataCtx,
+			Requests:      requests,
+		})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if requests.Action != "" {
+			t.Errorf("expected no action to be scheduled while paused, got %s", requests.Action)
+		}
+	})
+
+	t.Run("resume schedules the next fire without backfilling", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		requests := &contexts.RequestContext{}
+		_, err := schedule.HandleAction(newCtx(ActionResume, events, requests))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(events.Payloads) != 0 {
+			t.Errorf("expected no events to be emitted on resume, got %d", len(events.Payloads))
+		}
+
+		if requests.Action != ActionEmitEvent {
+			t.Fatalf("expected %s to be scheduled, got %q", ActionEmitEvent, requests.Action)
+		}
+
+		if requests.Duration <= 0 || requests.Duration > 5*time.Minute {
+			t.Errorf("expected next fire within the 5 minute interval, got %v", requests.Duration)
+		}
+
+		metadata := metadataCtx.Get().(Metadata)
+		if metadata.Paused {
+			t.Errorf("expected schedule to be resumed")
+		}
+
+		if metadata.NextTrigger == nil {
+			t.Fatalf("expected next trigger to be set")
+		}
+
+		if metadata.ReferenceTime == nil || *metadata.ReferenceTime != referenceTime {
+			t.Errorf("expected reference time to be kept")
+		}
+	})
+
+	t.Run("resumed schedule emits at its fire time", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		requests := &contexts.RequestContext{}
+		_, err := schedule.HandleAction(newCtx(ActionEmitEvent, events, requests))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(events.Payloads) != 1 {
+			t.Errorf("expected 1 event, got %d", len(events.Payloads))
+		}
+
+		if requests.Action != ActionEmitEvent {
+			t.Errorf("expected next %s to be scheduled, got %q", ActionEmitEvent, requests.Action)
+		}
+	})
+}
@@ -1,19 +1,42 @@
 package manual
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 
+	"github.com/mitchellh/mapstructure"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/registry"
 )
 
+const (
+	ActionEmitEvent = "emitEvent"
+	EventType       = "start.run"
+
+	ParameterTypeString  = "string"
+	ParameterTypeNumber  = "number"
+	ParameterTypeBoolean = "boolean"
+)
+
 func init() {
 	registry.RegisterTrigger("start", &Start{})
 }
 
 type Start struct{}
 
+type Configuration struct {
+	Parameters []Parameter `json:"parameters" mapstructure:"parameters"`
+}
+
+type Parameter struct {
+	Name     string `json:"name" mapstructure:"name"`
+	Type     string `json:"type" mapstructure:"type"`
+	Required bool   `json:"required" mapstructure:"required"`
+	Default  string `json:"default" mapstructure:"default"`
+}
+
 func (s *Start) Name() string {
 	return "start"
 }
@@ -40,15 +63,17 @@ func (s *Start) Documentation() string {
 
 1. Add the Manual Run trigger as the starting node of your workflow
 2. Click the "Run" button in the workflow UI to start an execution
-3. The workflow begins immediately with empty event data
+3. The workflow begins immediately with the payload of the run
 
 ## Configuration
 
-The Manual Run trigger requires no configuration. It's ready to use immediately after being added to a workflow.
+- **Templates**: Payloads you can pick from when starting a run from the UI
+- **Parameters**: The payload schema of runs started with the **emitEvent** action. Each parameter has a name, a type (string, number or boolean), and can be required or have a default value
 
 ## Event Data
 
-Manual runs start with an empty event payload. You can use this as a starting point and add data through subsequent components.`
+Runs started with the **emitEvent** action emit the given parameters as the event data, after validating them against the configured parameters.
+Parameters that are not given use their default values, and parameters that are not configured are not included.`
 }
 
 func (s *Start) Icon() string {
@@ -94,6 +119,55 @@ func (s *Start) Configuration() []configuration.Field {
 				},
 			},
 		},
+		{
+			Name:        "parameters",
+			Label:       "Parameters",
+			Type:        configuration.FieldTypeList,
+			Description: "Payload schema of runs started with the emitEvent action",
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Parameter",
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:     "name",
+								Label:    "Name",
+								Type:     configuration.FieldTypeString,
+								Required: true,
+							},
+							{
+								Name:     "type",
+								Label:    "Type",
+								Type:     configuration.FieldTypeSelect,
+								Required: true,
+								Default:  ParameterTypeString,
+								TypeOptions: &configuration.TypeOptions{
+									Select: &configuration.SelectTypeOptions{
+										Options: []configuration.FieldOption{
+											{Label: "String", Value: ParameterTypeString},
+											{Label: "Number", Value: ParameterTypeNumber},
+											{Label: "Boolean", Value: ParameterTypeBoolean},
+										},
+									},
+								},
+							},
+							{
+								Name:  "required",
+								Label: "Required",
+								Type:  configuration.FieldTypeBool,
+							},
+							{
+								Name:        "default",
+								Label:       "Default",
+								Type:        configuration.FieldTypeString,
+								Description: "Value used when the parameter is not given",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -102,15 +176,137 @@ func (s *Start) HandleWebhook(ctx core.WebhookRequestContext) (int, error) {
 }
 
 func (s *Start) Setup(ctx core.TriggerContext) error {
-	return nil
+	_, err := s.payloadSchema(ctx.Configuration)
+	return err
 }
 
 func (s *Start) Actions() []core.Action {
-	return []core.Action{}
+	return []core.Action{
+		{
+			Name:           ActionEmitEvent,
+			Description:    "Start a run with the given parameters as its payload",
+			UserAccessible: true,
+		},
+	}
+}
+
+func (s *Start) ActionParameters(action string, configuration any) ([]configuration.Field, error) {
+	if action != ActionEmitEvent {
+		return nil, fmt.Errorf("action %s not supported", action)
+	}
+
+	return s.payloadSchema(configuration)
 }
 
 func (s *Start) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
-	return nil, nil
+	switch ctx.Name {
+	case ActionEmitEvent:
+		return nil, s.emitEvent(ctx)
+	}
+
+	return nil, fmt.Errorf("action %s not supported", ctx.Name)
+}
+
+/*
+ * Parameters are validated against the payload schema before the action is called,
+ * so here we only need to fill in the defaults of the ones not given.
+ */
+func (s *Start) emitEvent(ctx core.TriggerActionContext) error {
+	schema, err := s.payloadSchema(ctx.Configuration)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]any{}
+	for _, field := range schema {
+		value, ok := ctx.Parameters[field.Name]
+		if ok && value != nil {
+			payload[field.Name] = value
+			continue
+		}
+
+		if field.Default != nil {
+			payload[field.Name] = field.Default
+		}
+	}
+
+	return ctx.Events.Emit(EventType, payload)
+}
+
+/*
+ * Parameters with a default value are never required,
+ * since the default is used when they are not given.
+ */
+func (s *Start) payloadSchema(config any) ([]configuration.Field, error) {
+	spec := Configuration{}
+	if err := mapstructure.Decode(config, &spec); err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	fields := []configuration.Field{}
+	names := map[string]bool{}
+	for _, parameter := range spec.Parameters {
+		if parameter.Name == "" {
+			return nil, fmt.Errorf("parameter name is required")
+		}
+
+		if names[parameter.Name] {
+			return nil, fmt.Errorf("parameter %s is defined more than once", parameter.Name)
+		}
+
+		names[parameter.Name] = true
+		field, err := parameterField(parameter)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", parameter.Name, err)
+		}
+
+		fields = append(fields, *field)
+	}
+
+	return fields, nil
+}
+
+func parameterField(parameter Parameter) (*configuration.Field, error) {
+	field := &configuration.Field{
+		Name:     parameter.Name,
+		Label:    parameter.Name,
+		Required: parameter.Required && parameter.Default == "",
+	}
+
+	switch parameter.Type {
+	case "", ParameterTypeString:
+		field.Type = configuration.FieldTypeString
+		if parameter.Default != "" {
+			field.Default = parameter.Default
+		}
+
+	case ParameterTypeNumber:
+		field.Type = configuration.FieldTypeNumber
+		if parameter.Default != "" {
+			value, err := strconv.ParseFloat(parameter.Default, 64)
+			if err != nil {
+				return nil, fmt.Errorf("default %q is not a number", parameter.Default)
+			}
+
+			field.Default = value
+		}
+
+	case ParameterTypeBoolean:
+		field.Type = configuration.FieldTypeBool
+		if parameter.Default != "" {
+			value, err := strconv.ParseBool(parameter.Default)
+			if err != nil {
+				return nil, fmt.Errorf("default %q is not a boolean", parameter.Default)
+			}
+
+			field.Default = value
+		}
+
+	default:
+		return nil, fmt.Errorf("type %s is not supported", parameter.Type)
+	}
+
+	return field, nil
 }
 
 func (s *Start) Cleanup(ctx core.TriggerContext) error {
@@ -0,0 +1,108 @@
+package manual
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__Start__ActionParameters(t *testing.T) {
+	trigger := &Start{}
+
+	t.Run("no parameters configured -> empty schema", func(t *testing.T) {
+		fields, err := trigger.ActionParameters(ActionEmitEvent, map[string]any{})
+		require.NoError(t, err)
+		assert.Empty(t, fields)
+	})
+
+	t.Run("parameters are converted to fields", func(t *testing.T) {
+		fields, err := trigger.ActionParameters(ActionEmitEvent, map[string]any{
+			"parameters": []any{
+				map[string]any{"name": "environment", "type": "string", "required": true},
+				map[string]any{"name": "replicas", "type": "number", "required": true, "default": "3"},
+				map[string]any{"name": "dryRun", "type": "boolean", "default": "false"},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, fields, 3)
+		assert.Equal(t, configuration.Field{Name: "environment", Label: "environment", Type: configuration.FieldTypeString, Required: true}, fields[0])
+		assert.Equal(t, configuration.Field{Name: "replicas", Label: "replicas", Type: configuration.FieldTypeNumber, Default: 3.0}, fields[1])
+		assert.Equal(t, configuration.Field{Name: "dryRun", Label: "dryRun", Type: configuration.FieldTypeBool, Default: false}, fields[2])
+	})
+
+	t.Run("default not matching the type -> error", func(t *testing.T) {
+		_, err := trigger.ActionParameters(ActionEmitEvent, map[string]any{
+			"parameters": []any{
+				map[string]any{"name": "replicas", "type": "number", "default": "many"},
+			},
+		})
+
+		require.ErrorContains(t, err, `parameter replicas: default "many" is not a number`)
+	})
+
+	t.Run("duplicate parameter -> error", func(t *testing.T) {
+		_, err := trigger.ActionParameters(ActionEmitEvent, map[string]any{
+			"parameters": []any{
+				map[string]any{"name": "environment", "type": "string"},
+				map[string]any{"name": "environment", "type": "number"},
+			},
+		})
+
+		require.ErrorContains(t, err, "parameter environment is defined more than once")
+	})
+
+	t.Run("unknown action -> error", func(t *testing.T) {
+		_, err := trigger.ActionParameters("unknown", map[string]any{})
+		require.ErrorContains(t, err, "action unknown not supported")
+	})
+}
+
+func Test__Start__HandleAction(t *testing.T) {
+	trigger := &Start{}
+	config := map[string]any{
+		"parameters": []any{
+			map[string]any{"name": "environment", "type": "string", "required": true},
+			map[string]any{"name": "replicas", "type": "number", "default": "3"},
+			map[string]any{"name": "dryRun", "type": "boolean"},
+		},
+	}
+
+	t.Run("emits the parameters, with defaults, as the payload", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:          ActionEmitEvent,
+			Configuration: config,
+			Parameters:    map[string]any{"environment": "production", "unknown": "ignored"},
+			Events:        events,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, events.Payloads, 1)
+		assert.Equal(t, EventType, events.Payloads[0].Type)
+		assert.Equal(t, map[string]any{"environment": "production", "replicas": 3.0}, events.Payloads[0].Data)
+	})
+
+	t.Run("given values are used over defaults", func(t *testing.T) {
+		events := &contexts.EventContext{}
+		_, err := trigger.HandleAction(core.TriggerActionContext{
+			Name:          ActionEmitEvent,
+			Configuration: config,
+			Parameters:    map[string]any{"environment": "staging", "replicas": 1.0, "dryRun": true},
+			Events:        events,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, events.Payloads, 1)
+		assert.Equal(t, map[string]any{"environment": "staging", "replicas": 1.0, "dryRun": true}, events.Payloads[0].Data)
+	})
+
+	t.Run("unknown action -> error", func(t *testing.T) {
+		_, err := trigger.HandleAction(core.TriggerActionContext{Name: "unknown"})
+		require.ErrorContains(t, err, "action unknown not supported")
+	})
+}
@@ -109,6 +109,7 @@ func (w *Webhook) Configuration() []configuration.Field {
 				},
 			},
 		},
+		core.DeduplicationField(),
 	}
 }
 
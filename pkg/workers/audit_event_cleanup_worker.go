@@ -0,0 +1,70 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+/*
+ * Deletes audit events older than the retention period.
+ * Events are deleted in batches, to avoid long running queries.
+ */
+type AuditEventCleanupWorker struct {
+	logger        *log.Entry
+	retention     time.Duration
+	batchSize     int
+	maxBatches    int
+	checkInterval time.Duration
+}
+
+func NewAuditEventCleanupWorker(retentionDays int) *AuditEventCleanupWorker {
+	return &AuditEventCleanupWorker{
+		logger:        log.WithFields(log.Fields{"worker": "AuditEventCleanupWorker"}),
+		retention:     time.Duration(retentionDays) * 24 * time.Hour,
+		batchSize:     1000,
+		maxBatches:    50,
+		checkInterval: time.Hour,
+	}
+}
+
+func (w *AuditEventCleanupWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Tick(); err != nil {
+				w.logger.Errorf("Error deleting old audit events: %v", err)
+			}
+		}
+	}
+}
+
+func (w *AuditEventCleanupWorker) Tick() error {
+	before := time.Now().Add(-w.retention)
+	total := int64(0)
+
+	for range w.maxBatches {
+		deleted, err := models.DeleteAuditEventsBefore(before, w.batchSize)
+		if err != nil {
+			return err
+		}
+
+		total += deleted
+		if deleted < int64(w.batchSize) {
+			break
+		}
+	}
+
+	if total > 0 {
+		w.logger.Infof("Deleted %d audit events older than %s", total, before.Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -153,6 +153,7 @@ func (w *CanvasCleanupWorker) deleteNodeResourcesBatched(tx *gorm.DB, workflowID
 	}{
 		{&models.CanvasNodeRequest{}, "canvas_node_requests"},
 		{&models.CanvasNodeExecutionKV{}, "canvas_node_execution_kvs"},
+		{&models.CanvasNodeExecutionLog{}, "canvas_node_execution_logs"},
 		{&models.CanvasNodeExecution{}, "canvas_node_executions"},
 		{&models.CanvasNodeQueueItem{}, "canvas_node_queue_items"},
 		{&models.CanvasEvent{}, "canvas_events"},
@@ -0,0 +1,39 @@
+package contexts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/approvals"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/jwt"
+)
+
+type ApprovalLinkContext struct {
+	signer      *jwt.Signer
+	baseURL     string
+	executionID uuid.UUID
+}
+
+func NewApprovalLinkContext(signer *jwt.Signer, baseURL string, executionID uuid.UUID) *ApprovalLinkContext {
+	return &ApprovalLinkContext{
+		signer:      signer,
+		baseURL:     baseURL,
+		executionID: executionID,
+	}
+}
+
+func (c *ApprovalLinkContext) Create(user core.User, decision string, index int, expiresIn time.Duration) (string, error) {
+	userID, err := uuid.Parse(user.ID)
+	if err != nil {
+		return "", fmt.Errorf("invalid user ID %s: %w", user.ID, err)
+	}
+
+	token, err := approvals.NewLinkToken(c.signer, c.executionID, userID, decision, index, expiresIn)
+	if err != nil {
+		return "", err
+	}
+
+	return approvals.LinkURL(c.baseURL, token), nil
+}
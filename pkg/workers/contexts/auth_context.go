@@ -87,3 +87,30 @@ func (c *AuthContext) InGroup(group string) (bool, error) {
 
 	return false, nil
 }
+
+func (c *AuthContext) GetGroupUsers(group string) ([]core.User, error) {
+	if c.authService == nil {
+		return nil, fmt.Errorf("authorization service not available")
+	}
+
+	userIDs, err := c.authService.GetGroupUsers(c.orgID.String(), models.DomainTypeOrganization, group)
+	if err != nil {
+		return nil, fmt.Errorf("error finding users in group %s: %v", group, err)
+	}
+
+	users := []core.User{}
+	for _, userID := range userIDs {
+		user, err := models.FindActiveUserByIDInTransaction(c.tx, c.orgID.String(), userID)
+		if err != nil {
+			continue
+		}
+
+		users = append(users, core.User{
+			ID:    user.ID.String(),
+			Name:  user.Name,
+			Email: user.Email,
+		})
+	}
+
+	return users, nil
+}
@@ -5,3 +5,16 @@ package contexts
  * event payloads from components and trigger implementations.
  */
 const DefaultMaxPayloadSize = 32 * 1024
+
+/*
+ * DefaultMaxMetadataSize is used to keep node and execution metadata small,
+ * since it is decoded every time the node or execution is processed.
+ * Larger data should be stored as execution attachments.
+ */
+const DefaultMaxMetadataSize = 32 * 1024
+
+/*
+ * DefaultMaxAttachmentSize is the largest blob
+ * a component can store as an execution attachment.
+ */
+const DefaultMaxAttachmentSize = 1024 * 1024
@@ -1,11 +1,16 @@
 package contexts
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/expr-lang/expr"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/models"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
@@ -57,7 +62,86 @@ func (s *EventContext) Emit(payloadType string, payload any) error {
 		event.CustomName = customName
 	}
 
-	return s.tx.Create(&event).Error
+	return s.tx.Transaction(func(tx *gorm.DB) error {
+		duplicate, err := s.isDuplicate(tx, payload)
+		if err != nil {
+			return err
+		}
+
+		if duplicate {
+			return nil
+		}
+
+		return tx.Create(&event).Error
+	})
+}
+
+/*
+ * If deduplication is enabled for the node, records the key of the event,
+ * and counts the event as deduplicated if the key was already recorded.
+ */
+func (s *EventContext) isDuplicate(tx *gorm.DB, payload any) (bool, error) {
+	key, window, ok := s.deduplicationKey(payload)
+	if !ok {
+		return false, nil
+	}
+
+	recorded, err := models.RecordEventDeduplicationKeyInTransaction(tx, s.node.WorkflowID, s.node.NodeID, key, window)
+	if err != nil {
+		return false, fmt.Errorf("failed to record deduplication key: %w", err)
+	}
+
+	if recorded {
+		return false, nil
+	}
+
+	return true, models.IncrementDeduplicatedEventsInTransaction(tx, s.node.WorkflowID, s.node.NodeID)
+}
+
+/*
+ * Events for which the key expression fails, or evaluates to an empty value,
+ * are never deduplicated, since dropping them could lose events.
+ */
+func (s *EventContext) deduplicationKey(payload any) (string, time.Duration, bool) {
+	config := s.node.Configuration.Data()
+	if config == nil {
+		return "", 0, false
+	}
+
+	rawConfig, ok := config[core.DeduplicationFieldName]
+	if !ok || rawConfig == nil {
+		return "", 0, false
+	}
+
+	deduplication := core.DeduplicationConfiguration{}
+	if err := mapstructure.Decode(rawConfig, &deduplication); err != nil {
+		return "", 0, false
+	}
+
+	expression := strings.TrimSpace(deduplication.Key)
+	if expression == "" || deduplication.WindowSeconds <= 0 {
+		return "", 0, false
+	}
+
+	env := map[string]any{"$": payload}
+	program, err := expr.Compile(expression, expr.Env(env))
+	if err != nil {
+		return "", 0, false
+	}
+
+	output, err := expr.Run(program, env)
+	if err != nil || output == nil {
+		return "", 0, false
+	}
+
+	value := fmt.Sprintf("%v", output)
+	if value == "" {
+		return "", 0, false
+	}
+
+	window := time.Duration(min(deduplication.WindowSeconds, core.MaxDeduplicationWindowSeconds)) * time.Second
+	hash := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(hash[:]), window, true
 }
 
 func (s *EventContext) resolveCustomName(payload any) (*string, error) {
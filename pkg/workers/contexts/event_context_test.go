@@ -3,9 +3,11 @@ package contexts
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/test/support"
@@ -45,3 +47,64 @@ func Test__EventContext__Emit(t *testing.T) {
 		support.VerifyCanvasEventsCount(t, canvas.ID, 0)
 	})
 }
+
+func Test__EventContext__EmitWithDeduplication(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNodeID := "trigger-1"
+	canvas, nodes := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNodeID,
+				Name:   triggerNodeID,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+				Configuration: datatypes.NewJSONType(map[string]any{
+					core.DeduplicationFieldName: map[string]any{
+						"key":           "$.id",
+						"windowSeconds": 60,
+					},
+				}),
+			},
+		},
+		nil,
+	)
+
+	ctx := NewEventContext(database.Conn(), &nodes[0])
+
+	t.Run("drops events with the same key within the window", func(t *testing.T) {
+		require.NoError(t, ctx.Emit("test.payload", map[string]any{"id": "delivery-1"}))
+		require.NoError(t, ctx.Emit("test.payload", map[string]any{"id": "delivery-1"}))
+		support.VerifyCanvasEventsCount(t, canvas.ID, 1)
+
+		node, err := models.FindCanvasNode(database.Conn(), canvas.ID, triggerNodeID)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, node.Metadata.Data()[models.DeduplicatedEventsMetadataField])
+	})
+
+	t.Run("emits events with a different key", func(t *testing.T) {
+		require.NoError(t, ctx.Emit("test.payload", map[string]any{"id": "delivery-2"}))
+		support.VerifyCanvasEventsCount(t, canvas.ID, 2)
+	})
+
+	t.Run("emits events without a key", func(t *testing.T) {
+		require.NoError(t, ctx.Emit("test.payload", map[string]any{}))
+		require.NoError(t, ctx.Emit("test.payload", map[string]any{}))
+		support.VerifyCanvasEventsCount(t, canvas.ID, 4)
+	})
+
+	t.Run("emits events with the same key after the window expires", func(t *testing.T) {
+		require.NoError(t, database.Conn().
+			Model(&models.CanvasEventDeduplicationKey{}).
+			Where("workflow_id = ? AND node_id = ?", canvas.ID, triggerNodeID).
+			Update("expires_at", time.Now().Add(-time.Second)).
+			Error)
+
+		require.NoError(t, ctx.Emit("test.payload", map[string]any{"id": "delivery-1"}))
+		support.VerifyCanvasEventsCount(t, canvas.ID, 5)
+	})
+}
@@ -0,0 +1,49 @@
+package contexts
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
+)
+
+type ExecutionAttachmentContext struct {
+	tx                *gorm.DB
+	execution         *models.CanvasNodeExecution
+	maxAttachmentSize int
+}
+
+func NewExecutionAttachmentContext(tx *gorm.DB, execution *models.CanvasNodeExecution) *ExecutionAttachmentContext {
+	return &ExecutionAttachmentContext{tx: tx, execution: execution, maxAttachmentSize: DefaultMaxAttachmentSize}
+}
+
+func (c *ExecutionAttachmentContext) Put(name string, data []byte) error {
+	if name == "" {
+		return fmt.Errorf("attachment name is required")
+	}
+
+	if len(data) > c.maxAttachmentSize {
+		return fmt.Errorf("attachment %s too large: %d bytes (max %d)", name, len(data), c.maxAttachmentSize)
+	}
+
+	return models.UpsertNodeExecutionAttachmentInTransaction(c.tx, c.execution.ID, name, data)
+}
+
+func (c *ExecutionAttachmentContext) Get(name string) ([]byte, error) {
+	attachment, err := models.FindNodeExecutionAttachmentInTransaction(c.tx, c.execution.ID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, core.ErrAttachmentNotFound
+		}
+
+		return nil, err
+	}
+
+	return attachment.Data, nil
+}
+
+func (c *ExecutionAttachmentContext) Delete(name string) error {
+	return models.DeleteNodeExecutionAttachmentInTransaction(c.tx, c.execution.ID, name)
+}
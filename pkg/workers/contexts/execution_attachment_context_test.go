@@ -0,0 +1,153 @@
+package contexts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__MetadataContext__SizeLimit(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNodeID := "trigger-1"
+	componentNodeID := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNodeID,
+				Name:   triggerNodeID,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: componentNodeID,
+				Name:   componentNodeID,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNodeID, TargetID: componentNodeID, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNodeWithData(t, canvas.ID, triggerNodeID, "default", nil, map[string]any{})
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, componentNodeID, rootEvent.ID, rootEvent.ID, nil)
+
+	t.Run("execution metadata above the limit -> error", func(t *testing.T) {
+		ctx := NewExecutionMetadataContext(database.Conn(), execution)
+		err := ctx.Set(map[string]any{"data": strings.Repeat("a", DefaultMaxMetadataSize)})
+		require.ErrorContains(t, err, "execution metadata too large")
+
+		stored, err := models.FindNodeExecution(canvas.ID, execution.ID)
+		require.NoError(t, err)
+		assert.Empty(t, stored.Metadata.Data())
+	})
+
+	t.Run("execution metadata below the limit -> stored", func(t *testing.T) {
+		ctx := NewExecutionMetadataContext(database.Conn(), execution)
+		require.NoError(t, ctx.Set(map[string]any{"data": "small"}))
+
+		stored, err := models.FindNodeExecution(canvas.ID, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"data": "small"}, stored.Metadata.Data())
+	})
+
+	t.Run("node metadata above the limit -> error", func(t *testing.T) {
+		node, err := models.FindCanvasNode(database.Conn(), canvas.ID, componentNodeID)
+		require.NoError(t, err)
+
+		ctx := NewNodeMetadataContext(database.Conn(), node)
+		err = ctx.Set(map[string]any{"data": strings.Repeat("a", DefaultMaxMetadataSize)})
+		require.ErrorContains(t, err, "node metadata too large")
+	})
+}
+
+func Test__ExecutionAttachmentContext(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNodeID := "trigger-1"
+	componentNodeID := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNodeID,
+				Name:   triggerNodeID,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: componentNodeID,
+				Name:   componentNodeID,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNodeID, TargetID: componentNodeID, Channel: "default"},
+		},
+	)
+
+	newExecution := func() *models.CanvasNodeExecution {
+		rootEvent := support.EmitCanvasEventForNodeWithData(t, canvas.ID, triggerNodeID, "default", nil, map[string]any{})
+		return support.CreateCanvasNodeExecution(t, canvas.ID, componentNodeID, rootEvent.ID, rootEvent.ID, nil)
+	}
+
+	t.Run("missing attachment -> not found", func(t *testing.T) {
+		ctx := NewExecutionAttachmentContext(database.Conn(), newExecution())
+		_, err := ctx.Get("tasks")
+		require.ErrorIs(t, err, core.ErrAttachmentNotFound)
+	})
+
+	t.Run("attachments are stored, replaced and deleted", func(t *testing.T) {
+		ctx := NewExecutionAttachmentContext(database.Conn(), newExecution())
+		large := []byte(strings.Repeat("a", DefaultMaxMetadataSize*2))
+		require.NoError(t, ctx.Put("tasks", large))
+
+		data, err := ctx.Get("tasks")
+		require.NoError(t, err)
+		assert.Equal(t, large, data)
+
+		require.NoError(t, ctx.Put("tasks", []byte("replaced")))
+		data, err = ctx.Get("tasks")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("replaced"), data)
+
+		require.NoError(t, ctx.Delete("tasks"))
+		_, err = ctx.Get("tasks")
+		require.ErrorIs(t, err, core.ErrAttachmentNotFound)
+	})
+
+	t.Run("attachment above the limit -> error", func(t *testing.T) {
+		ctx := NewExecutionAttachmentContext(database.Conn(), newExecution())
+		err := ctx.Put("tasks", []byte(strings.Repeat("a", DefaultMaxAttachmentSize+1)))
+		require.ErrorContains(t, err, "attachment tasks too large")
+	})
+
+	t.Run("attachments are deleted when the execution finishes", func(t *testing.T) {
+		execution := newExecution()
+		ctx := NewExecutionAttachmentContext(database.Conn(), execution)
+		require.NoError(t, ctx.Put("tasks", []byte("data")))
+
+		state := NewExecutionStateContext(database.Conn(), execution)
+		require.NoError(t, state.Fail(models.CanvasNodeExecutionResultReasonError, "failed"))
+
+		_, err := ctx.Get("tasks")
+		require.ErrorIs(t, err, core.ErrAttachmentNotFound)
+	})
+}
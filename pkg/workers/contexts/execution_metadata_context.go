@@ -2,6 +2,7 @@ package contexts
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/superplanehq/superplane/pkg/models"
 	"gorm.io/datatypes"
@@ -9,12 +10,13 @@ import (
 )
 
 type ExecutionMetadataContext struct {
-	tx        *gorm.DB
-	execution *models.CanvasNodeExecution
+	tx              *gorm.DB
+	execution       *models.CanvasNodeExecution
+	maxMetadataSize int
 }
 
 func NewExecutionMetadataContext(tx *gorm.DB, execution *models.CanvasNodeExecution) *ExecutionMetadataContext {
-	return &ExecutionMetadataContext{tx: tx, execution: execution}
+	return &ExecutionMetadataContext{tx: tx, execution: execution, maxMetadataSize: DefaultMaxMetadataSize}
 }
 
 func (m *ExecutionMetadataContext) Get() any {
@@ -27,6 +29,10 @@ func (m *ExecutionMetadataContext) Set(value any) error {
 		return err
 	}
 
+	if len(b) > m.maxMetadataSize {
+		return fmt.Errorf("execution metadata too large: %d bytes (max %d), use attachments for large data", len(b), m.maxMetadataSize)
+	}
+
 	var v map[string]any
 	err = json.Unmarshal(b, &v)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/models"
 	"gorm.io/gorm"
 )
@@ -33,6 +34,48 @@ func (s *ExecutionStateContext) Pass() error {
 }
 
 func (s *ExecutionStateContext) Emit(channel, payloadType string, payloads []any) error {
+	outputs, err := s.buildOutputs(channel, payloadType, payloads)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.execution.PassInTransaction(s.tx, outputs)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *ExecutionStateContext) Release(channel, payloadType string, payloads []any) ([]uuid.UUID, error) {
+	outputs, err := s.buildOutputs(channel, payloadType, payloads)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.execution.CreateEventsInTransaction(s.tx, outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+
+	return ids, nil
+}
+
+func (s *ExecutionStateContext) HasUnfinishedDescendants(eventID uuid.UUID) (bool, error) {
+	count, err := models.CountUnfinishedDescendantsInTransaction(s.tx, s.execution.WorkflowID, eventID)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (s *ExecutionStateContext) buildOutputs(channel, payloadType string, payloads []any) (map[string][]any, error) {
 	outputs := map[string][]any{
 		channel: {},
 	}
@@ -46,22 +89,17 @@ func (s *ExecutionStateContext) Emit(channel, payloadType string, payloads []any
 
 		data, err := json.Marshal(event)
 		if err != nil {
-			return fmt.Errorf("failed to marshal payload: %w", err)
+			return nil, fmt.Errorf("failed to marshal payload: %w", err)
 		}
 
 		if len(data) > s.maxPayloadSize {
-			return fmt.Errorf("event payload too large: %d bytes (max %d)", len(data), s.maxPayloadSize)
+			return nil, fmt.Errorf("event payload too large: %d bytes (max %d)", len(data), s.maxPayloadSize)
 		}
 
 		outputs[channel] = append(outputs[channel], json.RawMessage(data))
 	}
 
-	_, err := s.execution.PassInTransaction(s.tx, outputs)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return outputs, nil
 }
 
 func (s *ExecutionStateContext) Fail(reason, message string) error {
@@ -72,3 +110,7 @@ func (s *ExecutionStateContext) Fail(reason, message string) error {
 func (s *ExecutionStateContext) SetKV(key, value string) error {
 	return models.CreateNodeExecutionKVInTransaction(s.tx, s.execution.WorkflowID, s.execution.NodeID, s.execution.ID, key, value)
 }
+
+func (s *ExecutionStateContext) SetKVs(pairs map[string]string) error {
+	return models.CreateNodeExecutionKVsInTransaction(s.tx, s.execution.WorkflowID, s.execution.NodeID, s.execution.ID, pairs)
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,6 +25,19 @@ type IntegrationContext struct {
 	integration *models.Integration
 	encryptor   crypto.Encryptor
 	registry    *registry.Registry
+
+	//
+	// Columns of the integration changed through this context,
+	// so only those are written when saving it.
+	//
+	changedColumns []string
+
+	//
+	// Decrypted secrets, loaded on the first GetSecrets() call,
+	// so they are only decrypted once per context.
+	// Cleared by SetSecret().
+	//
+	secrets []core.IntegrationSecret
 }
 
 func NewIntegrationContext(tx *gorm.DB, node *models.CanvasNode, integration *models.Integration, encryptor crypto.Encryptor, registry *registry.Registry) *IntegrationContext {
@@ -169,7 +183,13 @@ func (c *IntegrationContext) ScheduleResync(interval time.Duration) error {
 		return err
 	}
 
-	runAt := time.Now().Add(interval)
+	//
+	// While the integration keeps failing to sync,
+	// resyncs are not scheduled before the backoff ends.
+	//
+	now := time.Now()
+	interval = max(interval, c.integration.SyncRetryAfter(now))
+	runAt := now.Add(interval)
 	return c.integration.CreateSyncRequest(c.tx, &runAt)
 }
 
@@ -259,7 +279,13 @@ func (c *IntegrationContext) SetMetadata(value any) {
 		return
 	}
 
+	current, err := json.Marshal(c.integration.Metadata.Data())
+	if err == nil && string(current) == string(b) {
+		return
+	}
+
 	c.integration.Metadata = datatypes.NewJSONType(v)
+	c.markChanged("metadata")
 }
 
 func (c *IntegrationContext) GetState() string {
@@ -267,16 +293,59 @@ func (c *IntegrationContext) GetState() string {
 }
 
 func (c *IntegrationContext) Ready() {
-	c.integration.State = models.IntegrationStateReady
-	c.integration.StateDescription = ""
+	c.setState(models.IntegrationStateReady, "")
 }
 
 func (c *IntegrationContext) Error(message string) {
-	c.integration.State = models.IntegrationStateError
-	c.integration.StateDescription = message
+	c.setState(models.IntegrationStateError, message)
+}
+
+func (c *IntegrationContext) setState(state, description string) {
+	if c.integration.State == state && c.integration.StateDescription == description {
+		return
+	}
+
+	c.integration.State = state
+	c.integration.StateDescription = description
+	c.markChanged("state", "state_description")
+}
+
+func (c *IntegrationContext) markChanged(columns ...string) {
+	for _, column := range columns {
+		if !slices.Contains(c.changedColumns, column) {
+			c.changedColumns = append(c.changedColumns, column)
+		}
+	}
+}
+
+func (c *IntegrationContext) HasChanges() bool {
+	return len(c.changedColumns) > 0
+}
+
+/*
+ * Writes only the columns changed through this context.
+ * If nothing changed, the integration is not written at all.
+ */
+func (c *IntegrationContext) SaveChanges() error {
+	if !c.HasChanges() {
+		return nil
+	}
+
+	now := time.Now()
+	c.integration.UpdatedAt = &now
+	columns := append(slices.Clone(c.changedColumns), "updated_at")
+
+	err := c.tx.Model(c.integration).Select(columns).Updates(c.integration).Error
+	if err != nil {
+		return err
+	}
+
+	c.changedColumns = nil
+	return nil
 }
 
 func (c *IntegrationContext) SetSecret(name string, value []byte) error {
+	c.secrets = nil
 	now := time.Now()
 
 	// Encrypt the secret value using the installation ID as associated data
@@ -320,6 +389,10 @@ func (c *IntegrationContext) SetSecret(name string, value []byte) error {
 }
 
 func (c *IntegrationContext) GetSecrets() ([]core.IntegrationSecret, error) {
+	if c.secrets != nil {
+		return slices.Clone(c.secrets), nil
+	}
+
 	var fromDB []models.IntegrationSecret
 	err := c.tx.
 		Where("installation_id = ?", c.integration.ID).
@@ -330,7 +403,7 @@ func (c *IntegrationContext) GetSecrets() ([]core.IntegrationSecret, error) {
 		return nil, err
 	}
 
-	var secrets []core.IntegrationSecret
+	secrets := []core.IntegrationSecret{}
 	for _, secret := range fromDB {
 		decryptedValue, err := c.encryptor.Decrypt(
 			context.Background(),
@@ -348,7 +421,8 @@ func (c *IntegrationContext) GetSecrets() ([]core.IntegrationSecret, error) {
 		})
 	}
 
-	return secrets, nil
+	c.secrets = secrets
+	return slices.Clone(secrets), nil
 }
 
 func (c *IntegrationContext) NewBrowserAction(action core.BrowserAction) {
@@ -360,14 +434,20 @@ func (c *IntegrationContext) NewBrowserAction(action core.BrowserAction) {
 	})
 
 	c.integration.BrowserAction = &d
+	c.markChanged("browser_action")
 }
 
 func (c *IntegrationContext) RemoveBrowserAction() {
+	if c.integration.BrowserAction == nil {
+		return
+	}
+
 	c.integration.BrowserAction = nil
+	c.markChanged("browser_action")
 }
 
 func (c *IntegrationContext) Subscribe(configuration any) (*uuid.UUID, error) {
-	subscription, err := models.CreateIntegrationSubscriptionInTransaction(c.tx, c.node, c.integration, configuration)
+	subscription, err := models.CreateIntegrationSubscriptionInTransaction(c.tx, c.node, c.integration, configuration, subscriptionIndex(configuration))
 	if err != nil {
 		return nil, err
 	}
@@ -375,8 +455,25 @@ func (c *IntegrationContext) Subscribe(configuration any) (*uuid.UUID, error) {
 	return &subscription.ID, nil
 }
 
-func (c *IntegrationContext) ListSubscriptions() ([]core.IntegrationSubscriptionContext, error) {
-	subscriptions, err := models.ListIntegrationSubscriptions(c.tx, c.integration.ID)
+func (c *IntegrationContext) UpdateSubscription(subscriptionID uuid.UUID, configuration any) error {
+	return models.UpdateIntegrationSubscriptionConfigurationInTransaction(c.tx, subscriptionID, c.node.WorkflowID, c.node.NodeID, configuration, subscriptionIndex(configuration))
+}
+
+func subscriptionIndex(configuration any) *models.SubscriptionIndex {
+	indexed, ok := configuration.(core.IndexedSubscription)
+	if !ok {
+		return nil
+	}
+
+	filter := indexed.SubscriptionIndex()
+	return &models.SubscriptionIndex{Source: filter.Source, Type: filter.Type}
+}
+
+func (c *IntegrationContext) ListSubscriptions(filter core.SubscriptionFilter) ([]core.IntegrationSubscriptionContext, error) {
+	subscriptions, err := models.ListIntegrationSubscriptions(c.tx, c.integration.ID, models.SubscriptionIndex{
+		Source: filter.Source,
+		Type:   filter.Type,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package contexts
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"reflect"
 	"slices"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
@@ -253,3 +255,180 @@ func Test__IntegrationContext_RequestWebhook_MergesExistingWebhookConfig(t *test
 	require.NoError(t, marshalErr)
 	assert.JSONEq(t, `{"eventTypes":["build_ended","deploy_ended"]}`, string(configurationJSON))
 }
+
+type indexedTestSubscription struct {
+	Source     string `json:"source"`
+	DetailType string `json:"detail-type"`
+}
+
+func (s indexedTestSubscription) SubscriptionIndex() core.SubscriptionFilter {
+	return core.SubscriptionFilter{Source: s.Source, Type: s.DetailType}
+}
+
+/*
+ * Creates count nodes subscribed to the integration, spread over ten detail types,
+ * plus one node with a subscription that is not indexed.
+ */
+func createIndexedSubscriptions(t testing.TB, r *support.ResourceRegistry, count int) (*models.Integration, []models.CanvasNode) {
+	integration, err := models.CreateIntegration(
+		uuid.New(),
+		r.Organization.ID,
+		"dummy",
+		support.RandomName("installation"),
+		map[string]any{},
+	)
+	require.NoError(t, err)
+
+	inputNodes := make([]models.CanvasNode, count+1)
+	for i := range inputNodes {
+		inputNodes[i] = models.CanvasNode{
+			NodeID:        fmt.Sprintf("node-%d", i),
+			Name:          fmt.Sprintf("Node %d", i),
+			Type:          models.NodeTypeTrigger,
+			Ref:           datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			Configuration: datatypes.NewJSONType(map[string]any{}),
+			Metadata:      datatypes.NewJSONType(map[string]any{}),
+			Position:      datatypes.NewJSONType(models.Position{}),
+		}
+	}
+
+	_, nodes := support.CreateCanvas(t, r.Organization.ID, r.User, inputNodes, nil)
+	for i := range nodes {
+		ctx := NewIntegrationContext(database.Conn(), &nodes[i], integration, r.Encryptor, r.Registry)
+		if i == count {
+			_, err = ctx.Subscribe(map[string]any{"type": "message"})
+		} else {
+			_, err = ctx.Subscribe(indexedTestSubscription{Source: "aws.ecr", DetailType: fmt.Sprintf("type-%d", i%10)})
+		}
+
+		require.NoError(t, err)
+	}
+
+	return integration, nodes
+}
+
+func Test__IntegrationContext_ListSubscriptions(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	integration, nodes := createIndexedSubscriptions(t, r, 100)
+	ctx := NewIntegrationContext(database.Conn(), nil, integration, r.Encryptor, r.Registry)
+
+	t.Run("empty filter -> all subscriptions", func(t *testing.T) {
+		subscriptions, err := ctx.ListSubscriptions(core.SubscriptionFilter{})
+		require.NoError(t, err)
+		assert.Len(t, subscriptions, 101)
+	})
+
+	t.Run("filter -> matching and unindexed subscriptions", func(t *testing.T) {
+		subscriptions, err := ctx.ListSubscriptions(core.SubscriptionFilter{Source: "aws.ecr", Type: "type-3"})
+		require.NoError(t, err)
+		assert.Len(t, subscriptions, 11)
+	})
+
+	t.Run("filter with no matches -> only unindexed subscriptions", func(t *testing.T) {
+		subscriptions, err := ctx.ListSubscriptions(core.SubscriptionFilter{Source: "aws.codeartifact", Type: "type-3"})
+		require.NoError(t, err)
+		assert.Len(t, subscriptions, 1)
+	})
+
+	t.Run("updating the subscription updates the index", func(t *testing.T) {
+		var subscription models.IntegrationSubscription
+		require.NoError(t, database.Conn().Where("node_id = ?", nodes[0].NodeID).First(&subscription).Error)
+
+		nodeCtx := NewIntegrationContext(database.Conn(), &nodes[0], integration, r.Encryptor, r.Registry)
+		err := nodeCtx.UpdateSubscription(subscription.ID, indexedTestSubscription{Source: "aws.codeartifact", DetailType: "type-3"})
+		require.NoError(t, err)
+
+		subscriptions, err := ctx.ListSubscriptions(core.SubscriptionFilter{Source: "aws.codeartifact", Type: "type-3"})
+		require.NoError(t, err)
+		assert.Len(t, subscriptions, 2)
+	})
+}
+
+type countingEncryptor struct {
+	crypto.Encryptor
+	decryptions int
+}
+
+func (e *countingEncryptor) Decrypt(ctx context.Context, value []byte, data []byte) ([]byte, error) {
+	e.decryptions++
+	return e.Encryptor.Decrypt(ctx, value, data)
+}
+
+func Test__IntegrationContext_GetSecrets(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	integration, err := models.CreateIntegration(
+		uuid.New(),
+		r.Organization.ID,
+		"dummy",
+		support.RandomName("installation"),
+		map[string]any{},
+	)
+	require.NoError(t, err)
+
+	encryptor := &countingEncryptor{Encryptor: r.Encryptor}
+	ctx := NewIntegrationContext(database.Conn(), nil, integration, encryptor, r.Registry)
+	require.NoError(t, ctx.SetSecret("a", []byte("value-a")))
+	require.NoError(t, ctx.SetSecret("b", []byte("value-b")))
+
+	t.Run("secrets are decrypted once per context", func(t *testing.T) {
+		encryptor.decryptions = 0
+
+		secrets, err := ctx.GetSecrets()
+		require.NoError(t, err)
+		require.Len(t, secrets, 2)
+		assert.Equal(t, 2, encryptor.decryptions)
+
+		secrets, err = ctx.GetSecrets()
+		require.NoError(t, err)
+		require.Len(t, secrets, 2)
+		assert.Equal(t, 2, encryptor.decryptions)
+	})
+
+	t.Run("setting a secret invalidates the cache", func(t *testing.T) {
+		require.NoError(t, ctx.SetSecret("a", []byte("new-value-a")))
+		encryptor.decryptions = 0
+
+		secrets, err := ctx.GetSecrets()
+		require.NoError(t, err)
+		assert.Equal(t, 2, encryptor.decryptions)
+
+		i := slices.IndexFunc(secrets, func(s core.IntegrationSecret) bool { return s.Name == "a" })
+		require.NotEqual(t, -1, i)
+		assert.Equal(t, []byte("new-value-a"), secrets[i].Value)
+	})
+
+	t.Run("new contexts decrypt again", func(t *testing.T) {
+		encryptor.decryptions = 0
+		otherCtx := NewIntegrationContext(database.Conn(), nil, integration, encryptor, r.Registry)
+
+		_, err := otherCtx.GetSecrets()
+		require.NoError(t, err)
+		assert.Equal(t, 2, encryptor.decryptions)
+	})
+}
+
+func Benchmark__IntegrationContext_ListSubscriptions(b *testing.B) {
+	r := support.Setup(b)
+	defer r.Close()
+
+	integration, _ := createIndexedSubscriptions(b, r, 1000)
+	ctx := NewIntegrationContext(database.Conn(), nil, integration, r.Encryptor, r.Registry)
+
+	b.Run("all", func(b *testing.B) {
+		for b.Loop() {
+			_, err := ctx.ListSubscriptions(core.SubscriptionFilter{})
+			require.NoError(b, err)
+		}
+	})
+
+	b.Run("filtered", func(b *testing.B) {
+		for b.Loop() {
+			_, err := ctx.ListSubscriptions(core.SubscriptionFilter{Source: "aws.ecr", Type: "type-3"})
+			require.NoError(b, err)
+		}
+	})
+}
@@ -29,6 +29,7 @@ type NodeConfigurationBuilder struct {
 	input               any
 	parentBlueprintNode *models.CanvasNode
 	configurationFields []configuration.Field
+	cache               *ConfigurationCache
 }
 
 func NewNodeConfigurationBuilder(tx *gorm.DB, workflowID uuid.UUID) *NodeConfigurationBuilder {
@@ -68,7 +69,20 @@ func (b *NodeConfigurationBuilder) WithConfigurationFields(fields []configuratio
 	return b
 }
 
+func (b *NodeConfigurationBuilder) WithCache(cache *ConfigurationCache) *NodeConfigurationBuilder {
+	b.cache = cache
+	return b
+}
+
 func (b *NodeConfigurationBuilder) Build(configuration map[string]any) (map[string]any, error) {
+	if b.cache != nil {
+		return b.buildWithCache(configuration)
+	}
+
+	return b.build(configuration)
+}
+
+func (b *NodeConfigurationBuilder) build(configuration map[string]any) (map[string]any, error) {
 	if len(b.configurationFields) > 0 {
 		return b.resolveWithSchema(configuration, b.configurationFields)
 	}
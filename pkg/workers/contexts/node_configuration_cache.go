@@ -0,0 +1,274 @@
+package contexts
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"sync"
+
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/parser"
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+)
+
+const DefaultConfigurationCacheSize = 1000
+
+/*
+ * Functions whose result changes between calls with the same arguments.
+ * Templates using them are never cached.
+ */
+var timeLikeFunctions = []string{"now", "date"}
+
+/*
+ * Bounded LRU cache of built configurations, keyed by the hash
+ * of the node configuration and of everything the expressions in it
+ * read from. If the node configuration changes, its hash changes too,
+ * so entries never need to be invalidated.
+ */
+type ConfigurationCache struct {
+	mu       sync.Mutex
+	size     int
+	entries  *list.List
+	elements map[string]*list.Element
+}
+
+type configurationCacheEntry struct {
+	key   string
+	value map[string]any
+}
+
+func NewConfigurationCache(size int) *ConfigurationCache {
+	return &ConfigurationCache{
+		size:     max(size, 1),
+		entries:  list.New(),
+		elements: map[string]*list.Element{},
+	}
+}
+
+func (c *ConfigurationCache) Get(key string) (map[string]any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.elements[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.entries.MoveToFront(element)
+	return cloneValue(element.Value.(*configurationCacheEntry).value).(map[string]any), true
+}
+
+func (c *ConfigurationCache) Add(key string, value map[string]any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	value = cloneValue(value).(map[string]any)
+	if element, ok := c.elements[key]; ok {
+		element.Value.(*configurationCacheEntry).value = value
+		c.entries.MoveToFront(element)
+		return
+	}
+
+	c.elements[key] = c.entries.PushFront(&configurationCacheEntry{key: key, value: value})
+	if c.entries.Len() <= c.size {
+		return
+	}
+
+	oldest := c.entries.Back()
+	c.entries.Remove(oldest)
+	delete(c.elements, oldest.Value.(*configurationCacheEntry).key)
+}
+
+func (c *ConfigurationCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.entries.Len()
+}
+
+/*
+ * What the expressions in a template read from,
+ * collected while parsing them.
+ */
+type templateInfo struct {
+	cacheable bool
+
+	//
+	// Expressions reading from the message chain, root() or previous()
+	// might need to go to the database, so their results depend on the
+	// execution being built, and not only on the input.
+	//
+	dependsOnExecution bool
+}
+
+func parseTemplates(value any) templateInfo {
+	info := templateInfo{cacheable: true}
+	collectTemplateInfo(value, &info)
+	return info
+}
+
+func collectTemplateInfo(value any, info *templateInfo) {
+	switch v := value.(type) {
+	case string:
+		for _, matches := range expressionRegex.FindAllStringSubmatch(v, -1) {
+			parseTemplate(matches[1], info)
+		}
+
+	case map[string]any:
+		for _, item := range v {
+			collectTemplateInfo(item, info)
+		}
+
+	case map[string]string:
+		for _, item := range v {
+			collectTemplateInfo(item, info)
+		}
+
+	case []any:
+		for _, item := range v {
+			collectTemplateInfo(item, info)
+		}
+	}
+}
+
+func parseTemplate(expression string, info *templateInfo) {
+	tree, err := parser.Parse(expression)
+	if err != nil {
+		info.cacheable = false
+		return
+	}
+
+	collector := &templateInfoCollector{info: info}
+	ast.Walk(&tree.Node, collector)
+}
+
+type templateInfoCollector struct {
+	info *templateInfo
+}
+
+func (c *templateInfoCollector) Visit(node *ast.Node) {
+	switch n := (*node).(type) {
+	case *ast.CallNode:
+		callee, ok := n.Callee.(*ast.IdentifierNode)
+		if !ok {
+			return
+		}
+
+		if slices.Contains(timeLikeFunctions, callee.Value) {
+			c.info.cacheable = false
+		}
+
+		if callee.Value == "root" || callee.Value == "previous" {
+			c.info.dependsOnExecution = true
+		}
+
+	case *ast.BuiltinNode:
+		if slices.Contains(timeLikeFunctions, n.Name) {
+			c.info.cacheable = false
+		}
+
+	case *ast.IdentifierNode:
+		if n.Value == "$" {
+			c.info.dependsOnExecution = true
+		}
+	}
+}
+
+type configurationCacheKey struct {
+	Configuration       map[string]any        `json:"configuration"`
+	Input               any                   `json:"input"`
+	Fields              []configuration.Field `json:"fields,omitempty"`
+	BlueprintConfig     any                   `json:"blueprintConfig,omitempty"`
+	WorkflowID          *uuid.UUID            `json:"workflowId,omitempty"`
+	NodeID              string                `json:"nodeId,omitempty"`
+	RootEventID         *uuid.UUID            `json:"rootEventId,omitempty"`
+	PreviousExecutionID *uuid.UUID            `json:"previousExecutionId,omitempty"`
+}
+
+/*
+ * Returns false if the configuration cannot be cached,
+ * because it uses time-like functions or cannot be hashed.
+ */
+func (b *NodeConfigurationBuilder) cacheKey(config map[string]any) (string, bool) {
+	info := parseTemplates(config)
+	if !info.cacheable {
+		return "", false
+	}
+
+	var blueprintConfig any
+	if b.parentBlueprintNode != nil {
+		blueprintConfig = b.parentBlueprintNode.Configuration.Data()
+	}
+
+	key := configurationCacheKey{
+		Configuration:   config,
+		Input:           b.input,
+		Fields:          b.configurationFields,
+		BlueprintConfig: blueprintConfig,
+	}
+
+	if info.dependsOnExecution {
+		key.WorkflowID = &b.workflowID
+		key.NodeID = b.nodeID
+		key.RootEventID = b.rootEventID
+		key.PreviousExecutionID = b.previousExecutionID
+	}
+
+	data, err := json.Marshal(key)
+	if err != nil {
+		return "", false
+	}
+
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), true
+}
+
+func (b *NodeConfigurationBuilder) buildWithCache(config map[string]any) (map[string]any, error) {
+	key, ok := b.cacheKey(config)
+	if !ok {
+		return b.build(config)
+	}
+
+	if cached, ok := b.cache.Get(key); ok {
+		telemetry.RecordConfigurationCacheHit()
+		return cached, nil
+	}
+
+	telemetry.RecordConfigurationCacheMiss()
+
+	//
+	// Errors are not cached, so configurations that fail
+	// to build fail the same way every time.
+	//
+	resolved, err := b.build(config)
+	if err != nil {
+		return nil, err
+	}
+
+	b.cache.Add(key, resolved)
+	return resolved, nil
+}
+
+func cloneValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, item := range v {
+			result[key] = cloneValue(item)
+		}
+		return result
+
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = cloneValue(item)
+		}
+		return result
+
+	default:
+		return v
+	}
+}
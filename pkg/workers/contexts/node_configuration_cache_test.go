@@ -0,0 +1,132 @@
+package contexts
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/datatypes"
+)
+
+func Test_ConfigurationCache(t *testing.T) {
+	blueprintNode := &models.CanvasNode{
+		Configuration: datatypes.NewJSONType(map[string]any{"environment": "production"}),
+	}
+
+	newBuilder := func(cache *ConfigurationCache, input any) *NodeConfigurationBuilder {
+		return NewNodeConfigurationBuilder(nil, uuid.New()).
+			ForBlueprintNode(blueprintNode).
+			WithInput(input).
+			WithCache(cache)
+	}
+
+	t.Run("same configuration and input is built once", func(t *testing.T) {
+		cache := NewConfigurationCache(10)
+		configuration := map[string]any{
+			"target": "{{ config.environment }}",
+			"tags":   []any{"deploy", "{{ config.environment }}"},
+		}
+
+		first, err := newBuilder(cache, map[string]any{"node-1": map[string]any{"a": 1}}).Build(configuration)
+		require.NoError(t, err)
+		assert.Equal(t, "production", first["target"])
+		assert.Equal(t, 1, cache.Len())
+
+		//
+		// Results handed out are copies, so callers can't change the cached entry.
+		//
+		first["target"] = "changed"
+		first["tags"].([]any)[0] = "changed"
+
+		second, err := newBuilder(cache, map[string]any{"node-1": map[string]any{"a": 1}}).Build(configuration)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"target": "production", "tags": []any{"deploy", "production"}}, second)
+		assert.Equal(t, 1, cache.Len())
+	})
+
+	t.Run("different input or configuration is built again", func(t *testing.T) {
+		cache := NewConfigurationCache(10)
+
+		_, err := newBuilder(cache, map[string]any{"node-1": 1}).Build(map[string]any{"target": "{{ config.environment }}"})
+		require.NoError(t, err)
+		_, err = newBuilder(cache, map[string]any{"node-1": 2}).Build(map[string]any{"target": "{{ config.environment }}"})
+		require.NoError(t, err)
+		_, err = newBuilder(cache, map[string]any{"node-1": 2}).Build(map[string]any{"target": "{{ config.environment }}-2"})
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, cache.Len())
+	})
+
+	t.Run("templates with time-like functions are not cached", func(t *testing.T) {
+		cache := NewConfigurationCache(10)
+
+		_, err := newBuilder(cache, nil).Build(map[string]any{"at": "{{ now().Unix() }}"})
+		require.NoError(t, err)
+		_, err = newBuilder(cache, nil).Build(map[string]any{"at": "{{ date('2024-01-01') }}"})
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, cache.Len())
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		cache := NewConfigurationCache(10)
+		configuration := map[string]any{"target": "{{ config.environment.missing() }}"}
+
+		_, firstErr := newBuilder(cache, nil).Build(configuration)
+		require.Error(t, firstErr)
+		_, secondErr := newBuilder(cache, nil).Build(configuration)
+		require.Error(t, secondErr)
+
+		assert.Equal(t, firstErr.Error(), secondErr.Error())
+		assert.Equal(t, 0, cache.Len())
+	})
+
+	t.Run("least recently used entries are evicted", func(t *testing.T) {
+		cache := NewConfigurationCache(2)
+		cache.Add("a", map[string]any{"value": "a"})
+		cache.Add("b", map[string]any{"value": "b"})
+
+		_, ok := cache.Get("a")
+		require.True(t, ok)
+
+		cache.Add("c", map[string]any{"value": "c"})
+		assert.Equal(t, 2, cache.Len())
+
+		_, ok = cache.Get("b")
+		assert.False(t, ok)
+		_, ok = cache.Get("a")
+		assert.True(t, ok)
+		_, ok = cache.Get("c")
+		assert.True(t, ok)
+	})
+}
+
+func Test_ParseTemplates(t *testing.T) {
+	tests := []struct {
+		name               string
+		value              any
+		cacheable          bool
+		dependsOnExecution bool
+	}{
+		{name: "no expressions", value: map[string]any{"a": "plain", "b": 1}, cacheable: true},
+		{name: "blueprint config", value: map[string]any{"a": "{{ config.name }}"}, cacheable: true},
+		{name: "message chain", value: map[string]any{"a": "{{ $['Trigger'].data.ref }}"}, cacheable: true, dependsOnExecution: true},
+		{name: "root", value: []any{"{{ root().data }}"}, cacheable: true, dependsOnExecution: true},
+		{name: "previous", value: map[string]string{"a": "{{ previous(2).data }}"}, cacheable: true, dependsOnExecution: true},
+		{name: "now", value: map[string]any{"a": map[string]any{"b": "at {{ now() }}"}}, cacheable: false},
+		{name: "date", value: map[string]any{"a": "{{ date('2024-01-01').Year() }}"}, cacheable: false},
+		{name: "invalid expression", value: map[string]any{"a": "{{ ( }}"}, cacheable: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := parseTemplates(tt.value)
+			assert.Equal(t, tt.cacheable, info.cacheable)
+			if tt.cacheable {
+				assert.Equal(t, tt.dependsOnExecution, info.dependsOnExecution)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package contexts
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/superplanehq/superplane/pkg/models"
 	"gorm.io/datatypes"
@@ -9,12 +10,13 @@ import (
 )
 
 type NodeMetadataContext struct {
-	tx   *gorm.DB
-	node *models.CanvasNode
+	tx              *gorm.DB
+	node            *models.CanvasNode
+	maxMetadataSize int
 }
 
 func NewNodeMetadataContext(tx *gorm.DB, node *models.CanvasNode) *NodeMetadataContext {
-	return &NodeMetadataContext{tx: tx, node: node}
+	return &NodeMetadataContext{tx: tx, node: node, maxMetadataSize: DefaultMaxMetadataSize}
 }
 
 func (m *NodeMetadataContext) Get() any {
@@ -27,6 +29,10 @@ func (m *NodeMetadataContext) Set(value any) error {
 		return err
 	}
 
+	if len(b) > m.maxMetadataSize {
+		return fmt.Errorf("node metadata too large: %d bytes (max %d)", len(b), m.maxMetadataSize)
+	}
+
 	var v map[string]any
 	err = json.Unmarshal(b, &v)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/config"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/models"
 	"gorm.io/gorm"
@@ -112,3 +113,18 @@ func (c *NodeWebhookContext) findOrCreateWebhook() (*models.Webhook, error) {
 func (c *NodeWebhookContext) GetBaseURL() string {
 	return c.baseURL
 }
+
+func (c *NodeWebhookContext) SetMaxBodySize(bytes int64) error {
+	if c.node.WebhookID == nil {
+		return fmt.Errorf("node does not have a webhook")
+	}
+
+	if bytes < 0 || bytes > config.WebhookMaxBodyBytesCeiling {
+		return fmt.Errorf("max body size must be between 0 and %d bytes", config.WebhookMaxBodyBytesCeiling)
+	}
+
+	return c.tx.Model(&models.Webhook{}).
+		Where("id = ?", *c.node.WebhookID).
+		Update("max_body_size", bytes).
+		Error
+}
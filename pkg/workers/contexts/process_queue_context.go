@@ -122,6 +122,7 @@ func BuildProcessQueueContext(httpCtx core.HTTPContext, tx *gorm.DB, node *model
 			Configuration:  execution.Configuration.Data(),
 			HTTP:           httpCtx,
 			Metadata:       NewExecutionMetadataContext(tx, &execution),
+			Attachments:    NewExecutionAttachmentContext(tx, &execution),
 			NodeMetadata:   NewNodeMetadataContext(tx, node),
 			ExecutionState: NewExecutionStateContext(tx, &execution),
 			Requests:       NewExecutionRequestContext(tx, &execution),
@@ -211,6 +212,15 @@ func BuildProcessQueueContext(httpCtx core.HTTPContext, tx *gorm.DB, node *model
 			return nil, err
 		}
 
+		//
+		// Actions for the execution, like timeouts, may be running at the same time,
+		// so the execution is locked to apply their updates one after the other.
+		//
+		execution, err = models.FindNodeExecutionForUpdateInTransaction(tx, node.WorkflowID, execution.ID)
+		if err != nil {
+			return nil, err
+		}
+
 		return &core.ExecutionContext{
 			ID:             execution.ID,
 			WorkflowID:     execution.WorkflowID.String(),
@@ -218,6 +228,7 @@ func BuildProcessQueueContext(httpCtx core.HTTPContext, tx *gorm.DB, node *model
 			Configuration:  execution.Configuration.Data(),
 			HTTP:           httpCtx,
 			Metadata:       NewExecutionMetadataContext(tx, execution),
+			Attachments:    NewExecutionAttachmentContext(tx, execution),
 			NodeMetadata:   NewNodeMetadataContext(tx, node),
 			ExecutionState: NewExecutionStateContext(tx, execution),
 			Requests:       NewExecutionRequestContext(tx, execution),
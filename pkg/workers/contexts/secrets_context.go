@@ -3,11 +3,14 @@ package contexts
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/secrets"
 	"gorm.io/gorm"
 )
 
@@ -16,19 +19,26 @@ type SecretsContext struct {
 	tx             *gorm.DB
 	organizationID uuid.UUID
 	encryptor      crypto.Encryptor
+	http           core.HTTPContext
+	resolved       map[string][]byte
 }
 
 // NewSecretsContext returns a SecretsContext that looks up secrets in the given transaction
-// for the given organization.
-func NewSecretsContext(tx *gorm.DB, organizationID uuid.UUID, encryptor crypto.Encryptor) *SecretsContext {
+// for the given organization. Values referencing external secret stores are resolved with
+// the resolver registered for their scheme, and cached for the lifetime of the SecretsContext.
+func NewSecretsContext(tx *gorm.DB, organizationID uuid.UUID, encryptor crypto.Encryptor, http core.HTTPContext) *SecretsContext {
 	return &SecretsContext{
 		tx:             tx,
 		organizationID: organizationID,
 		encryptor:      encryptor,
+		http:           http,
+		resolved:       map[string][]byte{},
 	}
 }
 
-// GetKey implements core.SecretsContext.
+// GetKey implements core.SecretsContext. Only the value of the requested key
+// is returned, or resolved, if it references an external secret store.
+// Errors for a missing secret or key wrap core.ErrSecretKeyNotFound.
 func (c *SecretsContext) GetKey(secretName, keyName string) ([]byte, error) {
 	if secretName == "" || keyName == "" {
 		return nil, core.ErrSecretKeyNotFound
@@ -36,6 +46,10 @@ func (c *SecretsContext) GetKey(secretName, keyName string) ([]byte, error) {
 
 	secret, err := models.FindSecretByNameInTransaction(c.tx, models.DomainTypeOrganization, c.organizationID, secretName)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("secret %s not found: %w", secretName, core.ErrSecretKeyNotFound)
+		}
+
 		return nil, err
 	}
 
@@ -46,10 +60,33 @@ func (c *SecretsContext) GetKey(secretName, keyName string) ([]byte, error) {
 
 	val, ok := data[keyName]
 	if !ok || val == "" {
-		return nil, core.ErrSecretKeyNotFound
+		return nil, fmt.Errorf("key %s not found in secret %s: %w", keyName, secretName, core.ErrSecretKeyNotFound)
+	}
+
+	return c.resolve(val)
+}
+
+func (c *SecretsContext) resolve(value string) ([]byte, error) {
+	resolver := secrets.FindReferenceResolver(value)
+	if resolver == nil {
+		return []byte(value), nil
+	}
+
+	if resolved, ok := c.resolved[value]; ok {
+		return resolved, nil
 	}
 
-	return []byte(val), nil
+	resolved, err := resolver.Resolve(secrets.ReferenceContext{
+		HTTP:            c.http,
+		FindIntegration: c.findIntegration,
+	}, value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.resolved[value] = resolved
+	return resolved, nil
 }
 
 func (c *SecretsContext) decryptSecretData(secret *models.Secret) (map[string]string, error) {
@@ -67,3 +104,37 @@ func (c *SecretsContext) decryptSecretData(secret *models.Secret) (map[string]st
 	}
 	return data, nil
 }
+
+func (c *SecretsContext) findIntegration(appName, name string) (core.IntegrationContext, error) {
+	integrations, err := models.ListIntegrationsInTransaction(c.tx, c.organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list integrations: %w", err)
+	}
+
+	var candidates []models.Integration
+	for _, integration := range integrations {
+		if integration.AppName != appName {
+			continue
+		}
+
+		if name != "" && integration.InstallationName != name {
+			continue
+		}
+
+		candidates = append(candidates, integration)
+	}
+
+	if len(candidates) == 0 {
+		if name != "" {
+			return nil, fmt.Errorf("%s integration %q not found", appName, name)
+		}
+
+		return nil, fmt.Errorf("no %s integration found to resolve secret reference", appName)
+	}
+
+	if len(candidates) > 1 {
+		return nil, fmt.Errorf("multiple %s integrations found, use ?integration=<name> in the secret reference", appName)
+	}
+
+	return NewIntegrationContext(c.tx, nil, &candidates[0], c.encryptor, nil), nil
+}
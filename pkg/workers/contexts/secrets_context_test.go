@@ -0,0 +1,121 @@
+package contexts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/secrets"
+	"github.com/superplanehq/superplane/test/support"
+	testcontexts "github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func Test__SecretsContext_GetKey(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	integration, err := models.CreateIntegration(
+		uuid.New(),
+		r.Organization.ID,
+		"aws",
+		support.RandomName("aws"),
+		map[string]any{},
+	)
+	require.NoError(t, err)
+
+	secretName := support.RandomName("secret")
+	data, err := json.Marshal(map[string]string{
+		"plain":      "local-value",
+		"referenced": "test-store://prod/db#password",
+	})
+	require.NoError(t, err)
+
+	encrypted, err := r.Encryptor.Encrypt(context.Background(), data, []byte(secretName))
+	require.NoError(t, err)
+
+	_, err = models.CreateSecret(secretName, secrets.ProviderLocal, uuid.NewString(), models.DomainTypeOrganization, r.Organization.ID, encrypted)
+	require.NoError(t, err)
+
+	t.Run("plain value -> resolved locally", func(t *testing.T) {
+		httpContext := &testcontexts.HTTPContext{}
+		ctx := NewSecretsContext(database.Conn(), r.Organization.ID, r.Encryptor, httpContext)
+
+		value, err := ctx.GetKey(secretName, "plain")
+		require.NoError(t, err)
+		assert.Equal(t, "local-value", string(value))
+		assert.Empty(t, httpContext.Requests)
+	})
+
+	t.Run("missing key -> error", func(t *testing.T) {
+		ctx := NewSecretsContext(database.Conn(), r.Organization.ID, r.Encryptor, &testcontexts.HTTPContext{})
+
+		_, err := ctx.GetKey(secretName, "missing")
+		require.ErrorIs(t, err, core.ErrSecretKeyNotFound)
+		assert.EqualError(t, err, fmt.Sprintf("key missing not found in secret %s: secret or key not found", secretName))
+	})
+
+	t.Run("missing secret -> error", func(t *testing.T) {
+		ctx := NewSecretsContext(database.Conn(), r.Organization.ID, r.Encryptor, &testcontexts.HTTPContext{})
+
+		_, err := ctx.GetKey("does-not-exist", "plain")
+		require.ErrorIs(t, err, core.ErrSecretKeyNotFound)
+		assert.EqualError(t, err, "secret does-not-exist not found: secret or key not found")
+	})
+
+	t.Run("reference -> resolved with the registered resolver and cached", func(t *testing.T) {
+		resolver := &testReferenceResolver{value: "hunter2"}
+		secrets.RegisterReferenceResolver("test-store", resolver)
+
+		ctx := NewSecretsContext(database.Conn(), r.Organization.ID, r.Encryptor, &testcontexts.HTTPContext{})
+
+		value, err := ctx.GetKey(secretName, "referenced")
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", string(value))
+
+		value, err = ctx.GetKey(secretName, "referenced")
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", string(value))
+
+		assert.Equal(t, 1, resolver.calls)
+		require.NotNil(t, resolver.integration)
+		assert.Equal(t, integration.ID, resolver.integration.ID())
+	})
+
+	t.Run("reference to a missing integration -> error", func(t *testing.T) {
+		secrets.RegisterReferenceResolver("test-store", &testReferenceResolver{integrationName: "missing"})
+		ctx := NewSecretsContext(database.Conn(), r.Organization.ID, r.Encryptor, &testcontexts.HTTPContext{})
+
+		_, err := ctx.GetKey(secretName, "referenced")
+		require.EqualError(t, err, `aws integration "missing" not found`)
+	})
+}
+
+/*
+ * Resolves references with the organization's AWS integration,
+ * always returning the same value.
+ */
+type testReferenceResolver struct {
+	value           string
+	integrationName string
+	integration     core.IntegrationContext
+	calls           int
+}
+
+func (r *testReferenceResolver) Resolve(ctx secrets.ReferenceContext, value string) ([]byte, error) {
+	r.calls++
+
+	integration, err := ctx.FindIntegration("aws", r.integrationName)
+	if err != nil {
+		return nil, err
+	}
+
+	r.integration = integration
+	return []byte(r.value), nil
+}
@@ -0,0 +1,68 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+/*
+ * Deletes expired event deduplication keys.
+ * Keys are deleted in batches, to avoid long running queries.
+ */
+type EventDeduplicationCleanupWorker struct {
+	logger        *log.Entry
+	batchSize     int
+	maxBatches    int
+	checkInterval time.Duration
+}
+
+func NewEventDeduplicationCleanupWorker() *EventDeduplicationCleanupWorker {
+	return &EventDeduplicationCleanupWorker{
+		logger:        log.WithFields(log.Fields{"worker": "EventDeduplicationCleanupWorker"}),
+		batchSize:     1000,
+		maxBatches:    50,
+		checkInterval: 10 * time.Minute,
+	}
+}
+
+func (w *EventDeduplicationCleanupWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Tick(); err != nil {
+				w.logger.Errorf("Error deleting expired event deduplication keys: %v", err)
+			}
+		}
+	}
+}
+
+func (w *EventDeduplicationCleanupWorker) Tick() error {
+	now := time.Now()
+	total := int64(0)
+
+	for range w.maxBatches {
+		deleted, err := models.DeleteExpiredEventDeduplicationKeys(now, w.batchSize)
+		if err != nil {
+			return err
+		}
+
+		total += deleted
+		if deleted < int64(w.batchSize) {
+			break
+		}
+	}
+
+	if total > 0 {
+		w.logger.Infof("Deleted %d expired event deduplication keys", total)
+	}
+
+	return nil
+}
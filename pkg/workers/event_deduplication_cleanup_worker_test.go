@@ -0,0 +1,51 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__EventDeduplicationCleanupWorker_DeletesExpiredKeys(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Name:   "Trigger 1",
+				Type:   models.NodeTypeTrigger,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Trigger: &models.TriggerRef{Name: "start"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	recorded, err := models.RecordEventDeduplicationKeyInTransaction(database.Conn(), canvas.ID, "trigger-1", "expired", -time.Minute)
+	require.NoError(t, err)
+	require.True(t, recorded)
+
+	recorded, err = models.RecordEventDeduplicationKeyInTransaction(database.Conn(), canvas.ID, "trigger-1", "active", time.Hour)
+	require.NoError(t, err)
+	require.True(t, recorded)
+
+	w := NewEventDeduplicationCleanupWorker()
+	w.batchSize = 1
+	require.NoError(t, w.Tick())
+
+	keys := []models.CanvasEventDeduplicationKey{}
+	require.NoError(t, database.Conn().Where("workflow_id = ?", canvas.ID).Find(&keys).Error)
+	require.Len(t, keys, 1)
+	assert.Equal(t, "active", keys[0].Key)
+}
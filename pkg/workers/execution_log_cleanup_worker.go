@@ -0,0 +1,104 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+/*
+ * Key/values of finished executions are kept
+ * for this long before being deleted.
+ */
+const ExecutionKVRetention = 24 * time.Hour
+
+/*
+ * Deletes node execution logs older than the retention period,
+ * and key/values of executions that finished a while ago.
+ * Both are deleted in batches, to avoid long running queries.
+ */
+type ExecutionLogCleanupWorker struct {
+	logger        *log.Entry
+	retention     time.Duration
+	kvRetention   time.Duration
+	batchSize     int
+	maxBatches    int
+	checkInterval time.Duration
+}
+
+func NewExecutionLogCleanupWorker(retentionDays int) *ExecutionLogCleanupWorker {
+	return &ExecutionLogCleanupWorker{
+		logger:        log.WithFields(log.Fields{"worker": "ExecutionLogCleanupWorker"}),
+		retention:     time.Duration(retentionDays) * 24 * time.Hour,
+		kvRetention:   ExecutionKVRetention,
+		batchSize:     1000,
+		maxBatches:    50,
+		checkInterval: 10 * time.Minute,
+	}
+}
+
+func (w *ExecutionLogCleanupWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Tick(); err != nil {
+				w.logger.Errorf("Error deleting old execution logs: %v", err)
+			}
+		}
+	}
+}
+
+func (w *ExecutionLogCleanupWorker) Tick() error {
+	before := time.Now().Add(-w.retention)
+	total, err := w.deleteInBatches(func() (int64, error) {
+		return models.DeleteNodeExecutionLogsBefore(before, w.batchSize)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if total > 0 {
+		w.logger.Infof("Deleted %d execution logs older than %s", total, before.Format(time.RFC3339))
+	}
+
+	kvsBefore := time.Now().Add(-w.kvRetention)
+	total, err = w.deleteInBatches(func() (int64, error) {
+		return models.DeleteFinishedNodeExecutionKVsBefore(kvsBefore, w.batchSize)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if total > 0 {
+		w.logger.Infof("Deleted %d key/values of executions finished before %s", total, kvsBefore.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func (w *ExecutionLogCleanupWorker) deleteInBatches(deleteBatch func() (int64, error)) (int64, error) {
+	total := int64(0)
+
+	for range w.maxBatches {
+		deleted, err := deleteBatch()
+		if err != nil {
+			return total, err
+		}
+
+		total += deleted
+		if deleted < int64(w.batchSize) {
+			break
+		}
+	}
+
+	return total, nil
+}
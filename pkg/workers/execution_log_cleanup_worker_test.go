@@ -0,0 +1,95 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__ExecutionLogCleanupWorker_DeletesLogsOlderThanRetention(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+
+	old := time.Now().Add(-8 * 24 * time.Hour)
+	recent := time.Now().Add(-time.Hour)
+	err := models.CreateNodeExecutionLogsInTransaction(database.Conn(), execution, []models.CanvasNodeExecutionLog{
+		{Level: "info", Message: "old", Fields: datatypes.NewJSONType(map[string]any{}), CreatedAt: &old},
+		{Level: "info", Message: "recent", Fields: datatypes.NewJSONType(map[string]any{}), CreatedAt: &recent},
+	})
+	require.NoError(t, err)
+
+	w := NewExecutionLogCleanupWorker(7)
+	w.batchSize = 1
+	require.NoError(t, w.Tick())
+
+	logs, err := models.ListNodeExecutionLogs(canvas.ID, execution.ID)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, "recent", logs[0].Message)
+}
+
+func Test__ExecutionLogCleanupWorker_DeletesKVsOfFinishedExecutions(t *testing.T) {
+	r := support.Setup(t)
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "node-1",
+				Name:   "Node 1",
+				Type:   models.NodeTypeComponent,
+				Ref: datatypes.NewJSONType(models.NodeRef{
+					Component: &models.ComponentRef{Name: "noop"},
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+	finished := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+	running := support.CreateCanvasNodeExecution(t, canvas.ID, "node-1", rootEvent.ID, rootEvent.ID, nil)
+
+	old := time.Now().Add(-2 * ExecutionKVRetention)
+	require.NoError(t, database.Conn().Model(finished).Updates(map[string]any{"state": models.CanvasNodeExecutionStateFinished, "updated_at": old}).Error)
+
+	require.NoError(t, models.CreateNodeExecutionKVsInTransaction(database.Conn(), canvas.ID, "node-1", finished.ID, map[string]string{"id": "finished"}))
+	require.NoError(t, models.CreateNodeExecutionKVsInTransaction(database.Conn(), canvas.ID, "node-1", running.ID, map[string]string{"id": "running"}))
+
+	w := NewExecutionLogCleanupWorker(7)
+	require.NoError(t, w.Tick())
+
+	_, err := models.FirstNodeExecutionByKVInTransaction(database.Conn(), canvas.ID, "node-1", "id", "finished")
+	assert.Error(t, err)
+
+	execution, err := models.FirstNodeExecutionByKVInTransaction(database.Conn(), canvas.ID, "node-1", "id", "running")
+	require.NoError(t, err)
+	assert.Equal(t, running.ID, execution.ID)
+}
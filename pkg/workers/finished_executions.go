@@ -0,0 +1,40 @@
+package workers
+
+import (
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+)
+
+/*
+ * Collects the results of the executions a worker finishes in a transaction,
+ * so they are only counted once that transaction commits,
+ * and executions whose transaction is rolled back are not counted.
+ */
+type finishedExecutions struct {
+	results []string
+}
+
+/*
+ * Runs fn, keeping the result of the execution if fn finishes it.
+ * Executions that were already finished are not counted again.
+ */
+func (f *finishedExecutions) Track(execution *models.CanvasNodeExecution, fn func() error) error {
+	wasFinished := execution.State == models.CanvasNodeExecutionStateFinished
+
+	err := fn()
+	if err != nil {
+		return err
+	}
+
+	if !wasFinished && execution.State == models.CanvasNodeExecutionStateFinished {
+		f.results = append(f.results, execution.Result)
+	}
+
+	return nil
+}
+
+func (f *finishedExecutions) Record() {
+	for _, result := range f.results {
+		telemetry.RecordExecutionFinished(result)
+	}
+}
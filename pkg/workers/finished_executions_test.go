@@ -0,0 +1,60 @@
+package workers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+func Test__FinishedExecutions_Track(t *testing.T) {
+	finish := func(execution *models.CanvasNodeExecution, result string) func() error {
+		return func() error {
+			execution.State = models.CanvasNodeExecutionStateFinished
+			execution.Result = result
+			return nil
+		}
+	}
+
+	t.Run("execution finished -> result is kept", func(t *testing.T) {
+		f := finishedExecutions{}
+		execution := &models.CanvasNodeExecution{State: models.CanvasNodeExecutionStateStarted}
+
+		require.NoError(t, f.Track(execution, finish(execution, models.CanvasNodeExecutionResultPassed)))
+		assert.Equal(t, []string{models.CanvasNodeExecutionResultPassed}, f.results)
+	})
+
+	t.Run("execution not finished -> nothing is kept", func(t *testing.T) {
+		f := finishedExecutions{}
+		execution := &models.CanvasNodeExecution{State: models.CanvasNodeExecutionStateStarted}
+
+		require.NoError(t, f.Track(execution, func() error { return nil }))
+		assert.Empty(t, f.results)
+	})
+
+	t.Run("execution already finished -> nothing is kept", func(t *testing.T) {
+		f := finishedExecutions{}
+		execution := &models.CanvasNodeExecution{
+			State:  models.CanvasNodeExecutionStateFinished,
+			Result: models.CanvasNodeExecutionResultPassed,
+		}
+
+		require.NoError(t, f.Track(execution, func() error { return nil }))
+		assert.Empty(t, f.results)
+	})
+
+	t.Run("error -> nothing is kept", func(t *testing.T) {
+		f := finishedExecutions{}
+		execution := &models.CanvasNodeExecution{State: models.CanvasNodeExecutionStateStarted}
+
+		err := f.Track(execution, func() error {
+			_ = finish(execution, models.CanvasNodeExecutionResultFailed)()
+			return errors.New("oops")
+		})
+
+		require.Error(t, err)
+		assert.Empty(t, f.results)
+	})
+}
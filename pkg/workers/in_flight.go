@@ -0,0 +1,56 @@
+package workers
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+ * How long a worker waits for the items it is still
+ * processing to finish, after its context is cancelled.
+ */
+const DefaultShutdownTimeout = 30 * time.Second
+
+/*
+ * Tracks the goroutines a worker spawns for each item it processes,
+ * so it can wait for them before returning on shutdown,
+ * instead of leaving their transactions half-way through.
+ */
+type inFlight struct {
+	wg    sync.WaitGroup
+	count atomic.Int64
+}
+
+func (f *inFlight) Go(fn func()) {
+	f.wg.Add(1)
+	f.count.Add(1)
+
+	go func() {
+		defer f.wg.Done()
+		defer f.count.Add(-1)
+		fn()
+	}()
+}
+
+/*
+ * Waits for the in-flight goroutines to finish, up to the given timeout.
+ * Returns how many were running when the drain started,
+ * and whether all of them finished in time.
+ */
+func (f *inFlight) Drain(timeout time.Duration) (int64, bool) {
+	pending := f.count.Load()
+	done := make(chan struct{})
+
+	go func() {
+		f.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return pending, true
+	case <-time.After(timeout):
+		return pending, false
+	}
+}
@@ -0,0 +1,42 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test__InFlight_Drain(t *testing.T) {
+	t.Run("waits for running goroutines", func(t *testing.T) {
+		f := inFlight{}
+		finished := false
+		f.Go(func() {
+			time.Sleep(50 * time.Millisecond)
+			finished = true
+		})
+
+		drained, ok := f.Drain(time.Second)
+		assert.True(t, ok)
+		assert.Equal(t, int64(1), drained)
+		assert.True(t, finished)
+	})
+
+	t.Run("gives up after the timeout", func(t *testing.T) {
+		f := inFlight{}
+		release := make(chan struct{})
+		defer close(release)
+		f.Go(func() { <-release })
+
+		drained, ok := f.Drain(10 * time.Millisecond)
+		assert.False(t, ok)
+		assert.Equal(t, int64(1), drained)
+	})
+
+	t.Run("nothing running", func(t *testing.T) {
+		f := inFlight{}
+		drained, ok := f.Drain(time.Second)
+		assert.True(t, ok)
+		assert.Zero(t, drained)
+	})
+}
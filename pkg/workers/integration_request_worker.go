@@ -106,6 +106,7 @@ func (w *IntegrationRequestWorker) syncIntegration(tx *gorm.DB, request *models.
 	}
 
 	integrationCtx := contexts.NewIntegrationContext(tx, nil, instance, w.encryptor, w.registry)
+	stateBefore, descriptionBefore := instance.State, instance.StateDescription
 	syncErr := integration.Sync(core.SyncContext{
 		Logger:          logging.ForIntegration(*instance),
 		HTTP:            w.registry.HTTPContext(),
@@ -118,10 +119,21 @@ func (w *IntegrationRequestWorker) syncIntegration(tx *gorm.DB, request *models.
 	})
 
 	if syncErr != nil {
-		instance.State = models.IntegrationStateError
-		instance.StateDescription = fmt.Sprintf("Sync failed: %v", syncErr)
+		if err := w.handleSyncFailure(instance, integrationCtx, syncErr); err != nil {
+			return err
+		}
 	} else {
+		//
+		// If the integration was put in the error state by previous failures,
+		// and the sync did not update the state, it is ready again.
+		//
+		stateUnchanged := instance.State == stateBefore && instance.StateDescription == descriptionBefore
+		if instance.SyncFailureCount > 0 && stateUnchanged && instance.State == models.IntegrationStateError {
+			instance.State = models.IntegrationStateReady
+		}
+
 		instance.StateDescription = ""
+		instance.ResetSyncFailures()
 	}
 
 	if err := tx.Save(instance).Error; err != nil {
@@ -131,6 +143,29 @@ func (w *IntegrationRequestWorker) syncIntegration(tx *gorm.DB, request *models.
 	return request.Complete(tx)
 }
 
+/*
+ * Integrations that never synced successfully go into the error state right away,
+ * since the failure is most likely caused by their configuration.
+ * Ready integrations only go into the error state after a number of consecutive failures,
+ * so a single transient failure does not affect the nodes using them.
+ * In both cases, the sync is retried with exponential backoff.
+ */
+func (w *IntegrationRequestWorker) handleSyncFailure(instance *models.Integration, integrationCtx *contexts.IntegrationContext, syncErr error) error {
+	instance.RecordSyncFailure(syncErr)
+	w.log("Sync for integration %s failed %d times in a row: %v", instance.ID, instance.SyncFailureCount, syncErr)
+
+	if instance.State != models.IntegrationStateReady || instance.SyncFailureCount >= models.IntegrationSyncFailuresBeforeError {
+		instance.State = models.IntegrationStateError
+		instance.StateDescription = fmt.Sprintf("Sync failed: %v", syncErr)
+	}
+
+	if err := integrationCtx.ScheduleResync(instance.SyncBackoff()); err != nil {
+		return fmt.Errorf("failed to schedule sync retry: %v", err)
+	}
+
+	return nil
+}
+
 func (w *IntegrationRequestWorker) invokeIntegrationAction(tx *gorm.DB, request *models.IntegrationRequest) error {
 	integration, err := models.FindUnscopedIntegrationInTransaction(tx, request.AppInstallationID)
 	if err != nil {
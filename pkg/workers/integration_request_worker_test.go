@@ -210,3 +210,76 @@ func Test__AppInstallationRequestWorker_InvokeActionError(t *testing.T) {
 	assert.Equal(t, models.IntegrationRequestStateCompleted, request.State)
 	assert.True(t, actionCalled)
 }
+
+func Test__IntegrationRequestWorker_SyncErrorBackoff(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	worker := NewIntegrationRequestWorker(r.Encryptor, r.Registry, nil, "http://localhost:8000", "http://localhost:8000")
+
+	//
+	// Register a dummy application whose sync fails until told otherwise.
+	//
+	failing := true
+	r.Registry.Integrations["dummy"] = support.NewDummyIntegration(support.DummyIntegrationOptions{
+		OnSync: func(ctx core.SyncContext) error {
+			if failing {
+				return errors.New("sync failed")
+			}
+
+			return nil
+		},
+	})
+
+	integration, err := models.CreateIntegration(uuid.New(), r.Organization.ID, "dummy", support.RandomName("integration"), nil)
+	require.NoError(t, err)
+	integration.State = models.IntegrationStateReady
+	require.NoError(t, database.Conn().Save(integration).Error)
+
+	runAt := time.Now().Add(-time.Second)
+	require.NoError(t, integration.CreateSyncRequest(database.Conn(), &runAt))
+
+	processNextSync := func() *models.Integration {
+		request, err := models.FindPendingRequestForIntegration(database.Conn(), integration.ID)
+		require.NoError(t, err)
+		require.NoError(t, worker.LockAndProcessRequest(*request))
+
+		integration, err := models.FindIntegration(r.Organization.ID, integration.ID)
+		require.NoError(t, err)
+		return integration
+	}
+
+	//
+	// Ready integration stays ready until the failures pile up,
+	// with each retry scheduled after the backoff.
+	//
+	for i := 1; i < models.IntegrationSyncFailuresBeforeError; i++ {
+		updated := processNextSync()
+		assert.Equal(t, models.IntegrationStateReady, updated.State)
+		assert.Equal(t, i, updated.SyncFailureCount)
+		require.NotNil(t, updated.LastSyncError)
+		assert.Equal(t, "sync failed", *updated.LastSyncError)
+		require.NotNil(t, updated.LastSyncFailedAt)
+
+		next, err := models.FindPendingRequestForIntegration(database.Conn(), integration.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.IntegrationRequestTypeSync, next.Type)
+		assert.WithinDuration(t, updated.LastSyncFailedAt.Add(updated.SyncBackoff()), next.RunAt, 5*time.Second)
+	}
+
+	updated := processNextSync()
+	assert.Equal(t, models.IntegrationStateError, updated.State)
+	assert.Equal(t, models.IntegrationSyncFailuresBeforeError, updated.SyncFailureCount)
+	assert.Contains(t, updated.StateDescription, "Sync failed: sync failed")
+
+	//
+	// A successful sync resets the failures and the state.
+	//
+	failing = false
+	updated = processNextSync()
+	assert.Equal(t, models.IntegrationStateReady, updated.State)
+	assert.Empty(t, updated.StateDescription)
+	assert.Equal(t, 0, updated.SyncFailureCount)
+	assert.Nil(t, updated.LastSyncError)
+	assert.Nil(t, updated.LastSyncFailedAt)
+}
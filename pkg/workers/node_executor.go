@@ -6,19 +6,23 @@ import (
 	"fmt"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/semaphore"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/jwt"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/oidc"
 	"github.com/superplanehq/superplane/pkg/registry"
 	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
@@ -26,6 +30,19 @@ import (
 
 var ErrRecordLocked = errors.New("record locked")
 
+/*
+ * Executions failing with a core.RetryableError are attempted again,
+ * with exponential backoff, up to MaxExecutionRetries times,
+ * unless the node configures its own core.RetryPolicy.
+ */
+const (
+	MaxExecutionRetries     = 5
+	ExecutionRetryBaseDelay = 10 * time.Second
+	ExecutionRetryMaxDelay  = 5 * time.Minute
+)
+
+const executeSavePoint = "component_execute"
+
 type NodeExecutor struct {
 	encryptor      crypto.Encryptor
 	registry       *registry.Registry
@@ -33,16 +50,35 @@ type NodeExecutor struct {
 	webhookBaseURL string
 	semaphore      *semaphore.Weighted
 	logger         *logrus.Entry
+	oidcProvider   oidc.Provider
+	authService    authorization.Authorization
+	jwtSigner      *jwt.Signer
+
+	//
+	// Configurations for the first node of blueprint executions
+	// are built from the same templates over and over again,
+	// so they are memoized for the lifetime of the process.
+	//
+	configurationCache *contexts.ConfigurationCache
+
+	inFlight        inFlight
+	shutdownTimeout time.Duration
 }
 
-func NewNodeExecutor(encryptor crypto.Encryptor, registry *registry.Registry, baseURL string, webhookBaseURL string) *NodeExecutor {
+func NewNodeExecutor(encryptor crypto.Encryptor, registry *registry.Registry, oidcProvider oidc.Provider, authService authorization.Authorization, jwtSigner *jwt.Signer, baseURL string, webhookBaseURL string) *NodeExecutor {
 	return &NodeExecutor{
-		encryptor:      encryptor,
-		registry:       registry,
-		baseURL:        baseURL,
-		webhookBaseURL: webhookBaseURL,
-		semaphore:      semaphore.NewWeighted(25),
-		logger:         logrus.WithFields(logrus.Fields{"worker": "NodeExecutor"}),
+		authService:     authService,
+		jwtSigner:       jwtSigner,
+		encryptor:       encryptor,
+		registry:        registry,
+		oidcProvider:    oidcProvider,
+		baseURL:         baseURL,
+		webhookBaseURL:  webhookBaseURL,
+		semaphore:       semaphore.NewWeighted(25),
+		logger:          logrus.WithFields(logrus.Fields{"worker": "NodeExecutor"}),
+		shutdownTimeout: DefaultShutdownTimeout,
+
+		configurationCache: contexts.NewConfigurationCache(contexts.DefaultConfigurationCacheSize),
 	}
 }
 
@@ -53,6 +89,7 @@ func (w *NodeExecutor) Start(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
+			w.drain()
 			return
 		case <-ticker.C:
 			tickStart := time.Now()
@@ -72,7 +109,7 @@ func (w *NodeExecutor) Start(ctx context.Context) {
 
 				messages.NewCanvasExecutionMessage(execution.WorkflowID.String(), execution.ID.String(), execution.NodeID).Publish()
 
-				go func(execution models.CanvasNodeExecution) {
+				w.inFlight.Go(func() {
 					defer w.semaphore.Release(1)
 
 					err := w.LockAndProcessNodeExecution(execution.ID)
@@ -86,7 +123,7 @@ func (w *NodeExecutor) Start(ctx context.Context) {
 					}
 
 					w.logger.Errorf("Error processing node execution - node=%s, execution=%s: %v", execution.NodeID, execution.ID, err)
-				}(execution)
+				})
 			}
 
 			telemetry.RecordExecutorWorkerTickDuration(context.Background(), time.Since(tickStart))
@@ -94,8 +131,28 @@ func (w *NodeExecutor) Start(ctx context.Context) {
 	}
 }
 
+func (w *NodeExecutor) drain() {
+	drained, ok := w.inFlight.Drain(w.shutdownTimeout)
+	if !ok {
+		w.logger.Warnf("Shutdown timed out after %v with %d executions in flight", w.shutdownTimeout, drained)
+		return
+	}
+
+	w.logger.Infof("Shutting down - drained %d in-flight executions", drained)
+}
+
 func (w *NodeExecutor) LockAndProcessNodeExecution(id uuid.UUID) error {
-	return database.Conn().Transaction(func(tx *gorm.DB) error {
+	ctx, span := telemetry.StartNodeExecutionSpan(context.Background(), id.String())
+
+	//
+	// The logs are stored after the transaction finishes,
+	// so the logs of executions whose transaction is rolled back are not lost.
+	//
+	logSink := logging.NewExecutionLogSink()
+	defer w.flushExecutionLogs(logSink)
+
+	finished := &finishedExecutions{}
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
 		var execution models.CanvasNodeExecution
 
 		//
@@ -129,21 +186,78 @@ func (w *NodeExecutor) LockAndProcessNodeExecution(id uuid.UUID) error {
 			return ErrRecordLocked
 		}
 
-		return w.processNodeExecution(tx, &execution)
+		return finished.Track(&execution, func() error {
+			return w.processNodeExecution(ctx, tx, &execution, logSink)
+		})
 	})
+
+	if err == nil {
+		finished.Record()
+	}
+
+	switch {
+	case errors.Is(err, ErrRecordLocked):
+		telemetry.EndNodeExecutionSpan(span, telemetry.ResultSkipped, nil)
+	case err != nil:
+		telemetry.EndNodeExecutionSpan(span, telemetry.ResultError, err)
+	default:
+		telemetry.EndNodeExecutionSpan(span, telemetry.ResultSuccess, nil)
+	}
+
+	return err
 }
 
-func (w *NodeExecutor) processNodeExecution(tx *gorm.DB, execution *models.CanvasNodeExecution) error {
+func (w *NodeExecutor) processNodeExecution(ctx context.Context, tx *gorm.DB, execution *models.CanvasNodeExecution, logSink *logging.ExecutionLogSink) error {
+	cancelled, err := w.cancelIfParentCancelled(tx, execution)
+	if err != nil || cancelled {
+		return err
+	}
+
 	node, err := models.FindCanvasNode(tx, execution.WorkflowID, execution.NodeID)
 	if err != nil {
 		return err
 	}
 
+	ref := node.Ref.Data()
+	componentName := ""
+	if ref.Component != nil {
+		componentName = ref.Component.Name
+	}
+
+	telemetry.SetExecutionSpanAttributes(
+		trace.SpanFromContext(ctx),
+		execution.WorkflowID.String(),
+		execution.NodeID,
+		componentName,
+	)
+
 	if node.Type == models.NodeTypeBlueprint {
 		return w.executeBlueprintNode(tx, execution, node)
 	}
 
-	return w.executeComponentNode(tx, execution, node)
+	return w.executeComponentNode(ctx, tx, execution, node, logSink)
+}
+
+/*
+ * Child executions of a blueprint execution that is cancelled
+ * while they are being created are cancelled too, instead of executed.
+ */
+func (w *NodeExecutor) cancelIfParentCancelled(tx *gorm.DB, execution *models.CanvasNodeExecution) (bool, error) {
+	if execution.ParentExecutionID == nil {
+		return false, nil
+	}
+
+	parent, err := models.FindNodeExecutionInTransaction(tx, execution.WorkflowID, *execution.ParentExecutionID)
+	if err != nil {
+		return false, err
+	}
+
+	if parent.State != models.CanvasNodeExecutionStateFinished || parent.Result != models.CanvasNodeExecutionResultCancelled {
+		return false, nil
+	}
+
+	w.logger.Infof("Parent execution %s was cancelled - cancelling %s", parent.ID, execution.ID)
+	return true, execution.CancelInTransaction(tx, parent.CancelledBy)
 }
 
 func (w *NodeExecutor) executeBlueprintNode(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
@@ -179,7 +293,8 @@ func (w *NodeExecutor) executeBlueprintNode(tx *gorm.DB, execution *models.Canva
 		WithRootEvent(&execution.RootEventID).
 		WithPreviousExecution(&execution.ID).
 		ForBlueprintNode(node).
-		WithInput(map[string]any{inputEvent.NodeID: input})
+		WithInput(map[string]any{inputEvent.NodeID: input}).
+		WithCache(w.configurationCache)
 
 	configFields, err := w.configurationFieldsForBlueprintNode(tx, *firstNode)
 	if err != nil {
@@ -241,11 +356,11 @@ func (w *NodeExecutor) configurationFieldsForBlueprintNode(tx *gorm.DB, node mod
 	}
 }
 
-func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
-	logger := logging.WithExecution(
-		logging.WithNode(w.logger, *node),
-		execution,
-		nil,
+func (w *NodeExecutor) executeComponentNode(spanCtx context.Context, tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode, logSink *logging.ExecutionLogSink) error {
+	logSink.Bind(execution)
+	logger := logging.WithSink(
+		logging.WithExecution(logging.WithNode(w.logger, *node), execution, nil),
+		logSink,
 	)
 
 	err := execution.StartInTransaction(tx)
@@ -269,6 +384,15 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 
 	input := inputEvent.Data.Data()
 
+	//
+	// Components declaring the input they expect
+	// fail here, instead of somewhere inside Execute().
+	//
+	if err := validateComponentInput(component, input); err != nil {
+		logger.Errorf("invalid input for component %s: %v", ref.Component.Name, err)
+		return execution.FailInTransaction(tx, models.CanvasNodeExecutionResultReasonError, err.Error())
+	}
+
 	workflow, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, node.WorkflowID)
 	if err != nil {
 		logger.Errorf("failed to find workflow: %v", err)
@@ -284,16 +408,22 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		BaseURL:        w.baseURL,
 		Configuration:  execution.Configuration.Data(),
 		Data:           input,
-		HTTP:           w.registry.HTTPContext(),
 		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
+		Attachments:    contexts.NewExecutionAttachmentContext(tx, execution),
 		NodeMetadata:   contexts.NewNodeMetadataContext(tx, node),
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
-		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
+		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, w.authService, nil),
 		Notifications:  contexts.NewNotificationContext(tx, workflow.OrganizationID, execution.WorkflowID),
-		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor, w.registry.HTTPContext()),
 		Webhook:        contexts.NewNodeWebhookContext(context.Background(), tx, w.encryptor, node, w.webhookBaseURL),
+		OIDC:           w.oidcProvider,
 	}
+
+	if w.jwtSigner != nil {
+		ctx.ApprovalLinks = contexts.NewApprovalLinkContext(w.jwtSigner, w.baseURL, execution.ID)
+	}
+
 	ctx.ExpressionEnv = func(expression string) (map[string]any, error) {
 		builder := contexts.NewNodeConfigurationBuilder(tx, execution.WorkflowID).
 			WithNodeID(node.NodeID).
@@ -322,13 +452,124 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 	}
 
 	ctx.Logger = logger
-	if err := component.Execute(ctx); err != nil {
-		logger.Errorf("failed to execute component: %v", err)
-		err = execution.FailInTransaction(tx, models.CanvasNodeExecutionResultReasonError, err.Error())
-		return err
+	call := telemetry.ComponentCall{
+		WorkflowID: ctx.WorkflowID,
+		NodeID:     ctx.NodeID,
+		Component:  ref.Component.Name,
+		Operation:  telemetry.ComponentOperationExecute,
+	}
+
+	//
+	// Writes made by an attempt that is retried are rolled back,
+	// so the next attempt starts from the same state as this one.
+	//
+	beforeExecute := *execution
+	err = tx.SavePoint(executeSavePoint).Error
+	if err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
+	}
+
+	err = telemetry.TraceComponentCall(spanCtx, call, func(callCtx context.Context) error {
+		ctx.HTTP = w.registry.HTTPContext().WithContext(callCtx)
+		return component.Execute(ctx)
+	})
+
+	if err != nil {
+		if core.IsRetryable(err) {
+			if rollbackErr := tx.RollbackTo(executeSavePoint).Error; rollbackErr != nil {
+				return fmt.Errorf("failed to roll back execution attempt: %w", rollbackErr)
+			}
+
+			*execution = beforeExecute
+		}
+
+		return w.handleExecutionError(tx, logger, execution, err)
 	}
 
 	logger.Info("Component executed successfully")
 
 	return tx.Save(execution).Error
 }
+
+func validateComponentInput(component core.Component, input any) error {
+	provider, ok := component.(core.InputSchemaProvider)
+	if !ok {
+		return nil
+	}
+
+	schema := provider.InputSchema()
+	if schema == nil {
+		return nil
+	}
+
+	if err := schema.Validate(input); err != nil {
+		return fmt.Errorf("input does not match the schema of component %s: %w", component.Name(), err)
+	}
+
+	return nil
+}
+
+func (w *NodeExecutor) handleExecutionError(tx *gorm.DB, logger *logrus.Entry, execution *models.CanvasNodeExecution, err error) error {
+	if !core.IsRetryable(err) {
+		logger.Errorf("failed to execute component: %v", err)
+		return execution.FailPermanentlyInTransaction(tx, models.CanvasNodeExecutionResultReasonError, err.Error())
+	}
+
+	attempts := execution.RetryCount + 1
+	if recordErr := execution.RecordAttemptsInTransaction(tx, attempts); recordErr != nil {
+		return fmt.Errorf("failed to record execution attempts: %w", recordErr)
+	}
+
+	policy := executionRetryPolicy(logger, execution)
+	if attempts >= policy.MaxAttempts {
+		logger.Errorf("failed to execute component after %d attempts: %v", attempts, err)
+		return execution.FailPermanentlyInTransaction(tx, models.CanvasNodeExecutionResultReasonError, err.Error())
+	}
+
+	delay := policy.Delay(execution.RetryCount, core.RetryDelay(err))
+	logger.Warnf("failed to execute component, retrying in %s (attempt %d/%d): %v", delay, attempts+1, policy.MaxAttempts, err)
+	return execution.RescheduleInTransaction(tx, time.Now().Add(delay), err.Error())
+}
+
+/*
+ * The retry policy of the node overrides the global defaults.
+ * An invalid policy is ignored, since the configuration
+ * was already validated when the canvas was saved.
+ */
+func executionRetryPolicy(logger *logrus.Entry, execution *models.CanvasNodeExecution) core.RetryPolicy {
+	policy := core.RetryPolicy{
+		MaxAttempts: MaxExecutionRetries + 1,
+		BackoffBase: ExecutionRetryBaseDelay,
+		MaxBackoff:  ExecutionRetryMaxDelay,
+	}
+
+	configured, err := core.RetryPolicyFromConfiguration(execution.Configuration.Data())
+	if err != nil {
+		logger.Warnf("ignoring invalid retry policy: %v", err)
+		return policy
+	}
+
+	if configured == nil {
+		return policy
+	}
+
+	if configured.MaxAttempts > 0 {
+		policy.MaxAttempts = configured.MaxAttempts
+	}
+
+	if configured.BackoffBase > 0 {
+		policy.BackoffBase = configured.BackoffBase
+	}
+
+	if configured.MaxBackoff > 0 {
+		policy.MaxBackoff = configured.MaxBackoff
+	}
+
+	return policy
+}
+
+func (w *NodeExecutor) flushExecutionLogs(logSink *logging.ExecutionLogSink) {
+	if err := logSink.Flush(database.Conn()); err != nil {
+		w.logger.Errorf("Error storing execution logs: %v", err)
+	}
+}
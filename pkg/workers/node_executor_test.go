@@ -1,13 +1,29 @@
 package workers
 
 import (
+	"context"
+	"errors"
+	"io"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/components/noop"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/canvases"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/test/support"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"gorm.io/datatypes"
 )
 
@@ -56,12 +72,12 @@ func Test__NodeExecutor_PreventsConcurrentProcessing(t *testing.T) {
 	// Create two workers and have them try to process the execution concurrently.
 	//
 	go func() {
-		executor1 := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
+		executor1 := NewNodeExecutor(r.Encryptor, r.Registry, support.NewOIDCProvider(), r.AuthService, nil, "http://localhost", "http://localhost")
 		results <- executor1.LockAndProcessNodeExecution(execution.ID)
 	}()
 
 	go func() {
-		executor2 := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
+		executor2 := NewNodeExecutor(r.Encryptor, r.Registry, support.NewOIDCProvider(), r.AuthService, nil, "http://localhost", "http://localhost")
 		results <- executor2.LockAndProcessNodeExecution(execution.ID)
 	}()
 
@@ -146,7 +162,7 @@ func Test__NodeExecutor_BlueprintNodeExecution(t *testing.T) {
 	// Process the execution and verify the blueprint node creates a child execution
 	// and moves the parent execution to started state.
 	//
-	executor := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, support.NewOIDCProvider(), r.AuthService, nil, "http://localhost", "http://localhost")
 	err := executor.LockAndProcessNodeExecution(execution.ID)
 	require.NoError(t, err)
 
@@ -169,6 +185,111 @@ func Test__NodeExecutor_BlueprintNodeExecution(t *testing.T) {
 	assert.Equal(t, &execution.ID, childExecutions[0].ParentExecutionID)
 }
 
+func Test__NodeExecutor_CancellingBlueprintExecutionCancelsChildren(t *testing.T) {
+	r := support.Setup(t)
+
+	blueprint := support.CreateBlueprint(
+		t,
+		r.Organization.ID,
+		[]models.Node{
+			{
+				ID:   "noop1",
+				Type: models.NodeTypeComponent,
+				Ref:  models.NodeRef{Component: &models.ComponentRef{Name: "noop"}},
+			},
+		},
+		[]models.Edge{},
+		[]models.BlueprintOutputChannel{
+			{
+				Name:              "default",
+				NodeID:            "noop1",
+				NodeOutputChannel: "default",
+			},
+		},
+	)
+
+	triggerNode := "trigger-1"
+	blueprintNode := "blueprint-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: blueprintNode,
+				Type:   models.NodeTypeBlueprint,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Blueprint: &models.BlueprintRef{ID: blueprint.ID.String()}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: blueprintNode, Channel: "default"},
+		},
+	)
+
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, support.NewOIDCProvider(), r.AuthService, nil, "http://localhost", "http://localhost")
+	startBlueprintExecution := func(t *testing.T) (*models.CanvasNodeExecution, *models.CanvasNodeExecution) {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, blueprintNode, rootEvent.ID, rootEvent.ID, nil)
+		require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+		children, err := models.FindChildExecutions(execution.ID, []string{models.CanvasNodeExecutionStatePending})
+		require.NoError(t, err)
+		require.Len(t, children, 1)
+		return execution, &children[0]
+	}
+
+	assertCancelled := func(t *testing.T, id uuid.UUID) {
+		execution, err := models.FindNodeExecution(canvas.ID, id)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateFinished, execution.State)
+		assert.Equal(t, models.CanvasNodeExecutionResultCancelled, execution.Result)
+	}
+
+	t.Run("cancelling the parent cancels its pending and started children", func(t *testing.T) {
+		parent, pendingChild := startBlueprintExecution(t)
+		startedChild := support.CreateCanvasNodeExecution(t, canvas.ID, pendingChild.NodeID, parent.RootEventID, parent.EventID, &parent.ID)
+		require.NoError(t, startedChild.StartInTransaction(database.Conn()))
+
+		_, err := canvases.CancelExecution(context.Background(), r.AuthService, r.Encryptor, r.Organization.ID.String(), r.Registry, canvas.ID, parent.ID)
+		require.NoError(t, err)
+
+		assertCancelled(t, parent.ID)
+		assertCancelled(t, pendingChild.ID)
+		assertCancelled(t, startedChild.ID)
+	})
+
+	t.Run("finished children are left untouched", func(t *testing.T) {
+		parent, child := startBlueprintExecution(t)
+		_, err := child.PassInTransaction(database.Conn(), map[string][]any{})
+		require.NoError(t, err)
+
+		_, err = canvases.CancelExecution(context.Background(), r.AuthService, r.Encryptor, r.Organization.ID.String(), r.Registry, canvas.ID, parent.ID)
+		require.NoError(t, err)
+
+		assertCancelled(t, parent.ID)
+		child, err = models.FindNodeExecution(canvas.ID, child.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionResultPassed, child.Result)
+	})
+
+	t.Run("pending child of a cancelled parent is cancelled instead of executed", func(t *testing.T) {
+		parent, child := startBlueprintExecution(t)
+		require.NoError(t, parent.CancelInTransaction(database.Conn(), &r.User))
+
+		require.NoError(t, executor.LockAndProcessNodeExecution(child.ID))
+		assertCancelled(t, child.ID)
+
+		child, err := models.FindNodeExecution(canvas.ID, child.ID)
+		require.NoError(t, err)
+		assert.Equal(t, &r.User, child.CancelledBy)
+	})
+}
+
 func Test__NodeExecutor_ComponentNodeWithoutStateChange(t *testing.T) {
 	r := support.Setup(t)
 
@@ -224,7 +345,7 @@ func Test__NodeExecutor_ComponentNodeWithoutStateChange(t *testing.T) {
 	// Process the execution and verify the execution is started but NOT finished.
 	// The approval component doesn't call Pass() in Execute(), so it should remain in started state.
 	//
-	executor := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, support.NewOIDCProvider(), r.AuthService, nil, "http://localhost", "http://localhost")
 	err = executor.LockAndProcessNodeExecution(execution.ID)
 	require.NoError(t, err)
 
@@ -249,6 +370,15 @@ func Test__NodeExecutor_ComponentNodeWithoutStateChange(t *testing.T) {
 			},
 		},
 	}, updatedExecution.Metadata.Data())
+
+	//
+	// Logs written during the execution are stored with it.
+	//
+	logs, err := models.ListNodeExecutionLogs(canvas.ID, execution.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, logs)
+	assert.Equal(t, "Component executed successfully", logs[len(logs)-1].Message)
+	assert.Equal(t, execution.ID.String(), logs[len(logs)-1].Fields.Data()["execution"])
 }
 
 func Test__NodeExecutor_ComponentNodeWithStateChange(t *testing.T) {
@@ -291,7 +421,7 @@ func Test__NodeExecutor_ComponentNodeWithStateChange(t *testing.T) {
 	// Process the execution and verify the execution is both started AND finished.
 	// The noop component calls Pass() in Execute(), which should finish the execution.
 	//
-	executor := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, support.NewOIDCProvider(), r.AuthService, nil, "http://localhost", "http://localhost")
 	err := executor.LockAndProcessNodeExecution(execution.ID)
 	require.NoError(t, err)
 
@@ -372,7 +502,7 @@ func Test__NodeExecutor_BlueprintNodeExecutionFailsWhenConfigurationCannotBeBuil
 	// LockAndProcessNodeExecution should not return an error,
 	// since this isn't a runtime error, but a configuration error.
 	//
-	executor := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost")
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, support.NewOIDCProvider(), r.AuthService, nil, "http://localhost", "http://localhost")
 	err := executor.LockAndProcessNodeExecution(execution.ID)
 	require.NoError(t, err)
 
@@ -399,3 +529,432 @@ func countConcurrentExecutionResults(t *testing.T, results []error) (successCoun
 	}
 	return successCount, lockedCount
 }
+
+func Test__NodeExecutor_RecordsComponentExecutionDuration(t *testing.T) {
+	r := support.Setup(t)
+
+	triggerNode := "trigger-1"
+	noopNode := "noop-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: noopNode,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: noopNode, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, noopNode, rootEvent.ID, rootEvent.ID, nil)
+
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, support.NewOIDCProvider(), r.AuthService, nil, "http://localhost", "http://localhost")
+	require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+	//
+	// The duration of the Execute() call is exported with the component name.
+	//
+	recorder := httptest.NewRecorder()
+	telemetry.MetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `superplane_component_execution_duration_seconds_count{component="noop",operation="execute",result="success"}`)
+}
+
+/*
+ * A noop component calling the given URL through its HTTP context.
+ */
+type httpCallingComponent struct {
+	noop.NoOp
+	url string
+}
+
+func (c *httpCallingComponent) Execute(ctx core.ExecutionContext) error {
+	request, err := http.NewRequestWithContext(ctx.HTTP.Context(), http.MethodGet, c.url, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := ctx.HTTP.Do(request)
+	if err != nil {
+		return err
+	}
+
+	_ = response.Body.Close()
+	return c.NoOp.Execute(ctx)
+}
+
+func Test__NodeExecutor_PropagatesTraceContextOnHTTPCalls(t *testing.T) {
+	r := support.Setup(t)
+	otel.SetTracerProvider(sdktrace.NewTracerProvider())
+
+	var traceparent atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent.Store(r.Header.Get("traceparent"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	r.Registry.Components["http-calling"] = &httpCallingComponent{url: server.URL}
+
+	triggerNode := "trigger-1"
+	httpNode := "http-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: httpNode,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "http-calling"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: httpNode, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, httpNode, rootEvent.ID, rootEvent.ID, nil)
+
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, support.NewOIDCProvider(), r.AuthService, nil, "http://localhost", "http://localhost")
+	require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+	//
+	// The request is sent with the span of the Execute() call.
+	//
+	assert.Regexp(t, `^00-[0-9a-f]{32}-[0-9a-f]{16}-01$`, traceparent.Load())
+
+	execution, err := models.FindNodeExecution(execution.WorkflowID, execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionResultPassed, execution.Result)
+}
+
+/*
+ * A noop component whose Execute always returns the given error,
+ * after storing the given metadata, if any.
+ */
+type failingComponent struct {
+	noop.NoOp
+	err      error
+	metadata any
+}
+
+func (c *failingComponent) Execute(ctx core.ExecutionContext) error {
+	if c.metadata != nil {
+		if err := ctx.Metadata.Set(c.metadata); err != nil {
+			return err
+		}
+	}
+
+	return c.err
+}
+
+func setupFailingComponentExecution(t *testing.T, r *support.ResourceRegistry, err error) *models.CanvasNodeExecution {
+	r.Registry.Components["failing"] = &failingComponent{err: err}
+
+	triggerNode := "trigger-1"
+	failingNode := "failing-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: failingNode,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "failing"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: failingNode, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+	return support.CreateCanvasNodeExecution(t, canvas.ID, failingNode, rootEvent.ID, rootEvent.ID, nil)
+}
+
+func Test__NodeExecutor_RetryableErrorReschedulesExecution(t *testing.T) {
+	r := support.Setup(t)
+	execution := setupFailingComponentExecution(t, r, core.Retryable(errors.New("rate exceeded")))
+
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, support.NewOIDCProvider(), r.AuthService, nil, "http://localhost", "http://localhost")
+	require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+	//
+	// The execution goes back to pending, and is not picked up before the next attempt.
+	//
+	execution, err := models.FindNodeExecution(execution.WorkflowID, execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionStatePending, execution.State)
+	assert.Equal(t, 1, execution.RetryCount)
+	assert.Equal(t, "rate exceeded", execution.ResultMessage)
+	require.NotNil(t, execution.NextAttemptAt)
+	assert.WithinDuration(t, time.Now().Add(ExecutionRetryBaseDelay), *execution.NextAttemptAt, 5*time.Second)
+
+	pending, err := models.ListPendingNodeExecutions()
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	//
+	// Once the retries are exhausted, the execution fails.
+	//
+	require.NoError(t, database.Conn().Model(execution).Update("retry_count", MaxExecutionRetries).Error)
+	require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+	execution, err = models.FindNodeExecution(execution.WorkflowID, execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionStateFinished, execution.State)
+	assert.Equal(t, models.CanvasNodeExecutionResultFailed, execution.Result)
+}
+
+func Test__NodeExecutor_RetryDiscardsAttemptWrites(t *testing.T) {
+	r := support.Setup(t)
+	execution := setupFailingComponentExecution(t, r, nil)
+	r.Registry.Components["failing"] = &failingComponent{
+		err:      core.Retryable(errors.New("rate exceeded")),
+		metadata: map[string]any{"step": "halfway"},
+	}
+
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, support.NewOIDCProvider(), r.AuthService, nil, "http://localhost", "http://localhost")
+	require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+	//
+	// The execution is rescheduled without the metadata written by the failed attempt.
+	//
+	execution, err := models.FindNodeExecution(execution.WorkflowID, execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionStatePending, execution.State)
+	assert.Equal(t, 1, execution.RetryCount)
+	assert.NotContains(t, execution.Metadata.Data(), "step")
+	assert.Equal(t, float64(1), execution.Metadata.Data()[models.ExecutionMetadataAttemptsKey])
+}
+
+func Test__NodeExecutor_RetryPolicy(t *testing.T) {
+	r := support.Setup(t)
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, support.NewOIDCProvider(), r.AuthService, nil, "http://localhost", "http://localhost")
+
+	withRetryPolicy := func(t *testing.T, execution *models.CanvasNodeExecution, maxAttempts int) {
+		config := map[string]any{
+			core.RetryPolicyFieldName: map[string]any{
+				"maxAttempts": maxAttempts,
+				"backoffBase": "1s",
+				"maxBackoff":  "2s",
+			},
+		}
+
+		require.NoError(t, database.Conn().Model(execution).Update("configuration", datatypes.NewJSONType(config)).Error)
+	}
+
+	t.Run("node with 3 attempts -> retried twice before failing", func(t *testing.T) {
+		execution := setupFailingComponentExecution(t, r, core.Retryable(errors.New("rate exceeded")))
+		withRetryPolicy(t, execution, 3)
+
+		for retry := 1; retry <= 2; retry++ {
+			require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+			execution, err := models.FindNodeExecution(execution.WorkflowID, execution.ID)
+			require.NoError(t, err)
+			assert.Equal(t, models.CanvasNodeExecutionStatePending, execution.State)
+			assert.Equal(t, retry, execution.RetryCount)
+			assert.Equal(t, float64(retry), execution.Metadata.Data()[models.ExecutionMetadataAttemptsKey])
+			require.NotNil(t, execution.NextAttemptAt)
+			assert.WithinDuration(t, time.Now().Add(time.Duration(retry)*time.Second), *execution.NextAttemptAt, time.Second)
+		}
+
+		require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+		execution, err := models.FindNodeExecution(execution.WorkflowID, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateFinished, execution.State)
+		assert.Equal(t, models.CanvasNodeExecutionResultFailed, execution.Result)
+		assert.Equal(t, 2, execution.RetryCount)
+		assert.Equal(t, float64(3), execution.Metadata.Data()[models.ExecutionMetadataAttemptsKey])
+	})
+
+	t.Run("node with 1 attempt -> fails immediately", func(t *testing.T) {
+		execution := setupFailingComponentExecution(t, r, core.Retryable(errors.New("rate exceeded")))
+		withRetryPolicy(t, execution, 1)
+
+		require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+		execution, err := models.FindNodeExecution(execution.WorkflowID, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateFinished, execution.State)
+		assert.Equal(t, models.CanvasNodeExecutionResultFailed, execution.Result)
+		assert.Equal(t, 0, execution.RetryCount)
+		assert.Equal(t, float64(1), execution.Metadata.Data()[models.ExecutionMetadataAttemptsKey])
+	})
+
+	t.Run("non-retryable error -> policy is ignored", func(t *testing.T) {
+		execution := setupFailingComponentExecution(t, r, errors.New("invalid configuration"))
+		withRetryPolicy(t, execution, 3)
+
+		require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+		execution, err := models.FindNodeExecution(execution.WorkflowID, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateFinished, execution.State)
+		assert.Equal(t, models.CanvasNodeExecutionResultFailed, execution.Result)
+		assert.Equal(t, 0, execution.RetryCount)
+		assert.NotContains(t, execution.Metadata.Data(), models.ExecutionMetadataAttemptsKey)
+	})
+}
+
+func Test__NodeExecutor_PermanentErrorFailsExecution(t *testing.T) {
+	r := support.Setup(t)
+	err := core.Permanent(core.Retryable(errors.New("invalid configuration")))
+	execution := setupFailingComponentExecution(t, r, err)
+
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, support.NewOIDCProvider(), r.AuthService, nil, "http://localhost", "http://localhost")
+	require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+	execution, err = models.FindNodeExecution(execution.WorkflowID, execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionStateFinished, execution.State)
+	assert.Equal(t, models.CanvasNodeExecutionResultFailed, execution.Result)
+	assert.Equal(t, "invalid configuration", execution.ResultMessage)
+	assert.Equal(t, 0, execution.RetryCount)
+}
+
+/*
+ * A noop component expecting a ref in the input event data.
+ */
+type schemaComponent struct {
+	noop.NoOp
+	executed bool
+}
+
+func (c *schemaComponent) Name() string {
+	return "with-schema"
+}
+
+func (c *schemaComponent) InputSchema() *core.Schema {
+	return &core.Schema{
+		Type:     core.SchemaTypeObject,
+		Required: []string{"ref"},
+		Properties: map[string]*core.Schema{
+			"ref": {Type: core.SchemaTypeString},
+		},
+	}
+}
+
+func (c *schemaComponent) Execute(ctx core.ExecutionContext) error {
+	c.executed = true
+	return c.NoOp.Execute(ctx)
+}
+
+func Test__NodeExecutor_InputSchema(t *testing.T) {
+	r := support.Setup(t)
+	component := &schemaComponent{}
+	r.Registry.Components["with-schema"] = component
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "trigger-1",
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: "component-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "with-schema"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: "trigger-1", TargetID: "component-1", Channel: "default"},
+		},
+	)
+
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, support.NewOIDCProvider(), r.AuthService, nil, "http://localhost", "http://localhost")
+
+	t.Run("event missing a required field -> execution fails without executing", func(t *testing.T) {
+		component.executed = false
+		event := support.EmitCanvasEventForNodeWithData(t, canvas.ID, "trigger-1", "default", nil, map[string]any{"other": "value"})
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "component-1", event.ID, event.ID, nil)
+
+		require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+		execution, err := models.FindNodeExecution(execution.WorkflowID, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionStateFinished, execution.State)
+		assert.Equal(t, models.CanvasNodeExecutionResultFailed, execution.Result)
+		assert.Equal(t, "input does not match the schema of component with-schema: input.ref is required", execution.ResultMessage)
+		assert.False(t, component.executed)
+	})
+
+	t.Run("event matching the schema -> executed", func(t *testing.T) {
+		component.executed = false
+		event := support.EmitCanvasEventForNodeWithData(t, canvas.ID, "trigger-1", "default", nil, map[string]any{"ref": "main"})
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "component-1", event.ID, event.ID, nil)
+
+		require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+		execution, err := models.FindNodeExecution(execution.WorkflowID, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasNodeExecutionResultPassed, execution.Result)
+		assert.True(t, component.executed)
+	})
+}
+
+func Test__ExecutionRetryPolicy(t *testing.T) {
+	logger := logrus.NewEntry(logrus.New())
+	execution := func(config map[string]any) *models.CanvasNodeExecution {
+		return &models.CanvasNodeExecution{Configuration: datatypes.NewJSONType(config)}
+	}
+
+	t.Run("no retry policy -> global defaults", func(t *testing.T) {
+		policy := executionRetryPolicy(logger, execution(map[string]any{}))
+		assert.Equal(t, MaxExecutionRetries+1, policy.MaxAttempts)
+		assert.Equal(t, ExecutionRetryBaseDelay, policy.Delay(0, 0))
+		assert.Equal(t, 4*ExecutionRetryBaseDelay, policy.Delay(2, 0))
+		assert.Equal(t, ExecutionRetryMaxDelay, policy.Delay(10, 0))
+		assert.Equal(t, time.Minute, policy.Delay(0, time.Minute))
+	})
+
+	t.Run("retry policy overrides the defaults it sets", func(t *testing.T) {
+		policy := executionRetryPolicy(logger, execution(map[string]any{
+			core.RetryPolicyFieldName: map[string]any{"maxAttempts": float64(3), "backoffBase": "1s"},
+		}))
+
+		assert.Equal(t, 3, policy.MaxAttempts)
+		assert.Equal(t, time.Second, policy.Delay(0, 0))
+		assert.Equal(t, 4*time.Second, policy.Delay(2, 0))
+		assert.Equal(t, ExecutionRetryMaxDelay, policy.MaxBackoff)
+	})
+
+	t.Run("invalid retry policy -> global defaults", func(t *testing.T) {
+		policy := executionRetryPolicy(logger, execution(map[string]any{
+			core.RetryPolicyFieldName: map[string]any{"maxAttempts": float64(0)},
+		}))
+
+		assert.Equal(t, MaxExecutionRetries+1, policy.MaxAttempts)
+	})
+}
@@ -23,89 +23,237 @@ import (
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
 )
 
+/*
+ * Requests are processed as soon as the worker is notified they were created.
+ * Notifications can be missed while the worker is not listening,
+ * so pending requests are also swept periodically.
+ */
+const NodeRequestSweepInterval = 30 * time.Second
+
+/*
+ * Due requests are claimed in batches. If the worker dies before
+ * processing a claimed request, the request is returned to pending
+ * once its lease expires, and another worker picks it up.
+ */
+const (
+	NodeRequestClaimBatchSize = 100
+	NodeRequestClaimLease     = 5 * time.Minute
+)
+
 type NodeRequestWorker struct {
-	semaphore *semaphore.Weighted
-	registry  *registry.Registry
-	encryptor crypto.Encryptor
+	semaphore       *semaphore.Weighted
+	registry        *registry.Registry
+	encryptor       crypto.Encryptor
+	inFlight        inFlight
+	shutdownTimeout time.Duration
+	sweepInterval   time.Duration
+	batchSize       int
+	lease           time.Duration
+	wakeup          chan struct{}
 }
 
 func NewNodeRequestWorker(encryptor crypto.Encryptor, registry *registry.Registry) *NodeRequestWorker {
 	return &NodeRequestWorker{
-		encryptor: encryptor,
-		registry:  registry,
-		semaphore: semaphore.NewWeighted(25),
+		encryptor:       encryptor,
+		registry:        registry,
+		semaphore:       semaphore.NewWeighted(25),
+		shutdownTimeout: DefaultShutdownTimeout,
+		sweepInterval:   NodeRequestSweepInterval,
+		batchSize:       NodeRequestClaimBatchSize,
+		lease:           NodeRequestClaimLease,
+		wakeup:          make(chan struct{}, 1),
 	}
 }
 
 func (w *NodeRequestWorker) Start(ctx context.Context) {
-	ticker := time.NewTicker(1 * time.Second)
-	defer ticker.Stop()
+	go w.listen(ctx)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
+			w.drain()
 			return
-		case <-ticker.C:
-			tickStart := time.Now()
+		case <-w.wakeup:
+		case <-timer.C:
+			w.releaseExpiredClaims()
+		}
 
-			requests, err := models.ListNodeRequests()
-			if err != nil {
-				w.log("Error finding workflow nodes ready to be processed: %v", err)
-			}
+		w.processDueRequests()
+		timer.Reset(w.nextSweep())
+	}
+}
 
-			telemetry.RecordNodeRequestWorkerRequestsCount(context.Background(), len(requests))
+/*
+ * Wakes up the worker, if it is not already about to wake up.
+ */
+func (w *NodeRequestWorker) wake() {
+	select {
+	case w.wakeup <- struct{}{}:
+	default:
+	}
+}
 
-			for _, request := range requests {
-				if err := w.semaphore.Acquire(context.Background(), 1); err != nil {
-					w.log("Error acquiring semaphore: %v", err)
-					continue
-				}
+func (w *NodeRequestWorker) listen(ctx context.Context) {
+	for {
+		//
+		// Requests created while we were not listening are picked up
+		// by waking up as soon as we are listening again.
+		//
+		err := database.Listen(ctx, models.NodeRequestsChannel, w.wake, func(string) { w.wake() })
+		if ctx.Err() != nil {
+			return
+		}
 
-				go func(request models.CanvasNodeRequest) {
-					defer w.semaphore.Release(1)
+		w.log("Error listening for new requests: %v - retrying", err)
 
-					if err := w.LockAndProcessRequest(request); err != nil {
-						w.log("Error processing request %s: %v", request.ID, err)
-					}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
 
-					if request.ExecutionID != nil {
-						messages.NewCanvasExecutionMessage(request.WorkflowID.String(), request.ExecutionID.String(), request.NodeID).Publish()
-					}
-				}(request)
-			}
+/*
+ * Requests scheduled for the future do not notify the worker when they are due,
+ * so the next sweep happens when the next one is due, if that is sooner.
+ */
+func (w *NodeRequestWorker) nextSweep() time.Duration {
+	nextRunAt, err := models.FindNextNodeRequestRunAt()
+	if err != nil {
+		w.log("Error finding next scheduled request: %v", err)
+		return w.sweepInterval
+	}
+
+	return nextSweepIn(time.Now(), nextRunAt, w.sweepInterval)
+}
+
+func nextSweepIn(now time.Time, nextRunAt *time.Time, interval time.Duration) time.Duration {
+	if nextRunAt == nil {
+		return interval
+	}
+
+	return max(min(nextRunAt.Sub(now), interval), 0)
+}
+
+func (w *NodeRequestWorker) releaseExpiredClaims() {
+	released, err := models.ReleaseExpiredNodeRequestClaims()
+	if err != nil {
+		w.log("Error releasing expired claims: %v", err)
+		return
+	}
+
+	if released > 0 {
+		w.log("Released %d requests with expired claims", released)
+	}
+}
+
+func (w *NodeRequestWorker) processDueRequests() {
+	tickStart := time.Now()
+
+	requests, err := models.ClaimNodeRequests(w.batchSize, w.lease)
+	if err != nil {
+		w.log("Error claiming requests ready to be processed: %v", err)
+	}
+
+	telemetry.RecordNodeRequestWorkerRequestsCount(context.Background(), len(requests))
+
+	//
+	// A full batch means more requests might be due,
+	// so we claim the next batch right away.
+	//
+	if len(requests) == w.batchSize {
+		w.wake()
+	}
 
-			telemetry.RecordNodeRequestWorkerTickDuration(context.Background(), time.Since(tickStart))
+	for _, request := range requests {
+		if err := w.semaphore.Acquire(context.Background(), 1); err != nil {
+			w.log("Error acquiring semaphore: %v", err)
+			continue
 		}
+
+		w.inFlight.Go(func() {
+			defer w.semaphore.Release(1)
+
+			if err := w.LockAndProcessRequest(request); err != nil {
+				w.log("Error processing request %s: %v", request.ID, err)
+				w.releaseClaim(request)
+			}
+
+			if request.ExecutionID != nil {
+				messages.NewCanvasExecutionMessage(request.WorkflowID.String(), request.ExecutionID.String(), request.NodeID).Publish()
+			}
+		})
+	}
+
+	telemetry.RecordNodeRequestWorkerTickDuration(context.Background(), time.Since(tickStart))
+}
+
+/*
+ * Failed requests are attempted again on the next sweep,
+ * instead of waiting for their lease to expire.
+ */
+func (w *NodeRequestWorker) releaseClaim(request models.CanvasNodeRequest) {
+	if err := request.ReleaseClaim(); err != nil {
+		w.log("Error releasing claim for request %s: %v", request.ID, err)
 	}
 }
 
+func (w *NodeRequestWorker) drain() {
+	drained, ok := w.inFlight.Drain(w.shutdownTimeout)
+	if !ok {
+		w.log("Shutdown timed out after %v with %d requests in flight", w.shutdownTimeout, drained)
+		return
+	}
+
+	w.log("Shutting down - drained %d in-flight requests", drained)
+}
+
 func (w *NodeRequestWorker) LockAndProcessRequest(request models.CanvasNodeRequest) error {
-	return database.Conn().Transaction(func(tx *gorm.DB) error {
+	//
+	// The logs are stored after the transaction finishes,
+	// so the logs of actions whose transaction is rolled back are not lost.
+	//
+	logSink := logging.NewExecutionLogSink()
+	defer w.flushExecutionLogs(logSink)
+
+	finished := &finishedExecutions{}
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
 		r, err := models.LockNodeRequest(tx, request.ID)
 		if err != nil {
 			w.log("Request %s already being processed - skipping", request.ID)
 			return nil
 		}
 
-		return w.processRequest(tx, r)
+		return w.processRequest(tx, r, logSink, finished)
 	})
+
+	if err != nil {
+		return err
+	}
+
+	finished.Record()
+	return nil
 }
 
-func (w *NodeRequestWorker) processRequest(tx *gorm.DB, request *models.CanvasNodeRequest) error {
+func (w *NodeRequestWorker) processRequest(tx *gorm.DB, request *models.CanvasNodeRequest, logSink *logging.ExecutionLogSink, finished *finishedExecutions) error {
 	switch request.Type {
 	case models.NodeRequestTypeInvokeAction:
-		return w.invokeAction(tx, request)
+		return w.invokeAction(tx, request, logSink, finished)
 	}
 
 	return fmt.Errorf("unsupported node execution request type %s", request.Type)
 }
 
-func (w *NodeRequestWorker) invokeAction(tx *gorm.DB, request *models.CanvasNodeRequest) error {
+func (w *NodeRequestWorker) invokeAction(tx *gorm.DB, request *models.CanvasNodeRequest, logSink *logging.ExecutionLogSink, finished *finishedExecutions) error {
 	if request.ExecutionID == nil {
 		return w.invokeTriggerAction(tx, request)
 	}
 
-	return w.invokeComponentAction(tx, request)
+	return w.invokeComponentAction(tx, request, logSink, finished)
 }
 
 func (w *NodeRequestWorker) invokeTriggerAction(tx *gorm.DB, request *models.CanvasNodeRequest) error {
@@ -168,20 +316,24 @@ func (w *NodeRequestWorker) invokeTriggerAction(tx *gorm.DB, request *models.Can
 	return request.Complete(tx)
 }
 
-func (w *NodeRequestWorker) invokeComponentAction(tx *gorm.DB, request *models.CanvasNodeRequest) error {
-	execution, err := models.FindNodeExecutionInTransaction(tx, request.WorkflowID, *request.ExecutionID)
+/*
+ * The execution is locked, so the action does not race with
+ * queue items being processed for the same execution.
+ */
+func (w *NodeRequestWorker) invokeComponentAction(tx *gorm.DB, request *models.CanvasNodeRequest, logSink *logging.ExecutionLogSink, finished *finishedExecutions) error {
+	execution, err := models.FindNodeExecutionForUpdateInTransaction(tx, request.WorkflowID, *request.ExecutionID)
 	if err != nil {
 		return fmt.Errorf("execution %s not found: %w", request.ExecutionID, err)
 	}
 
 	if execution.ParentExecutionID == nil {
-		return w.invokeParentNodeComponentAction(tx, request, execution)
+		return w.invokeParentNodeComponentAction(tx, request, execution, logSink, finished)
 	}
 
-	return w.invokeChildNodeComponentAction(tx, request, execution)
+	return w.invokeChildNodeComponentAction(tx, request, execution, logSink, finished)
 }
 
-func (w *NodeRequestWorker) invokeParentNodeComponentAction(tx *gorm.DB, request *models.CanvasNodeRequest, execution *models.CanvasNodeExecution) error {
+func (w *NodeRequestWorker) invokeParentNodeComponentAction(tx *gorm.DB, request *models.CanvasNodeRequest, execution *models.CanvasNodeExecution, logSink *logging.ExecutionLogSink, finished *finishedExecutions) error {
 	node, err := models.FindCanvasNode(tx, execution.WorkflowID, execution.NodeID)
 	if err != nil {
 		return fmt.Errorf("node not found: %w", err)
@@ -208,18 +360,20 @@ func (w *NodeRequestWorker) invokeParentNodeComponentAction(tx *gorm.DB, request
 		return fmt.Errorf("workflow not found: %w", err)
 	}
 
-	logger := logging.ForExecution(execution, nil)
+	logSink.Bind(execution)
+	logger := logging.WithSink(logging.ForExecution(execution, nil), logSink)
+
 	actionCtx := core.ActionContext{
 		Name:           actionName,
 		Configuration:  node.Configuration.Data(),
 		Parameters:     spec.InvokeAction.Parameters,
-		HTTP:           w.registry.HTTPContext(),
 		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
+		Attachments:    contexts.NewExecutionAttachmentContext(tx, execution),
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, node.WorkflowID),
 		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
-		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor, w.registry.HTTPContext()),
 	}
 
 	if node.AppInstallationID != nil {
@@ -233,7 +387,9 @@ func (w *NodeRequestWorker) invokeParentNodeComponentAction(tx *gorm.DB, request
 	}
 
 	actionCtx.Logger = logger
-	err = component.HandleAction(actionCtx)
+	err = finished.Track(execution, func() error {
+		return w.handleComponentAction(component, execution, actionCtx)
+	})
 	if err != nil {
 		return fmt.Errorf("action execution failed: %w", err)
 	}
@@ -246,7 +402,7 @@ func (w *NodeRequestWorker) invokeParentNodeComponentAction(tx *gorm.DB, request
 	return request.Complete(tx)
 }
 
-func (w *NodeRequestWorker) invokeChildNodeComponentAction(tx *gorm.DB, request *models.CanvasNodeRequest, execution *models.CanvasNodeExecution) error {
+func (w *NodeRequestWorker) invokeChildNodeComponentAction(tx *gorm.DB, request *models.CanvasNodeRequest, execution *models.CanvasNodeExecution, logSink *logging.ExecutionLogSink, finished *finishedExecutions) error {
 	parentExecution, err := models.FindNodeExecutionInTransaction(tx, execution.WorkflowID, *execution.ParentExecutionID)
 	if err != nil {
 		return fmt.Errorf("parent execution %s not found: %w", execution.ParentExecutionID, err)
@@ -289,21 +445,25 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(tx *gorm.DB, request
 		return fmt.Errorf("workflow not found: %w", err)
 	}
 
+	logSink.Bind(execution)
+
 	actionCtx := core.ActionContext{
 		Name:           actionName,
 		Configuration:  execution.Configuration.Data(),
 		Parameters:     spec.InvokeAction.Parameters,
-		Logger:         logging.ForExecution(execution, parentExecution),
-		HTTP:           w.registry.HTTPContext(),
+		Logger:         logging.WithSink(logging.ForExecution(execution, parentExecution), logSink),
 		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
+		Attachments:    contexts.NewExecutionAttachmentContext(tx, execution),
 		ExecutionState: contexts.NewExecutionStateContext(tx, execution),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, execution.WorkflowID),
 		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
-		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor, w.registry.HTTPContext()),
 	}
 
-	err = component.HandleAction(actionCtx)
+	err = finished.Track(execution, func() error {
+		return w.handleComponentAction(component, execution, actionCtx)
+	})
 	if err != nil {
 		return fmt.Errorf("action execution failed: %w", err)
 	}
@@ -316,6 +476,26 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(tx *gorm.DB, request
 	return request.Complete(tx)
 }
 
+func (w *NodeRequestWorker) handleComponentAction(component core.Component, execution *models.CanvasNodeExecution, actionCtx core.ActionContext) error {
+	call := telemetry.ComponentCall{
+		WorkflowID: execution.WorkflowID.String(),
+		NodeID:     execution.NodeID,
+		Component:  component.Name(),
+		Operation:  telemetry.ComponentOperationAction,
+	}
+
+	return telemetry.TraceComponentCall(context.Background(), call, func(callCtx context.Context) error {
+		actionCtx.HTTP = w.registry.HTTPContext().WithContext(callCtx)
+		return component.HandleAction(actionCtx)
+	})
+}
+
+func (w *NodeRequestWorker) flushExecutionLogs(logSink *logging.ExecutionLogSink) {
+	if err := logSink.Flush(database.Conn()); err != nil {
+		w.log("Error storing execution logs: %v", err)
+	}
+}
+
 func (w *NodeRequestWorker) log(format string, v ...any) {
 	log.Printf("[NodeRequestWorker] "+format, v...)
 }
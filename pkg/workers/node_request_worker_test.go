@@ -1,18 +1,25 @@
 package workers
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/components/noop"
 	"github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/triggers/schedule"
 	testconsumer "github.com/superplanehq/superplane/test/consumer"
 	"github.com/superplanehq/superplane/test/support"
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 )
 
 func Test__NodeRequestWorker_InvokeTriggerAction(t *testing.T) {
@@ -179,6 +186,106 @@ func Test__NodeRequestWorker_PreventsConcurrentProcessing(t *testing.T) {
 	assert.False(t, executionConsumer.HasReceivedMessage())
 }
 
+func Test__NodeRequestWorker_ClaimsAreLeased(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNode := "trigger-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "schedule"}}),
+				Configuration: datatypes.NewJSONType(map[string]interface{}{
+					"type":         "days",
+					"daysInterval": 1,
+					"hour":         12,
+					"minute":       0,
+				}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	request := models.CanvasNodeRequest{
+		ID:         uuid.New(),
+		WorkflowID: canvas.ID,
+		NodeID:     triggerNode,
+		Type:       models.NodeRequestTypeInvokeAction,
+		Spec: datatypes.NewJSONType(models.NodeExecutionRequestSpec{
+			InvokeAction: &models.InvokeAction{
+				ActionName: "emitEvent",
+				Parameters: map[string]interface{}{},
+			},
+		}),
+		State: models.NodeExecutionRequestStatePending,
+	}
+	require.NoError(t, database.Conn().Create(&request).Error)
+
+	//
+	// Two workers claim concurrently - only one of them gets the request.
+	//
+	claims := make(chan []models.CanvasNodeRequest, 2)
+	for range 2 {
+		go func() {
+			claimed, err := models.ClaimNodeRequests(NodeRequestClaimBatchSize, time.Minute)
+			assert.NoError(t, err)
+			claims <- claimed
+		}()
+	}
+
+	claimed := append(<-claims, <-claims...)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, request.ID, claimed[0].ID)
+	assert.Equal(t, models.NodeExecutionRequestStateClaimed, claimed[0].State)
+	require.NotNil(t, claimed[0].ClaimedUntil)
+
+	//
+	// The lease did not expire yet, so the claim is kept.
+	//
+	released, err := models.ReleaseExpiredNodeRequestClaims()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), released)
+
+	//
+	// The worker that claimed the request died, and the lease expired.
+	// The request is returned to pending, and claimed by another worker.
+	//
+	require.NoError(t, database.Conn().
+		Model(&models.CanvasNodeRequest{}).
+		Where("id = ?", request.ID).
+		Update("claimed_until", time.Now().Add(-time.Second)).
+		Error)
+
+	released, err = models.ReleaseExpiredNodeRequestClaims()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), released)
+
+	claimed, err = models.ClaimNodeRequests(NodeRequestClaimBatchSize, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, request.ID, claimed[0].ID)
+
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry)
+	require.NoError(t, worker.LockAndProcessRequest(claimed[0]))
+
+	var updatedRequest models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("id = ?", request.ID).First(&updatedRequest).Error)
+	assert.Equal(t, models.NodeExecutionRequestStateCompleted, updatedRequest.State)
+
+	//
+	// Completed requests are never processed again.
+	//
+	require.NoError(t, worker.LockAndProcessRequest(claimed[0]))
+	eventCount, err := models.CountCanvasEvents(canvas.ID, triggerNode)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), eventCount)
+}
+
 func Test__NodeRequestWorker_UnsupportedRequestType(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
@@ -411,6 +518,85 @@ func Test__NodeRequestWorker_NonExistentAction(t *testing.T) {
 	assert.False(t, executionConsumer.HasReceivedMessage())
 }
 
+type failingActionComponent struct {
+	noop.NoOp
+}
+
+func (c *failingActionComponent) Actions() []core.Action {
+	return []core.Action{{Name: "fail"}}
+}
+
+func (c *failingActionComponent) HandleAction(ctx core.ActionContext) error {
+	ctx.Logger.Info("Calling external service")
+	return errors.New("external service unavailable")
+}
+
+func Test__NodeRequestWorker_StoresLogsOfFailingActions(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+	r.Registry.Components["failing-action"] = &failingActionComponent{}
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry)
+
+	triggerNode := "trigger-1"
+	componentNode := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: componentNode,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "failing-action"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: componentNode, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+	execution := support.CreateNodeExecutionWithConfiguration(t, canvas.ID, componentNode, rootEvent.ID, rootEvent.ID, nil, map[string]any{})
+
+	request := models.CanvasNodeRequest{
+		ID:          uuid.New(),
+		WorkflowID:  canvas.ID,
+		NodeID:      componentNode,
+		ExecutionID: &execution.ID,
+		Type:        models.NodeRequestTypeInvokeAction,
+		Spec: datatypes.NewJSONType(models.NodeExecutionRequestSpec{
+			InvokeAction: &models.InvokeAction{
+				ActionName: "fail",
+				Parameters: map[string]any{},
+			},
+		}),
+		State: models.NodeExecutionRequestStatePending,
+	}
+	require.NoError(t, database.Conn().Create(&request).Error)
+
+	//
+	// The action fails, so the transaction processing it is rolled back,
+	// but the logs written by the action are still stored.
+	//
+	err := worker.LockAndProcessRequest(request)
+	require.ErrorContains(t, err, "external service unavailable")
+
+	logs, err := models.ListNodeExecutionLogs(canvas.ID, execution.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, logs)
+	assert.Equal(t, "Calling external service", logs[0].Message)
+	assert.Equal(t, execution.ID.String(), logs[0].Fields.Data()["execution"])
+
+	var updatedRequest models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("id = ?", request.ID).First(&updatedRequest).Error)
+	assert.Equal(t, models.NodeExecutionRequestStatePending, updatedRequest.State)
+}
+
 func Test__NodeRequestWorker_DoesNotProcessDeletedNodeRequests(t *testing.T) {
 	r := support.Setup(t)
 	defer r.Close()
@@ -468,9 +654,9 @@ func Test__NodeRequestWorker_DoesNotProcessDeletedNodeRequests(t *testing.T) {
 	require.NoError(t, database.Conn().Delete(&canvasNodes[0]).Error)
 
 	//
-	// Verify that ListNodeRequests does not return the request for the deleted node.
+	// Verify that ClaimNodeRequests does not claim the request for the deleted node.
 	//
-	requests, err := models.ListNodeRequests()
+	requests, err := models.ClaimNodeRequests(NodeRequestClaimBatchSize, NodeRequestClaimLease)
 	require.NoError(t, err)
 
 	// Check that our request is not in the list
@@ -481,7 +667,7 @@ func Test__NodeRequestWorker_DoesNotProcessDeletedNodeRequests(t *testing.T) {
 			break
 		}
 	}
-	assert.False(t, found, "Request for deleted node should not be returned by ListNodeRequests")
+	assert.False(t, found, "Request for deleted node should not be claimed by ClaimNodeRequests")
 
 	assert.False(t, executionConsumer.HasReceivedMessage())
 }
@@ -543,9 +729,9 @@ func Test__NodeRequestWorker_DoesNotProcessDeletedWorkflowRequests(t *testing.T)
 	require.NoError(t, database.Conn().Delete(&canvas).Error)
 
 	//
-	// Verify that ListNodeRequests does not return the request for the deleted workflow.
+	// Verify that ClaimNodeRequests does not claim the request for the deleted workflow.
 	//
-	requests, err := models.ListNodeRequests()
+	requests, err := models.ClaimNodeRequests(NodeRequestClaimBatchSize, NodeRequestClaimLease)
 	require.NoError(t, err)
 
 	// Check that our request is not in the list
@@ -556,7 +742,165 @@ func Test__NodeRequestWorker_DoesNotProcessDeletedWorkflowRequests(t *testing.T)
 			break
 		}
 	}
-	assert.False(t, found, "Request for deleted workflow should not be returned by ListNodeRequests")
+	assert.False(t, found, "Request for deleted workflow should not be claimed by ClaimNodeRequests")
 
 	assert.False(t, executionConsumer.HasReceivedMessage())
 }
+
+/*
+ * Schedule trigger whose action blocks until released,
+ * so the worker can be stopped while the action is running.
+ */
+type blockingScheduleTrigger struct {
+	schedule.Schedule
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingScheduleTrigger) HandleAction(ctx core.TriggerActionContext) (map[string]any, error) {
+	close(b.started)
+	<-b.release
+	return nil, nil
+}
+
+func Test__NodeRequestWorker_DrainsInFlightRequestsOnShutdown(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	trigger := &blockingScheduleTrigger{started: make(chan struct{}), release: make(chan struct{})}
+	r.Registry.Triggers["blocking-schedule"] = trigger
+
+	triggerNode := "trigger-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "blocking-schedule"}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	request := models.CanvasNodeRequest{
+		ID:         uuid.New(),
+		WorkflowID: canvas.ID,
+		NodeID:     triggerNode,
+		Type:       models.NodeRequestTypeInvokeAction,
+		Spec: datatypes.NewJSONType(models.NodeExecutionRequestSpec{
+			InvokeAction: &models.InvokeAction{
+				ActionName: "emitEvent",
+				Parameters: map[string]interface{}{},
+			},
+		}),
+		State: models.NodeExecutionRequestStatePending,
+		RunAt: time.Now().Add(-time.Second),
+	}
+	require.NoError(t, database.Conn().Create(&request).Error)
+
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry)
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		worker.Start(ctx)
+		close(stopped)
+	}()
+
+	//
+	// Cancel the context while the action is running.
+	// Start should only return after the action finishes.
+	//
+	select {
+	case <-trigger.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not picked up")
+	}
+
+	cancel()
+
+	select {
+	case <-stopped:
+		t.Fatal("worker stopped before the in-flight request finished")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	close(trigger.release)
+
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("worker did not stop after the in-flight request finished")
+	}
+
+	var updatedRequest models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("id = ?", request.ID).First(&updatedRequest).Error)
+	assert.Equal(t, models.NodeExecutionRequestStateCompleted, updatedRequest.State)
+}
+
+func Test__NodeRequestWorker_WakesUpWhenRequestIsCreated(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	trigger := &blockingScheduleTrigger{started: make(chan struct{}), release: make(chan struct{})}
+	close(trigger.release)
+	r.Registry.Triggers["blocking-schedule"] = trigger
+
+	triggerNode := "trigger-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "blocking-schedule"}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	//
+	// Sweeps are too far apart to pick up the request,
+	// so it is only processed if the worker is notified.
+	//
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry)
+	worker.sweepInterval = time.Hour
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go worker.Start(ctx)
+
+	node, err := models.FindCanvasNode(database.Conn(), canvas.ID, triggerNode)
+	require.NoError(t, err)
+
+	runAt := time.Now()
+	require.NoError(t, database.Conn().Transaction(func(tx *gorm.DB) error {
+		return node.CreateRequest(tx, models.NodeRequestTypeInvokeAction, models.NodeExecutionRequestSpec{
+			InvokeAction: &models.InvokeAction{
+				ActionName: "emitEvent",
+				Parameters: map[string]any{},
+			},
+		}, &runAt)
+	}))
+
+	select {
+	case <-trigger.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not picked up")
+	}
+}
+
+func Test__NextSweepIn(t *testing.T) {
+	now := time.Now()
+	soon := now.Add(5 * time.Second)
+	later := now.Add(time.Hour)
+	past := now.Add(-time.Second)
+
+	assert.Equal(t, NodeRequestSweepInterval, nextSweepIn(now, nil, NodeRequestSweepInterval))
+	assert.Equal(t, 5*time.Second, nextSweepIn(now, &soon, NodeRequestSweepInterval))
+	assert.Equal(t, NodeRequestSweepInterval, nextSweepIn(now, &later, NodeRequestSweepInterval))
+	assert.Equal(t, time.Duration(0), nextSweepIn(now, &past, NodeRequestSweepInterval))
+}
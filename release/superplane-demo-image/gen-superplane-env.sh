@@ -49,6 +49,9 @@ START_INTEGRATION_REQUEST_WORKER="${START_INTEGRATION_REQUEST_WORKER:-yes}"
 START_WEBHOOK_PROVISIONER="${START_WEBHOOK_PROVISIONER:-yes}"
 START_WEBHOOK_CLEANUP_WORKER="${START_WEBHOOK_CLEANUP_WORKER:-yes}"
 START_CANVAS_CLEANUP_WORKER="${START_CANVAS_CLEANUP_WORKER:-yes}"
+START_EXECUTION_LOG_CLEANUP_WORKER="${START_EXECUTION_LOG_CLEANUP_WORKER:-yes}"
+START_AUDIT_EVENT_CLEANUP_WORKER="${START_AUDIT_EVENT_CLEANUP_WORKER:-yes}"
+START_EVENT_DEDUPLICATION_CLEANUP_WORKER="${START_EVENT_DEDUPLICATION_CLEANUP_WORKER:-yes}"
 NO_ENCRYPTION="${NO_ENCRYPTION:-yes}"
 SUPERPLANE_BEACON_ENABLED="${SUPERPLANE_BEACON_ENABLED:-yes}"
 SUPERPLANE_INSTALLATION_TYPE="${SUPERPLANE_INSTALLATION_TYPE:-demo}"
@@ -138,6 +141,9 @@ export START_INTEGRATION_REQUEST_WORKER="${START_INTEGRATION_REQUEST_WORKER}"
 export START_WEBHOOK_PROVISIONER="${START_WEBHOOK_PROVISIONER}"
 export START_WEBHOOK_CLEANUP_WORKER="${START_WEBHOOK_CLEANUP_WORKER}"
 export START_CANVAS_CLEANUP_WORKER="${START_CANVAS_CLEANUP_WORKER}"
+export START_EXECUTION_LOG_CLEANUP_WORKER="${START_EXECUTION_LOG_CLEANUP_WORKER}"
+export START_AUDIT_EVENT_CLEANUP_WORKER="${START_AUDIT_EVENT_CLEANUP_WORKER}"
+export START_EVENT_DEDUPLICATION_CLEANUP_WORKER="${START_EVENT_DEDUPLICATION_CLEANUP_WORKER}"
 export ENCRYPTION_KEY="${ENCRYPTION_KEY}"
 export JWT_SECRET="${JWT_SECRET}"
 export OIDC_KEYS_PATH="${OIDC_KEYS_PATH}"
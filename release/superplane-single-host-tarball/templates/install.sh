@@ -127,6 +127,9 @@ START_WEBHOOK_PROVISIONER=yes
 START_WEBHOOK_CLEANUP_WORKER=yes
 START_INTEGRATION_CLEANUP_WORKER=yes
 START_CANVAS_CLEANUP_WORKER=yes
+START_EXECUTION_LOG_CLEANUP_WORKER=yes
+START_AUDIT_EVENT_CLEANUP_WORKER=yes
+START_EVENT_DEDUPLICATION_CLEANUP_WORKER=yes
 
 SENTRY_DSN=
 SENTRY_ENVIRONMENT=single-host
@@ -14,6 +14,7 @@ type DummyIntegration struct {
 	handleAction func(ctx core.IntegrationActionContext) error
 	onSync       func(ctx core.SyncContext) error
 	onCleanup    func(ctx core.IntegrationCleanupContext) error
+	onRequest    func(ctx core.HTTPRequestContext)
 }
 
 type DummyIntegrationOptions struct {
@@ -21,6 +22,7 @@ type DummyIntegrationOptions struct {
 	HandleAction func(ctx core.IntegrationActionContext) error
 	OnSync       func(ctx core.SyncContext) error
 	OnCleanup    func(ctx core.IntegrationCleanupContext) error
+	OnRequest    func(ctx core.HTTPRequestContext)
 }
 
 func NewDummyIntegration(options DummyIntegrationOptions) *DummyIntegration {
@@ -29,6 +31,7 @@ func NewDummyIntegration(options DummyIntegrationOptions) *DummyIntegration {
 		handleAction: options.HandleAction,
 		onSync:       options.OnSync,
 		onCleanup:    options.OnCleanup,
+		onRequest:    options.OnRequest,
 	}
 }
 
@@ -94,6 +97,10 @@ func (t *DummyIntegration) ListResources(resourceType string, ctx core.ListResou
 }
 
 func (t *DummyIntegration) HandleRequest(ctx core.HTTPRequestContext) {
+	if t.onRequest == nil {
+		return
+	}
+	t.onRequest(ctx)
 }
 
 type DummyWebhookHandlerOptions struct {
@@ -1,11 +1,14 @@
 package contexts
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/core"
 )
 
@@ -28,7 +31,8 @@ func (e *EventContext) Count() int {
 }
 
 type WebhookContext struct {
-	Secret string
+	Secret      string
+	MaxBodySize int64
 }
 
 func (w *WebhookContext) GetSecret() ([]byte, error) {
@@ -53,6 +57,11 @@ func (w *WebhookContext) GetBaseURL() string {
 	return "http://localhost:3000/api/v1"
 }
 
+func (w *WebhookContext) SetMaxBodySize(bytes int64) error {
+	w.MaxBodySize = bytes
+	return nil
+}
+
 type MetadataContext struct {
 	Metadata any
 }
@@ -66,6 +75,33 @@ func (m *MetadataContext) Set(metadata any) error {
 	return nil
 }
 
+type AttachmentContext struct {
+	Attachments map[string][]byte
+}
+
+func (c *AttachmentContext) Put(name string, data []byte) error {
+	if c.Attachments == nil {
+		c.Attachments = map[string][]byte{}
+	}
+
+	c.Attachments[name] = data
+	return nil
+}
+
+func (c *AttachmentContext) Get(name string) ([]byte, error) {
+	data, ok := c.Attachments[name]
+	if !ok {
+		return nil, core.ErrAttachmentNotFound
+	}
+
+	return data, nil
+}
+
+func (c *AttachmentContext) Delete(name string) error {
+	delete(c.Attachments, name)
+	return nil
+}
+
 type IntegrationContext struct {
 	IntegrationID    string
 	Configuration    map[string]any
@@ -175,7 +211,7 @@ func (c *IntegrationContext) ScheduleActionCall(actionName string, parameters an
 	return nil
 }
 
-func (c *IntegrationContext) ListSubscriptions() ([]core.IntegrationSubscriptionContext, error) {
+func (c *IntegrationContext) ListSubscriptions(filter core.SubscriptionFilter) ([]core.IntegrationSubscriptionContext, error) {
 	return nil, nil
 }
 
@@ -185,6 +221,17 @@ func (c *IntegrationContext) Subscribe(subscription any) (*uuid.UUID, error) {
 	return &s.ID, nil
 }
 
+func (c *IntegrationContext) UpdateSubscription(subscriptionID uuid.UUID, configuration any) error {
+	for i, s := range c.Subscriptions {
+		if s.ID == subscriptionID {
+			c.Subscriptions[i].Configuration = configuration
+			return nil
+		}
+	}
+
+	return fmt.Errorf("subscription %s not found", subscriptionID)
+}
+
 type ExecutionStateContext struct {
 	Finished       bool
 	Passed         bool
@@ -194,6 +241,9 @@ type ExecutionStateContext struct {
 	Type           string
 	Payloads       []any
 	KVs            map[string]string
+	Released       []any
+	ReleasedIDs    []uuid.UUID
+	Unfinished     map[uuid.UUID]bool
 }
 
 func (c *ExecutionStateContext) IsFinished() bool {
@@ -238,11 +288,43 @@ func (c *ExecutionStateContext) SetKV(key, value string) error {
 	return nil
 }
 
+func (c *ExecutionStateContext) SetKVs(pairs map[string]string) error {
+	for key, value := range pairs {
+		c.KVs[key] = value
+	}
+
+	return nil
+}
+
+func (c *ExecutionStateContext) Release(channel, payloadType string, payloads []any) ([]uuid.UUID, error) {
+	c.Channel = channel
+	c.Type = payloadType
+
+	ids := make([]uuid.UUID, 0, len(payloads))
+	for _, payload := range payloads {
+		id := uuid.New()
+		ids = append(ids, id)
+		c.ReleasedIDs = append(c.ReleasedIDs, id)
+		c.Released = append(c.Released, map[string]any{
+			"type":      payloadType,
+			"timestamp": time.Now(),
+			"data":      payload,
+		})
+	}
+
+	return ids, nil
+}
+
+func (c *ExecutionStateContext) HasUnfinishedDescendants(eventID uuid.UUID) (bool, error) {
+	return c.Unfinished[eventID], nil
+}
+
 type AuthContext struct {
-	User   *core.User
-	Users  map[string]*core.User
-	Roles  map[string]struct{}
-	Groups map[string]struct{}
+	User         *core.User
+	Users        map[string]*core.User
+	Roles        map[string]struct{}
+	Groups       map[string]struct{}
+	GroupMembers map[string][]core.User
 }
 
 func (c *AuthContext) AuthenticatedUser() *core.User {
@@ -285,6 +367,15 @@ func (c *AuthContext) InGroup(group string) (bool, error) {
 	return ok, nil
 }
 
+func (c *AuthContext) GetGroupUsers(group string) ([]core.User, error) {
+	members, ok := c.GroupMembers[group]
+	if !ok {
+		return nil, fmt.Errorf("group not found: %s", group)
+	}
+
+	return members, nil
+}
+
 type RequestContext struct {
 	Duration time.Duration
 	Action   string
@@ -298,11 +389,48 @@ func (c *RequestContext) ScheduleActionCall(action string, params map[string]any
 	return nil
 }
 
+type NotificationContext struct {
+	Notifications []Notification
+}
+
+type Notification struct {
+	Title     string
+	Body      string
+	URL       string
+	URLLabel  string
+	Receivers core.NotificationReceivers
+}
+
+func (c *NotificationContext) Send(title, body, url, urlLabel string, receivers core.NotificationReceivers) error {
+	c.Notifications = append(c.Notifications, Notification{
+		Title:     title,
+		Body:      body,
+		URL:       url,
+		URLLabel:  urlLabel,
+		Receivers: receivers,
+	})
+
+	return nil
+}
+
+type ApprovalLinkContext struct {
+	ExpiresIn time.Duration
+}
+
+func (c *ApprovalLinkContext) Create(user core.User, decision string, index int, expiresIn time.Duration) (string, error) {
+	c.ExpiresIn = expiresIn
+	return fmt.Sprintf("https://superplane.example.com/api/v1/approvals/%s-%s-%d", user.ID, decision, index), nil
+}
+
 type HTTPContext struct {
 	Requests  []*http.Request
 	Responses []*http.Response
 }
 
+func (c *HTTPContext) Context() context.Context {
+	return context.Background()
+}
+
 func (c *HTTPContext) Do(request *http.Request) (*http.Response, error) {
 	c.Requests = append(c.Requests, request)
 
@@ -314,3 +442,69 @@ func (c *HTTPContext) Do(request *http.Request) (*http.Response, error) {
 	c.Responses = c.Responses[1:]
 	return response, nil
 }
+
+/*
+ * ClientHTTPContext sends requests with a real HTTP client,
+ * for tests running against a local server.
+ */
+type ClientHTTPContext struct {
+	Client *http.Client
+}
+
+func NewClientHTTPContext() *ClientHTTPContext {
+	return &ClientHTTPContext{Client: &http.Client{}}
+}
+
+func (c *ClientHTTPContext) Context() context.Context {
+	return context.Background()
+}
+
+func (c *ClientHTTPContext) Do(request *http.Request) (*http.Response, error) {
+	return c.Client.Do(request)
+}
+
+type LogContext struct {
+	Entries []LogEntry
+}
+
+type LogEntry struct {
+	Level   string
+	Message string
+	Fields  map[string]any
+}
+
+func (c *LogContext) Logger() *logrus.Entry {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	logger.SetLevel(logrus.DebugLevel)
+	logger.AddHook(c)
+	return logrus.NewEntry(logger)
+}
+
+func (c *LogContext) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (c *LogContext) Fire(entry *logrus.Entry) error {
+	fields := make(map[string]any, len(entry.Data))
+	for key, value := range entry.Data {
+		fields[key] = value
+	}
+
+	c.Entries = append(c.Entries, LogEntry{
+		Level:   entry.Level.String(),
+		Message: entry.Message,
+		Fields:  fields,
+	})
+
+	return nil
+}
+
+func (c *LogContext) Messages() []string {
+	messages := make([]string, 0, len(c.Entries))
+	for _, entry := range c.Entries {
+		messages = append(messages, entry.Message)
+	}
+
+	return messages
+}
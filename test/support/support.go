@@ -25,13 +25,17 @@ import (
 	_ "github.com/superplanehq/superplane/pkg/components/filter"
 	_ "github.com/superplanehq/superplane/pkg/components/http"
 	_ "github.com/superplanehq/superplane/pkg/components/if"
+	_ "github.com/superplanehq/superplane/pkg/components/iterate"
 	_ "github.com/superplanehq/superplane/pkg/components/merge"
 	_ "github.com/superplanehq/superplane/pkg/components/noop"
+	_ "github.com/superplanehq/superplane/pkg/components/split"
 	_ "github.com/superplanehq/superplane/pkg/components/ssh"
+	_ "github.com/superplanehq/superplane/pkg/components/transform"
 	_ "github.com/superplanehq/superplane/pkg/components/wait"
 	_ "github.com/superplanehq/superplane/pkg/integrations/circleci"
 	_ "github.com/superplanehq/superplane/pkg/integrations/github"
 	_ "github.com/superplanehq/superplane/pkg/integrations/semaphore"
+	_ "github.com/superplanehq/superplane/pkg/triggers/manual"
 	_ "github.com/superplanehq/superplane/pkg/triggers/schedule"
 	_ "github.com/superplanehq/superplane/pkg/triggers/start"
 	_ "github.com/superplanehq/superplane/pkg/widgets/annotation"
@@ -55,7 +59,7 @@ type SetupOptions struct {
 	Approvals int
 }
 
-func Setup(t *testing.T) *ResourceRegistry {
+func Setup(t testing.TB) *ResourceRegistry {
 	return SetupWithOptions(t, SetupOptions{
 		Source:    true,
 		Stage:     true,
@@ -63,7 +67,7 @@ func Setup(t *testing.T) *ResourceRegistry {
 	})
 }
 
-func SetupWithOptions(t *testing.T, options SetupOptions) *ResourceRegistry {
+func SetupWithOptions(t testing.TB, options SetupOptions) *ResourceRegistry {
 	require.NoError(t, database.TruncateTables())
 
 	encryptor := crypto.NewNoOpEncryptor()
@@ -132,7 +136,7 @@ func RandomName(prefix string) string {
 	return prefix + "-" + uuid.New().String()
 }
 
-func AuthService(t *testing.T) *authorization.AuthService {
+func AuthService(t testing.TB) *authorization.AuthService {
 	authService, err := authorization.NewAuthService()
 	require.NoError(t, err)
 	return authService
@@ -298,11 +302,19 @@ func CreateNextNodeExecution(
 	return &execution
 }
 
-func CreateCanvas(t *testing.T, orgID uuid.UUID, userID uuid.UUID, nodes []models.CanvasNode, edges []models.Edge) (*models.Canvas, []models.CanvasNode) {
+func CreateCanvas(t testing.TB, orgID uuid.UUID, userID uuid.UUID, nodes []models.CanvasNode, edges []models.Edge) (*models.Canvas, []models.CanvasNode) {
 	now := time.Now()
 
 	inputNodes := make([]models.Node, len(nodes))
+	integrationIDs := map[string]*uuid.UUID{}
 	for i, node := range nodes {
+		var integrationID *string
+		if node.AppInstallationID != nil {
+			id := node.AppInstallationID.String()
+			integrationID = &id
+			integrationIDs[node.NodeID] = node.AppInstallationID
+		}
+
 		inputNodes[i] = models.Node{
 			ID:            node.NodeID,
 			Name:          node.Name,
@@ -312,6 +324,7 @@ func CreateCanvas(t *testing.T, orgID uuid.UUID, userID uuid.UUID, nodes []model
 			Metadata:      node.Metadata.Data(),
 			Position:      node.Position.Data(),
 			IsCollapsed:   node.IsCollapsed,
+			IntegrationID: integrationID,
 		}
 	}
 
@@ -347,19 +360,20 @@ func CreateCanvas(t *testing.T, orgID uuid.UUID, userID uuid.UUID, nodes []model
 		}
 
 		canvasNode := models.CanvasNode{
-			WorkflowID:    workflow.ID,
-			NodeID:        node.ID,
-			ParentNodeID:  parentNodeID,
-			Name:          node.Name,
-			State:         models.CanvasNodeStateReady,
-			Type:          node.Type,
-			Ref:           datatypes.NewJSONType(node.Ref),
-			Configuration: datatypes.NewJSONType(node.Configuration),
-			Position:      datatypes.NewJSONType(node.Position),
-			Metadata:      datatypes.NewJSONType(node.Metadata),
-			IsCollapsed:   node.IsCollapsed,
-			CreatedAt:     &now,
-			UpdatedAt:     &now,
+			WorkflowID:        workflow.ID,
+			NodeID:            node.ID,
+			ParentNodeID:      parentNodeID,
+			Name:              node.Name,
+			State:             models.CanvasNodeStateReady,
+			Type:              node.Type,
+			Ref:               datatypes.NewJSONType(node.Ref),
+			Configuration:     datatypes.NewJSONType(node.Configuration),
+			Position:          datatypes.NewJSONType(node.Position),
+			Metadata:          datatypes.NewJSONType(node.Metadata),
+			IsCollapsed:       node.IsCollapsed,
+			AppInstallationID: integrationIDs[node.ID],
+			CreatedAt:         &now,
+			UpdatedAt:         &now,
 		}
 
 		require.NoError(t, database.Conn().Clauses(clause.Returning{}).Create(&canvasNode).Error)
@@ -418,7 +432,12 @@ func VerifyCanvasNodeEventsCount(t *testing.T, canvasID uuid.UUID, nodeID string
 func VerifyNodeExecutionsCount(t *testing.T, workflowID uuid.UUID, expected int) {
 	var actual int64
 
+	//
+	// Rows soft deleted with their canvas are counted too,
+	// since they are only removed for good by the cleanup worker.
+	//
 	err := database.Conn().
+		Unscoped().
 		Model(&models.CanvasNodeExecution{}).
 		Where("workflow_id = ?", workflowID).
 		Count(&actual).
@@ -457,7 +476,12 @@ func VerifyNodeExecutionKVCount(t *testing.T, workflowID uuid.UUID, expected int
 func VerifyNodeRequestCount(t *testing.T, workflowID uuid.UUID, expected int) {
 	var actual int64
 
+	//
+	// Rows soft deleted with their canvas are counted too,
+	// since they are only removed for good by the cleanup worker.
+	//
 	err := database.Conn().
+		Unscoped().
 		Model(&models.CanvasNodeRequest{}).
 		Where("workflow_id = ?", workflowID).
 		Count(&actual).
@@ -497,7 +521,7 @@ func ensureCanvasNodeExists(t *testing.T, workflowID uuid.UUID, nodeID string) {
 	require.NoError(t, database.Conn().Create(&node).Error)
 }
 
-func expandBlueprintNodes(t *testing.T, orgID uuid.UUID, nodes []models.Node) ([]models.Node, error) {
+func expandBlueprintNodes(t testing.TB, orgID uuid.UUID, nodes []models.Node) ([]models.Node, error) {
 	expanded := make([]models.Node, 0, len(nodes))
 
 	for _, n := range nodes {
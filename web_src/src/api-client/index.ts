@@ -12,20 +12,26 @@ export {
   canvasesDeleteNodeQueueItem,
   canvasesDescribeCanvas,
   canvasesEmitNodeEvent,
+  canvasesGetNodeStatus,
   canvasesInvokeNodeExecutionAction,
   canvasesInvokeNodeTriggerAction,
   canvasesListCanvases,
   canvasesListCanvasEvents,
   canvasesListChildExecutions,
+  canvasesListDeadLetteredExecutions,
   canvasesListEventExecutions,
+  canvasesListExecutionLogs,
   canvasesListNodeEvents,
   canvasesListNodeExecutions,
   canvasesListNodeQueueItems,
+  canvasesListNodeStatuses,
+  canvasesRequeueDeadLetteredExecution,
   canvasesResolveExecutionErrors,
   canvasesUpdateCanvas,
   canvasesUpdateNodePause,
   componentsDescribeComponent,
   componentsListComponentActions,
+  componentsListComponentCatalog,
   componentsListComponents,
   groupsAddUserToGroup,
   groupsCreateGroup,
@@ -47,6 +53,7 @@ export {
   organizationsDescribeIntegration,
   organizationsDescribeOrganization,
   organizationsGetInviteLink,
+  organizationsListAuditEvents,
   organizationsListIntegrationResources,
   organizationsListIntegrations,
   organizationsListInvitations,
@@ -123,6 +130,7 @@ export type {
   CanvasesCancelExecutionResponses,
   CanvasesCanvas,
   CanvasesCanvasEvent,
+  CanvasesCanvasEventNodeCount,
   CanvasesCanvasEventWithExecutions,
   CanvasesCanvasMetadata,
   CanvasesCanvasNodeExecution,
@@ -136,6 +144,7 @@ export type {
   CanvasesCreateCanvasResponse,
   CanvasesCreateCanvasResponse2,
   CanvasesCreateCanvasResponses,
+  CanvasesDeadLetteredExecution,
   CanvasesDeleteCanvasData,
   CanvasesDeleteCanvasError,
   CanvasesDeleteCanvasErrors,
@@ -161,6 +170,13 @@ export type {
   CanvasesEmitNodeEventResponse,
   CanvasesEmitNodeEventResponse2,
   CanvasesEmitNodeEventResponses,
+  CanvasesExecutionLog,
+  CanvasesGetNodeStatusData,
+  CanvasesGetNodeStatusError,
+  CanvasesGetNodeStatusErrors,
+  CanvasesGetNodeStatusResponse,
+  CanvasesGetNodeStatusResponse2,
+  CanvasesGetNodeStatusResponses,
   CanvasesInvokeNodeExecutionActionBody,
   CanvasesInvokeNodeExecutionActionData,
   CanvasesInvokeNodeExecutionActionError,
@@ -194,12 +210,24 @@ export type {
   CanvasesListChildExecutionsResponse,
   CanvasesListChildExecutionsResponse2,
   CanvasesListChildExecutionsResponses,
+  CanvasesListDeadLetteredExecutionsData,
+  CanvasesListDeadLetteredExecutionsError,
+  CanvasesListDeadLetteredExecutionsErrors,
+  CanvasesListDeadLetteredExecutionsResponse,
+  CanvasesListDeadLetteredExecutionsResponse2,
+  CanvasesListDeadLetteredExecutionsResponses,
   CanvasesListEventExecutionsData,
   CanvasesListEventExecutionsError,
   CanvasesListEventExecutionsErrors,
   CanvasesListEventExecutionsResponse,
   CanvasesListEventExecutionsResponse2,
   CanvasesListEventExecutionsResponses,
+  CanvasesListExecutionLogsData,
+  CanvasesListExecutionLogsError,
+  CanvasesListExecutionLogsErrors,
+  CanvasesListExecutionLogsResponse,
+  CanvasesListExecutionLogsResponse2,
+  CanvasesListExecutionLogsResponses,
   CanvasesListNodeEventsData,
   CanvasesListNodeEventsError,
   CanvasesListNodeEventsErrors,
@@ -218,6 +246,22 @@ export type {
   CanvasesListNodeQueueItemsResponse,
   CanvasesListNodeQueueItemsResponse2,
   CanvasesListNodeQueueItemsResponses,
+  CanvasesListNodeStatusesData,
+  CanvasesListNodeStatusesError,
+  CanvasesListNodeStatusesErrors,
+  CanvasesListNodeStatusesResponse,
+  CanvasesListNodeStatusesResponse2,
+  CanvasesListNodeStatusesResponses,
+  CanvasesNodeExecutionCounts,
+  CanvasesNodeStatus,
+  CanvasesNodeStatusExecution,
+  CanvasesRequeueDeadLetteredExecutionBody,
+  CanvasesRequeueDeadLetteredExecutionData,
+  CanvasesRequeueDeadLetteredExecutionError,
+  CanvasesRequeueDeadLetteredExecutionErrors,
+  CanvasesRequeueDeadLetteredExecutionResponse,
+  CanvasesRequeueDeadLetteredExecutionResponse2,
+  CanvasesRequeueDeadLetteredExecutionResponses,
   CanvasesResolveExecutionErrorsBody,
   CanvasesResolveExecutionErrorsData,
   CanvasesResolveExecutionErrorsError,
@@ -243,6 +287,7 @@ export type {
   CanvasNodeExecutionResultReason,
   CanvasNodeExecutionState,
   ClientOptions,
+  ComponentsCatalogEntry,
   ComponentsComponent,
   ComponentsComponentAction,
   ComponentsDescribeComponentData,
@@ -259,6 +304,12 @@ export type {
   ComponentsListComponentActionsResponse,
   ComponentsListComponentActionsResponse2,
   ComponentsListComponentActionsResponses,
+  ComponentsListComponentCatalogData,
+  ComponentsListComponentCatalogError,
+  ComponentsListComponentCatalogErrors,
+  ComponentsListComponentCatalogResponse,
+  ComponentsListComponentCatalogResponse2,
+  ComponentsListComponentCatalogResponses,
   ComponentsListComponentsData,
   ComponentsListComponentsError,
   ComponentsListComponentsErrors,
@@ -374,6 +425,7 @@ export type {
   OrganizationsAcceptInviteLinkErrors,
   OrganizationsAcceptInviteLinkResponse,
   OrganizationsAcceptInviteLinkResponses,
+  OrganizationsAuditEvent,
   OrganizationsBrowserAction,
   OrganizationsCreateIntegrationBody,
   OrganizationsCreateIntegrationData,
@@ -429,6 +481,12 @@ export type {
   OrganizationsListIntegrationResourcesData,
   OrganizationsListIntegrationResourcesError,
   OrganizationsListIntegrationResourcesErrors,
+  OrganizationsListAuditEventsData,
+  OrganizationsListAuditEventsError,
+  OrganizationsListAuditEventsErrors,
+  OrganizationsListAuditEventsResponse,
+  OrganizationsListAuditEventsResponse2,
+  OrganizationsListAuditEventsResponses,
   OrganizationsListIntegrationResourcesResponse,
   OrganizationsListIntegrationResourcesResponse2,
   OrganizationsListIntegrationResourcesResponses,
@@ -36,6 +36,9 @@ import type {
   CanvasesEmitNodeEventData,
   CanvasesEmitNodeEventErrors,
   CanvasesEmitNodeEventResponses,
+  CanvasesGetNodeStatusData,
+  CanvasesGetNodeStatusErrors,
+  CanvasesGetNodeStatusResponses,
   CanvasesInvokeNodeExecutionActionData,
   CanvasesInvokeNodeExecutionActionErrors,
   CanvasesInvokeNodeExecutionActionResponses,
@@ -51,9 +54,15 @@ import type {
   CanvasesListChildExecutionsData,
   CanvasesListChildExecutionsErrors,
   CanvasesListChildExecutionsResponses,
+  CanvasesListDeadLetteredExecutionsData,
+  CanvasesListDeadLetteredExecutionsErrors,
+  CanvasesListDeadLetteredExecutionsResponses,
   CanvasesListEventExecutionsData,
   CanvasesListEventExecutionsErrors,
   CanvasesListEventExecutionsResponses,
+  CanvasesListExecutionLogsData,
+  CanvasesListExecutionLogsErrors,
+  CanvasesListExecutionLogsResponses,
   CanvasesListNodeEventsData,
   CanvasesListNodeEventsErrors,
   CanvasesListNodeEventsResponses,
@@ -63,6 +72,12 @@ import type {
   CanvasesListNodeQueueItemsData,
   CanvasesListNodeQueueItemsErrors,
   CanvasesListNodeQueueItemsResponses,
+  CanvasesListNodeStatusesData,
+  CanvasesListNodeStatusesErrors,
+  CanvasesListNodeStatusesResponses,
+  CanvasesRequeueDeadLetteredExecutionData,
+  CanvasesRequeueDeadLetteredExecutionErrors,
+  CanvasesRequeueDeadLetteredExecutionResponses,
   CanvasesResolveExecutionErrorsData,
   CanvasesResolveExecutionErrorsErrors,
   CanvasesResolveExecutionErrorsResponses,
@@ -78,6 +93,9 @@ import type {
   ComponentsListComponentActionsData,
   ComponentsListComponentActionsErrors,
   ComponentsListComponentActionsResponses,
+  ComponentsListComponentCatalogData,
+  ComponentsListComponentCatalogErrors,
+  ComponentsListComponentCatalogResponses,
   ComponentsListComponentsData,
   ComponentsListComponentsErrors,
   ComponentsListComponentsResponses,
@@ -117,6 +135,9 @@ import type {
   OrganizationsAcceptInviteLinkData,
   OrganizationsAcceptInviteLinkErrors,
   OrganizationsAcceptInviteLinkResponses,
+  OrganizationsBulkCreateInvitationsData,
+  OrganizationsBulkCreateInvitationsErrors,
+  OrganizationsBulkCreateInvitationsResponses,
   OrganizationsCreateIntegrationData,
   OrganizationsCreateIntegrationErrors,
   OrganizationsCreateIntegrationResponses,
@@ -138,6 +159,9 @@ import type {
   OrganizationsGetInviteLinkData,
   OrganizationsGetInviteLinkErrors,
   OrganizationsGetInviteLinkResponses,
+  OrganizationsListAuditEventsData,
+  OrganizationsListAuditEventsErrors,
+  OrganizationsListAuditEventsResponses,
   OrganizationsListIntegrationResourcesData,
   OrganizationsListIntegrationResourcesErrors,
   OrganizationsListIntegrationResourcesResponses,
@@ -195,6 +219,9 @@ import type {
   SecretsDescribeSecretData,
   SecretsDescribeSecretErrors,
   SecretsDescribeSecretResponses,
+  SecretsListSecretUsagesData,
+  SecretsListSecretUsagesErrors,
+  SecretsListSecretUsagesResponses,
   SecretsListSecretsData,
   SecretsListSecretsErrors,
   SecretsListSecretsResponses,
@@ -349,10 +376,45 @@ export const canvasesCreateCanvas = <ThrowOnError extends boolean = true>(
     },
   });
 
+/**
+ * List dead-lettered executions
+ *
+ * Returns the canvas node executions that failed permanently, with the context they failed in
+ */
+export const canvasesListDeadLetteredExecutions = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesListDeadLetteredExecutionsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesListDeadLetteredExecutionsResponses,
+    CanvasesListDeadLetteredExecutionsErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{canvasId}/dead-letters", ...options });
+
+/**
+ * Re-enqueue dead-lettered execution
+ *
+ * Creates a new pending execution with the input and configuration of a dead-lettered execution
+ */
+export const canvasesRequeueDeadLetteredExecution = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesRequeueDeadLetteredExecutionData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    CanvasesRequeueDeadLetteredExecutionResponses,
+    CanvasesRequeueDeadLetteredExecutionErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/dead-letters/{deadLetterId}/requeue",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * List canvas events
  *
- * Returns a list of root events that triggered executions in a canvas
+ * Returns a list of root events that triggered executions in a canvas, or only their counts per node
  */
 export const canvasesListCanvasEvents = <ThrowOnError extends boolean = true>(
   options: Options<CanvasesListCanvasEventsData, ThrowOnError>,
@@ -454,6 +516,32 @@ export const canvasesListChildExecutions = <ThrowOnError extends boolean = true>
     },
   });
 
+/**
+ * List execution logs
+ *
+ * Returns the most recent log entries written by a canvas node execution
+ */
+export const canvasesListExecutionLogs = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesListExecutionLogsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesListExecutionLogsResponses, CanvasesListExecutionLogsErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/executions/{executionId}/logs",
+    ...options,
+  });
+
+/**
+ * List node statuses
+ *
+ * Returns the status summary of multiple canvas nodes, or of all nodes in the canvas if no node IDs are given
+ */
+export const canvasesListNodeStatuses = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesListNodeStatusesData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesListNodeStatusesResponses, CanvasesListNodeStatusesErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/node-statuses",
+    ...options,
+  });
+
 /**
  * List node events
  *
@@ -541,6 +629,19 @@ export const canvasesDeleteNodeQueueItem = <ThrowOnError extends boolean = true>
     ThrowOnError
   >({ url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue/{itemId}", ...options });
 
+/**
+ * Get node status
+ *
+ * Returns a summary of the recent executions, queue and pause state of a canvas node
+ */
+export const canvasesGetNodeStatus = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesGetNodeStatusData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesGetNodeStatusResponses, CanvasesGetNodeStatusErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/status",
+    ...options,
+  });
+
 /**
  * Invoke trigger action
  *
@@ -618,6 +719,20 @@ export const componentsListComponents = <ThrowOnError extends boolean = true>(
     ...options,
   });
 
+/**
+ * List component catalog
+ *
+ * Returns all registered components and triggers, with their configuration schemas
+ */
+export const componentsListComponentCatalog = <ThrowOnError extends boolean = true>(
+  options?: Options<ComponentsListComponentCatalogData, ThrowOnError>,
+) =>
+  (options?.client ?? client).get<
+    ComponentsListComponentCatalogResponses,
+    ComponentsListComponentCatalogErrors,
+    ThrowOnError
+  >({ url: "/api/v1/components/catalog", ...options });
+
 /**
  * Describe component
  *
@@ -862,6 +977,20 @@ export const organizationsUpdateOrganization = <ThrowOnError extends boolean = t
     },
   });
 
+/**
+ * List audit events
+ *
+ * Returns the changes made in an organization, and the requests denied by the authorization check, most recent first
+ */
+export const organizationsListAuditEvents = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsListAuditEventsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    OrganizationsListAuditEventsResponses,
+    OrganizationsListAuditEventsErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/audit-events", ...options });
+
 /**
  * List integrations in an organization
  *
@@ -900,7 +1029,7 @@ export const organizationsCreateIntegration = <ThrowOnError extends boolean = tr
 /**
  * Delete organization integration
  *
- * Deletes an integration from an organization
+ * Deletes an integration from an organization. Fails if canvas nodes still use the integration, unless force is set, in which case the nodes are detached from it and paused
  */
 export const organizationsDeleteIntegration = <ThrowOnError extends boolean = true>(
   options: Options<OrganizationsDeleteIntegrationData, ThrowOnError>,
@@ -995,6 +1124,27 @@ export const organizationsCreateInvitation = <ThrowOnError extends boolean = tru
     },
   });
 
+/**
+ * Create organization invitations in bulk
+ *
+ * Invites multiple users to join an organization by email, each with an organization role
+ */
+export const organizationsBulkCreateInvitations = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsBulkCreateInvitationsData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    OrganizationsBulkCreateInvitationsResponses,
+    OrganizationsBulkCreateInvitationsErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/organizations/{id}/invitations/bulk",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * Remove an organization invitation
  *
@@ -1193,7 +1343,7 @@ export const secretsCreateSecret = <ThrowOnError extends boolean = true>(
 /**
  * Deletes a secret
  *
- * Deletes the specified secret
+ * Deletes the specified secret. Fails if canvas nodes or integrations still reference the secret, unless force is set
  */
 export const secretsDeleteSecret = <ThrowOnError extends boolean = true>(
   options: Options<SecretsDeleteSecretData, ThrowOnError>,
@@ -1280,6 +1430,19 @@ export const secretsUpdateSecretName = <ThrowOnError extends boolean = true>(
     },
   });
 
+/**
+ * List secret usages
+ *
+ * Returns the canvas nodes and integrations whose configuration references the secret
+ */
+export const secretsListSecretUsages = <ThrowOnError extends boolean = true>(
+  options: Options<SecretsListSecretUsagesData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<SecretsListSecretUsagesResponses, SecretsListSecretUsagesErrors, ThrowOnError>({
+    url: "/api/v1/secrets/{idOrName}/usages",
+    ...options,
+  });
+
 /**
  * List triggers
  *
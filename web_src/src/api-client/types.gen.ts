@@ -7,7 +7,7 @@ export type ClientOptions = {
 /**
  * Enums
  */
-export type AuthorizationDomainType = "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+export type AuthorizationDomainType = "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
 
 /**
  * Common data structures
@@ -62,6 +62,13 @@ export type BlueprintsUpdateBlueprintResponse = {
   blueprint?: BlueprintsBlueprint;
 };
 
+export type BulkCreateInvitationsRequestEntry = {
+  email?: string;
+  roleName?: string;
+};
+
+export type BulkCreateInvitationsResponseResultStatus = "STATUS_UNKNOWN" | "STATUS_CREATED" | "STATUS_SKIPPED";
+
 export type CanvasNodeExecutionResult = "RESULT_UNKNOWN" | "RESULT_PASSED" | "RESULT_FAILED" | "RESULT_CANCELLED";
 
 export type CanvasNodeExecutionResultReason =
@@ -97,6 +104,11 @@ export type CanvasesCanvasEvent = {
   createdAt?: string;
 };
 
+export type CanvasesCanvasEventNodeCount = {
+  nodeId?: string;
+  count?: number;
+};
+
 export type CanvasesCanvasEventWithExecutions = {
   id?: string;
   canvasId?: string;
@@ -180,6 +192,27 @@ export type CanvasesCreateCanvasResponse = {
   canvas?: CanvasesCanvas;
 };
 
+export type CanvasesDeadLetteredExecution = {
+  id?: string;
+  canvasId?: string;
+  nodeId?: string;
+  executionId?: string;
+  rootEventId?: string;
+  eventId?: string;
+  configHash?: string;
+  configuration?: {
+    [key: string]: unknown;
+  };
+  input?: {
+    [key: string]: unknown;
+  };
+  resultReason?: string;
+  resultMessage?: string;
+  requeuedExecutionId?: string;
+  requeuedAt?: string;
+  createdAt?: string;
+};
+
 export type CanvasesDeleteCanvasResponse = {
   [key: string]: unknown;
 };
@@ -203,6 +236,20 @@ export type CanvasesEmitNodeEventResponse = {
   eventId?: string;
 };
 
+export type CanvasesExecutionLog = {
+  id?: string;
+  level?: string;
+  message?: string;
+  fields?: {
+    [key: string]: unknown;
+  };
+  timestamp?: string;
+};
+
+export type CanvasesGetNodeStatusResponse = {
+  status?: CanvasesNodeStatus;
+};
+
 export type CanvasesInvokeNodeExecutionActionBody = {
   parameters?: {
     [key: string]: unknown;
@@ -230,6 +277,8 @@ export type CanvasesListCanvasEventsResponse = {
   totalCount?: number;
   hasNextPage?: boolean;
   lastTimestamp?: string;
+  nextCursor?: string;
+  nodeCounts?: Array<CanvasesCanvasEventNodeCount>;
 };
 
 export type CanvasesListCanvasesResponse = {
@@ -244,10 +293,18 @@ export type CanvasesListChildExecutionsResponse = {
   executions?: Array<CanvasesCanvasNodeExecution>;
 };
 
+export type CanvasesListDeadLetteredExecutionsResponse = {
+  deadLetters?: Array<CanvasesDeadLetteredExecution>;
+};
+
 export type CanvasesListEventExecutionsResponse = {
   executions?: Array<CanvasesCanvasNodeExecution>;
 };
 
+export type CanvasesListExecutionLogsResponse = {
+  logs?: Array<CanvasesExecutionLog>;
+};
+
 export type CanvasesListNodeEventsResponse = {
   events?: Array<CanvasesCanvasEvent>;
   totalCount?: number;
@@ -269,6 +326,45 @@ export type CanvasesListNodeQueueItemsResponse = {
   lastTimestamp?: string;
 };
 
+export type CanvasesListNodeStatusesResponse = {
+  statuses?: Array<CanvasesNodeStatus>;
+};
+
+export type CanvasesNodeExecutionCounts = {
+  pending?: number;
+  started?: number;
+  passed?: number;
+  failed?: number;
+  cancelled?: number;
+};
+
+export type CanvasesNodeStatus = {
+  nodeId?: string;
+  paused?: boolean;
+  queueDepth?: number;
+  executionCounts?: CanvasesNodeExecutionCounts;
+  lastExecution?: CanvasesNodeStatusExecution;
+  lastErrorMessage?: string;
+  windowStart?: string;
+};
+
+export type CanvasesNodeStatusExecution = {
+  id?: string;
+  state?: CanvasNodeExecutionState;
+  result?: CanvasNodeExecutionResult;
+  resultReason?: CanvasNodeExecutionResultReason;
+  createdAt?: string;
+  updatedAt?: string;
+};
+
+export type CanvasesRequeueDeadLetteredExecutionBody = {
+  [key: string]: unknown;
+};
+
+export type CanvasesRequeueDeadLetteredExecutionResponse = {
+  deadLetter?: CanvasesDeadLetteredExecution;
+};
+
 export type CanvasesResolveExecutionErrorsBody = {
   executionIds?: Array<string>;
 };
@@ -293,6 +389,19 @@ export type CanvasesUpdateNodePauseResponse = {
   node?: ComponentsNode;
 };
 
+export type ComponentsCatalogEntry = {
+  name?: string;
+  label?: string;
+  description?: string;
+  icon?: string;
+  color?: string;
+  outputChannels?: Array<SuperplaneComponentsOutputChannel>;
+  /**
+   * JSON encoded list of configuration fields, as defined by the component.
+   */
+  configurationSchema?: string;
+};
+
 export type ComponentsComponent = {
   name?: string;
   label?: string;
@@ -331,6 +440,11 @@ export type ComponentsListComponentActionsResponse = {
   actions?: Array<ComponentsComponentAction>;
 };
 
+export type ComponentsListComponentCatalogResponse = {
+  components?: Array<ComponentsCatalogEntry>;
+  triggers?: Array<ComponentsCatalogEntry>;
+};
+
 export type ComponentsListComponentsResponse = {
   components?: Array<ComponentsComponent>;
 };
@@ -355,6 +469,11 @@ export type ComponentsNode = {
   errorMessage?: string;
   warningMessage?: string;
   paused?: boolean;
+  /**
+   * Parameters of the user accessible actions of trigger nodes,
+   * resolved with the node configuration. Only included when describing a canvas.
+   */
+  actionParameters?: Array<NodeActionParameters>;
 };
 
 export type ComponentsNodeType = "TYPE_COMPONENT" | "TYPE_BLUEPRINT" | "TYPE_TRIGGER" | "TYPE_WIDGET";
@@ -598,6 +717,11 @@ export type MeRegenerateTokenResponse = {
   token?: string;
 };
 
+export type NodeActionParameters = {
+  action?: string;
+  parameters?: Array<ConfigurationField>;
+};
+
 export type NodeBlueprintRef = {
   id?: string;
 };
@@ -614,6 +738,19 @@ export type NodeWidgetRef = {
   name?: string;
 };
 
+export type OrganizationsAuditEvent = {
+  id?: string;
+  userId?: string;
+  method?: string;
+  resource?: string;
+  action?: string;
+  request?: {
+    [key: string]: unknown;
+  };
+  result?: string;
+  createdAt?: string;
+};
+
 export type OrganizationsBrowserAction = {
   url?: string;
   method?: string;
@@ -623,6 +760,23 @@ export type OrganizationsBrowserAction = {
   description?: string;
 };
 
+export type OrganizationsBulkCreateInvitationsBody = {
+  entries?: Array<BulkCreateInvitationsRequestEntry>;
+};
+
+export type OrganizationsBulkCreateInvitationsResponse = {
+  results?: Array<OrganizationsBulkCreateInvitationsResponseResult>;
+  warnings?: Array<string>;
+};
+
+export type OrganizationsBulkCreateInvitationsResponseResult = {
+  email?: string;
+  roleName?: string;
+  status?: BulkCreateInvitationsResponseResultStatus;
+  reason?: string;
+  invitation?: OrganizationsInvitation;
+};
+
 export type OrganizationsCreateIntegrationBody = {
   name?: string;
   integrationName?: string;
@@ -697,6 +851,9 @@ export type OrganizationsIntegrationStatus = {
   };
   browserAction?: OrganizationsBrowserAction;
   usedIn?: Array<IntegrationNodeRef>;
+  syncFailureCount?: number;
+  lastSyncError?: string;
+  lastSyncFailedAt?: string;
 };
 
 export type OrganizationsInvitation = {
@@ -716,6 +873,12 @@ export type OrganizationsInviteLink = {
   updatedAt?: string;
 };
 
+export type OrganizationsListAuditEventsResponse = {
+  events?: Array<OrganizationsAuditEvent>;
+  hasNextPage?: boolean;
+  lastTimestamp?: string;
+};
+
 export type OrganizationsListIntegrationResourcesResponse = {
   resources?: Array<OrganizationsIntegrationResourceRef>;
 };
@@ -871,6 +1034,11 @@ export type SecretsDescribeSecretResponse = {
   secret?: SecretsSecret;
 };
 
+export type SecretsListSecretUsagesResponse = {
+  nodes?: Array<SecretsSecretNodeUsage>;
+  integrations?: Array<SecretsSecretIntegrationUsage>;
+};
+
 export type SecretsListSecretsResponse = {
   secrets?: Array<SecretsSecret>;
 };
@@ -880,6 +1048,13 @@ export type SecretsSecret = {
   spec?: SecretsSecretSpec;
 };
 
+export type SecretsSecretIntegrationUsage = {
+  integrationId?: string;
+  integrationName?: string;
+  fieldPath?: string;
+  keyName?: string;
+};
+
 export type SecretsSecretMetadata = {
   id?: string;
   name?: string;
@@ -888,6 +1063,15 @@ export type SecretsSecretMetadata = {
   createdAt?: string;
 };
 
+export type SecretsSecretNodeUsage = {
+  canvasId?: string;
+  canvasName?: string;
+  nodeId?: string;
+  nodeName?: string;
+  fieldPath?: string;
+  keyName?: string;
+};
+
 export type SecretsSecretSpec = {
   provider?: SecretProvider;
   local?: SecretLocal;
@@ -1267,6 +1451,69 @@ export type CanvasesCreateCanvasResponses = {
 
 export type CanvasesCreateCanvasResponse2 = CanvasesCreateCanvasResponses[keyof CanvasesCreateCanvasResponses];
 
+export type CanvasesListDeadLetteredExecutionsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: {
+    nodeId?: string;
+    includeRequeued?: boolean;
+    limit?: number;
+  };
+  url: "/api/v1/canvases/{canvasId}/dead-letters";
+};
+
+export type CanvasesListDeadLetteredExecutionsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListDeadLetteredExecutionsError =
+  CanvasesListDeadLetteredExecutionsErrors[keyof CanvasesListDeadLetteredExecutionsErrors];
+
+export type CanvasesListDeadLetteredExecutionsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListDeadLetteredExecutionsResponse;
+};
+
+export type CanvasesListDeadLetteredExecutionsResponse2 =
+  CanvasesListDeadLetteredExecutionsResponses[keyof CanvasesListDeadLetteredExecutionsResponses];
+
+export type CanvasesRequeueDeadLetteredExecutionData = {
+  body: CanvasesRequeueDeadLetteredExecutionBody;
+  path: {
+    canvasId: string;
+    deadLetterId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/dead-letters/{deadLetterId}/requeue";
+};
+
+export type CanvasesRequeueDeadLetteredExecutionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesRequeueDeadLetteredExecutionError =
+  CanvasesRequeueDeadLetteredExecutionErrors[keyof CanvasesRequeueDeadLetteredExecutionErrors];
+
+export type CanvasesRequeueDeadLetteredExecutionResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesRequeueDeadLetteredExecutionResponse;
+};
+
+export type CanvasesRequeueDeadLetteredExecutionResponse2 =
+  CanvasesRequeueDeadLetteredExecutionResponses[keyof CanvasesRequeueDeadLetteredExecutionResponses];
+
 export type CanvasesListCanvasEventsData = {
   body?: never;
   path: {
@@ -1275,6 +1522,12 @@ export type CanvasesListCanvasEventsData = {
   query?: {
     limit?: number;
     before?: string;
+    nodeId?: string;
+    channel?: string;
+    after?: string;
+    payloadType?: string;
+    cursor?: string;
+    countOnly?: boolean;
   };
   url: "/api/v1/canvases/{canvasId}/events";
 };
@@ -1446,6 +1699,66 @@ export type CanvasesListChildExecutionsResponses = {
 export type CanvasesListChildExecutionsResponse2 =
   CanvasesListChildExecutionsResponses[keyof CanvasesListChildExecutionsResponses];
 
+export type CanvasesListExecutionLogsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    executionId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/logs";
+};
+
+export type CanvasesListExecutionLogsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListExecutionLogsError = CanvasesListExecutionLogsErrors[keyof CanvasesListExecutionLogsErrors];
+
+export type CanvasesListExecutionLogsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListExecutionLogsResponse;
+};
+
+export type CanvasesListExecutionLogsResponse2 =
+  CanvasesListExecutionLogsResponses[keyof CanvasesListExecutionLogsResponses];
+
+export type CanvasesListNodeStatusesData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: {
+    nodeIds?: Array<string>;
+    windowSeconds?: number;
+  };
+  url: "/api/v1/canvases/{canvasId}/node-statuses";
+};
+
+export type CanvasesListNodeStatusesErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListNodeStatusesError = CanvasesListNodeStatusesErrors[keyof CanvasesListNodeStatusesErrors];
+
+export type CanvasesListNodeStatusesResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListNodeStatusesResponse;
+};
+
+export type CanvasesListNodeStatusesResponse2 =
+  CanvasesListNodeStatusesResponses[keyof CanvasesListNodeStatusesResponses];
+
 export type CanvasesListNodeEventsData = {
   body?: never;
   path: {
@@ -1630,6 +1943,36 @@ export type CanvasesDeleteNodeQueueItemResponses = {
 export type CanvasesDeleteNodeQueueItemResponse2 =
   CanvasesDeleteNodeQueueItemResponses[keyof CanvasesDeleteNodeQueueItemResponses];
 
+export type CanvasesGetNodeStatusData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    nodeId: string;
+  };
+  query?: {
+    windowSeconds?: number;
+  };
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/status";
+};
+
+export type CanvasesGetNodeStatusErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesGetNodeStatusError = CanvasesGetNodeStatusErrors[keyof CanvasesGetNodeStatusErrors];
+
+export type CanvasesGetNodeStatusResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesGetNodeStatusResponse;
+};
+
+export type CanvasesGetNodeStatusResponse2 = CanvasesGetNodeStatusResponses[keyof CanvasesGetNodeStatusResponses];
+
 export type CanvasesInvokeNodeTriggerActionData = {
   body: CanvasesInvokeNodeTriggerActionBody;
   path: {
@@ -1768,6 +2111,33 @@ export type ComponentsListComponentsResponses = {
 export type ComponentsListComponentsResponse2 =
   ComponentsListComponentsResponses[keyof ComponentsListComponentsResponses];
 
+export type ComponentsListComponentCatalogData = {
+  body?: never;
+  path?: never;
+  query?: never;
+  url: "/api/v1/components/catalog";
+};
+
+export type ComponentsListComponentCatalogErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type ComponentsListComponentCatalogError =
+  ComponentsListComponentCatalogErrors[keyof ComponentsListComponentCatalogErrors];
+
+export type ComponentsListComponentCatalogResponses = {
+  /**
+   * A successful response.
+   */
+  200: ComponentsListComponentCatalogResponse;
+};
+
+export type ComponentsListComponentCatalogResponse2 =
+  ComponentsListComponentCatalogResponses[keyof ComponentsListComponentCatalogResponses];
+
 export type ComponentsDescribeComponentData = {
   body?: never;
   path: {
@@ -1830,7 +2200,7 @@ export type GroupsListGroupsData = {
   body?: never;
   path?: never;
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/groups";
@@ -1885,7 +2255,7 @@ export type GroupsDeleteGroupData = {
     groupName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/groups/{groupName}";
@@ -1915,7 +2285,7 @@ export type GroupsDescribeGroupData = {
     groupName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/groups/{groupName}";
@@ -1972,7 +2342,7 @@ export type GroupsListGroupUsersData = {
     groupName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/groups/{groupName}/users";
@@ -2252,6 +2622,42 @@ export type OrganizationsUpdateOrganizationResponses = {
 export type OrganizationsUpdateOrganizationResponse2 =
   OrganizationsUpdateOrganizationResponses[keyof OrganizationsUpdateOrganizationResponses];
 
+export type OrganizationsListAuditEventsData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: {
+    userId?: string;
+    resource?: string;
+    after?: string;
+    before?: string;
+    limit?: number;
+    result?: string;
+  };
+  url: "/api/v1/organizations/{id}/audit-events";
+};
+
+export type OrganizationsListAuditEventsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsListAuditEventsError =
+  OrganizationsListAuditEventsErrors[keyof OrganizationsListAuditEventsErrors];
+
+export type OrganizationsListAuditEventsResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsListAuditEventsResponse;
+};
+
+export type OrganizationsListAuditEventsResponse2 =
+  OrganizationsListAuditEventsResponses[keyof OrganizationsListAuditEventsResponses];
+
 export type OrganizationsListIntegrationsData = {
   body?: never;
   path: {
@@ -2316,7 +2722,9 @@ export type OrganizationsDeleteIntegrationData = {
     id: string;
     integrationId: string;
   };
-  query?: never;
+  query?: {
+    force?: boolean;
+  };
   url: "/api/v1/organizations/{id}/integrations/{integrationId}";
 };
 
@@ -2490,6 +2898,35 @@ export type OrganizationsCreateInvitationResponses = {
 export type OrganizationsCreateInvitationResponse2 =
   OrganizationsCreateInvitationResponses[keyof OrganizationsCreateInvitationResponses];
 
+export type OrganizationsBulkCreateInvitationsData = {
+  body: OrganizationsBulkCreateInvitationsBody;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/invitations/bulk";
+};
+
+export type OrganizationsBulkCreateInvitationsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsBulkCreateInvitationsError =
+  OrganizationsBulkCreateInvitationsErrors[keyof OrganizationsBulkCreateInvitationsErrors];
+
+export type OrganizationsBulkCreateInvitationsResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsBulkCreateInvitationsResponse;
+};
+
+export type OrganizationsBulkCreateInvitationsResponse2 =
+  OrganizationsBulkCreateInvitationsResponses[keyof OrganizationsBulkCreateInvitationsResponses];
+
 export type OrganizationsRemoveInvitationData = {
   body?: never;
   path: {
@@ -2638,7 +3075,7 @@ export type RolesListRolesData = {
   body?: never;
   path?: never;
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/roles";
@@ -2693,7 +3130,7 @@ export type RolesDeleteRoleData = {
     roleName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/roles/{roleName}";
@@ -2723,7 +3160,7 @@ export type RolesDescribeRoleData = {
     roleName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/roles/{roleName}";
@@ -2805,7 +3242,7 @@ export type SecretsListSecretsData = {
   body?: never;
   path?: never;
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/secrets";
@@ -2860,8 +3297,9 @@ export type SecretsDeleteSecretData = {
     idOrName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
+    force?: boolean;
   };
   url: "/api/v1/secrets/{idOrName}";
 };
@@ -2890,7 +3328,7 @@ export type SecretsDescribeSecretData = {
     idOrName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/secrets/{idOrName}";
@@ -2948,7 +3386,7 @@ export type SecretsDeleteSecretKeyData = {
     keyName: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/secrets/{idOrName}/keys/{keyName}";
@@ -3027,6 +3465,36 @@ export type SecretsUpdateSecretNameResponses = {
 
 export type SecretsUpdateSecretNameResponse2 = SecretsUpdateSecretNameResponses[keyof SecretsUpdateSecretNameResponses];
 
+export type SecretsListSecretUsagesData = {
+  body?: never;
+  path: {
+    idOrName: string;
+  };
+  query?: {
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
+    domainId?: string;
+  };
+  url: "/api/v1/secrets/{idOrName}/usages";
+};
+
+export type SecretsListSecretUsagesErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type SecretsListSecretUsagesError = SecretsListSecretUsagesErrors[keyof SecretsListSecretUsagesErrors];
+
+export type SecretsListSecretUsagesResponses = {
+  /**
+   * A successful response.
+   */
+  200: SecretsListSecretUsagesResponse;
+};
+
+export type SecretsListSecretUsagesResponse2 = SecretsListSecretUsagesResponses[keyof SecretsListSecretUsagesResponses];
+
 export type TriggersListTriggersData = {
   body?: never;
   path?: never;
@@ -3083,7 +3551,7 @@ export type UsersListUsersData = {
   body?: never;
   path?: never;
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/users";
@@ -3113,7 +3581,7 @@ export type UsersListUserPermissionsData = {
     userId: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/users/{userId}/permissions";
@@ -3144,7 +3612,7 @@ export type UsersListUserRolesData = {
     userId: string;
   };
   query?: {
-    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION";
+    domainType?: "DOMAIN_TYPE_UNSPECIFIED" | "DOMAIN_TYPE_ORGANIZATION" | "DOMAIN_TYPE_CANVAS";
     domainId?: string;
   };
   url: "/api/v1/users/{userId}/roles";
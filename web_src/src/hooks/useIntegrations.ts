@@ -180,10 +180,11 @@ export const useDeleteIntegration = (organizationId: string, integrationId: stri
   const queryClient = useQueryClient();
 
   return useMutation({
-    mutationFn: async () => {
+    mutationFn: async (force: boolean = false) => {
       return await organizationsDeleteIntegration(
         withOrganizationHeader({
           path: { id: organizationId, integrationId },
+          query: force ? { force } : undefined,
         }),
       );
     },
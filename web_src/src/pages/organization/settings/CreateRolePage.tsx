@@ -324,6 +324,20 @@ const ORGANIZATION_PERMISSIONS: PermissionCategory[] = [
       },
     ],
   },
+  {
+    category: "Audit Log",
+    icon: "history",
+    permissions: [
+      {
+        id: "audit.read",
+        name: "View Audit Log",
+        description: "View the organization audit log, including denied requests",
+        category: "Audit Log",
+        resource: "audit",
+        action: "read",
+      },
+    ],
+  },
 ];
 
 const DEFAULT_ROLE_NAMES = ["org_viewer", "org_admin", "org_owner"];
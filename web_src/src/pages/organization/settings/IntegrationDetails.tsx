@@ -56,6 +56,8 @@ export function IntegrationDetails({ organizationId }: IntegrationDetailsProps)
     setIntegrationName(integration?.metadata?.name || integration?.spec?.integrationName || "");
   }, [integration?.metadata?.name, integration?.spec?.integrationName]);
 
+  const usedInCount = integration?.status?.usedIn?.length || 0;
+
   // Group usedIn nodes by workflow
   const workflowGroups = useMemo(() => {
     if (!integration?.status?.usedIn) return [];
@@ -136,7 +138,10 @@ export function IntegrationDetails({ organizationId }: IntegrationDetailsProps)
   const handleDelete = async () => {
     if (!canDeleteIntegrations) return;
     try {
-      await deleteMutation.mutateAsync();
+      //
+      // Nodes still using the integration are detached from it and paused.
+      //
+      await deleteMutation.mutateAsync(usedInCount > 0);
       navigate(`/${organizationId}/settings/integrations`);
     } catch (_error) {
       showErrorToast("Failed to delete integration");
@@ -398,6 +403,8 @@ export function IntegrationDetails({ organizationId }: IntegrationDetailsProps)
               </h3>
               <p className="text-sm text-gray-800 dark:text-gray-100 mb-6">
                 This cannot be undone. All data will be permanently deleted.
+                {usedInCount > 0 &&
+                  ` ${usedInCount} ${usedInCount === 1 ? "node uses" : "nodes use"} this integration, and will be detached from it and paused.`}
               </p>
               <div className="flex justify-start gap-3">
                 <Button
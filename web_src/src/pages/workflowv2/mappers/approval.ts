@@ -75,6 +75,12 @@ export const APPROVAL_STATE_MAP: EventStateMap = {
     backgroundColor: "bg-red-100",
     badgeColor: "bg-red-400",
   },
+  expired: {
+    icon: "clock",
+    textColor: "text-gray-800",
+    backgroundColor: "bg-gray-100",
+    badgeColor: "bg-gray-500",
+  },
   error: {
     icon: "triangle-alert",
     textColor: "text-gray-800",
@@ -126,6 +132,10 @@ export const approvalStateFunction: StateFunction = (execution: CanvasesCanvasNo
       return "rejected";
     }
 
+    if (metadata?.result === "expired") {
+      return "expired";
+    }
+
     // Default to success if finished and passed but no specific result
     return "approved";
   }
@@ -301,6 +311,10 @@ function getComponentSubtitle(execution: ExecutionInfo, additionalData?: unknown
       return `Rejected · ${timeAgo}`;
     }
 
+    if (result === "expired") {
+      return `Expired · ${timeAgo}`;
+    }
+
     return timeAgo;
   }
 
@@ -334,6 +348,15 @@ function getApprovalDecisionLabel(record: ApprovalRecord, labelMaps?: ApprovalLa
   return "Approver";
 }
 
+function getGroupApprovalTitle(record: ApprovalRecord, labelMaps?: ApprovalLabelMaps): string {
+  const label = getApprovalDecisionLabel(record, labelMaps);
+  const required = record.minApprovals || 1;
+  if (required <= 1) return label;
+
+  const approved = Math.min(record.approvals?.length || 0, required);
+  return `${label} (${approved}/${required})`;
+}
+
 function buildApprovalTimeline(records: ApprovalRecord[]) {
   return records
     .map((record) => {
@@ -403,6 +426,9 @@ type ApprovalRecord = {
   role?: string;
   group?: string;
   approval?: { approvedAt?: string; comment?: string };
+  members?: { id?: string; email?: string }[];
+  minApprovals?: number;
+  approvals?: { user?: { id?: string; email?: string }; approvedAt?: string; comment?: string }[];
   rejection?: { rejectedAt?: string; reason?: string };
 };
 
@@ -532,11 +558,13 @@ export const approvalDataBuilder: ComponentAdditionalDataBuilder = {
         userLabel ||
         (record.type === "user"
           ? record.user?.name || record.user?.email
-          : record.type === "role" || record.type === "group"
+          : record.type === "role"
             ? getApprovalDecisionLabel(record, labelMaps)
-            : record.type === "anyone"
-              ? "Any user"
-              : "Unknown");
+            : record.type === "group"
+              ? getGroupApprovalTitle(record, labelMaps)
+              : record.type === "anyone"
+                ? "Any user"
+                : "Unknown");
 
       return {
         id: `${record.index}`,
@@ -654,6 +682,10 @@ function canCurrentUserActOnApproval(
     case "role":
       return !!record.role && currentUserRoles.includes(record.role);
     case "group": {
+      if (record.members) {
+        return record.members.some((member) => isCurrentUser(member, currentUserId, currentUserEmail));
+      }
+
       if (!record.group || !organizationId) return false;
       const groupUsers = queryClient.getQueryData<SuperplaneUsersUser[]>(
         organizationKeys.groupUsers(organizationId, record.group),
@@ -679,12 +711,21 @@ function hasCurrentUserApprovedAnyRecord(
 
   return records.some(
     (record) =>
-      record.state === "approved" &&
-      ((currentUserId && record.user?.id === currentUserId) ||
-        (currentUserEmail && record.user?.email === currentUserEmail)),
+      (record.state === "approved" &&
+        ((currentUserId && record.user?.id === currentUserId) ||
+          (currentUserEmail && record.user?.email === currentUserEmail))) ||
+      (record.approvals || []).some((approval) => isCurrentUser(approval.user, currentUserId, currentUserEmail)),
   );
 }
 
+function isCurrentUser(
+  user: { id?: string; email?: string } | undefined,
+  currentUserId?: string,
+  currentUserEmail?: string,
+): boolean {
+  return (!!currentUserId && user?.id === currentUserId) || (!!currentUserEmail && user?.email === currentUserEmail);
+}
+
 function getPendingUserApprovalIndex(
   records: ApprovalRecord[],
   currentUserId?: string,
@@ -10,6 +10,9 @@ import { CloudWatchAlarmEvent } from "./types";
 interface Configuration {
   region?: string;
   state?: string;
+  stateFilter?: string[];
+  previousStateFilter?: string[];
+  alarmNamePrefix?: string;
   alarms?: Predicate[];
 }
 
@@ -23,10 +26,23 @@ function buildMetadataItems(configuration?: Configuration): MetadataItem[] {
     });
   }
 
-  if (configuration?.state) {
+  const states = configuration?.stateFilter?.length
+    ? configuration.stateFilter
+    : configuration?.state
+      ? [configuration.state]
+      : [];
+  if (states.length > 0) {
+    const previousStates = configuration?.previousStateFilter ?? [];
     items.push({
       icon: "bell",
-      label: configuration.state,
+      label: previousStates.length > 0 ? `${previousStates.join(", ")} → ${states.join(", ")}` : states.join(", "),
+    });
+  }
+
+  if (configuration?.alarmNamePrefix) {
+    items.push({
+      icon: "funnel",
+      label: `${configuration.alarmNamePrefix}*`,
     });
   }
 
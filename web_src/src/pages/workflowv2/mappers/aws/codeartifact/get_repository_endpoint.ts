@@ -0,0 +1,116 @@
+import {
+  ComponentBaseContext,
+  ComponentBaseMapper,
+  ExecutionDetailsContext,
+  ExecutionInfo,
+  NodeInfo,
+  OutputPayload,
+  SubtitleContext,
+} from "../../types";
+import { ComponentBaseProps, EventSection } from "@/ui/componentBase";
+import { getBackgroundColorClass, getColorClass } from "@/utils/colors";
+import { getState, getStateMap, getTriggerRenderer } from "../..";
+import awsCodeArtifactIcon from "@/assets/icons/integrations/aws.codeartifact.svg";
+import { formatTimeAgo } from "@/utils/date";
+import { formatTimestampInUserTimezone } from "@/utils/timezone";
+import { MetadataItem } from "@/ui/metadataList";
+import { stringOrDash } from "../../utils";
+
+interface GetRepositoryEndpointConfiguration {
+  domain?: string;
+  repository?: string;
+  format?: string;
+}
+
+interface RepositoryEndpointPayload {
+  domain?: string;
+  repository?: string;
+  format?: string;
+  repositoryEndpoint?: string;
+}
+
+export const getRepositoryEndpointMapper: ComponentBaseMapper = {
+  props(context: ComponentBaseContext): ComponentBaseProps {
+    const lastExecution = context.lastExecutions.length > 0 ? context.lastExecutions[0] : null;
+    const componentName = context.componentDefinition.name ?? "unknown";
+
+    return {
+      title:
+        context.node.name ||
+        context.componentDefinition.label ||
+        context.componentDefinition.name ||
+        "Unnamed component",
+      iconSrc: awsCodeArtifactIcon,
+      iconColor: getColorClass(context.componentDefinition.color),
+      collapsedBackground: getBackgroundColorClass(context.componentDefinition.color),
+      collapsed: context.node.isCollapsed,
+      eventSections: lastExecution
+        ? getRepositoryEndpointEventSections(context.nodes, lastExecution, componentName)
+        : undefined,
+      includeEmptyState: !lastExecution,
+      metadata: getRepositoryEndpointMetadataList(context.node),
+      eventStateMap: getStateMap(componentName),
+    };
+  },
+
+  getExecutionDetails(context: ExecutionDetailsContext): Record<string, string> {
+    const outputs = context.execution.outputs as { default?: OutputPayload[] } | undefined;
+    const data = outputs?.default?.[0]?.data as RepositoryEndpointPayload | undefined;
+
+    if (!data) {
+      return {};
+    }
+
+    return {
+      Repository: stringOrDash(data.repository),
+      Domain: stringOrDash(data.domain),
+      Format: stringOrDash(data.format),
+      Endpoint: stringOrDash(data.repositoryEndpoint),
+      "Retrieved At": context.execution.createdAt ? formatTimestampInUserTimezone(context.execution.createdAt) : "-",
+    };
+  },
+
+  subtitle(context: SubtitleContext): string {
+    if (!context.execution.createdAt) {
+      return "";
+    }
+    return formatTimeAgo(new Date(context.execution.createdAt));
+  },
+};
+
+function getRepositoryEndpointMetadataList(node: NodeInfo): MetadataItem[] {
+  const config = node.configuration as GetRepositoryEndpointConfiguration | undefined;
+  const items: MetadataItem[] = [];
+
+  if (config?.domain) {
+    items.push({ icon: "database", label: config.domain });
+  }
+  if (config?.repository) {
+    items.push({ icon: "boxes", label: config.repository });
+  }
+  if (config?.format) {
+    items.push({ icon: "package", label: config.format });
+  }
+
+  return items;
+}
+
+function getRepositoryEndpointEventSections(
+  nodes: NodeInfo[],
+  execution: ExecutionInfo,
+  componentName: string,
+): EventSection[] {
+  const rootTriggerNode = nodes.find((n) => n.id === execution.rootEvent?.nodeId);
+  const rootTriggerRenderer = getTriggerRenderer(rootTriggerNode?.componentName ?? "");
+  const { title } = rootTriggerRenderer.getTitleAndSubtitle({ event: execution.rootEvent });
+
+  return [
+    {
+      receivedAt: new Date(execution.createdAt ?? 0),
+      eventTitle: title,
+      eventSubtitle: formatTimeAgo(new Date(execution.createdAt ?? 0)),
+      eventState: getState(componentName)(execution),
+      eventId: execution.rootEvent?.id ?? "",
+    },
+  ];
+}
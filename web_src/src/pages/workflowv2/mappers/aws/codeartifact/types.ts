@@ -9,6 +9,9 @@ export interface CodeArtifactRepository {
 export interface CodeArtifactTriggerConfiguration {
   region?: string;
   repository?: string;
+  packageNamePattern?: string;
+  packageFormats?: string[];
+  statuses?: string[];
   packages?: Predicate[];
   versions?: Predicate[];
 }
@@ -44,12 +47,24 @@ export interface CodeArtifactPackageVersionDetail {
   eventDeduplicationId?: string;
 }
 
+export interface CodeArtifactPackageVersionSummary {
+  domain?: string;
+  repository?: string;
+  format?: string;
+  namespace?: string;
+  name?: string;
+  version?: string;
+  status?: string;
+  operation?: string;
+}
+
 export interface CodeArtifactPackageVersionEvent {
   account?: string;
   region?: string;
   time?: string;
   "detail-type"?: string;
   detail?: CodeArtifactPackageVersionDetail;
+  package?: CodeArtifactPackageVersionSummary;
 }
 
 export interface CodeArtifactPackageVersionConfiguration {
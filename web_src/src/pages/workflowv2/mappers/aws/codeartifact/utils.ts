@@ -63,6 +63,27 @@ export function buildCodeArtifactMetadataItems(
     });
   }
 
+  if (configuration?.packageNamePattern) {
+    items.push({
+      icon: "package",
+      label: `Package: ${configuration.packageNamePattern}`,
+    });
+  }
+
+  if (configuration?.packageFormats && configuration.packageFormats.length > 0) {
+    items.push({
+      icon: "file-code",
+      label: `Formats: ${configuration.packageFormats.join(", ")}`,
+    });
+  }
+
+  if (configuration?.statuses && configuration.statuses.length > 0) {
+    items.push({
+      icon: "funnel",
+      label: `Statuses: ${configuration.statuses.join(", ")}`,
+    });
+  }
+
   const packagesLabel = formatPredicateList(configuration?.packages);
   if (packagesLabel) {
     items.push({
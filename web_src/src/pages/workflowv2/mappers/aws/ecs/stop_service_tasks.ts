@@ -0,0 +1,106 @@
+import {
+  ComponentBaseContext,
+  ComponentBaseMapper,
+  ExecutionDetailsContext,
+  ExecutionInfo,
+  NodeInfo,
+  OutputPayload,
+  SubtitleContext,
+} from "../../types";
+import { ComponentBaseProps, EventSection } from "@/ui/componentBase";
+import { getBackgroundColorClass, getColorClass } from "@/utils/colors";
+import { getState, getStateMap, getTriggerRenderer } from "../..";
+import awsIcon from "@/assets/icons/integrations/aws.svg";
+import { formatTimeAgo } from "@/utils/date";
+import { MetadataItem } from "@/ui/metadataList";
+import { numberOrZero, stringOrDash } from "../../utils";
+
+interface StopServiceTasksConfiguration {
+  region?: string;
+  cluster?: string;
+  service?: string;
+}
+
+interface StopServiceTasksData {
+  cluster?: string;
+  service?: string;
+  reason?: string;
+  stoppedTaskArns?: string[];
+  failedTasks?: { taskArn?: string; error?: string }[];
+  skippedTasks?: number;
+}
+
+export const stopServiceTasksMapper: ComponentBaseMapper = {
+  props(context: ComponentBaseContext): ComponentBaseProps {
+    const lastExecution = context.lastExecutions.length > 0 ? context.lastExecutions[0] : null;
+    const componentName = context.componentDefinition.name || "unknown";
+
+    return {
+      title: context.node.name || context.componentDefinition.label || "Unnamed component",
+      iconSrc: awsIcon,
+      iconColor: getColorClass(context.componentDefinition.color),
+      collapsedBackground: getBackgroundColorClass(context.componentDefinition.color),
+      collapsed: context.node.isCollapsed,
+      eventSections: lastExecution ? getEventSections(context.nodes, lastExecution, componentName) : undefined,
+      includeEmptyState: !lastExecution,
+      metadata: getMetadataList(context.node),
+      eventStateMap: getStateMap(componentName),
+    };
+  },
+
+  getExecutionDetails(context: ExecutionDetailsContext): Record<string, string> {
+    const outputs = context.execution.outputs as { default?: OutputPayload[] } | undefined;
+    const result = outputs?.default?.[0]?.data as StopServiceTasksData | undefined;
+
+    if (!result) {
+      return {};
+    }
+
+    return {
+      Cluster: stringOrDash(result.cluster),
+      Service: stringOrDash(result.service),
+      Reason: stringOrDash(result.reason),
+      "Stopped Tasks": numberOrZero(result.stoppedTaskArns?.length).toString(),
+      "Failed Tasks": numberOrZero(result.failedTasks?.length).toString(),
+      "Skipped Tasks": numberOrZero(result.skippedTasks).toString(),
+    };
+  },
+
+  subtitle(context: SubtitleContext): string {
+    if (!context.execution.createdAt) {
+      return "";
+    }
+    return formatTimeAgo(new Date(context.execution.createdAt));
+  },
+};
+
+function getMetadataList(node: NodeInfo): MetadataItem[] {
+  const metadata: MetadataItem[] = [];
+  const configuration = node.configuration as StopServiceTasksConfiguration | undefined;
+
+  if (configuration?.region) {
+    metadata.push({ icon: "map", label: configuration.region });
+  }
+
+  if (configuration?.cluster && configuration?.service) {
+    metadata.push({ icon: "server", label: `${configuration.cluster}/${configuration.service}` });
+  }
+
+  return metadata;
+}
+
+function getEventSections(nodes: NodeInfo[], execution: ExecutionInfo, componentName: string): EventSection[] {
+  const rootTriggerNode = nodes.find((n) => n.id === execution.rootEvent?.nodeId);
+  const rootTriggerRenderer = getTriggerRenderer(rootTriggerNode?.componentName!);
+  const { title } = rootTriggerRenderer.getTitleAndSubtitle({ event: execution.rootEvent });
+
+  return [
+    {
+      receivedAt: new Date(execution.createdAt!),
+      eventTitle: title,
+      eventSubtitle: formatTimeAgo(new Date(execution.createdAt!)),
+      eventState: getState(componentName)(execution),
+      eventId: execution.rootEvent!.id!,
+    },
+  ];
+}
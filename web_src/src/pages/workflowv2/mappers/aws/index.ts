@@ -6,8 +6,10 @@ import { getImageMapper } from "./ecr/get_image";
 import { getImageScanFindingsMapper } from "./ecr/get_image_scan_findings";
 import { buildActionStateRegistry } from "../utils";
 import { scanImageMapper } from "./ecr/scan_image";
+import { stopServiceTasksMapper } from "./ecs/stop_service_tasks";
 import { onPackageVersionTriggerRenderer } from "./codeartifact/on_package_version";
 import { getPackageVersionMapper } from "./codeartifact/get_package_version";
+import { getRepositoryEndpointMapper } from "./codeartifact/get_repository_endpoint";
 import { createRepositoryMapper } from "./codeartifact/create_repository";
 import { copyPackageVersionsMapper } from "./codeartifact/copy_package_versions";
 import { deletePackageVersionsMapper } from "./codeartifact/delete_package_versions";
@@ -27,12 +29,14 @@ export const componentMappers: Record<string, ComponentBaseMapper> = {
   "ecr.getImage": getImageMapper,
   "ecr.getImageScanFindings": getImageScanFindingsMapper,
   "ecr.scanImage": scanImageMapper,
+  "ecs.stopServiceTasks": stopServiceTasksMapper,
   "codeArtifact.copyPackageVersions": copyPackageVersionsMapper,
   "codeArtifact.createRepository": createRepositoryMapper,
   "codeArtifact.deletePackageVersions": deletePackageVersionsMapper,
   "codeArtifact.deleteRepository": deleteRepositoryMapper,
   "codeArtifact.disposePackageVersions": disposePackageVersionsMapper,
   "codeArtifact.getPackageVersion": getPackageVersionMapper,
+  "codeArtifact.getRepositoryEndpoint": getRepositoryEndpointMapper,
   "codeArtifact.updatePackageVersionsStatus": updatePackageVersionsStatusMapper,
   "sns.getTopic": getTopicMapper,
   "sns.getSubscription": getSubscriptionMapper,
@@ -53,12 +57,14 @@ export const eventStateRegistry: Record<string, EventStateRegistry> = {
   "ecr.getImage": buildActionStateRegistry("retrieved"),
   "ecr.getImageScanFindings": buildActionStateRegistry("retrieved"),
   "ecr.scanImage": buildActionStateRegistry("scanned"),
+  "ecs.stopServiceTasks": buildActionStateRegistry("stopped"),
   "codeArtifact.copyPackageVersions": buildActionStateRegistry("copied"),
   "codeArtifact.createRepository": buildActionStateRegistry("created"),
   "codeArtifact.deletePackageVersions": buildActionStateRegistry("deleted"),
   "codeArtifact.deleteRepository": buildActionStateRegistry("deleted"),
   "codeArtifact.disposePackageVersions": buildActionStateRegistry("disposed"),
   "codeArtifact.getPackageVersion": buildActionStateRegistry("retrieved"),
+  "codeArtifact.getRepositoryEndpoint": buildActionStateRegistry("retrieved"),
   "codeArtifact.updatePackageVersionsStatus": buildActionStateRegistry("updated"),
   "sns.getTopic": buildActionStateRegistry("retrieved"),
   "sns.getSubscription": buildActionStateRegistry("retrieved"),
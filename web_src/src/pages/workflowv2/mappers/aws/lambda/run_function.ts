@@ -25,9 +25,16 @@ interface RunFunctionMetadata {
 
 interface RunFunctionOutput {
   requestId: string;
+  executedVersion?: string;
   payload?: any;
   payloadRaw?: string;
   functionError?: string;
+  statusCode?: number;
+  error?: {
+    errorType?: string;
+    errorMessage?: string;
+    stackTrace?: string[];
+  };
   report?: {
     duration: string;
     billedDuration: string;
@@ -57,14 +64,15 @@ export const runFunctionMapper: ComponentBaseMapper = {
   },
 
   getExecutionDetails(context: ExecutionDetailsContext): Record<string, string> {
-    const outputs = context.execution.outputs as { default?: OutputPayload[] } | undefined;
-    const result = outputs?.default?.[0]?.data as RunFunctionOutput | undefined;
+    const outputs = context.execution.outputs as { default?: OutputPayload[]; failed?: OutputPayload[] } | undefined;
+    const result = (outputs?.default?.[0]?.data || outputs?.failed?.[0]?.data) as RunFunctionOutput | undefined;
     if (!result) {
       return {};
     }
 
     let details: Record<string, string> = {
       "Request ID": stringOrDash(result.requestId),
+      "Executed Version": stringOrDash(result.executedVersion),
       Duration: stringOrDash(result.report?.duration),
       "Billed Duration": stringOrDash(result.report?.billedDuration),
       "Memory Size": stringOrDash(result.report?.memorySize),
@@ -76,6 +84,15 @@ export const runFunctionMapper: ComponentBaseMapper = {
       details["Function Error"] = stringOrDash(result.functionError);
     }
 
+    if (result.statusCode) {
+      details["Status Code"] = stringOrDash(result.statusCode);
+    }
+
+    if (result.error) {
+      details["Error Type"] = stringOrDash(result.error.errorType);
+      details["Error Message"] = stringOrDash(result.error.errorMessage);
+    }
+
     return details;
   },
 
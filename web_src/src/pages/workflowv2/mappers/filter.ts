@@ -23,6 +23,16 @@ import { formatTimeAgo } from "@/utils/date";
 
 type FilterOutputs = Record<string, OutputPayload[]>;
 
+// Executions from before the matched channel was added emitted matching events to "default".
+const MATCHED_CHANNELS = ["matched", "default"];
+
+function filterPassed(outputs: unknown): boolean {
+  return MATCHED_CHANNELS.some((channel) => {
+    const payloads = (outputs as FilterOutputs | undefined)?.[channel];
+    return Array.isArray(payloads) && payloads.length > 0;
+  });
+}
+
 export const FILTER_STATE_MAP: EventStateMap = {
   ...DEFAULT_EVENT_STATE_MAP,
   passed: {
@@ -59,11 +69,7 @@ export const filterStateFunction: StateFunction = (execution: ExecutionInfo): Ev
   }
 
   if (execution.state === "STATE_FINISHED" && execution.result === "RESULT_PASSED") {
-    const outputs = execution.outputs as FilterOutputs | undefined;
-    const hasOutputs = outputs
-      ? Object.values(outputs).some((payloads) => Array.isArray(payloads) && payloads.length > 0)
-      : false;
-    return hasOutputs ? "passed" : "rejected";
+    return filterPassed(execution.outputs) ? "passed" : "rejected";
   }
 
   return "failed";
@@ -166,12 +172,8 @@ export const filterMapper: ComponentBaseMapper = {
         });
         const parsedEvaluation = parseExpression(substitutedExpression);
 
-        // Determine if the filter passed (has outputs) or failed (no outputs)
-        const outputs = context.execution.outputs as FilterOutputs | undefined;
-        const hasOutputs = outputs
-          ? Object.values(outputs).some((payloads) => Array.isArray(payloads) && payloads.length > 0)
-          : false;
-        const passed = hasOutputs;
+        // Determine if the filter passed (emitted on the pass channel) or failed
+        const passed = filterPassed(context.execution.outputs);
 
         // Evaluate individual comparisons to determine which parts should be red
         const failedParts = evaluateIndividualComparisons(substitutedExpression);
@@ -185,11 +187,7 @@ export const filterMapper: ComponentBaseMapper = {
       } else {
         // If no input data available, show the expression as-is with badges
         const parsedExpression = parseExpression(expression);
-        const outputs = context.execution.outputs as FilterOutputs | undefined;
-        const hasOutputs = outputs
-          ? Object.values(outputs).some((payloads) => Array.isArray(payloads) && payloads.length > 0)
-          : false;
-        const passed = hasOutputs;
+        const passed = filterPassed(context.execution.outputs);
 
         details["Evaluation"] = {
           __type: "evaluationBadges",
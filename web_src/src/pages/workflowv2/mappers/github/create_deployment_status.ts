@@ -0,0 +1,54 @@
+import { ComponentBaseProps } from "@/ui/componentBase";
+import {
+  ComponentBaseContext,
+  ComponentBaseMapper,
+  ExecutionDetailsContext,
+  OutputPayload,
+  SubtitleContext,
+} from "../types";
+import { baseProps } from "./base";
+import { buildGithubExecutionSubtitle } from "./utils";
+
+interface DeploymentStatus {
+  id?: number;
+  state?: string;
+  description?: string;
+  environment?: string;
+  log_url?: string;
+  environment_url?: string;
+  creator?: {
+    login?: string;
+  };
+  created_at?: string;
+}
+
+export const createDeploymentStatusMapper: ComponentBaseMapper = {
+  props(context: ComponentBaseContext): ComponentBaseProps {
+    return baseProps(context.nodes, context.node, context.componentDefinition, context.lastExecutions);
+  },
+  subtitle(context: SubtitleContext): string {
+    return buildGithubExecutionSubtitle(context.execution);
+  },
+
+  getExecutionDetails(context: ExecutionDetailsContext): Record<string, string> {
+    const outputs = context.execution.outputs as { default?: OutputPayload[] } | undefined;
+    const details: Record<string, string> = {};
+
+    if (outputs && outputs.default && outputs.default.length > 0) {
+      const status = outputs.default[0].data as DeploymentStatus;
+      Object.assign(details, {
+        "Created At": status.created_at ? new Date(status.created_at).toLocaleString() : "-",
+        "Created By": status.creator?.login || "-",
+      });
+
+      details["Deployment State"] = status?.state || "";
+      details["Environment"] = status?.environment || "";
+      details["Description"] = status?.description || "";
+      details["Log URL"] = status?.log_url || "";
+      details["Environment URL"] = status?.environment_url || "";
+      details["Status ID"] = status?.id?.toString() || "";
+    }
+
+    return details;
+  },
+};
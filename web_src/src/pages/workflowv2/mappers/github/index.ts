@@ -11,6 +11,7 @@ import { onWorkflowRunTriggerRenderer } from "./on_workflow_run";
 import { baseIssueMapper } from "./base";
 import { RUN_WORKFLOW_STATE_REGISTRY, runWorkflowMapper, runWorkflowCustomFieldRenderer } from "./run_workflow";
 import { publishCommitStatusMapper } from "./publish_commit_status";
+import { createDeploymentStatusMapper } from "./create_deployment_status";
 import { createIssueCommentMapper } from "./create_issue_comment";
 import { createReleaseMapper } from "./create_release";
 import { updateReleaseMapper } from "./update_release";
@@ -27,6 +28,7 @@ export const eventStateRegistry: Record<string, EventStateRegistry> = {
   updateIssue: buildActionStateRegistry("updated"),
   createReview: buildActionStateRegistry("created"),
   publishCommitStatus: buildActionStateRegistry("published"),
+  createDeploymentStatus: buildActionStateRegistry("created"),
   createRelease: buildActionStateRegistry("created"),
   updateRelease: buildActionStateRegistry("updated"),
   deleteRelease: buildActionStateRegistry("deleted"),
@@ -41,6 +43,7 @@ export const componentMappers: Record<string, ComponentBaseMapper> = {
   createReview: createReviewMapper,
   runWorkflow: runWorkflowMapper,
   publishCommitStatus: publishCommitStatusMapper,
+  createDeploymentStatus: createDeploymentStatusMapper,
   createRelease: createReleaseMapper,
   updateRelease: updateReleaseMapper,
   deleteRelease: deleteReleaseMapper,
@@ -125,6 +125,8 @@ import {
   eventStateRegistry as dockerhubEventStateRegistry,
 } from "./dockerhub";
 import { filterMapper, FILTER_STATE_REGISTRY } from "./filter";
+import { transformMapper } from "./transform";
+import { iterateMapper, ITERATE_STATE_REGISTRY } from "./iterate";
 import { sshMapper, SSH_STATE_REGISTRY } from "./ssh";
 import { waitCustomFieldRenderer, waitMapper, WAIT_STATE_REGISTRY } from "./wait";
 import { approvalMapper, approvalDataBuilder, APPROVAL_STATE_REGISTRY } from "./approval";
@@ -150,6 +152,8 @@ const componentBaseMappers: Record<string, ComponentBaseMapper> = {
   ssh: sshMapper,
   timeGate: timeGateMapper,
   filter: filterMapper,
+  transform: transformMapper,
+  iterate: iterateMapper,
   wait: waitMapper,
   approval: approvalMapper,
   merge: mergeMapper,
@@ -241,6 +245,7 @@ const eventStateRegistries: Record<string, EventStateRegistry> = {
   timeGate: TIME_GATE_STATE_REGISTRY,
   wait: WAIT_STATE_REGISTRY,
   merge: MERGE_STATE_REGISTRY,
+  iterate: ITERATE_STATE_REGISTRY,
 };
 
 const customFieldRenderers: Record<string, CustomFieldRenderer> = {
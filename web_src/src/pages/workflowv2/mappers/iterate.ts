@@ -0,0 +1,165 @@
+import {
+  ComponentBaseContext,
+  ComponentBaseMapper,
+  EventStateRegistry,
+  ExecutionDetailsContext,
+  ExecutionInfo,
+  NodeInfo,
+  OutputPayload,
+  StateFunction,
+  SubtitleContext,
+} from "./types";
+import {
+  ComponentBaseProps,
+  EventSection,
+  EventState,
+  EventStateMap,
+  DEFAULT_EVENT_STATE_MAP,
+} from "@/ui/componentBase";
+import { getTriggerRenderer } from ".";
+import { getBackgroundColorClass, getColorClass } from "@/utils/colors";
+import { formatTimeAgo } from "@/utils/date";
+import { MetadataItem } from "@/ui/metadataList";
+
+// Output channel name matching backend
+const CHANNEL_COMPLETED = "completed";
+
+interface IterateConfiguration {
+  itemsPath?: string;
+  concurrency?: number;
+  waitForCompletion?: boolean;
+}
+
+interface IterateExecutionMetadata {
+  total?: number;
+  released?: number;
+  inFlight?: string[];
+}
+
+export const ITERATE_STATE_MAP: EventStateMap = {
+  ...DEFAULT_EVENT_STATE_MAP,
+  iterating: {
+    icon: "repeat",
+    textColor: "text-gray-800",
+    backgroundColor: "bg-sky-100",
+    badgeColor: "bg-blue-500",
+  },
+  completed: {
+    icon: "circle-check",
+    textColor: "text-gray-800",
+    backgroundColor: "bg-green-100",
+    badgeColor: "bg-emerald-500",
+  },
+};
+
+export const iterateStateFunction: StateFunction = (execution: ExecutionInfo): EventState => {
+  if (!execution) return "neutral";
+
+  if (execution.result === "RESULT_CANCELLED") {
+    return "cancelled";
+  }
+
+  if (execution.state === "STATE_PENDING" || execution.state === "STATE_STARTED") {
+    return "iterating";
+  }
+
+  if (execution.state === "STATE_FINISHED" && execution.result === "RESULT_PASSED") {
+    const outputs = execution.outputs as Record<string, OutputPayload[]> | undefined;
+    return outputs?.[CHANNEL_COMPLETED]?.length ? "completed" : "success";
+  }
+
+  return "failed";
+};
+
+export const ITERATE_STATE_REGISTRY: EventStateRegistry = {
+  stateMap: ITERATE_STATE_MAP,
+  getState: iterateStateFunction,
+};
+
+export const iterateMapper: ComponentBaseMapper = {
+  props(context: ComponentBaseContext): ComponentBaseProps {
+    const lastExecution = context.lastExecutions.length > 0 ? context.lastExecutions[0] : null;
+
+    return {
+      iconSlug: context.componentDefinition?.icon || "repeat",
+      iconColor: getColorClass(context.componentDefinition?.color || "blue"),
+      collapsedBackground: getBackgroundColorClass("white"),
+      collapsed: context.node.isCollapsed,
+      title: context.node.name || context.componentDefinition?.label || "Iterate",
+      metadata: getIterateMetadataList(context.node),
+      eventSections: lastExecution ? getIterateEventSections(context.nodes, lastExecution) : undefined,
+      includeEmptyState: !lastExecution,
+      eventStateMap: ITERATE_STATE_MAP,
+    };
+  },
+
+  subtitle(context: SubtitleContext): string {
+    return getIterateSubtitle(context.execution);
+  },
+
+  getExecutionDetails(context: ExecutionDetailsContext): Record<string, any> {
+    const details: Record<string, any> = {};
+    const metadata = context.execution.metadata as IterateExecutionMetadata | undefined;
+
+    if (context.execution.createdAt) {
+      details["Started at"] = new Date(context.execution.createdAt).toLocaleString();
+    }
+
+    if (context.execution.state === "STATE_FINISHED" && context.execution.updatedAt) {
+      details["Finished at"] = new Date(context.execution.updatedAt).toLocaleString();
+    }
+
+    if (metadata?.total !== undefined) {
+      details["Elements"] = String(metadata.total);
+      details["Released"] = String(metadata.released || 0);
+      details["In progress"] = String(metadata.inFlight?.length || 0);
+    }
+
+    return details;
+  },
+};
+
+function getIterateMetadataList(node: NodeInfo): MetadataItem[] {
+  const configuration = node.configuration as IterateConfiguration | undefined;
+  const items: MetadataItem[] = [];
+
+  if (configuration?.itemsPath) {
+    items.push({ icon: "brackets", label: configuration.itemsPath });
+  }
+
+  if (configuration?.concurrency) {
+    items.push({ icon: "layers", label: `${configuration.concurrency} at a time` });
+  }
+
+  return items;
+}
+
+function getIterateEventSections(nodes: NodeInfo[], execution: ExecutionInfo): EventSection[] {
+  const rootTriggerNode = nodes.find((n) => n.id === execution.rootEvent?.nodeId);
+  const rootTriggerRenderer = getTriggerRenderer(rootTriggerNode?.componentName!);
+  const { title: eventTitle } = rootTriggerRenderer.getTitleAndSubtitle({ event: execution.rootEvent! });
+
+  return [
+    {
+      receivedAt: new Date(execution.createdAt!),
+      eventTitle,
+      eventSubtitle: getIterateSubtitle(execution),
+      eventState: iterateStateFunction(execution),
+      eventId: execution.rootEvent!.id!,
+    },
+  ];
+}
+
+function getIterateSubtitle(execution: ExecutionInfo): string {
+  const metadata = execution.metadata as IterateExecutionMetadata | undefined;
+  const timestamp =
+    execution.state === "STATE_FINISHED" && execution.updatedAt ? execution.updatedAt : execution.createdAt;
+  const timeAgo = timestamp ? formatTimeAgo(new Date(timestamp)) : "";
+
+  if (metadata?.total === undefined) {
+    return timeAgo;
+  }
+
+  const done = (metadata.released || 0) - (metadata.inFlight?.length || 0);
+  return `${done}/${metadata.total} done · ${timeAgo}`;
+}
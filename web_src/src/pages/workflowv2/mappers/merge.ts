@@ -50,7 +50,10 @@ interface MergeExecutionMetadata {
   groupKey?: string;
   eventIDs?: string[];
   sources?: string[];
+  items?: { eventId: string; sourceNodeId: string }[];
+  expected?: number;
   stopEarly?: boolean;
+  partial?: boolean;
 }
 
 /**
@@ -225,8 +228,10 @@ function getMergeSubtitle(execution: ExecutionInfo, additionalData?: unknown): s
   const metadata = execution.metadata as MergeExecutionMetadata | undefined;
   const mergeData = additionalData as MergeAdditionalData | undefined;
 
-  const sourcesReceived = metadata?.sources?.length || 0;
-  const sourcesNeeded = mergeData?.incomingSourcesCount;
+  // When waiting for a number of events, progress is counted in events
+  const waitingForEvents = !!metadata?.expected;
+  const sourcesReceived = waitingForEvents ? metadata?.items?.length || 0 : metadata?.sources?.length || 0;
+  const sourcesNeeded = waitingForEvents ? metadata?.expected : mergeData?.incomingSourcesCount;
 
   // Determine timestamp - use updatedAt for finished, createdAt otherwise
   const timestamp =
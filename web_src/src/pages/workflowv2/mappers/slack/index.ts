@@ -1,5 +1,6 @@
 import { ComponentBaseMapper, EventStateRegistry, TriggerRenderer } from "../types";
 import { onAppMentionTriggerRenderer } from "./on_app_mention";
+import { onMessageTriggerRenderer } from "./on_message";
 import { sendTextMessageMapper } from "./send_text_message";
 import { buildActionStateRegistry } from "../utils";
 
@@ -9,6 +10,7 @@ export const componentMappers: Record<string, ComponentBaseMapper> = {
 
 export const triggerRenderers: Record<string, TriggerRenderer> = {
   onAppMention: onAppMentionTriggerRenderer,
+  onMessage: onMessageTriggerRenderer,
 };
 
 export const eventStateRegistry: Record<string, EventStateRegistry> = {
@@ -0,0 +1,136 @@
+import { getBackgroundColorClass, getColorClass } from "@/utils/colors";
+import { TriggerEventContext, TriggerRenderer, TriggerRendererContext } from "../types";
+import { formatTimeAgo } from "@/utils/date";
+import { TriggerProps } from "@/ui/trigger";
+import slackIcon from "@/assets/icons/integrations/slack.svg";
+
+interface OnMessageConfiguration {
+  channel?: string;
+}
+
+interface OnMessageMetadata {
+  channel?: {
+    id?: string;
+    name?: string;
+  };
+}
+
+interface MessageEventData {
+  channel?: string;
+  text?: string;
+  user?: string;
+  ts?: string;
+  event_ts?: string;
+  thread_ts?: string;
+}
+
+/**
+ * Renderer for the "slack.onMessage" trigger
+ */
+export const onMessageTriggerRenderer: TriggerRenderer = {
+  getTitleAndSubtitle: (context: TriggerEventContext): { title: string; subtitle: string } => {
+    const eventData = context.event?.data as MessageEventData | undefined;
+    const title = eventData?.text?.trim() ? eventData.text : "Message";
+    const subtitle = buildSubtitle(
+      eventData?.user ? `Message from ${eventData.user}` : "Message",
+      context.event?.createdAt,
+    );
+
+    return {
+      title,
+      subtitle,
+    };
+  },
+
+  getRootEventValues: (context: TriggerEventContext): Record<string, string> => {
+    const eventData = context.event?.data as MessageEventData | undefined;
+    const postedAt = formatSlackTimestamp(eventData?.ts || eventData?.event_ts);
+
+    return {
+      "Posted At": postedAt || "",
+      Channel: stringOrDash(eventData?.channel),
+      User: stringOrDash(eventData?.user),
+      Text: stringOrDash(eventData?.text),
+      "Thread Timestamp": stringOrDash(eventData?.thread_ts),
+    };
+  },
+
+  getTriggerProps: (context: TriggerRendererContext) => {
+    const { node, definition, lastEvent } = context;
+    const metadata = node.metadata as OnMessageMetadata | undefined;
+    const configuration = node.configuration as OnMessageConfiguration | undefined;
+    const metadataItems = [];
+
+    const channelLabel = metadata?.channel?.name || configuration?.channel;
+    if (channelLabel) {
+      metadataItems.push({
+        icon: "hash",
+        label: channelLabel,
+      });
+    }
+
+    const props: TriggerProps = {
+      title: node.name || definition.label || "Unnamed trigger",
+      iconSrc: slackIcon,
+      iconSlug: "slack",
+      iconColor: getColorClass(definition.color),
+      collapsedBackground: getBackgroundColorClass(definition.color),
+      metadata: metadataItems,
+    };
+
+    if (lastEvent) {
+      const eventData = lastEvent.data as MessageEventData | undefined;
+      const title = eventData?.text?.trim() ? eventData.text : "Message";
+      const subtitle = buildSubtitle(
+        eventData?.user ? `Message from ${eventData.user}` : "Message",
+        lastEvent.createdAt,
+      );
+
+      props.lastEventData = {
+        title,
+        subtitle,
+        receivedAt: new Date(lastEvent.createdAt),
+        state: "triggered",
+        eventId: lastEvent.id,
+      };
+    }
+
+    return props;
+  },
+};
+
+function stringOrDash(value?: unknown): string {
+  if (value === undefined || value === null || value === "") {
+    return "-";
+  }
+
+  return String(value);
+}
+
+function buildSubtitle(content: string, createdAt?: string): string {
+  const timeAgo = createdAt ? formatTimeAgo(new Date(createdAt)) : "";
+  if (content && timeAgo) {
+    return `${content} · ${timeAgo}`;
+  }
+
+  return content || timeAgo;
+}
+
+function formatSlackTimestamp(value?: unknown): string | undefined {
+  if (value === undefined || value === null || value === "") {
+    return undefined;
+  }
+
+  const raw = String(value);
+  const seconds = Number.parseFloat(raw);
+  if (!Number.isNaN(seconds)) {
+    return new Date(seconds * 1000).toLocaleString();
+  }
+
+  const asDate = new Date(raw);
+  if (!Number.isNaN(asDate.getTime())) {
+    return asDate.toLocaleString();
+  }
+
+  return raw;
+}
@@ -0,0 +1,114 @@
+import {
+  ComponentBaseContext,
+  ComponentBaseMapper,
+  ExecutionDetailsContext,
+  ExecutionInfo,
+  NodeInfo,
+  OutputPayload,
+  SubtitleContext,
+} from "./types";
+import { ComponentBaseProps, ComponentBaseSpec, EventSection } from "@/ui/componentBase";
+import { getTriggerRenderer, getState, getStateMap } from ".";
+import { formatTimeAgo } from "@/utils/date";
+
+type TransformConfiguration = {
+  fields?: Array<{ name: string; expression: string }>;
+};
+
+export const transformMapper: ComponentBaseMapper = {
+  props(context: ComponentBaseContext): ComponentBaseProps {
+    const lastExecution = context.lastExecutions.length > 0 ? context.lastExecutions[0] : null;
+    const componentName = context.componentDefinition.name ?? "transform";
+
+    return {
+      iconSlug: context.componentDefinition.icon ?? "shuffle",
+      collapsed: context.node.isCollapsed,
+      collapsedBackground: "bg-white",
+      title:
+        context.node.name ||
+        context.componentDefinition.label ||
+        context.componentDefinition.name ||
+        "Unnamed component",
+      eventSections: lastExecution ? getTransformEventSections(context.nodes, lastExecution, componentName) : undefined,
+      includeEmptyState: !lastExecution,
+      specs: getTransformSpecs(context.node),
+      eventStateMap: getStateMap(componentName),
+    };
+  },
+
+  subtitle(context: SubtitleContext): string {
+    const timestamp = context.execution.updatedAt || context.execution.createdAt;
+    return timestamp ? formatTimeAgo(new Date(timestamp)) : "";
+  },
+
+  getExecutionDetails(context: ExecutionDetailsContext): Record<string, any> {
+    const details: Record<string, any> = {};
+    const outputs = context.execution.outputs as { default?: OutputPayload[] } | undefined;
+    const payload = outputs?.default?.[0];
+
+    if (payload?.timestamp) {
+      details["Transformed At"] = new Date(payload.timestamp).toLocaleString();
+    }
+
+    if (payload?.data && typeof payload.data === "object") {
+      details["Fields"] = Object.keys(payload.data as Record<string, unknown>).join(", ");
+    }
+
+    if (context.execution.resultMessage && context.execution.result === "RESULT_FAILED") {
+      details["Error"] = {
+        __type: "error",
+        message: context.execution.resultMessage,
+      };
+    }
+
+    return details;
+  },
+};
+
+function getTransformSpecs(node: NodeInfo): ComponentBaseSpec[] | undefined {
+  const configuration = node.configuration as TransformConfiguration;
+  const fields = configuration?.fields || [];
+  if (fields.length === 0) {
+    return undefined;
+  }
+
+  return [
+    {
+      title: "field",
+      tooltipTitle: "output fields",
+      iconSlug: "list",
+      values: fields.map((field) => ({
+        badges: [
+          {
+            label: field.name,
+            bgColor: "bg-green-100",
+            textColor: "text-green-800",
+          },
+          {
+            label: field.expression,
+            bgColor: "bg-gray-100",
+            textColor: "text-gray-800",
+          },
+        ],
+      })),
+    },
+  ];
+}
+
+function getTransformEventSections(nodes: NodeInfo[], execution: ExecutionInfo, componentName: string): EventSection[] {
+  const rootTriggerNode = nodes.find((n) => n.id === execution.rootEvent?.nodeId);
+  const rootTriggerRenderer = getTriggerRenderer(rootTriggerNode?.componentName!);
+  const { title } = rootTriggerRenderer.getTitleAndSubtitle({ event: execution.rootEvent });
+  const subtitleTimestamp = execution.updatedAt || execution.createdAt;
+  const eventSubtitle = subtitleTimestamp ? formatTimeAgo(new Date(subtitleTimestamp)) : "";
+
+  return [
+    {
+      receivedAt: new Date(execution.createdAt!),
+      eventTitle: title,
+      eventSubtitle,
+      eventState: getState(componentName)(execution),
+      eventId: execution.rootEvent!.id!,
+    },
+  ];
+}
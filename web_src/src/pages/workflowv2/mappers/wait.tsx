@@ -227,6 +227,22 @@ function getWaitMetadataList(node: NodeInfo): MetadataItem[] {
       } else if (waitUntil) {
         waitLabel = `Wait until: ${waitUntil}`;
       }
+    } else if (mode === "until") {
+      const until = configuration.until as string;
+
+      // Values that are not timestamps are expressions evaluated against the incoming payload
+      if (hasExpressions(until) || (until && isNaN(new Date(until).getTime()))) {
+        waitLabel = (
+          <span>
+            Wait until{" "}
+            <ExpressionTooltip expression={until}>
+              <span className="underline decoration-dotted cursor-help">Internal Expression</span>
+            </ExpressionTooltip>
+          </span>
+        );
+      } else if (until) {
+        waitLabel = `Wait until: ${until}`;
+      }
     }
 
     return [
@@ -282,10 +298,11 @@ function getWaitEventSections(
 }
 
 type WaitConfiguration = {
-  mode: "interval" | "countdown";
+  mode: "interval" | "countdown" | "until";
   unit?: "seconds" | "minutes" | "hours";
   waitFor?: string;
   waitUntil?: string;
+  until?: string;
   duration?: { value: number; unit: "seconds" | "minutes" | "hours" };
 };
 
@@ -434,6 +451,21 @@ export const waitCustomFieldRenderer: CustomFieldRenderer = {
           <p>Check Docs for more details on selecting data from payloads and expressions.</p>
         </div>
       );
+    } else if (mode === "until") {
+      title = "Wait Until Date/Time";
+      content = (
+        <div className="space-y-2">
+          <p>
+            Component will wait until the provided timestamp, or the time an expression evaluates to against the
+            incoming payload, before emitting an event forward. If the time is already in the past, the event is
+            emitted right away.
+          </p>
+          <ExpressionEnvironment />
+          <ExpressionExamples
+            examples={["$.deploy_at", "{{ root().data.deploy_at }}", '{{ $["Node Name"].data.run_at }}']}
+          />
+        </div>
+      );
     } else {
       title = "Wait Component";
       content = "Configure the wait mode to see more details.";
@@ -426,6 +426,7 @@ function CategorySection({
       cloudwatch: awsCloudwatchIcon,
       lambda: awsLambdaIcon,
       ecr: awsEcrIcon,
+      ecs: awsIcon,
       sns: awsSnsIcon,
     },
   };
@@ -504,6 +505,7 @@ function CategorySection({
               codeArtifact: awsCodeArtifactIcon,
               cloudwatch: awsCloudwatchIcon,
               ecr: awsEcrIcon,
+              ecs: awsIcon,
               lambda: awsLambdaIcon,
               sns: awsSnsIcon,
             },
@@ -0,0 +1,37 @@
+import React from "react";
+import { Input } from "@/components/ui/input";
+import { FieldRendererProps } from "./types";
+
+export const DurationFieldRenderer: React.FC<FieldRendererProps> = ({ field, value, onChange }) => {
+  const currentValue =
+    value !== undefined && value !== null
+      ? typeof value === "number"
+        ? `${value}s`
+        : String(value)
+      : ((field.defaultValue as string) ?? "");
+
+  const handleChange = (e: React.ChangeEvent<HTMLInputElement>) => {
+    const newValue = e.target.value;
+    onChange(newValue || undefined);
+  };
+
+  return (
+    <div className="space-y-2">
+      <Input
+        type="text"
+        value={currentValue}
+        onChange={handleChange}
+        placeholder={field.placeholder || "e.g. 30s, 5m, 1h30m"}
+        className=""
+        spellCheck={false}
+      />
+
+      <div className="text-xs text-gray-500 dark:text-gray-400">
+        Use <code className="bg-gray-100 dark:bg-gray-800 px-1 rounded">s</code>,{" "}
+        <code className="bg-gray-100 dark:bg-gray-800 px-1 rounded">m</code> and{" "}
+        <code className="bg-gray-100 dark:bg-gray-800 px-1 rounded">h</code> units, e.g.{" "}
+        <code className="bg-gray-100 dark:bg-gray-800 px-1 rounded">1h30m</code>
+      </div>
+    </div>
+  );
+};
@@ -19,6 +19,7 @@ import { IntegrationResourceFieldRenderer } from "./IntegrationResourceFieldRend
 import { TimeFieldRenderer } from "./TimeFieldRenderer";
 import { DayInYearFieldRenderer } from "./DayInYearFieldRenderer";
 import { CronFieldRenderer } from "./CronFieldRenderer";
+import { DurationFieldRenderer } from "./DurationFieldRenderer";
 import { UserFieldRenderer } from "./UserFieldRenderer";
 import { RoleFieldRenderer } from "./RoleFieldRenderer";
 import { GroupFieldRenderer } from "./GroupFieldRenderer";
@@ -289,6 +290,9 @@ export const ConfigurationFieldRenderer = ({
       case "cron":
         return <CronFieldRenderer {...commonProps} />;
 
+      case "duration":
+        return <DurationFieldRenderer {...commonProps} />;
+
       case "integration-resource":
         return (
           <IntegrationResourceFieldRenderer
@@ -69,6 +69,17 @@ function validateCronExpression(cronExpression: string): string | null {
   return null;
 }
 
+// Same syntax as Go's time.ParseDuration, e.g. 90s, 5m or 1h30m
+const durationRegex = /^(\d+(\.\d+)?(ns|us|µs|ms|s|m|h))+$/;
+
+function validateDuration(duration: string): string | null {
+  if (!durationRegex.test(duration.trim())) {
+    return "Invalid duration. Use a format like 30s, 5m or 1h30m";
+  }
+
+  return null;
+}
+
 export function getDefaultEventType(sourceType: string): string {
   switch (sourceType) {
     case "github":
@@ -214,6 +225,14 @@ export function isFieldRequired(field: ConfigurationField, allValues: Record<str
     return true;
   }
 
+  // Required if another field is set
+  const requiredIf = field.validationRules?.some(
+    (rule) => rule.type === "required_if" && !!rule.compareWith && isValueSet(allValues[rule.compareWith]),
+  );
+  if (requiredIf) {
+    return true;
+  }
+
   // If there are no required conditions, field is not required
   if (!field.requiredConditions || field.requiredConditions.length === 0) {
     return false;
@@ -235,6 +254,29 @@ export function isFieldRequired(field: ConfigurationField, allValues: Record<str
   });
 }
 
+/**
+ * Checks if a value is present and not empty
+ */
+function isValueSet(value: unknown): boolean {
+  if (value === undefined || value === null) {
+    return false;
+  }
+
+  if (typeof value === "string") {
+    return value.trim() !== "";
+  }
+
+  if (Array.isArray(value)) {
+    return value.length > 0;
+  }
+
+  if (typeof value === "object") {
+    return Object.keys(value as Record<string, unknown>).length > 0;
+  }
+
+  return true;
+}
+
 /**
  * Validates a single field value against validation rules
  */
@@ -254,7 +296,19 @@ export function validateFieldValue(
       continue;
     }
 
+    // Checked as part of the required validation
+    if (rule.type === "required_if") {
+      continue;
+    }
+
     const compareValue = allValues[rule.compareWith];
+    if (rule.type === "mutually_exclusive") {
+      if (isValueSet(value) && isValueSet(compareValue)) {
+        errors.push(rule.message || `cannot be set together with ${rule.compareWith}`);
+      }
+      continue;
+    }
+
     const error = validateComparisonRule(field, value, compareValue, rule);
 
     if (error) {
@@ -283,6 +337,13 @@ export function validateFieldForSubmission(
     }
   }
 
+  if (field.type === "duration" && typeof value === "string" && value !== "") {
+    const durationError = validateDuration(value);
+    if (durationError) {
+      errors.push(durationError);
+    }
+  }
+
   // Add min/max validation for number fields
   if (field.type === "number" && value != null && value !== "") {
     const numValue = Number(value);